@@ -31,10 +31,27 @@ const (
 	ManagementService_RenewServiceAccount_FullMethodName        = "/management.ManagementService/RenewServiceAccount"
 	ManagementService_ListServiceAccounts_FullMethodName        = "/management.ManagementService/ListServiceAccounts"
 	ManagementService_DestroyServiceAccount_FullMethodName      = "/management.ManagementService/DestroyServiceAccount"
+	ManagementService_ListUserSessions_FullMethodName           = "/management.ManagementService/ListUserSessions"
+	ManagementService_RevokeUserSession_FullMethodName          = "/management.ManagementService/RevokeUserSession"
 	ManagementService_KubernetesUpgradePreChecks_FullMethodName = "/management.ManagementService/KubernetesUpgradePreChecks"
 	ManagementService_KubernetesSyncManifests_FullMethodName    = "/management.ManagementService/KubernetesSyncManifests"
 	ManagementService_CreateSchematic_FullMethodName            = "/management.ManagementService/CreateSchematic"
 	ManagementService_GetSupportBundle_FullMethodName           = "/management.ManagementService/GetSupportBundle"
+	ManagementService_MoveMachine_FullMethodName                = "/management.ManagementService/MoveMachine"
+	ManagementService_GetCapabilities_FullMethodName            = "/management.ManagementService/GetCapabilities"
+	ManagementService_GetPayloadSamples_FullMethodName          = "/management.ManagementService/GetPayloadSamples"
+	ManagementService_WatchUpgradeProgress_FullMethodName       = "/management.ManagementService/WatchUpgradeProgress"
+	ManagementService_GetClusterAvailability_FullMethodName     = "/management.ManagementService/GetClusterAvailability"
+	ManagementService_GetClusterStatusHistory_FullMethodName    = "/management.ManagementService/GetClusterStatusHistory"
+	ManagementService_ValidateConfigPatch_FullMethodName        = "/management.ManagementService/ValidateConfigPatch"
+	ManagementService_GetMachineUserData_FullMethodName         = "/management.ManagementService/GetMachineUserData"
+	ManagementService_GetJoinArtifacts_FullMethodName           = "/management.ManagementService/GetJoinArtifacts"
+	ManagementService_GetConfigReloadStatus_FullMethodName      = "/management.ManagementService/GetConfigReloadStatus"
+	ManagementService_ValidateResource_FullMethodName           = "/management.ManagementService/ValidateResource"
+	ManagementService_CreateBreakGlassToken_FullMethodName      = "/management.ManagementService/CreateBreakGlassToken"
+	ManagementService_UpdateMachineLabels_FullMethodName        = "/management.ManagementService/UpdateMachineLabels"
+	ManagementService_BackupState_FullMethodName                = "/management.ManagementService/BackupState"
+	ManagementService_RestoreState_FullMethodName               = "/management.ManagementService/RestoreState"
 )
 
 // ManagementServiceClient is the client API for ManagementService service.
@@ -50,10 +67,27 @@ type ManagementServiceClient interface {
 	RenewServiceAccount(ctx context.Context, in *RenewServiceAccountRequest, opts ...grpc.CallOption) (*RenewServiceAccountResponse, error)
 	ListServiceAccounts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListServiceAccountsResponse, error)
 	DestroyServiceAccount(ctx context.Context, in *DestroyServiceAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListUserSessions(ctx context.Context, in *ListUserSessionsRequest, opts ...grpc.CallOption) (*ListUserSessionsResponse, error)
+	RevokeUserSession(ctx context.Context, in *RevokeUserSessionRequest, opts ...grpc.CallOption) (*RevokeUserSessionResponse, error)
 	KubernetesUpgradePreChecks(ctx context.Context, in *KubernetesUpgradePreChecksRequest, opts ...grpc.CallOption) (*KubernetesUpgradePreChecksResponse, error)
 	KubernetesSyncManifests(ctx context.Context, in *KubernetesSyncManifestRequest, opts ...grpc.CallOption) (ManagementService_KubernetesSyncManifestsClient, error)
 	CreateSchematic(ctx context.Context, in *CreateSchematicRequest, opts ...grpc.CallOption) (*CreateSchematicResponse, error)
 	GetSupportBundle(ctx context.Context, in *GetSupportBundleRequest, opts ...grpc.CallOption) (ManagementService_GetSupportBundleClient, error)
+	MoveMachine(ctx context.Context, in *MoveMachineRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetCapabilities(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetCapabilitiesResponse, error)
+	GetPayloadSamples(ctx context.Context, in *GetPayloadSamplesRequest, opts ...grpc.CallOption) (*GetPayloadSamplesResponse, error)
+	WatchUpgradeProgress(ctx context.Context, in *WatchUpgradeProgressRequest, opts ...grpc.CallOption) (ManagementService_WatchUpgradeProgressClient, error)
+	GetClusterAvailability(ctx context.Context, in *GetClusterAvailabilityRequest, opts ...grpc.CallOption) (*GetClusterAvailabilityResponse, error)
+	GetClusterStatusHistory(ctx context.Context, in *GetClusterStatusHistoryRequest, opts ...grpc.CallOption) (*GetClusterStatusHistoryResponse, error)
+	ValidateConfigPatch(ctx context.Context, in *ValidateConfigPatchRequest, opts ...grpc.CallOption) (*ValidateConfigPatchResponse, error)
+	GetMachineUserData(ctx context.Context, in *GetMachineUserDataRequest, opts ...grpc.CallOption) (*GetMachineUserDataResponse, error)
+	GetJoinArtifacts(ctx context.Context, in *GetJoinArtifactsRequest, opts ...grpc.CallOption) (*GetJoinArtifactsResponse, error)
+	GetConfigReloadStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetConfigReloadStatusResponse, error)
+	ValidateResource(ctx context.Context, in *ValidateResourceRequest, opts ...grpc.CallOption) (*ValidateResourceResponse, error)
+	CreateBreakGlassToken(ctx context.Context, in *CreateBreakGlassTokenRequest, opts ...grpc.CallOption) (*CreateBreakGlassTokenResponse, error)
+	UpdateMachineLabels(ctx context.Context, in *UpdateMachineLabelsRequest, opts ...grpc.CallOption) (*UpdateMachineLabelsResponse, error)
+	BackupState(ctx context.Context, in *BackupStateRequest, opts ...grpc.CallOption) (ManagementService_BackupStateClient, error)
+	RestoreState(ctx context.Context, opts ...grpc.CallOption) (ManagementService_RestoreStateClient, error)
 }
 
 type managementServiceClient struct {
@@ -177,6 +211,26 @@ func (c *managementServiceClient) DestroyServiceAccount(ctx context.Context, in
 	return out, nil
 }
 
+func (c *managementServiceClient) ListUserSessions(ctx context.Context, in *ListUserSessionsRequest, opts ...grpc.CallOption) (*ListUserSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserSessionsResponse)
+	err := c.cc.Invoke(ctx, ManagementService_ListUserSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) RevokeUserSession(ctx context.Context, in *RevokeUserSessionRequest, opts ...grpc.CallOption) (*RevokeUserSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeUserSessionResponse)
+	err := c.cc.Invoke(ctx, ManagementService_RevokeUserSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *managementServiceClient) KubernetesUpgradePreChecks(ctx context.Context, in *KubernetesUpgradePreChecksRequest, opts ...grpc.CallOption) (*KubernetesUpgradePreChecksResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(KubernetesUpgradePreChecksResponse)
@@ -263,6 +317,227 @@ func (x *managementServiceGetSupportBundleClient) Recv() (*GetSupportBundleRespo
 	return m, nil
 }
 
+func (c *managementServiceClient) MoveMachine(ctx context.Context, in *MoveMachineRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ManagementService_MoveMachine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) GetCapabilities(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetCapabilitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCapabilitiesResponse)
+	err := c.cc.Invoke(ctx, ManagementService_GetCapabilities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) GetPayloadSamples(ctx context.Context, in *GetPayloadSamplesRequest, opts ...grpc.CallOption) (*GetPayloadSamplesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPayloadSamplesResponse)
+	err := c.cc.Invoke(ctx, ManagementService_GetPayloadSamples_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) WatchUpgradeProgress(ctx context.Context, in *WatchUpgradeProgressRequest, opts ...grpc.CallOption) (ManagementService_WatchUpgradeProgressClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ManagementService_ServiceDesc.Streams[3], ManagementService_WatchUpgradeProgress_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &managementServiceWatchUpgradeProgressClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ManagementService_WatchUpgradeProgressClient interface {
+	Recv() (*WatchUpgradeProgressResponse, error)
+	grpc.ClientStream
+}
+
+type managementServiceWatchUpgradeProgressClient struct {
+	grpc.ClientStream
+}
+
+func (x *managementServiceWatchUpgradeProgressClient) Recv() (*WatchUpgradeProgressResponse, error) {
+	m := new(WatchUpgradeProgressResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *managementServiceClient) GetClusterAvailability(ctx context.Context, in *GetClusterAvailabilityRequest, opts ...grpc.CallOption) (*GetClusterAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetClusterAvailabilityResponse)
+	err := c.cc.Invoke(ctx, ManagementService_GetClusterAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) GetClusterStatusHistory(ctx context.Context, in *GetClusterStatusHistoryRequest, opts ...grpc.CallOption) (*GetClusterStatusHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetClusterStatusHistoryResponse)
+	err := c.cc.Invoke(ctx, ManagementService_GetClusterStatusHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) ValidateConfigPatch(ctx context.Context, in *ValidateConfigPatchRequest, opts ...grpc.CallOption) (*ValidateConfigPatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateConfigPatchResponse)
+	err := c.cc.Invoke(ctx, ManagementService_ValidateConfigPatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) GetMachineUserData(ctx context.Context, in *GetMachineUserDataRequest, opts ...grpc.CallOption) (*GetMachineUserDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMachineUserDataResponse)
+	err := c.cc.Invoke(ctx, ManagementService_GetMachineUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) GetJoinArtifacts(ctx context.Context, in *GetJoinArtifactsRequest, opts ...grpc.CallOption) (*GetJoinArtifactsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJoinArtifactsResponse)
+	err := c.cc.Invoke(ctx, ManagementService_GetJoinArtifacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) GetConfigReloadStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetConfigReloadStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConfigReloadStatusResponse)
+	err := c.cc.Invoke(ctx, ManagementService_GetConfigReloadStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) ValidateResource(ctx context.Context, in *ValidateResourceRequest, opts ...grpc.CallOption) (*ValidateResourceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateResourceResponse)
+	err := c.cc.Invoke(ctx, ManagementService_ValidateResource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) CreateBreakGlassToken(ctx context.Context, in *CreateBreakGlassTokenRequest, opts ...grpc.CallOption) (*CreateBreakGlassTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBreakGlassTokenResponse)
+	err := c.cc.Invoke(ctx, ManagementService_CreateBreakGlassToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) UpdateMachineLabels(ctx context.Context, in *UpdateMachineLabelsRequest, opts ...grpc.CallOption) (*UpdateMachineLabelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateMachineLabelsResponse)
+	err := c.cc.Invoke(ctx, ManagementService_UpdateMachineLabels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) BackupState(ctx context.Context, in *BackupStateRequest, opts ...grpc.CallOption) (ManagementService_BackupStateClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ManagementService_ServiceDesc.Streams[4], ManagementService_BackupState_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &managementServiceBackupStateClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ManagementService_BackupStateClient interface {
+	Recv() (*BackupStateResponse, error)
+	grpc.ClientStream
+}
+
+type managementServiceBackupStateClient struct {
+	grpc.ClientStream
+}
+
+func (x *managementServiceBackupStateClient) Recv() (*BackupStateResponse, error) {
+	m := new(BackupStateResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *managementServiceClient) RestoreState(ctx context.Context, opts ...grpc.CallOption) (ManagementService_RestoreStateClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ManagementService_ServiceDesc.Streams[5], ManagementService_RestoreState_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &managementServiceRestoreStateClient{ClientStream: stream}
+	return x, nil
+}
+
+type ManagementService_RestoreStateClient interface {
+	Send(*RestoreStateRequest) error
+	CloseAndRecv() (*RestoreStateResponse, error)
+	grpc.ClientStream
+}
+
+type managementServiceRestoreStateClient struct {
+	grpc.ClientStream
+}
+
+func (x *managementServiceRestoreStateClient) Send(m *RestoreStateRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *managementServiceRestoreStateClient) CloseAndRecv() (*RestoreStateResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(RestoreStateResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ManagementServiceServer is the server API for ManagementService service.
 // All implementations must embed UnimplementedManagementServiceServer
 // for forward compatibility
@@ -276,10 +551,27 @@ type ManagementServiceServer interface {
 	RenewServiceAccount(context.Context, *RenewServiceAccountRequest) (*RenewServiceAccountResponse, error)
 	ListServiceAccounts(context.Context, *emptypb.Empty) (*ListServiceAccountsResponse, error)
 	DestroyServiceAccount(context.Context, *DestroyServiceAccountRequest) (*emptypb.Empty, error)
+	ListUserSessions(context.Context, *ListUserSessionsRequest) (*ListUserSessionsResponse, error)
+	RevokeUserSession(context.Context, *RevokeUserSessionRequest) (*RevokeUserSessionResponse, error)
 	KubernetesUpgradePreChecks(context.Context, *KubernetesUpgradePreChecksRequest) (*KubernetesUpgradePreChecksResponse, error)
 	KubernetesSyncManifests(*KubernetesSyncManifestRequest, ManagementService_KubernetesSyncManifestsServer) error
 	CreateSchematic(context.Context, *CreateSchematicRequest) (*CreateSchematicResponse, error)
 	GetSupportBundle(*GetSupportBundleRequest, ManagementService_GetSupportBundleServer) error
+	MoveMachine(context.Context, *MoveMachineRequest) (*emptypb.Empty, error)
+	GetCapabilities(context.Context, *emptypb.Empty) (*GetCapabilitiesResponse, error)
+	GetPayloadSamples(context.Context, *GetPayloadSamplesRequest) (*GetPayloadSamplesResponse, error)
+	WatchUpgradeProgress(*WatchUpgradeProgressRequest, ManagementService_WatchUpgradeProgressServer) error
+	GetClusterAvailability(context.Context, *GetClusterAvailabilityRequest) (*GetClusterAvailabilityResponse, error)
+	GetClusterStatusHistory(context.Context, *GetClusterStatusHistoryRequest) (*GetClusterStatusHistoryResponse, error)
+	ValidateConfigPatch(context.Context, *ValidateConfigPatchRequest) (*ValidateConfigPatchResponse, error)
+	GetMachineUserData(context.Context, *GetMachineUserDataRequest) (*GetMachineUserDataResponse, error)
+	GetJoinArtifacts(context.Context, *GetJoinArtifactsRequest) (*GetJoinArtifactsResponse, error)
+	GetConfigReloadStatus(context.Context, *emptypb.Empty) (*GetConfigReloadStatusResponse, error)
+	ValidateResource(context.Context, *ValidateResourceRequest) (*ValidateResourceResponse, error)
+	CreateBreakGlassToken(context.Context, *CreateBreakGlassTokenRequest) (*CreateBreakGlassTokenResponse, error)
+	UpdateMachineLabels(context.Context, *UpdateMachineLabelsRequest) (*UpdateMachineLabelsResponse, error)
+	BackupState(*BackupStateRequest, ManagementService_BackupStateServer) error
+	RestoreState(ManagementService_RestoreStateServer) error
 	mustEmbedUnimplementedManagementServiceServer()
 }
 
@@ -314,6 +606,12 @@ func (UnimplementedManagementServiceServer) ListServiceAccounts(context.Context,
 func (UnimplementedManagementServiceServer) DestroyServiceAccount(context.Context, *DestroyServiceAccountRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DestroyServiceAccount not implemented")
 }
+func (UnimplementedManagementServiceServer) ListUserSessions(context.Context, *ListUserSessionsRequest) (*ListUserSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserSessions not implemented")
+}
+func (UnimplementedManagementServiceServer) RevokeUserSession(context.Context, *RevokeUserSessionRequest) (*RevokeUserSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeUserSession not implemented")
+}
 func (UnimplementedManagementServiceServer) KubernetesUpgradePreChecks(context.Context, *KubernetesUpgradePreChecksRequest) (*KubernetesUpgradePreChecksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method KubernetesUpgradePreChecks not implemented")
 }
@@ -326,6 +624,51 @@ func (UnimplementedManagementServiceServer) CreateSchematic(context.Context, *Cr
 func (UnimplementedManagementServiceServer) GetSupportBundle(*GetSupportBundleRequest, ManagementService_GetSupportBundleServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetSupportBundle not implemented")
 }
+func (UnimplementedManagementServiceServer) MoveMachine(context.Context, *MoveMachineRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveMachine not implemented")
+}
+func (UnimplementedManagementServiceServer) GetCapabilities(context.Context, *emptypb.Empty) (*GetCapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCapabilities not implemented")
+}
+func (UnimplementedManagementServiceServer) GetPayloadSamples(context.Context, *GetPayloadSamplesRequest) (*GetPayloadSamplesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayloadSamples not implemented")
+}
+func (UnimplementedManagementServiceServer) WatchUpgradeProgress(*WatchUpgradeProgressRequest, ManagementService_WatchUpgradeProgressServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchUpgradeProgress not implemented")
+}
+func (UnimplementedManagementServiceServer) GetClusterAvailability(context.Context, *GetClusterAvailabilityRequest) (*GetClusterAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClusterAvailability not implemented")
+}
+func (UnimplementedManagementServiceServer) GetClusterStatusHistory(context.Context, *GetClusterStatusHistoryRequest) (*GetClusterStatusHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClusterStatusHistory not implemented")
+}
+func (UnimplementedManagementServiceServer) ValidateConfigPatch(context.Context, *ValidateConfigPatchRequest) (*ValidateConfigPatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateConfigPatch not implemented")
+}
+func (UnimplementedManagementServiceServer) GetMachineUserData(context.Context, *GetMachineUserDataRequest) (*GetMachineUserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMachineUserData not implemented")
+}
+func (UnimplementedManagementServiceServer) GetJoinArtifacts(context.Context, *GetJoinArtifactsRequest) (*GetJoinArtifactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJoinArtifacts not implemented")
+}
+func (UnimplementedManagementServiceServer) GetConfigReloadStatus(context.Context, *emptypb.Empty) (*GetConfigReloadStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfigReloadStatus not implemented")
+}
+func (UnimplementedManagementServiceServer) ValidateResource(context.Context, *ValidateResourceRequest) (*ValidateResourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateResource not implemented")
+}
+func (UnimplementedManagementServiceServer) CreateBreakGlassToken(context.Context, *CreateBreakGlassTokenRequest) (*CreateBreakGlassTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBreakGlassToken not implemented")
+}
+func (UnimplementedManagementServiceServer) UpdateMachineLabels(context.Context, *UpdateMachineLabelsRequest) (*UpdateMachineLabelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMachineLabels not implemented")
+}
+func (UnimplementedManagementServiceServer) BackupState(*BackupStateRequest, ManagementService_BackupStateServer) error {
+	return status.Errorf(codes.Unimplemented, "method BackupState not implemented")
+}
+func (UnimplementedManagementServiceServer) RestoreState(ManagementService_RestoreStateServer) error {
+	return status.Errorf(codes.Unimplemented, "method RestoreState not implemented")
+}
 func (UnimplementedManagementServiceServer) mustEmbedUnimplementedManagementServiceServer() {}
 
 // UnsafeManagementServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -504,6 +847,42 @@ func _ManagementService_DestroyServiceAccount_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ManagementService_ListUserSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).ListUserSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_ListUserSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).ListUserSessions(ctx, req.(*ListUserSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_RevokeUserSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeUserSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).RevokeUserSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_RevokeUserSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).RevokeUserSession(ctx, req.(*RevokeUserSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ManagementService_KubernetesUpgradePreChecks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(KubernetesUpgradePreChecksRequest)
 	if err := dec(in); err != nil {
@@ -582,6 +961,290 @@ func (x *managementServiceGetSupportBundleServer) Send(m *GetSupportBundleRespon
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ManagementService_MoveMachine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveMachineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).MoveMachine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_MoveMachine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).MoveMachine(ctx, req.(*MoveMachineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_GetCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).GetCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_GetCapabilities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).GetCapabilities(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_GetPayloadSamples_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPayloadSamplesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).GetPayloadSamples(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_GetPayloadSamples_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).GetPayloadSamples(ctx, req.(*GetPayloadSamplesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_WatchUpgradeProgress_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchUpgradeProgressRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ManagementServiceServer).WatchUpgradeProgress(m, &managementServiceWatchUpgradeProgressServer{ServerStream: stream})
+}
+
+type ManagementService_WatchUpgradeProgressServer interface {
+	Send(*WatchUpgradeProgressResponse) error
+	grpc.ServerStream
+}
+
+type managementServiceWatchUpgradeProgressServer struct {
+	grpc.ServerStream
+}
+
+func (x *managementServiceWatchUpgradeProgressServer) Send(m *WatchUpgradeProgressResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ManagementService_GetClusterAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClusterAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).GetClusterAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_GetClusterAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).GetClusterAvailability(ctx, req.(*GetClusterAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_GetClusterStatusHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClusterStatusHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).GetClusterStatusHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_GetClusterStatusHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).GetClusterStatusHistory(ctx, req.(*GetClusterStatusHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_ValidateConfigPatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateConfigPatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).ValidateConfigPatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_ValidateConfigPatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).ValidateConfigPatch(ctx, req.(*ValidateConfigPatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_GetMachineUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMachineUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).GetMachineUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_GetMachineUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).GetMachineUserData(ctx, req.(*GetMachineUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_GetJoinArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJoinArtifactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).GetJoinArtifacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_GetJoinArtifacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).GetJoinArtifacts(ctx, req.(*GetJoinArtifactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_GetConfigReloadStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).GetConfigReloadStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_GetConfigReloadStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).GetConfigReloadStatus(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_ValidateResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).ValidateResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_ValidateResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).ValidateResource(ctx, req.(*ValidateResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_CreateBreakGlassToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBreakGlassTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).CreateBreakGlassToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_CreateBreakGlassToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).CreateBreakGlassToken(ctx, req.(*CreateBreakGlassTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_UpdateMachineLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMachineLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).UpdateMachineLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_UpdateMachineLabels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).UpdateMachineLabels(ctx, req.(*UpdateMachineLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_BackupState_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BackupStateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ManagementServiceServer).BackupState(m, &managementServiceBackupStateServer{ServerStream: stream})
+}
+
+type ManagementService_BackupStateServer interface {
+	Send(*BackupStateResponse) error
+	grpc.ServerStream
+}
+
+type managementServiceBackupStateServer struct {
+	grpc.ServerStream
+}
+
+func (x *managementServiceBackupStateServer) Send(m *BackupStateResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ManagementService_RestoreState_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ManagementServiceServer).RestoreState(&managementServiceRestoreStateServer{ServerStream: stream})
+}
+
+type ManagementService_RestoreStateServer interface {
+	SendAndClose(*RestoreStateResponse) error
+	Recv() (*RestoreStateRequest, error)
+	grpc.ServerStream
+}
+
+type managementServiceRestoreStateServer struct {
+	grpc.ServerStream
+}
+
+func (x *managementServiceRestoreStateServer) SendAndClose(m *RestoreStateResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *managementServiceRestoreStateServer) Recv() (*RestoreStateRequest, error) {
+	m := new(RestoreStateRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ManagementService_ServiceDesc is the grpc.ServiceDesc for ManagementService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -621,6 +1284,14 @@ var ManagementService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DestroyServiceAccount",
 			Handler:    _ManagementService_DestroyServiceAccount_Handler,
 		},
+		{
+			MethodName: "ListUserSessions",
+			Handler:    _ManagementService_ListUserSessions_Handler,
+		},
+		{
+			MethodName: "RevokeUserSession",
+			Handler:    _ManagementService_RevokeUserSession_Handler,
+		},
 		{
 			MethodName: "KubernetesUpgradePreChecks",
 			Handler:    _ManagementService_KubernetesUpgradePreChecks_Handler,
@@ -629,6 +1300,54 @@ var ManagementService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateSchematic",
 			Handler:    _ManagementService_CreateSchematic_Handler,
 		},
+		{
+			MethodName: "MoveMachine",
+			Handler:    _ManagementService_MoveMachine_Handler,
+		},
+		{
+			MethodName: "GetCapabilities",
+			Handler:    _ManagementService_GetCapabilities_Handler,
+		},
+		{
+			MethodName: "GetPayloadSamples",
+			Handler:    _ManagementService_GetPayloadSamples_Handler,
+		},
+		{
+			MethodName: "GetClusterAvailability",
+			Handler:    _ManagementService_GetClusterAvailability_Handler,
+		},
+		{
+			MethodName: "GetClusterStatusHistory",
+			Handler:    _ManagementService_GetClusterStatusHistory_Handler,
+		},
+		{
+			MethodName: "ValidateConfigPatch",
+			Handler:    _ManagementService_ValidateConfigPatch_Handler,
+		},
+		{
+			MethodName: "GetMachineUserData",
+			Handler:    _ManagementService_GetMachineUserData_Handler,
+		},
+		{
+			MethodName: "GetJoinArtifacts",
+			Handler:    _ManagementService_GetJoinArtifacts_Handler,
+		},
+		{
+			MethodName: "GetConfigReloadStatus",
+			Handler:    _ManagementService_GetConfigReloadStatus_Handler,
+		},
+		{
+			MethodName: "ValidateResource",
+			Handler:    _ManagementService_ValidateResource_Handler,
+		},
+		{
+			MethodName: "CreateBreakGlassToken",
+			Handler:    _ManagementService_CreateBreakGlassToken_Handler,
+		},
+		{
+			MethodName: "UpdateMachineLabels",
+			Handler:    _ManagementService_UpdateMachineLabels_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -646,6 +1365,21 @@ var ManagementService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ManagementService_GetSupportBundle_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchUpgradeProgress",
+			Handler:       _ManagementService_WatchUpgradeProgress_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "BackupState",
+			Handler:       _ManagementService_BackupState_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RestoreState",
+			Handler:       _ManagementService_RestoreState_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "omni/management/management.proto",
 }
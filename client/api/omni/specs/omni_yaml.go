@@ -37,12 +37,25 @@ func (c *ClusterMachineConfigPatchesSpec) MarshalYAML() (any, error) {
 		}
 	})
 
-	return &yaml.Node{
+	node := &yaml.Node{
 		Kind: yaml.MappingNode,
 		Tag:  "!!map",
 		Content: []*yaml.Node{
 			{Kind: yaml.ScalarNode, Tag: "!!str", Value: "patches"},
 			{Kind: yaml.SequenceNode, Tag: "!!seq", Content: contents},
 		},
-	}, nil
+	}
+
+	if len(c.BlobIds) > 0 {
+		blobIDs := xslices.Map(c.BlobIds, func(id string) *yaml.Node {
+			return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: id}
+		})
+
+		node.Content = append(node.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "blobids"},
+			&yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq", Content: blobIDs},
+		)
+	}
+
+	return node, nil
 }
@@ -45,6 +45,7 @@ func (m *SysVersionSpec) CloneVT() *SysVersionSpec {
 	r.BackendVersion = m.BackendVersion
 	r.InstanceName = m.InstanceName
 	r.BackendApiVersion = m.BackendApiVersion
+	r.BackendMinApiVersion = m.BackendMinApiVersion
 	if len(m.unknownFields) > 0 {
 		r.unknownFields = make([]byte, len(m.unknownFields))
 		copy(r.unknownFields, m.unknownFields)
@@ -106,6 +107,9 @@ func (this *SysVersionSpec) EqualVT(that *SysVersionSpec) bool {
 	if this.BackendApiVersion != that.BackendApiVersion {
 		return false
 	}
+	if this.BackendMinApiVersion != that.BackendMinApiVersion {
+		return false
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -200,6 +204,11 @@ func (m *SysVersionSpec) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if m.BackendMinApiVersion != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.BackendMinApiVersion))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.BackendApiVersion != 0 {
 		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.BackendApiVersion))
 		i--
@@ -285,6 +294,9 @@ func (m *SysVersionSpec) SizeVT() (n int) {
 	if m.BackendApiVersion != 0 {
 		n += 1 + protohelpers.SizeOfVarint(uint64(m.BackendApiVersion))
 	}
+	if m.BackendMinApiVersion != 0 {
+		n += 1 + protohelpers.SizeOfVarint(uint64(m.BackendMinApiVersion))
+	}
 	n += len(m.unknownFields)
 	return n
 }
@@ -481,6 +493,25 @@ func (m *SysVersionSpec) UnmarshalVT(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackendMinApiVersion", wireType)
+			}
+			m.BackendMinApiVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BackendMinApiVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
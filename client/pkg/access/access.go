@@ -8,4 +8,7 @@ package access
 const (
 	// ServiceAccountNameSuffix is appended to the name of all service accounts.
 	ServiceAccountNameSuffix = "@serviceaccount.omni.sidero.dev"
+
+	// BreakGlassNameSuffix is appended to the name of all identities granted the break-glass access.
+	BreakGlassNameSuffix = "@break-glass.omni.sidero.dev"
 )
@@ -0,0 +1,76 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/siderolabs/omni/client/pkg/constants"
+	"github.com/siderolabs/omni/client/pkg/version"
+)
+
+// DeprecationHandler is called when the server reports that the called method is deprecated.
+type DeprecationHandler func(method, message string)
+
+// apiVersionInterceptor reports the client API version to the server, and passes the deprecation notices to the handler.
+type apiVersionInterceptor struct {
+	deprecationHandler DeprecationHandler
+}
+
+func (i *apiVersionInterceptor) appendVersion(ctx context.Context) context.Context {
+	if version.API == 0 {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, constants.APIVersionHeader, strconv.FormatUint(uint64(version.API), 10))
+}
+
+func (i *apiVersionInterceptor) handleHeader(method string, header metadata.MD) {
+	if i.deprecationHandler == nil {
+		return
+	}
+
+	if values := header.Get(constants.DeprecationHeader); len(values) > 0 {
+		i.deprecationHandler(method, values[0])
+	}
+}
+
+// Unary returns a unary client interceptor.
+func (i *apiVersionInterceptor) Unary() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		var header metadata.MD
+
+		err := invoker(i.appendVersion(ctx), method, req, reply, cc, append(opts, grpc.Header(&header))...)
+
+		i.handleHeader(method, header)
+
+		return err
+	}
+}
+
+// Stream returns a stream client interceptor.
+func (i *apiVersionInterceptor) Stream() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		stream, err := streamer(i.appendVersion(ctx), desc, cc, method, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		if i.deprecationHandler != nil {
+			go func() {
+				// the header is received asynchronously, don't block the stream creation
+				if header, headerErr := stream.Header(); headerErr == nil {
+					i.handleHeader(method, header)
+				}
+			}()
+		}
+
+		return stream, nil
+	}
+}
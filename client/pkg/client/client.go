@@ -6,17 +6,22 @@
 package client
 
 import (
+	"context"
 	"crypto/tls"
 	"net"
 	"net/url"
 	"slices"
+	"sync"
 
 	"github.com/siderolabs/go-api-signature/pkg/client/auth"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/status"
 
+	managementpb "github.com/siderolabs/omni/client/api/omni/management"
 	"github.com/siderolabs/omni/client/pkg/client/management"
 	"github.com/siderolabs/omni/client/pkg/client/oidc"
 	"github.com/siderolabs/omni/client/pkg/client/omni"
@@ -28,7 +33,11 @@ import (
 type Client struct {
 	conn *grpc.ClientConn
 
+	capabilities *managementpb.GetCapabilitiesResponse
+
 	endpoint string
+
+	capabilitiesMu sync.Mutex
 }
 
 // New creates a new Omni API client.
@@ -59,12 +68,28 @@ func New(endpoint string, opts ...Option) (*Client, error) {
 		opt(&options)
 	}
 
+	apiVersionInterceptor := &apiVersionInterceptor{
+		deprecationHandler: options.deprecationHandler,
+	}
+
+	unaryInterceptors := []grpc.UnaryClientInterceptor{apiVersionInterceptor.Unary()}
+	streamInterceptors := []grpc.StreamClientInterceptor{apiVersionInterceptor.Stream()}
+
 	if options.AuthInterceptor != nil {
-		grpcDialOptions = append(grpcDialOptions,
-			grpc.WithUnaryInterceptor(options.AuthInterceptor.Unary()),
-			grpc.WithStreamInterceptor(options.AuthInterceptor.Stream()))
+		// the re-authentication interceptor must wrap the signature interceptor, so that the retried call is signed with the new key
+		if options.reauthInterceptor != nil {
+			unaryInterceptors = append(unaryInterceptors, options.reauthInterceptor.Unary())
+			streamInterceptors = append(streamInterceptors, options.reauthInterceptor.Stream())
+		}
+
+		unaryInterceptors = append(unaryInterceptors, options.AuthInterceptor.Unary())
+		streamInterceptors = append(streamInterceptors, options.AuthInterceptor.Stream())
 	}
 
+	grpcDialOptions = append(grpcDialOptions,
+		grpc.WithChainUnaryInterceptor(unaryInterceptors...),
+		grpc.WithChainStreamInterceptor(streamInterceptors...))
+
 	grpcDialOptions = slices.Concat(grpcDialOptions, options.AdditionalGRPCDialOptions)
 
 	switch u.Scheme {
@@ -130,3 +155,39 @@ func (c *Client) Talos() *talos.Client {
 func (c *Client) Endpoint() string {
 	return c.endpoint
 }
+
+// Capabilities returns the optional features enabled on the server, the API limits and the deprecation notices.
+//
+// The capabilities are fetched on the first call and cached for the lifetime of the client.
+// The servers which don't implement the capabilities API report no features, limits and deprecations.
+func (c *Client) Capabilities(ctx context.Context) (*managementpb.GetCapabilitiesResponse, error) {
+	c.capabilitiesMu.Lock()
+	defer c.capabilitiesMu.Unlock()
+
+	if c.capabilities != nil {
+		return c.capabilities, nil
+	}
+
+	capabilities, err := c.Management().GetCapabilities(ctx)
+	if err != nil {
+		if status.Code(err) != codes.Unimplemented {
+			return nil, err
+		}
+
+		capabilities = &managementpb.GetCapabilitiesResponse{}
+	}
+
+	c.capabilities = capabilities
+
+	return capabilities, nil
+}
+
+// HasFeature checks if the optional feature is enabled on the server, see constants.Feature* for the feature names.
+func (c *Client) HasFeature(ctx context.Context, feature string) (bool, error) {
+	capabilities, err := c.Capabilities(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return slices.Contains(capabilities.GetFeatures(), feature), nil
+}
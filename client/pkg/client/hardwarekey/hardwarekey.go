@@ -0,0 +1,213 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package hardwarekey implements a signing key stored on a hardware security token.
+//
+// The key is an OpenPGP key residing on a smart card (e.g. the OpenPGP applet of a YubiKey or a PIV token
+// exposed via gpg-agent), the private key never leaves the token, and the signing operations are delegated to gpg.
+// The touch confirmation is enforced by the token itself via its touch policy.
+//
+// The touch prompt is printed only for the mutating calls, see IsMutating. The token touch policy is expected to be
+// "cached" (e.g. ykman openpgp keys set-touch sig cached), so that the read-only calls made right after
+// a confirmed one are signed without another touch.
+package hardwarekey
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	pgpcrypto "github.com/ProtonMail/gopenpgp/v2/crypto"
+)
+
+// DefaultGPGPath is the default gpg binary used to talk to the hardware token.
+const DefaultGPGPath = "gpg"
+
+// Key is a signing key stored on a hardware token.
+//
+// It implements message.Signer.
+type Key struct {
+	prompt        io.Writer
+	touchRequired func(method string) bool
+	gpgPath       string
+	keyID         string
+	fingerprint   string
+	armoredPublic string
+}
+
+// Option configures the Key.
+type Option func(*Key)
+
+// WithGPGPath sets the path to the gpg binary.
+func WithGPGPath(path string) Option {
+	return func(k *Key) {
+		k.gpgPath = path
+	}
+}
+
+// WithTouchPrompt sets the writer to print the touch confirmation prompt to before signing the mutating calls.
+func WithTouchPrompt(w io.Writer) Option {
+	return func(k *Key) {
+		k.prompt = w
+	}
+}
+
+// WithTouchRequired sets the function which decides if the touch prompt is printed for the call with the given
+// gRPC method or HTTP verb, IsMutating is used by default.
+func WithTouchRequired(touchRequired func(method string) bool) Option {
+	return func(k *Key) {
+		k.touchRequired = touchRequired
+	}
+}
+
+// New looks up the key with the given ID (fingerprint, long key ID or user ID) in the gpg keyring.
+//
+// The private part of the key is expected to be stored on a hardware token.
+func New(ctx context.Context, keyID string, opts ...Option) (*Key, error) {
+	key := &Key{
+		gpgPath:       DefaultGPGPath,
+		keyID:         keyID,
+		prompt:        os.Stderr,
+		touchRequired: IsMutating,
+	}
+
+	for _, opt := range opts {
+		opt(key)
+	}
+
+	armored, err := key.gpg(ctx, nil, "--armor", "--export", keyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export the public key %q: %w", keyID, err)
+	}
+
+	if len(bytes.TrimSpace(armored)) == 0 {
+		return nil, fmt.Errorf("public key %q not found in the gpg keyring", keyID)
+	}
+
+	pgpKey, err := pgpcrypto.NewKeyFromArmored(string(armored))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the public key %q: %w", keyID, err)
+	}
+
+	if !pgpKey.CanVerify() {
+		return nil, fmt.Errorf("key %q can't be used for signing", keyID)
+	}
+
+	if pgpKey.IsExpired() {
+		return nil, fmt.Errorf("key %q is expired", keyID)
+	}
+
+	// Omni refuses to register the keys without an expiration
+	if pgpKey.GetEntity().PrimaryIdentity().SelfSignature.KeyLifetimeSecs == nil {
+		return nil, fmt.Errorf("key %q has no expiration", keyID)
+	}
+
+	key.armoredPublic = string(armored)
+	key.fingerprint = pgpKey.GetFingerprint()
+
+	return key, nil
+}
+
+// Fingerprint implements message.Signer.
+func (k *Key) Fingerprint() string {
+	return k.fingerprint
+}
+
+// Sign implements message.Signer.
+//
+// The signature is a binary detached OpenPGP signature, the same as produced by the software keys.
+func (k *Key) Sign(data []byte) ([]byte, error) {
+	if k.prompt != nil && k.touchRequired(payloadMethod(data)) {
+		fmt.Fprintln(k.prompt, "Touch your security key to confirm the request...") //nolint:errcheck
+	}
+
+	signature, err := k.gpg(context.Background(), data, "--local-user", k.fingerprint, "--detach-sign", "--output", "-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign with the hardware key: %w", err)
+	}
+
+	return signature, nil
+}
+
+// readOnlyPrefixes are the prefixes of the names of the gRPC methods which don't change anything.
+var readOnlyPrefixes = []string{"Get", "List", "Watch", "Validate"}
+
+// IsMutating checks if the call with the given gRPC method or HTTP verb can change anything.
+//
+// The unknown calls are considered mutating.
+func IsMutating(method string) bool {
+	switch method {
+	case "GET", "HEAD", "OPTIONS":
+		return false
+	}
+
+	_, name, ok := strings.Cut(strings.TrimPrefix(method, "/"), "/")
+	if !ok {
+		return true
+	}
+
+	for _, prefix := range readOnlyPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// payloadMethod returns the gRPC method or the HTTP verb of the signed payload, see message.GRPCPayload and message.HTTP.
+func payloadMethod(data []byte) string {
+	if bytes.HasPrefix(data, []byte("{")) {
+		var payload struct {
+			Method string `json:"method"`
+		}
+
+		if err := json.Unmarshal(data, &payload); err != nil {
+			return ""
+		}
+
+		return payload.Method
+	}
+
+	verb, _, _ := bytes.Cut(data, []byte("\n"))
+
+	return string(verb)
+}
+
+// ArmorPublic returns the armored public key.
+func (k *Key) ArmorPublic() (string, error) {
+	if k.armoredPublic == "" {
+		return "", errors.New("public key is not loaded")
+	}
+
+	return k.armoredPublic, nil
+}
+
+func (k *Key) gpg(ctx context.Context, stdin []byte, args ...string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, k.gpgPath, append([]string{"--no-tty", "--quiet"}, args...)...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if stdin != nil {
+		cmd.Stdin = bytes.NewReader(stdin)
+	}
+
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("%w: %s", err, msg)
+		}
+
+		return nil, err
+	}
+
+	return stdout.Bytes(), nil
+}
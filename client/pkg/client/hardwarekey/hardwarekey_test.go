@@ -0,0 +1,291 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hardwarekey_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pgpcrypto "github.com/ProtonMail/gopenpgp/v2/crypto"
+	"github.com/siderolabs/go-api-signature/pkg/message"
+	"github.com/siderolabs/go-api-signature/pkg/pgp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/pkg/client/hardwarekey"
+)
+
+const (
+	// fakeGPGEnv makes the test binary act as the fake gpg, the value is the directory with the keys.
+	fakeGPGEnv = "HARDWAREKEY_FAKE_GPG_DIR"
+
+	keyID          = "test@example.org"
+	otherKeyID     = "other@example.org"
+	noExpiryKeyID  = "no-expiry@example.org"
+	privateKeyFile = "private.asc"
+)
+
+// TestMain runs the test binary as the fake gpg, if it is started by the Key, see fakeGPG.
+func TestMain(m *testing.M) {
+	if dir := os.Getenv(fakeGPGEnv); dir != "" {
+		os.Exit(fakeGPG(dir, os.Args[1:]))
+	}
+
+	dir, err := os.MkdirTemp("", "hardwarekey")
+	if err != nil {
+		panic(err)
+	}
+
+	if err = writeKeys(dir); err != nil {
+		panic(err)
+	}
+
+	os.Setenv(fakeGPGEnv, dir) //nolint:errcheck
+
+	code := m.Run()
+
+	os.RemoveAll(dir) //nolint:errcheck
+
+	os.Exit(code)
+}
+
+func writeKeys(dir string) error {
+	key, err := pgp.GenerateKey("", "", keyID, time.Hour)
+	if err != nil {
+		return err
+	}
+
+	otherKey, err := pgp.GenerateKey("", "", otherKeyID, time.Hour)
+	if err != nil {
+		return err
+	}
+
+	noExpiryKey, err := pgp.GenerateKey("", "", noExpiryKeyID, 0)
+	if err != nil {
+		return err
+	}
+
+	armored, err := key.Armor()
+	if err != nil {
+		return err
+	}
+
+	if err = os.WriteFile(filepath.Join(dir, privateKeyFile), []byte(armored), 0o600); err != nil {
+		return err
+	}
+
+	for id, k := range map[string]*pgp.Key{keyID: key, otherKeyID: otherKey, noExpiryKeyID: noExpiryKey} {
+		var public string
+
+		if public, err = k.ArmorPublic(); err != nil {
+			return err
+		}
+
+		if err = os.WriteFile(filepath.Join(dir, id+".pub"), []byte(public), 0o600); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fakeGPG implements the gpg commands used by the Key, the private key of the keyID plays the hardware token.
+func fakeGPG(dir string, args []string) int {
+	if len(args) < 2 || args[0] != "--no-tty" || args[1] != "--quiet" {
+		fmt.Fprintf(os.Stderr, "unexpected arguments %q\n", args) //nolint:errcheck
+
+		return 2
+	}
+
+	args = args[2:]
+
+	switch {
+	case len(args) == 3 && args[0] == "--armor" && args[1] == "--export":
+		public, err := os.ReadFile(filepath.Join(dir, args[2]+".pub"))
+		if err != nil {
+			// gpg exports nothing for the unknown keys
+			return 0
+		}
+
+		os.Stdout.Write(public) //nolint:errcheck
+
+		return 0
+	case len(args) == 5 && args[0] == "--local-user" && args[2] == "--detach-sign":
+		armored, err := os.ReadFile(filepath.Join(dir, privateKeyFile))
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err) //nolint:errcheck
+
+			return 2
+		}
+
+		signature, err := sign(string(armored), args[1])
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err) //nolint:errcheck
+
+			return 2
+		}
+
+		os.Stdout.Write(signature) //nolint:errcheck
+
+		return 0
+	}
+
+	fmt.Fprintf(os.Stderr, "unexpected arguments %q\n", args) //nolint:errcheck
+
+	return 2
+}
+
+func sign(armored, fingerprint string) ([]byte, error) {
+	key, err := loadKey(armored)
+	if err != nil {
+		return nil, err
+	}
+
+	if key.Fingerprint() != fingerprint {
+		return nil, fmt.Errorf("secret key %q not available", fingerprint)
+	}
+
+	data, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return nil, err
+	}
+
+	return key.Sign(data)
+}
+
+func grpcPayload(t *testing.T, method string) []byte {
+	t.Helper()
+
+	payload, err := json.Marshal(message.GRPCPayload{
+		Headers: map[string][]string{message.TimestampHeaderKey: {"1700000000"}},
+		Method:  method,
+	})
+	require.NoError(t, err)
+
+	return payload
+}
+
+func TestKey(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	t.Cleanup(cancel)
+
+	var prompt bytes.Buffer
+
+	key, err := hardwarekey.New(ctx, keyID, hardwarekey.WithGPGPath(os.Args[0]), hardwarekey.WithTouchPrompt(&prompt))
+	require.NoError(t, err)
+
+	public, err := os.ReadFile(filepath.Join(os.Getenv(fakeGPGEnv), keyID+".pub"))
+	require.NoError(t, err)
+
+	armoredPublic, err := key.ArmorPublic()
+	require.NoError(t, err)
+	assert.Equal(t, string(public), armoredPublic)
+
+	verifier, err := loadKey(armoredPublic)
+	require.NoError(t, err)
+	assert.Equal(t, verifier.Fingerprint(), key.Fingerprint())
+
+	for _, tt := range []struct {
+		name   string
+		data   []byte
+		prompt bool
+	}{
+		{
+			name:   "mutating gRPC call",
+			data:   grpcPayload(t, "/omni.resources.ResourceService/Update"),
+			prompt: true,
+		},
+		{
+			name: "read-only gRPC call",
+			data: grpcPayload(t, "/omni.resources.ResourceService/List"),
+		},
+		{
+			name:   "mutating HTTP call",
+			data:   []byte("POST\n/image/abcd\n1700000000\ne3b0c442"),
+			prompt: true,
+		},
+		{
+			name: "read-only HTTP call",
+			data: []byte("GET\n/image/abcd\n1700000000\ne3b0c442"),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			prompt.Reset()
+
+			signature, err := key.Sign(tt.data)
+			require.NoError(t, err)
+
+			require.NoError(t, verifier.Verify(tt.data, signature))
+
+			if tt.prompt {
+				assert.Contains(t, prompt.String(), "Touch your security key")
+			} else {
+				assert.Empty(t, prompt.String())
+			}
+		})
+	}
+}
+
+func TestKeyErrors(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	t.Cleanup(cancel)
+
+	_, err := hardwarekey.New(ctx, "unknown@example.org", hardwarekey.WithGPGPath(os.Args[0]))
+	assert.ErrorContains(t, err, "not found in the gpg keyring")
+
+	_, err = hardwarekey.New(ctx, noExpiryKeyID, hardwarekey.WithGPGPath(os.Args[0]))
+	assert.ErrorContains(t, err, "has no expiration")
+
+	_, err = hardwarekey.New(ctx, keyID, hardwarekey.WithGPGPath(filepath.Join(t.TempDir(), "gpg")))
+	assert.ErrorContains(t, err, "failed to export the public key")
+
+	// the public key is in the keyring, but the private key is not on the token
+	key, err := hardwarekey.New(ctx, otherKeyID, hardwarekey.WithGPGPath(os.Args[0]), hardwarekey.WithTouchPrompt(nil))
+	require.NoError(t, err)
+
+	_, err = key.Sign(grpcPayload(t, "/omni.resources.ResourceService/Get"))
+	assert.ErrorContains(t, err, "not available")
+}
+
+func TestIsMutating(t *testing.T) {
+	t.Parallel()
+
+	for method, expected := range map[string]bool{
+		"/omni.resources.ResourceService/Get":          false,
+		"/omni.resources.ResourceService/List":         false,
+		"/omni.resources.ResourceService/Watch":        false,
+		"/omni.resources.ResourceService/Create":       true,
+		"/omni.resources.ResourceService/Delete":       true,
+		"/management.ManagementService/ValidateConfig": false,
+		"/management.ManagementService/Kubeconfig":     true,
+		"/machine.MachineService/Reboot":               true,
+		"GET":                                          false,
+		"HEAD":                                         false,
+		"POST":                                         true,
+		"":                                             true,
+	} {
+		assert.Equal(t, expected, hardwarekey.IsMutating(method), method)
+	}
+}
+
+func loadKey(armored string) (*pgp.Key, error) {
+	key, err := pgpcrypto.NewKeyFromArmored(armored)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgp.NewKey(key)
+}
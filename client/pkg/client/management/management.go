@@ -19,6 +19,7 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/siderolabs/omni/client/api/omni/management"
 )
@@ -67,6 +68,33 @@ func WithBreakGlassKubeconfig(value bool) KubeconfigOption {
 	}
 }
 
+// CreateServiceAccountOption is a functional option for CreateServiceAccount.
+type CreateServiceAccountOption func(request *management.CreateServiceAccountRequest)
+
+// WithServiceAccountCluster restricts the service account to the resources of the given cluster.
+func WithServiceAccountCluster(cluster string) CreateServiceAccountOption {
+	return func(request *management.CreateServiceAccountRequest) {
+		request.Cluster = cluster
+	}
+}
+
+// LogsOption is a functional option for LogsReader.
+type LogsOption func(request *management.MachineLogsRequest)
+
+// WithLogsSince skips the log lines written before the given time.
+func WithLogsSince(since time.Time) LogsOption {
+	return func(request *management.MachineLogsRequest) {
+		request.Since = timestamppb.New(since)
+	}
+}
+
+// WithLogsUntil stops reading the logs at the first line written after the given time.
+func WithLogsUntil(until time.Time) LogsOption {
+	return func(request *management.MachineLogsRequest) {
+		request.Until = timestamppb.New(until)
+	}
+}
+
 // Client for Management API .
 type Client struct {
 	conn management.ManagementServiceClient
@@ -111,12 +139,18 @@ func (client *Client) Omniconfig(ctx context.Context) ([]byte, error) {
 }
 
 // LogsReader returns the io.Reader for the logs with each message separated by '\n'.
-func (client *Client) LogsReader(ctx context.Context, machineID string, follow bool, tailLines int32) (io.Reader, error) {
-	logStream, err := client.conn.MachineLogs(ctx, &management.MachineLogsRequest{
+func (client *Client) LogsReader(ctx context.Context, machineID string, follow bool, tailLines int32, opts ...LogsOption) (io.Reader, error) {
+	request := management.MachineLogsRequest{
 		MachineId: machineID,
 		Follow:    follow,
 		TailLines: tailLines,
-	})
+	}
+
+	for _, opt := range opts {
+		opt(&request)
+	}
+
+	logStream, err := client.conn.MachineLogs(ctx, &request)
 	if err != nil {
 		return nil, err
 	}
@@ -138,12 +172,18 @@ func (client *Client) CreateSchematic(ctx context.Context, req *management.Creat
 }
 
 // CreateServiceAccount creates a service account and returns the public key ID.
-func (client *Client) CreateServiceAccount(ctx context.Context, armoredPGPPublicKey string, role string, useUserRole bool) (string, error) {
-	resp, err := client.conn.CreateServiceAccount(ctx, &management.CreateServiceAccountRequest{
+func (client *Client) CreateServiceAccount(ctx context.Context, armoredPGPPublicKey string, role string, useUserRole bool, opts ...CreateServiceAccountOption) (string, error) {
+	req := &management.CreateServiceAccountRequest{
 		ArmoredPgpPublicKey: armoredPGPPublicKey,
 		Role:                role,
 		UseUserRole:         useUserRole,
-	})
+	}
+
+	for _, opt := range opts {
+		opt(req)
+	}
+
+	resp, err := client.conn.CreateServiceAccount(ctx, req)
 	if err != nil {
 		return "", err
 	}
@@ -183,6 +223,71 @@ func (client *Client) DestroyServiceAccount(ctx context.Context, name string) er
 	return err
 }
 
+// ListUserSessions lists the sessions (registered public keys) of the given user identity.
+// Sessions of all users are returned if the identity is empty.
+func (client *Client) ListUserSessions(ctx context.Context, identity string) ([]*management.ListUserSessionsResponse_Session, error) {
+	response, err := client.conn.ListUserSessions(ctx, &management.ListUserSessionsRequest{
+		Identity: identity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response.GetSessions(), nil
+}
+
+// RevokeUserSession revokes the session with the given public key ID.
+func (client *Client) RevokeUserSession(ctx context.Context, publicKeyID string) error {
+	_, err := client.conn.RevokeUserSession(ctx, &management.RevokeUserSessionRequest{
+		PublicKeyId: publicKeyID,
+	})
+
+	return err
+}
+
+// RevokeUserSessions revokes all sessions of the given user identity and returns the revoked public key IDs.
+func (client *Client) RevokeUserSessions(ctx context.Context, identity string) ([]string, error) {
+	response, err := client.conn.RevokeUserSession(ctx, &management.RevokeUserSessionRequest{
+		Identity: identity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response.GetRevokedPublicKeyIds(), nil
+}
+
+// MoveMachine requests moving the worker machine to the machine set of another cluster.
+// The workers machine set of the target cluster is used if the machine set is empty.
+//
+// The progress of the move is reported in the MachineMoveStatus resource.
+func (client *Client) MoveMachine(ctx context.Context, machineID, targetCluster, targetMachineSet string) error {
+	_, err := client.conn.MoveMachine(ctx, &management.MoveMachineRequest{
+		MachineId:        machineID,
+		TargetCluster:    targetCluster,
+		TargetMachineSet: targetMachineSet,
+	})
+
+	return err
+}
+
+// GetCapabilities returns the optional features enabled on the server, the API limits and the deprecation notices.
+func (client *Client) GetCapabilities(ctx context.Context) (*management.GetCapabilitiesResponse, error) {
+	return client.conn.GetCapabilities(ctx, &emptypb.Empty{})
+}
+
+// GetPayloadSamples returns the recorded payloads of the sampled gRPC calls, optionally filtered by the full method name.
+func (client *Client) GetPayloadSamples(ctx context.Context, method string) ([]*management.PayloadSample, error) {
+	resp, err := client.conn.GetPayloadSamples(ctx, &management.GetPayloadSamplesRequest{
+		Method: method,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Samples, nil
+}
+
 // GetSupportBundle generates support bundle on Omni server and returns it to the client.
 func (client *Client) GetSupportBundle(ctx context.Context, cluster string, progress chan *management.GetSupportBundleResponse_Progress) ([]byte, error) {
 	if progress != nil {
@@ -214,6 +319,34 @@ func (client *Client) GetSupportBundle(ctx context.Context, cluster string, prog
 	}
 }
 
+// WatchUpgradeProgress streams the progress of the Talos and Kubernetes upgrades of the cluster.
+//
+// The callback is called with the current progress, then each time it changes.
+// The watch stops when the callback returns an error, which is returned, or when the context is canceled.
+func (client *Client) WatchUpgradeProgress(ctx context.Context, cluster string, callback func(*management.WatchUpgradeProgressResponse) error) error {
+	serv, err := client.conn.WatchUpgradeProgress(ctx, &management.WatchUpgradeProgressRequest{
+		Cluster: cluster,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		msg, err := serv.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return err
+		}
+
+		if err = callback(msg); err != nil {
+			return err
+		}
+	}
+}
+
 // LogReader is a log client reader which implements io.Reader.
 type LogReader struct {
 	ctx    context.Context //nolint:containedctx
@@ -366,3 +499,171 @@ func (client *ClusterClient) KubernetesSyncManifests(ctx context.Context, dryRun
 		}
 	}
 }
+
+// GetClusterAvailability returns the uptime percentages of the cluster over the given number of the latest days, all retained days are used if days is zero.
+func (client *Client) GetClusterAvailability(ctx context.Context, cluster string, days uint32) (*management.GetClusterAvailabilityResponse, error) {
+	return client.conn.GetClusterAvailability(ctx, &management.GetClusterAvailabilityRequest{
+		Cluster: cluster,
+		Days:    days,
+	})
+}
+
+// GetClusterStatusHistory returns the time series of the key cluster status fields for the given range.
+func (client *Client) GetClusterStatusHistory(ctx context.Context, cluster string, historyRange management.GetClusterStatusHistoryRequest_Range) ([]*management.GetClusterStatusHistoryResponse_Point, error) {
+	resp, err := client.conn.GetClusterStatusHistory(ctx, &management.GetClusterStatusHistoryRequest{
+		Cluster: cluster,
+		Range:   historyRange,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Points, nil
+}
+
+// ValidateConfigPatch applies the config patch to the current machine config of the cluster machine or of each machine of the machine set,
+// and returns the diffs and the validation results without persisting anything.
+func (client *Client) ValidateConfigPatch(ctx context.Context, data, machine, machineSet string) ([]*management.ValidateConfigPatchResponse_Result, error) {
+	resp, err := client.conn.ValidateConfigPatch(ctx, &management.ValidateConfigPatchRequest{
+		Data:       data,
+		Machine:    machine,
+		MachineSet: machineSet,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Results, nil
+}
+
+// ValidateResource runs the access checks and the validations of applying the resource on the server,
+// and returns whether the resource exists and the changed fields without persisting anything.
+func (client *Client) ValidateResource(ctx context.Context, resourceYAML string) (*management.ValidateResourceResponse, error) {
+	return client.conn.ValidateResource(ctx, &management.ValidateResourceRequest{
+		Resource: resourceYAML,
+	})
+}
+
+// GetMachineUserData returns the partial machine config which connects the machine to Omni, to be used as the cloud machine user-data.
+func (client *Client) GetMachineUserData(ctx context.Context, talosVersion string) (string, error) {
+	resp, err := client.conn.GetMachineUserData(ctx, &management.GetMachineUserDataRequest{
+		TalosVersion: talosVersion,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return resp.UserData, nil
+}
+
+// GetJoinArtifacts returns the kernel arguments, the ISO URL and the partial machine config which connect the machine to Omni with the join token.
+func (client *Client) GetJoinArtifacts(ctx context.Context, req *management.GetJoinArtifactsRequest) (*management.GetJoinArtifactsResponse, error) {
+	return client.conn.GetJoinArtifacts(ctx, req)
+}
+
+// GetConfigReloadStatus returns the result of the latest reload of the Omni config file.
+func (client *Client) GetConfigReloadStatus(ctx context.Context) (*management.GetConfigReloadStatusResponse, error) {
+	return client.conn.GetConfigReloadStatus(ctx, &emptypb.Empty{})
+}
+
+// CreateBreakGlassToken grants the break-glass access to the public key of the request.
+//
+// The request doesn't need to be authenticated, it is authorized by the break-glass secret and the current TOTP code.
+func (client *Client) CreateBreakGlassToken(ctx context.Context, req *management.CreateBreakGlassTokenRequest) (*management.CreateBreakGlassTokenResponse, error) {
+	return client.conn.CreateBreakGlassToken(ctx, req)
+}
+
+// UpdateMachineLabels sets and removes the labels of all machines matching any of the selectors and returns the result of each machine.
+func (client *Client) UpdateMachineLabels(ctx context.Context, selectors []string, set map[string]string, remove []string) ([]*management.UpdateMachineLabelsResponse_Result, error) {
+	resp, err := client.conn.UpdateMachineLabels(ctx, &management.UpdateMachineLabelsRequest{
+		Selectors: selectors,
+		Set:       set,
+		Remove:    remove,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Results, nil
+}
+
+// BackupState writes the backup archive of the user-owned resources of the Omni state to w.
+func (client *Client) BackupState(ctx context.Context, w io.Writer) error {
+	serv, err := client.conn.BackupState(ctx, &management.BackupStateRequest{})
+	if err != nil {
+		return err
+	}
+
+	for {
+		msg, err := serv.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return err
+		}
+
+		if _, err = w.Write(msg.Data); err != nil {
+			return err
+		}
+	}
+}
+
+// restoreChunkSize is the size of the chunks the backup archive is streamed in.
+const restoreChunkSize = 1024 * 1024
+
+// RestoreState restores the resources of the backup archive created by BackupState.
+//
+// The archive is streamed to the server in chunks, the outcome of restoring each resource is returned.
+func (client *Client) RestoreState(
+	ctx context.Context,
+	archive io.Reader,
+	conflictResolution management.RestoreStateRequest_ConflictResolution,
+	dryRun bool,
+) ([]*management.RestoreStateResponse_Result, error) {
+	serv, err := client.conn.RestoreState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &management.RestoreStateRequest{
+		ConflictResolution: conflictResolution,
+		DryRun:             dryRun,
+	}
+
+	buf := make([]byte, restoreChunkSize)
+
+	for {
+		n, readErr := io.ReadFull(archive, buf)
+		if n > 0 {
+			req.Data = buf[:n]
+
+			// the server closed the stream, the error is returned by CloseAndRecv
+			if err = serv.Send(req); err != nil && !errors.Is(err, io.EOF) {
+				return nil, err
+			}
+
+			if err != nil {
+				break
+			}
+
+			req = &management.RestoreStateRequest{}
+		}
+
+		if errors.Is(readErr, io.EOF) || errors.Is(readErr, io.ErrUnexpectedEOF) {
+			break
+		}
+
+		if readErr != nil {
+			return nil, readErr
+		}
+	}
+
+	resp, err := serv.CloseAndRecv()
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Results, nil
+}
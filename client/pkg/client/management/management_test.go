@@ -0,0 +1,120 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package management_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	managementpb "github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/client/management"
+)
+
+type managementServer struct {
+	managementpb.UnimplementedManagementServiceServer
+
+	requests chan *managementpb.MachineLogsRequest
+}
+
+func (s *managementServer) MachineLogs(req *managementpb.MachineLogsRequest, srv managementpb.ManagementService_MachineLogsServer) error {
+	s.requests <- req
+
+	return srv.Send(&common.Data{Bytes: []byte("log of " + req.MachineId)})
+}
+
+func setupClient(t *testing.T) (*management.Client, *managementServer) {
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+	srv := &managementServer{requests: make(chan *managementpb.MachineLogsRequest, 1)}
+
+	grpcServer := grpc.NewServer()
+	managementpb.RegisterManagementServiceServer(grpcServer, srv)
+
+	go grpcServer.Serve(listener) //nolint:errcheck
+
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { conn.Close() }) //nolint:errcheck
+
+	return management.NewClient(conn), srv
+}
+
+func TestLogsReader(t *testing.T) {
+	t.Parallel()
+
+	since := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	until := since.Add(time.Hour)
+
+	for _, tt := range []struct {
+		name          string
+		opts          []management.LogsOption
+		expectedSince time.Time
+		expectedUntil time.Time
+	}{
+		{
+			name: "no time range",
+		},
+		{
+			name:          "since",
+			opts:          []management.LogsOption{management.WithLogsSince(since)},
+			expectedSince: since,
+		},
+		{
+			name:          "since and until",
+			opts:          []management.LogsOption{management.WithLogsSince(since), management.WithLogsUntil(until)},
+			expectedSince: since,
+			expectedUntil: until,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			t.Cleanup(cancel)
+
+			client, srv := setupClient(t)
+
+			reader, err := client.LogsReader(ctx, "m1", false, 10, tt.opts...)
+			require.NoError(t, err)
+
+			logs, err := io.ReadAll(reader)
+			require.NoError(t, err)
+
+			assert.Equal(t, "log of m1\n", string(logs))
+
+			req := <-srv.requests
+
+			assert.Equal(t, "m1", req.MachineId)
+			assert.EqualValues(t, 10, req.TailLines)
+			assert.Equal(t, tt.expectedSince.IsZero(), req.Since == nil)
+			assert.Equal(t, tt.expectedUntil.IsZero(), req.Until == nil)
+
+			if req.Since != nil {
+				assert.True(t, tt.expectedSince.Equal(req.Since.AsTime()))
+			}
+
+			if req.Until != nil {
+				assert.True(t, tt.expectedUntil.Equal(req.Until.AsTime()))
+			}
+		})
+	}
+}
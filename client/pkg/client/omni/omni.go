@@ -6,10 +6,18 @@
 package omni
 
 import (
+	"context"
+	"errors"
+	"strconv"
+
 	"github.com/cosi-project/runtime/api/v1alpha1"
+	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/cosi-project/runtime/pkg/state/protobuf/client"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/siderolabs/omni/client/pkg/constants"
 
 	_ "github.com/siderolabs/omni/client/pkg/omni/resources/auth" // import resources to register protobufs
 	_ "github.com/siderolabs/omni/client/pkg/omni/resources/k8s"
@@ -36,3 +44,49 @@ func NewClient(conn *grpc.ClientConn) *Client {
 func (client *Client) State() state.State { //nolint:ireturn
 	return client.state
 }
+
+// ListPages lists the resources of the kind page by page ordered by ID, calling f for each page of at most pageSize resources.
+//
+// Unlike the State().List, it doesn't load all resources into memory at once.
+// The servers which don't support the pagination return all resources in a single page.
+func (client *Client) ListPages(ctx context.Context, kind resource.Kind, pageSize int, f func(resource.List) error, opts ...state.ListOption) error {
+	if pageSize <= 0 {
+		return errors.New("page size must be positive")
+	}
+
+	var continueID resource.ID
+
+	for {
+		pageCtx := metadata.AppendToOutgoingContext(ctx, constants.ListLimitHeader, strconv.Itoa(pageSize))
+
+		if continueID != "" {
+			pageCtx = metadata.AppendToOutgoingContext(pageCtx, constants.ListContinueHeader, continueID)
+		}
+
+		page, err := client.state.List(pageCtx, kind, opts...)
+		if err != nil {
+			return err
+		}
+
+		// the server ignored the pagination and returned all resources
+		if len(page.Items) > pageSize || (continueID != "" && len(page.Items) > 0 && page.Items[0].Metadata().ID() <= continueID) {
+			if continueID != "" {
+				return nil
+			}
+
+			return f(page)
+		}
+
+		if len(page.Items) > 0 {
+			if err = f(page); err != nil {
+				return err
+			}
+		}
+
+		if len(page.Items) < pageSize {
+			return nil
+		}
+
+		continueID = page.Items[len(page.Items)-1].Metadata().ID()
+	}
+}
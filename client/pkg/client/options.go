@@ -6,19 +6,35 @@
 package client
 
 import (
+	"context"
+	"fmt"
+	"os"
+
+	authcli "github.com/siderolabs/go-api-signature/pkg/client/auth"
 	"github.com/siderolabs/go-api-signature/pkg/client/interceptor"
+	"github.com/siderolabs/go-api-signature/pkg/message"
 	"github.com/siderolabs/go-api-signature/pkg/pgp/client"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
+	"github.com/siderolabs/omni/client/pkg/client/hardwarekey"
+	"github.com/siderolabs/omni/client/pkg/constants"
 	"github.com/siderolabs/omni/client/pkg/version"
 )
 
+// userKeysDir is the directory where the user PGP keys are stored.
+const userKeysDir = "omni/keys"
+
 // Options is the options for the client.
 type Options struct {
 	AuthInterceptor *interceptor.Interceptor
 
 	AdditionalGRPCDialOptions []grpc.DialOption
 
+	reauthInterceptor *reauthInterceptor
+
+	deprecationHandler DeprecationHandler
+
 	InsecureSkipTLSVerify bool
 }
 
@@ -36,6 +52,7 @@ func WithInsecureSkipTLSVerify(insecureSkipTLSVerify bool) Option {
 func WithServiceAccount(serviceAccountBase64 string) Option {
 	return func(options *Options) {
 		options.AuthInterceptor = signatureAuthInterceptor("", "", serviceAccountBase64)
+		options.reauthInterceptor = nil
 	}
 }
 
@@ -43,12 +60,68 @@ func WithServiceAccount(serviceAccountBase64 string) Option {
 func WithUserAccount(contextName, identity string) Option {
 	return func(options *Options) {
 		options.AuthInterceptor = signatureAuthInterceptor(contextName, identity, "")
+		options.reauthInterceptor = &reauthInterceptor{
+			keyProvider: client.NewKeyProvider(userKeysDir),
+			contextName: contextName,
+			identity:    identity,
+		}
+	}
+}
+
+// WithHardwareKey is used for accessing Omni by a human with the signing key stored on a hardware token.
+//
+// The keyID is the ID of the gpg key residing on the token, see hardwarekey package for details.
+// The key is registered for the identity on the first use and on the expiration, which requires confirming it in the browser.
+func WithHardwareKey(contextName, identity, keyID string) Option {
+	return func(options *Options) {
+		getKey := func(ctx context.Context, _ *grpc.ClientConn, _ *interceptor.Options) (message.Signer, error) {
+			return hardwarekey.New(ctx, keyID)
+		}
+
+		renewKey := func(ctx context.Context, cc *grpc.ClientConn, _ *interceptor.Options) (message.Signer, error) {
+			key, err := hardwarekey.New(ctx, keyID)
+			if err != nil {
+				return nil, err
+			}
+
+			armoredPublicKey, err := key.ArmorPublic()
+			if err != nil {
+				return nil, err
+			}
+
+			authCli := authcli.NewClient(cc)
+
+			// the server allows the long lifetime only for the keys registered as stored on a hardware token
+			registerCtx := metadata.AppendToOutgoingContext(ctx, constants.HardwareKeyHeader, "true")
+
+			loginURL, err := authCli.RegisterPGPPublicKey(registerCtx, identity, []byte(armoredPublicKey))
+			if err != nil {
+				return nil, fmt.Errorf("failed to register the hardware key: %w", err)
+			}
+
+			fmt.Fprintf(os.Stderr, "Please visit the following URL to confirm the hardware key %s:\n\n%s\n\n", key.Fingerprint(), loginURL) //nolint:errcheck
+
+			if err = authCli.AwaitPublicKeyConfirmation(ctx, key.Fingerprint()); err != nil {
+				return nil, fmt.Errorf("failed to wait for the hardware key confirmation: %w", err)
+			}
+
+			return key, nil
+		}
+
+		options.AuthInterceptor = interceptor.New(interceptor.Options{
+			GetUserKeyFunc:   getKey,
+			RenewUserKeyFunc: renewKey,
+			ContextName:      contextName,
+			Identity:         identity,
+			ClientName:       version.Name + " " + version.Tag,
+		})
+		options.reauthInterceptor = nil
 	}
 }
 
 func signatureAuthInterceptor(contextName, identity, serviceAccountBase64 string) *interceptor.Interceptor {
 	return interceptor.New(interceptor.Options{
-		UserKeyProvider:      client.NewKeyProvider("omni/keys"),
+		UserKeyProvider:      client.NewKeyProvider(userKeysDir),
 		ContextName:          contextName,
 		Identity:             identity,
 		ClientName:           version.Name + " " + version.Tag,
@@ -56,6 +129,13 @@ func signatureAuthInterceptor(contextName, identity, serviceAccountBase64 string
 	})
 }
 
+// WithDeprecationHandler sets the handler called when the server reports that the called method is deprecated.
+func WithDeprecationHandler(handler DeprecationHandler) Option {
+	return func(options *Options) {
+		options.deprecationHandler = handler
+	}
+}
+
 // WithGrpcOpts adds additional gRPC dial options to the client.
 func WithGrpcOpts(opts ...grpc.DialOption) Option {
 	return func(options *Options) {
@@ -0,0 +1,24 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/siderolabs/omni/client/pkg/constants"
+)
+
+// WithDestroyReason returns the context which attaches the reason to the resource deletions made with it.
+//
+// The reason is recorded by the server, and it might be required by the server policy for some resource types.
+func WithDestroyReason(ctx context.Context, reason string) context.Context {
+	if reason == "" {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, constants.DestroyReasonHeader, reason)
+}
@@ -0,0 +1,156 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client
+
+import (
+	"context"
+
+	"github.com/siderolabs/go-api-signature/pkg/pgp/client"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/pkg/constants"
+)
+
+// reauthInterceptor re-runs the authentication flow when the server rejects the user key as expired.
+//
+// The key might be still valid locally while being expired on the server side,
+// as the server can limit the key lifetime depending on the user role.
+type reauthInterceptor struct {
+	keyProvider *client.KeyProvider
+	contextName string
+	identity    string
+}
+
+// isExpiredKeyError returns true if the server rejected the request because the public key is expired.
+//
+// Other authentication errors (e.g. an invalid signature) don't mean the local key is unusable, so the key is kept.
+func isExpiredKeyError(err error) bool {
+	st, ok := status.FromError(err)
+
+	return ok && st.Code() == codes.Unauthenticated && st.Message() == constants.ExpiredPublicKeyMessage
+}
+
+// invalidateKey drops the stored key if the error reports it as expired.
+//
+// It returns true if the call should be retried.
+func (i *reauthInterceptor) invalidateKey(err error) bool {
+	if !isExpiredKeyError(err) {
+		return false
+	}
+
+	return i.keyProvider.DeleteKey(i.contextName, i.identity) == nil
+}
+
+// Unary returns a unary client interceptor which drops the expired key and retries the call once.
+//
+// The retried call goes through the signature interceptor, which generates a new key and runs the authentication flow.
+func (i *reauthInterceptor) Unary() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if !i.invalidateKey(err) {
+			return err
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// Stream returns a stream client interceptor which drops the expired key and re-opens the stream once.
+//
+// The server rejects the stream before sending any message, so the error is observed either on the stream creation,
+// or on the first received message. In the latter case, the stream is re-opened and the request is sent again.
+// Client streams can't be replayed, so they are only re-opened if the stream creation fails.
+func (i *reauthInterceptor) Stream() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		open := func() (grpc.ClientStream, error) {
+			return streamer(ctx, desc, cc, method, opts...)
+		}
+
+		stream, err := open()
+		if err != nil {
+			if !i.invalidateKey(err) {
+				return nil, err
+			}
+
+			return open()
+		}
+
+		if desc.ClientStreams {
+			return stream, nil
+		}
+
+		return &reauthClientStream{
+			ClientStream: stream,
+			interceptor:  i,
+			open:         open,
+		}, nil
+	}
+}
+
+// reauthClientStream re-opens the server stream if the first received message reports the key as expired.
+type reauthClientStream struct {
+	grpc.ClientStream
+
+	interceptor *reauthInterceptor
+	open        func() (grpc.ClientStream, error)
+	request     any
+
+	closeSent bool
+	received  bool
+}
+
+// SendMsg implements grpc.ClientStream.
+func (s *reauthClientStream) SendMsg(m any) error {
+	s.request = m
+
+	return s.ClientStream.SendMsg(m)
+}
+
+// CloseSend implements grpc.ClientStream.
+func (s *reauthClientStream) CloseSend() error {
+	s.closeSent = true
+
+	return s.ClientStream.CloseSend()
+}
+
+// RecvMsg implements grpc.ClientStream.
+func (s *reauthClientStream) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err == nil || s.received {
+		s.received = true
+
+		return err
+	}
+
+	// retry only once
+	s.received = true
+
+	if !s.interceptor.invalidateKey(err) {
+		return err
+	}
+
+	stream, openErr := s.open()
+	if openErr != nil {
+		return openErr
+	}
+
+	s.ClientStream = stream
+
+	if s.request != nil {
+		if err = stream.SendMsg(s.request); err != nil {
+			return err
+		}
+	}
+
+	if s.closeSent {
+		if err = stream.CloseSend(); err != nil {
+			return err
+		}
+	}
+
+	return stream.RecvMsg(m)
+}
@@ -0,0 +1,55 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	pgpcrypto "github.com/ProtonMail/gopenpgp/v2/crypto"
+	"github.com/siderolabs/go-api-signature/pkg/pgp/client"
+)
+
+// UserKey describes the user account key stored locally by [WithUserAccount].
+type UserKey struct {
+	Expiration  time.Time
+	Fingerprint string
+}
+
+// ReadUserKey reads the valid user account key stored for the context and the identity.
+//
+// An error is returned if there is no key or the key has expired.
+func ReadUserKey(contextName, identity string) (*UserKey, error) {
+	key, err := client.NewKeyProvider(userKeysDir).ReadValidKey(contextName, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	armoredPublicKey, err := key.ArmorPublic()
+	if err != nil {
+		return nil, err
+	}
+
+	pgpKey, err := pgpcrypto.NewKeyFromArmored(armoredPublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the user key: %w", err)
+	}
+
+	lifetimeSecs := pgpKey.GetEntity().PrimaryIdentity().SelfSignature.KeyLifetimeSecs
+	if lifetimeSecs == nil {
+		return nil, errors.New("user key has no expiration")
+	}
+
+	return &UserKey{
+		Fingerprint: key.Fingerprint(),
+		Expiration:  pgpKey.GetEntity().PrimaryKey.CreationTime.Add(time.Duration(*lifetimeSecs) * time.Second),
+	}, nil
+}
+
+// DeleteUserKey removes the user account key stored for the context and the identity.
+func DeleteUserKey(contextName, identity string) error {
+	return client.NewKeyProvider(userKeysDir).DeleteKey(contextName, identity)
+}
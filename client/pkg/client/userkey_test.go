@@ -0,0 +1,60 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client_test
+
+import (
+	"io/fs"
+	"testing"
+	"time"
+
+	"github.com/adrg/xdg"
+	pgpclient "github.com/siderolabs/go-api-signature/pkg/pgp/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/pkg/client"
+)
+
+// TestUserKey changes the XDG data directory, so it doesn't run in parallel.
+func TestUserKey(t *testing.T) {
+	// reload the directories once the environment is restored
+	t.Cleanup(xdg.Reload)
+
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	xdg.Reload()
+
+	const (
+		contextName = "default"
+		identity    = "user@example.org"
+	)
+
+	_, err := client.ReadUserKey(contextName, identity)
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+
+	provider := pgpclient.NewKeyProvider("omni/keys")
+
+	key, err := provider.GenerateKey(contextName, identity, "omnictl")
+	require.NoError(t, err)
+
+	_, err = provider.WriteKey(key)
+	require.NoError(t, err)
+
+	userKey, err := client.ReadUserKey(contextName, identity)
+	require.NoError(t, err)
+
+	assert.Equal(t, key.Fingerprint(), userKey.Fingerprint)
+	assert.WithinDuration(t, time.Now().Add(4*time.Hour), userKey.Expiration, time.Minute)
+
+	// the keys of the other contexts are not affected
+	_, err = client.ReadUserKey("other", identity)
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+
+	require.NoError(t, client.DeleteUserKey(contextName, identity))
+
+	_, err = client.ReadUserKey(contextName, identity)
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+
+	assert.ErrorIs(t, client.DeleteUserKey(contextName, identity), fs.ErrNotExist)
+}
@@ -52,6 +52,40 @@ const (
 // GRPCMaxMessageSize is the maximum message size for gRPC server.
 const GRPCMaxMessageSize = 32 * 1024 * 1024
 
+const (
+	// APIVersionHeader is the gRPC metadata key the clients use to report the API version they were built for.
+	//
+	// The server serves the clients using an older API version with the help of the compatibility shims.
+	APIVersionHeader = "omni-api-version"
+
+	// DeprecationHeader is the gRPC response header set by the server when the called method is deprecated.
+	DeprecationHeader = "omni-deprecation"
+
+	// DestroyReasonHeader is the gRPC metadata key which carries the reason of the resource deletion.
+	//
+	// The server records the reason, and can be configured to reject the deletion of some resource types without it.
+	// It's a binary header, so that the reason isn't limited to the printable ASCII characters.
+	DestroyReasonHeader = "omni-destroy-reason-bin"
+
+	// ListLimitHeader is the gRPC metadata key which limits the number of the resources returned by the COSI state List call.
+	ListLimitHeader = "omni-list-limit"
+
+	// ListContinueHeader is the gRPC metadata key which makes the COSI state List call return the resources with the IDs after the given one.
+	//
+	// The resources are ordered by ID, so the ID of the last resource of the page continues the listing from the next page.
+	ListContinueHeader = "omni-list-continue"
+
+	// HardwareKeyHeader is the gRPC metadata key set by the clients registering a public key stored on a hardware token.
+	//
+	// Only such keys can have the long lifetime, if the hardware keys are enabled on the server.
+	HardwareKeyHeader = "omni-hardware-key"
+)
+
+// ExpiredPublicKeyMessage is the message of the Unauthenticated gRPC error returned when the request is signed with an expired public key.
+//
+// The clients authenticating with the user keys drop the local key and re-authenticate when they receive it.
+const ExpiredPublicKeyMessage = "public key expired"
+
 // DisableValidation force disable resource validation on the Omni runtime for a particular resource (only for debug build).
 const DisableValidation = "disable-validation"
 
@@ -73,3 +107,21 @@ const CertificateValidityTime = time.Hour * 24 * 365 // 1 year
 
 // KubernetesAdminCertCommonName is the common name of the Kubernetes admin certificate.
 const KubernetesAdminCertCommonName = "omni:admin"
+
+// Optional features reported by the server in the capabilities.
+const (
+	// FeatureWorkloadProxying is enabled when the workload service proxying is enabled.
+	FeatureWorkloadProxying = "workload-proxying"
+	// FeatureEmbeddedDiscoveryService is enabled when the embedded discovery service is running.
+	FeatureEmbeddedDiscoveryService = "embedded-discovery-service"
+	// FeatureEtcdBackupS3 is enabled when the cluster etcd backups are stored in S3.
+	FeatureEtcdBackupS3 = "etcd-backup-s3"
+	// FeatureEtcdBackupLocal is enabled when the cluster etcd backups are stored in the local directory.
+	FeatureEtcdBackupLocal = "etcd-backup-local"
+	// FeatureBreakGlassConfigs is enabled when the admin Talos and Kubernetes configs can be downloaded.
+	FeatureBreakGlassConfigs = "break-glass-configs"
+	// FeatureTalosPreReleaseVersions is enabled when Talos pre-release versions are available.
+	FeatureTalosPreReleaseVersions = "talos-pre-release-versions"
+	// FeatureGitOps is enabled when the cluster templates are synced from a Git repository.
+	FeatureGitOps = "gitops"
+)
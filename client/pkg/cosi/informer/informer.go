@@ -0,0 +1,398 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package informer implements the client-side cache of the resources kept up to date by the watch.
+package informer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller/generic"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+)
+
+// IndexFunc returns the index values of the resource.
+type IndexFunc[T generic.ResourceWithRD] func(res T) []string
+
+// EventHandler is notified about the changes of the cached resources.
+//
+// Any of the functions can be nil.
+type EventHandler[T generic.ResourceWithRD] struct {
+	// OnAdd is called when the resource is added to the cache.
+	OnAdd func(res T)
+	// OnUpdate is called when the cached resource is updated, and for every cached resource on each resync.
+	OnUpdate func(oldRes, newRes T)
+	// OnDelete is called when the resource is removed from the cache.
+	OnDelete func(res T)
+}
+
+// Informer keeps the local cache of the resources of a single type.
+//
+// The informer lists and watches the resources, keeps them in the indexed store
+// and calls the event handlers on each change. If the watch fails, the informer re-establishes it
+// and reconciles the cache with the fresh list of the resources.
+type Informer[T generic.ResourceWithRD] struct {
+	st       state.State
+	items    map[resource.ID]T
+	indexers map[string]IndexFunc[T]
+	indices  map[string]map[string]map[resource.ID]struct{}
+	synced   chan struct{}
+	handlers []EventHandler[T]
+	options  Options
+
+	mu      sync.RWMutex
+	running bool
+}
+
+// New creates an informer for the resources of type T.
+func New[T generic.ResourceWithRD](st state.State, opts ...Option) *Informer[T] {
+	var zero T
+
+	options := Options{
+		Namespace:    zero.ResourceDefinition().DefaultNamespace,
+		RetryBackoff: time.Second,
+	}
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return &Informer[T]{
+		st:       st,
+		options:  options,
+		items:    map[resource.ID]T{},
+		indexers: map[string]IndexFunc[T]{},
+		indices:  map[string]map[string]map[resource.ID]struct{}{},
+		synced:   make(chan struct{}),
+	}
+}
+
+// AddIndex registers the index, it should be called before Run.
+func (inf *Informer[T]) AddIndex(name string, indexFunc IndexFunc[T]) error {
+	inf.mu.Lock()
+	defer inf.mu.Unlock()
+
+	if inf.running {
+		return errors.New("indexes can't be added to the running informer")
+	}
+
+	if _, ok := inf.indexers[name]; ok {
+		return fmt.Errorf("index %q already exists", name)
+	}
+
+	inf.indexers[name] = indexFunc
+	inf.indices[name] = map[string]map[resource.ID]struct{}{}
+
+	return nil
+}
+
+// AddEventHandler registers the event handler, it should be called before Run.
+//
+// The handlers are called sequentially from the informer goroutine, so a slow handler delays the cache updates.
+func (inf *Informer[T]) AddEventHandler(handler EventHandler[T]) error {
+	inf.mu.Lock()
+	defer inf.mu.Unlock()
+
+	if inf.running {
+		return errors.New("event handlers can't be added to the running informer")
+	}
+
+	inf.handlers = append(inf.handlers, handler)
+
+	return nil
+}
+
+// Run watches the resources and keeps the cache up to date until the context is canceled.
+func (inf *Informer[T]) Run(ctx context.Context) error {
+	inf.mu.Lock()
+
+	if inf.running {
+		inf.mu.Unlock()
+
+		return errors.New("informer is already running")
+	}
+
+	inf.running = true
+
+	inf.mu.Unlock()
+
+	var resyncCh <-chan time.Time
+
+	if inf.options.ResyncPeriod > 0 {
+		ticker := time.NewTicker(inf.options.ResyncPeriod)
+		defer ticker.Stop()
+
+		resyncCh = ticker.C
+	}
+
+	for {
+		err := inf.watch(ctx, resyncCh)
+		if ctx.Err() != nil {
+			return nil //nolint:nilerr
+		}
+
+		if inf.options.ErrorHandler != nil {
+			inf.options.ErrorHandler(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(inf.options.RetryBackoff):
+		}
+	}
+}
+
+// watch runs a single watch, it returns when the watch fails or the context is canceled.
+//
+//nolint:gocognit
+func (inf *Informer[T]) watch(ctx context.Context, resyncCh <-chan time.Time) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var zero T
+
+	watchCh := make(chan state.Event)
+
+	opts := []state.WatchKindOption{state.WithBootstrapContents(true)}
+
+	if len(inf.options.LabelQuery) > 0 {
+		opts = append(opts, state.WatchWithLabelQuery(inf.options.LabelQuery...))
+	}
+
+	if err := inf.st.WatchKind(ctx, resource.NewMetadata(inf.options.Namespace, zero.ResourceDefinition().Type, "", resource.VersionUndefined), watchCh, opts...); err != nil {
+		return fmt.Errorf("failed to watch resources: %w", err)
+	}
+
+	// the resources seen during the bootstrap, the cached resources which were not seen are removed once the bootstrap is done
+	seen := map[resource.ID]struct{}{}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-resyncCh:
+			inf.resync()
+		case event := <-watchCh:
+			switch event.Type {
+			case state.Errored:
+				return fmt.Errorf("watch failed: %w", event.Error)
+			case state.Bootstrapped:
+				inf.prune(seen)
+
+				seen = nil
+
+				select {
+				case <-inf.synced:
+				default:
+					close(inf.synced)
+				}
+			case state.Created, state.Updated:
+				res, ok := event.Resource.(T)
+				if !ok {
+					return fmt.Errorf("unexpected resource type %T", event.Resource)
+				}
+
+				if seen != nil {
+					seen[res.Metadata().ID()] = struct{}{}
+				}
+
+				inf.upsert(res)
+			case state.Destroyed:
+				inf.remove(event.Resource.Metadata().ID())
+			}
+		}
+	}
+}
+
+func (inf *Informer[T]) upsert(res T) {
+	inf.mu.Lock()
+
+	old, exists := inf.items[res.Metadata().ID()]
+	if exists {
+		if old.Metadata().Version().Equal(res.Metadata().Version()) && old.Metadata().Phase() == res.Metadata().Phase() {
+			inf.mu.Unlock()
+
+			return
+		}
+
+		inf.unindex(old)
+	}
+
+	inf.items[res.Metadata().ID()] = res
+	inf.index(res)
+
+	inf.mu.Unlock()
+
+	for _, handler := range inf.handlers {
+		switch {
+		case exists && handler.OnUpdate != nil:
+			handler.OnUpdate(old, res)
+		case !exists && handler.OnAdd != nil:
+			handler.OnAdd(res)
+		}
+	}
+}
+
+func (inf *Informer[T]) remove(id resource.ID) {
+	inf.mu.Lock()
+
+	old, exists := inf.items[id]
+	if !exists {
+		inf.mu.Unlock()
+
+		return
+	}
+
+	inf.unindex(old)
+	delete(inf.items, id)
+
+	inf.mu.Unlock()
+
+	for _, handler := range inf.handlers {
+		if handler.OnDelete != nil {
+			handler.OnDelete(old)
+		}
+	}
+}
+
+func (inf *Informer[T]) prune(seen map[resource.ID]struct{}) {
+	inf.mu.RLock()
+
+	var removed []resource.ID
+
+	for id := range inf.items {
+		if _, ok := seen[id]; !ok {
+			removed = append(removed, id)
+		}
+	}
+
+	inf.mu.RUnlock()
+
+	for _, id := range removed {
+		inf.remove(id)
+	}
+}
+
+func (inf *Informer[T]) resync() {
+	for _, res := range inf.List() {
+		for _, handler := range inf.handlers {
+			if handler.OnUpdate != nil {
+				handler.OnUpdate(res, res)
+			}
+		}
+	}
+}
+
+func (inf *Informer[T]) index(res T) {
+	for name, indexFunc := range inf.indexers {
+		for _, value := range indexFunc(res) {
+			ids, ok := inf.indices[name][value]
+			if !ok {
+				ids = map[resource.ID]struct{}{}
+				inf.indices[name][value] = ids
+			}
+
+			ids[res.Metadata().ID()] = struct{}{}
+		}
+	}
+}
+
+func (inf *Informer[T]) unindex(res T) {
+	for name, indexFunc := range inf.indexers {
+		for _, value := range indexFunc(res) {
+			delete(inf.indices[name][value], res.Metadata().ID())
+
+			if len(inf.indices[name][value]) == 0 {
+				delete(inf.indices[name], value)
+			}
+		}
+	}
+}
+
+// WaitForSync blocks until the initial list of the resources is loaded into the cache.
+func (inf *Informer[T]) WaitForSync(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-inf.synced:
+		return nil
+	}
+}
+
+// HasSynced returns true if the initial list of the resources is loaded into the cache.
+func (inf *Informer[T]) HasSynced() bool {
+	select {
+	case <-inf.synced:
+		return true
+	default:
+		return false
+	}
+}
+
+// Get returns the cached resource by its ID.
+func (inf *Informer[T]) Get(id resource.ID) (T, bool) {
+	inf.mu.RLock()
+	defer inf.mu.RUnlock()
+
+	res, ok := inf.items[id]
+
+	return res, ok
+}
+
+// List returns all cached resources sorted by their IDs.
+func (inf *Informer[T]) List() []T {
+	inf.mu.RLock()
+	defer inf.mu.RUnlock()
+
+	result := make([]T, 0, len(inf.items))
+
+	for _, res := range inf.items {
+		result = append(result, res)
+	}
+
+	sortByID(result)
+
+	return result
+}
+
+// ByIndex returns the cached resources having the value in the index, sorted by their IDs.
+func (inf *Informer[T]) ByIndex(name, value string) ([]T, error) {
+	inf.mu.RLock()
+	defer inf.mu.RUnlock()
+
+	index, ok := inf.indices[name]
+	if !ok {
+		return nil, fmt.Errorf("index %q doesn't exist", name)
+	}
+
+	result := make([]T, 0, len(index[value]))
+
+	for id := range index[value] {
+		result = append(result, inf.items[id])
+	}
+
+	sortByID(result)
+
+	return result, nil
+}
+
+func sortByID[T resource.Resource](items []T) {
+	slices.SortFunc(items, func(a, b T) int {
+		switch {
+		case a.Metadata().ID() < b.Metadata().ID():
+			return -1
+		case a.Metadata().ID() > b.Metadata().ID():
+			return 1
+		default:
+			return 0
+		}
+	})
+}
@@ -0,0 +1,150 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package informer_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/pkg/cosi/informer"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+func newClusterMachine(id, cluster string) *omni.ClusterMachine {
+	res := omni.NewClusterMachine(resources.DefaultNamespace, id)
+	res.Metadata().Labels().Set(omni.LabelCluster, cluster)
+
+	return res
+}
+
+func TestInformer(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	require.NoError(t, st.Create(ctx, newClusterMachine("m1", "c1")))
+	require.NoError(t, st.Create(ctx, newClusterMachine("m2", "c2")))
+
+	inf := informer.New[*omni.ClusterMachine](st)
+
+	require.NoError(t, inf.AddIndex("cluster", func(res *omni.ClusterMachine) []string {
+		cluster, ok := res.Metadata().Labels().Get(omni.LabelCluster)
+		if !ok {
+			return nil
+		}
+
+		return []string{cluster}
+	}))
+
+	var (
+		mu                      sync.Mutex
+		added, updated, deleted []resource.ID
+	)
+
+	require.NoError(t, inf.AddEventHandler(informer.EventHandler[*omni.ClusterMachine]{
+		OnAdd: func(res *omni.ClusterMachine) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			added = append(added, res.Metadata().ID())
+		},
+		OnUpdate: func(_, newRes *omni.ClusterMachine) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			updated = append(updated, newRes.Metadata().ID())
+		},
+		OnDelete: func(res *omni.ClusterMachine) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			deleted = append(deleted, res.Metadata().ID())
+		},
+	}))
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		assert.NoError(t, inf.Run(ctx))
+	}()
+
+	require.NoError(t, inf.WaitForSync(ctx))
+	assert.True(t, inf.HasSynced())
+
+	ids := func(items []*omni.ClusterMachine) []resource.ID {
+		result := make([]resource.ID, 0, len(items))
+
+		for _, item := range items {
+			result = append(result, item.Metadata().ID())
+		}
+
+		return result
+	}
+
+	assert.Equal(t, []resource.ID{"m1", "m2"}, ids(inf.List()))
+
+	require.NoError(t, st.Create(ctx, newClusterMachine("m3", "c1")))
+
+	_, err := st.UpdateWithConflicts(ctx, newClusterMachine("m2", "").Metadata(), func(res resource.Resource) error {
+		res.Metadata().Labels().Set(omni.LabelCluster, "c1")
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, st.Destroy(ctx, newClusterMachine("m1", "").Metadata()))
+
+	assert.EventuallyWithT(t, func(collect *assert.CollectT) {
+		machines, err := inf.ByIndex("cluster", "c1")
+		if !assert.NoError(collect, err) {
+			return
+		}
+
+		assert.Equal(collect, []resource.ID{"m2", "m3"}, ids(machines))
+	}, 5*time.Second, 50*time.Millisecond)
+
+	machines, err := inf.ByIndex("cluster", "c2")
+	require.NoError(t, err)
+	assert.Empty(t, machines)
+
+	_, ok := inf.Get("m1")
+	assert.False(t, ok)
+
+	res, ok := inf.Get("m2")
+	require.True(t, ok)
+	assert.Equal(t, "m2", res.Metadata().ID())
+
+	_, err = inf.ByIndex("missing", "c1")
+	assert.Error(t, err)
+
+	assert.Error(t, inf.AddIndex("late", func(*omni.ClusterMachine) []string { return nil }))
+
+	cancel()
+	wg.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	assert.Equal(t, []resource.ID{"m1", "m2", "m3"}, added)
+	assert.Equal(t, []resource.ID{"m2"}, updated)
+	assert.Equal(t, []resource.ID{"m1"}, deleted)
+}
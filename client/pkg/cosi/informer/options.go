@@ -0,0 +1,63 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package informer
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+)
+
+// Options configures the informer.
+type Options struct {
+	// ErrorHandler is called when the watch fails, before it's re-established.
+	ErrorHandler func(err error)
+	// Namespace of the resources, defaults to the default namespace of the resource definition.
+	Namespace resource.Namespace
+	// LabelQuery limits the cached resources.
+	LabelQuery []resource.LabelQueryOption
+	// ResyncPeriod is the interval of calling OnUpdate for all cached resources, the resync is disabled if zero.
+	ResyncPeriod time.Duration
+	// RetryBackoff is the delay before re-establishing the failed watch.
+	RetryBackoff time.Duration
+}
+
+// Option configures the informer.
+type Option func(*Options)
+
+// WithNamespace sets the namespace of the resources.
+func WithNamespace(namespace resource.Namespace) Option {
+	return func(o *Options) {
+		o.Namespace = namespace
+	}
+}
+
+// WithLabelQuery limits the cached resources by the label query.
+func WithLabelQuery(query ...resource.LabelQueryOption) Option {
+	return func(o *Options) {
+		o.LabelQuery = append(o.LabelQuery, query...)
+	}
+}
+
+// WithResyncPeriod enables the periodic resync.
+func WithResyncPeriod(period time.Duration) Option {
+	return func(o *Options) {
+		o.ResyncPeriod = period
+	}
+}
+
+// WithRetryBackoff sets the delay before re-establishing the failed watch.
+func WithRetryBackoff(backoff time.Duration) Option {
+	return func(o *Options) {
+		o.RetryBackoff = backoff
+	}
+}
+
+// WithErrorHandler sets the handler of the watch errors.
+func WithErrorHandler(handler func(err error)) Option {
+	return func(o *Options) {
+		o.ErrorHandler = handler
+	}
+}
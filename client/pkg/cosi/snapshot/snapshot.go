@@ -0,0 +1,139 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package snapshot reads the resources of several types as they were at the same point in time.
+//
+// The state doesn't expose the revisions, so the snapshot is read optimistically: all queries are read twice,
+// and the reads are repeated until two consecutive reads return the same versions of the same resources.
+// The resources returned by such a read existed together at the moment between the two reads.
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+)
+
+// DefaultMaxAttempts is the default number of the reads before giving up on the resources which keep changing.
+const DefaultMaxAttempts = 10
+
+// ErrInconsistent is returned when the resources kept changing during all read attempts.
+var ErrInconsistent = errors.New("failed to read a consistent snapshot: the resources keep changing")
+
+// Query selects the resources of a single type read into the snapshot.
+type Query struct {
+	// Kind selects the namespace and the type of the resources, if it is a [resource.Pointer] with the ID, the single resource is read.
+	Kind resource.Kind
+	// ListOptions filter the listed resources.
+	ListOptions []state.ListOption
+}
+
+// Snapshot is the set of the resources read at the same point in time.
+type Snapshot struct {
+	lists []resource.List
+}
+
+// Items returns the resources read by the query with the index i.
+func (s *Snapshot) Items(i int) []resource.Resource {
+	return s.lists[i].Items
+}
+
+// List returns the typed resources read by the query with the index i.
+func List[T resource.Resource](s *Snapshot, i int) safe.List[T] {
+	return safe.NewList[T](s.lists[i])
+}
+
+// Get returns the single resource read by the query with the index i, ok is false if it doesn't exist.
+func Get[T resource.Resource](s *Snapshot, i int) (T, bool) {
+	list := List[T](s, i)
+
+	if list.Len() == 0 {
+		var zero T
+
+		return zero, false
+	}
+
+	return list.Get(0), true
+}
+
+// Read reads the snapshot of the resources selected by the queries.
+func Read(ctx context.Context, st state.CoreState, queries ...Query) (*Snapshot, error) {
+	return ReadWithAttempts(ctx, st, DefaultMaxAttempts, queries...)
+}
+
+// ReadWithAttempts reads the snapshot of the resources selected by the queries with the custom number of the attempts.
+func ReadWithAttempts(ctx context.Context, st state.CoreState, maxAttempts int, queries ...Query) (*Snapshot, error) {
+	previous, err := read(ctx, st, queries)
+	if err != nil {
+		return nil, err
+	}
+
+	for range maxAttempts {
+		var current *Snapshot
+
+		if current, err = read(ctx, st, queries); err != nil {
+			return nil, err
+		}
+
+		if previous.equal(current) {
+			return current, nil
+		}
+
+		previous = current
+	}
+
+	return nil, ErrInconsistent
+}
+
+func read(ctx context.Context, st state.CoreState, queries []Query) (*Snapshot, error) {
+	snap := &Snapshot{
+		lists: make([]resource.List, 0, len(queries)),
+	}
+
+	for _, query := range queries {
+		if ptr, ok := query.Kind.(resource.Pointer); ok && ptr.ID() != "" {
+			res, err := st.Get(ctx, ptr)
+			if err != nil {
+				if state.IsNotFoundError(err) {
+					snap.lists = append(snap.lists, resource.List{})
+
+					continue
+				}
+
+				return nil, fmt.Errorf("failed to get %s: %w", ptr, err)
+			}
+
+			snap.lists = append(snap.lists, resource.List{Items: []resource.Resource{res}})
+
+			continue
+		}
+
+		list, err := st.List(ctx, query.Kind, query.ListOptions...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s: %w", query.Kind.Type(), err)
+		}
+
+		snap.lists = append(snap.lists, list)
+	}
+
+	return snap, nil
+}
+
+// equal checks that both snapshots contain the same versions of the same resources.
+//
+// The creation time is compared as well, as the version starts over when the resource is destroyed and created again.
+func (s *Snapshot) equal(other *Snapshot) bool {
+	return slices.EqualFunc(s.lists, other.lists, func(a, b resource.List) bool {
+		return slices.EqualFunc(a.Items, b.Items, func(resA, resB resource.Resource) bool {
+			return resA.Metadata().ID() == resB.Metadata().ID() &&
+				resA.Metadata().Version().Equal(resB.Metadata().Version()) &&
+				resA.Metadata().Created().Equal(resB.Metadata().Created())
+		})
+	})
+}
@@ -0,0 +1,100 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package snapshot_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/pkg/cosi/snapshot"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+// changingState updates the cluster after each list of the machine sets for the given number of times.
+type changingState struct {
+	state.State
+
+	changes int
+}
+
+func (st *changingState) List(ctx context.Context, kind resource.Kind, opts ...state.ListOption) (resource.List, error) {
+	list, err := st.State.List(ctx, kind, opts...)
+	if err != nil || kind.Type() != omni.MachineSetType || st.changes == 0 {
+		return list, err
+	}
+
+	st.changes--
+
+	_, err = safe.StateUpdateWithConflicts(ctx, st.State, omni.NewCluster(resources.DefaultNamespace, "cluster").Metadata(), func(res *omni.Cluster) error {
+		res.TypedSpec().Value.KubernetesVersion += ".1"
+
+		return nil
+	})
+
+	return list, err
+}
+
+func TestRead(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	t.Cleanup(cancel)
+
+	innerSt := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	cluster := omni.NewCluster(resources.DefaultNamespace, "cluster")
+	cluster.TypedSpec().Value.KubernetesVersion = "1"
+
+	require.NoError(t, innerSt.Create(ctx, cluster))
+
+	for _, id := range []string{"cluster-control-planes", "cluster-workers"} {
+		machineSet := omni.NewMachineSet(resources.DefaultNamespace, id)
+		machineSet.Metadata().Labels().Set(omni.LabelCluster, "cluster")
+
+		require.NoError(t, innerSt.Create(ctx, machineSet))
+	}
+
+	queries := []snapshot.Query{
+		{Kind: omni.NewCluster(resources.DefaultNamespace, "cluster").Metadata()},
+		{Kind: omni.NewCluster(resources.DefaultNamespace, "missing").Metadata()},
+		{
+			Kind:        resource.NewMetadata(resources.DefaultNamespace, omni.MachineSetType, "", resource.VersionUndefined),
+			ListOptions: []state.ListOption{state.WithLabelQuery(resource.LabelEqual(omni.LabelCluster, "cluster"))},
+		},
+	}
+
+	// the reads are repeated until the cluster stops changing
+	st := &changingState{State: innerSt, changes: 3}
+
+	snap, err := snapshot.Read(ctx, st, queries...)
+	require.NoError(t, err)
+
+	res, ok := snapshot.Get[*omni.Cluster](snap, 0)
+	require.True(t, ok)
+	assert.Equal(t, "1.1.1.1", res.TypedSpec().Value.KubernetesVersion)
+
+	_, ok = snapshot.Get[*omni.Cluster](snap, 1)
+	assert.False(t, ok)
+
+	machineSets := snapshot.List[*omni.MachineSet](snap, 2)
+	assert.Equal(t, 2, machineSets.Len())
+	assert.Len(t, snap.Items(2), 2)
+
+	// the resources which keep changing can't be read consistently
+	st = &changingState{State: innerSt, changes: 100}
+
+	_, err = snapshot.ReadWithAttempts(ctx, st, 5, queries...)
+	assert.ErrorIs(t, err, snapshot.ErrInconsistent)
+}
@@ -9,6 +9,7 @@ import "github.com/siderolabs/omni/client/pkg/omni/resources/registry"
 
 func init() {
 	registry.MustRegisterResource(AuthConfigType, &Config{})
+	registry.MustRegisterResource(BreakGlassTokenType, &BreakGlassToken{})
 	registry.MustRegisterResource(IdentityType, &Identity{})
 	registry.MustRegisterResource(PublicKeyType, &PublicKey{})
 	registry.MustRegisterResource(UserType, &User{})
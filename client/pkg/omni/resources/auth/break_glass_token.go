@@ -0,0 +1,66 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package auth
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewBreakGlassToken creates a new BreakGlassToken resource.
+func NewBreakGlassToken(ns, id string) *BreakGlassToken {
+	return typed.NewResource[BreakGlassTokenSpec, BreakGlassTokenExtension](
+		resource.NewMetadata(ns, BreakGlassTokenType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.BreakGlassTokenSpec{}),
+	)
+}
+
+const (
+	// BreakGlassTokenType is the type of BreakGlassToken resource.
+	//
+	// tsgen:BreakGlassTokenType
+	BreakGlassTokenType = resource.Type("BreakGlassTokens.omni.sidero.dev")
+)
+
+// BreakGlassToken resource is the audit record of an issued break-glass access token.
+type BreakGlassToken = typed.Resource[BreakGlassTokenSpec, BreakGlassTokenExtension]
+
+// BreakGlassTokenSpec wraps specs.BreakGlassTokenSpec.
+type BreakGlassTokenSpec = protobuf.ResourceSpec[specs.BreakGlassTokenSpec, *specs.BreakGlassTokenSpec]
+
+// BreakGlassTokenExtension providers auxiliary methods for BreakGlassToken resource.
+type BreakGlassTokenExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (BreakGlassTokenExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             BreakGlassTokenType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Identity",
+				JSONPath: "{.identity}",
+			},
+			{
+				Name:     "Role",
+				JSONPath: "{.role}",
+			},
+			{
+				Name:     "Expiration",
+				JSONPath: "{.expiration}",
+			},
+			{
+				Name:     "Reason",
+				JSONPath: "{.reason}",
+			},
+		},
+	}
+}
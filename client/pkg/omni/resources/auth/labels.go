@@ -14,6 +14,9 @@ const (
 	// LabelPublicKeyUserID is the label that defines the user ID of the public key.
 	LabelPublicKeyUserID = "user-id"
 
+	// LabelPublicKeyHardware is set on the public keys registered as stored on a hardware token.
+	LabelPublicKeyHardware = "hardware-key"
+
 	// LabelIdentityUserID is a label linking identity to the user.
 	// tsgen:LabelIdentityUserID
 	LabelIdentityUserID = "user-id"
@@ -21,6 +24,10 @@ const (
 	// LabelIdentityTypeServiceAccount is set when the type of the identity is service account.
 	// tsgen:LabelIdentityTypeServiceAccount
 	LabelIdentityTypeServiceAccount = "type-service-account"
+
+	// LabelServiceAccountCluster is set on the identity and the user of the service account which is restricted to a single cluster.
+	// tsgen:LabelServiceAccountCluster
+	LabelServiceAccountCluster = "service-account-cluster"
 )
 
 const (
@@ -20,11 +20,23 @@ var UserManagedResourceTypes = []resource.Type{
 	authres.SAMLLabelRuleType,
 	omni.ClusterType,
 	omni.ConfigPatchType,
+	omni.DiscoveryKeyRotationType,
 	omni.EtcdManualBackupType,
+	omni.ExposedServiceAccessPolicyType,
 	omni.MachineClassType,
 	omni.MachineLabelsType,
 	omni.MachineSetType,
 	omni.MachineSetNodeType,
+	omni.MaintenanceWindowType,
+	omni.TalosSecretsRotationType,
 	omni.EtcdBackupS3ConfType,
+	omni.EtcdBackupStorageConfigType,
 	omni.ExtensionsConfigurationType,
+	omni.DefaultExtensionsType,
+	omni.KernelArgsConfigurationType,
+	omni.LogLevelConfigType,
+	omni.NotificationConfigType,
+	omni.QuotaType,
+	omni.RuntimeConfigurationType,
+	omni.SettingsType,
 }
@@ -9,6 +9,21 @@ const (
 	// tsgen:MachineLocked
 	MachineLocked = SystemLabelPrefix + "locked"
 
+	// MachineUpgradeHold holds a machine set node on its current Talos and Kubernetes versions:
+	// the upgrades skip the machine and continue with the rest of the cluster, the machine is upgraded once the annotation is removed.
+	// tsgen:MachineUpgradeHold
+	MachineUpgradeHold = SystemLabelPrefix + "upgrade-hold"
+
+	// ClusterPaused pauses the reconciliation of a cluster: no config updates, upgrades and scaling changes are performed on the cluster,
+	// while the cluster status is still collected.
+	// tsgen:ClusterPaused
+	ClusterPaused = SystemLabelPrefix + "paused"
+
+	// ScaleDownMachines is the comma separated list of the machine IDs which are removed first when the machine class count of the machine set is decreased,
+	// it's set by the cluster-autoscaler integration to remove the nodes picked by the autoscaler.
+	// tsgen:ScaleDownMachines
+	ScaleDownMachines = SystemLabelPrefix + "scale-down-machines"
+
 	// UpdateLocked machine is locked and has pending update.
 	// tsgen:UpdateLocked
 	UpdateLocked = SystemLabelPrefix + "locked-update"
@@ -17,6 +32,24 @@ const (
 	// tsgen:ResourceManagedByClusterTemplates
 	ResourceManagedByClusterTemplates = SystemLabelPrefix + "managed-by-cluster-templates"
 
+	// CreatedBy is the identity which created the user managed resource, set by the API.
+	// tsgen:CreatedBy
+	CreatedBy = SystemLabelPrefix + "created-by"
+
+	// UpdatedBy is the identity which last updated the user managed resource, set by the API.
+	// tsgen:UpdatedBy
+	UpdatedBy = SystemLabelPrefix + "updated-by"
+
+	// UpdatedAt is the time of the last update of the user managed resource made through the API, in RFC3339 format.
+	//
+	// Unlike the resource metadata update time, it's not changed by the controllers updating the finalizers or the labels.
+	// tsgen:UpdatedAt
+	UpdatedAt = SystemLabelPrefix + "updated-at"
+
+	// DestroyReason is the reason given for the deletion of the resource through the API.
+	// tsgen:DestroyReason
+	DestroyReason = SystemLabelPrefix + "destroy-reason"
+
 	// ConfigPatchName human readable patch name.
 	// tsgen:ConfigPatchName
 	ConfigPatchName = "name"
@@ -0,0 +1,52 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+)
+
+// AutoscalerProviderIDPrefix is the prefix of the Kubernetes node provider ID set for the machines of the autoscaled machine sets.
+const AutoscalerProviderIDPrefix = "omni://"
+
+// AutoscalerProviderID returns the Kubernetes node provider ID of the machine.
+func AutoscalerProviderID(machineID string) string {
+	return AutoscalerProviderIDPrefix + machineID
+}
+
+// MachineIDFromAutoscalerProviderID returns the machine ID from the Kubernetes node provider ID, false is returned if the provider ID is not set by Omni.
+func MachineIDFromAutoscalerProviderID(providerID string) (string, bool) {
+	machineID, ok := strings.CutPrefix(providerID, AutoscalerProviderIDPrefix)
+
+	return machineID, ok && machineID != ""
+}
+
+// ValidateAutoscaling checks the autoscaling bounds of the machine set.
+func ValidateAutoscaling(spec *specs.MachineSetSpec) error {
+	autoscaling := spec.GetAutoscaling()
+	if autoscaling == nil {
+		return nil
+	}
+
+	var errs []error
+
+	if spec.GetMachineClass() == nil || spec.GetMachineClass().GetAllocationType() != specs.MachineSetSpec_MachineClass_Static {
+		errs = append(errs, errors.New("autoscaling requires the machine class with the static allocation"))
+	}
+
+	if autoscaling.MaxSize == 0 {
+		errs = append(errs, errors.New("autoscaling max size must be set"))
+	}
+
+	if autoscaling.MinSize > autoscaling.MaxSize {
+		errs = append(errs, fmt.Errorf("autoscaling min size %d is greater than the max size %d", autoscaling.MinSize, autoscaling.MaxSize))
+	}
+
+	return errors.Join(errs...)
+}
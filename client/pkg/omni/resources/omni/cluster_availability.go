@@ -0,0 +1,48 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewClusterAvailability creates new ClusterAvailability resource.
+func NewClusterAvailability(ns string, id resource.ID) *ClusterAvailability {
+	return typed.NewResource[ClusterAvailabilitySpec, ClusterAvailabilityExtension](
+		resource.NewMetadata(ns, ClusterAvailabilityType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.ClusterAvailabilitySpec{}),
+	)
+}
+
+const (
+	// ClusterAvailabilityType is the type of the ClusterAvailability resource.
+	// tsgen:ClusterAvailabilityType
+	ClusterAvailabilityType = resource.Type("ClusterAvailabilities.omni.sidero.dev")
+)
+
+// ClusterAvailability keeps the availability heartbeats of the cluster used for the SLA reporting.
+type ClusterAvailability = typed.Resource[ClusterAvailabilitySpec, ClusterAvailabilityExtension]
+
+// ClusterAvailabilitySpec wraps specs.ClusterAvailabilitySpec.
+type ClusterAvailabilitySpec = protobuf.ResourceSpec[specs.ClusterAvailabilitySpec, *specs.ClusterAvailabilitySpec]
+
+// ClusterAvailabilityExtension provides auxiliary methods for ClusterAvailability resource.
+type ClusterAvailabilityExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (ClusterAvailabilityExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ClusterAvailabilityType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns:     []meta.PrintColumn{},
+	}
+}
@@ -0,0 +1,59 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewClusterMachineConfigBackup creates new cluster machine config backup resource.
+func NewClusterMachineConfigBackup(ns string, id resource.ID) *ClusterMachineConfigBackup {
+	return typed.NewResource[ClusterMachineConfigBackupSpec, ClusterMachineConfigBackupExtension](
+		resource.NewMetadata(ns, ClusterMachineConfigBackupType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.ClusterMachineConfigBackupSpec{}),
+	)
+}
+
+const (
+	// ClusterMachineConfigBackupType is the type of the ClusterMachineConfigBackup resource.
+	// tsgen:ClusterMachineConfigBackupType
+	ClusterMachineConfigBackupType = resource.Type("ClusterMachineConfigBackups.omni.sidero.dev")
+)
+
+// ClusterMachineConfigBackup is the machine config which was running on the machine before it was upgraded.
+//
+// The backups of a machine are labeled with LabelClusterMachine, only the latest backups are kept.
+type ClusterMachineConfigBackup = typed.Resource[ClusterMachineConfigBackupSpec, ClusterMachineConfigBackupExtension]
+
+// ClusterMachineConfigBackupSpec wraps specs.ClusterMachineConfigBackupSpec.
+type ClusterMachineConfigBackupSpec = protobuf.ResourceSpec[specs.ClusterMachineConfigBackupSpec, *specs.ClusterMachineConfigBackupSpec]
+
+// ClusterMachineConfigBackupExtension provides auxiliary methods for ClusterMachineConfigBackup resource.
+type ClusterMachineConfigBackupExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (ClusterMachineConfigBackupExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ClusterMachineConfigBackupType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Reason",
+				JSONPath: "{.reason}",
+			},
+			{
+				Name:     "Talos Version",
+				JSONPath: "{.talosversion}",
+			},
+		},
+	}
+}
@@ -47,6 +47,20 @@ func TestClusterMachineConfigPatchesSpecW_marshal(t *testing.T) {
 	}
 }
 
+func TestClusterMachineConfigPatchesSpecW_marshalBlobIDs(t *testing.T) {
+	original := omni.NewClusterMachineConfigPatches("default", "1")
+	original.TypedSpec().Value.Patches = []string{"", justText}
+	original.TypedSpec().Value.BlobIds = []string{"machineset-0123", ""}
+
+	out := must.Value(yaml.Marshal(must.Value(resource.MarshalYAML(original))(t)))(t)
+
+	var dest protobuf.YAMLResource
+
+	require.NoError(t, yaml.Unmarshal(out, &dest))
+
+	require.True(t, resource.Equal(original, dest.Resource()), string(out))
+}
+
 func ExampleClusterMachineSpec_marshal() {
 	original := omni.NewClusterMachineConfigPatches("default", "1")
 	original.TypedSpec().Value.Patches = []string{newLineAndText, justText}
@@ -0,0 +1,65 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewClusterNodeVersions creates a new cluster node versions resource.
+func NewClusterNodeVersions(ns string, id resource.ID) *ClusterNodeVersions {
+	return typed.NewResource[ClusterNodeVersionsSpec, ClusterNodeVersionsExtension](
+		resource.NewMetadata(ns, ClusterNodeVersionsType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.ClusterNodeVersionsSpec{}),
+	)
+}
+
+const (
+	// ClusterNodeVersionsType is the type of the ClusterNodeVersions resource.
+	// tsgen:ClusterNodeVersionsType
+	ClusterNodeVersionsType = resource.Type("ClusterNodeVersions.omni.sidero.dev")
+)
+
+// ClusterNodeVersions describes the versions of Talos, kubelet and container runtime running on the nodes of a cluster.
+type ClusterNodeVersions = typed.Resource[ClusterNodeVersionsSpec, ClusterNodeVersionsExtension]
+
+// ClusterNodeVersionsSpec wraps specs.ClusterNodeVersionsSpec.
+type ClusterNodeVersionsSpec = protobuf.ResourceSpec[specs.ClusterNodeVersionsSpec, *specs.ClusterNodeVersionsSpec]
+
+// ClusterNodeVersionsExtension provides auxiliary methods for ClusterNodeVersions resource.
+type ClusterNodeVersionsExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (ClusterNodeVersionsExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ClusterNodeVersionsType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Talos",
+				JSONPath: "{.talosversions}",
+			},
+			{
+				Name:     "Kubelet",
+				JSONPath: "{.kubeletversions}",
+			},
+			{
+				Name:     "Container Runtime",
+				JSONPath: "{.containerruntimeversions}",
+			},
+			{
+				Name:     "Drift",
+				JSONPath: "{.versiondrift}",
+			},
+		},
+	}
+}
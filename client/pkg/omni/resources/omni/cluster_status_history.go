@@ -0,0 +1,48 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewClusterStatusHistory creates new ClusterStatusHistory resource.
+func NewClusterStatusHistory(ns string, id resource.ID) *ClusterStatusHistory {
+	return typed.NewResource[ClusterStatusHistorySpec, ClusterStatusHistoryExtension](
+		resource.NewMetadata(ns, ClusterStatusHistoryType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.ClusterStatusHistorySpec{}),
+	)
+}
+
+const (
+	// ClusterStatusHistoryType is the type of the ClusterStatusHistory resource.
+	// tsgen:ClusterStatusHistoryType
+	ClusterStatusHistoryType = resource.Type("ClusterStatusHistories.omni.sidero.dev")
+)
+
+// ClusterStatusHistory keeps the downsampled history of the cluster status.
+type ClusterStatusHistory = typed.Resource[ClusterStatusHistorySpec, ClusterStatusHistoryExtension]
+
+// ClusterStatusHistorySpec wraps specs.ClusterStatusHistorySpec.
+type ClusterStatusHistorySpec = protobuf.ResourceSpec[specs.ClusterStatusHistorySpec, *specs.ClusterStatusHistorySpec]
+
+// ClusterStatusHistoryExtension provides auxiliary methods for ClusterStatusHistory resource.
+type ClusterStatusHistoryExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (ClusterStatusHistoryExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ClusterStatusHistoryType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns:     []meta.PrintColumn{},
+	}
+}
@@ -5,7 +5,9 @@
 package omni
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/cosi-project/runtime/pkg/resource"
@@ -86,21 +88,47 @@ func (ConfigPatchExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
 
 // ValidateConfigPatch parses the config patch data using Talos config loader,
 // then validates that the config patch doesn't have fields that are controlled by omni.
+//
+// The patch might consist of multiple documents: the v1alpha1 document is checked for the forbidden fields,
+// the other documents are only decoded by the config loader, which also rejects the documents which are defined more than once.
+// The templates are validated by rendering them with the sample machine facts.
 func ValidateConfigPatch(data string) error {
-	_, err := configloader.NewFromBytes([]byte(data))
+	data, err := RenderConfigPatchTemplate(data, configPatchTemplateSample)
 	if err != nil {
 		return err
 	}
 
-	var config map[string]any
-
-	err = yaml.Unmarshal([]byte(data), &config)
+	_, err = configloader.NewFromBytes([]byte(data))
 	if err != nil {
 		return err
 	}
 
+	decoder := yaml.NewDecoder(strings.NewReader(data))
+
 	var multiErr error
 
+	for {
+		var config map[string]any
+
+		if err = decoder.Decode(&config); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return err
+		}
+
+		if _, ok := config["kind"]; ok {
+			continue
+		}
+
+		multiErr = validateV1Alpha1ConfigPatch(config, multiErr)
+	}
+
+	return multiErr
+}
+
+func validateV1Alpha1ConfigPatch(config map[string]any, multiErr error) error {
 	for _, field := range forbiddenFields {
 		if _, ok := getField(config, field); ok {
 			multiErr = multierror.Append(multiErr, fmt.Errorf("overriding %q is not allowed in the config patch", field))
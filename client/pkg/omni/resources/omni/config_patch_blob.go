@@ -0,0 +1,48 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewConfigPatchBlob creates new config patch blob resource.
+func NewConfigPatchBlob(ns string, id resource.ID) *ConfigPatchBlob {
+	return typed.NewResource[ConfigPatchBlobSpec, ConfigPatchBlobExtension](
+		resource.NewMetadata(ns, ConfigPatchBlobType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.ConfigPatchBlobSpec{}),
+	)
+}
+
+// ConfigPatchBlobType is the type of the ConfigPatchBlob resource.
+// tsgen:ConfigPatchBlobType
+const ConfigPatchBlobType = resource.Type("ConfigPatchBlobs.omni.sidero.dev")
+
+// ConfigPatchBlob keeps the compressed config patch shared by the cluster machines of a machine set.
+//
+// The ID of the resource is derived from the machine set ID and the hash of the patch contents.
+type ConfigPatchBlob = typed.Resource[ConfigPatchBlobSpec, ConfigPatchBlobExtension]
+
+// ConfigPatchBlobSpec wraps specs.ConfigPatchBlobSpec.
+type ConfigPatchBlobSpec = protobuf.ResourceSpec[specs.ConfigPatchBlobSpec, *specs.ConfigPatchBlobSpec]
+
+// ConfigPatchBlobExtension provides auxiliary methods for ConfigPatchBlob resource.
+type ConfigPatchBlobExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (ConfigPatchBlobExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ConfigPatchBlobType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns:     []meta.PrintColumn{},
+	}
+}
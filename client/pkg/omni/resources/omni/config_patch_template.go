@@ -0,0 +1,73 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// ConfigPatchTemplateMarker is the first line of the config patches which are Go templates.
+//
+// Such patches are rendered with the facts of each machine before they are applied,
+// so a single patch can set e.g. the per-machine hostname.
+const ConfigPatchTemplateMarker = "# omni:template"
+
+// ConfigPatchTemplateData is the data the config patch templates are rendered with.
+type ConfigPatchTemplateData struct {
+	// Labels are the user labels of the machine, the system labels are not included.
+	Labels map[string]string
+	// MachineID is the ID of the machine.
+	MachineID string
+	// Hostname is the current hostname of the machine.
+	Hostname string
+	// Cluster is the name of the cluster the machine is in.
+	Cluster string
+	// Addresses are the current IP addresses of the machine in CIDR notation.
+	Addresses []string
+}
+
+// configPatchTemplateSample is used to check that the templates are rendered into the valid config patches.
+var configPatchTemplateSample = ConfigPatchTemplateData{
+	Labels:    map[string]string{},
+	MachineID: "00000000-0000-0000-0000-000000000000",
+	Hostname:  "talos-sample",
+	Cluster:   "sample",
+	Addresses: []string{"172.20.0.2/24"},
+}
+
+var configPatchTemplateFuncs = template.FuncMap{
+	"join": strings.Join,
+}
+
+// IsConfigPatchTemplate checks if the config patch starts with the template marker.
+func IsConfigPatchTemplate(data string) bool {
+	firstLine, _, _ := strings.Cut(data, "\n")
+
+	return strings.TrimSpace(firstLine) == ConfigPatchTemplateMarker
+}
+
+// RenderConfigPatchTemplate renders the config patch template with the data, the patches which are not templates are returned as is.
+//
+// The missing labels are rendered as the empty strings.
+func RenderConfigPatchTemplate(data string, templateData ConfigPatchTemplateData) (string, error) {
+	if !IsConfigPatchTemplate(data) {
+		return data, nil
+	}
+
+	tmpl, err := template.New("patch").Funcs(configPatchTemplateFuncs).Option("missingkey=zero").Parse(data)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse the config patch template: %w", err)
+	}
+
+	var sb strings.Builder
+
+	if err = tmpl.Execute(&sb, templateData); err != nil {
+		return "", fmt.Errorf("failed to render the config patch template: %w", err)
+	}
+
+	return sb.String(), nil
+}
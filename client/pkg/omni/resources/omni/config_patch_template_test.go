@@ -0,0 +1,87 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+func TestRenderConfigPatchTemplate(t *testing.T) {
+	data := omni.ConfigPatchTemplateData{
+		Labels:    map[string]string{"rack": "r1"},
+		MachineID: "machine-1",
+		Hostname:  "talos-abc",
+		Cluster:   "prod",
+		Addresses: []string{"10.5.0.2/24", "fd00::2/64"},
+	}
+
+	for _, tt := range []struct {
+		name          string
+		patch         string
+		expected      string
+		expectedError string
+	}{
+		{
+			name:     "not a template",
+			patch:    "machine:\n  network:\n    hostname: {{ .Hostname }}\n",
+			expected: "machine:\n  network:\n    hostname: {{ .Hostname }}\n",
+		},
+		{
+			name: "template",
+			patch: strings.TrimSpace(`
+# omni:template
+machine:
+  network:
+    hostname: {{ .Cluster }}-{{ index .Labels "rack" }}-{{ .MachineID }}
+  certSANs: [{{ join .Addresses ", " }}]
+  nodeLabels:
+    zone: "{{ .Labels.zone }}"
+`),
+			expected: strings.TrimSpace(`
+# omni:template
+machine:
+  network:
+    hostname: prod-r1-machine-1
+  certSANs: [10.5.0.2/24, fd00::2/64]
+  nodeLabels:
+    zone: ""
+`),
+		},
+		{
+			name:          "invalid template",
+			patch:         "# omni:template\nmachine: {{ .Hostname\n",
+			expectedError: "failed to parse the config patch template",
+		},
+		{
+			name:          "unknown field",
+			patch:         "# omni:template\nmachine: {{ .Rack }}\n",
+			expectedError: "failed to render the config patch template",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			rendered, err := omni.RenderConfigPatchTemplate(tt.patch, data)
+			if tt.expectedError != "" {
+				require.ErrorContains(t, err, tt.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, rendered)
+		})
+	}
+}
+
+func TestValidateConfigPatchTemplate(t *testing.T) {
+	require.NoError(t, omni.ValidateConfigPatch("# omni:template\nmachine:\n  network:\n    hostname: \"{{ .Hostname }}\"\n"))
+
+	// the rendered template is validated
+	require.ErrorContains(t, omni.ValidateConfigPatch("# omni:template\nmachine:\n  token: \"{{ .MachineID }}\"\n"), "machine.token")
+}
@@ -81,6 +81,47 @@ machine:
 `),
 			expectedError: "1 error occurred:\n\t* element \"os:admin\" is not allowed in field \"machine.features.kubernetesTalosAPIAccess.allowedRoles\"\n\n",
 		},
+		{
+			name: "multiple documents",
+			config: strings.TrimSpace(`
+machine:
+  network:
+    hostname: abcd
+---
+apiVersion: v1alpha1
+kind: KmsgLogConfig
+name: remote-log
+url: tcp://10.0.0.1:3001/
+`),
+		},
+		{
+			name: "forbidden field in the second document",
+			config: strings.TrimSpace(`
+apiVersion: v1alpha1
+kind: KmsgLogConfig
+name: remote-log
+url: tcp://10.0.0.1:3001/
+---
+machine:
+  token: aaa
+`),
+			expectedError: "1 error occurred:\n\t* overriding \"machine.token\" is not allowed in the config patch\n\n",
+		},
+		{
+			name: "duplicate documents",
+			config: strings.TrimSpace(`
+apiVersion: v1alpha1
+kind: KmsgLogConfig
+name: remote-log
+url: tcp://10.0.0.1:3001/
+---
+apiVersion: v1alpha1
+kind: KmsgLogConfig
+name: remote-log
+url: tcp://10.0.0.2:3001/
+`),
+			expectedError: "duplicate document: KmsgLogConfig/remote-log",
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			err := omni.ValidateConfigPatch(tt.config)
@@ -0,0 +1,60 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewDefaultExtensions creates new DefaultExtensions resource.
+func NewDefaultExtensions() *DefaultExtensions {
+	return typed.NewResource[DefaultExtensionsSpec, DefaultExtensionsExtension](
+		resource.NewMetadata(resources.DefaultNamespace, DefaultExtensionsType, DefaultExtensionsID, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.DefaultExtensionsSpec{}),
+	)
+}
+
+const (
+	// DefaultExtensionsID is the ID of the DefaultExtensions resource.
+	// tsgen:DefaultExtensionsID
+	DefaultExtensionsID = resource.ID("default-extensions")
+
+	// DefaultExtensionsType is the type of the DefaultExtensions resource.
+	// tsgen:DefaultExtensionsType
+	DefaultExtensionsType = resource.Type("DefaultExtensions.omni.sidero.dev")
+)
+
+// DefaultExtensions is the account-wide list of the extensions installed on the machines of the new machine sets.
+//
+// The machine sets capture the list when they are created, so the changes don't affect the existing machine sets.
+// ExtensionsConfiguration defined for the cluster, the machine set or the machine overrides the default extensions.
+type DefaultExtensions = typed.Resource[DefaultExtensionsSpec, DefaultExtensionsExtension]
+
+// DefaultExtensionsSpec wraps specs.DefaultExtensionsSpec.
+type DefaultExtensionsSpec = protobuf.ResourceSpec[specs.DefaultExtensionsSpec, *specs.DefaultExtensionsSpec]
+
+// DefaultExtensionsExtension provides auxiliary methods for DefaultExtensions resource.
+type DefaultExtensionsExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (DefaultExtensionsExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             DefaultExtensionsType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Extensions",
+				JSONPath: "{.extensions}",
+			},
+		},
+	}
+}
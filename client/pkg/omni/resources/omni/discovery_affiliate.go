@@ -0,0 +1,67 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewDiscoveryAffiliate creates new DiscoveryAffiliate resource.
+func NewDiscoveryAffiliate(ns string, id resource.ID) *DiscoveryAffiliate {
+	return typed.NewResource[DiscoveryAffiliateSpec, DiscoveryAffiliateExtension](
+		resource.NewMetadata(ns, DiscoveryAffiliateType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.DiscoveryAffiliateSpec{}),
+	)
+}
+
+const (
+	// DiscoveryAffiliateType is the type of the DiscoveryAffiliate resource.
+	// tsgen:DiscoveryAffiliateType
+	DiscoveryAffiliateType = resource.Type("DiscoveryAffiliates.omni.sidero.dev")
+)
+
+// DiscoveryAffiliate mirrors the affiliate of the cluster registered in the embedded discovery service.
+//
+// The ID is the affiliate ID, which is the node identity of the machine.
+type DiscoveryAffiliate = typed.Resource[DiscoveryAffiliateSpec, DiscoveryAffiliateExtension]
+
+// DiscoveryAffiliateSpec wraps specs.DiscoveryAffiliateSpec.
+type DiscoveryAffiliateSpec = protobuf.ResourceSpec[specs.DiscoveryAffiliateSpec, *specs.DiscoveryAffiliateSpec]
+
+// DiscoveryAffiliateExtension provides auxiliary methods for DiscoveryAffiliate resource.
+type DiscoveryAffiliateExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (DiscoveryAffiliateExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             DiscoveryAffiliateType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.EphemeralNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Hostname",
+				JSONPath: "{.hostname}",
+			},
+			{
+				Name:     "Machine Type",
+				JSONPath: "{.machinetype}",
+			},
+			{
+				Name:     "Addresses",
+				JSONPath: "{.addresses}",
+			},
+			{
+				Name:     "Endpoints",
+				JSONPath: "{.endpoints}",
+			},
+		},
+	}
+}
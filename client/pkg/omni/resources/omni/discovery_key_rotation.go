@@ -0,0 +1,55 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewDiscoveryKeyRotation creates new DiscoveryKeyRotation resource.
+func NewDiscoveryKeyRotation(id resource.ID) *DiscoveryKeyRotation {
+	return typed.NewResource[DiscoveryKeyRotationSpec, DiscoveryKeyRotationExtension](
+		resource.NewMetadata(resources.DefaultNamespace, DiscoveryKeyRotationType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.DiscoveryKeyRotationSpec{}),
+	)
+}
+
+const (
+	// DiscoveryKeyRotationType is the type of the DiscoveryKeyRotation resource.
+	// tsgen:DiscoveryKeyRotationType
+	DiscoveryKeyRotationType = resource.Type("DiscoveryKeyRotations.omni.sidero.dev")
+)
+
+// DiscoveryKeyRotation requests the rotation of the discovery service encryption key of the cluster.
+//
+// The ID is the cluster name.
+type DiscoveryKeyRotation = typed.Resource[DiscoveryKeyRotationSpec, DiscoveryKeyRotationExtension]
+
+// DiscoveryKeyRotationSpec wraps specs.DiscoveryKeyRotationSpec.
+type DiscoveryKeyRotationSpec = protobuf.ResourceSpec[specs.DiscoveryKeyRotationSpec, *specs.DiscoveryKeyRotationSpec]
+
+// DiscoveryKeyRotationExtension provides auxiliary methods for DiscoveryKeyRotation resource.
+type DiscoveryKeyRotationExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (DiscoveryKeyRotationExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             DiscoveryKeyRotationType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Requested At",
+				JSONPath: "{.requestedat}",
+			},
+		},
+	}
+}
@@ -0,0 +1,61 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewDiscoveryKeyRotationStatus creates new DiscoveryKeyRotationStatus resource.
+func NewDiscoveryKeyRotationStatus(id resource.ID) *DiscoveryKeyRotationStatus {
+	return typed.NewResource[DiscoveryKeyRotationStatusSpec, DiscoveryKeyRotationStatusExtension](
+		resource.NewMetadata(resources.DefaultNamespace, DiscoveryKeyRotationStatusType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.DiscoveryKeyRotationStatusSpec{}),
+	)
+}
+
+const (
+	// DiscoveryKeyRotationStatusType is the type of the DiscoveryKeyRotationStatus resource.
+	// tsgen:DiscoveryKeyRotationStatusType
+	DiscoveryKeyRotationStatusType = resource.Type("DiscoveryKeyRotationStatuses.omni.sidero.dev")
+)
+
+// DiscoveryKeyRotationStatus reports the progress of the DiscoveryKeyRotation.
+type DiscoveryKeyRotationStatus = typed.Resource[DiscoveryKeyRotationStatusSpec, DiscoveryKeyRotationStatusExtension]
+
+// DiscoveryKeyRotationStatusSpec wraps specs.DiscoveryKeyRotationStatusSpec.
+type DiscoveryKeyRotationStatusSpec = protobuf.ResourceSpec[specs.DiscoveryKeyRotationStatusSpec, *specs.DiscoveryKeyRotationStatusSpec]
+
+// DiscoveryKeyRotationStatusExtension provides auxiliary methods for DiscoveryKeyRotationStatus resource.
+type DiscoveryKeyRotationStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (DiscoveryKeyRotationStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             DiscoveryKeyRotationStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Phase",
+				JSONPath: "{.phase}",
+			},
+			{
+				Name:     "Updated",
+				JSONPath: "{.updatedmachines}",
+			},
+			{
+				Name:     "Total",
+				JSONPath: "{.totalmachines}",
+			},
+		},
+	}
+}
@@ -0,0 +1,49 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewEtcdBackupStorageConfig creates new etcd backup storage config resource, the ID is the cluster name.
+func NewEtcdBackupStorageConfig(id resource.ID) *EtcdBackupStorageConfig {
+	return typed.NewResource[EtcdBackupStorageConfigSpec, EtcdBackupStorageConfigExtension](
+		resource.NewMetadata(resources.DefaultNamespace, EtcdBackupStorageConfigType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.EtcdBackupStorageConfigSpec{}),
+	)
+}
+
+const (
+	// EtcdBackupStorageConfigType is the type of the EtcdBackupStorageConfig resource.
+	// tsgen:EtcdBackupStorageConfigType
+	EtcdBackupStorageConfigType = resource.Type("EtcdBackupStorageConfigs.omni.sidero.dev")
+)
+
+// EtcdBackupStorageConfig overrides the etcd backup storage of the cluster.
+type EtcdBackupStorageConfig = typed.Resource[EtcdBackupStorageConfigSpec, EtcdBackupStorageConfigExtension]
+
+// EtcdBackupStorageConfigSpec wraps specs.EtcdBackupStorageConfigSpec.
+type EtcdBackupStorageConfigSpec = protobuf.ResourceSpec[specs.EtcdBackupStorageConfigSpec, *specs.EtcdBackupStorageConfigSpec]
+
+// EtcdBackupStorageConfigExtension provides auxiliary methods for EtcdBackupStorageConfig resource.
+type EtcdBackupStorageConfigExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (EtcdBackupStorageConfigExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             EtcdBackupStorageConfigType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns:     []meta.PrintColumn{},
+		Sensitivity:      meta.Sensitive,
+	}
+}
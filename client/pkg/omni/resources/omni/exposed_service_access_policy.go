@@ -0,0 +1,67 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewExposedServiceAccessPolicy creates new ExposedServiceAccessPolicy resource.
+//
+// The ID is the ID of the ExposedService the policy is applied to.
+func NewExposedServiceAccessPolicy(ns string, id resource.ID) *ExposedServiceAccessPolicy {
+	return typed.NewResource[ExposedServiceAccessPolicySpec, ExposedServiceAccessPolicyExtension](
+		resource.NewMetadata(ns, ExposedServiceAccessPolicyType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.ExposedServiceAccessPolicySpec{}),
+	)
+}
+
+const (
+	// ExposedServiceAccessPolicyType is the type of the ExposedServiceAccessPolicy resource.
+	// tsgen:ExposedServiceAccessPolicyType
+	ExposedServiceAccessPolicyType = resource.Type("ExposedServiceAccessPolicies.omni.sidero.dev")
+)
+
+// ExposedServiceAccessPolicy restricts the access to an exposed service through the workload proxy.
+type ExposedServiceAccessPolicy = typed.Resource[ExposedServiceAccessPolicySpec, ExposedServiceAccessPolicyExtension]
+
+// ExposedServiceAccessPolicySpec wraps specs.ExposedServiceAccessPolicySpec.
+type ExposedServiceAccessPolicySpec = protobuf.ResourceSpec[specs.ExposedServiceAccessPolicySpec, *specs.ExposedServiceAccessPolicySpec]
+
+// ExposedServiceAccessPolicyExtension provides auxiliary methods for ExposedServiceAccessPolicy resource.
+type ExposedServiceAccessPolicyExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (ExposedServiceAccessPolicyExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ExposedServiceAccessPolicyType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Identities",
+				JSONPath: "{.identities}",
+			},
+			{
+				Name:     "Min Role",
+				JSONPath: "{.minrole}",
+			},
+			{
+				Name:     "Allowed CIDRs",
+				JSONPath: "{.allowedcidrs}",
+			},
+			{
+				Name:     "Require MFA",
+				JSONPath: "{.requiremfa}",
+			},
+		},
+	}
+}
@@ -0,0 +1,100 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+)
+
+// ValidateInstallDiskPolicy checks the install disk policy correctness.
+func ValidateInstallDiskPolicy(policy *specs.MachineSetSpec_InstallDiskPolicy) error {
+	if policy == nil {
+		return nil
+	}
+
+	var errs []error
+
+	if policy.MaxSize != 0 && policy.MinSize > policy.MaxSize {
+		errs = append(errs, fmt.Errorf("install disk policy min size %d is greater than max size %d", policy.MinSize, policy.MaxSize))
+	}
+
+	if _, err := regexp.Compile(policy.BusPathRegex); err != nil {
+		errs = append(errs, fmt.Errorf("invalid install disk policy bus path regex: %w", err))
+	}
+
+	if _, err := regexp.Compile(policy.ModelRegex); err != nil {
+		errs = append(errs, fmt.Errorf("invalid install disk policy model regex: %w", err))
+	}
+
+	if _, ok := specs.MachineSetSpec_InstallDiskPolicy_Prefer_name[int32(policy.Prefer)]; !ok {
+		errs = append(errs, fmt.Errorf("invalid install disk policy prefer value %d", policy.Prefer))
+	}
+
+	return errors.Join(errs...)
+}
+
+// SelectInstallDisk picks the install disk matching the policy from the disks discovered on the machine.
+//
+// The disks smaller than minSize are never picked. Returns an error if none of the disks match the policy.
+func SelectInstallDisk(policy *specs.MachineSetSpec_InstallDiskPolicy, disks []*specs.MachineStatusSpec_HardwareStatus_BlockDevice, minSize uint64) (string, error) {
+	if err := ValidateInstallDiskPolicy(policy); err != nil {
+		return "", err
+	}
+
+	// the regexes are validated above
+	busPathRegex := regexp.MustCompile(policy.GetBusPathRegex())
+	modelRegex := regexp.MustCompile(policy.GetModelRegex())
+
+	var selected *specs.MachineStatusSpec_HardwareStatus_BlockDevice
+
+	for _, disk := range disks {
+		if disk.Size < max(minSize, policy.GetMinSize()) {
+			continue
+		}
+
+		if policy.GetMaxSize() != 0 && disk.Size > policy.GetMaxSize() {
+			continue
+		}
+
+		if len(policy.GetTypes()) > 0 && !slices.ContainsFunc(policy.GetTypes(), func(diskType string) bool {
+			return strings.EqualFold(diskType, disk.Type)
+		}) {
+			continue
+		}
+
+		if !busPathRegex.MatchString(disk.BusPath) || !modelRegex.MatchString(disk.Model) {
+			continue
+		}
+
+		if selected == nil {
+			selected = disk
+
+			continue
+		}
+
+		switch policy.GetPrefer() {
+		case specs.MachineSetSpec_InstallDiskPolicy_Smallest:
+			if disk.Size < selected.Size {
+				selected = disk
+			}
+		case specs.MachineSetSpec_InstallDiskPolicy_Largest:
+			if disk.Size > selected.Size {
+				selected = disk
+			}
+		}
+	}
+
+	if selected == nil {
+		return "", errors.New("no disks match the install disk policy")
+	}
+
+	return selected.LinuxName, nil
+}
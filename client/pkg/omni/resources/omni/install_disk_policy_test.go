@@ -0,0 +1,133 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+func TestSelectInstallDisk(t *testing.T) {
+	t.Parallel()
+
+	disks := []*specs.MachineStatusSpec_HardwareStatus_BlockDevice{
+		{
+			LinuxName: "/dev/sr0",
+			Size:      1e9,
+			Type:      "cd",
+		},
+		{
+			LinuxName: "/dev/sda",
+			Size:      2e12,
+			Type:      "HDD",
+			Model:     "ST2000DM008",
+			BusPath:   "/pci0000:00/0000:00:17.0/ata1/host0/target0:0:0/0:0:0:0",
+		},
+		{
+			LinuxName: "/dev/sdb",
+			Size:      480e9,
+			Type:      "SSD",
+			Model:     "Samsung SSD 860",
+			BusPath:   "/pci0000:00/0000:00:17.0/ata2/host1/target1:0:0/1:0:0:0",
+		},
+		{
+			LinuxName: "/dev/nvme0n1",
+			Size:      1e12,
+			Type:      "NVME",
+			Model:     "Samsung SSD 980 PRO",
+			BusPath:   "/pci0000:00/0000:00:1d.0/0000:03:00.0/nvme",
+		},
+	}
+
+	for _, test := range []struct { //nolint:govet
+		name         string
+		policy       *specs.MachineSetSpec_InstallDiskPolicy
+		expectedDisk string
+		expectedErr  string
+	}{
+		{
+			name:         "empty policy",
+			policy:       &specs.MachineSetSpec_InstallDiskPolicy{},
+			expectedDisk: "/dev/sdb",
+		},
+		{
+			name: "largest",
+			policy: &specs.MachineSetSpec_InstallDiskPolicy{
+				Prefer: specs.MachineSetSpec_InstallDiskPolicy_Largest,
+			},
+			expectedDisk: "/dev/sda",
+		},
+		{
+			name: "smallest ssd",
+			policy: &specs.MachineSetSpec_InstallDiskPolicy{
+				Types: []string{"ssd", "nvme"},
+			},
+			expectedDisk: "/dev/sdb",
+		},
+		{
+			name: "size range",
+			policy: &specs.MachineSetSpec_InstallDiskPolicy{
+				MinSize: 500e9,
+				MaxSize: 1e12,
+			},
+			expectedDisk: "/dev/nvme0n1",
+		},
+		{
+			name: "model",
+			policy: &specs.MachineSetSpec_InstallDiskPolicy{
+				ModelRegex: "^ST",
+			},
+			expectedDisk: "/dev/sda",
+		},
+		{
+			name: "bus path",
+			policy: &specs.MachineSetSpec_InstallDiskPolicy{
+				BusPathRegex: "nvme$",
+			},
+			expectedDisk: "/dev/nvme0n1",
+		},
+		{
+			name: "no match",
+			policy: &specs.MachineSetSpec_InstallDiskPolicy{
+				Types: []string{"sd"},
+			},
+			expectedErr: "no disks match the install disk policy",
+		},
+		{
+			name: "invalid regex",
+			policy: &specs.MachineSetSpec_InstallDiskPolicy{
+				ModelRegex: "(",
+			},
+			expectedErr: "invalid install disk policy model regex",
+		},
+		{
+			name: "invalid size range",
+			policy: &specs.MachineSetSpec_InstallDiskPolicy{
+				MinSize: 2e12,
+				MaxSize: 1e12,
+			},
+			expectedErr: "install disk policy min size 2000000000000 is greater than max size 1000000000000",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			disk, err := omni.SelectInstallDisk(test.policy, disks, 5e9)
+			if test.expectedErr != "" {
+				require.ErrorContains(t, err, test.expectedErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedDisk, disk)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// DeniedKernelArgs is the list of the kernel arguments which can't be added or removed by the KernelArgsConfiguration,
+// as they break the boot of the machine, its connection to Omni, or weaken its security.
+var DeniedKernelArgs = []string{
+	"init",
+	"rdinit",
+	"root",
+	"lockdown",
+	"module.sig_enforce",
+	"talos.config",
+	"talos.platform",
+	"talos.board",
+	"talos.experimental.wipe",
+	"talos.events.sink",
+	"talos.logging.kernel",
+	"siderolink.api",
+}
+
+// ValidateKernelArgs checks the kernel arguments of the KernelArgsConfiguration.
+func ValidateKernelArgs(add, remove []string) error {
+	var errs []error
+
+	for _, arg := range add {
+		name, _, _ := strings.Cut(arg, "=")
+
+		if err := validateKernelArgName(name); err != nil {
+			errs = append(errs, fmt.Errorf("kernel argument %q can't be added: %w", arg, err))
+		}
+
+		if strings.ContainsFunc(arg, unicode.IsSpace) {
+			errs = append(errs, fmt.Errorf("kernel argument %q can't be added: whitespace is not allowed", arg))
+		}
+	}
+
+	for _, name := range remove {
+		if err := validateKernelArgName(name); err != nil {
+			errs = append(errs, fmt.Errorf("kernel argument %q can't be removed: %w", name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func validateKernelArgName(name string) error {
+	switch {
+	case name == "":
+		return errors.New("the name is empty")
+	case strings.HasPrefix(name, "-"):
+		return errors.New("the name can't start with a dash")
+	case strings.ContainsFunc(name, func(r rune) bool {
+		return !(r == '.' || r == '_' || r == '-' || (r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r))))
+	}):
+		return errors.New("the name contains invalid characters")
+	}
+
+	for _, denied := range DeniedKernelArgs {
+		if name == denied {
+			return errors.New("the argument is managed by Talos or Omni")
+		}
+	}
+
+	return nil
+}
+
+// RenderKernelArgs converts the kernel arguments configurations to the extra kernel arguments of the schematic.
+//
+// The configurations are applied in order, the removed arguments are rendered with the dash prefix.
+func RenderKernelArgs(configurations ...*KernelArgsConfiguration) []string {
+	var args []string
+
+	for _, configuration := range configurations {
+		if configuration == nil {
+			continue
+		}
+
+		for _, name := range configuration.TypedSpec().Value.Remove {
+			args = append(args, "-"+name)
+		}
+
+		args = append(args, configuration.TypedSpec().Value.Add...)
+	}
+
+	return args
+}
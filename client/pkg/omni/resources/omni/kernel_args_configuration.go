@@ -0,0 +1,59 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewKernelArgsConfiguration creates new kernel arguments configuration resource.
+func NewKernelArgsConfiguration(ns string, id resource.ID) *KernelArgsConfiguration {
+	return typed.NewResource[KernelArgsConfigurationSpec, KernelArgsConfigurationExtension](
+		resource.NewMetadata(ns, KernelArgsConfigurationType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.KernelArgsConfigurationSpec{}),
+	)
+}
+
+const (
+	// KernelArgsConfigurationType is the type of the KernelArgsConfiguration resource.
+	// tsgen:KernelArgsConfigurationType
+	KernelArgsConfigurationType = resource.Type("KernelArgsConfigurations.omni.sidero.dev")
+)
+
+// KernelArgsConfiguration describes the kernel arguments added and removed for a particular cluster or machine set.
+//
+// The machine set configuration is applied on top of the cluster configuration.
+type KernelArgsConfiguration = typed.Resource[KernelArgsConfigurationSpec, KernelArgsConfigurationExtension]
+
+// KernelArgsConfigurationSpec wraps specs.KernelArgsConfigurationSpec.
+type KernelArgsConfigurationSpec = protobuf.ResourceSpec[specs.KernelArgsConfigurationSpec, *specs.KernelArgsConfigurationSpec]
+
+// KernelArgsConfigurationExtension provides auxiliary methods for KernelArgsConfiguration resource.
+type KernelArgsConfigurationExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (KernelArgsConfigurationExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             KernelArgsConfigurationType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Add",
+				JSONPath: "{.add}",
+			},
+			{
+				Name:     "Remove",
+				JSONPath: "{.remove}",
+			},
+		},
+	}
+}
@@ -0,0 +1,88 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+func TestValidateKernelArgs(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct { //nolint:govet
+		name        string
+		add         []string
+		remove      []string
+		expectedErr string
+	}{
+		{
+			name:   "valid",
+			add:    []string{"console=ttyS0,115200", "net.ifnames=0", "nomodeset"},
+			remove: []string{"console", "slab_nomerge"},
+		},
+		{
+			name:        "empty",
+			add:         []string{""},
+			expectedErr: `kernel argument "" can't be added: the name is empty`,
+		},
+		{
+			name:        "whitespace",
+			add:         []string{"console=tty0 init=/bin/sh"},
+			expectedErr: `kernel argument "console=tty0 init=/bin/sh" can't be added: whitespace is not allowed`,
+		},
+		{
+			name:        "dash prefix",
+			add:         []string{"-console"},
+			expectedErr: `kernel argument "-console" can't be added: the name can't start with a dash`,
+		},
+		{
+			name:        "denied add",
+			add:         []string{"talos.platform=metal"},
+			expectedErr: `kernel argument "talos.platform=metal" can't be added: the argument is managed by Talos or Omni`,
+		},
+		{
+			name:        "denied remove",
+			remove:      []string{"siderolink.api"},
+			expectedErr: `kernel argument "siderolink.api" can't be removed: the argument is managed by Talos or Omni`,
+		},
+		{
+			name:        "remove with value",
+			remove:      []string{"console=tty0"},
+			expectedErr: `kernel argument "console=tty0" can't be removed: the name contains invalid characters`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := omni.ValidateKernelArgs(test.add, test.remove)
+			if test.expectedErr != "" {
+				require.ErrorContains(t, err, test.expectedErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRenderKernelArgs(t *testing.T) {
+	t.Parallel()
+
+	cluster := omni.NewKernelArgsConfiguration(resources.DefaultNamespace, "cluster")
+	cluster.TypedSpec().Value.Add = []string{"console=ttyS0"}
+	cluster.TypedSpec().Value.Remove = []string{"console"}
+
+	machineSet := omni.NewKernelArgsConfiguration(resources.DefaultNamespace, "machine-set")
+	machineSet.TypedSpec().Value.Add = []string{"nomodeset"}
+
+	require.Equal(t, []string{"-console", "console=ttyS0", "nomodeset"}, omni.RenderKernelArgs(cluster, nil, machineSet))
+	require.Empty(t, omni.RenderKernelArgs())
+}
@@ -113,6 +113,14 @@ const (
 	// tsgen:MachineStatusLabelNet
 	MachineStatusLabelNet = SystemLabelPrefix + "net"
 
+	// MachineStatusLabelGPUVendor describes the vendor of the machine GPUs.
+	// tsgen:MachineStatusLabelGPUVendor
+	MachineStatusLabelGPUVendor = SystemLabelPrefix + "gpu-vendor"
+
+	// MachineStatusLabelGPUs describes the number of the machine GPUs.
+	// tsgen:MachineStatusLabelGPUs
+	MachineStatusLabelGPUs = SystemLabelPrefix + "gpus"
+
 	// MachineStatusLabelPlatform describes the machine platform.
 	// tsgen:MachineStatusLabelPlatform
 	MachineStatusLabelPlatform = SystemLabelPrefix + LabelSuffixPlatform
@@ -132,6 +140,18 @@ const (
 	// MachineStatusLabelTalosVersion describes the machine talos version.
 	// tsgen:MachineStatusLabelTalosVersion
 	MachineStatusLabelTalosVersion = SystemLabelPrefix + "talos-version"
+
+	// MachineStatusLabelMaintenance is set if the machine is running in the maintenance mode.
+	// tsgen:MachineStatusLabelMaintenance
+	MachineStatusLabelMaintenance = SystemLabelPrefix + "maintenance"
+)
+
+const (
+	// MachineBootHistory labels.
+
+	// MachineBootHistoryLabelCrashLoop is set if the machine is rebooting in a loop.
+	// tsgen:MachineBootHistoryLabelCrashLoop
+	MachineBootHistoryLabelCrashLoop = SystemLabelPrefix + "crash-loop"
 )
 
 const (
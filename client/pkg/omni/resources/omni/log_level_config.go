@@ -0,0 +1,57 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewLogLevelConfig creates new LogLevelConfig resource.
+func NewLogLevelConfig() *LogLevelConfig {
+	return typed.NewResource[LogLevelConfigSpec, LogLevelConfigExtension](
+		resource.NewMetadata(resources.DefaultNamespace, LogLevelConfigType, LogLevelConfigID, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.LogLevelConfigSpec{}),
+	)
+}
+
+const (
+	// LogLevelConfigID is the ID of the LogLevelConfig resource.
+	// tsgen:LogLevelConfigID
+	LogLevelConfigID = resource.ID("log-levels")
+
+	// LogLevelConfigType is the type of the LogLevelConfig resource.
+	// tsgen:LogLevelConfigType
+	LogLevelConfigType = resource.Type("LogLevelConfigs.omni.sidero.dev")
+)
+
+// LogLevelConfig overrides the log levels of the Omni subsystems, the changes are applied without a restart.
+type LogLevelConfig = typed.Resource[LogLevelConfigSpec, LogLevelConfigExtension]
+
+// LogLevelConfigSpec wraps specs.LogLevelConfigSpec.
+type LogLevelConfigSpec = protobuf.ResourceSpec[specs.LogLevelConfigSpec, *specs.LogLevelConfigSpec]
+
+// LogLevelConfigExtension provides auxiliary methods for LogLevelConfig resource.
+type LogLevelConfigExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (LogLevelConfigExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             LogLevelConfigType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Levels",
+				JSONPath: "{.levels}",
+			},
+		},
+	}
+}
@@ -0,0 +1,57 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewMachineBootHistory creates new MachineBootHistory resource.
+func NewMachineBootHistory(ns string, id resource.ID) *MachineBootHistory {
+	return typed.NewResource[MachineBootHistorySpec, MachineBootHistoryExtension](
+		resource.NewMetadata(ns, MachineBootHistoryType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.MachineBootHistorySpec{}),
+	)
+}
+
+const (
+	// MachineBootHistoryType is the type of the MachineBootHistory resource.
+	// tsgen:MachineBootHistoryType
+	MachineBootHistoryType = resource.Type("MachineBootHistories.omni.sidero.dev")
+)
+
+// MachineBootHistory keeps the latest boots of the machine and reports if the machine is rebooting in a loop.
+type MachineBootHistory = typed.Resource[MachineBootHistorySpec, MachineBootHistoryExtension]
+
+// MachineBootHistorySpec wraps specs.MachineBootHistorySpec.
+type MachineBootHistorySpec = protobuf.ResourceSpec[specs.MachineBootHistorySpec, *specs.MachineBootHistorySpec]
+
+// MachineBootHistoryExtension provides auxiliary methods for MachineBootHistory resource.
+type MachineBootHistoryExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (MachineBootHistoryExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             MachineBootHistoryType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Crash Loop",
+				JSONPath: "{.crashloop}",
+			},
+			{
+				Name:     "Crash Loop Since",
+				JSONPath: "{.crashloopsince}",
+			},
+		},
+	}
+}
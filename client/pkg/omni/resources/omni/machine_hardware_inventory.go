@@ -0,0 +1,77 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"strconv"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewMachineHardwareInventory creates new MachineHardwareInventory resource.
+func NewMachineHardwareInventory(ns string, id resource.ID) *MachineHardwareInventory {
+	return typed.NewResource[MachineHardwareInventorySpec, MachineHardwareInventoryExtension](
+		resource.NewMetadata(ns, MachineHardwareInventoryType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.MachineHardwareInventorySpec{}),
+	)
+}
+
+const (
+	// MachineHardwareInventoryType is the type of the MachineHardwareInventory resource.
+	// tsgen:MachineHardwareInventoryType
+	MachineHardwareInventoryType = resource.Type("MachineHardwareInventories.omni.sidero.dev")
+)
+
+// MachineHardwareInventory describes the PCI devices, the GPUs, the NVMe drives and the NUMA topology of the machine.
+type MachineHardwareInventory = typed.Resource[MachineHardwareInventorySpec, MachineHardwareInventoryExtension]
+
+// MachineHardwareInventorySpec wraps specs.MachineHardwareInventorySpec.
+type MachineHardwareInventorySpec = protobuf.ResourceSpec[specs.MachineHardwareInventorySpec, *specs.MachineHardwareInventorySpec]
+
+// MachineHardwareInventoryExtension provides auxiliary methods for MachineHardwareInventory resource.
+type MachineHardwareInventoryExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (MachineHardwareInventoryExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             MachineHardwareInventoryType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns:     []meta.PrintColumn{},
+	}
+}
+
+// MachineStatusReconcileGPULabels sets the GPU labels of the machine status from the hardware inventory of the machine.
+func MachineStatusReconcileGPULabels(machineStatus *MachineStatus, inventory *specs.MachineHardwareInventorySpec) {
+	labels := machineStatus.Metadata().Labels()
+
+	setLabel(labels, MachineStatusLabelGPUs, func() string {
+		if len(inventory.GetGpus()) == 0 {
+			return ""
+		}
+
+		return strconv.Itoa(len(inventory.GetGpus()))
+	})
+
+	setLabel(labels, MachineStatusLabelGPUVendor, func() string {
+		for _, gpu := range inventory.GetGpus() {
+			if gpu.GetVendor() != "" {
+				return gpu.GetVendor()
+			}
+		}
+
+		if len(inventory.GetGpus()) > 0 {
+			return inventory.GetGpus()[0].GetVendorId()
+		}
+
+		return ""
+	})
+}
@@ -0,0 +1,65 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewMachineLinkStatus creates new MachineLinkStatus resource.
+func NewMachineLinkStatus(ns string, id resource.ID) *MachineLinkStatus {
+	return typed.NewResource[MachineLinkStatusSpec, MachineLinkStatusExtension](
+		resource.NewMetadata(ns, MachineLinkStatusType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.MachineLinkStatusSpec{}),
+	)
+}
+
+const (
+	// MachineLinkStatusType is the type of the MachineLinkStatus resource.
+	// tsgen:MachineLinkStatusType
+	MachineLinkStatusType = resource.Type("MachineLinkStatuses.omni.sidero.dev")
+)
+
+// MachineLinkStatus describes the quality of the SideroLink connection of the machine.
+type MachineLinkStatus = typed.Resource[MachineLinkStatusSpec, MachineLinkStatusExtension]
+
+// MachineLinkStatusSpec wraps specs.MachineLinkStatusSpec.
+type MachineLinkStatusSpec = protobuf.ResourceSpec[specs.MachineLinkStatusSpec, *specs.MachineLinkStatusSpec]
+
+// MachineLinkStatusExtension provides auxiliary methods for MachineLinkStatus resource.
+type MachineLinkStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (MachineLinkStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             MachineLinkStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.EphemeralNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Connected",
+				JSONPath: "{.connected}",
+			},
+			{
+				Name:     "Last Handshake",
+				JSONPath: "{.lasthandshake}",
+			},
+			{
+				Name:     "Keepalive Latency",
+				JSONPath: "{.keepalivelatency}",
+			},
+			{
+				Name:     "Flaps",
+				JSONPath: "{.flaps}",
+			},
+		},
+	}
+}
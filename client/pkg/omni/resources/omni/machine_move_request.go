@@ -0,0 +1,90 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/blang/semver"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewMachineMoveRequest creates new MachineMoveRequest resource.
+//
+// The ID of the resource is the ID of the machine to move.
+func NewMachineMoveRequest(ns string, id resource.ID) *MachineMoveRequest {
+	return typed.NewResource[MachineMoveRequestSpec, MachineMoveRequestExtension](
+		resource.NewMetadata(ns, MachineMoveRequestType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.MachineMoveRequestSpec{}),
+	)
+}
+
+const (
+	// MachineMoveRequestType is the type of the MachineMoveRequest resource.
+	// tsgen:MachineMoveRequestType
+	MachineMoveRequestType = resource.Type("MachineMoveRequests.omni.sidero.dev")
+)
+
+// MachineMoveRequest requests moving a worker machine from its current cluster to another one.
+type MachineMoveRequest = typed.Resource[MachineMoveRequestSpec, MachineMoveRequestExtension]
+
+// MachineMoveRequestSpec wraps specs.MachineMoveRequestSpec.
+type MachineMoveRequestSpec = protobuf.ResourceSpec[specs.MachineMoveRequestSpec, *specs.MachineMoveRequestSpec]
+
+// MachineMoveRequestExtension provides auxiliary methods for MachineMoveRequest resource.
+type MachineMoveRequestExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (MachineMoveRequestExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             MachineMoveRequestType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Target Cluster",
+				JSONPath: "{.targetcluster}",
+			},
+			{
+				Name:     "Target Machine Set",
+				JSONPath: "{.targetmachineset}",
+			},
+		},
+	}
+}
+
+// ValidateMachineMove checks that the machine can be moved to the cluster by resetting it and joining it with the new cluster config.
+//
+// The machine should be installed to the disk and should not run a newer Talos version than the cluster,
+// as the machines are never downgraded. Otherwise, the machine needs a full reinstall.
+func ValidateMachineMove(machineStatus *MachineStatus, cluster *Cluster) error {
+	if GetMachineStatusSystemDisk(machineStatus) == "" {
+		return errors.New("machine is not installed to the disk")
+	}
+
+	machineVersion, err := semver.ParseTolerant(strings.TrimPrefix(machineStatus.TypedSpec().Value.TalosVersion, "v"))
+	if err != nil {
+		return fmt.Errorf("failed to parse machine Talos version: %w", err)
+	}
+
+	clusterVersion, err := semver.ParseTolerant(cluster.TypedSpec().Value.TalosVersion)
+	if err != nil {
+		return fmt.Errorf("failed to parse cluster Talos version: %w", err)
+	}
+
+	if machineVersion.Major > clusterVersion.Major || (machineVersion.Major == clusterVersion.Major && machineVersion.Minor > clusterVersion.Minor) {
+		return fmt.Errorf("machine Talos version %s is newer than the cluster Talos version %s", machineVersion, clusterVersion)
+	}
+
+	return nil
+}
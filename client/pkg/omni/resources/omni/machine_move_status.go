@@ -0,0 +1,65 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewMachineMoveStatus creates new MachineMoveStatus resource.
+func NewMachineMoveStatus(ns string, id resource.ID) *MachineMoveStatus {
+	return typed.NewResource[MachineMoveStatusSpec, MachineMoveStatusExtension](
+		resource.NewMetadata(ns, MachineMoveStatusType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.MachineMoveStatusSpec{}),
+	)
+}
+
+const (
+	// MachineMoveStatusType is the type of the MachineMoveStatus resource.
+	// tsgen:MachineMoveStatusType
+	MachineMoveStatusType = resource.Type("MachineMoveStatuses.omni.sidero.dev")
+)
+
+// MachineMoveStatus reports the progress of the MachineMoveRequest.
+type MachineMoveStatus = typed.Resource[MachineMoveStatusSpec, MachineMoveStatusExtension]
+
+// MachineMoveStatusSpec wraps specs.MachineMoveStatusSpec.
+type MachineMoveStatusSpec = protobuf.ResourceSpec[specs.MachineMoveStatusSpec, *specs.MachineMoveStatusSpec]
+
+// MachineMoveStatusExtension provides auxiliary methods for MachineMoveStatus resource.
+type MachineMoveStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (MachineMoveStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             MachineMoveStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Phase",
+				JSONPath: "{.phase}",
+			},
+			{
+				Name:     "Source Cluster",
+				JSONPath: "{.sourcecluster}",
+			},
+			{
+				Name:     "Target Cluster",
+				JSONPath: "{.targetcluster}",
+			},
+			{
+				Name:     "Error",
+				JSONPath: "{.error}",
+			},
+		},
+	}
+}
@@ -0,0 +1,53 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewMachineSetDefaultExtensions creates new MachineSetDefaultExtensions resource.
+func NewMachineSetDefaultExtensions(ns string, id resource.ID) *MachineSetDefaultExtensions {
+	return typed.NewResource[MachineSetDefaultExtensionsSpec, MachineSetDefaultExtensionsExtension](
+		resource.NewMetadata(ns, MachineSetDefaultExtensionsType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.MachineSetDefaultExtensionsSpec{}),
+	)
+}
+
+const (
+	// MachineSetDefaultExtensionsType is the type of the MachineSetDefaultExtensions resource.
+	// tsgen:MachineSetDefaultExtensionsType
+	MachineSetDefaultExtensionsType = resource.Type("MachineSetDefaultExtensions.omni.sidero.dev")
+)
+
+// MachineSetDefaultExtensions is the list of the default extensions captured when the machine set was created.
+type MachineSetDefaultExtensions = typed.Resource[MachineSetDefaultExtensionsSpec, MachineSetDefaultExtensionsExtension]
+
+// MachineSetDefaultExtensionsSpec wraps specs.MachineSetDefaultExtensionsSpec.
+type MachineSetDefaultExtensionsSpec = protobuf.ResourceSpec[specs.MachineSetDefaultExtensionsSpec, *specs.MachineSetDefaultExtensionsSpec]
+
+// MachineSetDefaultExtensionsExtension provides auxiliary methods for MachineSetDefaultExtensions resource.
+type MachineSetDefaultExtensionsExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (MachineSetDefaultExtensionsExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             MachineSetDefaultExtensionsType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Extensions",
+				JSONPath: "{.extensions}",
+			},
+		},
+	}
+}
@@ -0,0 +1,48 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewMachineSetScalingHistory creates new MachineSetScalingHistory resource.
+func NewMachineSetScalingHistory(ns string, id resource.ID) *MachineSetScalingHistory {
+	return typed.NewResource[MachineSetScalingHistorySpec, MachineSetScalingHistoryExtension](
+		resource.NewMetadata(ns, MachineSetScalingHistoryType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.MachineSetScalingHistorySpec{}),
+	)
+}
+
+const (
+	// MachineSetScalingHistoryType is the type of the MachineSetScalingHistory resource.
+	// tsgen:MachineSetScalingHistoryType
+	MachineSetScalingHistoryType = resource.Type("MachineSetScalingHistories.omni.sidero.dev")
+)
+
+// MachineSetScalingHistory keeps the history of the machine set scaling events.
+type MachineSetScalingHistory = typed.Resource[MachineSetScalingHistorySpec, MachineSetScalingHistoryExtension]
+
+// MachineSetScalingHistorySpec wraps specs.MachineSetScalingHistorySpec.
+type MachineSetScalingHistorySpec = protobuf.ResourceSpec[specs.MachineSetScalingHistorySpec, *specs.MachineSetScalingHistorySpec]
+
+// MachineSetScalingHistoryExtension provides auxiliary methods for MachineSetScalingHistory resource.
+type MachineSetScalingHistoryExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (MachineSetScalingHistoryExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             MachineSetScalingHistoryType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns:     []meta.PrintColumn{},
+	}
+}
@@ -212,6 +212,12 @@ func MachineStatusReconcileLabels(machineStatus *MachineStatus) {
 	setLabel(labels, MachineStatusLabelTalosVersion, func() string {
 		return machineStatus.TypedSpec().Value.TalosVersion
 	})
+
+	if machineStatus.TypedSpec().Value.Maintenance {
+		labels.Set(MachineStatusLabelMaintenance, "")
+	} else {
+		labels.Delete(MachineStatusLabelMaintenance)
+	}
 }
 
 // GetMachineStatusSystemDisk looks up a system disk for the Talos machine.
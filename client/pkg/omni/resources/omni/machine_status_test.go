@@ -125,6 +125,17 @@ func TestMachineStatusReconcileLabels(t *testing.T) {
 				omni.MachineStatusLabelNet:   "1Gbps",
 			},
 		},
+		{
+			name: "maintenance",
+			spec: &specs.MachineStatusSpec{
+				TalosVersion: "v1.8.0",
+				Maintenance:  true,
+			},
+			want: map[string]string{
+				omni.MachineStatusLabelTalosVersion: "v1.8.0",
+				omni.MachineStatusLabelMaintenance:  "",
+			},
+		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
@@ -140,6 +151,69 @@ func TestMachineStatusReconcileLabels(t *testing.T) {
 	}
 }
 
+func TestMachineStatusReconcileGPULabels(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct { //nolint:govet
+		name      string
+		inventory *specs.MachineHardwareInventorySpec
+		want      map[string]string
+	}{
+		{
+			name:      "no gpus",
+			inventory: &specs.MachineHardwareInventorySpec{},
+			want:      map[string]string{},
+		},
+		{
+			name: "known vendor",
+			inventory: &specs.MachineHardwareInventorySpec{
+				Gpus: []*specs.MachineHardwareInventorySpec_PCIDevice{
+					{
+						VendorId: "10de",
+						Vendor:   "nvidia",
+					},
+					{
+						VendorId: "10de",
+						Vendor:   "nvidia",
+					},
+				},
+			},
+			want: map[string]string{
+				omni.MachineStatusLabelGPUs:      "2",
+				omni.MachineStatusLabelGPUVendor: "nvidia",
+			},
+		},
+		{
+			name: "unknown vendor",
+			inventory: &specs.MachineHardwareInventorySpec{
+				Gpus: []*specs.MachineHardwareInventorySpec_PCIDevice{
+					{
+						VendorId: "1e52",
+					},
+				},
+			},
+			want: map[string]string{
+				omni.MachineStatusLabelGPUs:      "1",
+				omni.MachineStatusLabelGPUVendor: "1e52",
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ms := omni.NewMachineStatus("", "")
+
+			// the labels of the removed GPUs are cleaned up
+			ms.Metadata().Labels().Set(omni.MachineStatusLabelGPUs, "4")
+			ms.Metadata().Labels().Set(omni.MachineStatusLabelGPUVendor, "amd")
+
+			omni.MachineStatusReconcileGPULabels(ms, test.inventory)
+
+			assert.Equal(t, test.want, ms.Metadata().Labels().Raw())
+		})
+	}
+}
+
 func TestLookup(t *testing.T) {
 	ms := omni.NewMachineStatus("", "")
 	ms.TypedSpec().Value = &specs.MachineStatusSpec{
@@ -0,0 +1,61 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewMachineWipeStatus creates new MachineWipeStatus resource.
+func NewMachineWipeStatus(ns string, id resource.ID) *MachineWipeStatus {
+	return typed.NewResource[MachineWipeStatusSpec, MachineWipeStatusExtension](
+		resource.NewMetadata(ns, MachineWipeStatusType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.MachineWipeStatusSpec{}),
+	)
+}
+
+const (
+	// MachineWipeStatusType is the type of the MachineWipeStatus resource.
+	// tsgen:MachineWipeStatusType
+	MachineWipeStatusType = resource.Type("MachineWipeStatuses.omni.sidero.dev")
+)
+
+// MachineWipeStatus is the result of the verification that the machine was wiped after it was removed from the cluster.
+type MachineWipeStatus = typed.Resource[MachineWipeStatusSpec, MachineWipeStatusExtension]
+
+// MachineWipeStatusSpec wraps specs.MachineWipeStatusSpec.
+type MachineWipeStatusSpec = protobuf.ResourceSpec[specs.MachineWipeStatusSpec, *specs.MachineWipeStatusSpec]
+
+// MachineWipeStatusExtension provides auxiliary methods for MachineWipeStatus resource.
+type MachineWipeStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (MachineWipeStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             MachineWipeStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "State",
+				JSONPath: "{.state}",
+			},
+			{
+				Name:     "Cluster",
+				JSONPath: "{.cluster}",
+			},
+			{
+				Name:     "Verified At",
+				JSONPath: "{.verifiedat}",
+			},
+		},
+	}
+}
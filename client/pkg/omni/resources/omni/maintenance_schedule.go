@@ -0,0 +1,226 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+)
+
+// maxScheduleLookahead limits the search of the next schedule time, the schedules like "0 0 30 2 *" never match.
+const maxScheduleLookahead = 5 * 366 * 24 * time.Hour
+
+// MaintenanceSchedule is the parsed cron schedule of the maintenance window starts.
+type MaintenanceSchedule struct {
+	minute, hour, dayOfMonth, month, dayOfWeek uint64
+
+	// the day matches either the day of month or the day of week if both are restricted, as in cron
+	anyDayOfMonth, anyDayOfWeek bool
+}
+
+type scheduleField struct {
+	name     string
+	min, max int
+}
+
+var scheduleFields = []scheduleField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	{name: "day of week", min: 0, max: 7},
+}
+
+// ParseMaintenanceSchedule parses the cron expression in the "minute hour day-of-month month day-of-week" form.
+//
+// Each field is "*", a number, a range "a-b", a step "*/n" or "a-b/n", or a comma separated list of them.
+// Both 0 and 7 are Sunday.
+func ParseMaintenanceSchedule(expr string) (*MaintenanceSchedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != len(scheduleFields) {
+		return nil, fmt.Errorf("schedule %q must have %d fields, got %d", expr, len(scheduleFields), len(fields))
+	}
+
+	bits := make([]uint64, len(fields))
+
+	for i, field := range fields {
+		var err error
+
+		bits[i], err = parseScheduleField(field, scheduleFields[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule %q: %w", expr, err)
+		}
+	}
+
+	// Sunday is both 0 and 7
+	if bits[4]&(1<<7) != 0 {
+		bits[4] |= 1
+	}
+
+	return &MaintenanceSchedule{
+		minute:        bits[0],
+		hour:          bits[1],
+		dayOfMonth:    bits[2],
+		month:         bits[3],
+		dayOfWeek:     bits[4],
+		anyDayOfMonth: fields[2] == "*",
+		anyDayOfWeek:  fields[4] == "*",
+	}, nil
+}
+
+func parseScheduleField(value string, field scheduleField) (uint64, error) {
+	var bits uint64
+
+	for _, part := range strings.Split(value, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+
+		step := 1
+
+		if hasStep {
+			var err error
+
+			step, err = strconv.Atoi(stepPart)
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step %q of the %s", stepPart, field.name)
+			}
+		}
+
+		from, to := field.min, field.max
+
+		if rangePart != "*" {
+			fromPart, toPart, isRange := strings.Cut(rangePart, "-")
+
+			var err error
+
+			if from, err = parseScheduleValue(fromPart, field); err != nil {
+				return 0, err
+			}
+
+			to = from
+
+			if isRange {
+				if to, err = parseScheduleValue(toPart, field); err != nil {
+					return 0, err
+				}
+			} else if hasStep {
+				to = field.max
+			}
+
+			if from > to {
+				return 0, fmt.Errorf("invalid range %q of the %s", rangePart, field.name)
+			}
+		}
+
+		for v := from; v <= to; v += step {
+			bits |= 1 << v
+		}
+	}
+
+	return bits, nil
+}
+
+func parseScheduleValue(value string, field scheduleField) (int, error) {
+	v, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q", field.name, value)
+	}
+
+	if v < field.min || v > field.max {
+		return 0, fmt.Errorf("%s %d is out of the range %d-%d", field.name, v, field.min, field.max)
+	}
+
+	return v, nil
+}
+
+// Next returns the first time after t which matches the schedule, the zero time is returned if there is no such time.
+//
+// The result is in the location of t.
+func (s *MaintenanceSchedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	limit := t.Add(maxScheduleLookahead)
+
+	t = t.Truncate(time.Minute).Add(time.Minute)
+
+	for t.Before(limit) {
+		switch {
+		case s.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+		case !s.matchDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+		case s.hour&(1<<uint(t.Hour())) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+		case s.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
+func (s *MaintenanceSchedule) matchDay(t time.Time) bool {
+	dayOfMonth := s.dayOfMonth&(1<<uint(t.Day())) != 0
+	dayOfWeek := s.dayOfWeek&(1<<uint(t.Weekday())) != 0
+
+	if s.anyDayOfMonth || s.anyDayOfWeek {
+		return dayOfMonth && dayOfWeek
+	}
+
+	return dayOfMonth || dayOfWeek
+}
+
+// ValidateMaintenanceWindow checks the schedule, the duration and the time zone of the MaintenanceWindow.
+func ValidateMaintenanceWindow(spec *specs.MaintenanceWindowSpec) error {
+	var errs []error
+
+	schedule, err := ParseMaintenanceSchedule(spec.GetSchedule())
+	if err != nil {
+		errs = append(errs, err)
+	} else if schedule.Next(time.Now()).IsZero() {
+		errs = append(errs, fmt.Errorf("schedule %q never matches", spec.GetSchedule()))
+	}
+
+	if spec.GetDuration().AsDuration() < time.Minute {
+		errs = append(errs, errors.New("maintenance window duration must be at least a minute"))
+	}
+
+	if _, err = time.LoadLocation(spec.GetTimezone()); err != nil {
+		errs = append(errs, fmt.Errorf("invalid time zone %q: %w", spec.GetTimezone(), err))
+	}
+
+	return errors.Join(errs...)
+}
+
+// MaintenanceWindowOpen checks if the maintenance window is open at the given time.
+//
+// The returned time is the end of the window if it's open, and the start of the next window if it's closed,
+// it's zero if the window never opens again.
+func MaintenanceWindowOpen(spec *specs.MaintenanceWindowSpec, now time.Time) (bool, time.Time, error) {
+	schedule, err := ParseMaintenanceSchedule(spec.GetSchedule())
+	if err != nil {
+		return false, time.Time{}, err
+	}
+
+	loc, err := time.LoadLocation(spec.GetTimezone())
+	if err != nil {
+		return false, time.Time{}, fmt.Errorf("invalid time zone %q: %w", spec.GetTimezone(), err)
+	}
+
+	now = now.In(loc)
+	duration := spec.GetDuration().AsDuration()
+
+	// the window is open if it started within the duration before now
+	if start := schedule.Next(now.Add(-duration)); !start.IsZero() && !start.After(now) {
+		return true, start.Add(duration), nil
+	}
+
+	return false, schedule.Next(now), nil
+}
@@ -0,0 +1,123 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+func TestMaintenanceScheduleNext(t *testing.T) {
+	t.Parallel()
+
+	// Wednesday
+	now := time.Date(2024, 5, 15, 10, 30, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		schedule string
+		expected time.Time
+	}{
+		{
+			schedule: "* * * * *",
+			expected: time.Date(2024, 5, 15, 10, 31, 0, 0, time.UTC),
+		},
+		{
+			schedule: "0 2 * * *",
+			expected: time.Date(2024, 5, 16, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			schedule: "*/20 10-11 * * *",
+			expected: time.Date(2024, 5, 15, 10, 40, 0, 0, time.UTC),
+		},
+		{
+			schedule: "0 22 * * 6,7",
+			expected: time.Date(2024, 5, 18, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			schedule: "0 0 1 * *",
+			expected: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			// the day of month or the day of week
+			schedule: "0 0 1 * 0",
+			expected: time.Date(2024, 5, 19, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			schedule: "0 0 29 2 *",
+			expected: time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			schedule: "0 0 30 2 *",
+		},
+	} {
+		t.Run(tt.schedule, func(t *testing.T) {
+			t.Parallel()
+
+			schedule, err := omni.ParseMaintenanceSchedule(tt.schedule)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, schedule.Next(now))
+		})
+	}
+}
+
+func TestParseMaintenanceScheduleErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, schedule := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"mon * * * *",
+	} {
+		_, err := omni.ParseMaintenanceSchedule(schedule)
+		assert.Error(t, err, schedule)
+	}
+}
+
+func TestMaintenanceWindowOpen(t *testing.T) {
+	t.Parallel()
+
+	spec := &specs.MaintenanceWindowSpec{
+		Schedule: "0 2 * * *",
+		Duration: durationpb.New(3 * time.Hour),
+		Timezone: "Europe/Berlin",
+	}
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	open, next, err := omni.MaintenanceWindowOpen(spec, time.Date(2024, 5, 15, 3, 0, 0, 0, berlin))
+	require.NoError(t, err)
+
+	assert.True(t, open)
+	assert.True(t, next.Equal(time.Date(2024, 5, 15, 5, 0, 0, 0, berlin)))
+
+	open, next, err = omni.MaintenanceWindowOpen(spec, time.Date(2024, 5, 15, 5, 0, 0, 0, berlin))
+	require.NoError(t, err)
+
+	assert.False(t, open)
+	assert.True(t, next.Equal(time.Date(2024, 5, 16, 2, 0, 0, 0, berlin)))
+
+	require.NoError(t, omni.ValidateMaintenanceWindow(spec))
+
+	spec.Timezone = "Mars/Olympus"
+	spec.Duration = nil
+
+	require.Error(t, omni.ValidateMaintenanceWindow(spec))
+}
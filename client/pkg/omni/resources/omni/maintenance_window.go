@@ -0,0 +1,63 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewMaintenanceWindow creates new MaintenanceWindow resource.
+func NewMaintenanceWindow(ns string, id resource.ID) *MaintenanceWindow {
+	return typed.NewResource[MaintenanceWindowSpec, MaintenanceWindowExtension](
+		resource.NewMetadata(ns, MaintenanceWindowType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.MaintenanceWindowSpec{}),
+	)
+}
+
+const (
+	// MaintenanceWindowType is the type of the MaintenanceWindow resource.
+	// tsgen:MaintenanceWindowType
+	MaintenanceWindowType = resource.Type("MaintenanceWindows.omni.sidero.dev")
+)
+
+// MaintenanceWindow defines when the Talos and Kubernetes upgrades of the cluster can run, the upgrades are deferred until the window opens.
+//
+// The ID of the resource is the ID of the cluster.
+type MaintenanceWindow = typed.Resource[MaintenanceWindowSpec, MaintenanceWindowExtension]
+
+// MaintenanceWindowSpec wraps specs.MaintenanceWindowSpec.
+type MaintenanceWindowSpec = protobuf.ResourceSpec[specs.MaintenanceWindowSpec, *specs.MaintenanceWindowSpec]
+
+// MaintenanceWindowExtension provides auxiliary methods for MaintenanceWindow resource.
+type MaintenanceWindowExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (MaintenanceWindowExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             MaintenanceWindowType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Schedule",
+				JSONPath: "{.schedule}",
+			},
+			{
+				Name:     "Duration",
+				JSONPath: "{.duration}",
+			},
+			{
+				Name:     "Timezone",
+				JSONPath: "{.timezone}",
+			},
+		},
+	}
+}
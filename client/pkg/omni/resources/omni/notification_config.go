@@ -0,0 +1,57 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewNotificationConfig creates new NotificationConfig resource.
+func NewNotificationConfig(id resource.ID) *NotificationConfig {
+	return typed.NewResource[NotificationConfigSpec, NotificationConfigExtension](
+		resource.NewMetadata(resources.DefaultNamespace, NotificationConfigType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.NotificationConfigSpec{}),
+	)
+}
+
+const (
+	// NotificationConfigType is the type of the NotificationConfig resource.
+	// tsgen:NotificationConfigType
+	NotificationConfigType = resource.Type("NotificationConfigs.omni.sidero.dev")
+)
+
+// NotificationConfig configures a webhook notified about the cluster lifecycle events.
+type NotificationConfig = typed.Resource[NotificationConfigSpec, NotificationConfigExtension]
+
+// NotificationConfigSpec wraps specs.NotificationConfigSpec.
+type NotificationConfigSpec = protobuf.ResourceSpec[specs.NotificationConfigSpec, *specs.NotificationConfigSpec]
+
+// NotificationConfigExtension provides auxiliary methods for NotificationConfig resource.
+type NotificationConfigExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (NotificationConfigExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             NotificationConfigType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "URL",
+				JSONPath: "{.url}",
+			},
+			{
+				Name:     "Format",
+				JSONPath: "{.format}",
+			},
+		},
+	}
+}
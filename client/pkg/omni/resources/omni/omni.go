@@ -11,24 +11,33 @@ func init() {
 	registry.MustRegisterResource(ClusterBootstrapStatusType, &ClusterBootstrapStatus{})
 	registry.MustRegisterResource(ClusterConfigVersionType, &ClusterConfigVersion{})
 	registry.MustRegisterResource(ClusterEndpointType, &ClusterEndpoint{})
+	registry.MustRegisterResource(ClusterAvailabilityType, &ClusterAvailability{})
 	registry.MustRegisterResource(ClusterDestroyStatusType, &ClusterDestroyStatus{})
 	registry.MustRegisterResource(ClusterWorkloadProxyStatusType, &ClusterWorkloadProxyStatus{})
 	registry.MustRegisterResource(ClusterKubernetesNodesType, &ClusterKubernetesNodes{})
+	registry.MustRegisterResource(ClusterNodeVersionsType, &ClusterNodeVersions{})
 	registry.MustRegisterResource(ClusterType, &Cluster{})
 	registry.MustRegisterResource(ClusterUUIDType, &ClusterUUID{})
 	registry.MustRegisterResource(ClusterSecretsType, &ClusterSecrets{})
 	registry.MustRegisterResource(ClusterStatusType, &ClusterStatus{})
+	registry.MustRegisterResource(ClusterStatusHistoryType, &ClusterStatusHistory{})
 	registry.MustRegisterResource(ClusterMachineType, &ClusterMachine{})
 	registry.MustRegisterResource(ClusterMachineEncryptionKeyType, &ClusterMachineEncryptionKey{})
 	registry.MustRegisterResource(ClusterMachineIdentityType, &ClusterMachineIdentity{})
 	registry.MustRegisterResource(ClusterMachineStatusType, &ClusterMachineStatus{})
 	registry.MustRegisterResource(ClusterMachineConfigType, &ClusterMachineConfig{})
+	registry.MustRegisterResource(ClusterMachineConfigBackupType, &ClusterMachineConfigBackup{})
 	registry.MustRegisterResource(ClusterMachineConfigPatchesType, &ClusterMachineConfigPatches{})
 	registry.MustRegisterResource(ClusterMachineConfigStatusType, &ClusterMachineConfigStatus{})
 	registry.MustRegisterResource(ClusterMachineTalosVersionType, &ClusterMachineTalosVersion{})
 	registry.MustRegisterResource(ClusterMachineTemplateType, &ClusterMachineTemplate{})
 	registry.MustRegisterResource(ClusterTaintType, &ClusterTaint{})
 	registry.MustRegisterResource(ConfigPatchType, &ConfigPatch{})
+	registry.MustRegisterResource(ConfigPatchBlobType, &ConfigPatchBlob{})
+	registry.MustRegisterResource(DefaultExtensionsType, &DefaultExtensions{})
+	registry.MustRegisterResource(DiscoveryAffiliateType, &DiscoveryAffiliate{})
+	registry.MustRegisterResource(DiscoveryKeyRotationType, &DiscoveryKeyRotation{})
+	registry.MustRegisterResource(DiscoveryKeyRotationStatusType, &DiscoveryKeyRotationStatus{})
 	registry.MustRegisterResource(EtcdAuditResultType, &EtcdAuditResult{})
 	registry.MustRegisterResource(EtcdBackupType, &EtcdBackup{})
 	registry.MustRegisterResource(EtcdBackupS3ConfType, &EtcdBackupS3Conf{})
@@ -36,16 +45,19 @@ func init() {
 	registry.MustRegisterResource(EtcdBackupStatusType, &EtcdBackupStatus{})
 	registry.MustRegisterResource(EtcdBackupOverallStatusType, &EtcdBackupOverallStatus{})
 	registry.MustRegisterResource(EtcdBackupStoreStatusType, &EtcdBackupStoreStatus{})
+	registry.MustRegisterResource(EtcdBackupStorageConfigType, &EtcdBackupStorageConfig{})
 	registry.MustRegisterResource(ExtensionsConfigurationType, &ExtensionsConfiguration{})
 	registry.MustRegisterResource(ExtensionsConfigurationStatusType, &ExtensionsConfigurationStatus{})
 	registry.MustRegisterResource(BackupDataType, &BackupData{})
 	registry.MustRegisterResource(EtcdManualBackupType, &EtcdManualBackup{})
 	registry.MustRegisterResource(ExposedServiceType, &ExposedService{})
+	registry.MustRegisterResource(ExposedServiceAccessPolicyType, &ExposedServiceAccessPolicy{})
 	registry.MustRegisterResource(FeaturesConfigType, &FeaturesConfig{})
 	registry.MustRegisterResource(ImagePullRequestType, &ImagePullRequest{})
 	registry.MustRegisterResource(ImagePullStatusType, &ImagePullStatus{})
 	registry.MustRegisterResource(InstallationMediaType, &InstallationMedia{})
 	registry.MustRegisterResource(ControlPlaneStatusType, &ControlPlaneStatus{})
+	registry.MustRegisterResource(KernelArgsConfigurationType, &KernelArgsConfiguration{})
 	registry.MustRegisterResource(KubeconfigType, &Kubeconfig{})
 	registry.MustRegisterResource(KubernetesNodeAuditResultType, &KubernetesNodeAuditResult{})
 	registry.MustRegisterResource(KubernetesStatusType, &KubernetesStatus{})
@@ -53,27 +65,45 @@ func init() {
 	registry.MustRegisterResource(KubernetesUpgradeStatusType, &KubernetesUpgradeStatus{})
 	registry.MustRegisterResource(KubernetesVersionType, &KubernetesVersion{})
 	registry.MustRegisterResource(MachineLabelsType, &MachineLabels{})
+	registry.MustRegisterResource(MachineLinkStatusType, &MachineLinkStatus{})
+	registry.MustRegisterResource(MachineWipeStatusType, &MachineWipeStatus{})
 	registry.MustRegisterResource(MachineType, &Machine{})
+	registry.MustRegisterResource(MachineBootHistoryType, &MachineBootHistory{})
 	registry.MustRegisterResource(MachineClassType, &MachineClass{})
 	registry.MustRegisterResource(MachineConfigGenOptionsType, &MachineConfigGenOptions{})
 	registry.MustRegisterResource(MachineExtensionsStatusType, &MachineExtensionsStatus{})
 	registry.MustRegisterResource(MachineExtensionsType, &MachineExtensions{})
+	registry.MustRegisterResource(MachineHardwareInventoryType, &MachineHardwareInventory{})
+	registry.MustRegisterResource(MachineMoveRequestType, &MachineMoveRequest{})
+	registry.MustRegisterResource(MachineMoveStatusType, &MachineMoveStatus{})
 	registry.MustRegisterResource(MachineSetType, &MachineSet{})
+	registry.MustRegisterResource(MachineSetDefaultExtensionsType, &MachineSetDefaultExtensions{})
 	registry.MustRegisterResource(MachineSetDestroyStatusType, &MachineSetDestroyStatus{})
 	registry.MustRegisterResource(MachineSetNodeType, &MachineSetNode{})
 	registry.MustRegisterResource(MachineSetStatusType, &MachineSetStatus{})
+	registry.MustRegisterResource(MachineSetScalingHistoryType, &MachineSetScalingHistory{})
 	registry.MustRegisterResource(MachineStatusType, &MachineStatus{})
 	registry.MustRegisterResource(MachineStatusSnapshotType, &MachineStatusSnapshot{})
 	registry.MustRegisterResource(MachineStatusLinkType, &MachineStatusLink{})
 	registry.MustRegisterResource(MachineStatusMetricsType, &MachineStatusMetrics{})
+	registry.MustRegisterResource(MaintenanceWindowType, &MaintenanceWindow{})
 	registry.MustRegisterResource(LoadBalancerConfigType, &LoadBalancerConfig{})
 	registry.MustRegisterResource(LoadBalancerStatusType, &LoadBalancerStatus{})
+	registry.MustRegisterResource(LogLevelConfigType, &LogLevelConfig{})
+	registry.MustRegisterResource(NotificationConfigType, &NotificationConfig{})
 	registry.MustRegisterResource(OngoingTaskType, &OngoingTask{})
+	registry.MustRegisterResource(QuotaType, &Quota{})
 	registry.MustRegisterResource(RedactedClusterMachineConfigType, &RedactedClusterMachineConfig{})
+	registry.MustRegisterResource(RuntimeConfigurationType, &RuntimeConfiguration{})
 	registry.MustRegisterResource(SchematicType, &Schematic{})
 	registry.MustRegisterResource(SchematicConfigurationType, &SchematicConfiguration{})
+	registry.MustRegisterResource(SettingsType, &Settings{})
+	registry.MustRegisterResource(SchematicDriftStatusType, &SchematicDriftStatus{})
 	registry.MustRegisterResource(TalosConfigType, &TalosConfig{})
+	registry.MustRegisterResource(TalosSecretsRotationType, &TalosSecretsRotation{})
+	registry.MustRegisterResource(TalosSecretsRotationStatusType, &TalosSecretsRotationStatus{})
 	registry.MustRegisterResource(TalosExtensionsType, &TalosExtensions{})
 	registry.MustRegisterResource(TalosVersionType, &TalosVersion{})
 	registry.MustRegisterResource(TalosUpgradeStatusType, &TalosUpgradeStatus{})
+	registry.MustRegisterResource(TemplateSyncStatusType, &TemplateSyncStatus{})
 }
@@ -0,0 +1,57 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewQuota creates new Quota resource.
+func NewQuota(id resource.ID) *Quota {
+	return typed.NewResource[QuotaSpec, QuotaExtension](
+		resource.NewMetadata(resources.DefaultNamespace, QuotaType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.QuotaSpec{}),
+	)
+}
+
+const (
+	// QuotaType is the type of the Quota resource.
+	// tsgen:QuotaType
+	QuotaType = resource.Type("Quotas.omni.sidero.dev")
+)
+
+// Quota limits the number of the clusters and the machines owned by a user or a team.
+type Quota = typed.Resource[QuotaSpec, QuotaExtension]
+
+// QuotaSpec wraps specs.QuotaSpec.
+type QuotaSpec = protobuf.ResourceSpec[specs.QuotaSpec, *specs.QuotaSpec]
+
+// QuotaExtension provides auxiliary methods for Quota resource.
+type QuotaExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (QuotaExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             QuotaType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Max Clusters",
+				JSONPath: "{.maxclusters}",
+			},
+			{
+				Name:     "Max Machines",
+				JSONPath: "{.maxmachines}",
+			},
+		},
+	}
+}
@@ -0,0 +1,55 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/cosi/labels"
+)
+
+// ReadinessGatesTaint is the key of the NoSchedule taint of the nodes which haven't passed the readiness gates of their machine set yet.
+const ReadinessGatesTaint = SystemLabelPrefix + "readiness-gates"
+
+// ValidateReadinessGates checks the readiness gates of the machine set.
+func ValidateReadinessGates(gates *specs.MachineSetSpec_ReadinessGates) error {
+	if gates == nil {
+		return nil
+	}
+
+	var errs []error
+
+	extensions := make(map[string]struct{}, len(gates.Extensions))
+
+	for _, extension := range gates.Extensions {
+		if extension == "" {
+			errs = append(errs, errors.New("readiness gate extension name can't be empty"))
+
+			continue
+		}
+
+		if _, ok := extensions[extension]; ok {
+			errs = append(errs, fmt.Errorf("readiness gate extension %q is listed more than once", extension))
+		}
+
+		extensions[extension] = struct{}{}
+	}
+
+	for _, probe := range gates.Probes {
+		if probe == "" {
+			errs = append(errs, errors.New("readiness gate probe selector can't be empty"))
+
+			continue
+		}
+
+		if _, err := labels.ParseQuery(probe); err != nil {
+			errs = append(errs, fmt.Errorf("readiness gate probe selector %q is invalid: %w", probe, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,70 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+func TestValidateReadinessGates(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct { //nolint:govet
+		name        string
+		gates       *specs.MachineSetSpec_ReadinessGates
+		expectedErr string
+	}{
+		{
+			name: "no gates",
+		},
+		{
+			name: "valid",
+			gates: &specs.MachineSetSpec_ReadinessGates{
+				Extensions:     []string{"siderolabs/nvidia-container-toolkit", "siderolabs/gvisor"},
+				DiskEncryption: true,
+				Probes:         []string{"compliance.example.com/cis=passed", "!quarantined"},
+			},
+		},
+		{
+			name: "duplicate extension",
+			gates: &specs.MachineSetSpec_ReadinessGates{
+				Extensions: []string{"siderolabs/gvisor", "siderolabs/gvisor"},
+			},
+			expectedErr: `readiness gate extension "siderolabs/gvisor" is listed more than once`,
+		},
+		{
+			name: "empty extension",
+			gates: &specs.MachineSetSpec_ReadinessGates{
+				Extensions: []string{""},
+			},
+			expectedErr: "readiness gate extension name can't be empty",
+		},
+		{
+			name: "empty probe",
+			gates: &specs.MachineSetSpec_ReadinessGates{
+				Probes: []string{""},
+			},
+			expectedErr: "readiness gate probe selector can't be empty",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := omni.ValidateReadinessGates(test.gates)
+			if test.expectedErr == "" {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.ErrorContains(t, err, test.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewRuntimeConfiguration creates new RuntimeConfiguration resource.
+func NewRuntimeConfiguration() *RuntimeConfiguration {
+	return typed.NewResource[RuntimeConfigurationSpec, RuntimeConfigurationExtension](
+		resource.NewMetadata(resources.DefaultNamespace, RuntimeConfigurationType, RuntimeConfigurationID, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.RuntimeConfigurationSpec{}),
+	)
+}
+
+const (
+	// RuntimeConfigurationID is the ID of the RuntimeConfiguration resource.
+	// tsgen:RuntimeConfigurationID
+	RuntimeConfigurationID = resource.ID("runtime-configuration")
+
+	// RuntimeConfigurationType is the type of the RuntimeConfiguration resource.
+	// tsgen:RuntimeConfigurationType
+	RuntimeConfigurationType = resource.Type("RuntimeConfigurations.omni.sidero.dev")
+)
+
+// RuntimeConfiguration overrides the timeouts and the intervals of the controllers, the changes are applied without a restart.
+type RuntimeConfiguration = typed.Resource[RuntimeConfigurationSpec, RuntimeConfigurationExtension]
+
+// RuntimeConfigurationSpec wraps specs.RuntimeConfigurationSpec.
+type RuntimeConfigurationSpec = protobuf.ResourceSpec[specs.RuntimeConfigurationSpec, *specs.RuntimeConfigurationSpec]
+
+// RuntimeConfigurationExtension provides auxiliary methods for RuntimeConfiguration resource.
+type RuntimeConfigurationExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (RuntimeConfigurationExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             RuntimeConfigurationType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Machine Teardown Timeout",
+				JSONPath: "{.machineteardowntimeout}",
+			},
+			{
+				Name:     "Etcd Member Remove Timeout",
+				JSONPath: "{.etcdmemberremovetimeout}",
+			},
+			{
+				Name:     "Kubernetes Node Delete Timeout",
+				JSONPath: "{.kubernetesnodedeletetimeout}",
+			},
+		},
+	}
+}
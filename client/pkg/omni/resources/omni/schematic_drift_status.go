@@ -0,0 +1,61 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewSchematicDriftStatus creates new SchematicDriftStatus resource.
+func NewSchematicDriftStatus(ns string, id resource.ID) *SchematicDriftStatus {
+	return typed.NewResource[SchematicDriftStatusSpec, SchematicDriftStatusExtension](
+		resource.NewMetadata(ns, SchematicDriftStatusType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.SchematicDriftStatusSpec{}),
+	)
+}
+
+const (
+	// SchematicDriftStatusType is the type of the SchematicDriftStatus resource.
+	// tsgen:SchematicDriftStatusType
+	SchematicDriftStatusType = resource.Type("SchematicDriftStatuses.omni.sidero.dev")
+)
+
+// SchematicDriftStatus reports if the schematic running on the cluster machine differs from the desired one.
+type SchematicDriftStatus = typed.Resource[SchematicDriftStatusSpec, SchematicDriftStatusExtension]
+
+// SchematicDriftStatusSpec wraps specs.SchematicDriftStatusSpec.
+type SchematicDriftStatusSpec = protobuf.ResourceSpec[specs.SchematicDriftStatusSpec, *specs.SchematicDriftStatusSpec]
+
+// SchematicDriftStatusExtension provides auxiliary methods for SchematicDriftStatus resource.
+type SchematicDriftStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (SchematicDriftStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             SchematicDriftStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Phase",
+				JSONPath: "{.phase}",
+			},
+			{
+				Name:     "Desired",
+				JSONPath: "{.desiredschematicid}",
+			},
+			{
+				Name:     "Actual",
+				JSONPath: "{.actualschematicid}",
+			},
+		},
+	}
+}
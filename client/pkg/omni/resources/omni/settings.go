@@ -0,0 +1,61 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewSettings creates new Settings resource.
+func NewSettings() *Settings {
+	return typed.NewResource[SettingsSpec, SettingsExtension](
+		resource.NewMetadata(resources.DefaultNamespace, SettingsType, SettingsID, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.SettingsSpec{}),
+	)
+}
+
+const (
+	// SettingsID is the ID of the Settings resource.
+	// tsgen:SettingsID
+	SettingsID = resource.ID("settings")
+
+	// SettingsType is the type of the Settings resource.
+	// tsgen:SettingsType
+	SettingsType = resource.Type("Settings.omni.sidero.dev")
+)
+
+// Settings overrides the instance-wide settings of Omni, the changes are applied without a restart.
+type Settings = typed.Resource[SettingsSpec, SettingsExtension]
+
+// SettingsSpec wraps specs.SettingsSpec.
+type SettingsSpec = protobuf.ResourceSpec[specs.SettingsSpec, *specs.SettingsSpec]
+
+// SettingsExtension provides auxiliary methods for Settings resource.
+type SettingsExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (SettingsExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             SettingsType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Default Talos Version",
+				JSONPath: "{.defaulttalosversion}",
+			},
+			{
+				Name:     "Default Kubernetes Version",
+				JSONPath: "{.defaultkubernetesversion}",
+			},
+		},
+	}
+}
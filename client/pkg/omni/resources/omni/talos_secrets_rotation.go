@@ -0,0 +1,55 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewTalosSecretsRotation creates new TalosSecretsRotation resource.
+func NewTalosSecretsRotation(id resource.ID) *TalosSecretsRotation {
+	return typed.NewResource[TalosSecretsRotationSpec, TalosSecretsRotationExtension](
+		resource.NewMetadata(resources.DefaultNamespace, TalosSecretsRotationType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.TalosSecretsRotationSpec{}),
+	)
+}
+
+const (
+	// TalosSecretsRotationType is the type of the TalosSecretsRotation resource.
+	// tsgen:TalosSecretsRotationType
+	TalosSecretsRotationType = resource.Type("TalosSecretsRotations.omni.sidero.dev")
+)
+
+// TalosSecretsRotation requests the rotation of the Talos CA and the trustd token of the cluster.
+//
+// The ID is the cluster name.
+type TalosSecretsRotation = typed.Resource[TalosSecretsRotationSpec, TalosSecretsRotationExtension]
+
+// TalosSecretsRotationSpec wraps specs.TalosSecretsRotationSpec.
+type TalosSecretsRotationSpec = protobuf.ResourceSpec[specs.TalosSecretsRotationSpec, *specs.TalosSecretsRotationSpec]
+
+// TalosSecretsRotationExtension provides auxiliary methods for TalosSecretsRotation resource.
+type TalosSecretsRotationExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (TalosSecretsRotationExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             TalosSecretsRotationType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Requested At",
+				JSONPath: "{.requestedat}",
+			},
+		},
+	}
+}
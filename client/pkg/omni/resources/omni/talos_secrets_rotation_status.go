@@ -0,0 +1,61 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewTalosSecretsRotationStatus creates new TalosSecretsRotationStatus resource.
+func NewTalosSecretsRotationStatus(id resource.ID) *TalosSecretsRotationStatus {
+	return typed.NewResource[TalosSecretsRotationStatusSpec, TalosSecretsRotationStatusExtension](
+		resource.NewMetadata(resources.DefaultNamespace, TalosSecretsRotationStatusType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.TalosSecretsRotationStatusSpec{}),
+	)
+}
+
+const (
+	// TalosSecretsRotationStatusType is the type of the TalosSecretsRotationStatus resource.
+	// tsgen:TalosSecretsRotationStatusType
+	TalosSecretsRotationStatusType = resource.Type("TalosSecretsRotationStatuses.omni.sidero.dev")
+)
+
+// TalosSecretsRotationStatus reports the progress of the TalosSecretsRotation.
+type TalosSecretsRotationStatus = typed.Resource[TalosSecretsRotationStatusSpec, TalosSecretsRotationStatusExtension]
+
+// TalosSecretsRotationStatusSpec wraps specs.TalosSecretsRotationStatusSpec.
+type TalosSecretsRotationStatusSpec = protobuf.ResourceSpec[specs.TalosSecretsRotationStatusSpec, *specs.TalosSecretsRotationStatusSpec]
+
+// TalosSecretsRotationStatusExtension provides auxiliary methods for TalosSecretsRotationStatus resource.
+type TalosSecretsRotationStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (TalosSecretsRotationStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             TalosSecretsRotationStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Phase",
+				JSONPath: "{.phase}",
+			},
+			{
+				Name:     "Updated",
+				JSONPath: "{.updatedmachines}",
+			},
+			{
+				Name:     "Total",
+				JSONPath: "{.totalmachines}",
+			},
+		},
+	}
+}
@@ -0,0 +1,67 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewTemplateSyncStatus creates new TemplateSyncStatus resource.
+func NewTemplateSyncStatus(ns string, id resource.ID) *TemplateSyncStatus {
+	return typed.NewResource[TemplateSyncStatusSpec, TemplateSyncStatusExtension](
+		resource.NewMetadata(ns, TemplateSyncStatusType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.TemplateSyncStatusSpec{}),
+	)
+}
+
+const (
+	// TemplateSyncStatusType is the type of the TemplateSyncStatus resource.
+	// tsgen:TemplateSyncStatusType
+	TemplateSyncStatusType = resource.Type("TemplateSyncStatuses.omni.sidero.dev")
+)
+
+// TemplateSyncStatus reports the state of the cluster template synced from the Git repository.
+//
+// TemplateSyncStatus is created for each cluster template found in the repository, the ID is derived from the template path.
+type TemplateSyncStatus = typed.Resource[TemplateSyncStatusSpec, TemplateSyncStatusExtension]
+
+// TemplateSyncStatusSpec wraps specs.TemplateSyncStatusSpec.
+type TemplateSyncStatusSpec = protobuf.ResourceSpec[specs.TemplateSyncStatusSpec, *specs.TemplateSyncStatusSpec]
+
+// TemplateSyncStatusExtension provides auxiliary methods for TemplateSyncStatus resource.
+type TemplateSyncStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (TemplateSyncStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             TemplateSyncStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Phase",
+				JSONPath: "{.phase}",
+			},
+			{
+				Name:     "Cluster",
+				JSONPath: "{.cluster}",
+			},
+			{
+				Name:     "Commit",
+				JSONPath: "{.commit}",
+			},
+			{
+				Name:     "Error",
+				JSONPath: "{.error}",
+			},
+		},
+	}
+}
@@ -0,0 +1,51 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+)
+
+// ValidateUserVolumes checks the user volumes correctness.
+//
+// The volumes are validated against the disks of the machines when the config is generated.
+func ValidateUserVolumes(volumes []*specs.MachineSetSpec_UserVolume) error {
+	var errs []error
+
+	mountPoints := make(map[string]struct{}, len(volumes))
+	fullDiskVolumes := map[string]string{}
+
+	for _, volume := range volumes {
+		if !filepath.IsAbs(volume.Disk) {
+			errs = append(errs, fmt.Errorf("user volume disk %q must be an absolute device path", volume.Disk))
+		}
+
+		if !filepath.IsAbs(volume.MountPoint) || filepath.Clean(volume.MountPoint) != volume.MountPoint || volume.MountPoint == "/" {
+			errs = append(errs, fmt.Errorf("user volume mount point %q must be a clean absolute path", volume.MountPoint))
+		}
+
+		if _, ok := mountPoints[volume.MountPoint]; ok {
+			errs = append(errs, fmt.Errorf("user volume mount point %q is used more than once", volume.MountPoint))
+		}
+
+		mountPoints[volume.MountPoint] = struct{}{}
+
+		if mountPoint, ok := fullDiskVolumes[volume.Disk]; ok {
+			errs = append(errs, fmt.Errorf("user volume %q takes the remaining space of the disk %q, so it must be the last volume on the disk", mountPoint, volume.Disk))
+
+			delete(fullDiskVolumes, volume.Disk)
+		}
+
+		if volume.Size == 0 {
+			fullDiskVolumes[volume.Disk] = volume.MountPoint
+		}
+	}
+
+	return errors.Join(errs...)
+}
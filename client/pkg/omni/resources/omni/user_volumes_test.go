@@ -0,0 +1,76 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+func TestValidateUserVolumes(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct { //nolint:govet
+		name        string
+		volumes     []*specs.MachineSetSpec_UserVolume
+		expectedErr string
+	}{
+		{
+			name: "valid",
+			volumes: []*specs.MachineSetSpec_UserVolume{
+				{Disk: "/dev/sdb", MountPoint: "/var/mnt/data", Size: 100e9},
+				{Disk: "/dev/sdb", MountPoint: "/var/mnt/logs"},
+				{Disk: "/dev/sdc", MountPoint: "/var/mnt/cache"},
+			},
+		},
+		{
+			name: "relative disk",
+			volumes: []*specs.MachineSetSpec_UserVolume{
+				{Disk: "sdb", MountPoint: "/var/mnt/data"},
+			},
+			expectedErr: `user volume disk "sdb" must be an absolute device path`,
+		},
+		{
+			name: "unclean mount point",
+			volumes: []*specs.MachineSetSpec_UserVolume{
+				{Disk: "/dev/sdb", MountPoint: "/var/mnt/../data"},
+			},
+			expectedErr: `user volume mount point "/var/mnt/../data" must be a clean absolute path`,
+		},
+		{
+			name: "duplicate mount point",
+			volumes: []*specs.MachineSetSpec_UserVolume{
+				{Disk: "/dev/sdb", MountPoint: "/var/mnt/data"},
+				{Disk: "/dev/sdc", MountPoint: "/var/mnt/data"},
+			},
+			expectedErr: `user volume mount point "/var/mnt/data" is used more than once`,
+		},
+		{
+			name: "full disk volume is not last",
+			volumes: []*specs.MachineSetSpec_UserVolume{
+				{Disk: "/dev/sdb", MountPoint: "/var/mnt/data"},
+				{Disk: "/dev/sdb", MountPoint: "/var/mnt/logs", Size: 100e9},
+			},
+			expectedErr: `user volume "/var/mnt/data" takes the remaining space of the disk "/dev/sdb", so it must be the last volume on the disk`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := omni.ValidateUserVolumes(test.volumes)
+			if test.expectedErr != "" {
+				require.ErrorContains(t, err, test.expectedErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
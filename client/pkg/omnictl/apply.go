@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/protobuf"
@@ -40,34 +41,50 @@ var applyCmd = &cobra.Command{
 			return fmt.Errorf("failed to read resource yaml file %q: %w", applyCmdFlags.resFile, err)
 		}
 
-		if applyCmdFlags.options.dryRun {
+		switch applyCmdFlags.options.dryRun {
+		case "", dryRunNone:
+		case dryRunClient:
 			applyCmdFlags.options.verbose = true
+		case dryRunServer:
+			return access.WithClient(validateConfig(yamlRaw))
+		default:
+			return fmt.Errorf("invalid dry run mode %q, expected one of %q, %q or %q", applyCmdFlags.options.dryRun, dryRunNone, dryRunClient, dryRunServer)
 		}
 
 		return access.WithClient(applyConfig(yamlRaw))
 	},
 }
 
-func applyConfig(yamlRaw []byte) func(ctx context.Context, client *client.Client) error {
-	return func(ctx context.Context, client *client.Client) error {
-		st := client.Omni().State()
-		dec := yaml.NewDecoder(bytes.NewReader(yamlRaw))
+func decodeResources(yamlRaw []byte) ([]resource.Resource, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(yamlRaw))
 
-		var resources []resource.Resource
+	var resources []resource.Resource
 
-		for {
-			var res protobuf.YAMLResource
+	for {
+		var res protobuf.YAMLResource
 
-			err := dec.Decode(&res)
-			if errors.Is(err, io.EOF) {
-				break
-			}
+		err := dec.Decode(&res)
+		if errors.Is(err, io.EOF) {
+			break
+		}
 
-			if err != nil {
-				return err
-			}
+		if err != nil {
+			return nil, err
+		}
+
+		resources = append(resources, res.Resource())
+	}
+
+	return resources, nil
+}
 
-			resources = append(resources, res.Resource())
+func applyConfig(yamlRaw []byte) func(ctx context.Context, client *client.Client) error {
+	return func(ctx context.Context, client *client.Client) error {
+		st := client.Omni().State()
+
+		resources, err := decodeResources(yamlRaw)
+		if err != nil {
+			return err
 		}
 
 		for _, res := range resources {
@@ -95,8 +112,70 @@ func applyConfig(yamlRaw []byte) func(ctx context.Context, client *client.Client
 	}
 }
 
+// validateConfig sends each resource to the server which validates it without persisting and prints the changed fields.
+func validateConfig(yamlRaw []byte) func(ctx context.Context, client *client.Client) error {
+	return func(ctx context.Context, client *client.Client) error {
+		resources, err := decodeResources(yamlRaw)
+		if err != nil {
+			return err
+		}
+
+		for _, res := range resources {
+			out, err := marshalResource(res)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Management().ValidateResource(ctx, out)
+			if err != nil {
+				return fmt.Errorf("resource '%s' '%s' is not valid: %w", res.Metadata().ID(), res.Metadata().Type(), err)
+			}
+
+			action := "Creating"
+			if resp.Exists {
+				action = "Updating"
+			}
+
+			fmt.Printf("%s resource '%s' (server dry run)\n\n", action, res.Metadata().ID())
+
+			if len(resp.Changes) == 0 {
+				fmt.Printf("no changes\n\n")
+
+				continue
+			}
+
+			for _, change := range resp.Changes {
+				fmt.Printf("%s:\n", change.Path)
+
+				if change.Old != "" {
+					fmt.Printf("%s\n", indentLines(change.Old, "  - "))
+				}
+
+				if change.New != "" {
+					fmt.Printf("%s\n", indentLines(change.New, "  + "))
+				}
+			}
+
+			fmt.Println()
+		}
+
+		return nil
+	}
+}
+
+func indentLines(s, prefix string) string {
+	return prefix + strings.ReplaceAll(s, "\n", "\n"+prefix)
+}
+
+// Dry run modes.
+const (
+	dryRunNone   = "none"
+	dryRunClient = "client"
+	dryRunServer = "server"
+)
+
 type options struct {
-	dryRun  bool
+	dryRun  string
 	verbose bool
 }
 
@@ -110,7 +189,7 @@ func createResource(ctx context.Context, st state.State, res resource.Resource,
 		fmt.Printf("Creating resource '%s'\n\n%s\n\n", res.Metadata().ID(), out)
 	}
 
-	if opts.dryRun {
+	if opts.dryRun == dryRunClient {
 		return nil
 	}
 
@@ -139,7 +218,7 @@ func updateResource(ctx context.Context, st state.State, got resource.Resource,
 		fmt.Printf("Updating resource '%s'\n\n%s\n\n", res.Metadata().ID(), dmp.DiffPrettyText(diffs))
 	}
 
-	if opts.dryRun {
+	if opts.dryRun == dryRunClient {
 		return nil
 	}
 
@@ -169,7 +248,10 @@ func marshalResource(res resource.Resource) (string, error) {
 func init() {
 	applyCmd.PersistentFlags().StringVarP(&applyCmdFlags.resFile, "file", "f", "", "Resource file to load and apply")
 	applyCmd.PersistentFlags().BoolVarP(&applyCmdFlags.options.verbose, "verbose", "v", false, "Verbose output")
-	applyCmd.PersistentFlags().BoolVarP(&applyCmdFlags.options.dryRun, "dry-run", "d", false, "Dry run, implies verbose")
+	applyCmd.PersistentFlags().StringVarP(&applyCmdFlags.options.dryRun, "dry-run", "d", dryRunNone,
+		"Dry run mode: 'client' prints the changes without applying them, implies verbose, "+
+			"'server' validates the resources on the server and prints the changed fields without applying them")
+	applyCmd.PersistentFlags().Lookup("dry-run").NoOptDefVal = dryRunClient
 	ensure.NoError(applyCmd.MarkPersistentFlagRequired("file"))
 
 	RootCmd.AddCommand(applyCmd)
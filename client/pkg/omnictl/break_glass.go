@@ -0,0 +1,189 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omnictl
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/siderolabs/go-api-signature/pkg/pgp"
+	"github.com/siderolabs/go-api-signature/pkg/serviceaccount"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	pkgaccess "github.com/siderolabs/omni/client/pkg/access"
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/omnictl/config"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+)
+
+var (
+	breakGlassCmdFlags struct {
+		name       string
+		reason     string
+		role       string
+		secretFile string
+		totpCode   string
+		ttl        time.Duration
+	}
+
+	// breakGlassCmd represents the break-glass command.
+	breakGlassCmd = &cobra.Command{
+		Use:   "break-glass",
+		Short: "Get a short-lived emergency access key when locked out of the identity provider",
+		Long: `Get a short-lived emergency access key when locked out of the identity provider.
+
+The request doesn't need a login, instead it requires both the break-glass secret and the current TOTP code
+generated from the seed configured on the Omni instance. The reason is written to the Omni logs.
+
+A new key is generated and granted the access until its TTL expires. The key is printed as the service account key,
+which is used by omnictl when it is set in the environment.`,
+		Example: `  omnictl break-glass --name jane --reason "SAML provider outage"
+  omnictl break-glass --name jane --reason "SAML provider outage" --secret-file /mnt/vault/omni-break-glass --totp-code 123456 --ttl 30m`,
+		Args: cobra.NoArgs,
+		RunE: func(*cobra.Command, []string) error {
+			return breakGlass()
+		},
+	}
+)
+
+func breakGlass() error {
+	_, configCtx, err := loginContext()
+	if err != nil {
+		return err
+	}
+
+	url := configCtx.URL
+	if endpointEnv := os.Getenv(access.EndpointEnvVar); endpointEnv != "" {
+		url = endpointEnv
+	}
+
+	if url == "" || url == config.PlaceholderURL {
+		return fmt.Errorf("the Omni endpoint is not configured, set it in the context or in %s", access.EndpointEnvVar)
+	}
+
+	secret, err := readBreakGlassSecret()
+	if err != nil {
+		return err
+	}
+
+	totpCode, err := readBreakGlassTOTPCode()
+	if err != nil {
+		return err
+	}
+
+	name := breakGlassCmdFlags.name
+	comment := fmt.Sprintf("%s/%s", runtime.GOOS, runtime.GOARCH)
+
+	key, err := pgp.GenerateKey(name, comment, name+pkgaccess.BreakGlassNameSuffix, breakGlassCmdFlags.ttl)
+	if err != nil {
+		return err
+	}
+
+	armoredPublicKey, err := key.ArmorPublic()
+	if err != nil {
+		return err
+	}
+
+	return access.WithContext(func(ctx context.Context) error {
+		// the client has no authentication, the request is authorized by the break-glass factors
+		omniClient, err := client.New(url, client.WithInsecureSkipTLSVerify(access.CmdFlags.InsecureSkipTLSVerify))
+		if err != nil {
+			return err
+		}
+
+		defer omniClient.Close() //nolint:errcheck
+
+		resp, err := omniClient.Management().CreateBreakGlassToken(ctx, &management.CreateBreakGlassTokenRequest{
+			Secret:              secret,
+			TotpCode:            totpCode,
+			Reason:              breakGlassCmdFlags.reason,
+			ArmoredPgpPublicKey: armoredPublicKey,
+			Role:                breakGlassCmdFlags.role,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get the break-glass access: %w", err)
+		}
+
+		encodedKey, err := serviceaccount.Encode(name, key)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Granted the break-glass access to %q with public key ID %q until %s\n",
+			resp.GetIdentity(), resp.GetPublicKeyId(), resp.GetExpiration().AsTime().Local().Format(time.RFC3339))
+		fmt.Printf("\n")
+		fmt.Printf("Set the following environment variables to use the access:\n")
+		fmt.Printf("%s=%s\n", access.EndpointEnvVar, url)
+		fmt.Printf("%s=%s\n", serviceaccount.OmniServiceAccountKeyEnvVar, encodedKey)
+		fmt.Printf("\n")
+		fmt.Printf("Note: The key is not stored, it will not be displayed again\n")
+
+		return nil
+	})
+}
+
+func readBreakGlassSecret() (string, error) {
+	if breakGlassCmdFlags.secretFile != "" {
+		data, err := os.ReadFile(breakGlassCmdFlags.secretFile)
+		if err != nil {
+			return "", fmt.Errorf("failed to read the break-glass secret: %w", err)
+		}
+
+		return strings.TrimSpace(string(data)), nil
+	}
+
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		return "", errors.New("the break-glass secret can't be prompted for without a terminal, use --secret-file")
+	}
+
+	fmt.Fprint(os.Stderr, "break-glass secret: ") //nolint:errcheck
+
+	data, err := term.ReadPassword(int(os.Stdin.Fd()))
+
+	fmt.Fprintln(os.Stderr) //nolint:errcheck
+
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(data)), nil
+}
+
+func readBreakGlassTOTPCode() (string, error) {
+	if breakGlassCmdFlags.totpCode != "" {
+		return breakGlassCmdFlags.totpCode, nil
+	}
+
+	fmt.Fprint(os.Stderr, "TOTP code: ") //nolint:errcheck
+
+	code, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		return "", fmt.Errorf("failed to read the TOTP code: %w", err)
+	}
+
+	return strings.TrimSpace(code), nil
+}
+
+func init() {
+	RootCmd.AddCommand(breakGlassCmd)
+
+	breakGlassCmd.Flags().StringVar(&breakGlassCmdFlags.name, "name", "", "name of the operator requesting the access, it is the name of the granted identity")
+	breakGlassCmd.Flags().StringVar(&breakGlassCmdFlags.reason, "reason", "", "reason of the emergency access, written to the Omni logs")
+	breakGlassCmd.Flags().StringVarP(&breakGlassCmdFlags.role, "role", "r", "", "role of the access, Admin if not set")
+	breakGlassCmd.Flags().StringVar(&breakGlassCmdFlags.secretFile, "secret-file", "", "path to the file containing the break-glass secret, prompted for if not set")
+	breakGlassCmd.Flags().StringVar(&breakGlassCmdFlags.totpCode, "totp-code", "", "current TOTP code, prompted for if not set")
+	breakGlassCmd.Flags().DurationVarP(&breakGlassCmdFlags.ttl, "ttl", "t", time.Hour, "TTL of the access, limited by the server")
+
+	breakGlassCmd.MarkFlagRequired("name")   //nolint:errcheck
+	breakGlassCmd.MarkFlagRequired("reason") //nolint:errcheck
+}
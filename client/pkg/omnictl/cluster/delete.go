@@ -16,6 +16,7 @@ import (
 )
 
 var deleteCmdFlags struct {
+	reason  string
 	options operations.SyncOptions
 }
 
@@ -32,14 +33,17 @@ var deleteCmd = &cobra.Command{
 }
 
 func deleteImpl(clusterName string) func(ctx context.Context, client *client.Client) error {
-	return func(ctx context.Context, client *client.Client) error {
-		return operations.DeleteCluster(ctx, clusterName, os.Stdout, client.Omni().State(), deleteCmdFlags.options)
+	return func(ctx context.Context, cli *client.Client) error {
+		ctx = client.WithDestroyReason(ctx, deleteCmdFlags.reason)
+
+		return operations.DeleteCluster(ctx, clusterName, os.Stdout, cli.Omni().State(), deleteCmdFlags.options)
 	}
 }
 
 func init() {
 	deleteCmd.PersistentFlags().BoolVarP(&deleteCmdFlags.options.Verbose, "verbose", "v", false, "verbose output (show diff for each resource)")
 	deleteCmd.PersistentFlags().BoolVarP(&deleteCmdFlags.options.DryRun, "dry-run", "d", false, "dry run")
+	deleteCmd.PersistentFlags().StringVar(&deleteCmdFlags.reason, "reason", "", "reason of the deletion, recorded by Omni and might be required by its policy")
 	deleteCmd.PersistentFlags().BoolVar(&deleteCmdFlags.options.DestroyMachines, "destroy-disconnected-machines", false, "removes all disconnected machines which are part of the cluster from Omni")
 	clusterCmd.AddCommand(deleteCmd)
 }
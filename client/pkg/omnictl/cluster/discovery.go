@@ -0,0 +1,107 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+)
+
+var rotateDiscoveryKeyCmdFlags struct {
+	wait bool
+}
+
+var rotateDiscoveryKeyCmd = &cobra.Command{
+	Use:   "rotate-discovery-key cluster-name",
+	Short: "Rotate the discovery service encryption key of the cluster",
+	Long: `Generates the new discovery service encryption key and rolls it out to the cluster machines. ` +
+		`The old key is kept by Omni until all machines are updated.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		return access.WithClient(rotateDiscoveryKey(args[0]))
+	},
+}
+
+func rotateDiscoveryKey(clusterID resource.ID) func(context.Context, *client.Client) error {
+	return func(ctx context.Context, client *client.Client) error {
+		st := client.Omni().State()
+		requestedAt := timestamppb.Now()
+
+		rotation := omni.NewDiscoveryKeyRotation(clusterID)
+
+		_, err := safe.StateGet[*omni.DiscoveryKeyRotation](ctx, st, rotation.Metadata())
+		if err != nil && !state.IsNotFoundError(err) {
+			return err
+		}
+
+		if state.IsNotFoundError(err) {
+			rotation.TypedSpec().Value.RequestedAt = requestedAt
+
+			err = st.Create(ctx, rotation)
+		} else {
+			_, err = safe.StateUpdateWithConflicts(ctx, st, rotation.Metadata(), func(res *omni.DiscoveryKeyRotation) error {
+				res.TypedSpec().Value.RequestedAt = requestedAt
+
+				return nil
+			})
+		}
+
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("discovery key rotation is requested for the cluster %q\n", clusterID)
+
+		if !rotateDiscoveryKeyCmdFlags.wait {
+			return nil
+		}
+
+		var lastStatus string
+
+		_, err = st.WatchFor(ctx,
+			omni.NewDiscoveryKeyRotationStatus(clusterID).Metadata(),
+			state.WithCondition(func(r resource.Resource) (bool, error) {
+				rotationStatus, ok := r.(*omni.DiscoveryKeyRotationStatus)
+				if !ok {
+					return false, nil
+				}
+
+				spec := rotationStatus.TypedSpec().Value
+
+				if !proto.Equal(spec.RequestedAt, requestedAt) {
+					return false, nil
+				}
+
+				if status := fmt.Sprintf("phase: %s, updated machines: %d/%d", spec.Phase, spec.UpdatedMachines, spec.TotalMachines); status != lastStatus {
+					lastStatus = status
+
+					fmt.Println(status)
+				}
+
+				return spec.Phase == specs.DiscoveryKeyRotationStatusSpec_Done, nil
+			}),
+		)
+
+		return err
+	}
+}
+
+func init() {
+	clusterCmd.AddCommand(rotateDiscoveryKeyCmd)
+
+	rotateDiscoveryKeyCmd.Flags().BoolVar(&rotateDiscoveryKeyCmdFlags.wait, "wait", false, "wait for the rotation to be done")
+}
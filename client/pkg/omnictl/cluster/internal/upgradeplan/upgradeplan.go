@@ -0,0 +1,213 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package upgradeplan computes the cluster upgrade plan printed by the omnictl cluster upgrade command.
+package upgradeplan
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/blang/semver"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/siderolabs/gen/maps"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+)
+
+// Plan is the cluster upgrade plan.
+//
+// The Talos upgrade goes first, the Kubernetes upgrade starts after it is done.
+// The nil stage means that the cluster already runs the requested version.
+type Plan struct {
+	Talos      *TalosPlan
+	Kubernetes *KubernetesPlan
+}
+
+// TalosPlan is the Talos upgrade stage of the plan.
+type TalosPlan struct {
+	FromVersion string
+	ToVersion   string
+
+	// Batches are the machines in the upgrade order, one machine per batch.
+	Batches []Machine
+
+	// Held are the nodenames (or IDs, if the nodename is not known) of the machines skipped due to the upgrade hold.
+	Held []string
+}
+
+// Machine is a cluster machine considered by the Talos upgrade.
+type Machine struct {
+	Created      time.Time
+	ID           resource.ID
+	Nodename     string
+	TalosVersion string
+	ControlPlane bool
+	Locked       bool
+	Held         bool
+}
+
+// KubernetesPlan is the Kubernetes upgrade stage of the plan.
+type KubernetesPlan struct {
+	FromVersion string
+	ToVersion   string
+	Steps       []KubernetesStep
+
+	// Held are the nodenames of the nodes skipped due to the upgrade hold.
+	Held []string
+}
+
+// KubernetesStep is the update of a single Kubernetes component on the nodes.
+type KubernetesStep struct {
+	Component string
+	Nodes     []string
+}
+
+// ResolveVersion picks the version matching the pattern from the list of the available versions.
+//
+// The pattern is either an exact version, or a version prefix ending with .x, which resolves to the latest matching version.
+func ResolveVersion(name, pattern string, available []string) (string, error) {
+	pattern = strings.TrimPrefix(pattern, "v")
+
+	prefix, wildcard := strings.CutSuffix(pattern, ".x")
+
+	var (
+		resolved       string
+		resolvedSemver semver.Version
+	)
+
+	for _, version := range available {
+		if wildcard {
+			if !strings.HasPrefix(version, prefix+".") {
+				continue
+			}
+		} else if version != pattern {
+			continue
+		}
+
+		parsed, err := semver.ParseTolerant(version)
+		if err != nil {
+			continue
+		}
+
+		if resolved == "" || parsed.GT(resolvedSemver) {
+			resolved, resolvedSemver = version, parsed
+		}
+	}
+
+	if resolved == "" {
+		sorted := slices.Clone(available)
+		slices.Sort(sorted)
+		sorted = slices.Compact(sorted)
+
+		return "", fmt.Errorf("%s version %q is not available for the upgrade, available versions: %s", name, pattern, strings.Join(sorted, ", "))
+	}
+
+	return resolved, nil
+}
+
+// Talos computes the Talos upgrade stage.
+//
+// The machines are upgraded in the same order as the upgrade controller does:
+// control planes first, then workers, one machine at a time, oldest machines first.
+func Talos(fromVersion, toVersion string, machines []Machine) *TalosPlan {
+	pending := make([]Machine, 0, len(machines))
+
+	for _, machine := range machines {
+		if strings.TrimPrefix(machine.TalosVersion, "v") != toVersion {
+			pending = append(pending, machine)
+		}
+	}
+
+	slices.SortStableFunc(pending, func(a, b Machine) int {
+		switch {
+		case a.ControlPlane && !b.ControlPlane:
+			return -1
+		case !a.ControlPlane && b.ControlPlane:
+			return 1
+		}
+
+		return a.Created.Compare(b.Created)
+	})
+
+	plan := &TalosPlan{
+		FromVersion: fromVersion,
+		ToVersion:   toVersion,
+	}
+
+	for _, machine := range pending {
+		if machine.Held {
+			plan.Held = append(plan.Held, cmp.Or(machine.Nodename, machine.ID))
+
+			continue
+		}
+
+		plan.Batches = append(plan.Batches, machine)
+	}
+
+	return plan
+}
+
+// Kubernetes computes the Kubernetes upgrade stage.
+//
+// The control plane components are updated before the kubelets, each component is updated one node at a time.
+// The nodes of the held machines are skipped.
+func Kubernetes(fromVersion, toVersion string, status *specs.KubernetesStatusSpec, heldNodes map[string]struct{}) *KubernetesPlan {
+	plan := &KubernetesPlan{
+		FromVersion: fromVersion,
+		ToVersion:   toVersion,
+	}
+
+	skippedNodes := map[string]struct{}{}
+
+	pending := func(nodename, version string) bool {
+		if version == toVersion {
+			return false
+		}
+
+		if _, held := heldNodes[nodename]; held {
+			skippedNodes[nodename] = struct{}{}
+
+			return false
+		}
+
+		return true
+	}
+
+	for _, component := range []string{"kube-apiserver", "kube-controller-manager", "kube-scheduler"} {
+		step := KubernetesStep{Component: component}
+
+		for _, node := range status.GetStaticPods() {
+			for _, pod := range node.GetStaticPods() {
+				if pod.GetApp() == component && pending(node.GetNodename(), pod.GetVersion()) {
+					step.Nodes = append(step.Nodes, node.GetNodename())
+				}
+			}
+		}
+
+		if len(step.Nodes) > 0 {
+			plan.Steps = append(plan.Steps, step)
+		}
+	}
+
+	kubeletStep := KubernetesStep{Component: "kubelet"}
+
+	for _, node := range status.GetNodes() {
+		if pending(node.GetNodename(), node.GetKubeletVersion()) {
+			kubeletStep.Nodes = append(kubeletStep.Nodes, node.GetNodename())
+		}
+	}
+
+	if len(kubeletStep.Nodes) > 0 {
+		plan.Steps = append(plan.Steps, kubeletStep)
+	}
+
+	plan.Held = maps.Keys(skippedNodes)
+	slices.Sort(plan.Held)
+
+	return plan
+}
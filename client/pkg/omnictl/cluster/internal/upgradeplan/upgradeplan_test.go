@@ -0,0 +1,216 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package upgradeplan_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omnictl/cluster/internal/upgradeplan"
+)
+
+func TestResolveVersion(t *testing.T) {
+	t.Parallel()
+
+	available := []string{"1.6.7", "1.7.4", "1.7.10", "1.7.6", "1.8.0-alpha.1", "1.8.0"}
+
+	for _, tt := range []struct {
+		name          string
+		pattern       string
+		expected      string
+		expectedError string
+	}{
+		{
+			name:     "exact",
+			pattern:  "1.7.4",
+			expected: "1.7.4",
+		},
+		{
+			name:     "exact with v prefix",
+			pattern:  "v1.7.6",
+			expected: "1.7.6",
+		},
+		{
+			name:     "wildcard picks the latest by semver",
+			pattern:  "1.7.x",
+			expected: "1.7.10",
+		},
+		{
+			name:     "wildcard prefers the release over the pre-release",
+			pattern:  "1.8.x",
+			expected: "1.8.0",
+		},
+		{
+			name:     "major wildcard",
+			pattern:  "1.x",
+			expected: "1.8.0",
+		},
+		{
+			name:          "exact not available",
+			pattern:       "1.7.5",
+			expectedError: `Talos version "1.7.5" is not available for the upgrade, available versions: 1.6.7, 1.7.10, 1.7.4, 1.7.6, 1.8.0, 1.8.0-alpha.1`,
+		},
+		{
+			name:          "wildcard doesn't match a partial minor",
+			pattern:       "1.1.x",
+			expectedError: `Talos version "1.1.x" is not available for the upgrade`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resolved, err := upgradeplan.ResolveVersion("Talos", tt.pattern, available)
+			if tt.expectedError != "" {
+				require.ErrorContains(t, err, tt.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, resolved)
+		})
+	}
+}
+
+func TestTalos(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	for _, tt := range []struct {
+		name            string
+		machines        []upgradeplan.Machine
+		expectedBatches []string
+		expectedHeld    []string
+	}{
+		{
+			name: "up to date",
+			machines: []upgradeplan.Machine{
+				{ID: "cp-1", TalosVersion: "v1.7.6", ControlPlane: true},
+				{ID: "w-1", TalosVersion: "1.7.6"},
+			},
+		},
+		{
+			name: "control planes first, then the oldest machines",
+			machines: []upgradeplan.Machine{
+				{ID: "w-2", TalosVersion: "1.7.4", Created: now.Add(-time.Hour)},
+				{ID: "w-1", TalosVersion: "1.7.4", Created: now.Add(-2 * time.Hour)},
+				{ID: "cp-2", TalosVersion: "1.7.4", ControlPlane: true, Created: now},
+				{ID: "cp-1", TalosVersion: "1.7.4", ControlPlane: true, Created: now.Add(-time.Hour)},
+			},
+			expectedBatches: []string{"cp-1", "cp-2", "w-1", "w-2"},
+		},
+		{
+			name: "held machines are skipped",
+			machines: []upgradeplan.Machine{
+				{ID: "cp-1", TalosVersion: "1.7.4", ControlPlane: true},
+				{ID: "w-1", TalosVersion: "1.7.4", Held: true, Nodename: "worker-1"},
+				{ID: "w-2", TalosVersion: "1.7.4", Held: true},
+				{ID: "w-3", TalosVersion: "1.7.4", Locked: true},
+			},
+			expectedBatches: []string{"cp-1", "w-3"},
+			expectedHeld:    []string{"worker-1", "w-2"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			plan := upgradeplan.Talos("1.7.4", "1.7.6", tt.machines)
+
+			assert.Equal(t, "1.7.4", plan.FromVersion)
+			assert.Equal(t, "1.7.6", plan.ToVersion)
+
+			var batches []string
+
+			for _, machine := range plan.Batches {
+				batches = append(batches, machine.ID)
+			}
+
+			assert.Equal(t, tt.expectedBatches, batches)
+			assert.Equal(t, tt.expectedHeld, plan.Held)
+		})
+	}
+}
+
+func TestKubernetes(t *testing.T) {
+	t.Parallel()
+
+	status := &specs.KubernetesStatusSpec{
+		Nodes: []*specs.KubernetesStatusSpec_NodeStatus{
+			{Nodename: "cp-1", KubeletVersion: "1.30.1"},
+			{Nodename: "cp-2", KubeletVersion: "1.30.3"},
+			{Nodename: "w-1", KubeletVersion: "1.30.1"},
+		},
+		StaticPods: []*specs.KubernetesStatusSpec_NodeStaticPods{
+			{
+				Nodename: "cp-1",
+				StaticPods: []*specs.KubernetesStatusSpec_StaticPodStatus{
+					{App: "kube-apiserver", Version: "1.30.1"},
+					{App: "kube-controller-manager", Version: "1.30.1"},
+					{App: "kube-scheduler", Version: "1.30.3"},
+				},
+			},
+			{
+				Nodename: "cp-2",
+				StaticPods: []*specs.KubernetesStatusSpec_StaticPodStatus{
+					{App: "kube-apiserver", Version: "1.30.1"},
+					{App: "kube-controller-manager", Version: "1.30.3"},
+					{App: "kube-scheduler", Version: "1.30.3"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range []struct {
+		name          string
+		toVersion     string
+		heldNodes     map[string]struct{}
+		expectedSteps []upgradeplan.KubernetesStep
+		expectedHeld  []string
+	}{
+		{
+			name:      "pending components",
+			toVersion: "1.30.3",
+			expectedSteps: []upgradeplan.KubernetesStep{
+				{Component: "kube-apiserver", Nodes: []string{"cp-1", "cp-2"}},
+				{Component: "kube-controller-manager", Nodes: []string{"cp-1"}},
+				{Component: "kubelet", Nodes: []string{"cp-1", "w-1"}},
+			},
+		},
+		{
+			name:      "held nodes are skipped",
+			toVersion: "1.30.3",
+			heldNodes: map[string]struct{}{"cp-1": {}, "w-1": {}},
+			expectedSteps: []upgradeplan.KubernetesStep{
+				{Component: "kube-apiserver", Nodes: []string{"cp-2"}},
+			},
+			expectedHeld: []string{"cp-1", "w-1"},
+		},
+		{
+			name:      "components are compared with the target version",
+			toVersion: "1.30.1",
+			expectedSteps: []upgradeplan.KubernetesStep{
+				{Component: "kube-controller-manager", Nodes: []string{"cp-2"}},
+				{Component: "kube-scheduler", Nodes: []string{"cp-1", "cp-2"}},
+				{Component: "kubelet", Nodes: []string{"cp-2"}},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			plan := upgradeplan.Kubernetes("1.30.1", tt.toVersion, status, tt.heldNodes)
+
+			assert.Equal(t, "1.30.1", plan.FromVersion)
+			assert.Equal(t, tt.toVersion, plan.ToVersion)
+			assert.Equal(t, tt.expectedSteps, plan.Steps)
+			assert.Equal(t, tt.expectedHeld, plan.Held)
+		})
+	}
+}
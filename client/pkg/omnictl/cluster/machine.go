@@ -13,6 +13,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/spf13/cobra"
 
+	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/client"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
@@ -39,6 +40,94 @@ var unlockCmd = &cobra.Command{
 	},
 }
 
+var holdCmd = &cobra.Command{
+	Use:   "hold machine-id",
+	Short: "Hold the machine on its current Talos and Kubernetes versions",
+	Long: `When held, the Talos and Kubernetes upgrades of the cluster skip the machine and continue with the rest of the machines. ` +
+		`The machine is upgraded to the cluster versions once it is released.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		return access.WithClient(setUpgradeHold(args[0], true))
+	},
+}
+
+var releaseCmd = &cobra.Command{
+	Use:   "release machine-id",
+	Short: "Release the upgrade hold of the machine",
+	Long:  `Removes the upgrade hold annotation from the machine, so that it's upgraded to the cluster versions.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		return access.WithClient(setUpgradeHold(args[0], false))
+	},
+}
+
+var moveCmdFlags struct {
+	cluster    string
+	machineSet string
+	wait       bool
+}
+
+var moveCmd = &cobra.Command{
+	Use:   "move machine-id",
+	Short: "Move the worker machine to another cluster",
+	Long: `Moves the worker machine to another cluster without a reinstall: the machine is removed from its current cluster, ` +
+		`reset and added to the target machine set, so that it rejoins with the new cluster config. ` +
+		`The machine must be installed and must not run a newer Talos version than the target cluster.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		return access.WithClient(moveMachine(args[0]))
+	},
+}
+
+func moveMachine(machineID resource.ID) func(context.Context, *client.Client) error {
+	return func(ctx context.Context, client *client.Client) error {
+		if err := client.Management().MoveMachine(ctx, machineID, moveCmdFlags.cluster, moveCmdFlags.machineSet); err != nil {
+			return err
+		}
+
+		fmt.Printf("machine %q is being moved to the cluster %q\n", machineID, moveCmdFlags.cluster)
+
+		if !moveCmdFlags.wait {
+			return nil
+		}
+
+		var lastPhase specs.MachineMoveStatusSpec_Phase
+
+		_, err := client.Omni().State().WatchFor(ctx,
+			omni.NewMachineMoveStatus(resources.DefaultNamespace, machineID).Metadata(),
+			state.WithCondition(func(r resource.Resource) (bool, error) {
+				moveStatus, ok := r.(*omni.MachineMoveStatus)
+				if !ok {
+					return false, nil
+				}
+
+				spec := moveStatus.TypedSpec().Value
+
+				if spec.TargetCluster != moveCmdFlags.cluster {
+					return false, nil
+				}
+
+				if spec.Phase != lastPhase {
+					lastPhase = spec.Phase
+
+					fmt.Printf("phase: %s\n", spec.Phase)
+				}
+
+				switch spec.Phase { //nolint:exhaustive
+				case specs.MachineMoveStatusSpec_Done:
+					return true, nil
+				case specs.MachineMoveStatusSpec_Failed:
+					return false, fmt.Errorf("machine move failed: %s", spec.Error)
+				}
+
+				return false, nil
+			}),
+		)
+
+		return err
+	}
+}
+
 func setLocked(machineID resource.ID, lock bool) func(context.Context, *client.Client) error {
 	return func(ctx context.Context, client *client.Client) error {
 		st := client.Omni().State()
@@ -64,6 +153,28 @@ func setLocked(machineID resource.ID, lock bool) func(context.Context, *client.C
 	}
 }
 
+func setUpgradeHold(machineID resource.ID, hold bool) func(context.Context, *client.Client) error {
+	return func(ctx context.Context, client *client.Client) error {
+		_, err := safe.StateUpdateWithConflicts(ctx, client.Omni().State(),
+			resource.NewMetadata(resources.DefaultNamespace, omni.MachineSetNodeType, machineID, resource.VersionUndefined),
+			func(res *omni.MachineSetNode) error {
+				if hold {
+					res.Metadata().Annotations().Set(omni.MachineUpgradeHold, "")
+				} else {
+					res.Metadata().Annotations().Delete(omni.MachineUpgradeHold)
+				}
+
+				return nil
+			},
+		)
+		if state.IsNotFoundError(err) {
+			return fmt.Errorf("no machine set nodes with id %q found", machineID)
+		}
+
+		return err
+	}
+}
+
 // machineCmd represents the cluster machine commands.
 var machineCmd = &cobra.Command{
 	Use:     "machine",
@@ -75,5 +186,13 @@ var machineCmd = &cobra.Command{
 func init() {
 	machineCmd.AddCommand(lockCmd)
 	machineCmd.AddCommand(unlockCmd)
+	machineCmd.AddCommand(holdCmd)
+	machineCmd.AddCommand(releaseCmd)
+	machineCmd.AddCommand(moveCmd)
+
+	moveCmd.Flags().StringVar(&moveCmdFlags.cluster, "cluster", "", "target cluster name")
+	moveCmd.Flags().StringVar(&moveCmdFlags.machineSet, "machine-set", "", "target worker machine set, defaults to the workers machine set of the target cluster")
+	moveCmd.Flags().BoolVar(&moveCmdFlags.wait, "wait", false, "wait for the machine to join the target cluster")
+	moveCmd.MarkFlagRequired("cluster") //nolint:errcheck
 	clusterCmd.AddCommand(machineCmd)
 }
@@ -0,0 +1,70 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+)
+
+var pauseCmd = &cobra.Command{
+	Use:   "pause cluster-name",
+	Short: "Pause the cluster reconciliation",
+	Long: `When paused, no config updates, upgrades and scaling changes are performed on the cluster. ` +
+		`The cluster status is still collected.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		return access.WithClient(setPaused(args[0], true))
+	},
+}
+
+var resumeCmd = &cobra.Command{
+	Use:   "resume cluster-name",
+	Short: "Resume the cluster reconciliation",
+	Long:  `Removes paused annotation from the cluster, the pending changes are applied.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		return access.WithClient(setPaused(args[0], false))
+	},
+}
+
+func setPaused(clusterID resource.ID, pause bool) func(context.Context, *client.Client) error {
+	return func(ctx context.Context, client *client.Client) error {
+		_, err := safe.StateUpdateWithConflicts(ctx, client.Omni().State(), omni.NewCluster(resources.DefaultNamespace, clusterID).Metadata(), func(res *omni.Cluster) error {
+			if pause {
+				res.Metadata().Annotations().Set(omni.ClusterPaused, "")
+			} else {
+				res.Metadata().Annotations().Delete(omni.ClusterPaused)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		if pause {
+			fmt.Printf("cluster %q is paused\n", clusterID)
+		} else {
+			fmt.Printf("cluster %q is resumed\n", clusterID)
+		}
+
+		return nil
+	}
+}
+
+func init() {
+	clusterCmd.AddCommand(pauseCmd)
+	clusterCmd.AddCommand(resumeCmd)
+}
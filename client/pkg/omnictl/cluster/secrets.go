@@ -0,0 +1,112 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+)
+
+var rotateSecretsCmdFlags struct {
+	wait bool
+}
+
+var rotateSecretsCmd = &cobra.Command{
+	Use:   "rotate-secrets cluster-name",
+	Short: "Rotate the Talos CA and the trustd token of the cluster",
+	Long: `Generates the new Talos CA and trustd token and rolls them out to the cluster machines in stages: ` +
+		`the new CA is accepted by all machines first, then it becomes the issuing CA, then the old CA is removed. ` +
+		`Each stage is started only when the previous one is applied to all machines and the cluster is ready.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		return access.WithClient(rotateSecrets(args[0]))
+	},
+}
+
+func rotateSecrets(clusterID resource.ID) func(context.Context, *client.Client) error {
+	return func(ctx context.Context, client *client.Client) error {
+		st := client.Omni().State()
+		requestedAt := timestamppb.Now()
+
+		rotation := omni.NewTalosSecretsRotation(clusterID)
+
+		_, err := safe.StateGet[*omni.TalosSecretsRotation](ctx, st, rotation.Metadata())
+		if err != nil && !state.IsNotFoundError(err) {
+			return err
+		}
+
+		if state.IsNotFoundError(err) {
+			rotation.TypedSpec().Value.RequestedAt = requestedAt
+
+			err = st.Create(ctx, rotation)
+		} else {
+			_, err = safe.StateUpdateWithConflicts(ctx, st, rotation.Metadata(), func(res *omni.TalosSecretsRotation) error {
+				res.TypedSpec().Value.RequestedAt = requestedAt
+
+				return nil
+			})
+		}
+
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("secrets rotation is requested for the cluster %q\n", clusterID)
+
+		if !rotateSecretsCmdFlags.wait {
+			return nil
+		}
+
+		var lastStatus string
+
+		_, err = st.WatchFor(ctx,
+			omni.NewTalosSecretsRotationStatus(clusterID).Metadata(),
+			state.WithCondition(func(r resource.Resource) (bool, error) {
+				rotationStatus, ok := r.(*omni.TalosSecretsRotationStatus)
+				if !ok {
+					return false, nil
+				}
+
+				spec := rotationStatus.TypedSpec().Value
+
+				if !proto.Equal(spec.RequestedAt, requestedAt) {
+					return false, nil
+				}
+
+				if spec.Phase == specs.TalosSecretsRotationStatusSpec_Failed {
+					return false, fmt.Errorf("secrets rotation failed: %s", spec.Error)
+				}
+
+				if status := fmt.Sprintf("phase: %s, updated machines: %d/%d, cluster ready: %t", spec.Phase, spec.UpdatedMachines, spec.TotalMachines, spec.ClusterReady); status != lastStatus {
+					lastStatus = status
+
+					fmt.Println(status)
+				}
+
+				return spec.Phase == specs.TalosSecretsRotationStatusSpec_Done, nil
+			}),
+		)
+
+		return err
+	}
+}
+
+func init() {
+	clusterCmd.AddCommand(rotateSecretsCmd)
+
+	rotateSecretsCmd.Flags().BoolVar(&rotateSecretsCmdFlags.wait, "wait", false, "wait for the rotation to be done")
+}
@@ -16,6 +16,7 @@ import (
 )
 
 var deleteCmdFlags struct {
+	reason  string
 	options operations.SyncOptions
 }
 
@@ -31,21 +32,22 @@ var deleteCmd = &cobra.Command{
 	},
 }
 
-func deleteImpl(ctx context.Context, client *client.Client) error {
-	f, err := os.Open(cmdFlags.TemplatePath)
+func deleteImpl(ctx context.Context, cli *client.Client) error {
+	f, err := openTemplate()
 	if err != nil {
 		return err
 	}
 
-	defer f.Close() //nolint:errcheck
+	ctx = client.WithDestroyReason(ctx, deleteCmdFlags.reason)
 
-	return operations.DeleteTemplate(ctx, f, os.Stdout, client.Omni().State(), deleteCmdFlags.options)
+	return operations.DeleteTemplate(ctx, f, os.Stdout, cli.Omni().State(), deleteCmdFlags.options)
 }
 
 func init() {
 	addRequiredFileFlag(deleteCmd)
 	deleteCmd.PersistentFlags().BoolVarP(&deleteCmdFlags.options.Verbose, "verbose", "v", false, "verbose output (show diff for each resource)")
 	deleteCmd.PersistentFlags().BoolVarP(&deleteCmdFlags.options.DryRun, "dry-run", "d", false, "dry run")
+	deleteCmd.PersistentFlags().StringVar(&deleteCmdFlags.reason, "reason", "", "reason of the deletion, recorded by Omni and might be required by its policy")
 	deleteCmd.PersistentFlags().BoolVar(&deleteCmdFlags.options.DestroyMachines, "destroy-disconnected-machines", false, "removes all disconnected machines which are part of the cluster from Omni")
 	templateCmd.AddCommand(deleteCmd)
 }
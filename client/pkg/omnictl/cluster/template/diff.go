@@ -6,6 +6,8 @@ package template
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"os"
 
 	"github.com/spf13/cobra"
@@ -15,30 +17,69 @@ import (
 	"github.com/siderolabs/omni/client/pkg/template/operations"
 )
 
+const (
+	diffOutputUnified = "unified"
+	diffOutputSummary = "summary"
+	diffOutputJSON    = "json"
+)
+
+var diffCmdFlags struct {
+	output string
+}
+
 // diffCmd represents the template diff command.
 var diffCmd = &cobra.Command{
-	Use:     "diff",
-	Short:   "Show diff in resources if the template is synced.",
-	Long:    `Query existing resources for the cluster and compare them with the resources generated from the template. This command requires API access.`,
-	Example: "",
+	Use:   "diff",
+	Short: "Show diff in resources if the template is synced.",
+	Long: `Query existing resources for the cluster and compare them with the resources generated from the template. This command requires API access.
+
+The unified output shows the full diff of each resource, the summary output lists the machines, the patches and the other resources
+which would be created, updated or destroyed together with the changed fields, the json output is the structured form of the summary.`,
+	Example: `  omnictl cluster template diff -f cluster.yaml --output summary`,
 	Args:    cobra.NoArgs,
 	RunE: func(*cobra.Command, []string) error {
+		switch diffCmdFlags.output {
+		case diffOutputUnified, diffOutputSummary, diffOutputJSON:
+		default:
+			return fmt.Errorf("unknown output format %q, expected one of: %s, %s, %s", diffCmdFlags.output, diffOutputUnified, diffOutputSummary, diffOutputJSON)
+		}
+
 		return access.WithClient(diff)
 	},
 }
 
 func diff(ctx context.Context, client *client.Client) error {
-	f, err := os.Open(cmdFlags.TemplatePath)
+	f, err := openTemplate()
 	if err != nil {
 		return err
 	}
 
-	defer f.Close() //nolint:errcheck
+	if diffCmdFlags.output == diffOutputUnified {
+		return operations.DiffTemplate(ctx, f, os.Stdout, client.Omni().State())
+	}
+
+	changes, err := operations.TemplateChanges(ctx, f, client.Omni().State())
+	if err != nil {
+		return err
+	}
+
+	if diffCmdFlags.output == diffOutputJSON {
+		if changes == nil {
+			changes = []operations.ResourceChange{}
+		}
+
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(changes)
+	}
 
-	return operations.DiffTemplate(ctx, f, os.Stdout, client.Omni().State())
+	return operations.RenderChangesSummary(os.Stdout, changes)
 }
 
 func init() {
 	addRequiredFileFlag(diffCmd)
+	diffCmd.Flags().StringVarP(&diffCmdFlags.output, "output", "o", diffOutputUnified,
+		fmt.Sprintf("output format (%s, %s, %s)", diffOutputUnified, diffOutputSummary, diffOutputJSON))
 	templateCmd.AddCommand(diffCmd)
 }
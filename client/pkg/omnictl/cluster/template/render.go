@@ -25,13 +25,11 @@ var renderCmd = &cobra.Command{
 }
 
 func render() error {
-	f, err := os.Open(cmdFlags.TemplatePath)
+	f, err := openTemplate()
 	if err != nil {
 		return err
 	}
 
-	defer f.Close() //nolint:errcheck
-
 	return operations.RenderTemplate(f, os.Stdout)
 }
 
@@ -34,13 +34,11 @@ var statusCmd = &cobra.Command{
 }
 
 func status(ctx context.Context, client *client.Client) error {
-	f, err := os.Open(cmdFlags.TemplatePath)
+	f, err := openTemplate()
 	if err != nil {
 		return err
 	}
 
-	defer f.Close() //nolint:errcheck
-
 	if statusCmdFlags.wait > 0 {
 		var cancel context.CancelFunc
 
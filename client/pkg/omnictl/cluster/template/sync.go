@@ -16,6 +16,7 @@ import (
 )
 
 var syncCmdFlags struct {
+	reason  string
 	options operations.SyncOptions
 }
 
@@ -31,20 +32,21 @@ var syncCmd = &cobra.Command{
 	},
 }
 
-func sync(ctx context.Context, client *client.Client) error {
-	f, err := os.Open(cmdFlags.TemplatePath)
+func sync(ctx context.Context, cli *client.Client) error {
+	f, err := openTemplate()
 	if err != nil {
 		return err
 	}
 
-	defer f.Close() //nolint:errcheck
+	ctx = client.WithDestroyReason(ctx, syncCmdFlags.reason)
 
-	return operations.SyncTemplate(ctx, f, os.Stdout, client.Omni().State(), syncCmdFlags.options)
+	return operations.SyncTemplate(ctx, f, os.Stdout, cli.Omni().State(), syncCmdFlags.options)
 }
 
 func init() {
 	addRequiredFileFlag(syncCmd)
 	syncCmd.PersistentFlags().BoolVarP(&syncCmdFlags.options.Verbose, "verbose", "v", false, "verbose output (show diff for each resource)")
 	syncCmd.PersistentFlags().BoolVarP(&syncCmdFlags.options.DryRun, "dry-run", "d", false, "dry run")
+	syncCmd.PersistentFlags().StringVar(&syncCmdFlags.reason, "reason", "", "reason of the deletion of the resources removed from the template, recorded by Omni and might be required by its policy")
 	templateCmd.AddCommand(syncCmd)
 }
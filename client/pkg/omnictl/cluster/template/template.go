@@ -6,8 +6,13 @@
 package template
 
 import (
+	"bytes"
+	"io"
+
 	"github.com/siderolabs/gen/ensure"
 	"github.com/spf13/cobra"
+
+	pkgtemplate "github.com/siderolabs/omni/client/pkg/template"
 )
 
 // cmdFlags contains shared cluster template flags.
@@ -34,3 +39,13 @@ func addRequiredFileFlag(cmd *cobra.Command) {
 	cmd.PersistentFlags().StringVarP(&cmdFlags.TemplatePath, "file", "f", "", "path to the cluster template file.")
 	ensure.NoError(cmd.MarkPersistentFlagRequired("file"))
 }
+
+// openTemplate reads the cluster template file with the included files expanded.
+func openTemplate() (io.Reader, error) {
+	data, _, err := pkgtemplate.ExpandFile(cmdFlags.TemplatePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.NewReader(data), nil
+}
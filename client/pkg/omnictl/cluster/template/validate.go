@@ -5,8 +5,6 @@
 package template
 
 import (
-	"os"
-
 	"github.com/spf13/cobra"
 
 	"github.com/siderolabs/omni/client/pkg/template/operations"
@@ -25,13 +23,11 @@ var validateCmd = &cobra.Command{
 }
 
 func validate() error {
-	f, err := os.Open(cmdFlags.TemplatePath)
+	f, err := openTemplate()
 	if err != nil {
 		return err
 	}
 
-	defer f.Close() //nolint:errcheck
-
 	return operations.ValidateTemplate(f)
 }
 
@@ -0,0 +1,115 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+)
+
+var upgradeStatusCmdFlags struct {
+	follow bool
+}
+
+var errStopWatch = errors.New("stop watch")
+
+var upgradeStatusCmd = &cobra.Command{
+	Use:   "upgrade-status cluster-name",
+	Short: "Show the progress of the Talos and Kubernetes upgrades of the cluster",
+	Long:  `Shows the state of the Talos and Kubernetes upgrades of the cluster and the upgrade phase of each machine.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		return access.WithClient(upgradeStatus(args[0]))
+	},
+}
+
+func upgradeStatus(clusterName string) func(context.Context, *client.Client) error {
+	return func(ctx context.Context, client *client.Client) error {
+		err := client.Management().WatchUpgradeProgress(ctx, clusterName, func(progress *management.WatchUpgradeProgressResponse) error {
+			if upgradeStatusCmdFlags.follow {
+				fmt.Printf("--- %s\n", time.Now().Format(time.TimeOnly))
+			}
+
+			if err := printUpgradeProgress(os.Stdout, progress); err != nil {
+				return err
+			}
+
+			if !upgradeStatusCmdFlags.follow {
+				return errStopWatch
+			}
+
+			return nil
+		})
+		if errors.Is(err, errStopWatch) {
+			return nil
+		}
+
+		return err
+	}
+}
+
+func printUpgradeProgress(w io.Writer, progress *management.WatchUpgradeProgressResponse) error {
+	for _, upgrade := range []struct {
+		status   *management.WatchUpgradeProgressResponse_Upgrade
+		name     string
+		machines []*management.WatchUpgradeProgressResponse_Machine
+	}{
+		{name: "Talos", status: progress.Talos, machines: progress.TalosMachines},
+		{name: "Kubernetes", status: progress.Kubernetes, machines: progress.KubernetesMachines},
+	} {
+		if upgrade.status == nil {
+			continue
+		}
+
+		summary := []string{upgrade.status.Phase}
+
+		for _, s := range []string{upgrade.status.Step, upgrade.status.Status, upgrade.status.Error} {
+			if s != "" {
+				summary = append(summary, s)
+			}
+		}
+
+		fmt.Fprintf(w, "%s %s: %s\n", upgrade.name, upgrade.status.TargetVersion, strings.Join(summary, ", ")) //nolint:errcheck
+
+		writer := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+
+		fmt.Fprintf(writer, "  NODE\tMACHINE\tROLE\tVERSION\tPHASE\tERROR\n") //nolint:errcheck
+
+		for _, machine := range upgrade.machines {
+			role := "worker"
+			if machine.ControlPlane {
+				role = "control plane"
+			}
+
+			fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\t%s\n", machine.Nodename, machine.Id, role, machine.Version, machine.Phase, machine.Error) //nolint:errcheck
+		}
+
+		if err := writer.Flush(); err != nil {
+			return err
+		}
+
+		fmt.Fprintln(w) //nolint:errcheck
+	}
+
+	return nil
+}
+
+func init() {
+	clusterCmd.AddCommand(upgradeStatusCmd)
+
+	upgradeStatusCmd.Flags().BoolVarP(&upgradeStatusCmdFlags.follow, "follow", "f", false, "print the progress each time it changes")
+}
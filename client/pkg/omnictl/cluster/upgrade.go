@@ -0,0 +1,426 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/omnictl/cluster/internal/upgradeplan"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+)
+
+var upgradeCmdFlags struct {
+	cluster           string
+	talosVersion      string
+	kubernetesVersion string
+	confirm           bool
+	autoApprove       bool
+}
+
+var upgradeCmd = &cobra.Command{
+	Use:   "upgrade",
+	Short: "Upgrade Talos and/or Kubernetes on the cluster",
+	Long: `Computes and prints the upgrade plan: the order of the upgrades, the machines upgraded in each batch and the estimated number of reboots. ` +
+		`The plan is applied only with --confirm (asks for a confirmation) or --auto-approve. ` +
+		`Versions can be given as a pattern, e.g. 1.7.x, which resolves to the latest available matching version. ` +
+		`When both Talos and Kubernetes are upgraded, the Kubernetes upgrade starts after the Talos upgrade is done.`,
+	Example: `  omnictl cluster upgrade --cluster my-cluster --talos 1.7.x --k8s 1.30.x --confirm`,
+	Args:    cobra.NoArgs,
+	RunE: func(*cobra.Command, []string) error {
+		if upgradeCmdFlags.talosVersion == "" && upgradeCmdFlags.kubernetesVersion == "" {
+			return errors.New("at least one of --talos and --k8s should be set")
+		}
+
+		return access.WithClient(upgrade)
+	},
+}
+
+func upgrade(ctx context.Context, client *client.Client) error {
+	st := client.Omni().State()
+
+	cluster, err := safe.StateGetByID[*omni.Cluster](ctx, st, upgradeCmdFlags.cluster)
+	if err != nil {
+		return err
+	}
+
+	plan, err := computeUpgradePlan(ctx, st, cluster)
+	if err != nil {
+		return err
+	}
+
+	if err = printUpgradePlan(os.Stdout, cluster.Metadata().ID(), plan); err != nil {
+		return err
+	}
+
+	if plan.Talos == nil && plan.Kubernetes == nil {
+		return nil
+	}
+
+	if plan.Kubernetes != nil {
+		if err = client.Management().WithCluster(cluster.Metadata().ID()).KubernetesUpgradePreChecks(ctx, plan.Kubernetes.ToVersion); err != nil {
+			return fmt.Errorf("kubernetes upgrade pre-checks failed: %w", err)
+		}
+	}
+
+	switch {
+	case upgradeCmdFlags.autoApprove:
+	case upgradeCmdFlags.confirm:
+		buf := bufio.NewReader(os.Stdin)
+
+		fmt.Print("apply the upgrade plan? [y/N]: ")
+
+		choice, err := buf.ReadString('\n')
+		if err != nil {
+			return err
+		}
+
+		if strings.TrimSpace(strings.ToLower(choice)) != "y" {
+			return fmt.Errorf("operation was aborted")
+		}
+	default:
+		fmt.Println("the plan was not applied, run the command with --confirm or --auto-approve to apply it")
+
+		return nil
+	}
+
+	if plan.Talos != nil {
+		if err = updateClusterVersions(ctx, st, cluster.Metadata().ID(), func(spec *specs.ClusterSpec) {
+			spec.TalosVersion = plan.Talos.ToVersion
+		}); err != nil {
+			return err
+		}
+
+		fmt.Printf("upgrading Talos to %s\n", plan.Talos.ToVersion)
+
+		if plan.Kubernetes != nil {
+			if err = waitTalosUpgrade(ctx, st, cluster.Metadata().ID(), plan.Talos.ToVersion); err != nil {
+				return err
+			}
+		}
+	}
+
+	if plan.Kubernetes != nil {
+		if err = updateClusterVersions(ctx, st, cluster.Metadata().ID(), func(spec *specs.ClusterSpec) {
+			spec.KubernetesVersion = plan.Kubernetes.ToVersion
+		}); err != nil {
+			return err
+		}
+
+		fmt.Printf("upgrading Kubernetes to %s\n", plan.Kubernetes.ToVersion)
+	}
+
+	fmt.Printf("run 'omnictl cluster upgrade-status %s --follow' to follow the progress\n", cluster.Metadata().ID())
+
+	return nil
+}
+
+func computeUpgradePlan(ctx context.Context, st state.State, cluster *omni.Cluster) (upgradeplan.Plan, error) {
+	var plan upgradeplan.Plan
+
+	targetTalosVersion := cluster.TypedSpec().Value.TalosVersion
+
+	if upgradeCmdFlags.talosVersion != "" {
+		talosUpgradeStatus, err := safe.StateGetByID[*omni.TalosUpgradeStatus](ctx, st, cluster.Metadata().ID())
+		if err != nil {
+			return plan, err
+		}
+
+		targetTalosVersion, err = upgradeplan.ResolveVersion("Talos", upgradeCmdFlags.talosVersion,
+			append([]string{cluster.TypedSpec().Value.TalosVersion}, talosUpgradeStatus.TypedSpec().Value.UpgradeVersions...))
+		if err != nil {
+			return plan, err
+		}
+
+		if targetTalosVersion != cluster.TypedSpec().Value.TalosVersion {
+			machines, err := talosUpgradeMachines(ctx, st, cluster.Metadata().ID())
+			if err != nil {
+				return plan, err
+			}
+
+			plan.Talos = upgradeplan.Talos(cluster.TypedSpec().Value.TalosVersion, targetTalosVersion, machines)
+		}
+	}
+
+	if upgradeCmdFlags.kubernetesVersion != "" {
+		talosVersion, err := safe.StateGetByID[*omni.TalosVersion](ctx, st, targetTalosVersion)
+		if err != nil {
+			return plan, fmt.Errorf("failed to get the Talos version %q: %w", targetTalosVersion, err)
+		}
+
+		targetKubernetesVersion, err := upgradeplan.ResolveVersion("Kubernetes", upgradeCmdFlags.kubernetesVersion,
+			talosVersion.TypedSpec().Value.CompatibleKubernetesVersions)
+		if err != nil {
+			return plan, fmt.Errorf("%w (compatible with Talos %s)", err, targetTalosVersion)
+		}
+
+		if targetKubernetesVersion != cluster.TypedSpec().Value.KubernetesVersion {
+			kubernetesStatus, err := safe.StateGetByID[*omni.KubernetesStatus](ctx, st, cluster.Metadata().ID())
+			if err != nil {
+				return plan, fmt.Errorf("failed to get the Kubernetes status of the cluster: %w", err)
+			}
+
+			heldNodes, err := heldNodenames(ctx, st, cluster.Metadata().ID())
+			if err != nil {
+				return plan, err
+			}
+
+			plan.Kubernetes = upgradeplan.Kubernetes(cluster.TypedSpec().Value.KubernetesVersion, targetKubernetesVersion,
+				kubernetesStatus.TypedSpec().Value, heldNodes)
+		}
+	}
+
+	return plan, nil
+}
+
+// talosUpgradeMachines collects the cluster machines with their Talos versions, roles and the upgrade lock and hold annotations.
+func talosUpgradeMachines(ctx context.Context, st state.State, clusterID resource.ID) ([]upgradeplan.Machine, error) {
+	clusterQuery := state.WithLabelQuery(resource.LabelEqual(omni.LabelCluster, clusterID))
+
+	talosVersions, err := safe.StateListAll[*omni.ClusterMachineTalosVersion](ctx, st, clusterQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	machineSetNodeList, err := safe.StateListAll[*omni.MachineSetNode](ctx, st, clusterQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	identities, err := safe.StateListAll[*omni.ClusterMachineIdentity](ctx, st, clusterQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	nodenames := map[resource.ID]string{}
+
+	identities.ForEach(func(identity *omni.ClusterMachineIdentity) {
+		nodenames[identity.Metadata().ID()] = identity.TypedSpec().Value.Nodename
+	})
+
+	machineSetNodes := map[resource.ID]upgradeplan.Machine{}
+
+	machineSetNodeList.ForEach(func(machineSetNode *omni.MachineSetNode) {
+		_, locked := machineSetNode.Metadata().Annotations().Get(omni.MachineLocked)
+		_, held := machineSetNode.Metadata().Annotations().Get(omni.MachineUpgradeHold)
+
+		machineSetNodes[machineSetNode.Metadata().ID()] = upgradeplan.Machine{
+			Created: machineSetNode.Metadata().Created(),
+			Locked:  locked,
+			Held:    held,
+		}
+	})
+
+	result := make([]upgradeplan.Machine, 0, talosVersions.Len())
+
+	talosVersions.ForEach(func(talosVersion *omni.ClusterMachineTalosVersion) {
+		machine := machineSetNodes[talosVersion.Metadata().ID()]
+
+		_, machine.ControlPlane = talosVersion.Metadata().Labels().Get(omni.LabelControlPlaneRole)
+
+		machine.ID = talosVersion.Metadata().ID()
+		machine.Nodename = nodenames[talosVersion.Metadata().ID()]
+		machine.TalosVersion = talosVersion.TypedSpec().Value.TalosVersion
+
+		result = append(result, machine)
+	})
+
+	return result, nil
+}
+
+// heldNodenames returns the nodenames of the cluster machines which have the upgrade hold annotation.
+func heldNodenames(ctx context.Context, st state.State, clusterID resource.ID) (map[string]struct{}, error) {
+	clusterQuery := state.WithLabelQuery(resource.LabelEqual(omni.LabelCluster, clusterID))
+
+	machineSetNodes, err := safe.StateListAll[*omni.MachineSetNode](ctx, st, clusterQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	identities, err := safe.StateListAll[*omni.ClusterMachineIdentity](ctx, st, clusterQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	nodenames := map[resource.ID]string{}
+
+	identities.ForEach(func(identity *omni.ClusterMachineIdentity) {
+		nodenames[identity.Metadata().ID()] = identity.TypedSpec().Value.Nodename
+	})
+
+	held := map[string]struct{}{}
+
+	machineSetNodes.ForEach(func(machineSetNode *omni.MachineSetNode) {
+		if _, ok := machineSetNode.Metadata().Annotations().Get(omni.MachineUpgradeHold); ok {
+			if nodename, ok := nodenames[machineSetNode.Metadata().ID()]; ok {
+				held[nodename] = struct{}{}
+			}
+		}
+	})
+
+	return held, nil
+}
+
+func printUpgradePlan(w io.Writer, clusterID resource.ID, plan upgradeplan.Plan) error {
+	if plan.Talos == nil && plan.Kubernetes == nil {
+		fmt.Fprintf(w, "the cluster %q already runs the requested versions, nothing to upgrade\n", clusterID) //nolint:errcheck
+
+		return nil
+	}
+
+	fmt.Fprintf(w, "Upgrade plan for the cluster %q:\n\n", clusterID) //nolint:errcheck
+
+	stage := 0
+	reboots := 0
+
+	if plan.Talos != nil {
+		stage++
+
+		fmt.Fprintf(w, "%d. Talos %s -> %s\n", stage, plan.Talos.FromVersion, plan.Talos.ToVersion) //nolint:errcheck
+
+		writer := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+
+		fmt.Fprintf(writer, "   BATCH\tNODE\tMACHINE\tROLE\tNOTE\n") //nolint:errcheck
+
+		var locked int
+
+		for i, machine := range plan.Talos.Batches {
+			role := "worker"
+			if machine.ControlPlane {
+				role = "control plane"
+			}
+
+			var note string
+
+			if machine.Locked {
+				note = "locked, the upgrade pauses until it is unlocked"
+
+				locked++
+			}
+
+			fmt.Fprintf(writer, "   %d\t%s\t%s\t%s\t%s\n", i+1, machine.Nodename, machine.ID, role, note) //nolint:errcheck
+		}
+
+		if err := writer.Flush(); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(w, "   machines are upgraded one at a time, each machine reboots once\n") //nolint:errcheck
+
+		if locked > 0 {
+			fmt.Fprintf(w, "   %d machine(s) are locked\n", locked) //nolint:errcheck
+		}
+
+		if len(plan.Talos.Held) > 0 {
+			fmt.Fprintf(w, "   held machines are skipped: %s\n", strings.Join(plan.Talos.Held, ", ")) //nolint:errcheck
+		}
+
+		fmt.Fprintf(w, "   estimated reboots: %d\n\n", len(plan.Talos.Batches)) //nolint:errcheck
+
+		reboots += len(plan.Talos.Batches)
+	}
+
+	if plan.Kubernetes != nil {
+		stage++
+
+		fmt.Fprintf(w, "%d. Kubernetes %s -> %s\n", stage, plan.Kubernetes.FromVersion, plan.Kubernetes.ToVersion) //nolint:errcheck
+
+		writer := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+
+		fmt.Fprintf(writer, "   STEP\tCOMPONENT\tNODES\n") //nolint:errcheck
+
+		for i, step := range plan.Kubernetes.Steps {
+			fmt.Fprintf(writer, "   %d\t%s\t%s\n", i+1, step.Component, strings.Join(step.Nodes, ", ")) //nolint:errcheck
+		}
+
+		if err := writer.Flush(); err != nil {
+			return err
+		}
+
+		if len(plan.Kubernetes.Held) > 0 {
+			fmt.Fprintf(w, "   held machines are skipped: %s\n", strings.Join(plan.Kubernetes.Held, ", ")) //nolint:errcheck
+		}
+
+		fmt.Fprintf(w, "   components are updated one node at a time without rebooting the machines\n") //nolint:errcheck
+		fmt.Fprintf(w, "   estimated reboots: 0\n\n")                                                   //nolint:errcheck
+	}
+
+	fmt.Fprintf(w, "Total estimated reboots: %d\n", reboots) //nolint:errcheck
+
+	return nil
+}
+
+func updateClusterVersions(ctx context.Context, st state.State, clusterID resource.ID, update func(spec *specs.ClusterSpec)) error {
+	_, err := safe.StateUpdateWithConflicts(ctx, st, omni.NewCluster(resources.DefaultNamespace, clusterID).Metadata(), func(res *omni.Cluster) error {
+		update(res.TypedSpec().Value)
+
+		return nil
+	})
+
+	return err
+}
+
+func waitTalosUpgrade(ctx context.Context, st state.State, clusterID resource.ID, targetVersion string) error {
+	fmt.Println("waiting for the Talos upgrade to finish before upgrading Kubernetes")
+
+	var lastStatus string
+
+	_, err := st.WatchFor(ctx,
+		omni.NewTalosUpgradeStatus(resources.DefaultNamespace, clusterID).Metadata(),
+		state.WithCondition(func(r resource.Resource) (bool, error) {
+			upgradeStatus, ok := r.(*omni.TalosUpgradeStatus)
+			if !ok {
+				return false, nil
+			}
+
+			spec := upgradeStatus.TypedSpec().Value
+
+			if spec.Status != lastStatus && spec.Status != "" {
+				lastStatus = spec.Status
+
+				fmt.Printf("talos upgrade: %s\n", spec.Status)
+			}
+
+			switch spec.Phase { //nolint:exhaustive
+			case specs.TalosUpgradeStatusSpec_Done:
+				return spec.LastUpgradeVersion == targetVersion, nil
+			case specs.TalosUpgradeStatusSpec_Failed:
+				return false, fmt.Errorf("talos upgrade failed: %s", spec.Error)
+			}
+
+			return false, nil
+		}),
+	)
+
+	return err
+}
+
+func init() {
+	clusterCmd.AddCommand(upgradeCmd)
+
+	upgradeCmd.Flags().StringVar(&upgradeCmdFlags.cluster, "cluster", "", "cluster to upgrade")
+	upgradeCmd.Flags().StringVar(&upgradeCmdFlags.talosVersion, "talos", "", "target Talos version, e.g. 1.7.6 or 1.7.x")
+	upgradeCmd.Flags().StringVar(&upgradeCmdFlags.kubernetesVersion, "k8s", "", "target Kubernetes version, e.g. 1.30.3 or 1.30.x")
+	upgradeCmd.Flags().BoolVar(&upgradeCmdFlags.confirm, "confirm", false, "ask for a confirmation and apply the upgrade plan")
+	upgradeCmd.Flags().BoolVar(&upgradeCmdFlags.autoApprove, "auto-approve", false, "apply the upgrade plan without asking for a confirmation")
+
+	upgradeCmd.MarkFlagRequired("cluster") //nolint:errcheck
+	upgradeCmd.MarkFlagsMutuallyExclusive("confirm", "auto-approve")
+}
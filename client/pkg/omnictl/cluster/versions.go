@@ -0,0 +1,64 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+)
+
+var versionsCmd = &cobra.Command{
+	Use:   "versions cluster-name",
+	Short: "Show the versions of Talos, kubelet and container runtime on the cluster nodes",
+	Long:  `Shows the versions of the components running on each node of the cluster, and whether the nodes run different versions, e.g. after a partial upgrade.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		return access.WithClient(versions(args[0]))
+	},
+}
+
+func versions(clusterID resource.ID) func(context.Context, *client.Client) error {
+	return func(ctx context.Context, client *client.Client) error {
+		nodeVersions, err := safe.StateGetByID[*omni.ClusterNodeVersions](ctx, client.Omni().State(), clusterID)
+		if err != nil {
+			return err
+		}
+
+		writer := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+		fmt.Fprintf(writer, "NODE\tMACHINE\tTALOS\tKUBERNETES\tKUBELET\tCONTAINER RUNTIME\tOS IMAGE\tKERNEL\n") //nolint:errcheck
+
+		for _, node := range nodeVersions.TypedSpec().Value.Nodes {
+			fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", //nolint:errcheck
+				node.Nodename, node.MachineId, node.TalosVersion, node.KubernetesVersion,
+				node.KubeletVersion, node.ContainerRuntimeVersion, node.OsImage, node.KernelVersion,
+			)
+		}
+
+		if err = writer.Flush(); err != nil {
+			return err
+		}
+
+		if nodeVersions.TypedSpec().Value.VersionDrift {
+			fmt.Printf("\nversion drift detected in the cluster %q\n", clusterID)
+		}
+
+		return nil
+	}
+}
+
+func init() {
+	clusterCmd.AddCommand(versionsCmd)
+}
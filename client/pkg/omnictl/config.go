@@ -73,6 +73,30 @@ var configIdentityCmd = &cobra.Command{
 	},
 }
 
+// configHardwareKeyCmd represents the `config hardware-key` command.
+var configHardwareKeyCmd = &cobra.Command{
+	Use:   "hardware-key <key-id>",
+	Short: "Set the gpg key stored on a hardware token (e.g. YubiKey) to sign the requests for the current context",
+	Long: `The key must be an OpenPGP signing key with an expiration, stored on a hardware token available via gpg-agent.
+Configure the touch policy of the token to require a touch for each signature. Pass an empty string to switch back to the software keys.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		conf, err := config.Init(access.CmdFlags.Omniconfig, false)
+		if err != nil {
+			return err
+		}
+
+		context, err := conf.GetContext(access.CmdFlags.Context)
+		if err != nil {
+			return err
+		}
+
+		context.Auth.SideroV1.HardwareKey = args[0]
+
+		return conf.Save()
+	},
+}
+
 // configContextCmd represents the `config context` command.
 var configContextCmd = &cobra.Command{
 	Use:     "context <context>",
@@ -88,6 +112,10 @@ var configContextCmd = &cobra.Command{
 
 		context := args[0]
 
+		if _, ok := conf.Contexts[context]; !ok {
+			return fmt.Errorf("context not found: %s", context)
+		}
+
 		conf.Context = context
 
 		return conf.Save()
@@ -154,7 +182,7 @@ var configGetContextsCmd = &cobra.Command{
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
 		defer w.Flush() //nolint:errcheck
 
-		_, err = fmt.Fprintln(w, "CURRENT\tNAME\tURL")
+		_, err = fmt.Fprintln(w, "CURRENT\tNAME\tURL\tCLUSTER\tOUTPUT")
 		if err != nil {
 			return err
 		}
@@ -168,7 +196,7 @@ var configGetContextsCmd = &cobra.Command{
 				current = "*"
 			}
 
-			_, err = fmt.Fprintf(w, "%s\t%s\t%s\n", current, name, context.URL)
+			_, err = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", current, name, context.URL, context.Defaults.Cluster, context.Defaults.Output)
 			if err != nil {
 				return err
 			}
@@ -178,6 +206,45 @@ var configGetContextsCmd = &cobra.Command{
 	},
 }
 
+// configSetDefaultsCmdFlags represents the `config set-defaults` command flags.
+var configSetDefaultsCmdFlags struct {
+	output  string
+	cluster string
+}
+
+// configSetDefaultsCmd represents the `config set-defaults` command.
+var configSetDefaultsCmd = &cobra.Command{
+	Use:   "set-defaults",
+	Short: "Set the default flag values for the current context",
+	Long: fmt.Sprintf(`Sets the default output format and the default cluster used by the commands run with the current context.
+Only the flags passed are changed, pass an empty value to clear the default.
+The defaults can be overridden by the %s and %s environment variables.`, access.OutputEnvVar, access.ClusterEnvVar),
+	Example: `  omnictl config set-defaults --cluster my-cluster --output yaml
+  omnictl --context staging config set-defaults --cluster=""`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		conf, err := config.Init(access.CmdFlags.Omniconfig, false)
+		if err != nil {
+			return err
+		}
+
+		context, err := conf.GetContext(access.CmdFlags.Context)
+		if err != nil {
+			return err
+		}
+
+		if cmd.Flags().Changed("output") {
+			context.Defaults.Output = configSetDefaultsCmdFlags.output
+		}
+
+		if cmd.Flags().Changed("cluster") {
+			context.Defaults.Cluster = configSetDefaultsCmdFlags.cluster
+		}
+
+		return conf.Save()
+	},
+}
+
 // configMergeCmd represents the `config merge` command.
 var configMergeCmd = &cobra.Command{
 	Use:   "merge <from>",
@@ -245,6 +312,9 @@ var configInfoCmdTemplate = template.Must(template.New("configInfoCmdTemplate").
 Current context: {{ .Context }}
 URL:             {{ .APIURL }}
 Identity:        {{ .Identity }}
+Hardware key:    {{ .HardwareKey }}
+Default cluster: {{ .DefaultCluster }}
+Default output:  {{ .DefaultOutput }}
 `)))
 
 // configInfoCmd represents the `config info` command.
@@ -267,9 +337,12 @@ var configInfoCmd = &cobra.Command{
 
 		var buf bytes.Buffer
 		err = configInfoCmdTemplate.Execute(&buf, map[string]string{
-			"Context":  conf.Context,
-			"APIURL":   context.URL,
-			"Identity": context.Auth.SideroV1.Identity,
+			"Context":        conf.Context,
+			"APIURL":         context.URL,
+			"Identity":       context.Auth.SideroV1.Identity,
+			"HardwareKey":    context.Auth.SideroV1.HardwareKey,
+			"DefaultCluster": context.Defaults.Cluster,
+			"DefaultOutput":  context.Defaults.Output,
 		})
 		if err != nil {
 			return err
@@ -300,9 +373,11 @@ func init() {
 	configCmd.AddCommand(
 		configURLCmd,
 		configIdentityCmd,
+		configHardwareKeyCmd,
 		configContextCmd,
 		configAddCmd,
 		configGetContextsCmd,
+		configSetDefaultsCmd,
 		configMergeCmd,
 		configNewCmd,
 		configInfoCmd,
@@ -311,6 +386,9 @@ func init() {
 	configAddCmd.Flags().StringVar(&configAddCmdFlags.url, "url", config.DefaultContext.URL, "URL of the server")
 	configAddCmd.Flags().StringVar(&configAddCmdFlags.identity, "identity", "", "identity to use for authentication")
 
+	configSetDefaultsCmd.Flags().StringVarP(&configSetDefaultsCmdFlags.output, "output", "o", "", "default output format (json, table, yaml, jsonpath)")
+	configSetDefaultsCmd.Flags().StringVarP(&configSetDefaultsCmdFlags.cluster, "cluster", "c", "", "default cluster")
+
 	configNewCmd.Flags().StringVar(&configNewCmdFlags.url, "url", config.DefaultContext.URL, "URL of the server")
 	configNewCmd.Flags().StringVar(&configNewCmdFlags.identity, "identity", "", "identity to use for authentication")
 
@@ -13,8 +13,17 @@ type Config struct {
 
 // Context represents a context in the config.
 type Context struct {
-	URL  string `yaml:"url"`
-	Auth Auth   `yaml:"auth,omitempty"`
+	URL      string   `yaml:"url"`
+	Auth     Auth     `yaml:"auth,omitempty"`
+	Defaults Defaults `yaml:"defaults,omitempty"`
+}
+
+// Defaults are the default values of the command flags used with the context.
+type Defaults struct {
+	// Output is the default output format of the commands which support it.
+	Output string `yaml:"output,omitempty"`
+	// Cluster is the default cluster of the commands which require a cluster.
+	Cluster string `yaml:"cluster,omitempty"`
 }
 
 // Auth contains the authentication configuration for a context.
@@ -27,6 +36,10 @@ type Auth struct {
 // SideroV1 is the auth configuration v1.
 type SideroV1 struct {
 	Identity string `yaml:"identity,omitempty"`
+	// HardwareKey is the ID of the gpg key stored on a hardware token to sign the requests with.
+	//
+	// If empty, a software key is generated and stored locally.
+	HardwareKey string `yaml:"hardwareKey,omitempty"`
 }
 
 // PlaceholderURL is a placeholder url.
@@ -0,0 +1,111 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omnictl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+)
+
+var (
+	configPatchValidateFlags struct {
+		file       string
+		machine    string
+		machineSet string
+	}
+
+	// configPatchCmd represents the config-patch command.
+	configPatchCmd = &cobra.Command{
+		Use:     "config-patch",
+		Aliases: []string{"configpatch", "cp"},
+		Short:   "Config patch related subcommands.",
+	}
+
+	configPatchValidateCmd = &cobra.Command{
+		Use:   "validate",
+		Short: "Validate a config patch against the current machine config without applying it",
+		Long: `Validate a config patch against the current machine config without applying it.
+
+The patch is applied on top of the current machine config of the cluster machine, or of each machine of the machine set,
+and the resulting config is validated. The diff of the config is printed with the secrets redacted.`,
+		Example: `  omnictl config-patch validate -f patch.yaml --machine 430d882a-51a8-48b3-ae00-90c5b0b5b0b0
+  cat patch.yaml | omnictl config-patch validate -f - --machine-set my-cluster-workers`,
+		Args: cobra.NoArgs,
+		RunE: func(*cobra.Command, []string) error {
+			if (configPatchValidateFlags.machine == "") == (configPatchValidateFlags.machineSet == "") {
+				return errors.New("exactly one of --machine or --machine-set is required")
+			}
+
+			data, err := readConfigPatch(configPatchValidateFlags.file)
+			if err != nil {
+				return err
+			}
+
+			return access.WithClient(func(ctx context.Context, client *client.Client) error {
+				results, err := client.Management().ValidateConfigPatch(ctx, string(data), configPatchValidateFlags.machine, configPatchValidateFlags.machineSet)
+				if err != nil {
+					return err
+				}
+
+				if len(results) == 0 {
+					return errors.New("no machines to validate the config patch against")
+				}
+
+				failed := 0
+
+				for _, result := range results {
+					if result.Error != "" {
+						failed++
+
+						fmt.Printf("machine %s: invalid: %s\n", result.Machine, result.Error)
+					} else {
+						fmt.Printf("machine %s: valid\n", result.Machine)
+					}
+
+					for _, warning := range result.Warnings {
+						fmt.Printf("  warning: %s\n", warning)
+					}
+
+					if result.Diff != "" {
+						fmt.Printf("\n%s\n", result.Diff)
+					}
+				}
+
+				if failed > 0 {
+					return fmt.Errorf("the config patch is not valid for %d of %d machines", failed, len(results))
+				}
+
+				return nil
+			})
+		},
+	}
+)
+
+func readConfigPatch(path string) ([]byte, error) {
+	if path == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+
+	return os.ReadFile(path)
+}
+
+func init() {
+	RootCmd.AddCommand(configPatchCmd)
+	configPatchCmd.AddCommand(configPatchValidateCmd)
+
+	configPatchValidateCmd.Flags().StringVarP(&configPatchValidateFlags.file, "file", "f", "", "path to the config patch, - reads it from stdin")
+	configPatchValidateCmd.Flags().StringVar(&configPatchValidateFlags.machine, "machine", "", "ID of the cluster machine to validate the patch against")
+	configPatchValidateCmd.Flags().StringVar(&configPatchValidateFlags.machineSet, "machine-set", "", "ID of the machine set to validate the patch against each of its machines")
+
+	configPatchValidateCmd.MarkFlagRequired("file") //nolint:errcheck
+}
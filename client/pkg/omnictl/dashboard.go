@@ -0,0 +1,46 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omnictl
+
+import (
+	"context"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/omnictl/dashboard"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+)
+
+var dashboardCmdFlags struct {
+	cluster         string
+	refreshInterval time.Duration
+}
+
+// dashboardCmd represents the dashboard command.
+var dashboardCmd = &cobra.Command{
+	Use:   "dashboard",
+	Short: "Show the live terminal dashboard of the clusters, the machines and the ongoing operations.",
+	Long: `Shows the clusters, the machine health and the ongoing upgrades and scaling operations, updated live from the resource watches.
+The dashboard is redrawn only when the resources change, at most once per the refresh interval.`,
+	Args: cobra.NoArgs,
+	RunE: func(*cobra.Command, []string) error {
+		return access.WithClient(func(ctx context.Context, client *client.Client) error {
+			return dashboard.Run(ctx, client.Omni().State(), dashboard.Options{
+				Endpoint:        client.Endpoint(),
+				Cluster:         dashboardCmdFlags.cluster,
+				RefreshInterval: dashboardCmdFlags.refreshInterval,
+			})
+		})
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(dashboardCmd)
+
+	dashboardCmd.Flags().StringVarP(&dashboardCmdFlags.cluster, "cluster", "c", "", "show only the given cluster and its machines")
+	dashboardCmd.Flags().DurationVar(&dashboardCmdFlags.refreshInterval, "refresh-interval", time.Second, "minimum interval between the redraws")
+}
@@ -0,0 +1,153 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package dashboard implements the terminal dashboard of omnictl.
+package dashboard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"golang.org/x/term"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+const (
+	enterAltScreen = "\033[?1049h\033[?25l"
+	exitAltScreen  = "\033[?25h\033[?1049l"
+	clearScreen    = "\033[H\033[2J"
+)
+
+// Options configures the dashboard.
+type Options struct {
+	// Endpoint is shown in the dashboard header.
+	Endpoint string
+	// Cluster limits the dashboard to the single cluster.
+	Cluster string
+	// RefreshInterval is the minimum interval between the redraws, the updates coming in between are coalesced.
+	RefreshInterval time.Duration
+}
+
+// Run watches the resources and renders the dashboard until the user quits or the context is canceled.
+//
+// The dashboard is redrawn only when the resources change, so it stays usable over the slow links.
+//
+//nolint:gocognit,gocyclo,cyclop
+func Run(ctx context.Context, st state.State, options Options) error {
+	stdin, stdout := int(os.Stdin.Fd()), int(os.Stdout.Fd())
+
+	if !term.IsTerminal(stdin) || !term.IsTerminal(stdout) {
+		return errors.New("the dashboard requires an interactive terminal")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	watchCh := make(chan state.Event)
+
+	for _, resourceType := range []resource.Type{
+		omni.ClusterStatusType,
+		omni.MachineStatusType,
+		omni.TalosUpgradeStatusType,
+		omni.KubernetesUpgradeStatusType,
+	} {
+		opts := []state.WatchKindOption{state.WithBootstrapContents(true)}
+
+		if options.Cluster != "" {
+			if resourceType == omni.MachineStatusType {
+				opts = append(opts, state.WatchWithLabelQuery(resource.LabelEqual(omni.LabelCluster, options.Cluster)))
+			} else {
+				// the cluster level resources have the cluster ID as their ID
+				opts = append(opts, state.WatchWithIDQuery(resource.IDRegexpMatch(regexp.MustCompile("^"+regexp.QuoteMeta(options.Cluster)+"$"))))
+			}
+		}
+
+		if err := st.WatchKind(ctx, resource.NewMetadata(resources.DefaultNamespace, resourceType, "", resource.VersionUndefined), watchCh, opts...); err != nil {
+			return err
+		}
+	}
+
+	oldState, err := term.MakeRaw(stdin)
+	if err != nil {
+		return fmt.Errorf("failed to switch the terminal to the raw mode: %w", err)
+	}
+
+	defer term.Restore(stdin, oldState) //nolint:errcheck
+
+	fmt.Fprint(os.Stdout, enterAltScreen) //nolint:errcheck
+
+	defer fmt.Fprint(os.Stdout, exitAltScreen) //nolint:errcheck
+
+	keyCh := make(chan byte)
+
+	go readKeys(ctx, os.Stdin, keyCh)
+
+	model := NewModel(options.Endpoint)
+
+	renderTicker := time.NewTicker(options.RefreshInterval)
+	defer renderTicker.Stop()
+
+	dirty := true
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event := <-watchCh:
+			if event.Type == state.Errored {
+				return fmt.Errorf("watch error: %w", event.Error)
+			}
+
+			if model.Update(event, time.Now()) {
+				dirty = true
+			}
+		case key := <-keyCh:
+			if !model.HandleKey(key) {
+				return nil
+			}
+
+			dirty = true
+		case <-renderTicker.C:
+			if !dirty {
+				continue
+			}
+
+			dirty = false
+
+			width, height, sizeErr := term.GetSize(stdout)
+			if sizeErr != nil {
+				width, height = 80, 24
+			}
+
+			if _, err = fmt.Fprint(os.Stdout, clearScreen+model.View(width, height)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func readKeys(ctx context.Context, r io.Reader, keyCh chan<- byte) {
+	buf := make([]byte, 1)
+
+	for {
+		if _, err := r.Read(buf); err != nil {
+			return
+		}
+
+		select {
+		case keyCh <- buf[0]:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,307 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dashboard
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+// Panel is the section of the dashboard.
+type Panel int
+
+// Panel values.
+const (
+	PanelClusters Panel = iota
+	PanelMachines
+	PanelOperations
+
+	panelCount
+)
+
+func (p Panel) String() string {
+	switch p {
+	case PanelClusters:
+		return "Clusters"
+	case PanelMachines:
+		return "Machines"
+	case PanelOperations:
+		return "Operations"
+	case panelCount:
+	}
+
+	return "Unknown"
+}
+
+// Model keeps the state of the dashboard.
+//
+// The model is updated from the resource watch events and the key presses, and is rendered by View.
+type Model struct {
+	updated           time.Time
+	clusters          map[resource.ID]*omni.ClusterStatus
+	machines          map[resource.ID]*omni.MachineStatus
+	talosUpgrades     map[resource.ID]*omni.TalosUpgradeStatus
+	kubernetesUpgrade map[resource.ID]*omni.KubernetesUpgradeStatus
+	endpoint          string
+	focused           Panel
+}
+
+// NewModel creates an empty Model.
+func NewModel(endpoint string) *Model {
+	return &Model{
+		endpoint:          endpoint,
+		clusters:          map[resource.ID]*omni.ClusterStatus{},
+		machines:          map[resource.ID]*omni.MachineStatus{},
+		talosUpgrades:     map[resource.ID]*omni.TalosUpgradeStatus{},
+		kubernetesUpgrade: map[resource.ID]*omni.KubernetesUpgradeStatus{},
+	}
+}
+
+// Update applies the watch event to the model, it returns true if the model has changed.
+func (m *Model) Update(event state.Event, now time.Time) bool {
+	if event.Resource == nil {
+		return false
+	}
+
+	var changed bool
+
+	switch res := event.Resource.(type) {
+	case *omni.ClusterStatus:
+		changed = updateMap(m.clusters, res, event.Type)
+	case *omni.MachineStatus:
+		changed = updateMap(m.machines, res, event.Type)
+	case *omni.TalosUpgradeStatus:
+		changed = updateMap(m.talosUpgrades, res, event.Type)
+	case *omni.KubernetesUpgradeStatus:
+		changed = updateMap(m.kubernetesUpgrade, res, event.Type)
+	}
+
+	if changed {
+		m.updated = now
+	}
+
+	return changed
+}
+
+func updateMap[T resource.Resource](items map[resource.ID]T, res T, eventType state.EventType) bool {
+	switch eventType { //nolint:exhaustive
+	case state.Created, state.Updated:
+		items[res.Metadata().ID()] = res
+	case state.Destroyed:
+		delete(items, res.Metadata().ID())
+	default:
+		return false
+	}
+
+	return true
+}
+
+// HandleKey handles the key press, it returns false if the dashboard should quit.
+func (m *Model) HandleKey(key byte) bool {
+	switch key {
+	case 'q', 'Q', 0x03: // Ctrl+C
+		return false
+	case '\t':
+		m.focused = (m.focused + 1) % panelCount
+	case '1':
+		m.focused = PanelClusters
+	case '2':
+		m.focused = PanelMachines
+	case '3':
+		m.focused = PanelOperations
+	}
+
+	return true
+}
+
+// View renders the dashboard to fit into the terminal of the given size.
+//
+// The focused panel gets the most of the screen, the other panels show only their first rows.
+func (m *Model) View(width, height int) string {
+	var sb strings.Builder
+
+	updated := "never"
+	if !m.updated.IsZero() {
+		updated = m.updated.Local().Format(time.TimeOnly)
+	}
+
+	fmt.Fprintf(&sb, "Omni %s | %d clusters, %d machines | updated %s\n", m.endpoint, len(m.clusters), len(m.machines), updated)
+	fmt.Fprintf(&sb, "[1] Clusters  [2] Machines  [3] Operations  [tab] next  [q] quit\n")
+
+	panels := []struct {
+		header string
+		rows   []string
+		panel  Panel
+	}{
+		{panel: PanelClusters, header: "NAME\tPHASE\tREADY\tK8S API\tMACHINES (HEALTHY/CONNECTED/TOTAL)", rows: m.clusterRows()},
+		{panel: PanelMachines, header: "ID\tHOSTNAME\tCLUSTER\tCONNECTED\tSTAGE\tTALOS VERSION", rows: m.machineRows()},
+		{panel: PanelOperations, header: "CLUSTER\tOPERATION\tPHASE\tSTEP", rows: m.operationRows()},
+	}
+
+	const (
+		headerLines    = 2
+		collapsedRows  = 3
+		panelOverhead  = 2 // title and table header
+		minFocusedRows = 5
+	)
+
+	focusedRows := height - headerLines - len(panels)*panelOverhead - (len(panels)-1)*collapsedRows
+	focusedRows = max(focusedRows, minFocusedRows)
+
+	for _, panel := range panels {
+		limit := collapsedRows
+		marker := " "
+
+		if panel.panel == m.focused {
+			limit = focusedRows
+			marker = ">"
+		}
+
+		fmt.Fprintf(&sb, "%s %s (%d)\n", marker, strings.ToUpper(panel.panel.String()), len(panel.rows))
+
+		rows := panel.rows
+		hidden := 0
+
+		if len(rows) > limit {
+			hidden = len(rows) - limit + 1
+			rows = rows[:limit-1]
+		}
+
+		tw := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
+
+		fmt.Fprintln(tw, panel.header) //nolint:errcheck
+
+		for _, row := range rows {
+			fmt.Fprintln(tw, row) //nolint:errcheck
+		}
+
+		if hidden > 0 {
+			fmt.Fprintf(tw, "... %d more\n", hidden) //nolint:errcheck
+		}
+
+		tw.Flush() //nolint:errcheck
+	}
+
+	return truncateLines(sb.String(), width, height)
+}
+
+func (m *Model) clusterRows() []string {
+	return sortedRows(m.clusters, func(res *omni.ClusterStatus) string {
+		spec := res.TypedSpec().Value
+
+		return fmt.Sprintf("%s\t%s\t%t\t%t\t%d/%d/%d",
+			res.Metadata().ID(),
+			spec.Phase,
+			spec.Ready,
+			spec.KubernetesAPIReady,
+			spec.GetMachines().GetHealthy(),
+			spec.GetMachines().GetConnected(),
+			spec.GetMachines().GetTotal(),
+		)
+	})
+}
+
+func (m *Model) machineRows() []string {
+	return sortedRows(m.machines, func(res *omni.MachineStatus) string {
+		spec := res.TypedSpec().Value
+
+		stage := "running"
+		if spec.Maintenance {
+			stage = "maintenance"
+		}
+
+		cluster, _ := res.Metadata().Labels().Get(omni.LabelCluster)
+
+		return fmt.Sprintf("%s\t%s\t%s\t%t\t%s\t%s",
+			res.Metadata().ID(),
+			spec.GetNetwork().GetHostname(),
+			cluster,
+			spec.Connected,
+			stage,
+			spec.TalosVersion,
+		)
+	})
+}
+
+func (m *Model) operationRows() []string {
+	var rows []string
+
+	for id, res := range m.clusters {
+		switch phase := res.TypedSpec().Value.Phase; phase { //nolint:exhaustive
+		case specs.ClusterStatusSpec_SCALING_UP, specs.ClusterStatusSpec_SCALING_DOWN, specs.ClusterStatusSpec_DESTROYING:
+			rows = append(rows, fmt.Sprintf("%s\tcluster\t%s\t", id, phase))
+		}
+	}
+
+	for id, res := range m.talosUpgrades {
+		if spec := res.TypedSpec().Value; spec.Phase != specs.TalosUpgradeStatusSpec_Done && spec.Phase != specs.TalosUpgradeStatusSpec_Unknown {
+			rows = append(rows, fmt.Sprintf("%s\tTalos upgrade to %s\t%s\t%s", id, spec.CurrentUpgradeVersion, spec.Phase, operationStep(spec.Step, spec.Error)))
+		}
+	}
+
+	for id, res := range m.kubernetesUpgrade {
+		if spec := res.TypedSpec().Value; spec.Phase != specs.KubernetesUpgradeStatusSpec_Done && spec.Phase != specs.KubernetesUpgradeStatusSpec_Unknown {
+			rows = append(rows, fmt.Sprintf("%s\tKubernetes upgrade to %s\t%s\t%s", id, spec.CurrentUpgradeVersion, spec.Phase, operationStep(spec.Step, spec.Error)))
+		}
+	}
+
+	slices.Sort(rows)
+
+	return rows
+}
+
+func operationStep(step, err string) string {
+	if err != "" {
+		return "error: " + err
+	}
+
+	return step
+}
+
+func sortedRows[T resource.Resource](items map[resource.ID]T, row func(T) string) []string {
+	ids := make([]resource.ID, 0, len(items))
+
+	for id := range items {
+		ids = append(ids, id)
+	}
+
+	slices.Sort(ids)
+
+	rows := make([]string, 0, len(ids))
+
+	for _, id := range ids {
+		rows = append(rows, row(items[id]))
+	}
+
+	return rows
+}
+
+// truncateLines cuts the lines which don't fit into the screen, so that the terminal doesn't scroll.
+func truncateLines(s string, width, height int) string {
+	lines := strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+
+	if height > 0 && len(lines) > height {
+		lines = lines[:height]
+	}
+
+	if width > 0 {
+		for i, line := range lines {
+			if runes := []rune(line); len(runes) > width {
+				lines[i] = string(runes[:width])
+			}
+		}
+	}
+
+	return strings.Join(lines, "\r\n")
+}
@@ -0,0 +1,132 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dashboard_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/omnictl/dashboard"
+)
+
+func TestModelUpdate(t *testing.T) {
+	t.Parallel()
+
+	model := dashboard.NewModel("https://omni.example.org")
+	now := time.Now()
+
+	assert.Contains(t, model.View(0, 0), "0 clusters, 0 machines | updated never")
+
+	cluster := omni.NewClusterStatus(resources.DefaultNamespace, "talos-default")
+	cluster.TypedSpec().Value.Phase = specs.ClusterStatusSpec_RUNNING
+
+	assert.True(t, model.Update(state.Event{Type: state.Created, Resource: cluster}, now))
+	assert.True(t, model.Update(state.Event{Type: state.Created, Resource: omni.NewMachineStatus(resources.DefaultNamespace, "machine-1")}, now))
+
+	// the bootstrapped events and the other resources don't change the model
+	assert.False(t, model.Update(state.Event{Type: state.Bootstrapped}, now))
+	assert.False(t, model.Update(state.Event{Type: state.Created, Resource: omni.NewMachine(resources.DefaultNamespace, "machine-1")}, now))
+
+	view := model.View(0, 0)
+	assert.Contains(t, view, "Omni https://omni.example.org | 1 clusters, 1 machines | updated "+now.Local().Format(time.TimeOnly))
+	assert.Contains(t, view, "talos-default  RUNNING")
+	assert.Contains(t, view, "machine-1")
+
+	assert.True(t, model.Update(state.Event{Type: state.Destroyed, Resource: cluster}, now))
+	assert.Contains(t, model.View(0, 0), "0 clusters, 1 machines")
+}
+
+func TestModelOperations(t *testing.T) {
+	t.Parallel()
+
+	model := dashboard.NewModel("omni")
+	now := time.Now()
+
+	cluster := omni.NewClusterStatus(resources.DefaultNamespace, "scaling")
+	cluster.TypedSpec().Value.Phase = specs.ClusterStatusSpec_SCALING_UP
+
+	talosUpgrade := omni.NewTalosUpgradeStatus(resources.DefaultNamespace, "talos")
+	talosUpgrade.TypedSpec().Value.Phase = specs.TalosUpgradeStatusSpec_Upgrading
+	talosUpgrade.TypedSpec().Value.CurrentUpgradeVersion = "1.8.0"
+	talosUpgrade.TypedSpec().Value.Step = "upgrading machine-1"
+
+	kubernetesUpgrade := omni.NewKubernetesUpgradeStatus(resources.DefaultNamespace, "kubernetes")
+	kubernetesUpgrade.TypedSpec().Value.Phase = specs.KubernetesUpgradeStatusSpec_Upgrading
+	kubernetesUpgrade.TypedSpec().Value.CurrentUpgradeVersion = "1.31.0"
+	kubernetesUpgrade.TypedSpec().Value.Error = "failed"
+
+	// the finished upgrades are not shown
+	doneUpgrade := omni.NewTalosUpgradeStatus(resources.DefaultNamespace, "done")
+	doneUpgrade.TypedSpec().Value.Phase = specs.TalosUpgradeStatusSpec_Done
+
+	for _, res := range []resource.Resource{cluster, talosUpgrade, kubernetesUpgrade, doneUpgrade} {
+		assert.True(t, model.Update(state.Event{Type: state.Created, Resource: res}, now))
+	}
+
+	view := model.View(0, 0)
+
+	assert.Contains(t, view, "OPERATIONS (3)")
+	assert.Contains(t, view, "scaling     cluster")
+	assert.Contains(t, view, "Talos upgrade to 1.8.0")
+	assert.Contains(t, view, "upgrading machine-1")
+	assert.Contains(t, view, "Kubernetes upgrade to 1.31.0")
+	assert.Contains(t, view, "error: failed")
+	assert.NotContains(t, view, "done")
+}
+
+func TestModelView(t *testing.T) {
+	t.Parallel()
+
+	model := dashboard.NewModel("omni")
+	now := time.Now()
+
+	for i := range 20 {
+		model.Update(state.Event{Type: state.Created, Resource: omni.NewMachineStatus(resources.DefaultNamespace, fmt.Sprintf("machine-%02d", i))}, now)
+	}
+
+	// the clusters panel is focused, so the machines are collapsed
+	view := model.View(0, 0)
+	assert.Contains(t, view, "> CLUSTERS (0)")
+	assert.Contains(t, view, "  MACHINES (20)")
+	assert.Contains(t, view, "machine-01")
+	assert.NotContains(t, view, "machine-02")
+	assert.Contains(t, view, "... 18 more")
+
+	// the focused panel gets the rest of the screen
+	assert.True(t, model.HandleKey('2'))
+
+	view = model.View(0, 30)
+	assert.Contains(t, view, "> MACHINES (20)")
+	assert.Contains(t, view, "machine-14")
+	assert.NotContains(t, view, "machine-15")
+	assert.Contains(t, view, "... 5 more")
+
+	// the screen is not overflown
+	view = model.View(20, 10)
+
+	lines := strings.Split(view, "\r\n")
+	assert.Len(t, lines, 10)
+
+	for _, line := range lines {
+		assert.LessOrEqual(t, len(line), 20)
+	}
+
+	assert.True(t, model.HandleKey('\t'))
+	assert.Contains(t, model.View(0, 0), "> OPERATIONS (0)")
+
+	assert.True(t, model.HandleKey('\t'))
+	assert.Contains(t, model.View(0, 0), "> CLUSTERS (0)")
+
+	assert.False(t, model.HandleKey('q'))
+}
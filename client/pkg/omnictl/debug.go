@@ -0,0 +1,261 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omnictl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+)
+
+var (
+	debugPayloadSamplesFlags struct {
+		method string
+		json   bool
+	}
+
+	debugDiagnosticsFlags struct {
+		output  string
+		seconds int
+	}
+
+	debugControllerGraphFlags struct {
+		format     string
+		finalizers bool
+	}
+
+	// debugCmd represents the debug command.
+	debugCmd = &cobra.Command{
+		Use:   "debug",
+		Short: "Debug the Omni server (admin only)",
+	}
+
+	debugPayloadSamplesCmd = &cobra.Command{
+		Use:   "payload-samples",
+		Short: "Show the recorded payloads of the sampled gRPC calls",
+		Long: `The payload sampling is enabled on the Omni server for the selected gRPC methods with the --payload-sampling-methods flag. ` +
+			`The sensitive data is redacted in the recorded payloads.`,
+		Args: cobra.NoArgs,
+		RunE: func(*cobra.Command, []string) error {
+			return access.WithClient(showPayloadSamples)
+		},
+	}
+
+	debugPprofCmd = &cobra.Command{
+		Use:   "pprof <profile>",
+		Short: "Download a pprof profile of the Omni server",
+		Long: `The diagnostics are enabled on the Omni server with the --enable-diagnostics flag. ` +
+			`The profile is one of the runtime/pprof profiles (heap, allocs, goroutine, block, mutex, threadcreate), ` +
+			`"profile" for the CPU profile or "trace" for the execution trace.`,
+		Example: "  omnictl debug pprof heap -o heap.pprof\n  omnictl debug pprof profile --seconds 10 -o cpu.pprof",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			query := url.Values{}
+
+			if args[0] == "profile" || args[0] == "trace" {
+				query.Set("seconds", strconv.Itoa(debugDiagnosticsFlags.seconds))
+			}
+
+			output := debugDiagnosticsFlags.output
+			if output == "" {
+				output = args[0] + ".pprof"
+			}
+
+			return access.WithClient(func(ctx context.Context, client *client.Client) error {
+				return fetchDiagnostics(ctx, client, "pprof/"+args[0], query, output)
+			})
+		},
+	}
+
+	debugGoroutinesCmd = &cobra.Command{
+		Use:   "goroutines",
+		Short: "Dump the stack traces of all goroutines of the Omni server",
+		Args:  cobra.NoArgs,
+		RunE: func(*cobra.Command, []string) error {
+			return access.WithClient(func(ctx context.Context, client *client.Client) error {
+				return fetchDiagnostics(ctx, client, "goroutines", nil, debugDiagnosticsFlags.output)
+			})
+		},
+	}
+
+	debugCOSICmd = &cobra.Command{
+		Use:   "cosi",
+		Short: "Dump the controllers and the resource counts of the Omni server COSI runtime as JSON",
+		Args:  cobra.NoArgs,
+		RunE: func(*cobra.Command, []string) error {
+			return access.WithClient(func(ctx context.Context, client *client.Client) error {
+				return fetchDiagnostics(ctx, client, "cosi", nil, debugDiagnosticsFlags.output)
+			})
+		},
+	}
+
+	debugDryRunCmd = &cobra.Command{
+		Use:   "dry-run",
+		Short: "Show the calls blocked by the Omni server running in the dry-run mode",
+		Long: `The dry-run mode is enabled on the Omni server with the --dry-run flag. ` +
+			`The mutating Talos and Kubernetes API calls are not sent to the machines, the blocked calls are reported as JSON.`,
+		Args: cobra.NoArgs,
+		RunE: func(*cobra.Command, []string) error {
+			return access.WithClient(func(ctx context.Context, client *client.Client) error {
+				return fetchDiagnostics(ctx, client, "dry-run", nil, debugDiagnosticsFlags.output)
+			})
+		},
+	}
+
+	debugControllerGraphCmd = &cobra.Command{
+		Use:   "controller-graph",
+		Short: "Export the controller dependency graph of the Omni server",
+		Long: `The graph contains the inputs and the outputs of all registered controllers. ` +
+			`The DOT output can be rendered with Graphviz, e.g. omnictl debug controller-graph --format dot | dot -Tsvg > graph.svg`,
+		Args: cobra.NoArgs,
+		RunE: func(*cobra.Command, []string) error {
+			query := url.Values{}
+			query.Set("format", debugControllerGraphFlags.format)
+
+			if debugControllerGraphFlags.finalizers {
+				query.Set("finalizers", "true")
+			}
+
+			return access.WithClient(func(ctx context.Context, client *client.Client) error {
+				return fetchDiagnostics(ctx, client, "controller-graph", query, debugDiagnosticsFlags.output)
+			})
+		},
+	}
+)
+
+// fetchDiagnostics downloads the diagnostics from the Omni server to the output file, or to stdout if the output is empty.
+func fetchDiagnostics(ctx context.Context, client *client.Client, path string, query url.Values, output string) error {
+	u, err := url.Parse(client.Endpoint())
+	if err != nil {
+		return err
+	}
+
+	u.Scheme = "https"
+	u.RawQuery = query.Encode()
+
+	if u.Path, err = url.JoinPath(u.Path, "diagnostics", path); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	if err = signRequest(req); err != nil {
+		return err
+	}
+
+	httpClient, err := newHTTPClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer checkCloser(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		message, readErr := io.ReadAll(resp.Body)
+		if readErr != nil {
+			return readErr
+		}
+
+		return fmt.Errorf("failed to fetch the diagnostics, error code: %d, message: %s", resp.StatusCode, message)
+	}
+
+	if output == "" {
+		_, err = io.Copy(os.Stdout, resp.Body)
+
+		return err
+	}
+
+	if err = downloadResponseTo(output, resp); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "Saved to %s\n", output)
+
+	return nil
+}
+
+func showPayloadSamples(ctx context.Context, client *client.Client) error {
+	samples, err := client.Management().GetPayloadSamples(ctx, debugPayloadSamplesFlags.method)
+	if err != nil {
+		return err
+	}
+
+	for _, sample := range samples {
+		if debugPayloadSamplesFlags.json {
+			data, marshalErr := protojson.Marshal(sample)
+			if marshalErr != nil {
+				return marshalErr
+			}
+
+			fmt.Println(string(data))
+
+			continue
+		}
+
+		fmt.Printf("--- %s %s by %q (%s, %s)\n",
+			sample.GetTime().AsTime().Format(time.RFC3339Nano),
+			sample.GetMethod(),
+			sample.GetIdentity(),
+			sample.GetDuration().AsDuration(),
+			codes.Code(sample.GetCode()),
+		)
+
+		for _, request := range sample.GetRequests() {
+			fmt.Printf("> %s\n", request)
+		}
+
+		for _, response := range sample.GetResponses() {
+			fmt.Printf("< %s\n", response)
+		}
+
+		if sample.GetError() != "" {
+			fmt.Printf("! %s\n", sample.GetError())
+		}
+
+		if sample.GetTruncated() {
+			fmt.Println("(truncated)")
+		}
+	}
+
+	return nil
+}
+
+func init() {
+	debugPayloadSamplesCmd.Flags().StringVar(&debugPayloadSamplesFlags.method, "method", "", "show only the samples of the full gRPC method name, e.g. /management.ManagementService/Kubeconfig")
+	debugPayloadSamplesCmd.Flags().BoolVar(&debugPayloadSamplesFlags.json, "json", false, "output the samples in the JSON format, one per line")
+
+	for _, cmd := range []*cobra.Command{debugGoroutinesCmd, debugCOSICmd, debugControllerGraphCmd, debugDryRunCmd} {
+		cmd.Flags().StringVarP(&debugDiagnosticsFlags.output, "output", "o", "", "output file, stdout if not set")
+	}
+
+	debugPprofCmd.Flags().StringVarP(&debugDiagnosticsFlags.output, "output", "o", "", "output file, <profile>.pprof if not set")
+	debugPprofCmd.Flags().IntVar(&debugDiagnosticsFlags.seconds, "seconds", 30, "duration of the CPU profile or the execution trace")
+
+	debugControllerGraphCmd.Flags().StringVar(&debugControllerGraphFlags.format, "format", "json", "output format: json or dot")
+	debugControllerGraphCmd.Flags().BoolVar(&debugControllerGraphFlags.finalizers, "finalizers", false, "include the finalizers currently set on the resources, lists all resources of the graph")
+
+	debugCmd.AddCommand(debugPayloadSamplesCmd, debugPprofCmd, debugGoroutinesCmd, debugCOSICmd, debugControllerGraphCmd, debugDryRunCmd)
+	RootCmd.AddCommand(debugCmd)
+}
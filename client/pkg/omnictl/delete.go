@@ -21,6 +21,7 @@ import (
 var deleteCmdFlags struct {
 	namespace string
 	selector  string
+	reason    string
 	all       bool
 }
 
@@ -39,8 +40,10 @@ var deleteCmd = &cobra.Command{
 
 //nolint:gocognit,gocyclo,cyclop
 func deleteResources(cmd *cobra.Command, args []string) func(ctx context.Context, client *client.Client) error {
-	return func(ctx context.Context, client *client.Client) error {
-		st := client.Omni().State()
+	return func(ctx context.Context, cli *client.Client) error {
+		ctx = client.WithDestroyReason(ctx, deleteCmdFlags.reason)
+
+		st := cli.Omni().State()
 
 		resourceType := resource.Type(args[0]) //nolint:unconvert
 
@@ -185,6 +188,7 @@ func init() {
 	deleteCmd.PersistentFlags().StringVarP(&deleteCmdFlags.namespace, "namespace", "n", resources.DefaultNamespace, "The resource namespace.")
 	deleteCmd.PersistentFlags().BoolVar(&deleteCmdFlags.all, "all", false, "Delete all resources of the type.")
 	deleteCmd.PersistentFlags().StringVarP(&deleteCmdFlags.selector, "selector", "l", "", "Selector (label query) to filter on, supports '=' and '==' (e.g. -l key1=value1,key2=value2)")
+	deleteCmd.PersistentFlags().StringVar(&deleteCmdFlags.reason, "reason", "", "Reason of the deletion, recorded by Omni and might be required by its policy.")
 
 	deleteCmd.MarkFlagsMutuallyExclusive("all", "selector")
 
@@ -223,22 +223,9 @@ func downloadImageTo(ctx context.Context, client *client.Client, media *omni.Ins
 		return err
 	}
 
-	httpTransport := http.DefaultTransport
-
-	if access.CmdFlags.InsecureSkipTLSVerify {
-		defaultTransport, ok := http.DefaultTransport.(*http.Transport)
-		if !ok {
-			return fmt.Errorf("unexpected default transport type: %T", http.DefaultTransport)
-		}
-
-		defaultTransportClone := defaultTransport.Clone()
-		defaultTransportClone.TLSClientConfig.InsecureSkipVerify = true
-
-		httpTransport = defaultTransportClone
-	}
-
-	httpClient := &http.Client{
-		Transport: httpTransport,
+	httpClient, err := newHTTPClient()
+	if err != nil {
+		return err
 	}
 
 	fmt.Println("Generating the image...")
@@ -345,6 +332,27 @@ func createRequest(ctx context.Context, client *client.Client, schematic string,
 	return req, err
 }
 
+// newHTTPClient creates the HTTP client for the requests to the Omni API endpoint.
+func newHTTPClient() (*http.Client, error) {
+	httpTransport := http.DefaultTransport
+
+	if access.CmdFlags.InsecureSkipTLSVerify {
+		defaultTransport, ok := http.DefaultTransport.(*http.Transport)
+		if !ok {
+			return nil, fmt.Errorf("unexpected default transport type: %T", http.DefaultTransport)
+		}
+
+		defaultTransportClone := defaultTransport.Clone()
+		defaultTransportClone.TLSClientConfig.InsecureSkipVerify = true
+
+		httpTransport = defaultTransportClone
+	}
+
+	return &http.Client{
+		Transport: httpTransport,
+	}, nil
+}
+
 func signRequest(req *http.Request) error {
 	identity, signer, err := getSigner()
 	if err != nil {
@@ -25,6 +25,7 @@ var getCmdFlags struct {
 	output    string
 	selector  string
 	idRegexp  string
+	jsonPath  string
 	watch     bool
 }
 
@@ -35,8 +36,9 @@ var getCmd = &cobra.Command{
 	Short:   "Get a specific resource or list of resources.",
 	Long: `Similar to 'kubectl get', 'omnictl get' returns a set of resources from the OS.
 To get a list of all available resource definitions, issue 'omnictl get rd'`,
-	Example: "",
-	Args:    cobra.RangeArgs(1, 2),
+	Example: `  omnictl get machinestatus --jsonpath '{.metadata.id}'
+  omnictl get clusterstatus --watch --jsonpath '{.spec.phase}'`,
+	Args: cobra.RangeArgs(1, 2),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return access.WithClient(getResources(cmd, args))
 	},
@@ -99,7 +101,23 @@ func getResources(cmd *cobra.Command, args []string) func(ctx context.Context, c
 			idQuery = append(idQuery, resource.IDRegexpMatch(idRegexp))
 		}
 
-		out, err := output.NewWriter(getCmdFlags.output)
+		outputFormat, err := access.ResolveOutput(getCmdFlags.output, cmd.Flags().Changed("output"))
+		if err != nil {
+			return err
+		}
+
+		var writerOpts []output.WriterOption
+
+		if getCmdFlags.jsonPath != "" {
+			// the JSONPath filter implies the JSON output unless the output format is set explicitly
+			if !cmd.Flags().Changed("output") {
+				outputFormat = "json"
+			}
+
+			writerOpts = append(writerOpts, output.WithJSONPath(getCmdFlags.jsonPath))
+		}
+
+		out, err := output.NewWriter(outputFormat, writerOpts...)
 		if err != nil {
 			return err
 		}
@@ -225,9 +243,12 @@ func getResources(cmd *cobra.Command, args []string) func(ctx context.Context, c
 func init() {
 	getCmd.PersistentFlags().StringVarP(&getCmdFlags.namespace, "namespace", "n", resources.DefaultNamespace, "The resource namespace.")
 	getCmd.PersistentFlags().BoolVarP(&getCmdFlags.watch, "watch", "w", false, "Watch the resource state.")
-	getCmd.PersistentFlags().StringVarP(&getCmdFlags.output, "output", "o", "table", "Output format (json, table, yaml, jsonpath).")
+	getCmd.PersistentFlags().StringVarP(&getCmdFlags.output, "output", "o", "table", "Output format (json, table, yaml, jsonpath, diff), defaults to the default output format of the context. "+
+		"The diff format prints only the changed fields of the resources in the watch mode.")
 	getCmd.PersistentFlags().StringVarP(&getCmdFlags.selector, "selector", "l", "", "Selector (label query) to filter on, supports '=' and '==' (e.g. -l key1=value1,key2=value2)")
 	getCmd.PersistentFlags().StringVar(&getCmdFlags.idRegexp, "id-match-regexp", "", "Match resource ID against a regular expression.")
+	getCmd.PersistentFlags().StringVar(&getCmdFlags.jsonPath, "jsonpath", "",
+		"Write only the values matching the JSONPath expression as JSON (e.g. --jsonpath '{.metadata.id}'), implies the json output format. In the watch mode the event type is available as '{.event}'.")
 
 	if err := getCmd.RegisterFlagCompletionFunc("output", output.CompleteOutputArg); err != nil {
 		panic(err)
@@ -14,6 +14,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/siderolabs/go-api-signature/pkg/serviceaccount"
+	"golang.org/x/term"
 
 	"github.com/siderolabs/omni/client/pkg/client"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
@@ -29,6 +30,7 @@ const (
 
 type clientOptions struct {
 	skipAuth bool
+	login    bool
 }
 
 // ClientOption is a functional option for the client.
@@ -41,6 +43,15 @@ func WithSkipAuth(skipAuth bool) ClientOption {
 	}
 }
 
+// WithLogin allows the client to run the interactive authentication flow even if the omnictl is not running in a terminal.
+//
+// Without it, a non-interactive omnictl fails instead of opening the browser if there is no valid key for the context.
+func WithLogin(login bool) ClientOption {
+	return func(o *clientOptions) {
+		o.login = login
+	}
+}
+
 // WithClient initializes the Omni API client.
 //
 //nolint:gocognit
@@ -98,7 +109,17 @@ func WithClient(f func(ctx context.Context, client *client.Client) error, client
 				fmt.Fprintf(os.Stderr, "[WARN] basic auth is deprecated and has no effect\n")
 			}
 
-			opts = append(opts, client.WithUserAccount(contextName, configCtx.Auth.SideroV1.Identity))
+			if configCtx.Auth.SideroV1.HardwareKey != "" {
+				opts = append(opts, client.WithHardwareKey(contextName, configCtx.Auth.SideroV1.Identity, configCtx.Auth.SideroV1.HardwareKey))
+			} else {
+				if !cliOpts.skipAuth && !cliOpts.login && !term.IsTerminal(int(os.Stdin.Fd())) {
+					if _, keyErr := client.ReadUserKey(contextName, configCtx.Auth.SideroV1.Identity); keyErr != nil {
+						return fmt.Errorf("not logged in to the context %q, run `omnictl login` first: %w", contextName, keyErr)
+					}
+				}
+
+				opts = append(opts, client.WithUserAccount(contextName, configCtx.Auth.SideroV1.Identity))
+			}
 
 			if configCtx.URL == config.PlaceholderURL {
 				return fmt.Errorf("context %q has not been configured, you will need to set it manually", contextName)
@@ -130,6 +151,12 @@ func WithClient(f func(ctx context.Context, client *client.Client) error, client
 			return err
 		}
 
+		if !cliOpts.skipAuth {
+			if err = warnDeprecations(ctx, client); err != nil {
+				return err
+			}
+		}
+
 		return f(ctx, client)
 	})
 }
@@ -155,9 +182,21 @@ If you want to enable the version validation and disable this warning, set githu
 			"client version %v, server version %v", version.API, version.Tag, sysVersion.TypedSpec().Value.BackendVersion)
 	}
 
-	// compare the API versions
-	if sysVersion.TypedSpec().Value.BackendApiVersion != version.API {
-		return fmt.Errorf("client API version mismatch: backend API version %v, client API version %v", sysVersion.TypedSpec().Value.BackendApiVersion, version.API)
+	if sysVersion.TypedSpec().Value.BackendMinApiVersion == 0 { // the backend doesn't serve older API versions, the API versions should match exactly
+		if sysVersion.TypedSpec().Value.BackendApiVersion != version.API {
+			return fmt.Errorf("client API version mismatch: backend API version %v, client API version %v", sysVersion.TypedSpec().Value.BackendApiVersion, version.API)
+		}
+
+		return nil
+	}
+
+	switch {
+	case version.API < sysVersion.TypedSpec().Value.BackendMinApiVersion:
+		return fmt.Errorf("client API version %v is no longer supported by the backend, the oldest supported API version is %v, please upgrade the client",
+			version.API, sysVersion.TypedSpec().Value.BackendMinApiVersion)
+	case version.API > sysVersion.TypedSpec().Value.BackendApiVersion:
+		return fmt.Errorf("client API version %v is newer than the backend API version %v, please upgrade the server or downgrade the client",
+			version.API, sysVersion.TypedSpec().Value.BackendApiVersion)
 	}
 
 	return nil
@@ -178,3 +217,21 @@ func checkVersionWarning(sysVersion *system.SysVersion) {
 		fmt.Fprintf(os.Stderr, "[WARN] omnictl version differs from the backend version: %q vs %q.\n", clientVersion.String(), backendVersion.String())
 	}
 }
+
+func warnDeprecations(ctx context.Context, client *client.Client) error {
+	capabilities, err := client.Capabilities(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get server capabilities: %w", err)
+	}
+
+	for _, deprecation := range capabilities.GetDeprecations() {
+		removal := ""
+		if deprecation.GetRemovalVersion() != "" {
+			removal = fmt.Sprintf(" and will be removed in %s", deprecation.GetRemovalVersion())
+		}
+
+		fmt.Fprintf(os.Stderr, "[WARN] %s is deprecated%s: %s\n", deprecation.GetApi(), removal, deprecation.GetMessage())
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package access
+
+import (
+	"errors"
+	"os"
+
+	"github.com/siderolabs/omni/client/pkg/omnictl/config"
+)
+
+const (
+	// ContextEnvVar is the name of the environment variable that overrides the current context of the omniconfig.
+	ContextEnvVar = "OMNI_CONTEXT"
+
+	// ClusterEnvVar is the name of the environment variable that overrides the default cluster of the context.
+	ClusterEnvVar = "OMNI_CLUSTER"
+
+	// OutputEnvVar is the name of the environment variable that overrides the default output format of the context.
+	OutputEnvVar = "OMNI_OUTPUT"
+)
+
+// Defaults returns the defaults of the context selected by the flags, overridden by the environment variables.
+func Defaults() (config.Defaults, error) {
+	var defaults config.Defaults
+
+	conf, err := config.Current()
+	if err != nil {
+		conf, err = config.Init(CmdFlags.Omniconfig, false)
+	}
+
+	switch {
+	case err == nil:
+		configCtx, ctxErr := conf.GetContext(CmdFlags.Context)
+		if ctxErr != nil {
+			return defaults, ctxErr
+		}
+
+		defaults = configCtx.Defaults
+	case !os.IsNotExist(err):
+		return defaults, err
+	}
+
+	if cluster := os.Getenv(ClusterEnvVar); cluster != "" {
+		defaults.Cluster = cluster
+	}
+
+	if output := os.Getenv(OutputEnvVar); output != "" {
+		defaults.Output = output
+	}
+
+	return defaults, nil
+}
+
+// ResolveCluster returns the cluster set by the flag, or the default cluster of the context if the flag is empty.
+func ResolveCluster(cluster string) (string, error) {
+	if cluster != "" {
+		return cluster, nil
+	}
+
+	defaults, err := Defaults()
+	if err != nil {
+		return "", err
+	}
+
+	if defaults.Cluster == "" {
+		return "", errors.New("cluster is not specified: set the --cluster flag, the " + ClusterEnvVar + " environment variable or the default cluster of the context")
+	}
+
+	return defaults.Cluster, nil
+}
+
+// ResolveOutput returns the output format set by the flag if it was changed, otherwise the default output format of the context, falling back to the flag value.
+func ResolveOutput(output string, changed bool) (string, error) {
+	if changed {
+		return output, nil
+	}
+
+	defaults, err := Defaults()
+	if err != nil {
+		return "", err
+	}
+
+	if defaults.Output != "" {
+		return defaults.Output, nil
+	}
+
+	return output, nil
+}
@@ -0,0 +1,109 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package access_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/pkg/omnictl/config"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+)
+
+// TestDefaults modifies the global flags and the current config, so it doesn't run in parallel.
+func TestDefaults(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Cleanup(func() {
+		access.CmdFlags.Omniconfig = ""
+		access.CmdFlags.Context = ""
+	})
+
+	// no config, only the environment is used
+	access.CmdFlags.Omniconfig = filepath.Join(dir, "missing")
+
+	_, err := access.ResolveCluster("")
+	assert.ErrorContains(t, err, "cluster is not specified")
+
+	t.Setenv(access.ClusterEnvVar, "from-env")
+
+	cluster, err := access.ResolveCluster("")
+	require.NoError(t, err)
+	assert.Equal(t, "from-env", cluster)
+
+	t.Setenv(access.ClusterEnvVar, "")
+
+	conf := &config.Config{
+		Path:    filepath.Join(dir, "config"),
+		Context: "prod",
+		Contexts: map[string]*config.Context{
+			"prod": {
+				URL:      "https://prod.example.org",
+				Defaults: config.Defaults{Cluster: "prod-cluster", Output: "yaml"},
+			},
+			"staging": {
+				URL: "https://staging.example.org",
+			},
+		},
+	}
+
+	require.NoError(t, conf.Save())
+
+	access.CmdFlags.Omniconfig = conf.Path
+
+	_, err = config.Init(conf.Path, false)
+	require.NoError(t, err)
+
+	// the flags win over the defaults
+	cluster, err = access.ResolveCluster("explicit")
+	require.NoError(t, err)
+	assert.Equal(t, "explicit", cluster)
+
+	output, err := access.ResolveOutput("json", true)
+	require.NoError(t, err)
+	assert.Equal(t, "json", output)
+
+	// the defaults of the current context
+	cluster, err = access.ResolveCluster("")
+	require.NoError(t, err)
+	assert.Equal(t, "prod-cluster", cluster)
+
+	output, err = access.ResolveOutput("table", false)
+	require.NoError(t, err)
+	assert.Equal(t, "yaml", output)
+
+	// the environment wins over the defaults
+	t.Setenv(access.ClusterEnvVar, "from-env")
+	t.Setenv(access.OutputEnvVar, "json")
+
+	cluster, err = access.ResolveCluster("")
+	require.NoError(t, err)
+	assert.Equal(t, "from-env", cluster)
+
+	output, err = access.ResolveOutput("table", false)
+	require.NoError(t, err)
+	assert.Equal(t, "json", output)
+
+	t.Setenv(access.ClusterEnvVar, "")
+	t.Setenv(access.OutputEnvVar, "")
+
+	// the context without the defaults
+	access.CmdFlags.Context = "staging"
+
+	_, err = access.ResolveCluster("")
+	assert.ErrorContains(t, err, "cluster is not specified")
+
+	output, err = access.ResolveOutput("table", false)
+	require.NoError(t, err)
+	assert.Equal(t, "table", output)
+
+	access.CmdFlags.Context = "unknown"
+
+	_, err = access.Defaults()
+	assert.ErrorContains(t, err, "context not found: unknown")
+}
@@ -66,12 +66,17 @@ Otherwise kubeconfig will be written to PWD or [local-path] if specified.`,
 //nolint:gocognit
 func getKubeconfig(args []string) func(ctx context.Context, client *client.Client) error {
 	return func(ctx context.Context, client *client.Client) error {
-		var localPath string
+		var (
+			localPath string
+			err       error
+		)
+
+		if kubeconfigCmdFlags.cluster, err = access.ResolveCluster(kubeconfigCmdFlags.cluster); err != nil {
+			return err
+		}
 
 		if len(args) == 0 {
 			// no path given, use defaults
-			var err error
-
 			if kubeconfigCmdFlags.merge {
 				localPath, err = kubeconfig.SinglePath()
 				if err != nil {
@@ -209,7 +214,7 @@ func askOverwriteOrRename(prompt string) (kubeconfig.ConflictDecision, error) {
 }
 
 func init() {
-	kubeconfigCmd.Flags().StringVarP(&kubeconfigCmdFlags.cluster, "cluster", "c", "", "cluster to use")
+	kubeconfigCmd.Flags().StringVarP(&kubeconfigCmdFlags.cluster, "cluster", "c", "", "cluster to use, defaults to the default cluster of the context")
 	kubeconfigCmd.Flags().BoolVarP(&kubeconfigCmdFlags.force, "force", "f", false, "force overwrite of kubeconfig if already present, force overwrite on kubeconfig merge")
 	kubeconfigCmd.Flags().StringVar(&kubeconfigCmdFlags.forceContextName, "force-context-name", "", "force context name for kubeconfig merge")
 	kubeconfigCmd.Flags().BoolVarP(&kubeconfigCmdFlags.merge, "merge", "m", true, "merge with existing kubeconfig")
@@ -225,7 +230,5 @@ func init() {
 	kubeconfigCmd.Flags().StringVar(&kubeconfigCmdFlags.grantType, "grant-type", "", fmt.Sprintf("Authorization grant type to use. One of (%s)", allGrantTypes))
 	kubeconfigCmd.Flags().BoolVar(&kubeconfigCmdFlags.breakGlass, "break-glass", false, "get kubeconfig that allows accessing nodes bypasing Omni (if enabled for the account)")
 
-	kubeconfigCmd.MarkFlagRequired("cluster") //nolint:errcheck
-
 	RootCmd.AddCommand(kubeconfigCmd)
 }
@@ -0,0 +1,135 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omnictl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+)
+
+var (
+	labelMachinesFlags struct {
+		selectors []string
+	}
+
+	// labelCmd represents the label command.
+	labelCmd = &cobra.Command{
+		Use:   "label",
+		Short: "Update the labels of many resources at once.",
+	}
+
+	labelMachinesCmd = &cobra.Command{
+		Use:     "machines key=value ... key- ...",
+		Aliases: []string{"machine", "m"},
+		Short:   "Set and remove the labels of all machines matching the selectors",
+		Long: `Set and remove the labels of all machines matching the selectors.
+
+The label is set with the key=value argument and removed with the key- argument.
+The machines matching any of the selectors are updated, the failure to update a machine doesn't stop the update of the others.`,
+		Example: `  omnictl label machines -l site=a rack=r1
+  omnictl label machines -l site=a -l site=b env=prod deprecated-`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			set, remove, err := parseLabelArgs(args)
+			if err != nil {
+				return err
+			}
+
+			return access.WithClient(func(ctx context.Context, client *client.Client) error {
+				results, err := client.Management().UpdateMachineLabels(ctx, labelMachinesFlags.selectors, set, remove)
+				if err != nil {
+					return err
+				}
+
+				writer := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+				fmt.Fprintf(writer, "ID\tRESULT\n") //nolint:errcheck
+
+				failed := 0
+
+				for _, result := range results {
+					outcome := "unchanged"
+
+					switch {
+					case result.Error != "":
+						outcome = "error: " + result.Error
+
+						failed++
+					case result.Updated:
+						outcome = "updated"
+					}
+
+					fmt.Fprintf(writer, "%s\t%s\n", result.MachineId, outcome) //nolint:errcheck
+				}
+
+				if err = writer.Flush(); err != nil {
+					return err
+				}
+
+				if len(results) == 0 {
+					fmt.Fprintln(os.Stderr, "no machines match the selectors") //nolint:errcheck
+				}
+
+				if failed > 0 {
+					return fmt.Errorf("failed to update the labels of %d of %d machines", failed, len(results))
+				}
+
+				return nil
+			})
+		},
+	}
+)
+
+// parseLabelArgs parses the key=value arguments into the labels to set and the key- arguments into the labels to remove.
+func parseLabelArgs(args []string) (map[string]string, []string, error) {
+	set := map[string]string{}
+
+	var remove []string
+
+	for _, arg := range args {
+		if key, value, ok := strings.Cut(arg, "="); ok {
+			if key == "" {
+				return nil, nil, fmt.Errorf("invalid label %q: the key is empty", arg)
+			}
+
+			set[key] = value
+
+			continue
+		}
+
+		if key, ok := strings.CutSuffix(arg, "-"); ok && key != "" {
+			remove = append(remove, key)
+
+			continue
+		}
+
+		return nil, nil, fmt.Errorf("invalid label %q: expected key=value or key-", arg)
+	}
+
+	if len(set) == 0 && len(remove) == 0 {
+		return nil, nil, errors.New("no labels to set or remove")
+	}
+
+	return set, remove, nil
+}
+
+func init() {
+	labelMachinesCmd.Flags().StringArrayVarP(&labelMachinesFlags.selectors, "selector", "l", nil,
+		"Selector (label query) of the machines, supports '=' and '==' (e.g. -l key1=value1,key2=value2), the machines matching any of the selectors are updated")
+	labelMachinesCmd.MarkFlagRequired("selector") //nolint:errcheck
+
+	labelCmd.AddCommand(labelMachinesCmd)
+
+	RootCmd.AddCommand(labelCmd)
+}
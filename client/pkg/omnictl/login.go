@@ -0,0 +1,174 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omnictl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"runtime"
+	"time"
+
+	"github.com/siderolabs/go-api-signature/pkg/serviceaccount"
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/omnictl/config"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+)
+
+var (
+	loginCmdFlags struct {
+		noBrowser bool
+		force     bool
+	}
+
+	// loginCmd represents the login command.
+	loginCmd = &cobra.Command{
+		Use:   "login",
+		Short: "Log in to the Omni instance of the current context",
+		Long: `Log in to the Omni instance of the current context.
+
+A new key is generated and registered for the identity of the context, and the key has to be confirmed in the browser.
+The browser is opened automatically if possible. On headless hosts, or with --no-browser, the confirmation URL is printed instead,
+so that it can be opened on any other device.
+
+The key is stored locally and used by the following commands until it expires.`,
+		Example: `  omnictl login
+  omnictl login --no-browser
+  omnictl --context staging login --force`,
+		Args: cobra.NoArgs,
+		RunE: func(*cobra.Command, []string) error {
+			return login()
+		},
+	}
+
+	// logoutCmd represents the logout command.
+	logoutCmd = &cobra.Command{
+		Use:   "logout",
+		Short: "Remove the locally stored key of the current context",
+		Args:  cobra.NoArgs,
+		RunE: func(*cobra.Command, []string) error {
+			contextName, configCtx, err := loginContext()
+			if err != nil {
+				return err
+			}
+
+			if err = client.DeleteUserKey(contextName, configCtx.Auth.SideroV1.Identity); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return fmt.Errorf("failed to remove the key: %w", err)
+			}
+
+			fmt.Printf("logged out of the context %q\n", contextName)
+
+			return nil
+		},
+	}
+)
+
+func login() error {
+	if envKey, _ := serviceaccount.GetFromEnv(); envKey != "" {
+		return fmt.Errorf("the service account key is set in %s, login is not required", envKey)
+	}
+
+	contextName, configCtx, err := loginContext()
+	if err != nil {
+		return err
+	}
+
+	identity := configCtx.Auth.SideroV1.Identity
+	hardwareKey := configCtx.Auth.SideroV1.HardwareKey != ""
+
+	if !hardwareKey {
+		if loginCmdFlags.force {
+			if err = client.DeleteUserKey(contextName, identity); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return fmt.Errorf("failed to remove the existing key: %w", err)
+			}
+		} else if key, keyErr := client.ReadUserKey(contextName, identity); keyErr == nil {
+			fmt.Printf("already logged in to the context %q\n", contextName)
+			printUserKey(key)
+
+			return nil
+		}
+	}
+
+	if loginCmdFlags.noBrowser || isHeadless() {
+		// the authentication flow prints the URL instead of opening the browser
+		if err = os.Setenv("BROWSER", "echo"); err != nil {
+			return err
+		}
+
+		fmt.Fprintln(os.Stderr, "Open the URL printed below in a browser on any device and confirm the key to complete the login.") //nolint:errcheck
+	}
+
+	// any authenticated call runs the authentication flow if there is no valid key
+	if err = access.WithClient(func(context.Context, *client.Client) error { return nil }, access.WithLogin(true)); err != nil {
+		return err
+	}
+
+	fmt.Printf("logged in to the context %q\n", contextName)
+
+	if hardwareKey {
+		fmt.Printf("key: stored on the hardware token %s\n", configCtx.Auth.SideroV1.HardwareKey)
+
+		return nil
+	}
+
+	key, err := client.ReadUserKey(contextName, identity)
+	if err != nil {
+		return fmt.Errorf("failed to read the stored key: %w", err)
+	}
+
+	printUserKey(key)
+
+	return nil
+}
+
+func loginContext() (string, *config.Context, error) {
+	conf, err := config.Init(access.CmdFlags.Omniconfig, true)
+	if err != nil {
+		return "", nil, err
+	}
+
+	contextName := conf.Context
+	if access.CmdFlags.Context != "" {
+		contextName = access.CmdFlags.Context
+	}
+
+	configCtx, err := conf.GetContext(access.CmdFlags.Context)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return contextName, configCtx, nil
+}
+
+func printUserKey(key *client.UserKey) {
+	fmt.Printf("key: %s\n", key.Fingerprint)
+	fmt.Printf("expires: %s (in %s)\n", key.Expiration.Local().Format(time.RFC3339), time.Until(key.Expiration).Round(time.Minute))
+}
+
+// isHeadless returns true if there is no display to open the browser on.
+func isHeadless() bool {
+	if os.Getenv("SSH_CONNECTION") != "" || os.Getenv("SSH_TTY") != "" {
+		return true
+	}
+
+	switch runtime.GOOS {
+	case "darwin", "windows":
+		return false
+	default:
+		return os.Getenv("DISPLAY") == "" && os.Getenv("WAYLAND_DISPLAY") == ""
+	}
+}
+
+func init() {
+	RootCmd.AddCommand(loginCmd)
+	RootCmd.AddCommand(logoutCmd)
+
+	loginCmd.Flags().BoolVar(&loginCmdFlags.noBrowser, "no-browser", false, "print the confirmation URL instead of opening the browser")
+	loginCmd.Flags().BoolVar(&loginCmdFlags.force, "force", false, "register a new key even if the stored key is still valid")
+}
@@ -7,16 +7,20 @@ package omnictl
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/client/management"
 	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
 	"github.com/siderolabs/omni/client/pkg/omnictl/logformat"
 )
 
 var logsCmdFlags struct {
 	logFormat string
+	since     string
+	until     string
 	follow    bool
 	tailLines int32
 }
@@ -39,7 +43,29 @@ func getLogs(_ *cobra.Command, args []string) func(ctx context.Context, client *
 	return func(ctx context.Context, client *client.Client) error {
 		machineID := args[0]
 
-		logReader, err := client.Management().LogsReader(ctx, machineID, logsCmdFlags.follow, logsCmdFlags.tailLines)
+		var opts []management.LogsOption
+
+		now := time.Now()
+
+		if logsCmdFlags.since != "" {
+			since, err := parseLogTime(logsCmdFlags.since, now)
+			if err != nil {
+				return fmt.Errorf("invalid --since: %w", err)
+			}
+
+			opts = append(opts, management.WithLogsSince(since))
+		}
+
+		if logsCmdFlags.until != "" {
+			until, err := parseLogTime(logsCmdFlags.until, now)
+			if err != nil {
+				return fmt.Errorf("invalid --until: %w", err)
+			}
+
+			opts = append(opts, management.WithLogsUntil(until))
+		}
+
+		logReader, err := client.Management().LogsReader(ctx, machineID, logsCmdFlags.follow, logsCmdFlags.tailLines, opts...)
 		if err != nil {
 			return fmt.Errorf("failed to get logs stream for '%s': %w", machineID, err)
 		}
@@ -61,9 +87,25 @@ func getLogs(_ *cobra.Command, args []string) func(ctx context.Context, client *
 	}
 }
 
+// parseLogTime parses either the RFC3339 timestamp or the duration before now.
+func parseLogTime(value string, now time.Time) (time.Time, error) {
+	if duration, err := time.ParseDuration(value); err == nil {
+		return now.Add(-duration), nil
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is neither a duration nor an RFC3339 timestamp", value)
+	}
+
+	return timestamp, nil
+}
+
 func init() {
 	logsCmd.Flags().BoolVarP(&logsCmdFlags.follow, "follow", "f", false, "specify if the logs should be streamed")
 	logsCmd.Flags().Int32Var(&logsCmdFlags.tailLines, "tail", -1, "lines of log file to display (default is to show from the beginning)")
+	logsCmd.Flags().StringVar(&logsCmdFlags.since, "since", "", "show the logs written after the time, either a duration before now (e.g. 1h) or an RFC3339 timestamp")
+	logsCmd.Flags().StringVar(&logsCmdFlags.until, "until", "", "show the logs written before the time, either a duration before now (e.g. 30m) or an RFC3339 timestamp")
 	logsCmd.Flags().StringVar(&logsCmdFlags.logFormat, "log-format", "raw", "log format (raw, omni, dmesg) to display (default is to display in raw format)")
 	RootCmd.AddCommand(logsCmd)
 }
@@ -0,0 +1,55 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omnictl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLogTime(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		name     string
+		value    string
+		expected time.Time
+	}{
+		{
+			name:     "duration",
+			value:    "1h30m",
+			expected: now.Add(-90 * time.Minute),
+		},
+		{
+			name:     "timestamp",
+			value:    "2024-04-30T10:00:00Z",
+			expected: time.Date(2024, 4, 30, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "timestamp with offset",
+			value:    "2024-05-01T10:00:00+02:00",
+			expected: time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			parsed, err := parseLogTime(tt.value, now)
+			require.NoError(t, err)
+
+			assert.True(t, tt.expected.Equal(parsed), "expected %s, got %s", tt.expected, parsed)
+		})
+	}
+
+	for _, value := range []string{"", "yesterday", "2024-05-01"} {
+		_, err := parseLogTime(value, now)
+		assert.ErrorContains(t, err, "neither a duration nor an RFC3339 timestamp", value)
+	}
+}
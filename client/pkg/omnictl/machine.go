@@ -0,0 +1,326 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omnictl
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/blang/semver"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/constants"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+)
+
+const (
+	machineStageMaintenance = "maintenance"
+	machineStageRunning     = "running"
+
+	// machineListPageSize is the number of the machines fetched at once, so that the large installations can be listed.
+	machineListPageSize = 1000
+)
+
+var (
+	machineListFlags struct {
+		cluster      string
+		stage        string
+		talosVersion string
+		labels       []string
+		connected    bool
+	}
+
+	machineUserDataFlags struct {
+		talosVersion string
+	}
+
+	machineJoinArtifactsFlags struct {
+		joinToken    string
+		talosVersion string
+		arch         string
+		outputDir    string
+		secureBoot   bool
+	}
+
+	// machineCmd represents the machine command.
+	machineCmd = &cobra.Command{
+		Use:     "machine",
+		Aliases: []string{"machines", "m"},
+		Short:   "Machine-related subcommands.",
+	}
+
+	machineListCmd = &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"l", "ls"},
+		Short:   "List machines, optionally filtered by the cluster, the connection status, the stage, the Talos version and the labels",
+		Example: `  omnictl machine list --cluster my-cluster --connected=false
+  omnictl machine list --stage maintenance --label omni.sidero.dev/arch=amd64
+  omnictl machine list --talos-version "<1.8"`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			labelQuery, err := machineListLabelQuery(cmd.Flags().Changed("connected"))
+			if err != nil {
+				return err
+			}
+
+			versionFilter, err := parseTalosVersionFilter(machineListFlags.talosVersion)
+			if err != nil {
+				return err
+			}
+
+			return access.WithClient(func(ctx context.Context, client *client.Client) error {
+				writer := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+				fmt.Fprintf(writer, "ID\tHOSTNAME\tCLUSTER\tCONNECTED\tSTAGE\tTALOS VERSION\n") //nolint:errcheck
+
+				err = client.Omni().ListPages(ctx, omni.NewMachineStatus(resources.DefaultNamespace, "").Metadata(), machineListPageSize, func(page resource.List) error {
+					for _, item := range page.Items {
+						machine, ok := item.(*omni.MachineStatus)
+						if !ok {
+							return fmt.Errorf("unexpected resource type %T", item)
+						}
+
+						if versionFilter != nil && !versionFilter(machine.TypedSpec().Value.TalosVersion) {
+							continue
+						}
+
+						stage := machineStageRunning
+						if machine.TypedSpec().Value.Maintenance {
+							stage = machineStageMaintenance
+						}
+
+						cluster, _ := machine.Metadata().Labels().Get(omni.LabelCluster)
+
+						fmt.Fprintf(writer, "%s\t%s\t%s\t%t\t%s\t%s\n", //nolint:errcheck
+							machine.Metadata().ID(),
+							machine.TypedSpec().Value.GetNetwork().GetHostname(),
+							cluster,
+							machine.TypedSpec().Value.Connected,
+							stage,
+							machine.TypedSpec().Value.TalosVersion,
+						)
+					}
+
+					return nil
+				}, state.WithLabelQuery(labelQuery...))
+				if err != nil {
+					return err
+				}
+
+				return writer.Flush()
+			})
+		},
+	}
+
+	machineUserDataCmd = &cobra.Command{
+		Use:   "user-data",
+		Short: "Print the machine config which connects the cloud machines to Omni when passed as the user-data",
+		Long: `Print the partial machine config which connects the machine to Omni over SideroLink.
+
+The output can be passed as the user-data to the cloud machines booted from the vanilla Talos images,
+the machines are then registered in Omni without the SideroLink kernel arguments.
+The partial machine configs are supported by Talos 1.5 and later.`,
+		Example: `  omnictl machine user-data --talos-version 1.7.6 > user-data.yaml`,
+		Args:    cobra.NoArgs,
+		RunE: func(*cobra.Command, []string) error {
+			return access.WithClient(func(ctx context.Context, client *client.Client) error {
+				userData, err := client.Management().GetMachineUserData(ctx, machineUserDataFlags.talosVersion)
+				if err != nil {
+					return err
+				}
+
+				_, err = os.Stdout.WriteString(userData)
+
+				return err
+			})
+		},
+	}
+
+	machineJoinArtifactsCmd = &cobra.Command{
+		Use:   "join-artifacts",
+		Short: "Download the artifacts which connect the machines to Omni with the join token",
+		Long: `Download the artifacts which connect the machines to Omni with the join token into the output directory:
+
+  * kernel-args.txt - the kernel arguments to boot the machine with
+  * iso-url.txt - the image factory URL of the ISO with the kernel arguments embedded
+  * join-config.yaml - the partial machine config, written only if the Talos version supports it`,
+		Example: `  omnictl machine join-artifacts --talos-version 1.7.6 --arch arm64 --output-dir ./join`,
+		Args:    cobra.NoArgs,
+		RunE: func(*cobra.Command, []string) error {
+			return access.WithClient(func(ctx context.Context, client *client.Client) error {
+				artifacts, err := client.Management().GetJoinArtifacts(ctx, &management.GetJoinArtifactsRequest{
+					JoinToken:    machineJoinArtifactsFlags.joinToken,
+					TalosVersion: machineJoinArtifactsFlags.talosVersion,
+					Arch:         machineJoinArtifactsFlags.arch,
+					SecureBoot:   machineJoinArtifactsFlags.secureBoot,
+				})
+				if err != nil {
+					return err
+				}
+
+				if err = os.MkdirAll(machineJoinArtifactsFlags.outputDir, 0o755); err != nil {
+					return err
+				}
+
+				type file struct {
+					name, data string
+				}
+
+				files := []file{
+					{name: "kernel-args.txt", data: strings.Join(artifacts.KernelArgs, " ") + "\n"},
+					{name: "iso-url.txt", data: artifacts.IsoUrl + "\n"},
+				}
+
+				if artifacts.Config != "" {
+					files = append(files, file{name: "join-config.yaml", data: artifacts.Config})
+				}
+
+				for _, f := range files {
+					path := filepath.Join(machineJoinArtifactsFlags.outputDir, f.name)
+
+					// the artifacts contain the join token
+					if err = os.WriteFile(path, []byte(f.data), 0o600); err != nil {
+						return err
+					}
+
+					fmt.Printf("wrote %s\n", path)
+				}
+
+				return nil
+			})
+		},
+	}
+)
+
+// machineListLabelQuery builds the server-side label query from the machine list flags.
+func machineListLabelQuery(connectedSet bool) ([]resource.LabelQueryOption, error) {
+	var query []resource.LabelQueryOption
+
+	if machineListFlags.cluster != "" {
+		query = append(query, resource.LabelEqual(omni.LabelCluster, machineListFlags.cluster))
+	}
+
+	if connectedSet {
+		if machineListFlags.connected {
+			query = append(query, resource.LabelExists(omni.MachineStatusLabelConnected))
+		} else {
+			query = append(query, resource.LabelExists(omni.MachineStatusLabelDisconnected))
+		}
+	}
+
+	switch machineListFlags.stage {
+	case "":
+	case machineStageMaintenance:
+		query = append(query, resource.LabelExists(omni.MachineStatusLabelMaintenance))
+	case machineStageRunning:
+		query = append(query, resource.LabelExists(omni.MachineStatusLabelMaintenance, resource.NotMatches))
+	default:
+		return nil, fmt.Errorf("unknown stage %q, expected one of: %s, %s", machineListFlags.stage, machineStageMaintenance, machineStageRunning)
+	}
+
+	if machineListFlags.talosVersion != "" {
+		query = append(query, resource.LabelExists(omni.MachineStatusLabelTalosVersion))
+	}
+
+	for _, label := range machineListFlags.labels {
+		key, value, hasValue := strings.Cut(label, "=")
+		if key == "" {
+			return nil, fmt.Errorf("invalid label %q, expected key or key=value", label)
+		}
+
+		if hasValue {
+			query = append(query, resource.LabelEqual(key, value))
+		} else {
+			query = append(query, resource.LabelExists(key))
+		}
+	}
+
+	return query, nil
+}
+
+// parseTalosVersionFilter parses the version constraint like "<1.8", ">=1.7.4" or "1.7.4".
+//
+// The label query can only compare the versions as strings, so the machines are filtered by the version on the client side.
+func parseTalosVersionFilter(constraint string) (func(string) bool, error) {
+	if constraint == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	var op string
+
+	for _, prefix := range []string{"<=", ">=", "!=", "<", ">", "="} {
+		if strings.HasPrefix(constraint, prefix) {
+			op = prefix
+
+			break
+		}
+	}
+
+	expected, err := semver.ParseTolerant(strings.TrimSpace(strings.TrimPrefix(constraint, op)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid Talos version constraint %q: %w", constraint, err)
+	}
+
+	return func(version string) bool {
+		actual, err := semver.ParseTolerant(version)
+		if err != nil {
+			return false
+		}
+
+		// compare the released versions only, so that "<1.8" doesn't match the 1.8 pre-releases
+		actual.Pre = nil
+		actual.Build = nil
+
+		cmp := actual.Compare(expected)
+
+		switch op {
+		case "<":
+			return cmp < 0
+		case "<=":
+			return cmp <= 0
+		case ">":
+			return cmp > 0
+		case ">=":
+			return cmp >= 0
+		case "!=":
+			return cmp != 0
+		default:
+			return cmp == 0
+		}
+	}, nil
+}
+
+func init() {
+	RootCmd.AddCommand(machineCmd)
+
+	machineCmd.AddCommand(machineListCmd)
+	machineCmd.AddCommand(machineUserDataCmd)
+	machineCmd.AddCommand(machineJoinArtifactsCmd)
+
+	machineListCmd.Flags().StringVar(&machineListFlags.cluster, "cluster", "", "list only the machines allocated to the cluster")
+	machineListCmd.Flags().BoolVar(&machineListFlags.connected, "connected", true, "list only the connected (true) or the disconnected (false) machines")
+	machineListCmd.Flags().StringVar(&machineListFlags.stage, "stage", "", "list only the machines in the stage: "+machineStageMaintenance+" or "+machineStageRunning)
+	machineListCmd.Flags().StringVar(&machineListFlags.talosVersion, "talos-version", "",
+		"list only the machines running the matching Talos version, supports the <, <=, >, >=, = and != operators, e.g. \"<1.8\"")
+	machineListCmd.Flags().StringSliceVar(&machineListFlags.labels, "label", nil, "list only the machines with the label, in the key=value or key format, can be repeated")
+
+	machineUserDataCmd.Flags().StringVar(&machineUserDataFlags.talosVersion, "talos-version", "", "check that the Talos version the machine boots supports the partial machine configs")
+
+	machineJoinArtifactsCmd.Flags().StringVar(&machineJoinArtifactsFlags.joinToken, "join-token", "", "the join token to generate the artifacts for, the current join token is used by default")
+	machineJoinArtifactsCmd.Flags().StringVar(&machineJoinArtifactsFlags.talosVersion, "talos-version", constants.DefaultTalosVersion, "the Talos version of the ISO")
+	machineJoinArtifactsCmd.Flags().StringVar(&machineJoinArtifactsFlags.arch, "arch", "amd64", "the architecture of the ISO: amd64 or arm64")
+	machineJoinArtifactsCmd.Flags().BoolVar(&machineJoinArtifactsFlags.secureBoot, "secure-boot", false, "use the SecureBoot ISO")
+	machineJoinArtifactsCmd.Flags().StringVarP(&machineJoinArtifactsFlags.outputDir, "output-dir", "o", ".", "the directory to write the artifacts to")
+}
@@ -0,0 +1,166 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package output
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/fatih/color"
+	"github.com/siderolabs/gen/maps"
+	yaml "gopkg.in/yaml.v3"
+)
+
+// Diff outputs only the fields which changed between the consecutive versions of the watched resources.
+//
+// The resource is written in full YAML when it is seen for the first time.
+type Diff struct {
+	writer   io.Writer
+	previous map[string]map[string]any
+}
+
+// NewDiff initializes diff resource output.
+func NewDiff(writer io.Writer) *Diff {
+	return &Diff{
+		writer:   writer,
+		previous: map[string]map[string]any{},
+	}
+}
+
+// WriteHeader implements output.Writer interface.
+func (d *Diff) WriteHeader(_ *meta.ResourceDefinition, withEvents bool) error {
+	if !withEvents {
+		return errors.New("diff output format is supported only in the watch mode")
+	}
+
+	return nil
+}
+
+// WriteResource implements output.Writer interface.
+func (d *Diff) WriteResource(r resource.Resource, event state.EventType) error {
+	key := resource.String(r)
+
+	color.New(color.Bold).Fprintf(d.writer, "%s %s\n", strings.ToLower(event.String()), key) //nolint:errcheck
+
+	if event == state.Destroyed {
+		delete(d.previous, key)
+
+		return nil
+	}
+
+	data, err := resourceToMap(r)
+	if err != nil {
+		return err
+	}
+
+	current := map[string]any{}
+
+	flatten("", data, current)
+
+	previous, ok := d.previous[key]
+
+	d.previous[key] = current
+
+	if !ok {
+		return yaml.NewEncoder(d.writer).Encode(data)
+	}
+
+	d.writeChanges(previous, current)
+
+	return nil
+}
+
+func (d *Diff) writeChanges(previous, current map[string]any) {
+	paths := maps.Keys(previous)
+
+	for path := range current {
+		if _, ok := previous[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+
+	slices.Sort(paths)
+
+	red := color.New(color.FgRed)
+	green := color.New(color.FgGreen)
+	yellow := color.New(color.FgYellow)
+
+	for _, path := range paths {
+		oldValue, inPrevious := previous[path]
+		newValue, inCurrent := current[path]
+
+		switch {
+		case !inCurrent:
+			red.Fprintf(d.writer, "- %s: %s\n", path, formatValue(oldValue)) //nolint:errcheck
+		case !inPrevious:
+			green.Fprintf(d.writer, "+ %s: %s\n", path, formatValue(newValue)) //nolint:errcheck
+		case !reflect.DeepEqual(oldValue, newValue):
+			yellow.Fprintf(d.writer, "~ %s: %s -> %s\n", path, formatValue(oldValue), formatValue(newValue)) //nolint:errcheck
+		}
+	}
+}
+
+// Flush implements output.Writer interface.
+func (d *Diff) Flush() error {
+	return nil
+}
+
+// flatten collects the scalar values of the nested maps and lists keyed by their paths, e.g. "spec.machines[0]".
+func flatten(path string, value any, out map[string]any) {
+	switch value := value.(type) {
+	case map[string]any:
+		if len(value) == 0 {
+			out[path] = value
+
+			return
+		}
+
+		for key, v := range value {
+			if path != "" {
+				key = path + "." + key
+			}
+
+			flatten(key, v, out)
+		}
+	case []any:
+		if len(value) == 0 {
+			out[path] = value
+
+			return
+		}
+
+		for i, v := range value {
+			flatten(fmt.Sprintf("%s[%d]", path, i), v, out)
+		}
+	default:
+		out[path] = value
+	}
+}
+
+func formatValue(value any) string {
+	switch value := value.(type) {
+	case map[string]any:
+		return "{}"
+	case []any:
+		return "[]"
+	case string:
+		// keep the multiline values on a single line
+		if strings.Contains(value, "\n") || value == "" {
+			return strconv.Quote(value)
+		}
+
+		return value
+	default:
+		return fmt.Sprint(value)
+	}
+}
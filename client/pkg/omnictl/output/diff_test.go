@@ -0,0 +1,69 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package output_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/pkg/omnictl/output"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	out := output.NewDiff(&buf)
+
+	assert.ErrorContains(t, out.WriteHeader(nil, false), "supported only in the watch mode")
+	require.NoError(t, out.WriteHeader(nil, true))
+
+	cluster := newCluster("talos-default", "1.30.0")
+	cluster.Metadata().Labels().Set("env", "prod")
+
+	// the resource is written in full when it is seen for the first time
+	require.NoError(t, out.WriteResource(cluster, state.Created))
+
+	assert.Contains(t, buf.String(), "created Clusters.omni.sidero.dev(default/talos-default)\n")
+	assert.Contains(t, buf.String(), "kubernetesversion: 1.30.0\n")
+
+	buf.Reset()
+
+	cluster.TypedSpec().Value.KubernetesVersion = "1.31.0"
+	cluster.TypedSpec().Value.TalosVersion = "1.8.0"
+	cluster.Metadata().Labels().Delete("env")
+	cluster.Metadata().Labels().Set("tier", "gold")
+
+	require.NoError(t, out.WriteResource(cluster, state.Updated))
+
+	assert.Equal(t, `updated Clusters.omni.sidero.dev(default/talos-default)
+- metadata.labels.env: prod
++ metadata.labels.tier: gold
+~ spec.kubernetesversion: 1.30.0 -> 1.31.0
+~ spec.talosversion: "" -> 1.8.0
+`, buf.String())
+
+	buf.Reset()
+
+	// no changes, only the event is written
+	require.NoError(t, out.WriteResource(cluster, state.Updated))
+
+	assert.Equal(t, "updated Clusters.omni.sidero.dev(default/talos-default)\n", buf.String())
+
+	buf.Reset()
+
+	// the destroyed resource is forgotten, and written in full once created again
+	require.NoError(t, out.WriteResource(cluster, state.Destroyed))
+	require.NoError(t, out.WriteResource(cluster, state.Created))
+
+	assert.Contains(t, buf.String(), "destroyed Clusters.omni.sidero.dev(default/talos-default)\ncreated")
+	assert.Contains(t, buf.String(), "kubernetesversion: 1.31.0\n")
+	assert.NotContains(t, buf.String(), "->")
+}
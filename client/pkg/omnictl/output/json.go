@@ -6,6 +6,7 @@ package output
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 	"strings"
 
@@ -13,11 +14,13 @@ import (
 	"github.com/cosi-project/runtime/pkg/resource/meta"
 	"github.com/cosi-project/runtime/pkg/state"
 	yaml "gopkg.in/yaml.v3"
+	"k8s.io/client-go/util/jsonpath"
 )
 
 // JSON outputs resources in JSON format.
 type JSON struct {
 	writer     io.Writer
+	jsonPath   *jsonpath.JSONPath
 	withEvents bool
 }
 
@@ -28,6 +31,23 @@ func NewJSON(writer io.Writer) *JSON {
 	}
 }
 
+// NewJSONWithJSONPath initializes JSON resource output which writes only the values matching the JSONPath expression.
+//
+// Each matching value is written as a separate JSON document, the resources without the matching values are skipped.
+// In the watch mode the event type is available as the "event" field, e.g. '{.event}'.
+func NewJSONWithJSONPath(writer io.Writer, expr string) (*JSON, error) {
+	jp := jsonpath.New("json")
+
+	if err := jp.Parse(expr); err != nil {
+		return nil, fmt.Errorf("error parsing jsonpath: %w", err)
+	}
+
+	return &JSON{
+		writer:   writer,
+		jsonPath: jp.AllowMissingKeys(true),
+	}, nil
+}
+
 // WriteHeader implements output.Writer interface.
 func (j *JSON) WriteHeader(_ *meta.ResourceDefinition, withEvents bool) error {
 	j.withEvents = withEvents
@@ -37,6 +57,20 @@ func (j *JSON) WriteHeader(_ *meta.ResourceDefinition, withEvents bool) error {
 
 // prepareEncodableData prepares the data of a resource to be encoded as JSON and populates it with some extra information.
 func (j *JSON) prepareEncodableData(r resource.Resource, event state.EventType) (map[string]any, error) {
+	data, err := resourceToMap(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if j.withEvents {
+		data["event"] = strings.ToLower(event.String())
+	}
+
+	return data, nil
+}
+
+// resourceToMap converts the resource to the generic map, as it is represented in the YAML output.
+func resourceToMap(r resource.Resource) (map[string]any, error) {
 	out, err := resource.MarshalYAML(r)
 	if err != nil {
 		return nil, err
@@ -49,15 +83,10 @@ func (j *JSON) prepareEncodableData(r resource.Resource, event state.EventType)
 
 	var data map[string]any
 
-	err = yaml.Unmarshal(yamlBytes, &data)
-	if err != nil {
+	if err = yaml.Unmarshal(yamlBytes, &data); err != nil {
 		return nil, err
 	}
 
-	if j.withEvents {
-		data["event"] = strings.ToLower(event.String())
-	}
-
 	return data, nil
 }
 
@@ -75,7 +104,24 @@ func (j *JSON) WriteResource(r resource.Resource, event state.EventType) error {
 		return err
 	}
 
-	return writeAsIndentedJSON(j.writer, data)
+	if j.jsonPath == nil {
+		return writeAsIndentedJSON(j.writer, data)
+	}
+
+	results, err := j.jsonPath.FindResults(data)
+	if err != nil {
+		return fmt.Errorf("error finding result for jsonpath: %w", err)
+	}
+
+	for _, resultGroup := range results {
+		for _, result := range resultGroup {
+			if err = writeAsIndentedJSON(j.writer, result.Interface()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
 }
 
 // Flush implements output.Writer interface.
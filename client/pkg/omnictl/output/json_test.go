@@ -0,0 +1,83 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package output_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/omnictl/output"
+)
+
+func newCluster(id, kubernetesVersion string) *omni.Cluster {
+	cluster := omni.NewCluster(resources.DefaultNamespace, id)
+	cluster.TypedSpec().Value.KubernetesVersion = kubernetesVersion
+
+	return cluster
+}
+
+func TestJSONWithJSONPath(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name       string
+		expr       string
+		expected   string
+		withEvents bool
+	}{
+		{
+			name:     "field",
+			expr:     "{.spec.kubernetesversion}",
+			expected: "\"1.30.0\"\n\"1.31.0\"\n",
+		},
+		{
+			name:     "metadata",
+			expr:     "{.metadata.id}",
+			expected: "\"first\"\n\"second\"\n",
+		},
+		{
+			name:       "event",
+			expr:       "{.event}",
+			withEvents: true,
+			expected:   "\"created\"\n\"updated\"\n",
+		},
+		{
+			name: "missing field",
+			expr: "{.spec.missing}",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+
+			out, err := output.NewJSONWithJSONPath(&buf, tt.expr)
+			require.NoError(t, err)
+
+			require.NoError(t, out.WriteHeader(nil, tt.withEvents))
+			require.NoError(t, out.WriteResource(newCluster("first", "1.30.0"), state.Created))
+			require.NoError(t, out.WriteResource(newCluster("second", "1.31.0"), state.Updated))
+			require.NoError(t, out.Flush())
+
+			assert.Equal(t, tt.expected, buf.String())
+		})
+	}
+}
+
+func TestJSONPathErrors(t *testing.T) {
+	t.Parallel()
+
+	_, err := output.NewJSONWithJSONPath(&bytes.Buffer{}, "{.spec")
+	assert.ErrorContains(t, err, "error parsing jsonpath")
+
+	_, err = output.NewWriter("yaml", output.WithJSONPath("{.spec}"))
+	assert.ErrorContains(t, err, "jsonpath filter is supported only for the json output format")
+}
@@ -24,8 +24,36 @@ type Writer interface {
 	Flush() error
 }
 
+type writerOptions struct {
+	jsonPath string
+}
+
+// WriterOption is a functional option for the writer.
+type WriterOption func(*writerOptions)
+
+// WithJSONPath filters the values written by the JSON writer with the JSONPath expression.
+func WithJSONPath(expr string) WriterOption {
+	return func(o *writerOptions) {
+		o.jsonPath = expr
+	}
+}
+
 // NewWriter builds writer from type.
-func NewWriter(format string) (Writer, error) { //nolint:ireturn
+func NewWriter(format string, opts ...WriterOption) (Writer, error) { //nolint:ireturn
+	var options writerOptions
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if options.jsonPath != "" {
+		if format != "json" {
+			return nil, fmt.Errorf("jsonpath filter is supported only for the json output format, got %q", format)
+		}
+
+		return NewJSONWithJSONPath(os.Stdout, options.jsonPath)
+	}
+
 	switch {
 	case format == "table":
 		return NewTable(), nil
@@ -33,6 +61,8 @@ func NewWriter(format string) (Writer, error) { //nolint:ireturn
 		return NewYAML(), nil
 	case format == "json":
 		return NewJSON(os.Stdout), nil
+	case format == "diff":
+		return NewDiff(os.Stdout), nil
 	case strings.HasPrefix(format, "jsonpath="):
 		path := format[len("jsonpath="):]
 
@@ -50,5 +80,5 @@ func NewWriter(format string) (Writer, error) { //nolint:ireturn
 
 // CompleteOutputArg represents tab completion for `--output` argument.
 func CompleteOutputArg(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
-	return []string{"json", "table", "yaml"}, cobra.ShellCompDirectiveNoFileComp
+	return []string{"diff", "json", "table", "yaml"}, cobra.ShellCompDirectiveNoFileComp
 }
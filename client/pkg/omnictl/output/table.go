@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 	"text/tabwriter"
 
@@ -16,6 +17,9 @@ import (
 	"github.com/cosi-project/runtime/pkg/state"
 	"gopkg.in/yaml.v3"
 	"k8s.io/client-go/util/jsonpath"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/common"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 )
 
 // Table outputs resources in Table view.
@@ -24,6 +28,7 @@ type Table struct {
 	displayType    string
 	w              tabwriter.Writer
 	withEvents     bool
+	withAuthorship bool
 }
 
 type dynamicColumn func(value any) (string, error)
@@ -68,6 +73,13 @@ func (table *Table) WriteHeader(definition *meta.ResourceDefinition, withEvents
 		})
 	}
 
+	// the user managed resources have the identity of the last change recorded by the API
+	if slices.Contains(common.UserManagedResourceTypes, definition.TypedSpec().Type) {
+		table.withAuthorship = true
+
+		fields = append(fields, "CREATED BY", "UPDATED BY", "UPDATED")
+	}
+
 	_, err := fmt.Fprintln(&table.w, strings.Join(fields, "\t"))
 
 	return err
@@ -122,11 +134,28 @@ func (table *Table) WriteResource(r resource.Resource, event state.EventType) er
 		values = append(values, value)
 	}
 
+	if table.withAuthorship {
+		values = append(values,
+			annotationValue(r, omni.CreatedBy),
+			annotationValue(r, omni.UpdatedBy),
+			annotationValue(r, omni.UpdatedAt),
+		)
+	}
+
 	_, err = fmt.Fprintln(&table.w, strings.Join(values, "\t"))
 
 	return err
 }
 
+func annotationValue(r resource.Resource, key string) string {
+	value, ok := r.Metadata().Annotations().Get(key)
+	if !ok || value == "" {
+		return "-"
+	}
+
+	return value
+}
+
 // Flush implements output.Writer interface.
 func (table *Table) Flush() error {
 	return table.w.Flush()
@@ -6,6 +6,7 @@ package omnictl
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 
@@ -27,8 +28,8 @@ func init() {
 		fmt.Sprintf("The path to the omni configuration file. Defaults to '%s' env variable if set, otherwise the config directory according to the XDG specification.",
 			config.OmniConfigEnvVar,
 		))
-	RootCmd.PersistentFlags().StringVar(&access.CmdFlags.Context, "context", "",
-		"The context to be used. Defaults to the selected context in the omniconfig file.")
+	RootCmd.PersistentFlags().StringVar(&access.CmdFlags.Context, "context", os.Getenv(access.ContextEnvVar),
+		fmt.Sprintf("The context to be used. Defaults to '%s' env variable if set, otherwise the selected context in the omniconfig file.", access.ContextEnvVar))
 	RootCmd.PersistentFlags().BoolVar(&access.CmdFlags.InsecureSkipTLSVerify, "insecure-skip-tls-verify", false,
 		"Skip TLS verification for the Omni GRPC and HTTP API endpoints.")
 }
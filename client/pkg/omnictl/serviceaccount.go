@@ -18,12 +18,14 @@ import (
 
 	pkgaccess "github.com/siderolabs/omni/client/pkg/access"
 	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/client/management"
 	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
 )
 
 var (
 	serviceAccountCreateFlags struct {
-		role string
+		role    string
+		cluster string
 
 		useUserRole bool
 		ttl         time.Duration
@@ -59,7 +61,9 @@ var (
 					return err
 				}
 
-				publicKeyID, err := client.Management().CreateServiceAccount(ctx, armoredPublicKey, serviceAccountCreateFlags.role, serviceAccountCreateFlags.useUserRole)
+				publicKeyID, err := client.Management().CreateServiceAccount(ctx, armoredPublicKey, serviceAccountCreateFlags.role, serviceAccountCreateFlags.useUserRole,
+					management.WithServiceAccountCluster(serviceAccountCreateFlags.cluster),
+				)
 				if err != nil {
 					return err
 				}
@@ -138,14 +142,19 @@ var (
 
 				writer := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
 
-				fmt.Fprintf(writer, "NAME\tROLE\tPUBLIC KEY ID\tEXPIRATION\n") //nolint:errcheck
+				fmt.Fprintf(writer, "NAME\tROLE\tCLUSTER\tPUBLIC KEY ID\tEXPIRATION\n") //nolint:errcheck
 
 				for _, sa := range serviceAccounts {
+					cluster := sa.GetCluster()
+					if cluster == "" {
+						cluster = "*"
+					}
+
 					for i, publicKey := range sa.PgpPublicKeys {
 						if i == 0 {
-							fmt.Fprintf(writer, "%s\t%q\t%s\t%s\n", sa.Name, sa.GetRole(), publicKey.Id, publicKey.Expiration.AsTime().String()) //nolint:errcheck
+							fmt.Fprintf(writer, "%s\t%q\t%s\t%s\t%s\n", sa.Name, sa.GetRole(), cluster, publicKey.Id, publicKey.Expiration.AsTime().String()) //nolint:errcheck
 						} else {
-							fmt.Fprintf(writer, "\t\t%s\t%s\n", publicKey.Id, publicKey.Expiration.AsTime().String()) //nolint:errcheck
+							fmt.Fprintf(writer, "\t\t\t%s\t%s\n", publicKey.Id, publicKey.Expiration.AsTime().String()) //nolint:errcheck
 						}
 					}
 				}
@@ -198,6 +207,7 @@ func init() {
 	serviceAccountCreateCmd.Flags().DurationVarP(&serviceAccountCreateFlags.ttl, "ttl", "t", 365*24*time.Hour, "TTL for the service account key")
 	serviceAccountCreateCmd.Flags().StringVarP(&serviceAccountCreateFlags.role, roleFlag, "r", "", "role of the service account. only used when --"+useUserRoleFlag+"=false")
 	serviceAccountCreateCmd.Flags().BoolVarP(&serviceAccountCreateFlags.useUserRole, useUserRoleFlag, "u", true, "use the role of the creating user. if true, --"+roleFlag+" is ignored")
+	serviceAccountCreateCmd.Flags().StringVarP(&serviceAccountCreateFlags.cluster, "cluster", "c", "", "restrict the service account to the resources of the cluster. if empty, the service account has access to all clusters")
 
 	serviceAccountRenewCmd.Flags().DurationVarP(&serviceAccountRenewFlags.ttl, "ttl", "t", 365*24*time.Hour, "TTL for the service account key")
 }
@@ -0,0 +1,173 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omnictl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+)
+
+var (
+	stateBackupFlags struct {
+		output string
+	}
+
+	stateRestoreFlags struct {
+		onConflict string
+		dryRun     bool
+	}
+
+	// stateCmd represents the state command.
+	stateCmd = &cobra.Command{
+		Use:   "state",
+		Short: "Back up and restore the Omni state",
+	}
+
+	stateBackupCmd = &cobra.Command{
+		Use:   "backup",
+		Short: "Download the backup archive of the user-owned resources of the Omni state",
+		Long: `Download the backup archive of the user-owned resources of the Omni state.
+
+The archive contains the clusters, the machine sets, the config patches, the users, the service accounts and the instance settings.
+The resources managed by Omni itself are not included, they are recreated after the restore,
+except for the cluster secrets and the etcd backup encryption keys, which the restored clusters and their etcd backups depend on.
+The archive contains the cluster secrets, the config patches and the backup storage credentials, store it securely.`,
+		Example: `  omnictl state backup -o omni-backup.tar.gz`,
+		Args:    cobra.NoArgs,
+		RunE: func(*cobra.Command, []string) error {
+			return access.WithClient(backupState)
+		},
+	}
+
+	stateRestoreCmd = &cobra.Command{
+		Use:   "restore <archive>",
+		Short: "Restore the resources of the backup archive",
+		Long: `Restore the resources of the backup archive created by 'omnictl state backup', e.g. to the new Omni instance.
+
+The --on-conflict flag defines how the resources which already exist are restored:
+  skip      - keep the existing resources
+  overwrite - replace the labels, the annotations and the spec of the existing resources
+  fail      - abort without any changes if any of the resources exists`,
+		Example: `  omnictl state restore omni-backup.tar.gz --dry-run
+  omnictl state restore omni-backup.tar.gz --on-conflict overwrite`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			conflictResolution, ok := management.RestoreStateRequest_ConflictResolution_value[strings.ToUpper(stateRestoreFlags.onConflict)]
+			if !ok {
+				return fmt.Errorf("invalid --on-conflict value %q, expected one of skip, overwrite or fail", stateRestoreFlags.onConflict)
+			}
+
+			archive, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+
+			defer archive.Close() //nolint:errcheck
+
+			return access.WithClient(func(ctx context.Context, client *client.Client) error {
+				return restoreState(ctx, client, archive, management.RestoreStateRequest_ConflictResolution(conflictResolution))
+			})
+		},
+	}
+)
+
+// backupState writes the archive to a temporary file which replaces the output only when the backup is complete.
+func backupState(ctx context.Context, client *client.Client) error {
+	f, err := os.CreateTemp(filepath.Dir(stateBackupFlags.output), ".omni-backup-*")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(f.Name()) //nolint:errcheck
+
+	if err = client.Management().BackupState(ctx, f); err != nil {
+		f.Close() //nolint:errcheck
+
+		return err
+	}
+
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	if err = os.Rename(f.Name(), stateBackupFlags.output); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "state backup is written to %s\n", stateBackupFlags.output) //nolint:errcheck
+
+	return nil
+}
+
+func restoreState(ctx context.Context, client *client.Client, archive io.Reader, conflictResolution management.RestoreStateRequest_ConflictResolution) error {
+	results, err := client.Management().RestoreState(ctx, archive, conflictResolution, stateRestoreFlags.dryRun)
+	if err != nil {
+		return err
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintf(writer, "TYPE\tID\tACTION\n") //nolint:errcheck
+
+	counts := map[management.RestoreStateResponse_Action]int{}
+
+	for _, result := range results {
+		counts[result.Action]++
+
+		outcome := strings.ToLower(result.Action.String())
+
+		if result.Error != "" {
+			outcome += ": " + result.Error
+		}
+
+		fmt.Fprintf(writer, "%s\t%s\t%s\n", result.Type, result.Id, outcome) //nolint:errcheck
+	}
+
+	if err = writer.Flush(); err != nil {
+		return err
+	}
+
+	summary := fmt.Sprintf("created: %d, updated: %d, skipped: %d, failed: %d",
+		counts[management.RestoreStateResponse_CREATED],
+		counts[management.RestoreStateResponse_UPDATED],
+		counts[management.RestoreStateResponse_SKIPPED],
+		counts[management.RestoreStateResponse_FAILED],
+	)
+
+	if stateRestoreFlags.dryRun {
+		summary += " (dry run)"
+	}
+
+	fmt.Fprintln(os.Stderr, summary) //nolint:errcheck
+
+	if failed := counts[management.RestoreStateResponse_FAILED]; failed > 0 {
+		return fmt.Errorf("failed to restore %d resources", failed)
+	}
+
+	return nil
+}
+
+func init() {
+	stateBackupCmd.Flags().StringVarP(&stateBackupFlags.output, "output", "o", "omni-backup.tar.gz", "path of the backup archive")
+
+	stateRestoreCmd.Flags().StringVar(&stateRestoreFlags.onConflict, "on-conflict", "skip", "how to restore the existing resources: skip, overwrite or fail")
+	stateRestoreCmd.Flags().BoolVar(&stateRestoreFlags.dryRun, "dry-run", false, "report the outcome of the restore without changing the state")
+
+	stateCmd.AddCommand(stateBackupCmd)
+	stateCmd.AddCommand(stateRestoreCmd)
+
+	RootCmd.AddCommand(stateCmd)
+}
@@ -33,8 +33,9 @@ var supportCmdFlags struct {
 var supportCmd = &cobra.Command{
 	Use:   "support [local-path]",
 	Short: "Download the support bundle for a cluster",
-	Long:  `The command collects all non-sensitive information for the cluster from the Omni state.`,
-	Args:  cobra.NoArgs,
+	Long: `The command collects all non-sensitive information for the cluster from the Omni state,
+the recent controller logs, the machine logs, the etcd backup status and the Talos and Kubernetes diagnostics of the cluster.`,
+	Args: cobra.NoArgs,
 	RunE: func(*cobra.Command, []string) error {
 		return access.WithClient(createSupportBundle())
 	},
@@ -94,6 +95,12 @@ func (sbe *supportBundleErrors) print() error {
 
 func createSupportBundle() func(ctx context.Context, client *client.Client) error {
 	return func(ctx context.Context, client *client.Client) error {
+		var err error
+
+		if supportCmdFlags.cluster, err = access.ResolveCluster(supportCmdFlags.cluster); err != nil {
+			return err
+		}
+
 		progress := make(chan *management.GetSupportBundleResponse_Progress)
 
 		eg, ctx := errgroup.WithContext(ctx)
@@ -221,11 +228,9 @@ func showProgress(progress <-chan *management.GetSupportBundleResponse_Progress,
 }
 
 func init() {
-	supportCmd.Flags().StringVarP(&supportCmdFlags.cluster, "cluster", "c", "", "cluster to use")
+	supportCmd.Flags().StringVarP(&supportCmdFlags.cluster, "cluster", "c", "", "cluster to use, defaults to the default cluster of the context")
 	supportCmd.Flags().StringVarP(&supportCmdFlags.output, "output", "O", "support.zip", "support bundle output")
 	supportCmd.Flags().BoolVarP(&supportCmdFlags.verbose, "verbose", "v", false, "verbose output")
 
-	supportCmd.MarkFlagRequired("cluster") //nolint:errcheck
-
 	RootCmd.AddCommand(supportCmd)
 }
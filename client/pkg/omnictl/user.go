@@ -0,0 +1,133 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omnictl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+)
+
+var (
+	userSessionsRevokeFlags struct {
+		identity string
+	}
+
+	// userCmd represents the user command.
+	userCmd = &cobra.Command{
+		Use:   "user",
+		Short: "Manage users",
+	}
+
+	userSessionsCmd = &cobra.Command{
+		Use:     "sessions",
+		Aliases: []string{"session"},
+		Short:   "Manage user sessions (registered public keys)",
+	}
+
+	userSessionsListCmd = &cobra.Command{
+		Use:     "list [email]",
+		Aliases: []string{"l"},
+		Short:   "List active user sessions, optionally filtered by the user email",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			var identity string
+
+			if len(args) > 0 {
+				identity = args[0]
+			}
+
+			return access.WithClient(func(ctx context.Context, client *client.Client) error {
+				sessions, err := client.Management().ListUserSessions(ctx, identity)
+				if err != nil {
+					return err
+				}
+
+				writer := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+				fmt.Fprintf(writer, "PUBLIC KEY ID\tIDENTITY\tROLE\tCONFIRMED\tCREATED\tLAST USED\tEXPIRATION\tCLIENT\n") //nolint:errcheck
+
+				for _, session := range sessions {
+					fmt.Fprintf(writer, "%s\t%s\t%s\t%t\t%s\t%s\t%s\t%s\n", //nolint:errcheck
+						session.GetPublicKeyId(),
+						session.GetIdentity(),
+						session.GetRole(),
+						session.GetConfirmed(),
+						formatSessionTime(session.GetCreated()),
+						formatSessionTime(session.GetLastUsed()),
+						formatSessionTime(session.GetExpiration()),
+						session.GetClientInfo(),
+					)
+				}
+
+				return writer.Flush()
+			})
+		},
+	}
+
+	userSessionsRevokeCmd = &cobra.Command{
+		Use:     "revoke [public-key-id]",
+		Aliases: []string{"r"},
+		Short:   "Revoke a user session by its public key ID, or all sessions of a user with --identity",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			if (len(args) == 0) == (userSessionsRevokeFlags.identity == "") {
+				return errors.New("either a public key ID or --identity must be specified")
+			}
+
+			return access.WithClient(func(ctx context.Context, client *client.Client) error {
+				if userSessionsRevokeFlags.identity == "" {
+					if err := client.Management().RevokeUserSession(ctx, args[0]); err != nil {
+						return fmt.Errorf("failed to revoke session: %w", err)
+					}
+
+					fmt.Printf("revoked session: %s\n", args[0])
+
+					return nil
+				}
+
+				revoked, err := client.Management().RevokeUserSessions(ctx, userSessionsRevokeFlags.identity)
+				if err != nil {
+					return fmt.Errorf("failed to revoke sessions: %w", err)
+				}
+
+				for _, id := range revoked {
+					fmt.Printf("revoked session: %s\n", id)
+				}
+
+				fmt.Printf("revoked %d session(s) of %s\n", len(revoked), userSessionsRevokeFlags.identity)
+
+				return nil
+			})
+		},
+	}
+)
+
+func formatSessionTime(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return "-"
+	}
+
+	return ts.AsTime().Local().Format(time.RFC3339)
+}
+
+func init() {
+	RootCmd.AddCommand(userCmd)
+
+	userCmd.AddCommand(userSessionsCmd)
+	userSessionsCmd.AddCommand(userSessionsListCmd)
+	userSessionsCmd.AddCommand(userSessionsRevokeCmd)
+
+	userSessionsRevokeCmd.Flags().StringVarP(&userSessionsRevokeFlags.identity, "identity", "i", "", "revoke all sessions of the user with the given email")
+}
@@ -0,0 +1,397 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package template
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// KindInclude is the kind of the template document which is replaced with the documents of the included file.
+const KindInclude = "Include"
+
+// ExpandOption configures ExpandFile.
+type ExpandOption func(*expander)
+
+// WithPatchDir sets the directory the config patch files of the template are relative to.
+//
+// By default the config patch files are relative to the current working directory.
+func WithPatchDir(dir string) ExpandOption {
+	return func(e *expander) {
+		e.patchDir = dir
+	}
+}
+
+// WithIncludeRoot restricts the included files to the directory, e.g. to the repository the template is read from.
+func WithIncludeRoot(root string) ExpandOption {
+	return func(e *expander) {
+		e.root = root
+	}
+}
+
+// ExpandFile reads the template from the file and replaces the includes with the contents of the included files.
+//
+// The template document of kind Include is replaced with all documents of the included file:
+//
+//	kind: Include
+//	file: partials/workers.yaml
+//	params:
+//	  name: gpu
+//
+// The patch entry with the include field is replaced with the patch entries listed in the included file:
+//
+//	patches:
+//	  - include: partials/common-patches.yaml
+//	    params:
+//	      region: eu
+//
+// The included files are resolved relative to the file which includes them and might include other files.
+// The ${name} references in the included files are substituted with the parameters of the include,
+// the reference to the undefined parameter is an error, $${name} is kept as ${name}.
+// The config patch files referenced by the included files are relative to the included file, they are rewritten
+// to resolve the same way as the config patch files of the template itself (see WithPatchDir).
+//
+// It returns the expanded template and the paths of all included files.
+func ExpandFile(path string, opts ...ExpandOption) ([]byte, []string, error) {
+	var e expander
+
+	for _, opt := range opts {
+		opt(&e)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	e.stack = []string{absPath}
+
+	docs, err := e.expandDocuments(path, data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var out bytes.Buffer
+
+	enc := yaml.NewEncoder(&out)
+	enc.SetIndent(2)
+
+	for _, doc := range docs {
+		if err = enc.Encode(doc); err != nil {
+			return nil, nil, fmt.Errorf("error encoding template: %w", err)
+		}
+	}
+
+	if err = enc.Close(); err != nil {
+		return nil, nil, fmt.Errorf("error encoding template: %w", err)
+	}
+
+	return out.Bytes(), e.included, nil
+}
+
+var paramReference = regexp.MustCompile(`\$?\$\{([^}]*)\}`)
+
+var paramName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+
+type expander struct {
+	root     string
+	patchDir string
+
+	// stack is the chain of the files being included starting with the template itself, to detect the cycles
+	stack    []string
+	included []string
+}
+
+type include struct {
+	Params map[string]string `yaml:"params"`
+	Kind   string            `yaml:"kind"`
+	File   string            `yaml:"file"`
+}
+
+type patchInclude struct {
+	Params  map[string]string `yaml:"params"`
+	Include string            `yaml:"include"`
+}
+
+// expandDocuments expands the includes in all documents of the file.
+func (e *expander) expandDocuments(path string, data []byte) ([]*yaml.Node, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+
+	var docs []*yaml.Node
+
+	for {
+		var doc yaml.Node
+
+		if err := dec.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				return docs, nil
+			}
+
+			return nil, fmt.Errorf("error decoding %q: %w", path, err)
+		}
+
+		if len(doc.Content) != 1 || doc.Content[0].Kind != yaml.MappingNode {
+			docs = append(docs, &doc)
+
+			continue
+		}
+
+		document := doc.Content[0]
+
+		if kind := mappingValue(document, "kind"); kind != nil && kind.Value == KindInclude {
+			var spec include
+
+			if err := decodeStrict(document, &spec); err != nil {
+				return nil, fmt.Errorf("error decoding include in %q at line %d: %w", path, document.Line, err)
+			}
+
+			includedDocs, err := e.includeFile(path, spec.File, spec.Params, e.expandDocuments)
+			if err != nil {
+				return nil, err
+			}
+
+			docs = append(docs, includedDocs...)
+
+			continue
+		}
+
+		if err := e.expandDocumentPatches(path, document); err != nil {
+			return nil, err
+		}
+
+		docs = append(docs, &doc)
+	}
+}
+
+func (e *expander) expandDocumentPatches(path string, document *yaml.Node) error {
+	patches := mappingValue(document, "patches")
+	if patches == nil || patches.Kind != yaml.SequenceNode {
+		return nil
+	}
+
+	expanded, err := e.expandPatches(path, patches.Content)
+	if err != nil {
+		return err
+	}
+
+	patches.Content = expanded
+
+	return nil
+}
+
+// expandPatches expands the includes in the list of the patch entries and rewrites the config patch files of the included entries.
+func (e *expander) expandPatches(path string, patches []*yaml.Node) ([]*yaml.Node, error) {
+	expanded := make([]*yaml.Node, 0, len(patches))
+
+	for _, patch := range patches {
+		if patch.Kind != yaml.MappingNode || mappingValue(patch, "include") == nil {
+			if err := e.rewritePatchFile(path, patch); err != nil {
+				return nil, err
+			}
+
+			expanded = append(expanded, patch)
+
+			continue
+		}
+
+		var spec patchInclude
+
+		if err := decodeStrict(patch, &spec); err != nil {
+			return nil, fmt.Errorf("error decoding patch include in %q at line %d: %w", path, patch.Line, err)
+		}
+
+		includedPatches, err := e.includeFile(path, spec.Include, spec.Params, e.expandPatchList)
+		if err != nil {
+			return nil, err
+		}
+
+		expanded = append(expanded, includedPatches...)
+	}
+
+	return expanded, nil
+}
+
+// expandPatchList expands the file which contains the list of the patch entries.
+func (e *expander) expandPatchList(path string, data []byte) ([]*yaml.Node, error) {
+	var doc yaml.Node
+
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("error decoding %q: %w", path, err)
+	}
+
+	if len(doc.Content) == 0 {
+		return nil, nil
+	}
+
+	if len(doc.Content) != 1 || doc.Content[0].Kind != yaml.SequenceNode {
+		return nil, fmt.Errorf("patches file %q must contain a list of patches", path)
+	}
+
+	return e.expandPatches(path, doc.Content[0].Content)
+}
+
+// includeFile reads the included file, substitutes the parameters and expands it.
+func (e *expander) includeFile(
+	includer, file string,
+	params map[string]string,
+	expand func(path string, data []byte) ([]*yaml.Node, error),
+) ([]*yaml.Node, error) {
+	if file == "" {
+		return nil, fmt.Errorf("include in %q doesn't specify the file", includer)
+	}
+
+	path := file
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(filepath.Dir(includer), file)
+	}
+
+	if err := e.checkRoot(path); err != nil {
+		return nil, err
+	}
+
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if slices.Contains(e.stack, absPath) {
+		return nil, fmt.Errorf("include cycle: %q includes %q", includer, file)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the file %q included in %q: %w", file, includer, err)
+	}
+
+	data, err = substituteParams(data, params)
+	if err != nil {
+		return nil, fmt.Errorf("error in %q: %w", path, err)
+	}
+
+	if !slices.Contains(e.included, path) {
+		e.included = append(e.included, path)
+	}
+
+	e.stack = append(e.stack, absPath)
+	defer func() { e.stack = e.stack[:len(e.stack)-1] }()
+
+	return expand(path, data)
+}
+
+func (e *expander) checkRoot(path string) error {
+	if e.root == "" {
+		return nil
+	}
+
+	realRoot, err := filepath.EvalSymlinks(e.root)
+	if err != nil {
+		return err
+	}
+
+	realPath, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return fmt.Errorf("failed to access %q: %w", path, err)
+	}
+
+	relPath, err := filepath.Rel(realRoot, realPath)
+	if err != nil || relPath == ".." || strings.HasPrefix(relPath, ".."+string(filepath.Separator)) {
+		return fmt.Errorf("included file %q is outside of %q", path, e.root)
+	}
+
+	return nil
+}
+
+// rewritePatchFile makes the config patch file referenced from the included file resolve the same way as the ones of the template.
+func (e *expander) rewritePatchFile(path string, patch *yaml.Node) error {
+	if len(e.stack) < 2 || patch.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	file := mappingValue(patch, "file")
+	if file == nil || file.Kind != yaml.ScalarNode || file.Value == "" || filepath.IsAbs(file.Value) {
+		return nil
+	}
+
+	patchPath := filepath.Join(filepath.Dir(path), file.Value)
+
+	if e.patchDir != "" {
+		relPath, err := filepath.Rel(e.patchDir, patchPath)
+		if err != nil {
+			return fmt.Errorf("failed to resolve the patch file %q included in %q: %w", file.Value, path, err)
+		}
+
+		patchPath = relPath
+	}
+
+	file.Value = filepath.ToSlash(patchPath)
+
+	return nil
+}
+
+// substituteParams replaces the ${name} references with the parameter values.
+func substituteParams(data []byte, params map[string]string) ([]byte, error) {
+	var err error
+
+	result := paramReference.ReplaceAllFunc(data, func(match []byte) []byte {
+		if bytes.HasPrefix(match, []byte("$$")) {
+			return match[1:]
+		}
+
+		name := string(match[2 : len(match)-1])
+
+		if !paramName.MatchString(name) {
+			err = errors.Join(err, fmt.Errorf("invalid parameter reference %q", match))
+
+			return match
+		}
+
+		value, ok := params[name]
+		if !ok {
+			err = errors.Join(err, fmt.Errorf("parameter %q is not defined", name))
+
+			return match
+		}
+
+		return []byte(value)
+	})
+
+	return result, err
+}
+
+func decodeStrict(node *yaml.Node, out any) error {
+	raw, err := yaml.Marshal(node)
+	if err != nil {
+		return err
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(raw))
+	dec.KnownFields(true)
+
+	return dec.Decode(out)
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+
+	return nil
+}
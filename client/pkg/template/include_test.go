@@ -0,0 +1,230 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package template_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/omni/client/pkg/template"
+)
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+}
+
+func decodeDocuments(t *testing.T, data []byte) []map[string]any {
+	t.Helper()
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+
+	var docs []map[string]any
+
+	for {
+		var doc map[string]any
+
+		err := dec.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			return docs
+		}
+
+		require.NoError(t, err)
+
+		docs = append(docs, doc)
+	}
+}
+
+func TestExpandFile(t *testing.T) {
+	dir := t.TempDir()
+
+	writeFiles(t, dir, map[string]string{
+		"cluster.yaml": `kind: Cluster
+name: test
+kubernetes:
+  version: v1.29.1
+talos:
+  version: v1.6.4
+patches:
+  - file: patches/cluster.yaml
+  - include: partials/common-patches.yaml
+    params:
+      region: eu
+---
+kind: ControlPlane
+machines:
+  - 430d882a-51a8-48b3-ae00-90c5b0b5b0b0
+---
+kind: Include
+file: partials/workers.yaml
+params:
+  name: gpu
+  machine: 4aed1106-6f44-4be9-9796-d4b5b0b5b0b0
+`,
+		"partials/workers.yaml": `kind: Workers
+name: ${name}
+machines:
+  - ${machine}
+patches:
+  - include: common-patches.yaml
+    params:
+      region: us
+  - name: ${name}-labels
+    inline:
+      machine:
+        nodeLabels:
+          pool: ${name}
+          escaped: $${name}
+`,
+		"partials/common-patches.yaml": `- file: patches/${region}.yaml
+- name: region-${region}
+  inline:
+    machine:
+      nodeLabels:
+        region: ${region}
+`,
+	})
+
+	data, included, err := template.ExpandFile(filepath.Join(dir, "cluster.yaml"), template.WithPatchDir(dir))
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{
+		filepath.Join(dir, "partials", "common-patches.yaml"),
+		filepath.Join(dir, "partials", "workers.yaml"),
+	}, included)
+
+	docs := decodeDocuments(t, data)
+	require.Len(t, docs, 3)
+
+	assert.Equal(t, []any{
+		map[string]any{"file": "patches/cluster.yaml"},
+		map[string]any{"file": "partials/patches/eu.yaml"},
+		map[string]any{
+			"name":   "region-eu",
+			"inline": map[string]any{"machine": map[string]any{"nodeLabels": map[string]any{"region": "eu"}}},
+		},
+	}, docs[0]["patches"])
+
+	assert.Equal(t, "ControlPlane", docs[1]["kind"])
+
+	assert.Equal(t, "Workers", docs[2]["kind"])
+	assert.Equal(t, "gpu", docs[2]["name"])
+	assert.Equal(t, []any{"4aed1106-6f44-4be9-9796-d4b5b0b5b0b0"}, docs[2]["machines"])
+	assert.Equal(t, []any{
+		map[string]any{"file": "partials/patches/us.yaml"},
+		map[string]any{
+			"name":   "region-us",
+			"inline": map[string]any{"machine": map[string]any{"nodeLabels": map[string]any{"region": "us"}}},
+		},
+		map[string]any{
+			"name":   "gpu-labels",
+			"inline": map[string]any{"machine": map[string]any{"nodeLabels": map[string]any{"pool": "gpu", "escaped": "${name}"}}},
+		},
+	}, docs[2]["patches"])
+
+	// the expanded template is a regular template
+	templ, err := template.Load(bytes.NewReader(data))
+	require.NoError(t, err)
+
+	clusterName, err := templ.ClusterName()
+	require.NoError(t, err)
+	assert.Equal(t, "test", clusterName)
+}
+
+func TestExpandFileErrors(t *testing.T) {
+	for _, tt := range []struct {
+		files         map[string]string
+		name          string
+		expectedError string
+		root          string
+	}{
+		{
+			name: "undefined parameter",
+			files: map[string]string{
+				"cluster.yaml": "kind: Include\nfile: workers.yaml\nparams:\n  name: gpu\n",
+				"workers.yaml": "kind: Workers\nname: ${name}\nmachines:\n  - ${machine}\n",
+			},
+			expectedError: `parameter "machine" is not defined`,
+		},
+		{
+			name: "cycle",
+			files: map[string]string{
+				"cluster.yaml": "kind: Include\nfile: a.yaml\n",
+				"a.yaml":       "kind: Include\nfile: b.yaml\n",
+				"b.yaml":       "kind: Include\nfile: a.yaml\n",
+			},
+			expectedError: "include cycle",
+		},
+		{
+			name: "patch cycle",
+			files: map[string]string{
+				"cluster.yaml": "kind: Cluster\nname: test\npatches:\n  - include: patches.yaml\n",
+				"patches.yaml": "- include: patches.yaml\n",
+			},
+			expectedError: "include cycle",
+		},
+		{
+			name: "unknown field",
+			files: map[string]string{
+				"cluster.yaml": "kind: Include\nfile: workers.yaml\nname: gpu\n",
+				"workers.yaml": "kind: Workers\n",
+			},
+			expectedError: "field name not found",
+		},
+		{
+			name: "not a list of patches",
+			files: map[string]string{
+				"cluster.yaml": "kind: Cluster\nname: test\npatches:\n  - include: patches.yaml\n",
+				"patches.yaml": "file: patch.yaml\n",
+			},
+			expectedError: "must contain a list of patches",
+		},
+		{
+			name: "outside of the root",
+			files: map[string]string{
+				"root/cluster.yaml": "kind: Include\nfile: ../workers.yaml\n",
+				"workers.yaml":      "kind: Workers\n",
+			},
+			root:          "root",
+			expectedError: "is outside of",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+
+			writeFiles(t, dir, tt.files)
+
+			var opts []template.ExpandOption
+
+			if tt.root != "" {
+				opts = append(opts, template.WithIncludeRoot(filepath.Join(dir, tt.root)))
+			}
+
+			_, _, err := template.ExpandFile(filepath.Join(dir, tt.root, "cluster.yaml"), opts...)
+			require.Error(t, err)
+			assert.True(t, strings.Contains(err.Error(), tt.expectedError), err.Error())
+		})
+	}
+}
+
+func TestLoadInclude(t *testing.T) {
+	_, err := template.Load(strings.NewReader("kind: Include\nfile: workers.yaml\n"))
+	require.ErrorContains(t, err, "includes are supported only when the template is loaded from a file")
+}
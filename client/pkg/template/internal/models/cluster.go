@@ -6,6 +6,7 @@ package models
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 	"unicode"
@@ -36,6 +37,12 @@ type Cluster struct { //nolint:govet
 	// Descriptors are the user descriptors to apply to the cluster.
 	Descriptors Descriptors `yaml:",inline"`
 
+	// Paused pauses the cluster reconciliation, so no config updates, upgrades and scaling changes will be performed on the cluster.
+	Paused bool `yaml:"paused,omitempty"`
+
+	// TTL is the time after the cluster creation when the cluster is destroyed automatically, e.g. for CI and demo clusters.
+	TTL time.Duration `yaml:"ttl,omitempty"`
+
 	// Kubernetes settings.
 	Kubernetes KubernetesCluster `yaml:"kubernetes"`
 
@@ -45,6 +52,9 @@ type Cluster struct { //nolint:govet
 	// Features settings.
 	Features Features `yaml:"features,omitempty"`
 
+	// KernelArgs defines the cluster-wide kernel arguments.
+	KernelArgs *KernelArgs `yaml:"kernelArgs,omitempty"`
+
 	// Cluster-wide patches.
 	Patches PatchList `yaml:"patches,omitempty"`
 }
@@ -95,11 +105,15 @@ func (cluster *Cluster) Validate() error {
 		}
 	}
 
+	if cluster.TTL < 0 {
+		multiErr = multierror.Append(multiErr, fmt.Errorf("ttl should not be negative"))
+	}
+
 	if err := cluster.Descriptors.Validate(); err != nil {
 		multiErr = multierror.Append(multiErr, err)
 	}
 
-	multiErr = joinErrors(multiErr, cluster.Kubernetes.Validate(), cluster.Talos.Validate(), cluster.Patches.Validate())
+	multiErr = joinErrors(multiErr, cluster.Kubernetes.Validate(), cluster.Talos.Validate(), cluster.KernelArgs.Validate(), cluster.Patches.Validate())
 
 	if multiErr != nil {
 		return fmt.Errorf("error validating cluster %q: %w", cluster.Name, multiErr)
@@ -156,6 +170,10 @@ func (cluster *Cluster) Translate(ctx TranslateContext) ([]resource.Resource, er
 
 	cluster.Descriptors.Apply(clusterResource)
 
+	if cluster.Paused {
+		clusterResource.Metadata().Annotations().Set(omni.ClusterPaused, "")
+	}
+
 	clusterResource.TypedSpec().Value.Features = &specs.ClusterSpec_Features{
 		EnableWorkloadProxy:         cluster.Features.EnableWorkloadProxy,
 		UseEmbeddedDiscoveryService: cluster.Features.UseEmbeddedDiscoveryService,
@@ -179,6 +197,10 @@ func (cluster *Cluster) Translate(ctx TranslateContext) ([]resource.Resource, er
 		clusterResource.TypedSpec().Value.BackupConfiguration = &specs.EtcdBackupConf{Interval: durationpb.New(interval), Enabled: true}
 	}
 
+	if cluster.TTL > 0 {
+		clusterResource.TypedSpec().Value.Ttl = durationpb.New(cluster.TTL)
+	}
+
 	resourceList := append([]resource.Resource{clusterResource}, patches...)
 
 	schematicConfigurations := cluster.SystemExtensions.translate(
@@ -186,7 +208,12 @@ func (cluster *Cluster) Translate(ctx TranslateContext) ([]resource.Resource, er
 		cluster.Name,
 	)
 
-	return append(resourceList, schematicConfigurations...), nil
+	kernelArgsConfigurations := cluster.KernelArgs.translate(
+		ctx,
+		cluster.Name,
+	)
+
+	return slices.Concat(resourceList, schematicConfigurations, kernelArgsConfigurations), nil
 }
 
 func init() {
@@ -124,6 +124,7 @@ func (l List) Validate() error {
 func (l List) Translate() ([]resource.Resource, error) {
 	context := TranslateContext{
 		LockedMachines:     make(map[MachineID]struct{}),
+		HeldMachines:       make(map[MachineID]struct{}),
 		MachineDescriptors: make(map[MachineID]Descriptors),
 	}
 
@@ -137,6 +138,10 @@ func (l List) Translate() ([]resource.Resource, error) {
 			if m.Locked {
 				context.LockedMachines[m.Name] = struct{}{}
 			}
+
+			if m.UpgradeHold {
+				context.HeldMachines[m.Name] = struct{}{}
+			}
 		}
 	}
 
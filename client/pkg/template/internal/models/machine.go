@@ -39,6 +39,9 @@ type Machine struct { //nolint:govet
 	// Locked locks the machine, so no config updates, upgrades and downgrades will be performed on the machine.
 	Locked bool `yaml:"locked,omitempty"`
 
+	// UpgradeHold holds the machine on its current Talos and Kubernetes versions, the cluster upgrades skip the machine.
+	UpgradeHold bool `yaml:"upgradeHold,omitempty"`
+
 	// Install specification.
 	Install MachineInstall `yaml:"install,omitempty"`
 
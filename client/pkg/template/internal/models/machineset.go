@@ -6,11 +6,16 @@ package models
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/dustin/go-humanize"
 	"github.com/hashicorp/go-multierror"
 	"github.com/siderolabs/gen/pair"
+	"github.com/siderolabs/gen/xslices"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/constants"
@@ -42,6 +47,18 @@ type MachineSet struct {
 	// DeleteStrategy defines the delete strategy for the machine set.
 	DeleteStrategy *UpdateStrategyConfig `yaml:"deleteStrategy,omitempty"`
 
+	// InstallDiskPolicy defines the install disk selection policy for the machine set.
+	InstallDiskPolicy *InstallDiskPolicy `yaml:"installDiskPolicy,omitempty"`
+
+	// UserVolumes defines the extra volumes created on the user disks of the machines.
+	UserVolumes []UserVolume `yaml:"userVolumes,omitempty"`
+
+	// KernelArgs defines the kernel arguments of the machine set, they are applied on top of the cluster kernel arguments.
+	KernelArgs *KernelArgs `yaml:"kernelArgs,omitempty"`
+
+	// ReadinessGates defines the checks the machines must pass before their nodes become schedulable.
+	ReadinessGates *ReadinessGates `yaml:"readinessGates,omitempty"`
+
 	// MachineSet patches.
 	Patches PatchList `yaml:"patches,omitempty"`
 }
@@ -63,6 +80,29 @@ type MachineClassConfig struct {
 
 	// Size sets the number of machines to be pulled from the machine class.
 	Size Size `yaml:"size"`
+
+	// Autoscaling lets the Kubernetes cluster-autoscaler change the size within the given bounds.
+	Autoscaling *Autoscaling `yaml:"autoscaling,omitempty"`
+}
+
+// Autoscaling defines the bounds of the machine class size set by the cluster-autoscaler.
+type Autoscaling struct {
+	// MinSize is the minimum number of machines the autoscaler can scale the machine set down to.
+	MinSize uint32 `yaml:"minSize"`
+
+	// MaxSize is the maximum number of machines the autoscaler can scale the machine set up to.
+	MaxSize uint32 `yaml:"maxSize"`
+}
+
+func (autoscaling *Autoscaling) translate() *specs.MachineSetSpec_Autoscaling {
+	if autoscaling == nil {
+		return nil
+	}
+
+	return &specs.MachineSetSpec_Autoscaling{
+		MinSize: autoscaling.MinSize,
+		MaxSize: autoscaling.MaxSize,
+	}
 }
 
 // Size extends protobuf generated allocation type enum to parse string constants.
@@ -141,6 +181,25 @@ func (t UpdateStrategyType) MarshalYAML() (any, error) {
 // RollingUpdateStrategyConfig defines the model for setting the rolling update strategy in the machine set.
 type RollingUpdateStrategyConfig struct {
 	MaxParallelism uint32 `yaml:"maxParallelism,omitempty"`
+
+	// MaxUnavailable is the maximum number of machines which can be unhealthy or being updated at the same time.
+	MaxUnavailable uint32 `yaml:"maxUnavailable,omitempty"`
+
+	// WaitForHealthyTimeout is how long an updated machine is waited for to become healthy before the next machines are updated.
+	WaitForHealthyTimeout time.Duration `yaml:"waitForHealthyTimeout,omitempty"`
+}
+
+func (config *RollingUpdateStrategyConfig) translate() *specs.MachineSetSpec_RollingUpdateStrategyConfig {
+	spec := &specs.MachineSetSpec_RollingUpdateStrategyConfig{
+		MaxParallelism: config.MaxParallelism,
+		MaxUnavailable: config.MaxUnavailable,
+	}
+
+	if config.WaitForHealthyTimeout > 0 {
+		spec.WaitForHealthyTimeout = durationpb.New(config.WaitForHealthyTimeout)
+	}
+
+	return spec
 }
 
 // UpdateStrategyConfig defines the model for setting the update strategy in the machine set.
@@ -149,6 +208,144 @@ type UpdateStrategyConfig struct {
 	Rolling *RollingUpdateStrategyConfig `yaml:"rolling,omitempty"`
 }
 
+// InstallDiskPolicy defines the model for setting the install disk selection policy in the machine set.
+type InstallDiskPolicy struct {
+	// MinSize is the minimum disk size, e.g. 100GB.
+	MinSize ByteSize `yaml:"minSize,omitempty"`
+
+	// MaxSize is the maximum disk size, e.g. 2TB.
+	MaxSize ByteSize `yaml:"maxSize,omitempty"`
+
+	// Prefer defines which disk to pick if several disks match the policy: Smallest or Largest.
+	Prefer *InstallDiskPreference `yaml:"prefer,omitempty"`
+
+	// BusPath is the regular expression to match the disk bus path.
+	BusPath string `yaml:"busPath,omitempty"`
+
+	// Model is the regular expression to match the disk model.
+	Model string `yaml:"model,omitempty"`
+
+	// Types are the allowed disk types: nvme, ssd, hdd, sd.
+	Types []string `yaml:"types,omitempty"`
+}
+
+// UserVolume defines the model for setting the user volume in the machine set.
+type UserVolume struct {
+	// Disk is the device path of the disk to create the volume on.
+	Disk string `yaml:"disk"`
+
+	// MountPoint is the absolute path where the volume is mounted.
+	MountPoint string `yaml:"mountPoint"`
+
+	// Size is the volume size, e.g. 100GB. The volume takes the remaining space of the disk if unset.
+	Size ByteSize `yaml:"size,omitempty"`
+}
+
+func (volume UserVolume) translate() *specs.MachineSetSpec_UserVolume {
+	return &specs.MachineSetSpec_UserVolume{
+		Disk:       volume.Disk,
+		MountPoint: volume.MountPoint,
+		Size:       uint64(volume.Size),
+	}
+}
+
+// ReadinessGates defines the model for setting the readiness gates in the machine set.
+type ReadinessGates struct {
+	// Extensions are the system extensions which must be installed on the machine.
+	Extensions []string `yaml:"extensions,omitempty"`
+
+	// DiskEncryption requires the machine to run the config with the cluster disk encryption enabled.
+	DiskEncryption bool `yaml:"diskEncryption,omitempty"`
+
+	// Probes are the label selectors the labels of the machine must match.
+	Probes []string `yaml:"probes,omitempty"`
+}
+
+func (gates *ReadinessGates) translate() *specs.MachineSetSpec_ReadinessGates {
+	if gates == nil {
+		return nil
+	}
+
+	return &specs.MachineSetSpec_ReadinessGates{
+		Extensions:     gates.Extensions,
+		DiskEncryption: gates.DiskEncryption,
+		Probes:         gates.Probes,
+	}
+}
+
+// ByteSize is the size in bytes, which is parsed from the human-readable format.
+type ByteSize uint64
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (s *ByteSize) UnmarshalYAML(unmarshal func(any) error) error {
+	var value string
+
+	if err := unmarshal(&value); err != nil {
+		return err
+	}
+
+	size, err := humanize.ParseBytes(value)
+	if err != nil {
+		return fmt.Errorf("invalid size %s: %w", value, err)
+	}
+
+	*s = ByteSize(size)
+
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (s ByteSize) MarshalYAML() (any, error) {
+	return humanize.Bytes(uint64(s)), nil
+}
+
+// InstallDiskPreference extends protobuf generated install disk preference enum to parse string constants.
+type InstallDiskPreference uint32
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (p *InstallDiskPreference) UnmarshalYAML(unmarshal func(any) error) error {
+	var value string
+
+	if err := unmarshal(&value); err != nil {
+		return err
+	}
+
+	v, ok := specs.MachineSetSpec_InstallDiskPolicy_Prefer_value[value]
+
+	if !ok {
+		return fmt.Errorf("invalid install disk preference %s", value)
+	}
+
+	*p = InstallDiskPreference(v)
+
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (p InstallDiskPreference) MarshalYAML() (any, error) {
+	return specs.MachineSetSpec_InstallDiskPolicy_Prefer_name[int32(p)], nil
+}
+
+func (policy *InstallDiskPolicy) translate() *specs.MachineSetSpec_InstallDiskPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	spec := &specs.MachineSetSpec_InstallDiskPolicy{
+		MinSize:      uint64(policy.MinSize),
+		MaxSize:      uint64(policy.MaxSize),
+		Types:        policy.Types,
+		BusPathRegex: policy.BusPath,
+		ModelRegex:   policy.Model,
+	}
+
+	if policy.Prefer != nil {
+		spec.Prefer = specs.MachineSetSpec_InstallDiskPolicy_Prefer(*policy.Prefer)
+	}
+
+	return spec
+}
+
 // Validate checks the machine set fields correctness.
 func (machineset *MachineSet) Validate() error {
 	var multiErr error
@@ -161,6 +358,45 @@ func (machineset *MachineSet) Validate() error {
 		multiErr = multierror.Append(multiErr, fmt.Errorf("machine set can not have both machines and machine class defined"))
 	}
 
+	if err := omni.ValidateInstallDiskPolicy(machineset.InstallDiskPolicy.translate()); err != nil {
+		multiErr = multierror.Append(multiErr, err)
+	}
+
+	if err := omni.ValidateUserVolumes(xslices.Map(machineset.UserVolumes, UserVolume.translate)); err != nil {
+		multiErr = multierror.Append(multiErr, err)
+	}
+
+	if err := machineset.KernelArgs.Validate(); err != nil {
+		multiErr = multierror.Append(multiErr, err)
+	}
+
+	if err := omni.ValidateReadinessGates(machineset.ReadinessGates.translate()); err != nil {
+		multiErr = multierror.Append(multiErr, err)
+	}
+
+	if machineset.MachineClass != nil && machineset.MachineClass.Autoscaling != nil {
+		if machineset.Kind == KindControlPlane {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("autoscaling is not allowed in the controlplane"))
+		}
+
+		if err := omni.ValidateAutoscaling(&specs.MachineSetSpec{
+			MachineClass: &specs.MachineSetSpec_MachineClass{AllocationType: machineset.MachineClass.Size.AllocationType},
+			Autoscaling:  machineset.MachineClass.Autoscaling.translate(),
+		}); err != nil {
+			multiErr = multierror.Append(multiErr, err)
+		}
+	}
+
+	if machineset.UpdateStrategy != nil && machineset.UpdateStrategy.Rolling != nil && machineset.UpdateStrategy.Rolling.WaitForHealthyTimeout < 0 {
+		multiErr = multierror.Append(multiErr, fmt.Errorf("updateStrategy waitForHealthyTimeout can not be negative"))
+	}
+
+	if machineset.DeleteStrategy != nil && machineset.DeleteStrategy.Rolling != nil {
+		if machineset.DeleteStrategy.Rolling.MaxUnavailable != 0 || machineset.DeleteStrategy.Rolling.WaitForHealthyTimeout != 0 {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("deleteStrategy supports only maxParallelism"))
+		}
+	}
+
 	return multiErr
 }
 
@@ -183,9 +419,7 @@ func (machineset *MachineSet) translate(ctx TranslateContext, nameSuffix, roleLa
 
 		if machineset.UpdateStrategy.Rolling != nil {
 			machineSet.TypedSpec().Value.UpdateStrategyConfig = &specs.MachineSetSpec_UpdateStrategyConfig{
-				Rolling: &specs.MachineSetSpec_RollingUpdateStrategyConfig{
-					MaxParallelism: machineset.UpdateStrategy.Rolling.MaxParallelism,
-				},
+				Rolling: machineset.UpdateStrategy.Rolling.translate(),
 			}
 		}
 	}
@@ -204,6 +438,10 @@ func (machineset *MachineSet) translate(ctx TranslateContext, nameSuffix, roleLa
 		}
 	}
 
+	machineSet.TypedSpec().Value.InstallDiskPolicy = machineset.InstallDiskPolicy.translate()
+	machineSet.TypedSpec().Value.UserVolumes = xslices.Map(machineset.UserVolumes, UserVolume.translate)
+	machineSet.TypedSpec().Value.ReadinessGates = machineset.ReadinessGates.translate()
+
 	resourceList := []resource.Resource{machineSet}
 
 	if machineset.BootstrapSpec != nil {
@@ -219,6 +457,7 @@ func (machineset *MachineSet) translate(ctx TranslateContext, nameSuffix, roleLa
 			MachineCount:   machineset.MachineClass.Size.Value,
 			AllocationType: machineset.MachineClass.Size.AllocationType,
 		}
+		machineSet.TypedSpec().Value.Autoscaling = machineset.MachineClass.Autoscaling.translate()
 	} else {
 		for _, machineID := range machineset.Machines {
 			machineSetNode := omni.NewMachineSetNode(resources.DefaultNamespace, string(machineID), machineSet)
@@ -231,6 +470,11 @@ func (machineset *MachineSet) translate(ctx TranslateContext, nameSuffix, roleLa
 				machineSetNode.Metadata().Annotations().Set(omni.MachineLocked, "")
 			}
 
+			_, held := ctx.HeldMachines[machineID]
+			if held {
+				machineSetNode.Metadata().Annotations().Set(omni.MachineUpgradeHold, "")
+			}
+
 			resourceList = append(resourceList, machineSetNode)
 		}
 	}
@@ -253,5 +497,11 @@ func (machineset *MachineSet) translate(ctx TranslateContext, nameSuffix, roleLa
 		pair.MakePair(omni.LabelMachineSet, id),
 	)
 
-	return append(resourceList, schematicConfigurations...), nil
+	kernelArgsConfigurations := machineset.KernelArgs.translate(
+		ctx,
+		id,
+		pair.MakePair(omni.LabelMachineSet, id),
+	)
+
+	return slices.Concat(resourceList, schematicConfigurations, kernelArgsConfigurations), nil
 }
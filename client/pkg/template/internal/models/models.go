@@ -27,6 +27,8 @@ type Meta struct {
 type TranslateContext struct {
 	LockedMachines map[MachineID]struct{}
 
+	HeldMachines map[MachineID]struct{}
+
 	MachineDescriptors map[MachineID]Descriptors
 
 	// ClusterName is the name of the cluster.
@@ -60,6 +62,51 @@ func (s *SystemExtensions) translate(ctx TranslateContext, nameSuffix string, la
 	}
 }
 
+// KernelArgs defines the kernel arguments of the Cluster and MachineSet objects.
+type KernelArgs struct {
+	// Add is the list of the kernel arguments to add.
+	Add []string `yaml:"add,omitempty"`
+
+	// Remove is the list of the kernel argument names to remove.
+	Remove []string `yaml:"remove,omitempty"`
+}
+
+// Validate the model.
+func (k *KernelArgs) Validate() error {
+	if k == nil {
+		return nil
+	}
+
+	if err := omni.ValidateKernelArgs(k.Add, k.Remove); err != nil {
+		return fmt.Errorf("error validating kernel args: %w", err)
+	}
+
+	return nil
+}
+
+func (k *KernelArgs) translate(ctx TranslateContext, nameSuffix string, labels ...pair.Pair[string, string]) []resource.Resource {
+	if k == nil || (len(k.Add) == 0 && len(k.Remove) == 0) {
+		return nil
+	}
+
+	configuration := omni.NewKernelArgsConfiguration(resources.DefaultNamespace, fmt.Sprintf("kernel-args-%s", nameSuffix))
+
+	configuration.Metadata().Labels().Set(omni.LabelCluster, ctx.ClusterName)
+
+	configuration.Metadata().Labels().Do(func(temp kvutils.TempKV) {
+		for _, l := range labels {
+			temp.Set(l.F1, l.F2)
+		}
+	})
+
+	configuration.TypedSpec().Value.Add = k.Add
+	configuration.TypedSpec().Value.Remove = k.Remove
+
+	return []resource.Resource{
+		configuration,
+	}
+}
+
 // Descriptors are the user descriptors (i.e. Labels, Annotations) to apply to the resource.
 type Descriptors struct {
 	// Labels are the user labels to apply to the resource.
@@ -5,6 +5,7 @@
 package models
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 
@@ -35,11 +36,16 @@ type Patch struct { //nolint:govet
 
 	// File path to the file containing the patch.
 	//
-	// Mutually exclusive with `inline:`.
+	// Mutually exclusive with `inline:` and `inlineDocuments:`.
 	File string `yaml:"file,omitempty"`
 
 	// Inline patch content.
 	Inline map[string]any `yaml:"inline,omitempty"`
+
+	// InlineDocuments is the inline patch content which consists of multiple machine config documents.
+	//
+	// Mutually exclusive with `inline:`.
+	InlineDocuments []map[string]any `yaml:"inlineDocuments,omitempty"`
 }
 
 // Validate the model.
@@ -82,11 +88,15 @@ func (patch *Patch) Validate() error {
 		multiErr = multierror.Append(multiErr, err)
 	}
 
-	if patch.File != "" && patch.Inline != nil {
+	if patch.File != "" && patch.isInline() {
 		multiErr = multierror.Append(multiErr, fmt.Errorf("path and inline are mutually exclusive"))
 	}
 
-	if patch.File == "" && patch.Inline == nil {
+	if patch.Inline != nil && patch.InlineDocuments != nil {
+		multiErr = multierror.Append(multiErr, fmt.Errorf("inline and inlineDocuments are mutually exclusive"))
+	}
+
+	if patch.File == "" && !patch.isInline() {
 		multiErr = multierror.Append(multiErr, fmt.Errorf("path or inline is required"))
 	}
 
@@ -100,12 +110,12 @@ func (patch *Patch) Validate() error {
 				multiErr = multierror.Append(multiErr, fmt.Errorf("failed to validate patch %q: %w", patch.File, err))
 			}
 		}
-	case patch.Inline != nil:
+	case patch.isInline():
 		if patch.Name == "" && patch.IDOverride == "" {
 			multiErr = multierror.Append(multiErr, fmt.Errorf("either name or idOverride is required for inline patches"))
 		}
 
-		raw, err := yaml.Marshal(patch.Inline)
+		raw, err := patch.marshalInline()
 		if err != nil {
 			multiErr = multierror.Append(multiErr, fmt.Errorf("failed to marshal inline patch %q: %w", name, err))
 		} else {
@@ -142,8 +152,8 @@ func (patch *Patch) Translate(prefix string, weight int, labels ...pair.Pair[str
 	switch {
 	case patch.File != "":
 		raw, err = os.ReadFile(patch.File)
-	case patch.Inline != nil:
-		raw, err = yaml.Marshal(patch.Inline)
+	case patch.isInline():
+		raw, err = patch.marshalInline()
 	default:
 		panic("missing patch contents?")
 	}
@@ -161,3 +171,30 @@ func (patch *Patch) Translate(prefix string, weight int, labels ...pair.Pair[str
 
 	return patchResource, nil
 }
+
+func (patch *Patch) isInline() bool {
+	return patch.Inline != nil || patch.InlineDocuments != nil
+}
+
+// marshalInline encodes the inline patch content, each of the inline documents is encoded as a separate YAML document.
+func (patch *Patch) marshalInline() ([]byte, error) {
+	if patch.Inline != nil {
+		return yaml.Marshal(patch.Inline)
+	}
+
+	var buf bytes.Buffer
+
+	encoder := yaml.NewEncoder(&buf)
+
+	for _, document := range patch.InlineDocuments {
+		if err := encoder.Encode(document); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -5,12 +5,20 @@
 package operations
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"io"
+	"reflect"
+	"slices"
+	"strings"
 
+	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/maps"
+	"gopkg.in/yaml.v3"
 
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/client/pkg/template"
 	"github.com/siderolabs/omni/client/pkg/template/operations/internal/utils"
 )
@@ -53,3 +61,225 @@ func DiffTemplate(ctx context.Context, templateReader io.Reader, output io.Write
 
 	return nil
 }
+
+// Change actions.
+const (
+	ChangeActionCreate  = "create"
+	ChangeActionUpdate  = "update"
+	ChangeActionDestroy = "destroy"
+)
+
+// ResourceChange describes the change of a single resource which would be made by the template sync.
+type ResourceChange struct {
+	// Action is one of create, update or destroy.
+	Action string `json:"action"`
+	// Kind is the human-readable kind of the resource: cluster, machine set, machine, patch, etc.
+	Kind string `json:"kind"`
+	Type string `json:"type"`
+	ID   string `json:"id"`
+	// Fields are the changed fields of the updated resource, e.g. spec.kubernetesversion or metadata.labels.
+	Fields []string `json:"fields,omitempty"`
+}
+
+// TemplateChanges returns the structured list of the changes which would be made by syncing the template.
+func TemplateChanges(ctx context.Context, templateReader io.Reader, st state.State) ([]ResourceChange, error) {
+	tmpl, err := template.Load(templateReader)
+	if err != nil {
+		return nil, fmt.Errorf("error loading template: %w", err)
+	}
+
+	if err = tmpl.Validate(); err != nil {
+		return nil, err
+	}
+
+	syncResult, err := tmpl.Sync(ctx, st)
+	if err != nil {
+		return nil, fmt.Errorf("error syncing template: %w", err)
+	}
+
+	var changes []ResourceChange
+
+	for _, p := range syncResult.Update {
+		fields, err := changedFields(p.Old, p.New)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, newResourceChange(ChangeActionUpdate, p.New, fields))
+	}
+
+	for _, r := range syncResult.Create {
+		changes = append(changes, newResourceChange(ChangeActionCreate, r, nil))
+	}
+
+	for _, phase := range syncResult.Destroy {
+		for _, r := range phase {
+			changes = append(changes, newResourceChange(ChangeActionDestroy, r, nil))
+		}
+	}
+
+	return changes, nil
+}
+
+// RenderChangesSummary outputs the changes grouped by the resource kind.
+func RenderChangesSummary(output io.Writer, changes []ResourceChange) error {
+	if len(changes) == 0 {
+		_, err := fmt.Fprintln(output, "No changes.")
+
+		return err
+	}
+
+	kinds := map[string][]ResourceChange{}
+
+	for _, change := range changes {
+		kinds[change.Kind] = append(kinds[change.Kind], change)
+	}
+
+	kindNames := maps.Keys(kinds)
+	slices.SortFunc(kindNames, func(a, b string) int {
+		return cmp.Or(cmp.Compare(changeKindOrder(a), changeKindOrder(b)), cmp.Compare(a, b))
+	})
+
+	for _, kind := range kindNames {
+		if _, err := fmt.Fprintf(output, "%s:\n", kind); err != nil {
+			return err
+		}
+
+		for _, change := range kinds[kind] {
+			line := fmt.Sprintf("  %s %s", changeActionSymbol(change.Action), change.ID)
+
+			if len(change.Fields) > 0 {
+				line += " (" + strings.Join(change.Fields, ", ") + ")"
+			}
+
+			if _, err := fmt.Fprintln(output, line); err != nil {
+				return err
+			}
+		}
+	}
+
+	counts := map[string]int{}
+
+	for _, change := range changes {
+		counts[change.Action]++
+	}
+
+	_, err := fmt.Fprintf(output, "\n%d to create, %d to update, %d to destroy.\n",
+		counts[ChangeActionCreate], counts[ChangeActionUpdate], counts[ChangeActionDestroy])
+
+	return err
+}
+
+func newResourceChange(action string, r resource.Resource, fields []string) ResourceChange {
+	return ResourceChange{
+		Action: action,
+		Kind:   changeKind(r.Metadata().Type()),
+		Type:   r.Metadata().Type(),
+		ID:     r.Metadata().ID(),
+		Fields: fields,
+	}
+}
+
+var changeKinds = []struct {
+	resourceType resource.Type
+	kind         string
+}{
+	{omni.ClusterType, "cluster"},
+	{omni.MachineSetType, "machine set"},
+	{omni.MachineSetNodeType, "machine"},
+	{omni.ConfigPatchType, "patch"},
+	{omni.ExtensionsConfigurationType, "extensions"},
+	{omni.KernelArgsConfigurationType, "kernel args"},
+}
+
+func changeKind(resourceType resource.Type) string {
+	for _, changeKind := range changeKinds {
+		if changeKind.resourceType == resourceType {
+			return changeKind.kind
+		}
+	}
+
+	return resourceType
+}
+
+func changeKindOrder(kind string) int {
+	for i, changeKind := range changeKinds {
+		if changeKind.kind == kind {
+			return i
+		}
+	}
+
+	return len(changeKinds)
+}
+
+func changeActionSymbol(action string) string {
+	switch action {
+	case ChangeActionCreate:
+		return "+"
+	case ChangeActionDestroy:
+		return "-"
+	default:
+		return "~"
+	}
+}
+
+// changedFields compares the labels, the annotations and the top-level spec fields of the resources.
+func changedFields(oldR, newR resource.Resource) ([]string, error) {
+	oldFields, err := resourceFields(oldR)
+	if err != nil {
+		return nil, err
+	}
+
+	newFields, err := resourceFields(newR)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []string
+
+	for key, value := range oldFields {
+		if !reflect.DeepEqual(value, newFields[key]) {
+			fields = append(fields, key)
+		}
+	}
+
+	for key := range newFields {
+		if _, ok := oldFields[key]; !ok {
+			fields = append(fields, key)
+		}
+	}
+
+	slices.Sort(fields)
+
+	return fields, nil
+}
+
+func resourceFields(r resource.Resource) (map[string]any, error) {
+	data, err := utils.MarshalResource(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw struct {
+		Spec     map[string]any `yaml:"spec"`
+		Metadata struct {
+			Labels      map[string]string `yaml:"labels"`
+			Annotations map[string]string `yaml:"annotations"`
+		} `yaml:"metadata"`
+	}
+
+	if err = yaml.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	fields := map[string]any{
+		"metadata.labels":      raw.Metadata.Labels,
+		"metadata.annotations": raw.Metadata.Annotations,
+	}
+
+	for key, value := range raw.Spec {
+		fields["spec."+key] = value
+	}
+
+	return fields, nil
+}
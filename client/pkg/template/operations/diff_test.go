@@ -0,0 +1,53 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package operations_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/template/operations"
+)
+
+func TestTemplateChanges(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	st := buildState(ctx, t)
+
+	// the config patches created via the UI differ from the template ones in the data formatting, so sync the template first
+	require.NoError(t, operations.SyncTemplate(ctx, strings.NewReader(clusterTemplate), io.Discard, st, operations.SyncOptions{}))
+
+	changes, err := operations.TemplateChanges(ctx, strings.NewReader(clusterTemplate), st)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+
+	changedTemplate := strings.Replace(clusterTemplate, "version: v1.28.2", "version: v1.28.3", 1)
+
+	changes, err = operations.TemplateChanges(ctx, strings.NewReader(changedTemplate), st)
+	require.NoError(t, err)
+
+	require.Len(t, changes, 1)
+	assert.Equal(t, operations.ResourceChange{
+		Action: operations.ChangeActionUpdate,
+		Kind:   "cluster",
+		Type:   omni.ClusterType,
+		ID:     "export-test",
+		Fields: []string{"spec.kubernetesversion"},
+	}, changes[0])
+
+	var sb strings.Builder
+
+	require.NoError(t, operations.RenderChangesSummary(&sb, changes))
+
+	assert.Equal(t, "cluster:\n  ~ export-test (spec.kubernetesversion)\n\n0 to create, 1 to update, 0 to destroy.\n", sb.String())
+}
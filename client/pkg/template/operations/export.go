@@ -23,6 +23,8 @@ import (
 
 	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/constants"
+	"github.com/siderolabs/omni/client/pkg/cosi/snapshot"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/client/pkg/template/internal/models"
 )
@@ -30,6 +32,7 @@ import (
 type clusterResources struct {
 	patches    *layeredResources[*omni.ConfigPatch]
 	extensions *layeredResources[*omni.ExtensionsConfiguration]
+	kernelArgs *layeredResources[*omni.KernelArgsConfiguration]
 
 	machineSetNodes            map[string][]*omni.MachineSetNode
 	clusterMachineInstallDisks map[string]string
@@ -52,6 +55,7 @@ func ExportTemplate(ctx context.Context, st state.State, clusterID string, write
 	}
 
 	clusterModel.SystemExtensions = transformExtensions(resources.extensions.cluster)
+	clusterModel.KernelArgs = transformKernelArgs(resources.kernelArgs.cluster)
 
 	var controlPlaneMachineSetModel models.ControlPlane
 
@@ -66,6 +70,7 @@ func ExportTemplate(ctx context.Context, st state.State, clusterID string, write
 		}
 
 		machineSetModel.SystemExtensions = transformExtensions(resources.extensions.machineSet[machineSet.Metadata().ID()])
+		machineSetModel.KernelArgs = transformKernelArgs(resources.kernelArgs.machineSet[machineSet.Metadata().ID()])
 
 		if _, isControlPlane := machineSet.Metadata().Labels().Get(omni.LabelControlPlaneRole); isControlPlane {
 			controlPlaneMachineSetModel = models.ControlPlane{MachineSet: machineSetModel}
@@ -148,17 +153,38 @@ func writeYAML(writer io.Writer, modelList models.List) (err error) {
 }
 
 func transformConfigPatchToModel(configPatch *omni.ConfigPatch) (models.Patch, error) {
-	var data map[string]any
+	decoder := yaml.NewDecoder(strings.NewReader(configPatch.TypedSpec().Value.GetData()))
 
-	if err := yaml.Unmarshal([]byte(configPatch.TypedSpec().Value.GetData()), &data); err != nil {
-		return models.Patch{}, fmt.Errorf("failed to unmarshal patch %q: %w", configPatch.Metadata().ID(), err)
+	var documents []map[string]any
+
+	for {
+		var data map[string]any
+
+		if err := decoder.Decode(&data); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return models.Patch{}, fmt.Errorf("failed to unmarshal patch %q: %w", configPatch.Metadata().ID(), err)
+		}
+
+		documents = append(documents, data)
 	}
 
-	return models.Patch{
+	patch := models.Patch{
 		Descriptors: getUserDescriptors(configPatch),
 		IDOverride:  configPatch.Metadata().ID(),
-		Inline:      data,
-	}, nil
+	}
+
+	switch len(documents) {
+	case 0:
+	case 1:
+		patch.Inline = documents[0]
+	default:
+		patch.InlineDocuments = documents
+	}
+
+	return patch, nil
 }
 
 func transformConfigPatchesToModels(configPatches []*omni.ConfigPatch) (models.PatchList, error) {
@@ -182,6 +208,7 @@ func transformConfigPatchesToModels(configPatches []*omni.ConfigPatch) (models.P
 
 func transformMachineSetNodeToModel(machineSetNode *omni.MachineSetNode, patches []*omni.ConfigPatch, installDisk string) (models.Machine, error) {
 	_, locked := machineSetNode.Metadata().Annotations().Get(omni.MachineLocked)
+	_, held := machineSetNode.Metadata().Annotations().Get(omni.MachineUpgradeHold)
 
 	patchModels, err := transformConfigPatchesToModels(patches)
 	if err != nil {
@@ -195,6 +222,7 @@ func transformMachineSetNodeToModel(machineSetNode *omni.MachineSetNode, patches
 		Name:        models.MachineID(machineSetNode.Metadata().ID()),
 		Descriptors: getUserDescriptors(machineSetNode),
 		Locked:      locked,
+		UpgradeHold: held,
 		Install: models.MachineInstall{
 			Disk: installDisk,
 		},
@@ -235,6 +263,13 @@ func transformMachineSetToModel(machineSet *omni.MachineSet, nodes []*omni.Machi
 				AllocationType: spec.GetMachineClass().GetAllocationType(),
 			},
 		}
+
+		if autoscaling := spec.GetAutoscaling(); autoscaling != nil {
+			machineClassConfig.Autoscaling = &models.Autoscaling{
+				MinSize: autoscaling.GetMinSize(),
+				MaxSize: autoscaling.GetMaxSize(),
+			}
+		}
 	} else {
 		machineIDs = xslices.Map(nodes, func(node *omni.MachineSetNode) models.MachineID {
 			return models.MachineID(node.Metadata().ID())
@@ -254,7 +289,9 @@ func transformMachineSetToModel(machineSet *omni.MachineSet, nodes []*omni.Machi
 
 		if spec.GetUpdateStrategyConfig().GetRolling() != nil {
 			updateStrategyConfig.Rolling = &models.RollingUpdateStrategyConfig{
-				MaxParallelism: spec.GetUpdateStrategyConfig().GetRolling().GetMaxParallelism(),
+				MaxParallelism:        spec.GetUpdateStrategyConfig().GetRolling().GetMaxParallelism(),
+				MaxUnavailable:        spec.GetUpdateStrategyConfig().GetRolling().GetMaxUnavailable(),
+				WaitForHealthyTimeout: spec.GetUpdateStrategyConfig().GetRolling().GetWaitForHealthyTimeout().AsDuration(),
 			}
 		}
 	}
@@ -275,6 +312,40 @@ func transformMachineSetToModel(machineSet *omni.MachineSet, nodes []*omni.Machi
 		}
 	}
 
+	var installDiskPolicy *models.InstallDiskPolicy
+
+	if policy := spec.GetInstallDiskPolicy(); policy != nil {
+		installDiskPolicy = &models.InstallDiskPolicy{
+			MinSize: models.ByteSize(policy.GetMinSize()),
+			MaxSize: models.ByteSize(policy.GetMaxSize()),
+			Types:   policy.GetTypes(),
+			BusPath: policy.GetBusPathRegex(),
+			Model:   policy.GetModelRegex(),
+		}
+
+		if policy.GetPrefer() != specs.MachineSetSpec_InstallDiskPolicy_Smallest { // Smallest is the default, so set the preference only when it is not Smallest.
+			installDiskPolicy.Prefer = pointer.To(models.InstallDiskPreference(policy.GetPrefer()))
+		}
+	}
+
+	userVolumes := xslices.Map(spec.GetUserVolumes(), func(volume *specs.MachineSetSpec_UserVolume) models.UserVolume {
+		return models.UserVolume{
+			Disk:       volume.GetDisk(),
+			MountPoint: volume.GetMountPoint(),
+			Size:       models.ByteSize(volume.GetSize()),
+		}
+	})
+
+	var readinessGates *models.ReadinessGates
+
+	if gates := spec.GetReadinessGates(); gates != nil {
+		readinessGates = &models.ReadinessGates{
+			Extensions:     gates.GetExtensions(),
+			DiskEncryption: gates.GetDiskEncryption(),
+			Probes:         gates.GetProbes(),
+		}
+	}
+
 	kind := models.KindControlPlane
 	if isWorker {
 		kind = models.KindWorkers
@@ -294,14 +365,17 @@ func transformMachineSetToModel(machineSet *omni.MachineSet, nodes []*omni.Machi
 		Meta: models.Meta{
 			Kind: kind,
 		},
-		Name:           name,
-		Descriptors:    getUserDescriptors(machineSet),
-		BootstrapSpec:  bootstrapSpec,
-		Machines:       machineIDs,
-		MachineClass:   machineClassConfig,
-		Patches:        patchModels,
-		UpdateStrategy: updateStrategyConfig,
-		DeleteStrategy: deleteStrategyConfig,
+		Name:              name,
+		Descriptors:       getUserDescriptors(machineSet),
+		BootstrapSpec:     bootstrapSpec,
+		Machines:          machineIDs,
+		MachineClass:      machineClassConfig,
+		Patches:           patchModels,
+		UpdateStrategy:    updateStrategyConfig,
+		DeleteStrategy:    deleteStrategyConfig,
+		InstallDiskPolicy: installDiskPolicy,
+		UserVolumes:       userVolumes,
+		ReadinessGates:    readinessGates,
 	}, nil
 }
 
@@ -318,12 +392,16 @@ func transformClusterToModel(cluster *omni.Cluster, patches []*omni.ConfigPatch)
 		return models.Cluster{}, err
 	}
 
+	_, paused := cluster.Metadata().Annotations().Get(omni.ClusterPaused)
+
 	return models.Cluster{
 		Meta: models.Meta{
 			Kind: models.KindCluster,
 		},
 		Name:        cluster.Metadata().ID(),
 		Descriptors: getUserDescriptors(cluster),
+		Paused:      paused,
+		TTL:         spec.GetTtl().AsDuration(),
 		Kubernetes: models.KubernetesCluster{
 			Version: "v" + spec.GetKubernetesVersion(),
 		},
@@ -368,24 +446,33 @@ func getUserDescriptors(res resource.Resource) models.Descriptors {
 }
 
 func collectClusterResources(ctx context.Context, st state.State, clusterID string) (clusterResources, error) {
-	cluster, err := safe.StateGetByID[*omni.Cluster](ctx, st, clusterID)
+	clusterQuery := []state.ListOption{state.WithLabelQuery(resource.LabelEqual(omni.LabelCluster, clusterID))}
+
+	// the resources are read from the same point in time, so that the exported template doesn't mix the changes applied during the export
+	snap, err := snapshot.Read(ctx, st,
+		snapshot.Query{Kind: omni.NewCluster(resources.DefaultNamespace, clusterID).Metadata()},
+		snapshot.Query{Kind: resource.NewMetadata(resources.DefaultNamespace, omni.MachineSetType, "", resource.VersionUndefined), ListOptions: clusterQuery},
+		snapshot.Query{Kind: resource.NewMetadata(resources.DefaultNamespace, omni.MachineSetNodeType, "", resource.VersionUndefined), ListOptions: clusterQuery},
+		snapshot.Query{Kind: resource.NewMetadata(resources.DefaultNamespace, omni.ConfigPatchType, "", resource.VersionUndefined), ListOptions: clusterQuery},
+		snapshot.Query{Kind: resource.NewMetadata(resources.DefaultNamespace, omni.ExtensionsConfigurationType, "", resource.VersionUndefined), ListOptions: clusterQuery},
+		snapshot.Query{Kind: resource.NewMetadata(resources.DefaultNamespace, omni.KernelArgsConfigurationType, "", resource.VersionUndefined), ListOptions: clusterQuery},
+	)
 	if err != nil {
-		return clusterResources{}, fmt.Errorf("error getting cluster %q: %w", clusterID, err)
+		return clusterResources{}, fmt.Errorf("error reading resources of cluster %q: %w", clusterID, err)
 	}
 
-	machineSetList, err := safe.StateListAll[*omni.MachineSet](ctx, st, state.WithLabelQuery(resource.LabelEqual(omni.LabelCluster, clusterID)))
-	if err != nil {
-		return clusterResources{}, fmt.Errorf("error listing machine sets of cluster %q: %w", clusterID, err)
+	cluster, ok := snapshot.Get[*omni.Cluster](snap, 0)
+	if !ok {
+		return clusterResources{}, fmt.Errorf("error getting cluster %q: not found", clusterID)
 	}
 
+	machineSetList := snapshot.List[*omni.MachineSet](snap, 1)
+
 	machineSetIDToMachineSet := listToMap(machineSetList, func(machineSet *omni.MachineSet) resource.ID {
 		return machineSet.Metadata().ID()
 	})
 
-	machineSetNodeList, err := safe.StateListAll[*omni.MachineSetNode](ctx, st, state.WithLabelQuery(resource.LabelEqual(omni.LabelCluster, clusterID)))
-	if err != nil {
-		return clusterResources{}, fmt.Errorf("error listing machine set nodes of cluster %q: %w", clusterID, err)
-	}
+	machineSetNodeList := snapshot.List[*omni.MachineSetNode](snap, 2)
 
 	machineSetNodes := make(map[string][]*omni.MachineSetNode, machineSetNodeList.Len())
 
@@ -412,7 +499,7 @@ func collectClusterResources(ctx context.Context, st state.State, clusterID stri
 
 	clusterMachineInstallDisks := map[string]string{}
 
-	patches, err := collectResourceLayers[*omni.ConfigPatch](ctx, st, clusterID, func(item *omni.ConfigPatch) bool {
+	patches := collectResourceLayers(snapshot.List[*omni.ConfigPatch](snap, 3), func(item *omni.ConfigPatch) bool {
 		if clusterMachineLabel, ok := item.Metadata().Labels().Get(omni.LabelClusterMachine); ok {
 			installDisk := getInstallDiskFromConfigPatch(item)
 			if installDisk != "" {
@@ -426,14 +513,9 @@ func collectClusterResources(ctx context.Context, st state.State, clusterID stri
 
 		return false
 	})
-	if err != nil {
-		return clusterResources{}, err
-	}
 
-	extensions, err := collectResourceLayers[*omni.ExtensionsConfiguration](ctx, st, clusterID, nil)
-	if err != nil {
-		return clusterResources{}, err
-	}
+	extensions := collectResourceLayers(snapshot.List[*omni.ExtensionsConfiguration](snap, 4), nil)
+	kernelArgs := collectResourceLayers(snapshot.List[*omni.KernelArgsConfiguration](snap, 5), nil)
 
 	return clusterResources{
 		cluster:                    cluster,
@@ -441,6 +523,7 @@ func collectClusterResources(ctx context.Context, st state.State, clusterID stri
 		machineSetNodes:            machineSetNodes,
 		patches:                    patches,
 		extensions:                 extensions,
+		kernelArgs:                 kernelArgs,
 		clusterMachineInstallDisks: clusterMachineInstallDisks,
 	}, nil
 }
@@ -453,18 +536,28 @@ func transformExtensions(extensions []*omni.ExtensionsConfiguration) models.Syst
 	return models.SystemExtensions{SystemExtensions: extensions[0].TypedSpec().Value.Extensions}
 }
 
+func transformKernelArgs(configurations []*omni.KernelArgsConfiguration) *models.KernelArgs {
+	if len(configurations) == 0 {
+		return nil
+	}
+
+	var kernelArgs models.KernelArgs
+
+	for _, configuration := range configurations {
+		kernelArgs.Add = append(kernelArgs.Add, configuration.TypedSpec().Value.Add...)
+		kernelArgs.Remove = append(kernelArgs.Remove, configuration.TypedSpec().Value.Remove...)
+	}
+
+	return &kernelArgs
+}
+
 type layeredResources[T meta.ResourceWithRD] struct {
 	machineSet     map[string][]T
 	clusterMachine map[string][]T
 	cluster        []T
 }
 
-func collectResourceLayers[T meta.ResourceWithRD](ctx context.Context, st state.State, clusterID string, callback func(res T) bool) (*layeredResources[T], error) {
-	resources, err := safe.StateListAll[T](ctx, st, state.WithLabelQuery(resource.LabelEqual(omni.LabelCluster, clusterID)))
-	if err != nil {
-		return nil, fmt.Errorf("error listing config patches of cluster %q: %w", clusterID, err)
-	}
-
+func collectResourceLayers[T meta.ResourceWithRD](resources safe.List[T], callback func(res T) bool) *layeredResources[T] {
 	res := &layeredResources[T]{
 		cluster:        make([]T, 0, resources.Len()),
 		machineSet:     make(map[string][]T, resources.Len()),
@@ -496,7 +589,7 @@ func collectResourceLayers[T meta.ResourceWithRD](ctx context.Context, st state.
 		res.cluster = append(res.cluster, item)
 	})
 
-	return res, nil
+	return res
 }
 
 func getInstallDiskFromConfigPatch(configPatch *omni.ConfigPatch) string {
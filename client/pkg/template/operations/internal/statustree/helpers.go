@@ -152,6 +152,14 @@ func clusterMachineConfigOutdated(outdated bool) string {
 	return " " + color.YellowString("Config Outdated")
 }
 
+func clusterMachineUpgradeHold(node *omni.ClusterMachineStatus) string {
+	if _, held := node.Metadata().Annotations().Get(omni.MachineUpgradeHold); !held {
+		return ""
+	}
+
+	return " " + color.BlueString("Upgrade Held")
+}
+
 func clusterMachineConfigStatus(node *omni.ClusterMachineStatus) string {
 	if _, locked := node.Metadata().Labels().Get(omni.UpdateLocked); locked {
 		return " " + color.BlueString("Pending Config Update (Machine Locked)")
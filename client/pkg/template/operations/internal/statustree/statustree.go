@@ -58,7 +58,7 @@ func (t NodeWrapper) String() string {
 		)
 	case *omni.ClusterMachineStatus:
 		return fmt.Sprintf(
-			"%s %q %s%s%s%s%s",
+			"%s %q %s%s%s%s%s%s",
 			color.YellowString("Machine"),
 			node.Metadata().ID(),
 			clusterMachineStageString(node.TypedSpec().Value.Stage),
@@ -66,6 +66,7 @@ func (t NodeWrapper) String() string {
 			clusterMachineConnected(node),
 			clusterMachineConfigOutdated(!node.TypedSpec().Value.ConfigUpToDate),
 			clusterMachineConfigStatus(node),
+			clusterMachineUpgradeHold(node),
 		)
 	case *omni.ControlPlaneStatus:
 		return fmt.Sprintf(
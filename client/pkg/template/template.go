@@ -57,6 +57,10 @@ func Load(input io.Reader) (*Template, error) {
 			return nil, fmt.Errorf("error in document at line %d:%d: %w", docNode.Line, docNode.Column, err)
 		}
 
+		if kind == KindInclude {
+			return nil, fmt.Errorf("error in document at line %d:%d: includes are supported only when the template is loaded from a file", docNode.Line, docNode.Column)
+		}
+
 		model, err := models.New(kind)
 		if err != nil {
 			return nil, fmt.Errorf("error in document at line %d:%d: %w", docNode.Line, docNode.Column, err)
@@ -161,6 +165,7 @@ func (t *Template) actualResources(ctx context.Context, st state.State) ([]resou
 		omni.MachineSetNodeType,
 		omni.ConfigPatchType,
 		omni.ExtensionsConfigurationType,
+		omni.KernelArgsConfigurationType,
 	} {
 		items, err := st.List(
 			ctx,
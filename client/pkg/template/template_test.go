@@ -62,6 +62,9 @@ var clusterInvalid4 []byte
 //go:embed testdata/cluster-invalid-bootstrapspec.yaml
 var clusterInvalidBootstrapSpec []byte
 
+//go:embed testdata/cluster-invalid-kernelargs.yaml
+var clusterInvalidKernelArgs []byte
+
 //go:embed testdata/cluster1-resources.yaml
 var cluster1Resources []byte
 
@@ -255,6 +258,13 @@ machine:
 	* workers is invalid: 1 error occurred:
 	* bootstrapSpec is not allowed in workers`,
 		},
+		{
+			name: "clusterInvalidKernelArgs",
+			data: clusterInvalidKernelArgs,
+			expectedError: `1 error occurred:
+	* workers is invalid: 1 error occurred:
+	* error validating kernel args: kernel argument "talos.platform=metal" can't be added: the argument is managed by Talos or Omni`,
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			templ, err := template.Load(bytes.NewReader(tt.data))
@@ -38,6 +38,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/siderolabs/omni/client/api/omni/management"
@@ -232,6 +233,51 @@ func AssertServiceAccountAPIFlow(testCtx context.Context, cli *client.Client) Te
 	}
 }
 
+// AssertUserSessionsAPIFlow tests listing and revoking the user sessions.
+func AssertUserSessionsAPIFlow(testCtx context.Context, cli *client.Client) TestFunc {
+	return func(t *testing.T) {
+		name := "test-" + uuid.NewString()
+		identity := name + pkgaccess.ServiceAccountNameSuffix
+
+		saCli, armoredPublicKey, err := newServiceAccountClient(cli, name)
+		require.NoError(t, err)
+
+		defer saCli.Close() //nolint:errcheck
+
+		publicKeyID, err := cli.Management().CreateServiceAccount(testCtx, armoredPublicKey, string(role.None), true)
+		require.NoError(t, err)
+
+		defer cli.Management().DestroyServiceAccount(testCtx, name) //nolint:errcheck
+
+		// make an API call to mark the session as used
+		_, err = saCli.Omni().State().List(testCtx, resource.NewMetadata(resources.DefaultNamespace, omni.ClusterType, "", resource.VersionUndefined))
+		require.NoError(t, err)
+
+		sessions, err := cli.Management().ListUserSessions(testCtx, identity)
+		require.NoError(t, err)
+
+		require.Len(t, sessions, 1)
+		assert.Equal(t, publicKeyID, sessions[0].GetPublicKeyId())
+		assert.Equal(t, identity, sessions[0].GetIdentity())
+		assert.NotNil(t, sessions[0].GetCreated())
+		assert.NotNil(t, sessions[0].GetLastUsed())
+
+		revoked, err := cli.Management().RevokeUserSessions(testCtx, identity)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{publicKeyID}, revoked)
+
+		sessions, err = cli.Management().ListUserSessions(testCtx, identity)
+		require.NoError(t, err)
+
+		assert.Empty(t, sessions)
+
+		// the revoked session should not be able to make API calls anymore
+		_, err = saCli.Omni().State().List(testCtx, resource.NewMetadata(resources.DefaultNamespace, omni.ClusterType, "", resource.VersionUndefined))
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	}
+}
+
 func newServiceAccountClient(cli *client.Client, name string) (*client.Client, string, error) {
 	// generate a new PGP key with long lifetime
 	comment := fmt.Sprintf("%s/%s", runtime.GOOS, runtime.GOARCH)
@@ -564,6 +610,7 @@ type resourceAuthzTestCase struct {
 	resource              resource.Resource
 	allowedVerbSet        map[state.Verb]struct{}
 	isAdminOnly           bool
+	isAdminOnlyWrite      bool
 	isSignatureSufficient bool
 	isPublic              bool
 }
@@ -598,12 +645,25 @@ func AssertResourceAuthz(rootCtx context.Context, rootCli *client.Client, client
 		extensionsConfiguration := omni.NewExtensionsConfiguration(resources.DefaultNamespace, uuid.New().String())
 		extensionsConfiguration.Metadata().Labels().Set(omni.LabelCluster, cluster.Metadata().ID())
 
+		kernelArgsConfiguration := omni.NewKernelArgsConfiguration(resources.DefaultNamespace, uuid.New().String())
+		kernelArgsConfiguration.Metadata().Labels().Set(omni.LabelCluster, cluster.Metadata().ID())
+
+		maintenanceWindow := omni.NewMaintenanceWindow(resources.DefaultNamespace, cluster.Metadata().ID())
+		maintenanceWindow.TypedSpec().Value.Schedule = "0 2 * * *"
+		maintenanceWindow.TypedSpec().Value.Duration = durationpb.New(time.Hour)
+
 		machineExtensions := omni.NewMachineExtensions(resources.DefaultNamespace, uuid.New().String())
 		machineExtensions.Metadata().Labels().Set(omni.LabelCluster, uuid.New().String())
 
 		machineExtensionsStatus := omni.NewMachineExtensionsStatus(resources.DefaultNamespace, uuid.New().String())
 		machineExtensionsStatus.Metadata().Labels().Set(omni.LabelCluster, uuid.New().String())
 
+		machineSetDefaultExtensions := omni.NewMachineSetDefaultExtensions(resources.DefaultNamespace, uuid.New().String())
+		machineSetDefaultExtensions.Metadata().Labels().Set(omni.LabelCluster, uuid.New().String())
+
+		schematicDriftStatus := omni.NewSchematicDriftStatus(resources.DefaultNamespace, uuid.New().String())
+		schematicDriftStatus.Metadata().Labels().Set(omni.LabelCluster, uuid.New().String())
+
 		testCases := []resourceAuthzTestCase{
 			{
 				resource:       identity,
@@ -653,15 +713,70 @@ func AssertResourceAuthz(rootCtx context.Context, rootCli *client.Client, client
 				resource:       omni.NewEtcdManualBackup(uuid.New().String()),
 				allowedVerbSet: allVerbsSet,
 			},
+			{
+				resource:       omni.NewDiscoveryKeyRotation(uuid.New().String()),
+				allowedVerbSet: allVerbsSet,
+			},
+			{
+				resource:       omni.NewTalosSecretsRotation(uuid.New().String()),
+				allowedVerbSet: allVerbsSet,
+			},
+			{
+				resource:       omni.NewExposedServiceAccessPolicy(resources.DefaultNamespace, uuid.New().String()),
+				allowedVerbSet: allVerbsSet,
+			},
 			{
 				resource:       omni.NewEtcdBackupS3Conf(),
 				allowedVerbSet: allVerbsSet,
 				isAdminOnly:    true,
 			},
+			{
+				resource:       omni.NewEtcdBackupStorageConfig(uuid.New().String()),
+				allowedVerbSet: allVerbsSet,
+				isAdminOnly:    true,
+			},
+			{
+				resource:       omni.NewLogLevelConfig(),
+				allowedVerbSet: allVerbsSet,
+				isAdminOnly:    true,
+			},
+			{
+				resource:         omni.NewSettings(),
+				allowedVerbSet:   allVerbsSet,
+				isAdminOnlyWrite: true,
+			},
+			{
+				resource:       omni.NewNotificationConfig(uuid.New().String()),
+				allowedVerbSet: allVerbsSet,
+				isAdminOnly:    true,
+			},
+			{
+				resource:       omni.NewQuota(uuid.New().String()),
+				allowedVerbSet: allVerbsSet,
+				isAdminOnly:    true,
+			},
+			{
+				resource:       omni.NewRuntimeConfiguration(),
+				allowedVerbSet: allVerbsSet,
+				isAdminOnly:    true,
+			},
+			{
+				resource:       omni.NewDefaultExtensions(),
+				allowedVerbSet: allVerbsSet,
+				isAdminOnly:    true,
+			},
 			{
 				resource:       extensionsConfiguration,
 				allowedVerbSet: allVerbsSet,
 			},
+			{
+				resource:       kernelArgsConfiguration,
+				allowedVerbSet: allVerbsSet,
+			},
+			{
+				resource:       maintenanceWindow,
+				allowedVerbSet: allVerbsSet,
+			},
 			{
 				resource:       machineExtensions,
 				allowedVerbSet: readOnlyVerbSet,
@@ -670,6 +785,14 @@ func AssertResourceAuthz(rootCtx context.Context, rootCli *client.Client, client
 				resource:       machineExtensionsStatus,
 				allowedVerbSet: readOnlyVerbSet,
 			},
+			{
+				resource:       machineSetDefaultExtensions,
+				allowedVerbSet: readOnlyVerbSet,
+			},
+			{
+				resource:       schematicDriftStatus,
+				allowedVerbSet: readOnlyVerbSet,
+			},
 		}
 
 		// read-only resources
@@ -706,6 +829,10 @@ func AssertResourceAuthz(rootCtx context.Context, rootCli *client.Client, client
 				resource:       omni.NewClusterKubernetesNodes(resources.DefaultNamespace, uuid.New().String()),
 				allowedVerbSet: readOnlyVerbSet,
 			},
+			{
+				resource:       omni.NewClusterNodeVersions(resources.DefaultNamespace, uuid.New().String()),
+				allowedVerbSet: readOnlyVerbSet,
+			},
 			{
 				resource:       omni.NewClusterMachineIdentity(resources.DefaultNamespace, uuid.New().String()),
 				allowedVerbSet: readOnlyVerbSet,
@@ -718,6 +845,10 @@ func AssertResourceAuthz(rootCtx context.Context, rootCli *client.Client, client
 				resource:       omni.NewClusterMachineConfigPatches(resources.DefaultNamespace, uuid.New().String()),
 				allowedVerbSet: readOnlyVerbSet,
 			},
+			{
+				resource:       omni.NewConfigPatchBlob(resources.DefaultNamespace, uuid.New().String()),
+				allowedVerbSet: readOnlyVerbSet,
+			},
 			{
 				resource:       omni.NewClusterMachineTalosVersion(resources.DefaultNamespace, uuid.New().String()),
 				allowedVerbSet: readOnlyVerbSet,
@@ -734,6 +865,14 @@ func AssertResourceAuthz(rootCtx context.Context, rootCli *client.Client, client
 				resource:       omni.NewClusterStatus(resources.DefaultNamespace, uuid.New().String()),
 				allowedVerbSet: readOnlyVerbSet,
 			},
+			{
+				resource:       omni.NewClusterAvailability(resources.DefaultNamespace, uuid.New().String()),
+				allowedVerbSet: readOnlyVerbSet,
+			},
+			{
+				resource:       omni.NewClusterStatusHistory(resources.DefaultNamespace, uuid.New().String()),
+				allowedVerbSet: readOnlyVerbSet,
+			},
 			{
 				resource:       omni.NewClusterUUID(uuid.New().String()),
 				allowedVerbSet: readOnlyVerbSet,
@@ -754,6 +893,18 @@ func AssertResourceAuthz(rootCtx context.Context, rootCli *client.Client, client
 				resource:       omni.NewControlPlaneStatus(resources.DefaultNamespace, uuid.New().String()),
 				allowedVerbSet: readOnlyVerbSet,
 			},
+			{
+				resource:       omni.NewDiscoveryAffiliate(resources.EphemeralNamespace, uuid.New().String()),
+				allowedVerbSet: readOnlyVerbSet,
+			},
+			{
+				resource:       omni.NewDiscoveryKeyRotationStatus(uuid.New().String()),
+				allowedVerbSet: readOnlyVerbSet,
+			},
+			{
+				resource:       omni.NewTalosSecretsRotationStatus(uuid.New().String()),
+				allowedVerbSet: readOnlyVerbSet,
+			},
 			{
 				resource:       omni.NewExposedService(resources.DefaultNamespace, uuid.New().String()),
 				allowedVerbSet: readOnlyVerbSet,
@@ -799,6 +950,14 @@ func AssertResourceAuthz(rootCtx context.Context, rootCli *client.Client, client
 				resource:       omni.NewMachineSetStatus(resources.DefaultNamespace, uuid.New().String()),
 				allowedVerbSet: readOnlyVerbSet,
 			},
+			{
+				resource:       omni.NewMachineSetScalingHistory(resources.DefaultNamespace, uuid.New().String()),
+				allowedVerbSet: readOnlyVerbSet,
+			},
+			{
+				resource:       omni.NewMachineHardwareInventory(resources.DefaultNamespace, uuid.New().String()),
+				allowedVerbSet: readOnlyVerbSet,
+			},
 			{
 				resource:       omni.NewMachineStatus(resources.DefaultNamespace, uuid.New().String()),
 				allowedVerbSet: readOnlyVerbSet,
@@ -807,10 +966,22 @@ func AssertResourceAuthz(rootCtx context.Context, rootCli *client.Client, client
 				resource:       omni.NewMachineStatusLink(resources.MetricsNamespace, uuid.New().String()),
 				allowedVerbSet: readOnlyVerbSet,
 			},
+			{
+				resource:       omni.NewMachineLinkStatus(resources.EphemeralNamespace, uuid.New().String()),
+				allowedVerbSet: readOnlyVerbSet,
+			},
 			{
 				resource:       omni.NewMachineStatusSnapshot(resources.DefaultNamespace, uuid.New().String()),
 				allowedVerbSet: readOnlyVerbSet,
 			},
+			{
+				resource:       omni.NewMachineWipeStatus(resources.DefaultNamespace, uuid.New().String()),
+				allowedVerbSet: readOnlyVerbSet,
+			},
+			{
+				resource:       omni.NewMachineBootHistory(resources.DefaultNamespace, uuid.New().String()),
+				allowedVerbSet: readOnlyVerbSet,
+			},
 			{
 				resource:       omni.NewMachineConfigGenOptions(resources.DefaultNamespace, uuid.New().String()),
 				allowedVerbSet: readOnlyVerbSet,
@@ -834,6 +1005,10 @@ func AssertResourceAuthz(rootCtx context.Context, rootCli *client.Client, client
 				resource:       omni.NewTalosUpgradeStatus(resources.DefaultNamespace, uuid.New().String()),
 				allowedVerbSet: readOnlyVerbSet,
 			},
+			{
+				resource:       omni.NewTemplateSyncStatus(resources.DefaultNamespace, uuid.New().String()),
+				allowedVerbSet: readOnlyVerbSet,
+			},
 			{
 				resource:              omni.NewInstallationMedia(resources.DefaultNamespace, uuid.New().String()),
 				allowedVerbSet:        readOnlyVerbSet,
@@ -843,6 +1018,16 @@ func AssertResourceAuthz(rootCtx context.Context, rootCli *client.Client, client
 				resource:       omni.NewRedactedClusterMachineConfig(resources.DefaultNamespace, uuid.New().String()),
 				allowedVerbSet: readOnlyVerbSet,
 			},
+			{
+				resource:       omni.NewClusterMachineConfigBackup(resources.DefaultNamespace, uuid.New().String()),
+				allowedVerbSet: readOnlyVerbSet,
+				isAdminOnly:    true,
+			},
+			{
+				resource:       authres.NewBreakGlassToken(resources.DefaultNamespace, uuid.New().String()),
+				allowedVerbSet: readOnlyVerbSet,
+				isAdminOnly:    true,
+			},
 			{
 				resource:       omni.NewImagePullRequest(resources.DefaultNamespace, uuid.New().String()),
 				allowedVerbSet: readOnlyVerbSet,
@@ -892,6 +1077,14 @@ func AssertResourceAuthz(rootCtx context.Context, rootCli *client.Client, client
 				resource:       omni.NewMachineSetDestroyStatus(resources.EphemeralNamespace, uuid.New().String()),
 				allowedVerbSet: readOnlyVerbSet,
 			},
+			{
+				resource:       omni.NewMachineMoveRequest(resources.DefaultNamespace, uuid.New().String()),
+				allowedVerbSet: readOnlyVerbSet,
+			},
+			{
+				resource:       omni.NewMachineMoveStatus(resources.DefaultNamespace, uuid.New().String()),
+				allowedVerbSet: readOnlyVerbSet,
+			},
 			{
 				resource:       omni.NewEtcdBackupStoreStatus(),
 				allowedVerbSet: readOnlyVerbSet,
@@ -1039,7 +1232,7 @@ func AssertResourceAuthz(rootCtx context.Context, rootCli *client.Client, client
 						_, verbAllowed := tc.allowedVerbSet[testVerb]
 						sufficientRole := true
 
-						if tc.isAdminOnly {
+						if tc.isAdminOnly || (tc.isAdminOnlyWrite && !testVerb.Readonly()) {
 							if !isAdmin {
 								sufficientRole = false
 							}
@@ -1034,6 +1034,10 @@ Test authorization on accessing Omni API, some tests run without a cluster, some
 					"ServiceAccountAPIShouldWork",
 					AssertServiceAccountAPIFlow(ctx, rootClient),
 				},
+				subTest{
+					"UserSessionsAPIShouldWork",
+					AssertUserSessionsAPIFlow(ctx, rootClient),
+				},
 				subTest{
 					"ResourceAuthzShouldWork",
 					AssertResourceAuthz(ctx, rootClient, clientConfig),
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"slices"
 	"strings"
 	"syscall"
 
@@ -33,6 +34,7 @@ import (
 	"github.com/siderolabs/omni/internal/backend"
 	"github.com/siderolabs/omni/internal/backend/discovery"
 	"github.com/siderolabs/omni/internal/backend/dns"
+	"github.com/siderolabs/omni/internal/backend/dryrun"
 	"github.com/siderolabs/omni/internal/backend/imagefactory"
 	"github.com/siderolabs/omni/internal/backend/logging"
 	"github.com/siderolabs/omni/internal/backend/resourcelogger"
@@ -61,6 +63,37 @@ func runDebugServer(ctx context.Context, logger *zap.Logger) {
 	}
 }
 
+// parseEtcdShards fills the etcd shards config from the flags.
+func parseEtcdShards(shards, shardEndpoints []string) error {
+	for _, shard := range shards {
+		name, namespaces, ok := strings.Cut(shard, "=")
+		if !ok || name == "" || namespaces == "" {
+			return fmt.Errorf("invalid etcd shard spec: %q", shard)
+		}
+
+		config.Config.Storage.Etcd.Shards = append(config.Config.Storage.Etcd.Shards, config.EtcdShardParams{
+			Name:       name,
+			Namespaces: strings.Split(namespaces, ","),
+		})
+	}
+
+	for _, spec := range shardEndpoints {
+		name, endpoints, ok := strings.Cut(spec, "=")
+		if !ok || endpoints == "" {
+			return fmt.Errorf("invalid etcd shard endpoints spec: %q", spec)
+		}
+
+		index := slices.IndexFunc(config.Config.Storage.Etcd.Shards, func(shard config.EtcdShardParams) bool { return shard.Name == name })
+		if index == -1 {
+			return fmt.Errorf("endpoints are set for the unknown etcd shard %q", name)
+		}
+
+		config.Config.Storage.Etcd.Shards[index].Endpoints = strings.Split(endpoints, ",")
+	}
+
+	return nil
+}
+
 // rootCmd represents the base command when called without any subcommands.
 var rootCmd = &cobra.Command{
 	Use:          "omni",
@@ -82,19 +115,33 @@ var rootCmd = &cobra.Command{
 			loggerConfig = zap.NewProductionConfig()
 		}
 
-		if !rootCmdArgs.debug {
-			loggerConfig.Level.SetLevel(zap.InfoLevel)
-		} else {
-			loggerConfig.Level.SetLevel(zap.DebugLevel)
+		logLevel := zap.InfoLevel
+
+		if rootCmdArgs.debug {
+			logLevel = zap.DebugLevel
 		}
 
+		// the log entries are filtered by the subsystem levels, which can be lowered at runtime
+		logLevels := logging.NewLevels(logLevel)
+
+		// the latest controller logs are kept for the support bundles
+		recentLogs := logging.NewRecentLogs(logging.RecentLogsSize)
+
+		loggerConfig.Level.SetLevel(zap.DebugLevel)
+
 		logger, err := loggerConfig.Build(
 			zap.AddStacktrace(zapcore.FatalLevel), // only print stack traces for fatal errors
+			zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+				return logLevels.WrapCore(recentLogs.WrapCore(core))
+			}),
 		)
 		if err != nil {
 			return fmt.Errorf("failed to set up logging: %w", err)
 		}
 
+		logging.SetDefaultLevels(logLevels)
+		logging.SetDefaultRecentLogs(recentLogs)
+
 		// set kubernetes logger to use warn log level and use zap
 		klog.SetLogger(zapr.NewLogger(logger.WithOptions(zap.IncreaseLevel(zapcore.WarnLevel)).With(logging.Component("kubernetes"))))
 
@@ -111,6 +158,19 @@ var rootCmd = &cobra.Command{
 			config.Config.DefaultConfigGenOptions = append(config.Config.DefaultConfigGenOptions, generate.WithRegistryMirror(hostname, endpoint))
 		}
 
+		if err = parseEtcdShards(rootCmdArgs.etcdShards, rootCmdArgs.etcdShardEndpoints); err != nil {
+			return err
+		}
+
+		if rootCmdArgs.configPath != "" {
+			reloader, reloaderErr := config.NewReloader(rootCmdArgs.configPath, config.Config, logger.With(logging.Component("config_reloader")))
+			if reloaderErr != nil {
+				return fmt.Errorf("failed to load the config file: %w", reloaderErr)
+			}
+
+			config.SetDefaultReloader(reloader)
+		}
+
 		logger.Info("starting Omni", zap.String("version", version.Tag))
 
 		logger.Debug("using config", zap.Any("config", config.Config))
@@ -135,6 +195,12 @@ var rootCmd = &cobra.Command{
 			runDebugServer(ctx, logger)
 		}, logger)
 
+		if reloader := config.DefaultReloader(); reloader != nil {
+			panichandler.Go(func() {
+				reloader.Run(ctx)
+			}, logger)
+		}
+
 		// this global context propagates into all controllers and any other background activities
 		ctx = actor.MarkContextAsInternalActor(ctx)
 
@@ -150,11 +216,16 @@ var rootCmd = &cobra.Command{
 //nolint:gocognit
 func runWithState(logger *zap.Logger) func(context.Context, state.State, *virtual.State) error {
 	return func(ctx context.Context, resourceState state.State, virtualState *virtual.State) error {
+		if config.Config.DryRun {
+			dryrun.Enable(logger.With(logging.Component("dry_run")))
+		}
+
 		talosClientFactory := talos.NewClientFactory(resourceState, logger)
 		prometheus.MustRegister(talosClientFactory)
 
 		dnsService := dns.NewService(resourceState, logger)
-		workloadProxyReconciler := workloadproxy.NewReconciler(logger.With(logging.Component("workload_proxy_reconciler")), zapcore.DebugLevel)
+		workloadProxyReconciler := workloadproxy.NewReconciler(logger.With(logging.Component("workload_proxy_reconciler")), zapcore.DebugLevel,
+			workloadproxy.WithIdleTimeout(config.Config.WorkloadProxying.IdleTimeout))
 
 		var resourceLogger *resourcelogger.Logger
 
@@ -174,6 +245,7 @@ func runWithState(logger *zap.Logger) func(context.Context, state.State, *virtua
 		}
 
 		linkCounterDeltaCh := make(chan siderolink.LinkCounterDeltas)
+		linkSampleCh := make(chan siderolink.LinkSamples)
 		siderolinkEventsCh := make(chan *omnires.MachineStatusSnapshot)
 
 		defaultDiscoveryClient, err := discovery.NewClient(discovery.Options{
@@ -200,9 +272,16 @@ func runWithState(logger *zap.Logger) func(context.Context, state.State, *virtua
 			}
 		}()
 
-		omniRuntime, err := omni.New(talosClientFactory, dnsService, workloadProxyReconciler, resourceLogger,
-			imageFactoryClient, linkCounterDeltaCh, siderolinkEventsCh, resourceState, virtualState,
-			prometheus.DefaultRegisterer, defaultDiscoveryClient, embeddedDiscoveryClient, logger.With(logging.Component("omni_runtime")))
+		omniRuntime, err := omni.New(talosClientFactory, dnsService, workloadProxyReconciler, resourceState,
+			prometheus.DefaultRegisterer, defaultDiscoveryClient, logger.With(logging.Component("omni_runtime")),
+			omni.WithResourceLogger(resourceLogger),
+			omni.WithImageFactoryClient(imageFactoryClient),
+			omni.WithLinkCounterDeltas(linkCounterDeltaCh),
+			omni.WithLinkSamples(linkSampleCh),
+			omni.WithMachineStatusSnapshots(siderolinkEventsCh),
+			omni.WithVirtualState(virtualState),
+			omni.WithEmbeddedDiscoveryClient(embeddedDiscoveryClient),
+		)
 		if err != nil {
 			return fmt.Errorf("failed to set up the controller runtime: %w", err)
 		}
@@ -251,6 +330,7 @@ func runWithState(logger *zap.Logger) func(context.Context, state.State, *virtua
 			workloadProxyReconciler,
 			imageFactoryClient,
 			linkCounterDeltaCh,
+			linkSampleCh,
 			siderolinkEventsCh,
 			omniRuntime,
 			talosRuntime,
@@ -283,6 +363,9 @@ var rootCmdArgs struct {
 	keyFile             string
 	certFile            string
 	registryMirrors     []string
+	etcdShards          []string
+	etcdShardEndpoints  []string
+	configPath          string
 
 	debug bool
 }
@@ -296,6 +379,8 @@ func main() {
 //nolint:maintidx
 func init() {
 	rootCmd.Flags().BoolVar(&rootCmdArgs.debug, "debug", false, "enable debug logs.")
+	rootCmd.Flags().StringVar(&rootCmdArgs.configPath, "config-path", "",
+		"path of the YAML config file, the settings from the file override the flags and are reloaded on SIGHUP and on the file change.")
 	rootCmd.Flags().StringVar(&rootCmdArgs.bindAddress, "bind-addr", "0.0.0.0:8080", "start HTTP server on the defined address.")
 	rootCmd.Flags().StringVar(&rootCmdArgs.frontendDst, "frontend-dst", "", "destination address non API requests from proxy server.")
 	rootCmd.Flags().StringVar(&rootCmdArgs.frontendBind, "frontend-bind", "", "proxy server which will redirect all non API requests to the definied frontend server.")
@@ -351,6 +436,10 @@ func init() {
 		config.Config.MachineLogConfig.StorageFlushPeriod, "period for flushing machine logs to disk")
 	rootCmd.Flags().Float64Var(&config.Config.MachineLogConfig.StorageFlushJitter, "machine-log-storage-flush-jitter",
 		config.Config.MachineLogConfig.StorageFlushJitter, "jitter for the machine log storage flush period")
+	rootCmd.Flags().DurationVar(&config.Config.MachineLogConfig.StorageMaxAge, "machine-log-storage-max-age",
+		config.Config.MachineLogConfig.StorageMaxAge, "remove the stored logs of the machines which didn't send any logs for this duration, 0 to keep them forever")
+	rootCmd.Flags().Int64Var(&config.Config.MachineLogConfig.StorageMaxSize, "machine-log-storage-max-size",
+		config.Config.MachineLogConfig.StorageMaxSize, "max size of the stored logs of a single machine in bytes, 0 for no limit")
 
 	// keep the old flags for backwards-compatibility
 	{
@@ -386,6 +475,24 @@ func init() {
 	)
 	rootCmd.Flags().Var(&config.Config.Auth.SAML.LabelRules, "auth-saml-label-rules", "defines mapping of SAML assertion attributes into Omni identity labels")
 
+	rootCmd.Flags().BoolVar(&config.Config.Auth.Static.Enabled, "auth-static-enabled", config.Config.Auth.Static.Enabled,
+		"enable static users file authentication (for air-gapped installations).",
+	)
+	rootCmd.Flags().StringVar(&config.Config.Auth.Static.UsersFile, "auth-static-users-file", config.Config.Auth.Static.UsersFile,
+		"path to the static users file, containing the users' emails, bcrypt password hashes and roles.",
+	)
+
+	rootCmd.Flags().Var(&config.Config.Auth.PublicKeyMaxLifetimes, "auth-public-key-max-lifetimes",
+		"maximum lifetime of the user public keys per role, e.g. Admin=8h,Reader=168h. keys registered with a longer lifetime expire earlier.",
+	)
+
+	rootCmd.Flags().BoolVar(&config.Config.Auth.HardwareKeys.Enabled, "auth-hardware-keys-enabled", config.Config.Auth.HardwareKeys.Enabled,
+		"allow registering long-lived user keys stored on hardware tokens.",
+	)
+	rootCmd.Flags().DurationVar(&config.Config.Auth.HardwareKeys.SessionLifetime, "auth-hardware-keys-session-lifetime", config.Config.Auth.HardwareKeys.SessionLifetime,
+		"lifetime of the session bound to a long-lived user key, the key has to be confirmed again after it expires.",
+	)
+
 	rootCmd.Flags().StringSliceVar(&config.Config.InitialUsers, "initial-users", config.Config.InitialUsers, "initial set of user emails. these users will be created on startup.")
 
 	rootCmd.Flags().StringVar(&config.Config.Storage.Kind, "storage-kind", config.Config.Storage.Kind, "storage type: etcd|boltdb.")
@@ -396,6 +503,11 @@ func init() {
 	rootCmd.Flags().StringVar(&config.Config.Storage.Etcd.CAPath, "etcd-ca-path", config.Config.Storage.Etcd.CAPath, "external etcd CA path.")
 	rootCmd.Flags().StringVar(&config.Config.Storage.Etcd.CertPath, "etcd-client-cert-path", config.Config.Storage.Etcd.CertPath, "external etcd client cert path.")
 	rootCmd.Flags().StringVar(&config.Config.Storage.Etcd.KeyPath, "etcd-client-key-path", config.Config.Storage.Etcd.KeyPath, "external etcd client key path.")
+	rootCmd.Flags().StringArrayVar(&rootCmdArgs.etcdShards, "etcd-shard", nil,
+		"store the resource namespaces under the separate etcd key prefix in format: <shard name>=<namespace pattern>[,<namespace pattern>...], "+
+			"the patterns use path.Match syntax.")
+	rootCmd.Flags().StringArrayVar(&rootCmdArgs.etcdShardEndpoints, "etcd-shard-endpoints", nil,
+		"store the etcd shard in the external etcd in format: <shard name>=<endpoint>[,<endpoint>...], the client TLS settings of the main etcd are used.")
 
 	rootCmd.Flags().StringVar(&config.Config.SecondaryStorage.Path, "secondary-storage-path", config.Config.SecondaryStorage.Path,
 		"path of the file for boltdb-backed secondary storage for frequently updated data.")
@@ -432,6 +544,19 @@ func init() {
 
 	rootCmd.Flags().BoolVar(&config.Config.WorkloadProxying.Enabled, "workload-proxying-enabled", config.Config.WorkloadProxying.Enabled, "enable workload proxying feature.")
 	rootCmd.Flags().StringVar(&config.Config.WorkloadProxying.Subdomain, "workload-proxying-subdomain", config.Config.WorkloadProxying.Subdomain, "workload proxying subdomain.")
+	rootCmd.Flags().DurationVar(&config.Config.WorkloadProxying.IdleTimeout, "workload-proxying-idle-timeout", config.Config.WorkloadProxying.IdleTimeout,
+		"close the connections to the exposed services, including WebSockets, after the given time without traffic.")
+	rootCmd.Flags().BoolVar(&config.Config.WorkloadProxying.CustomDomains.Enabled, "workload-proxying-custom-domains-enabled", config.Config.WorkloadProxying.CustomDomains.Enabled,
+		"allow exposing services on custom domains, the certificates are issued by ACME. Requires TLS to be enabled on the API server.")
+	rootCmd.Flags().StringVar(&config.Config.WorkloadProxying.CustomDomains.ACMEDirectoryURL, "workload-proxying-acme-directory-url", config.Config.WorkloadProxying.CustomDomains.ACMEDirectoryURL,
+		"ACME directory URL used to issue the certificates for the custom domains, Let's Encrypt is used if not set.")
+	rootCmd.Flags().StringVar(&config.Config.WorkloadProxying.CustomDomains.ACMEEmail, "workload-proxying-acme-email", config.Config.WorkloadProxying.CustomDomains.ACMEEmail,
+		"contact email of the ACME account.")
+	rootCmd.Flags().StringVar(&config.Config.WorkloadProxying.CustomDomains.CertCacheDir, "workload-proxying-cert-cache-dir", config.Config.WorkloadProxying.CustomDomains.CertCacheDir,
+		"directory to store the ACME account key and the issued certificates in.")
+	rootCmd.Flags().StringVar(&config.Config.WorkloadProxying.CustomDomains.HTTPChallengeBindAddress, "workload-proxying-acme-http-bind-address",
+		config.Config.WorkloadProxying.CustomDomains.HTTPChallengeBindAddress,
+		"address to answer the ACME HTTP-01 challenges on, if not set only the TLS-ALPN-01 challenges are answered on the API bind address.")
 
 	rootCmd.Flags().IntVar(&config.Config.LocalResourceServerPort, "local-resource-server-port", config.Config.LocalResourceServerPort, "port for local read-only public resource server.")
 
@@ -531,6 +656,12 @@ func init() {
 		config.Config.EmbeddedDiscoveryService.LogLevel,
 		"log level for the embedded discovery service - it has no effect if it is lower (more verbose) than the main log level",
 	)
+	rootCmd.Flags().DurationVar(
+		&config.Config.EmbeddedDiscoveryService.StaleAffiliateTimeout,
+		"embedded-discovery-service-stale-affiliate-timeout",
+		config.Config.EmbeddedDiscoveryService.StaleAffiliateTimeout,
+		"delete the affiliates which don't belong to any cluster machine from the embedded discovery service after this timeout, 0 disables the deletion",
+	)
 
 	rootCmd.Flags().BoolVar(
 		&config.Config.EnableBreakGlassConfigs,
@@ -538,4 +669,267 @@ func init() {
 		config.Config.EnableBreakGlassConfigs,
 		"Allows downloading admin Talos and Kubernetes configs.",
 	)
+
+	rootCmd.Flags().StringVar(
+		&config.Config.BreakGlass.SecretPath,
+		"break-glass-secret-path",
+		config.Config.BreakGlass.SecretPath,
+		"path to the file containing the secret required to issue the break-glass access tokens. "+
+			"The tokens are disabled unless both --break-glass-secret-path and --break-glass-totp-seed-path are set.",
+	)
+
+	rootCmd.Flags().StringVar(
+		&config.Config.BreakGlass.TOTPSeedPath,
+		"break-glass-totp-seed-path",
+		config.Config.BreakGlass.TOTPSeedPath,
+		"path to the file containing the base32 encoded TOTP seed, the current TOTP code is required to issue the break-glass access tokens.",
+	)
+
+	rootCmd.Flags().DurationVar(
+		&config.Config.BreakGlass.MaxTTL,
+		"break-glass-max-ttl",
+		config.Config.BreakGlass.MaxTTL,
+		"maximum lifetime of the break-glass access tokens.",
+	)
+
+	rootCmd.Flags().StringSliceVar(
+		&config.Config.BreakGlass.TrustedProxies,
+		"break-glass-trusted-proxies",
+		config.Config.BreakGlass.TrustedProxies,
+		"addresses or CIDRs of the reverse proxies trusted to set the X-Forwarded-For and X-Real-IP headers of the break-glass token requests.",
+	)
+
+	rootCmd.Flags().BoolVar(&config.Config.GitOps.Enabled, "gitops-enabled", config.Config.GitOps.Enabled, "enable syncing cluster templates from a Git repository.")
+	rootCmd.Flags().StringVar(&config.Config.GitOps.Repository, "gitops-repository", config.Config.GitOps.Repository, "URL of the Git repository with the cluster templates.")
+	rootCmd.Flags().StringVar(&config.Config.GitOps.Branch, "gitops-branch", config.Config.GitOps.Branch, "Git branch to sync the cluster templates from.")
+	rootCmd.Flags().StringVar(&config.Config.GitOps.Path, "gitops-path", config.Config.GitOps.Path, "directory in the Git repository containing the cluster templates.")
+	rootCmd.Flags().StringVar(&config.Config.GitOps.WorkDir, "gitops-work-dir", config.Config.GitOps.WorkDir, "local directory to check out the Git repository to.")
+	rootCmd.Flags().StringVar(&config.Config.GitOps.SSHKeyPath, "gitops-ssh-key-path", config.Config.GitOps.SSHKeyPath, "path to the private SSH key used to access the Git repository.")
+	rootCmd.Flags().StringVar(
+		&config.Config.GitOps.SSHKnownHostsPath,
+		"gitops-ssh-known-hosts-path",
+		config.Config.GitOps.SSHKnownHostsPath,
+		"path to the SSH known hosts file, if not set, the Git server host key is accepted on the first use.",
+	)
+	rootCmd.Flags().StringVar(&config.Config.GitOps.TokenPath, "gitops-token-path", config.Config.GitOps.TokenPath, "path to the file containing the token used to access the Git repository over HTTPS.")
+	rootCmd.Flags().StringVar(&config.Config.GitOps.TokenUsername, "gitops-token-username", config.Config.GitOps.TokenUsername, "username sent along with the Git access token.")
+	rootCmd.Flags().StringVar(
+		&config.Config.GitOps.WebhookSecretPath,
+		"gitops-webhook-secret-path",
+		config.Config.GitOps.WebhookSecretPath,
+		"path to the file containing the secret of the GitHub or GitLab push event webhook triggering the cluster templates sync.",
+	)
+	rootCmd.Flags().StringVar(&config.Config.GitOps.GitPath, "gitops-git-path", config.Config.GitOps.GitPath, "path to the git binary.")
+	rootCmd.Flags().DurationVar(&config.Config.GitOps.PollInterval, "gitops-poll-interval", config.Config.GitOps.PollInterval, "interval between the Git repository polls.")
+	rootCmd.Flags().BoolVar(&config.Config.GitOps.Prune, "gitops-prune", config.Config.GitOps.Prune, "destroy the clusters whose templates were removed from the Git repository.")
+
+	rootCmd.MarkFlagsMutuallyExclusive("gitops-ssh-key-path", "gitops-token-path")
+
+	rootCmd.Flags().StringSliceVar(
+		&config.Config.PayloadSampling.Methods,
+		"payload-sampling-methods",
+		config.Config.PayloadSampling.Methods,
+		"full names of the gRPC methods to record the redacted request and response payloads of, e.g. /management.ManagementService/Kubeconfig.",
+	)
+	rootCmd.Flags().Float64Var(&config.Config.PayloadSampling.Rate, "payload-sampling-rate", config.Config.PayloadSampling.Rate, "fraction of the calls of the sampled methods to record.")
+	rootCmd.Flags().IntVar(&config.Config.PayloadSampling.BufferSize, "payload-sampling-buffer-size", config.Config.PayloadSampling.BufferSize, "number of the latest payload samples to keep.")
+	rootCmd.Flags().IntVar(
+		&config.Config.PayloadSampling.MessageSizeLimit,
+		"payload-sampling-message-size-limit",
+		config.Config.PayloadSampling.MessageSizeLimit,
+		"maximum size of a recorded message, longer messages are truncated.",
+	)
+	rootCmd.Flags().IntVar(
+		&config.Config.PayloadSampling.MaxStreamMessages,
+		"payload-sampling-max-stream-messages",
+		config.Config.PayloadSampling.MaxStreamMessages,
+		"maximum number of the recorded messages of a streaming call in each direction.",
+	)
+
+	rootCmd.Flags().Float64Var(
+		&config.Config.RateLimit.Rate,
+		"rate-limit",
+		config.Config.RateLimit.Rate,
+		"number of the API calls per second allowed for each user, the rate limiting is disabled if zero.",
+	)
+	rootCmd.Flags().IntVar(&config.Config.RateLimit.Burst, "rate-limit-burst", config.Config.RateLimit.Burst, "number of the API calls a user can make at once.")
+	rootCmd.Flags().Float64Var(
+		&config.Config.RateLimit.ServiceAccountRate,
+		"rate-limit-service-account",
+		config.Config.RateLimit.ServiceAccountRate,
+		"number of the API calls per second allowed for each service account, --rate-limit is used if zero.",
+	)
+	rootCmd.Flags().IntVar(
+		&config.Config.RateLimit.ServiceAccountBurst,
+		"rate-limit-service-account-burst",
+		config.Config.RateLimit.ServiceAccountBurst,
+		"number of the API calls a service account can make at once, --rate-limit-burst is used if zero.",
+	)
+
+	rootCmd.Flags().BoolVar(
+		&config.Config.EnableDiagnostics,
+		"enable-diagnostics",
+		config.Config.EnableDiagnostics,
+		"Exposes the pprof profiles, goroutine dumps and COSI runtime dumps to the admins on the API port under /diagnostics/.",
+	)
+
+	rootCmd.Flags().StringVar(
+		&config.Config.ControllerGraphExportPath,
+		"export-controller-graph",
+		config.Config.ControllerGraphExportPath,
+		"write the controller dependency graph on startup to the file, the format is detected by the extension: .dot, .gv or .json.",
+	)
+
+	rootCmd.Flags().BoolVar(
+		&config.Config.DryRun,
+		"dry-run",
+		config.Config.DryRun,
+		"run the controllers without pushing the changes to the machines: the mutating Talos and Kubernetes API calls are blocked and logged. "+
+			"Useful to verify a restored backup before it manages the machines.",
+	)
+
+	rootCmd.Flags().IntVar(
+		&config.Config.ConfigApply.Concurrency,
+		"config-apply-concurrency",
+		config.Config.ConfigApply.Concurrency,
+		"maximum number of the machine configs applied at the same time across all clusters, the control plane machines go first.",
+	)
+
+	rootCmd.Flags().IntVar(
+		&config.Config.UpgradeConcurrency.Limit,
+		"upgrade-concurrency",
+		config.Config.UpgradeConcurrency.Limit,
+		"maximum number of the clusters running Talos or Kubernetes upgrades at the same time, the other upgrades are queued. Unlimited if zero.",
+	)
+
+	rootCmd.Flags().StringVar(
+		&config.Config.UpgradeConcurrency.GroupLabel,
+		"upgrade-concurrency-group-label",
+		config.Config.UpgradeConcurrency.GroupLabel,
+		"cluster label which splits the clusters into the groups for the --upgrade-concurrency-group-limit.",
+	)
+
+	rootCmd.Flags().IntVar(
+		&config.Config.UpgradeConcurrency.GroupLimit,
+		"upgrade-concurrency-group-limit",
+		config.Config.UpgradeConcurrency.GroupLimit,
+		"maximum number of the clusters with the same value of the --upgrade-concurrency-group-label running upgrades at the same time. Unlimited if zero.",
+	)
+
+	rootCmd.Flags().BoolVar(
+		&config.Config.SchematicDriftRemediation,
+		"schematic-drift-remediation",
+		config.Config.SchematicDriftRemediation,
+		"upgrade the machines running another schematic than the one Omni installed back to the desired schematic. "+
+			"If disabled, the drift is only reported.",
+	)
+
+	rootCmd.Flags().IntVar(
+		&config.Config.CrashLoopDetection.Boots,
+		"crash-loop-boots",
+		config.Config.CrashLoopDetection.Boots,
+		"number of the machine boots within the --crash-loop-window which marks the machine as crash looping. Disabled if zero.",
+	)
+
+	rootCmd.Flags().DurationVar(
+		&config.Config.CrashLoopDetection.Window,
+		"crash-loop-window",
+		config.Config.CrashLoopDetection.Window,
+		"period of time the machine boots are counted in for the crash loop detection.",
+	)
+
+	rootCmd.Flags().DurationVar(
+		&config.Config.MachinePolling.MinInterval,
+		"machine-poll-min-interval",
+		config.Config.MachinePolling.MinInterval,
+		"interval between the periodic polls of the machine status from the Talos API while the machine is changing, "+
+			"and between the first retries of the failed polls.",
+	)
+
+	rootCmd.Flags().DurationVar(
+		&config.Config.MachinePolling.MaxInterval,
+		"machine-poll-max-interval",
+		config.Config.MachinePolling.MaxInterval,
+		"maximum interval between the periodic polls of the machine status from the Talos API and between the retries of the failed polls, "+
+			"the interval grows from the --machine-poll-min-interval up to it while the machine is stable or the polls keep failing.",
+	)
+
+	rootCmd.Flags().IntVar(
+		&config.Config.ControllerAdmission.MaxInFlight,
+		"controller-max-in-flight",
+		config.Config.ControllerAdmission.MaxInFlight,
+		"number of the controller reconciles running at the same time which marks the controller runtime as overloaded, "+
+			"the low priority reconciles like the cleanups and the statistics are delayed while it's overloaded. Disabled if zero.",
+	)
+
+	rootCmd.Flags().DurationVar(
+		&config.Config.ControllerAdmission.ShedDelay,
+		"controller-shed-delay",
+		config.Config.ControllerAdmission.ShedDelay,
+		"time the controller reconcile delayed due to the overload is retried after.",
+	)
+
+	rootCmd.Flags().StringVar(
+		&config.Config.Autoscaler.BindAddress,
+		"autoscaler-bind-address",
+		config.Config.Autoscaler.BindAddress,
+		"address to serve the Kubernetes cluster-autoscaler external gRPC cloud provider API on. Disabled if empty.",
+	)
+
+	rootCmd.Flags().StringVar(
+		&config.Config.Autoscaler.CertFile,
+		"autoscaler-cert",
+		config.Config.Autoscaler.CertFile,
+		"TLS certificate file of the cluster-autoscaler API.",
+	)
+
+	rootCmd.Flags().StringVar(
+		&config.Config.Autoscaler.KeyFile,
+		"autoscaler-key",
+		config.Config.Autoscaler.KeyFile,
+		"TLS key file of the cluster-autoscaler API.",
+	)
+
+	rootCmd.Flags().StringVar(
+		&config.Config.Autoscaler.ClientCAFile,
+		"autoscaler-client-ca",
+		config.Config.Autoscaler.ClientCAFile,
+		"CA certificate file the cluster-autoscaler client certificates are verified with, "+
+			"the common name of the client certificate is the name of the cluster the autoscaler manages.",
+	)
+
+	rootCmd.Flags().BoolVar(
+		&config.Config.DestroyReason.Required,
+		"require-destroy-reason",
+		config.Config.DestroyReason.Required,
+		"reject the deletion of the resources of the --destroy-reason-types made through the API without a reason.",
+	)
+
+	rootCmd.Flags().StringSliceVar(
+		&config.Config.DestroyReason.Types,
+		"destroy-reason-types",
+		config.Config.DestroyReason.Types,
+		"resource types which require a reason on deletion if --require-destroy-reason is set.",
+	)
+
+	rootCmd.Flags().StringSliceVar(
+		&config.Config.LabelPolicy.AllowedKeyPrefixes,
+		"label-allowed-key-prefixes",
+		config.Config.LabelPolicy.AllowedKeyPrefixes,
+		"prefixes one of which the keys of the user labels set through the API must start with. Any key is allowed if empty.",
+	)
+
+	rootCmd.Flags().StringSliceVar(
+		&config.Config.LabelPolicy.ReservedKeyPrefixes,
+		"label-reserved-key-prefixes",
+		config.Config.LabelPolicy.ReservedKeyPrefixes,
+		"prefixes the keys of the user labels set through the API can't start with in addition to the prefix of the Omni system labels.",
+	)
+
+	rootCmd.Flags().StringVar(
+		&config.Config.LabelPolicy.ValuePattern,
+		"label-value-pattern",
+		config.Config.LabelPolicy.ValuePattern,
+		"regular expression the values of the user labels set through the API must fully match. Any value is allowed if empty.",
+	)
 }
@@ -16,6 +16,34 @@ export enum KubernetesSyncManifestResponseResponseType {
   ROLLOUT = 2,
 }
 
+export enum GetClusterStatusHistoryRequestRange {
+  LAST_DAY = 0,
+  LAST_WEEK = 1,
+}
+
+export enum WatchUpgradeProgressResponseMachinePhase {
+  PENDING = 0,
+  DRAINING = 1,
+  UPGRADING = 2,
+  REBOOTING = 3,
+  VERIFYING = 4,
+  DONE = 5,
+  FAILED = 6,
+}
+
+export enum RestoreStateRequestConflictResolution {
+  SKIP = 0,
+  OVERWRITE = 1,
+  FAIL = 2,
+}
+
+export enum RestoreStateResponseAction {
+  CREATED = 0,
+  UPDATED = 1,
+  SKIPPED = 2,
+  FAILED = 3,
+}
+
 export type KubeconfigResponse = {
   kubeconfig?: Uint8Array
 }
@@ -32,6 +60,8 @@ export type MachineLogsRequest = {
   machine_id?: string
   follow?: boolean
   tail_lines?: number
+  since?: GoogleProtobufTimestamp.Timestamp
+  until?: GoogleProtobufTimestamp.Timestamp
 }
 
 export type ValidateConfigRequest = {
@@ -47,6 +77,7 @@ export type CreateServiceAccountRequest = {
   armored_pgp_public_key?: string
   use_user_role?: boolean
   role?: string
+  cluster?: string
 }
 
 export type CreateServiceAccountResponse = {
@@ -76,12 +107,41 @@ export type ListServiceAccountsResponseServiceAccount = {
   name?: string
   pgp_public_keys?: ListServiceAccountsResponseServiceAccountPgpPublicKey[]
   role?: string
+  cluster?: string
 }
 
 export type ListServiceAccountsResponse = {
   service_accounts?: ListServiceAccountsResponseServiceAccount[]
 }
 
+export type ListUserSessionsRequest = {
+  identity?: string
+}
+
+export type ListUserSessionsResponseSession = {
+  public_key_id?: string
+  identity?: string
+  role?: string
+  created?: GoogleProtobufTimestamp.Timestamp
+  expiration?: GoogleProtobufTimestamp.Timestamp
+  last_used?: GoogleProtobufTimestamp.Timestamp
+  client_info?: string
+  confirmed?: boolean
+}
+
+export type ListUserSessionsResponse = {
+  sessions?: ListUserSessionsResponseSession[]
+}
+
+export type RevokeUserSessionRequest = {
+  public_key_id?: string
+  identity?: string
+}
+
+export type RevokeUserSessionResponse = {
+  revoked_public_key_ids?: string[]
+}
+
 export type KubeconfigRequest = {
   service_account?: boolean
   service_account_ttl?: GoogleProtobufDuration.Duration
@@ -143,6 +203,233 @@ export type GetSupportBundleResponse = {
   bundle_data?: Uint8Array
 }
 
+export type MoveMachineRequest = {
+  machine_id?: string
+  target_cluster?: string
+  target_machine_set?: string
+}
+
+export type GetCapabilitiesResponseLimits = {
+  max_message_size?: string
+  max_list_page_size?: string
+}
+
+export type GetCapabilitiesResponseDeprecation = {
+  api?: string
+  message?: string
+  removal_version?: string
+}
+
+export type GetCapabilitiesResponse = {
+  version?: string
+  features?: string[]
+  limits?: GetCapabilitiesResponseLimits
+  deprecations?: GetCapabilitiesResponseDeprecation[]
+}
+
+export type PayloadSample = {
+  method?: string
+  identity?: string
+  time?: GoogleProtobufTimestamp.Timestamp
+  duration?: GoogleProtobufDuration.Duration
+  requests?: string[]
+  responses?: string[]
+  code?: number
+  error?: string
+  truncated?: boolean
+}
+
+export type GetPayloadSamplesRequest = {
+  method?: string
+}
+
+export type GetPayloadSamplesResponse = {
+  samples?: PayloadSample[]
+}
+
+export type GetClusterAvailabilityRequest = {
+  cluster?: string
+  days?: number
+}
+
+export type GetClusterAvailabilityResponseDay = {
+  date?: GoogleProtobufTimestamp.Timestamp
+  control_plane_reachable?: number
+  all_machines_connected?: number
+}
+
+export type GetClusterAvailabilityResponse = {
+  heartbeats?: string
+  control_plane_reachable?: number
+  all_machines_connected?: number
+  days?: GetClusterAvailabilityResponseDay[]
+}
+
+export type GetClusterStatusHistoryRequest = {
+  cluster?: string
+  range?: GetClusterStatusHistoryRequestRange
+}
+
+export type GetClusterStatusHistoryResponsePoint = {
+  timestamp?: GoogleProtobufTimestamp.Timestamp
+  phase?: string
+  machines_total?: number
+  machines_connected?: number
+  machines_healthy?: number
+  nodes_total?: number
+  nodes_ready?: number
+}
+
+export type GetClusterStatusHistoryResponse = {
+  points?: GetClusterStatusHistoryResponsePoint[]
+}
+
+export type WatchUpgradeProgressRequest = {
+  cluster?: string
+}
+
+export type WatchUpgradeProgressResponseUpgrade = {
+  phase?: string
+  step?: string
+  status?: string
+  error?: string
+  target_version?: string
+  last_upgrade_version?: string
+  queue_position?: number
+}
+
+export type WatchUpgradeProgressResponseMachine = {
+  id?: string
+  nodename?: string
+  control_plane?: boolean
+  phase?: WatchUpgradeProgressResponseMachinePhase
+  version?: string
+  error?: string
+}
+
+export type WatchUpgradeProgressResponse = {
+  talos?: WatchUpgradeProgressResponseUpgrade
+  kubernetes?: WatchUpgradeProgressResponseUpgrade
+  talos_machines?: WatchUpgradeProgressResponseMachine[]
+  kubernetes_machines?: WatchUpgradeProgressResponseMachine[]
+}
+
+export type ValidateConfigPatchRequest = {
+  data?: string
+  machine?: string
+  machine_set?: string
+}
+
+export type ValidateConfigPatchResponseResult = {
+  machine?: string
+  diff?: string
+  warnings?: string[]
+  error?: string
+}
+
+export type ValidateConfigPatchResponse = {
+  results?: ValidateConfigPatchResponseResult[]
+}
+
+export type GetMachineUserDataRequest = {
+  talos_version?: string
+}
+
+export type GetMachineUserDataResponse = {
+  user_data?: string
+}
+
+export type GetJoinArtifactsRequest = {
+  join_token?: string
+  talos_version?: string
+  arch?: string
+  secure_boot?: boolean
+}
+
+export type GetJoinArtifactsResponse = {
+  kernel_args?: string[]
+  iso_url?: string
+  schematic_id?: string
+  config?: string
+}
+
+export type GetConfigReloadStatusResponse = {
+  config_path?: string
+  reload_time?: GoogleProtobufTimestamp.Timestamp
+  applied?: string[]
+  restart_required?: string[]
+  error?: string
+}
+
+export type ValidateResourceRequest = {
+  resource?: string
+}
+
+export type ValidateResourceResponseFieldChange = {
+  path?: string
+  old?: string
+  new?: string
+}
+
+export type ValidateResourceResponse = {
+  exists?: boolean
+  changes?: ValidateResourceResponseFieldChange[]
+}
+
+export type CreateBreakGlassTokenRequest = {
+  secret?: string
+  totp_code?: string
+  reason?: string
+  armored_pgp_public_key?: string
+  role?: string
+}
+
+export type CreateBreakGlassTokenResponse = {
+  public_key_id?: string
+  identity?: string
+  expiration?: GoogleProtobufTimestamp.Timestamp
+}
+
+export type UpdateMachineLabelsRequest = {
+  selectors?: string[]
+  set?: {[key: string]: string}
+  remove?: string[]
+}
+
+export type UpdateMachineLabelsResponseResult = {
+  machine_id?: string
+  updated?: boolean
+  error?: string
+}
+
+export type UpdateMachineLabelsResponse = {
+  results?: UpdateMachineLabelsResponseResult[]
+}
+
+export type BackupStateRequest = {
+}
+
+export type BackupStateResponse = {
+  data?: Uint8Array
+}
+
+export type RestoreStateRequest = {
+  data?: Uint8Array
+  conflict_resolution?: RestoreStateRequestConflictResolution
+  dry_run?: boolean
+}
+
+export type RestoreStateResponseResult = {
+  type?: string
+  id?: string
+  action?: RestoreStateResponseAction
+  error?: string
+}
+
+export type RestoreStateResponse = {
+  results?: RestoreStateResponseResult[]
+}
+
 export class ManagementService {
   static Kubeconfig(req: KubeconfigRequest, ...options: fm.fetchOption[]): Promise<KubeconfigResponse> {
     return fm.fetchReq<KubeconfigRequest, KubeconfigResponse>("POST", `/management.ManagementService/Kubeconfig`, req, ...options)
@@ -171,6 +458,12 @@ export class ManagementService {
   static DestroyServiceAccount(req: DestroyServiceAccountRequest, ...options: fm.fetchOption[]): Promise<GoogleProtobufEmpty.Empty> {
     return fm.fetchReq<DestroyServiceAccountRequest, GoogleProtobufEmpty.Empty>("POST", `/management.ManagementService/DestroyServiceAccount`, req, ...options)
   }
+  static ListUserSessions(req: ListUserSessionsRequest, ...options: fm.fetchOption[]): Promise<ListUserSessionsResponse> {
+    return fm.fetchReq<ListUserSessionsRequest, ListUserSessionsResponse>("POST", `/management.ManagementService/ListUserSessions`, req, ...options)
+  }
+  static RevokeUserSession(req: RevokeUserSessionRequest, ...options: fm.fetchOption[]): Promise<RevokeUserSessionResponse> {
+    return fm.fetchReq<RevokeUserSessionRequest, RevokeUserSessionResponse>("POST", `/management.ManagementService/RevokeUserSession`, req, ...options)
+  }
   static KubernetesUpgradePreChecks(req: KubernetesUpgradePreChecksRequest, ...options: fm.fetchOption[]): Promise<KubernetesUpgradePreChecksResponse> {
     return fm.fetchReq<KubernetesUpgradePreChecksRequest, KubernetesUpgradePreChecksResponse>("POST", `/management.ManagementService/KubernetesUpgradePreChecks`, req, ...options)
   }
@@ -183,4 +476,46 @@ export class ManagementService {
   static GetSupportBundle(req: GetSupportBundleRequest, entityNotifier?: fm.NotifyStreamEntityArrival<GetSupportBundleResponse>, ...options: fm.fetchOption[]): Promise<void> {
     return fm.fetchStreamingRequest<GetSupportBundleRequest, GetSupportBundleResponse>("POST", `/management.ManagementService/GetSupportBundle`, req, entityNotifier, ...options)
   }
+  static MoveMachine(req: MoveMachineRequest, ...options: fm.fetchOption[]): Promise<GoogleProtobufEmpty.Empty> {
+    return fm.fetchReq<MoveMachineRequest, GoogleProtobufEmpty.Empty>("POST", `/management.ManagementService/MoveMachine`, req, ...options)
+  }
+  static GetCapabilities(req: GoogleProtobufEmpty.Empty, ...options: fm.fetchOption[]): Promise<GetCapabilitiesResponse> {
+    return fm.fetchReq<GoogleProtobufEmpty.Empty, GetCapabilitiesResponse>("POST", `/management.ManagementService/GetCapabilities`, req, ...options)
+  }
+  static GetPayloadSamples(req: GetPayloadSamplesRequest, ...options: fm.fetchOption[]): Promise<GetPayloadSamplesResponse> {
+    return fm.fetchReq<GetPayloadSamplesRequest, GetPayloadSamplesResponse>("POST", `/management.ManagementService/GetPayloadSamples`, req, ...options)
+  }
+  static WatchUpgradeProgress(req: WatchUpgradeProgressRequest, entityNotifier?: fm.NotifyStreamEntityArrival<WatchUpgradeProgressResponse>, ...options: fm.fetchOption[]): Promise<void> {
+    return fm.fetchStreamingRequest<WatchUpgradeProgressRequest, WatchUpgradeProgressResponse>("POST", `/management.ManagementService/WatchUpgradeProgress`, req, entityNotifier, ...options)
+  }
+  static GetClusterAvailability(req: GetClusterAvailabilityRequest, ...options: fm.fetchOption[]): Promise<GetClusterAvailabilityResponse> {
+    return fm.fetchReq<GetClusterAvailabilityRequest, GetClusterAvailabilityResponse>("POST", `/management.ManagementService/GetClusterAvailability`, req, ...options)
+  }
+  static GetClusterStatusHistory(req: GetClusterStatusHistoryRequest, ...options: fm.fetchOption[]): Promise<GetClusterStatusHistoryResponse> {
+    return fm.fetchReq<GetClusterStatusHistoryRequest, GetClusterStatusHistoryResponse>("POST", `/management.ManagementService/GetClusterStatusHistory`, req, ...options)
+  }
+  static ValidateConfigPatch(req: ValidateConfigPatchRequest, ...options: fm.fetchOption[]): Promise<ValidateConfigPatchResponse> {
+    return fm.fetchReq<ValidateConfigPatchRequest, ValidateConfigPatchResponse>("POST", `/management.ManagementService/ValidateConfigPatch`, req, ...options)
+  }
+  static GetMachineUserData(req: GetMachineUserDataRequest, ...options: fm.fetchOption[]): Promise<GetMachineUserDataResponse> {
+    return fm.fetchReq<GetMachineUserDataRequest, GetMachineUserDataResponse>("POST", `/management.ManagementService/GetMachineUserData`, req, ...options)
+  }
+  static GetJoinArtifacts(req: GetJoinArtifactsRequest, ...options: fm.fetchOption[]): Promise<GetJoinArtifactsResponse> {
+    return fm.fetchReq<GetJoinArtifactsRequest, GetJoinArtifactsResponse>("POST", `/management.ManagementService/GetJoinArtifacts`, req, ...options)
+  }
+  static GetConfigReloadStatus(req: GoogleProtobufEmpty.Empty, ...options: fm.fetchOption[]): Promise<GetConfigReloadStatusResponse> {
+    return fm.fetchReq<GoogleProtobufEmpty.Empty, GetConfigReloadStatusResponse>("POST", `/management.ManagementService/GetConfigReloadStatus`, req, ...options)
+  }
+  static ValidateResource(req: ValidateResourceRequest, ...options: fm.fetchOption[]): Promise<ValidateResourceResponse> {
+    return fm.fetchReq<ValidateResourceRequest, ValidateResourceResponse>("POST", `/management.ManagementService/ValidateResource`, req, ...options)
+  }
+  static CreateBreakGlassToken(req: CreateBreakGlassTokenRequest, ...options: fm.fetchOption[]): Promise<CreateBreakGlassTokenResponse> {
+    return fm.fetchReq<CreateBreakGlassTokenRequest, CreateBreakGlassTokenResponse>("POST", `/management.ManagementService/CreateBreakGlassToken`, req, ...options)
+  }
+  static UpdateMachineLabels(req: UpdateMachineLabelsRequest, ...options: fm.fetchOption[]): Promise<UpdateMachineLabelsResponse> {
+    return fm.fetchReq<UpdateMachineLabelsRequest, UpdateMachineLabelsResponse>("POST", `/management.ManagementService/UpdateMachineLabels`, req, ...options)
+  }
+  static BackupState(req: BackupStateRequest, entityNotifier?: fm.NotifyStreamEntityArrival<BackupStateResponse>, ...options: fm.fetchOption[]): Promise<void> {
+    return fm.fetchStreamingRequest<BackupStateRequest, BackupStateResponse>("POST", `/management.ManagementService/BackupState`, req, entityNotifier, ...options)
+  }
 }
\ No newline at end of file
@@ -89,6 +89,7 @@ export type DeleteRequest = {
   namespace?: string
   type?: string
   id?: string
+  reason?: string
 }
 
 export type DeleteResponse = {
@@ -24,11 +24,16 @@ export type AuthConfigSpecSAML = {
   label_rules?: {[key: string]: string}
 }
 
+export type AuthConfigSpecStatic = {
+  enabled?: boolean
+}
+
 export type AuthConfigSpec = {
   auth0?: AuthConfigSpecAuth0
   webauthn?: AuthConfigSpecWebauthn
   suspended?: boolean
   saml?: AuthConfigSpecSAML
+  static?: AuthConfigSpecStatic
 }
 
 export type SAMLAssertionSpec = {
@@ -57,6 +62,9 @@ export type PublicKeySpec = {
   confirmed?: boolean
   identity?: Identity
   role?: string
+  last_used?: GoogleProtobufTimestamp.Timestamp
+  client_info?: string
+  mfa_verified?: boolean
 }
 
 export type AccessPolicyUserGroupUser = {
@@ -132,4 +140,12 @@ export type AccessPolicySpec = {
 export type SAMLLabelRuleSpec = {
   match_labels?: string[]
   assign_role_on_registration?: string
+}
+
+export type BreakGlassTokenSpec = {
+  identity?: string
+  role?: string
+  reason?: string
+  expiration?: GoogleProtobufTimestamp.Timestamp
+  client_address?: string
 }
\ No newline at end of file
@@ -74,6 +74,13 @@ export enum ClusterStatusSpecPhase {
   DESTROYING = 4,
 }
 
+export enum ClusterSecretsSpecTalosSecretsRotationStage {
+  None = 0,
+  PreRotate = 1,
+  Rotate = 2,
+  PostRotate = 3,
+}
+
 export enum MachineSetSpecUpdateStrategy {
   Unset = 0,
   Rolling = 1,
@@ -84,6 +91,11 @@ export enum MachineSetSpecMachineClassAllocationType {
   Unlimited = 1,
 }
 
+export enum MachineSetSpecInstallDiskPolicyPrefer {
+  Smallest = 0,
+  Largest = 1,
+}
+
 export enum TalosUpgradeStatusSpecPhase {
   Unknown = 0,
   Upgrading = 1,
@@ -92,6 +104,13 @@ export enum TalosUpgradeStatusSpecPhase {
   Reverting = 4,
 }
 
+export enum MachineSetScalingHistorySpecInitiator {
+  UNKNOWN = 0,
+  USER = 1,
+  AUTOSCALER = 2,
+  SELF_HEAL = 3,
+}
+
 export enum ControlPlaneStatusSpecConditionStatus {
   Unknown = 0,
   Ready = 1,
@@ -112,6 +131,12 @@ export enum KubernetesUpgradeStatusSpecPhase {
   Reverting = 4,
 }
 
+export enum ExposedServiceSpecHealthStatus {
+  UNKNOWN = 0,
+  HEALTHY = 1,
+  UNHEALTHY = 2,
+}
+
 export enum ExtensionsConfigurationStatusSpecPhase {
   Unknown = 0,
   Ready = 1,
@@ -124,6 +149,68 @@ export enum MachineExtensionsStatusSpecItemPhase {
   Removing = 2,
 }
 
+export enum MachineMoveStatusSpecPhase {
+  Pending = 0,
+  Removing = 1,
+  Resetting = 2,
+  Joining = 3,
+  Done = 4,
+  Failed = 5,
+}
+
+export enum TemplateSyncStatusSpecPhase {
+  Unknown = 0,
+  Synced = 1,
+  Failed = 2,
+}
+
+export enum DiscoveryKeyRotationStatusSpecPhase {
+  Generating = 0,
+  Publishing = 1,
+  Rolling = 2,
+  Retiring = 3,
+  Done = 4,
+}
+
+export enum TalosSecretsRotationStatusSpecPhase {
+  Pending = 0,
+  PreRotate = 1,
+  Rotate = 2,
+  PostRotate = 3,
+  Done = 4,
+  Failed = 5,
+}
+
+export enum SchematicDriftStatusSpecPhase {
+  InSync = 0,
+  Pending = 1,
+  Drifted = 2,
+  Remediating = 3,
+}
+
+export enum NotificationConfigSpecEvent {
+  Unknown = 0,
+  ClusterCreated = 1,
+  ClusterDestroyed = 2,
+  MachineJoined = 3,
+  MachineLeft = 4,
+  UpgradeStarted = 5,
+  UpgradeFinished = 6,
+  EtcdBackupFailed = 7,
+}
+
+export enum NotificationConfigSpecFormat {
+  Generic = 0,
+  Slack = 1,
+}
+
+export enum MachineWipeStatusSpecState {
+  PENDING = 0,
+  VERIFIED = 1,
+  FAILED = 2,
+  UNSUPPORTED = 3,
+}
+
 export type MachineSpec = {
   management_address?: string
   connected?: boolean
@@ -229,6 +316,37 @@ export type MachineStatusSpec = {
   schematic?: MachineStatusSpecSchematic
   initial_talos_version?: string
   secure_boot_status?: SecureBootStatus
+  boot_id?: string
+}
+
+export type MachineHardwareInventorySpecPCIDevice = {
+  address?: string
+  vendor_id?: string
+  product_id?: string
+  class?: string
+  vendor?: string
+  numa_node?: number
+}
+
+export type MachineHardwareInventorySpecNVMeDrive = {
+  name?: string
+  model?: string
+  serial?: string
+  firmware_version?: string
+  address?: string
+}
+
+export type MachineHardwareInventorySpecNUMANode = {
+  id?: number
+  cpus?: string
+  memory_bytes?: string
+}
+
+export type MachineHardwareInventorySpec = {
+  pci_devices?: MachineHardwareInventorySpecPCIDevice[]
+  gpus?: MachineHardwareInventorySpecPCIDevice[]
+  nvme_drives?: MachineHardwareInventorySpecNVMeDrive[]
+  numa_nodes?: MachineHardwareInventorySpecNUMANode[]
 }
 
 export type TalosConfigSpec = {
@@ -249,6 +367,7 @@ export type ClusterSpec = {
   talos_version?: string
   features?: ClusterSpecFeatures
   backup_configuration?: EtcdBackupConf
+  ttl?: GoogleProtobufDuration.Duration
 }
 
 export type ClusterTaintSpec = {
@@ -290,6 +409,28 @@ export type EtcdBackupS3ConfSpec = {
   session_token?: string
 }
 
+export type EtcdBackupStorageConfigSpecGCS = {
+  bucket?: string
+  access_key_id?: string
+  secret_access_key?: string
+}
+
+export type EtcdBackupStorageConfigSpecAzure = {
+  container_url?: string
+  sas_token?: string
+}
+
+export type EtcdBackupStorageConfigSpecLocal = {
+  path?: string
+}
+
+export type EtcdBackupStorageConfigSpec = {
+  s3?: EtcdBackupS3ConfSpec
+  gcs?: EtcdBackupStorageConfigSpecGCS
+  azure?: EtcdBackupStorageConfigSpecAzure
+  local?: EtcdBackupStorageConfigSpecLocal
+}
+
 export type EtcdBackupStatusSpec = {
   status?: EtcdBackupStatusSpecStatus
   error?: string
@@ -318,6 +459,11 @@ export type ClusterMachineSpec = {
 
 export type ClusterMachineConfigPatchesSpec = {
   patches?: string[]
+  blob_ids?: string[]
+}
+
+export type ConfigPatchBlobSpec = {
+  compressed_data?: Uint8Array
 }
 
 export type ClusterMachineTalosVersionSpec = {
@@ -335,6 +481,13 @@ export type RedactedClusterMachineConfigSpec = {
   data?: string
 }
 
+export type ClusterMachineConfigBackupSpec = {
+  data?: string
+  reason?: string
+  talos_version?: string
+  schematic_id?: string
+}
+
 export type ClusterMachineIdentitySpec = {
   node_identity?: string
   etcd_member_id?: string
@@ -376,6 +529,34 @@ export type ClusterStatusSpec = {
   controlplaneReady?: boolean
   has_connected_control_planes?: boolean
   use_embedded_discovery_service?: boolean
+  held_machines?: string[]
+  expires_at?: GoogleProtobufTimestamp.Timestamp
+}
+
+export type ClusterAvailabilitySpecDay = {
+  date?: GoogleProtobufTimestamp.Timestamp
+  heartbeats?: number
+  control_plane_reachable?: number
+  all_machines_connected?: number
+}
+
+export type ClusterAvailabilitySpec = {
+  days?: ClusterAvailabilitySpecDay[]
+}
+
+export type ClusterStatusHistorySpecSample = {
+  timestamp?: GoogleProtobufTimestamp.Timestamp
+  phase?: ClusterStatusSpecPhase
+  machines_total?: number
+  machines_connected?: number
+  machines_healthy?: number
+  nodes_total?: number
+  nodes_ready?: number
+}
+
+export type ClusterStatusHistorySpec = {
+  recent?: ClusterStatusHistorySpecSample[]
+  hourly?: ClusterStatusHistorySpecSample[]
 }
 
 export type ClusterUUID = {
@@ -399,8 +580,19 @@ export type ClusterBootstrapStatusSpec = {
   bootstrapped?: boolean
 }
 
+export type ClusterSecretsSpecTalosSecretsRotation = {
+  stage?: ClusterSecretsSpecTalosSecretsRotationStage
+  requested_at?: GoogleProtobufTimestamp.Timestamp
+  extra_ca_crt?: Uint8Array
+  extra_ca_key?: Uint8Array
+  previous_trustd_token?: string
+}
+
 export type ClusterSecretsSpec = {
   data?: Uint8Array
+  previous_discovery_key?: string
+  discovery_key_rotation_requested_at?: GoogleProtobufTimestamp.Timestamp
+  talos_secrets_rotation?: ClusterSecretsSpecTalosSecretsRotation
 }
 
 export type LoadBalancerConfigSpec = {
@@ -452,12 +644,40 @@ export type MachineSetSpecBootstrapSpec = {
 
 export type MachineSetSpecRollingUpdateStrategyConfig = {
   max_parallelism?: number
+  max_unavailable?: number
+  wait_for_healthy_timeout?: GoogleProtobufDuration.Duration
 }
 
 export type MachineSetSpecUpdateStrategyConfig = {
   rolling?: MachineSetSpecRollingUpdateStrategyConfig
 }
 
+export type MachineSetSpecInstallDiskPolicy = {
+  min_size?: string
+  max_size?: string
+  types?: string[]
+  bus_path_regex?: string
+  model_regex?: string
+  prefer?: MachineSetSpecInstallDiskPolicyPrefer
+}
+
+export type MachineSetSpecUserVolume = {
+  disk?: string
+  mount_point?: string
+  size?: string
+}
+
+export type MachineSetSpecAutoscaling = {
+  min_size?: number
+  max_size?: number
+}
+
+export type MachineSetSpecReadinessGates = {
+  extensions?: string[]
+  disk_encryption?: boolean
+  probes?: string[]
+}
+
 export type MachineSetSpec = {
   update_strategy?: MachineSetSpecUpdateStrategy
   machine_class?: MachineSetSpecMachineClass
@@ -465,6 +685,10 @@ export type MachineSetSpec = {
   delete_strategy?: MachineSetSpecUpdateStrategy
   update_strategy_config?: MachineSetSpecUpdateStrategyConfig
   delete_strategy_config?: MachineSetSpecUpdateStrategyConfig
+  install_disk_policy?: MachineSetSpecInstallDiskPolicy
+  user_volumes?: MachineSetSpecUserVolume[]
+  autoscaling?: MachineSetSpecAutoscaling
+  readiness_gates?: MachineSetSpecReadinessGates
 }
 
 export type TalosUpgradeStatusSpec = {
@@ -475,6 +699,9 @@ export type TalosUpgradeStatusSpec = {
   last_upgrade_version?: string
   current_upgrade_version?: string
   upgrade_versions?: string[]
+  queue_position?: number
+  held_machines?: string[]
+  next_maintenance_window?: GoogleProtobufTimestamp.Timestamp
 }
 
 export type MachineSetStatusSpec = {
@@ -487,6 +714,19 @@ export type MachineSetStatusSpec = {
   locked_updates?: number
 }
 
+export type MachineSetScalingHistorySpecEvent = {
+  timestamp?: GoogleProtobufTimestamp.Timestamp
+  old_count?: number
+  new_count?: number
+  initiator?: MachineSetScalingHistorySpecInitiator
+  identity?: string
+}
+
+export type MachineSetScalingHistorySpec = {
+  events?: MachineSetScalingHistorySpecEvent[]
+  requested_machines?: number
+}
+
 export type MachineSetNodeSpec = {
 }
 
@@ -497,6 +737,17 @@ export type MachineStatusSnapshotSpec = {
   machine_status?: MachineMachine.MachineStatusEvent
 }
 
+export type MachineBootHistorySpecBoot = {
+  boot_id?: string
+  detected_at?: GoogleProtobufTimestamp.Timestamp
+}
+
+export type MachineBootHistorySpec = {
+  boots?: MachineBootHistorySpecBoot[]
+  crash_loop?: boolean
+  crash_loop_since?: GoogleProtobufTimestamp.Timestamp
+}
+
 export type ControlPlaneStatusSpecCondition = {
   type?: ConditionType
   reason?: string
@@ -516,6 +767,9 @@ export type KubernetesStatusSpecNodeStatus = {
   nodename?: string
   kubelet_version?: string
   ready?: boolean
+  container_runtime_version?: string
+  os_image?: string
+  kernel_version?: string
 }
 
 export type KubernetesStatusSpecStaticPodStatus = {
@@ -542,6 +796,9 @@ export type KubernetesUpgradeStatusSpec = {
   last_upgrade_version?: string
   current_upgrade_version?: string
   upgrade_versions?: string[]
+  queue_position?: number
+  held_machines?: string[]
+  next_maintenance_window?: GoogleProtobufTimestamp.Timestamp
 }
 
 export type KubernetesUpgradeManifestStatusSpec = {
@@ -570,6 +827,19 @@ export type ExposedServiceSpec = {
   label?: string
   icon_base64?: string
   url?: string
+  custom_domain?: string
+  health_check_path?: string
+  health_check_interval?: GoogleProtobufDuration.Duration
+  health_status?: ExposedServiceSpecHealthStatus
+  health_error?: string
+  sticky_sessions?: boolean
+}
+
+export type ExposedServiceAccessPolicySpec = {
+  identities?: string[]
+  min_role?: string
+  allowed_cidrs?: string[]
+  require_mfa?: boolean
 }
 
 export type ClusterWorkloadProxyStatusSpec = {
@@ -707,4 +977,164 @@ export type ClusterKubernetesNodesSpec = {
 
 export type KubernetesNodeAuditResultSpec = {
   deleted_nodes?: string[]
+}
+
+export type MachineMoveRequestSpec = {
+  target_cluster?: string
+  target_machine_set?: string
+}
+
+export type MachineMoveStatusSpec = {
+  phase?: MachineMoveStatusSpecPhase
+  source_cluster?: string
+  target_cluster?: string
+  target_machine_set?: string
+  error?: string
+}
+
+export type TemplateSyncStatusSpec = {
+  phase?: TemplateSyncStatusSpecPhase
+  path?: string
+  commit?: string
+  cluster?: string
+  error?: string
+  last_sync_time?: GoogleProtobufTimestamp.Timestamp
+}
+
+export type DiscoveryAffiliateSpec = {
+  hostname?: string
+  nodename?: string
+  machine_type?: string
+  operating_system?: string
+  addresses?: string[]
+  endpoints?: string[]
+  kubespan_public_key?: string
+  control_plane?: boolean
+  decrypted?: boolean
+}
+
+export type DiscoveryKeyRotationSpec = {
+  requested_at?: GoogleProtobufTimestamp.Timestamp
+}
+
+export type DiscoveryKeyRotationStatusSpec = {
+  phase?: DiscoveryKeyRotationStatusSpecPhase
+  requested_at?: GoogleProtobufTimestamp.Timestamp
+  updated_machines?: number
+  total_machines?: number
+}
+
+export type LogLevelConfigSpec = {
+  levels?: {[key: string]: string}
+}
+
+export type RuntimeConfigurationSpec = {
+  machine_teardown_timeout?: GoogleProtobufDuration.Duration
+  etcd_member_remove_timeout?: GoogleProtobufDuration.Duration
+  kubernetes_node_delete_timeout?: GoogleProtobufDuration.Duration
+  machine_set_status_poll_interval?: GoogleProtobufDuration.Duration
+  upgrade_queue_poll_interval?: GoogleProtobufDuration.Duration
+}
+
+export type SettingsSpec = {
+  default_talos_version?: string
+  default_kubernetes_version?: string
+  etcd_backup_min_interval?: GoogleProtobufDuration.Duration
+  etcd_backup_max_interval?: GoogleProtobufDuration.Duration
+  public_key_max_lifetimes?: {[key: string]: GoogleProtobufDuration.Duration}
+}
+
+export type TalosSecretsRotationSpec = {
+  requested_at?: GoogleProtobufTimestamp.Timestamp
+}
+
+export type TalosSecretsRotationStatusSpec = {
+  phase?: TalosSecretsRotationStatusSpecPhase
+  requested_at?: GoogleProtobufTimestamp.Timestamp
+  updated_machines?: number
+  total_machines?: number
+  cluster_ready?: boolean
+  error?: string
+}
+
+export type ClusterNodeVersionsSpecNode = {
+  machine_id?: string
+  nodename?: string
+  talos_version?: string
+  kubernetes_version?: string
+  kubelet_version?: string
+  container_runtime_version?: string
+  os_image?: string
+  kernel_version?: string
+}
+
+export type ClusterNodeVersionsSpec = {
+  nodes?: ClusterNodeVersionsSpecNode[]
+  talos_versions?: string[]
+  kubelet_versions?: string[]
+  container_runtime_versions?: string[]
+  version_drift?: boolean
+}
+
+export type DefaultExtensionsSpec = {
+  extensions?: string[]
+}
+
+export type MachineSetDefaultExtensionsSpec = {
+  extensions?: string[]
+}
+
+export type SchematicDriftStatusSpec = {
+  phase?: SchematicDriftStatusSpecPhase
+  desired_schematic_id?: string
+  actual_schematic_id?: string
+  drifted_since?: GoogleProtobufTimestamp.Timestamp
+}
+
+export type KernelArgsConfigurationSpec = {
+  add?: string[]
+  remove?: string[]
+}
+
+export type QuotaSpec = {
+  identities?: string[]
+  max_clusters?: number
+  max_machines?: number
+}
+
+export type NotificationConfigSpec = {
+  url?: string
+  events?: NotificationConfigSpecEvent[]
+  format?: NotificationConfigSpecFormat
+  template?: string
+  headers?: {[key: string]: string}
+}
+
+export type MaintenanceWindowSpec = {
+  schedule?: string
+  duration?: GoogleProtobufDuration.Duration
+  timezone?: string
+}
+
+export type MachineLinkStatusSpec = {
+  connected?: boolean
+  last_handshake?: GoogleProtobufTimestamp.Timestamp
+  endpoint?: string
+  bytes_received?: string
+  bytes_sent?: string
+  receive_rate?: number
+  send_rate?: number
+  keepalive_interval?: GoogleProtobufDuration.Duration
+  keepalive_latency?: GoogleProtobufDuration.Duration
+  flaps?: number
+  last_flap?: GoogleProtobufTimestamp.Timestamp
+  sampled_at?: GoogleProtobufTimestamp.Timestamp
+}
+
+export type MachineWipeStatusSpec = {
+  state?: MachineWipeStatusSpecState
+  message?: string
+  cluster?: string
+  verified_at?: GoogleProtobufTimestamp.Timestamp
+  partitions?: string[]
 }
\ No newline at end of file
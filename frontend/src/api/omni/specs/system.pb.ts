@@ -11,6 +11,7 @@ export type SysVersionSpec = {
   backend_version?: string
   instance_name?: string
   backend_api_version?: number
+  backend_min_api_version?: number
 }
 
 export type CertRefreshTickSpec = {
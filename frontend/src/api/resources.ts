@@ -24,9 +24,14 @@ export const DefaultKubernetesVersion = "1.30.1";
 export const ServiceLabelAnnotationKey = "omni-kube-service-exposer.sidero.dev/label";
 export const ServicePortAnnotationKey = "omni-kube-service-exposer.sidero.dev/port";
 export const ServiceIconAnnotationKey = "omni-kube-service-exposer.sidero.dev/icon";
+export const ServiceCustomDomainAnnotationKey = "omni-kube-service-exposer.sidero.dev/custom-domain";
+export const ServiceHealthCheckPathAnnotationKey = "omni-kube-service-exposer.sidero.dev/health-check-path";
+export const ServiceHealthCheckIntervalAnnotationKey = "omni-kube-service-exposer.sidero.dev/health-check-interval";
+export const ServiceStickySessionsAnnotationKey = "omni-kube-service-exposer.sidero.dev/sticky-sessions";
 export const installDiskMinSize = 5e+09;
 export const workloadProxyPublicKeyIdCookie = "publicKeyId";
 export const workloadProxyPublicKeyIdSignatureBase64Cookie = "publicKeyIdSignatureBase64";
+export const workloadProxyCustomDomainAuthPath = "/_omni/workload-proxy-auth";
 export const authPublicKeyIDQueryParam = "public-key-id";
 export const DefaultNamespace = "default";
 export const EphemeralNamespace = "ephemeral";
@@ -34,31 +39,42 @@ export const MetricsNamespace = "metrics";
 export const VirtualNamespace = "virtual";
 export const ExternalNamespace = "external";
 export const AccessPolicyType = "AccessPolicies.omni.sidero.dev";
+export const BreakGlassTokenType = "BreakGlassTokens.omni.sidero.dev";
 export const AuthConfigID = "auth-config";
 export const AuthConfigType = "AuthConfigs.omni.sidero.dev";
 export const IdentityType = "Identities.omni.sidero.dev";
 export const SAMLLabelPrefix = "saml.omni.sidero.dev/";
 export const LabelIdentityUserID = "user-id";
 export const LabelIdentityTypeServiceAccount = "type-service-account";
+export const LabelServiceAccountCluster = "service-account-cluster";
 export const PublicKeyType = "PublicKeys.omni.sidero.dev";
 export const SAMLLabelRuleType = "SAMLLabelRules.omni.sidero.dev";
 export const UserType = "Users.omni.sidero.dev";
 export const KubernetesResourceType = "KubernetesResources.omni.sidero.dev";
 export const MachineLocked = "omni.sidero.dev/locked";
+export const MachineUpgradeHold = "omni.sidero.dev/upgrade-hold";
+export const ClusterPaused = "omni.sidero.dev/paused";
+export const ScaleDownMachines = "omni.sidero.dev/scale-down-machines";
 export const UpdateLocked = "omni.sidero.dev/locked-update";
 export const ResourceManagedByClusterTemplates = "omni.sidero.dev/managed-by-cluster-templates";
+export const CreatedBy = "omni.sidero.dev/created-by";
+export const UpdatedBy = "omni.sidero.dev/updated-by";
+export const UpdatedAt = "omni.sidero.dev/updated-at";
+export const DestroyReason = "omni.sidero.dev/destroy-reason";
 export const ConfigPatchName = "name";
 export const ConfigPatchDescription = "description";
 export const EtcdBackupS3ConfID = "etcd-backup-s3-conf";
 export const EtcdBackupS3ConfType = "EtcdBackupS3Configs.omni.sidero.dev";
 export const BackupDataType = "BackupDatas.omni.sidero.dev";
 export const ClusterType = "Clusters.omni.sidero.dev";
+export const ClusterAvailabilityType = "ClusterAvailabilities.omni.sidero.dev";
 export const ClusterBootstrapStatusType = "ClusterBootstrapStatuses.omni.sidero.dev";
 export const ClusterConfigVersionType = "ClusterConfigVersions.omni.sidero.dev";
 export const ClusterDestroyStatusType = "ClusterDestroyStatuses.omni.sidero.dev";
 export const ClusterEndpointType = "ClusterEndpoints.omni.sidero.dev";
 export const ClusterMachineType = "ClusterMachines.omni.sidero.dev";
 export const ClusterMachineConfigType = "ClusterMachineConfigs.omni.sidero.dev";
+export const ClusterMachineConfigBackupType = "ClusterMachineConfigBackups.omni.sidero.dev";
 export const ClusterMachineConfigPatchesType = "ClusterMachineConfigPatches.omni.sidero.dev";
 export const ClusterMachineConfigStatusType = "ClusterMachineConfigStatuses.omni.sidero.dev";
 export const ClusterMachineEncryptionKeyType = "ClusterMachineEncryptionKeys.omni.sidero.dev";
@@ -66,12 +82,20 @@ export const ClusterMachineIdentityType = "ClusterMachineIdentities.omni.sidero.
 export const ClusterMachineStatusType = "ClusterMachineStatuses.omni.sidero.dev";
 export const ClusterMachineTalosVersionType = "ClusterMachineTalosVersions.omni.sidero.dev";
 export const ClusterMachineTemplateType = "ClusterMachineTemplates.omni.sidero.dev";
+export const ClusterNodeVersionsType = "ClusterNodeVersions.omni.sidero.dev";
 export const ClusterStatusType = "ClusterStatuses.omni.sidero.dev";
+export const ClusterStatusHistoryType = "ClusterStatusHistories.omni.sidero.dev";
 export const ClusterTaintType = "ClusterTaints.omni.sidero.dev";
 export const ClusterUUIDType = "ClusterUUIDs.omni.sidero.dev";
 export const ClusterWorkloadProxyStatusType = "ClusterWorkloadProxyStatuses.omni.sidero.dev";
 export const ConfigPatchType = "ConfigPatches.omni.sidero.dev";
+export const ConfigPatchBlobType = "ConfigPatchBlobs.omni.sidero.dev";
 export const ControlPlaneStatusType = "ControlPlaneStatuses.omni.sidero.dev";
+export const DefaultExtensionsID = "default-extensions";
+export const DefaultExtensionsType = "DefaultExtensions.omni.sidero.dev";
+export const DiscoveryAffiliateType = "DiscoveryAffiliates.omni.sidero.dev";
+export const DiscoveryKeyRotationType = "DiscoveryKeyRotations.omni.sidero.dev";
+export const DiscoveryKeyRotationStatusType = "DiscoveryKeyRotationStatuses.omni.sidero.dev";
 export const EtcdBackupType = "EtcdBackups.omni.sidero.dev";
 export const EtcdBackupStoreStatusID = "etcdbackup-store-status";
 export const EtcdBackupStoreStatusType = "EtcdBackupStoreStatuses.omni.sidero.dev";
@@ -79,8 +103,10 @@ export const EtcdBackupEncryptionType = "EtcdBackupEncryptions.omni.sidero.dev";
 export const EtcdBackupOverallStatusID = "etcdbackup-overall-status";
 export const EtcdBackupOverallStatusType = "EtcdBackupOverallStatuses.omni.sidero.dev";
 export const EtcdBackupStatusType = "EtcdBackupStatuses.omni.sidero.dev";
+export const EtcdBackupStorageConfigType = "EtcdBackupStorageConfigs.omni.sidero.dev";
 export const EtcdManualBackupType = "EtcdManualBackups.omni.sidero.dev";
 export const ExposedServiceType = "ExposedServices.omni.sidero.dev";
+export const ExposedServiceAccessPolicyType = "ExposedServiceAccessPolicies.omni.sidero.dev";
 export const ExtensionsConfigurationType = "ExtensionsConfigurations.omni.sidero.dev";
 export const ExtensionsConfigurationStatusType = "ExtensionsConfigurationStatuses.omni.sidero.dev";
 export const FeaturesConfigID = "features-config";
@@ -88,6 +114,7 @@ export const FeaturesConfigType = "FeaturesConfigs.omni.sidero.dev";
 export const ImagePullRequestType = "ImagePullRequests.omni.sidero.dev";
 export const ImagePullStatusType = "ImagePullStatuses.omni.sidero.dev";
 export const InstallationMediaType = "InstallationMedias.omni.sidero.dev";
+export const KernelArgsConfigurationType = "KernelArgsConfigurations.omni.sidero.dev";
 export const KubernetesStatusType = "KubernetesStatuses.omni.sidero.dev";
 export const KubernetesUpgradeManifestStatusType = "KubernetesUpgradeManifestStatuses.omni.sidero.dev";
 export const KubernetesUpgradeStatusType = "KubernetesUpgradeStatuses.omni.sidero.dev";
@@ -114,38 +141,63 @@ export const MachineStatusLabelCores = "omni.sidero.dev/cores";
 export const MachineStatusLabelMem = "omni.sidero.dev/mem";
 export const MachineStatusLabelStorage = "omni.sidero.dev/storage";
 export const MachineStatusLabelNet = "omni.sidero.dev/net";
+export const MachineStatusLabelGPUVendor = "omni.sidero.dev/gpu-vendor";
+export const MachineStatusLabelGPUs = "omni.sidero.dev/gpus";
 export const MachineStatusLabelPlatform = "omni.sidero.dev/platform";
 export const MachineStatusLabelRegion = "omni.sidero.dev/region";
 export const MachineStatusLabelZone = "omni.sidero.dev/zone";
 export const MachineStatusLabelInstance = "omni.sidero.dev/instance";
 export const MachineStatusLabelTalosVersion = "omni.sidero.dev/talos-version";
+export const MachineStatusLabelMaintenance = "omni.sidero.dev/maintenance";
+export const MachineBootHistoryLabelCrashLoop = "omni.sidero.dev/crash-loop";
 export const ClusterMachineStatusLabelNodeName = "omni.sidero.dev/node-name";
 export const ExtensionsConfigurationLabel = "omni.sidero.dev/root-configuration";
+export const LogLevelConfigID = "log-levels";
+export const LogLevelConfigType = "LogLevelConfigs.omni.sidero.dev";
 export const MachineType = "Machines.omni.sidero.dev";
+export const MachineBootHistoryType = "MachineBootHistories.omni.sidero.dev";
 export const MachineClassType = "MachineClasses.omni.sidero.dev";
 export const MachineConfigGenOptionsType = "MachineConfigGenOptions.omni.sidero.dev";
 export const MachineExtensionsType = "MachineExtensions.omni.sidero.dev";
 export const MachineExtensionsStatusType = "MachineExtensionsStatuses.omni.sidero.dev";
+export const MachineHardwareInventoryType = "MachineHardwareInventories.omni.sidero.dev";
 export const MachineLabelsType = "MachineLabels.omni.sidero.dev";
+export const MachineLinkStatusType = "MachineLinkStatuses.omni.sidero.dev";
+export const MachineMoveRequestType = "MachineMoveRequests.omni.sidero.dev";
+export const MachineMoveStatusType = "MachineMoveStatuses.omni.sidero.dev";
 export const ControlPlanesIDSuffix = "control-planes";
 export const DefaultWorkersIDSuffix = "workers";
 export const MachineSetType = "MachineSets.omni.sidero.dev";
+export const MachineSetDefaultExtensionsType = "MachineSetDefaultExtensions.omni.sidero.dev";
 export const MachineSetDestroyStatusType = "MachineSetDestroyStatuses.omni.sidero.dev";
 export const MachineSetNodeType = "MachineSetNodes.omni.sidero.dev";
+export const MachineSetScalingHistoryType = "MachineSetScalingHistories.omni.sidero.dev";
 export const MachineSetStatusType = "MachineSetStatuses.omni.sidero.dev";
 export const MachineStatusType = "MachineStatuses.omni.sidero.dev";
 export const MachineStatusLinkType = "MachineStatusLinks.omni.sidero.dev";
 export const MachineStatusMetricsType = "MachineStatusMetrics.omni.sidero.dev";
 export const MachineStatusMetricsID = "metrics";
 export const MachineStatusSnapshotType = "MachineStatusSnapshots.omni.sidero.dev";
+export const MachineWipeStatusType = "MachineWipeStatuses.omni.sidero.dev";
+export const MaintenanceWindowType = "MaintenanceWindows.omni.sidero.dev";
+export const NotificationConfigType = "NotificationConfigs.omni.sidero.dev";
 export const OngoingTaskType = "OngoingTasks.omni.sidero.dev";
+export const QuotaType = "Quotas.omni.sidero.dev";
 export const RedactedClusterMachineConfigType = "RedactedClusterMachineConfigs.omni.sidero.dev";
+export const RuntimeConfigurationID = "runtime-configuration";
+export const RuntimeConfigurationType = "RuntimeConfigurations.omni.sidero.dev";
 export const SchematicType = "Schematics.omni.sidero.dev";
 export const SchematicConfigurationType = "SchematicConfigurations.omni.sidero.dev";
+export const SettingsID = "settings";
+export const SettingsType = "Settings.omni.sidero.dev";
+export const SchematicDriftStatusType = "SchematicDriftStatuses.omni.sidero.dev";
 export const ClusterSecretsType = "ClusterSecrets.omni.sidero.dev";
 export const TalosExtensionsType = "TalosExtensions.omni.sidero.dev";
+export const TalosSecretsRotationType = "TalosSecretsRotations.omni.sidero.dev";
+export const TalosSecretsRotationStatusType = "TalosSecretsRotationStatuses.omni.sidero.dev";
 export const TalosUpgradeStatusType = "TalosUpgradeStatuses.omni.sidero.dev";
 export const TalosVersionType = "TalosVersions.omni.sidero.dev";
+export const TemplateSyncStatusType = "TemplateSyncStatuses.omni.sidero.dev";
 export const ConfigType = "Configs.omni.sidero.dev";
 export const ConfigID = "siderolink-config";
 export const ConnectionParamsType = "ConnectionParams.omni.sidero.dev";
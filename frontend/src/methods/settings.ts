@@ -0,0 +1,37 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+import { Resource, ResourceService } from "@/api/grpc";
+import { SettingsSpec } from "@/api/omni/specs/omni.pb";
+import { Runtime } from "@/api/common/omni.pb";
+import { DefaultKubernetesVersion, DefaultNamespace, DefaultTalosVersion, SettingsID, SettingsType } from "@/api/resources";
+import { withRuntime } from "@/api/options";
+
+export type DefaultVersions = {
+  talosVersion: string
+  kubernetesVersion: string
+}
+
+// getDefaultVersions returns the versions pre-selected for the new clusters, the settings override the built-in defaults.
+export const getDefaultVersions = async (): Promise<DefaultVersions> => {
+  let spec: SettingsSpec | undefined;
+
+  try {
+    const settings: Resource<SettingsSpec> = await ResourceService.Get({
+      type: SettingsType,
+      namespace: DefaultNamespace,
+      id: SettingsID,
+    }, withRuntime(Runtime.Omni));
+
+    spec = settings.spec;
+  } catch {
+    // the settings are not overridden
+  }
+
+  return {
+    talosVersion: spec?.default_talos_version || DefaultTalosVersion,
+    kubernetesVersion: spec?.default_kubernetes_version || DefaultKubernetesVersion,
+  };
+}
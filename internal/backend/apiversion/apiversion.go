@@ -0,0 +1,156 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package apiversion implements the versioning of the Omni API.
+//
+// The clients report the API version they were built for in the request metadata.
+// The server accepts the clients in the [version.MinAPI, version.API] range, and converts the requests and the responses
+// of the changed methods for the older clients with the registered compatibility shims.
+package apiversion
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/pkg/constants"
+	"github.com/siderolabs/omni/internal/version"
+)
+
+// LegacyAPI is the API version assumed for the clients which don't report their API version.
+//
+// These are the clients built before the API version header was introduced.
+const LegacyAPI uint32 = 1
+
+type apiVersionContextKey struct{}
+
+// FromContext returns the API version of the client which made the request.
+//
+// The requests which didn't come through the gRPC API (e.g. internal calls) are considered to use the current API version.
+func FromContext(ctx context.Context) uint32 {
+	apiVersion, ok := ctx.Value(apiVersionContextKey{}).(uint32)
+	if !ok {
+		return version.API
+	}
+
+	return apiVersion
+}
+
+// Interceptors returns the unary and stream server interceptors which check the client API version,
+// apply the compatibility shims and report the method deprecations.
+func Interceptors() (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	unary := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		apiVersion, err := clientAPIVersion(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		ctx = context.WithValue(ctx, apiVersionContextKey{}, apiVersion)
+
+		if err = reportDeprecation(ctx, info.FullMethod, grpc.SetHeader); err != nil {
+			return nil, err
+		}
+
+		shims := shimsFor(info.FullMethod, apiVersion)
+
+		if err = shims.upgradeRequest(ctx, apiVersion, req); err != nil {
+			return nil, err
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = shims.downgradeResponse(ctx, apiVersion, resp); err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+
+	stream := func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		apiVersion, err := clientAPIVersion(ss.Context())
+		if err != nil {
+			return err
+		}
+
+		ctx := context.WithValue(ss.Context(), apiVersionContextKey{}, apiVersion)
+
+		if err = reportDeprecation(ctx, info.FullMethod, func(_ context.Context, md metadata.MD) error { return ss.SetHeader(md) }); err != nil {
+			return err
+		}
+
+		return handler(srv, &serverStream{
+			ServerStream: ss,
+			ctx:          ctx,
+			shims:        shimsFor(info.FullMethod, apiVersion),
+			apiVersion:   apiVersion,
+		})
+	}
+
+	return unary, stream
+}
+
+// clientAPIVersion reads the client API version from the request metadata and checks that it is supported.
+func clientAPIVersion(ctx context.Context) (uint32, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	apiVersion := uint64(LegacyAPI)
+
+	if values := md.Get(constants.APIVersionHeader); len(values) > 0 {
+		var err error
+
+		apiVersion, err = strconv.ParseUint(values[0], 10, 32)
+		if err != nil || apiVersion == 0 {
+			return 0, status.Errorf(codes.InvalidArgument, "invalid client API version %q", values[0])
+		}
+	} else if len(md.Get("grpcgateway-user-agent")) > 0 {
+		// the web frontend is shipped with the backend, so it always uses the current API version
+		return version.API, nil
+	}
+
+	switch {
+	case uint32(apiVersion) > version.API:
+		return 0, status.Errorf(codes.FailedPrecondition,
+			"client API version %d is newer than the server API version %d, please upgrade the server or downgrade the client", apiVersion, version.API)
+	case uint32(apiVersion) < version.MinAPI:
+		return 0, status.Errorf(codes.FailedPrecondition,
+			"client API version %d is no longer supported, the oldest supported API version is %d, please upgrade the client", apiVersion, version.MinAPI)
+	}
+
+	return uint32(apiVersion), nil
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx        context.Context //nolint:containedctx
+	shims      shimList
+	apiVersion uint32
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *serverStream) RecvMsg(msg any) error {
+	if err := s.ServerStream.RecvMsg(msg); err != nil {
+		return err
+	}
+
+	return s.shims.upgradeRequest(s.ctx, s.apiVersion, msg)
+}
+
+func (s *serverStream) SendMsg(msg any) error {
+	if err := s.shims.downgradeResponse(s.ctx, s.apiVersion, msg); err != nil {
+		return err
+	}
+
+	return s.ServerStream.SendMsg(msg)
+}
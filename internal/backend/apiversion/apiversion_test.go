@@ -0,0 +1,234 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package apiversion_test
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/interop/grpc_testing"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/pkg/constants"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/system"
+	"github.com/siderolabs/omni/internal/backend/apiversion"
+	"github.com/siderolabs/omni/internal/version"
+)
+
+type testService struct {
+	grpc_testing.UnimplementedTestServiceServer
+
+	apiVersion   uint32
+	responseSize int32
+}
+
+func (s *testService) UnaryCall(ctx context.Context, req *grpc_testing.SimpleRequest) (*grpc_testing.SimpleResponse, error) {
+	s.apiVersion = apiversion.FromContext(ctx)
+	s.responseSize = req.ResponseSize
+
+	return &grpc_testing.SimpleResponse{
+		Username: "user",
+	}, nil
+}
+
+func setupServer(t *testing.T, service *testService) grpc_testing.TestServiceClient {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	unary, stream := apiversion.Interceptors()
+
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(unary), grpc.ChainStreamInterceptor(stream))
+
+	grpc_testing.RegisterTestServiceServer(server, service)
+
+	go server.Serve(listener) //nolint:errcheck
+
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+
+	t.Cleanup(func() { require.NoError(t, conn.Close()) })
+
+	return grpc_testing.NewTestServiceClient(conn)
+}
+
+func TestClientAPIVersion(t *testing.T) {
+	t.Parallel()
+
+	service := &testService{}
+	client := setupServer(t, service)
+
+	for _, tt := range []struct {
+		name            string
+		md              []string
+		expectedCode    codes.Code
+		expectedVersion uint32
+	}{
+		{
+			name:            "legacy client",
+			expectedVersion: apiversion.LegacyAPI,
+		},
+		{
+			name:            "web frontend",
+			md:              []string{"grpcgateway-user-agent", "Mozilla/5.0"},
+			expectedVersion: version.API,
+		},
+		{
+			name:            "current client",
+			md:              []string{constants.APIVersionHeader, strconv.FormatUint(uint64(version.API), 10)},
+			expectedVersion: version.API,
+		},
+		{
+			name:         "newer client",
+			md:           []string{constants.APIVersionHeader, strconv.FormatUint(uint64(version.API)+1, 10)},
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "invalid version",
+			md:           []string{constants.APIVersionHeader, "v1"},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "zero version",
+			md:           []string{constants.APIVersionHeader, "0"},
+			expectedCode: codes.InvalidArgument,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			_, err := client.UnaryCall(metadata.AppendToOutgoingContext(ctx, tt.md...), &grpc_testing.SimpleRequest{})
+
+			if tt.expectedCode != codes.OK {
+				require.Equal(t, tt.expectedCode, status.Code(err))
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedVersion, service.apiVersion)
+		})
+	}
+}
+
+// TestShims and TestDeprecation modify the global registries, so they are not parallel.
+func TestShims(t *testing.T) {
+	service := &testService{}
+	client := setupServer(t, service)
+
+	// the shim for a change introduced in the next API version applies to all current clients
+	apiversion.RegisterShim(t, apiversion.Shim{
+		Method: grpc_testing.TestService_UnaryCall_FullMethodName,
+		Since:  version.API + 1,
+		UpgradeRequest: func(_ context.Context, _ uint32, req any) error {
+			req.(*grpc_testing.SimpleRequest).ResponseSize *= 2 //nolint:forcetypeassert,errcheck
+
+			return nil
+		},
+		DowngradeResponse: func(_ context.Context, clientAPI uint32, resp any) error {
+			resp.(*grpc_testing.SimpleResponse).Username += "@" + strconv.FormatUint(uint64(clientAPI), 10) //nolint:forcetypeassert,errcheck
+
+			return nil
+		},
+	})
+
+	// the shim for a change in the current API version doesn't apply to the current clients
+	apiversion.RegisterShim(t, apiversion.Shim{
+		Method: grpc_testing.TestService_UnaryCall_FullMethodName,
+		Since:  version.API,
+		UpgradeRequest: func(_ context.Context, _ uint32, req any) error {
+			req.(*grpc_testing.SimpleRequest).ResponseSize = 0 //nolint:forcetypeassert,errcheck
+
+			return nil
+		},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	ctx = metadata.AppendToOutgoingContext(ctx, constants.APIVersionHeader, strconv.FormatUint(uint64(version.API), 10))
+
+	resp, err := client.UnaryCall(ctx, &grpc_testing.SimpleRequest{ResponseSize: 21})
+	require.NoError(t, err)
+
+	assert.EqualValues(t, 42, service.responseSize)
+	assert.Equal(t, "user@"+strconv.FormatUint(uint64(version.API), 10), resp.Username)
+}
+
+func TestDeprecation(t *testing.T) {
+	service := &testService{}
+	client := setupServer(t, service)
+
+	apiversion.RegisterDeprecation(t, apiversion.Deprecation{
+		Method:         grpc_testing.TestService_UnaryCall_FullMethodName,
+		Message:        "use EmptyCall instead",
+		RemovalVersion: "v1.0.0",
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var header metadata.MD
+
+	_, err := client.UnaryCall(ctx, &grpc_testing.SimpleRequest{}, grpc.Header(&header))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"/grpc.testing.TestService/UnaryCall is deprecated and will be removed in v1.0.0: use EmptyCall instead"},
+		header.Get(constants.DeprecationHeader))
+
+	deprecations := apiversion.Deprecations()
+	require.Len(t, deprecations, 1)
+	assert.Equal(t, grpc_testing.TestService_UnaryCall_FullMethodName, deprecations[0].Method)
+}
+
+func TestWrapState(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	sysVersion := system.NewSysVersion(resources.EphemeralNamespace, system.SysVersionID)
+	sysVersion.TypedSpec().Value.BackendApiVersion = version.API
+	sysVersion.TypedSpec().Value.BackendMinApiVersion = version.MinAPI
+
+	require.NoError(t, st.Create(ctx, sysVersion))
+
+	wrapped := apiversion.WrapState(st)
+
+	res, err := safe.StateGet[*system.SysVersion](ctx, wrapped, sysVersion.Metadata())
+	require.NoError(t, err)
+	assert.Equal(t, version.API, res.TypedSpec().Value.BackendApiVersion)
+
+	// an older client sees its own API version
+	res, err = safe.StateGet[*system.SysVersion](apiversion.WithAPIVersion(ctx, version.API-1), wrapped, sysVersion.Metadata())
+	require.NoError(t, err)
+	assert.Equal(t, version.API-1, res.TypedSpec().Value.BackendApiVersion)
+
+	// the stored resource is not modified
+	res, err = safe.StateGet[*system.SysVersion](ctx, st, sysVersion.Metadata())
+	require.NoError(t, err)
+	assert.Equal(t, version.API, res.TypedSpec().Value.BackendApiVersion)
+}
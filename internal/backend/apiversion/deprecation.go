@@ -0,0 +1,62 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package apiversion
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/siderolabs/omni/client/pkg/constants"
+)
+
+// Deprecation describes a deprecated API method which is still served.
+type Deprecation struct {
+	// Method is the full gRPC method name, e.g. /management.ManagementService/Kubeconfig.
+	Method string
+	// Message explains what should be used instead.
+	Message string
+	// RemovalVersion is the Omni version the method is going to be removed in, empty if not planned yet.
+	RemovalVersion string
+}
+
+// String implements fmt.Stringer.
+func (d Deprecation) String() string {
+	if d.RemovalVersion != "" {
+		return fmt.Sprintf("%s is deprecated and will be removed in %s: %s", d.Method, d.RemovalVersion, d.Message)
+	}
+
+	return fmt.Sprintf("%s is deprecated: %s", d.Method, d.Message)
+}
+
+// deprecations is the registry of the deprecated methods keyed by the full method name.
+var deprecations = map[string]Deprecation{}
+
+// Deprecations returns all deprecated methods sorted by the method name.
+func Deprecations() []Deprecation {
+	result := make([]Deprecation, 0, len(deprecations))
+
+	for _, deprecation := range deprecations {
+		result = append(result, deprecation)
+	}
+
+	slices.SortFunc(result, func(a, b Deprecation) int { return strings.Compare(a.Method, b.Method) })
+
+	return result
+}
+
+// reportDeprecation sets the deprecation response header if the method is deprecated.
+func reportDeprecation(ctx context.Context, method string, setHeader func(context.Context, metadata.MD) error) error {
+	deprecation, ok := deprecations[method]
+	if !ok {
+		return nil
+	}
+
+	return setHeader(ctx, metadata.Pairs(constants.DeprecationHeader, deprecation.String()))
+}
@@ -0,0 +1,27 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package apiversion
+
+import (
+	"context"
+	"testing"
+)
+
+func RegisterShim(t *testing.T, shim Shim) {
+	registerShim(shim)
+
+	t.Cleanup(func() { delete(shims, shim.Method) })
+}
+
+func RegisterDeprecation(t *testing.T, deprecation Deprecation) {
+	deprecations[deprecation.Method] = deprecation
+
+	t.Cleanup(func() { delete(deprecations, deprecation.Method) })
+}
+
+func WithAPIVersion(ctx context.Context, apiVersion uint32) context.Context {
+	return context.WithValue(ctx, apiVersionContextKey{}, apiVersion)
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package apiversion
+
+import (
+	"context"
+	"fmt"
+	"slices"
+)
+
+// Shim keeps a method compatible with the clients built for an API version preceding the breaking change of the method.
+//
+// Both functions modify the message in place and might be nil if the respective message wasn't changed.
+type Shim struct {
+	// UpgradeRequest converts the request sent by an older client to the current API format.
+	UpgradeRequest func(ctx context.Context, clientAPI uint32, req any) error
+	// DowngradeResponse converts the response to the format expected by an older client.
+	DowngradeResponse func(ctx context.Context, clientAPI uint32, resp any) error
+	// Method is the full gRPC method name.
+	Method string
+	// Since is the API version which introduced the breaking change, the shim is applied to the clients using older API versions.
+	Since uint32
+}
+
+// shims is the registry of the compatibility shims keyed by the full method name.
+//
+// The shims for a method are ordered by the API version, the requests are upgraded starting with the oldest shim,
+// the responses are downgraded in the reverse order.
+var shims = map[string]shimList{}
+
+// registerShim adds the compatibility shim to the registry.
+func registerShim(shim Shim) {
+	if shim.Since == 0 {
+		panic(fmt.Sprintf("shim for %q has no API version", shim.Method))
+	}
+
+	list := shims[shim.Method]
+
+	for i, existing := range list {
+		if existing.Since == shim.Since {
+			panic(fmt.Sprintf("duplicate shim for %q since API version %d", shim.Method, shim.Since))
+		}
+
+		if existing.Since > shim.Since {
+			shims[shim.Method] = slices.Insert(list, i, shim)
+
+			return
+		}
+	}
+
+	shims[shim.Method] = append(list, shim)
+}
+
+type shimList []Shim
+
+// shimsFor returns the shims of the method which should be applied for the client API version.
+func shimsFor(method string, clientAPI uint32) shimList {
+	var result shimList
+
+	for _, shim := range shims[method] {
+		if clientAPI < shim.Since {
+			result = append(result, shim)
+		}
+	}
+
+	return result
+}
+
+func (list shimList) upgradeRequest(ctx context.Context, clientAPI uint32, req any) error {
+	for _, shim := range list {
+		if shim.UpgradeRequest == nil {
+			continue
+		}
+
+		if err := shim.UpgradeRequest(ctx, clientAPI, req); err != nil {
+			return fmt.Errorf("failed to convert the request from API version %d: %w", clientAPI, err)
+		}
+	}
+
+	return nil
+}
+
+func (list shimList) downgradeResponse(ctx context.Context, clientAPI uint32, resp any) error {
+	for i := len(list) - 1; i >= 0; i-- {
+		if list[i].DowngradeResponse == nil {
+			continue
+		}
+
+		if err := list[i].DowngradeResponse(ctx, clientAPI, resp); err != nil {
+			return fmt.Errorf("failed to convert the response to API version %d: %w", clientAPI, err)
+		}
+	}
+
+	return nil
+}
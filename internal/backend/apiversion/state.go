@@ -0,0 +1,53 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package apiversion
+
+import (
+	"context"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/system"
+	"github.com/siderolabs/omni/internal/version"
+)
+
+// WrapState wraps the state served to the clients to keep the resources compatible with the older clients.
+//
+// The older clients check that the backend API version reported in the SysVersion resource is equal to their API version,
+// so the backend reports the client API version as long as the client is within the supported range.
+func WrapState(st state.State) state.State {
+	return &compatState{
+		State: st,
+	}
+}
+
+type compatState struct {
+	state.State
+}
+
+// Get implements state.State.
+func (st *compatState) Get(ctx context.Context, ptr resource.Pointer, opts ...state.GetOption) (resource.Resource, error) {
+	res, err := st.State.Get(ctx, ptr, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	clientAPI := FromContext(ctx)
+	if clientAPI >= version.API {
+		return res, nil
+	}
+
+	sysVersion, ok := res.(*system.SysVersion)
+	if !ok {
+		return res, nil
+	}
+
+	sysVersion = sysVersion.DeepCopy().(*system.SysVersion) //nolint:forcetypeassert,errcheck
+	sysVersion.TypedSpec().Value.BackendApiVersion = clientAPI
+
+	return sysVersion, nil
+}
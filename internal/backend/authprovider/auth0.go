@@ -0,0 +1,47 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package authprovider
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/internal/pkg/auth/auth0"
+	"github.com/siderolabs/omni/internal/pkg/auth/interceptor"
+)
+
+// Auth0 verifies the identity using the ID tokens issued by Auth0.
+type Auth0 struct {
+	jwtInterceptor *interceptor.JWT
+}
+
+// NewAuth0 creates a new Auth0 provider.
+func NewAuth0(cfg *specs.AuthConfigSpec_Auth0, logger *zap.Logger) (*Auth0, error) {
+	verifier, err := auth0.NewIDTokenVerifier(cfg.GetDomain())
+	if err != nil {
+		return nil, err
+	}
+
+	return &Auth0{
+		jwtInterceptor: interceptor.NewJWT(verifier, logger),
+	}, nil
+}
+
+// Name implements Provider.
+func (p *Auth0) Name() string {
+	return "auth0"
+}
+
+// Interceptors implements Provider.
+func (p *Auth0) Interceptors() ([]grpc.UnaryServerInterceptor, []grpc.StreamServerInterceptor) {
+	return []grpc.UnaryServerInterceptor{p.jwtInterceptor.Unary()}, []grpc.StreamServerInterceptor{p.jwtInterceptor.Stream()}
+}
+
+// RegisterHandlers implements Provider.
+func (p *Auth0) RegisterHandlers(*http.ServeMux, *zap.Logger) {}
@@ -0,0 +1,68 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package authprovider contains the pluggable authentication backends which verify the user identity.
+package authprovider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+// Provider is an authentication backend.
+//
+// Provider verifies the identity of the user (e.g. when the user confirms a public key) and
+// puts the verified email into the request context.
+type Provider interface {
+	// Name returns the name of the provider.
+	Name() string
+
+	// Interceptors returns the gRPC interceptors which verify the identity of the user.
+	Interceptors() ([]grpc.UnaryServerInterceptor, []grpc.StreamServerInterceptor)
+
+	// RegisterHandlers registers provider-specific HTTP handlers.
+	RegisterHandlers(mux *http.ServeMux, logger *zap.Logger)
+}
+
+// New creates the authentication provider enabled in the auth config.
+//
+// If no identity provider is enabled, a provider which doesn't verify any identity is returned.
+func New(ctx context.Context, authConfig *authres.Config, params config.AuthParams, st state.State, logger *zap.Logger) (Provider, error) {
+	spec := authConfig.TypedSpec().Value
+
+	switch {
+	case spec.GetAuth0().GetEnabled():
+		return NewAuth0(spec.GetAuth0(), logger)
+	case spec.GetSaml().GetEnabled():
+		return NewSAML(st, spec.GetSaml(), logger)
+	case spec.GetStatic().GetEnabled():
+		return NewStatic(ctx, st, params.Static, logger)
+	default:
+		return none{}, nil
+	}
+}
+
+// none is the provider used when no identity provider is enabled.
+type none struct{}
+
+// Name implements Provider.
+func (none) Name() string {
+	return "none"
+}
+
+// Interceptors implements Provider.
+func (none) Interceptors() ([]grpc.UnaryServerInterceptor, []grpc.StreamServerInterceptor) {
+	return nil, nil
+}
+
+// RegisterHandlers implements Provider.
+func (none) RegisterHandlers(*http.ServeMux, *zap.Logger) {}
@@ -0,0 +1,53 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package authprovider
+
+import (
+	"net/http"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/crewjam/saml/samlsp"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/internal/backend/saml"
+	"github.com/siderolabs/omni/internal/pkg/auth/interceptor"
+)
+
+// SAML verifies the identity using the SAML sessions.
+type SAML struct {
+	handler     *samlsp.Middleware
+	interceptor *interceptor.SAML
+}
+
+// NewSAML creates a new SAML provider.
+func NewSAML(st state.State, cfg *specs.AuthConfigSpec_SAML, logger *zap.Logger) (*SAML, error) {
+	handler, err := saml.NewHandler(st, cfg, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SAML{
+		handler:     handler,
+		interceptor: interceptor.NewSAML(st, logger),
+	}, nil
+}
+
+// Name implements Provider.
+func (p *SAML) Name() string {
+	return "saml"
+}
+
+// Interceptors implements Provider.
+func (p *SAML) Interceptors() ([]grpc.UnaryServerInterceptor, []grpc.StreamServerInterceptor) {
+	return []grpc.UnaryServerInterceptor{p.interceptor.Unary()}, []grpc.StreamServerInterceptor{p.interceptor.Stream()}
+}
+
+// RegisterHandlers implements Provider.
+func (p *SAML) RegisterHandlers(mux *http.ServeMux, logger *zap.Logger) {
+	saml.RegisterHandlers(p.handler, mux, logger)
+}
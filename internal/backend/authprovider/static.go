@@ -0,0 +1,61 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package authprovider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"github.com/siderolabs/omni/internal/pkg/auth/interceptor"
+	"github.com/siderolabs/omni/internal/pkg/auth/static"
+	"github.com/siderolabs/omni/internal/pkg/auth/user"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+// Static verifies the identity using the credentials from the static users file.
+//
+// It is intended for the air-gapped environments without an identity provider.
+type Static struct {
+	interceptor *interceptor.Static
+}
+
+// NewStatic loads the static users file, makes sure that all users from it exist in the state and creates a new static provider.
+func NewStatic(ctx context.Context, st state.State, params config.StaticParams, logger *zap.Logger) (*Static, error) {
+	users, err := static.Load(params.UsersFile)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, u := range users.List() {
+		if err = user.Ensure(ctx, st, u.Email, u.Role); err != nil {
+			return nil, fmt.Errorf("failed to ensure static user %q: %w", u.Email, err)
+		}
+	}
+
+	logger.Info("loaded static users", zap.String("path", params.UsersFile), zap.Int("count", len(users.List())))
+
+	return &Static{
+		interceptor: interceptor.NewStatic(users, logger),
+	}, nil
+}
+
+// Name implements Provider.
+func (p *Static) Name() string {
+	return "static"
+}
+
+// Interceptors implements Provider.
+func (p *Static) Interceptors() ([]grpc.UnaryServerInterceptor, []grpc.StreamServerInterceptor) {
+	return []grpc.UnaryServerInterceptor{p.interceptor.Unary()}, []grpc.StreamServerInterceptor{p.interceptor.Stream()}
+}
+
+// RegisterHandlers implements Provider.
+func (p *Static) RegisterHandlers(*http.ServeMux, *zap.Logger) {}
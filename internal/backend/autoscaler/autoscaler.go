@@ -0,0 +1,476 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package autoscaler implements the Kubernetes cluster-autoscaler external gRPC cloud provider API backed by the machine sets.
+package autoscaler
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/maps"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/autoscaler"
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+)
+
+// Server implements the cluster-autoscaler external gRPC cloud provider API.
+//
+// Each worker machine set which uses a machine class and has the autoscaling bounds is a node group,
+// the autoscaler scales it by changing the machine count of the machine class.
+// The machines are matched to the Kubernetes nodes by the provider ID, or by the node name if the provider ID is not set.
+//
+// The autoscaler is authenticated by the client certificate, its common name is the ID of the cluster the autoscaler manages.
+type Server struct {
+	autoscaler.UnimplementedCloudProviderServer
+
+	state  state.State
+	logger *zap.Logger
+}
+
+// NewServer creates a new Server.
+func NewServer(st state.State, logger *zap.Logger) *Server {
+	return &Server{
+		state:  st,
+		logger: logger,
+	}
+}
+
+// Register registers the server on the gRPC server.
+func (s *Server) Register(server grpc.ServiceRegistrar) {
+	autoscaler.RegisterCloudProviderServer(server, s)
+}
+
+// NodeGroups implements autoscaler.CloudProviderServer.
+func (s *Server) NodeGroups(ctx context.Context, _ *autoscaler.NodeGroupsRequest) (*autoscaler.NodeGroupsResponse, error) {
+	clusterID, err := clusterFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	machineSets, err := safe.StateListAll[*omni.MachineSet](ctx, s.state,
+		state.WithLabelQuery(resource.LabelEqual(omni.LabelCluster, clusterID), resource.LabelExists(omni.LabelWorkerRole)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &autoscaler.NodeGroupsResponse{}
+
+	machineSets.ForEach(func(machineSet *omni.MachineSet) {
+		if isNodeGroup(machineSet, clusterID) {
+			resp.NodeGroups = append(resp.NodeGroups, toNodeGroup(machineSet))
+		}
+	})
+
+	return resp, nil
+}
+
+// NodeGroupForNode implements autoscaler.CloudProviderServer.
+func (s *Server) NodeGroupForNode(ctx context.Context, req *autoscaler.NodeGroupForNodeRequest) (*autoscaler.NodeGroupForNodeResponse, error) {
+	clusterID, err := clusterFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	// the empty node group means the node isn't managed by the autoscaler
+	resp := &autoscaler.NodeGroupForNodeResponse{
+		NodeGroup: &autoscaler.NodeGroup{},
+	}
+
+	machineSetNode, err := s.machineSetNodeForNode(ctx, clusterID, req.GetNode())
+	if err != nil {
+		return nil, err
+	}
+
+	if machineSetNode == nil {
+		return resp, nil
+	}
+
+	machineSetID, _ := machineSetNode.Metadata().Labels().Get(omni.LabelMachineSet)
+
+	machineSet, err := safe.StateGetByID[*omni.MachineSet](ctx, s.state, machineSetID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return resp, nil
+		}
+
+		return nil, err
+	}
+
+	if isNodeGroup(machineSet, clusterID) {
+		resp.NodeGroup = toNodeGroup(machineSet)
+	}
+
+	return resp, nil
+}
+
+// Refresh implements autoscaler.CloudProviderServer.
+func (s *Server) Refresh(context.Context, *autoscaler.RefreshRequest) (*autoscaler.RefreshResponse, error) {
+	return &autoscaler.RefreshResponse{}, nil
+}
+
+// Cleanup implements autoscaler.CloudProviderServer.
+func (s *Server) Cleanup(context.Context, *autoscaler.CleanupRequest) (*autoscaler.CleanupResponse, error) {
+	return &autoscaler.CleanupResponse{}, nil
+}
+
+// NodeGroupTargetSize implements autoscaler.CloudProviderServer.
+func (s *Server) NodeGroupTargetSize(ctx context.Context, req *autoscaler.NodeGroupTargetSizeRequest) (*autoscaler.NodeGroupTargetSizeResponse, error) {
+	machineSet, err := s.nodeGroup(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &autoscaler.NodeGroupTargetSizeResponse{
+		TargetSize: int32(machineSet.TypedSpec().Value.MachineClass.MachineCount),
+	}, nil
+}
+
+// NodeGroupIncreaseSize implements autoscaler.CloudProviderServer.
+func (s *Server) NodeGroupIncreaseSize(ctx context.Context, req *autoscaler.NodeGroupIncreaseSizeRequest) (*autoscaler.NodeGroupIncreaseSizeResponse, error) {
+	if req.GetDelta() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "size increase must be positive, got %d", req.GetDelta())
+	}
+
+	if err := s.resize(ctx, req.GetId(), func(machineSet *omni.MachineSet, _ safe.List[*omni.MachineSetNode]) error {
+		machineClass := machineSet.TypedSpec().Value.MachineClass
+		size := int64(machineClass.MachineCount) + int64(req.GetDelta())
+
+		if maxSize := machineSet.TypedSpec().Value.Autoscaling.MaxSize; size > int64(maxSize) {
+			return status.Errorf(codes.InvalidArgument, "size increase is too large: the new size %d is greater than the max size %d", size, maxSize)
+		}
+
+		machineClass.MachineCount = uint32(size)
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("scaled node group up", zap.String("node_group", req.GetId()), zap.Int32("delta", req.GetDelta()))
+
+	return &autoscaler.NodeGroupIncreaseSizeResponse{}, nil
+}
+
+// NodeGroupDecreaseTargetSize implements autoscaler.CloudProviderServer.
+//
+// Only the machines which are not allocated yet can be removed from the target size.
+func (s *Server) NodeGroupDecreaseTargetSize(ctx context.Context, req *autoscaler.NodeGroupDecreaseTargetSizeRequest) (*autoscaler.NodeGroupDecreaseTargetSizeResponse, error) {
+	if req.GetDelta() >= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "size decrease must be negative, got %d", req.GetDelta())
+	}
+
+	if err := s.resize(ctx, req.GetId(), func(machineSet *omni.MachineSet, machineSetNodes safe.List[*omni.MachineSetNode]) error {
+		machineClass := machineSet.TypedSpec().Value.MachineClass
+		size := int64(machineClass.MachineCount) + int64(req.GetDelta())
+
+		if size < int64(machineSetNodes.Len()) {
+			return status.Errorf(codes.FailedPrecondition, "attempt to delete the existing nodes: the new size %d is less than the number of the machines %d", size, machineSetNodes.Len())
+		}
+
+		machineClass.MachineCount = uint32(size)
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("decreased node group target size", zap.String("node_group", req.GetId()), zap.Int32("delta", req.GetDelta()))
+
+	return &autoscaler.NodeGroupDecreaseTargetSizeResponse{}, nil
+}
+
+// NodeGroupDeleteNodes implements autoscaler.CloudProviderServer.
+//
+// The machine count is decreased by the number of the nodes, and the machines of the nodes are marked to be removed first.
+func (s *Server) NodeGroupDeleteNodes(ctx context.Context, req *autoscaler.NodeGroupDeleteNodesRequest) (*autoscaler.NodeGroupDeleteNodesResponse, error) {
+	clusterID, err := clusterFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	internalCtx := actor.MarkContextAsInternalActor(ctx)
+
+	machineIDs := make([]string, 0, len(req.GetNodes()))
+
+	for _, node := range req.GetNodes() {
+		machineSetNode, err := s.machineSetNodeForNode(internalCtx, clusterID, node)
+		if err != nil {
+			return nil, err
+		}
+
+		if machineSetNode == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "node %q doesn't belong to the node group %q", node.GetName(), req.GetId())
+		}
+
+		if machineSetID, _ := machineSetNode.Metadata().Labels().Get(omni.LabelMachineSet); machineSetID != req.GetId() {
+			return nil, status.Errorf(codes.InvalidArgument, "node %q doesn't belong to the node group %q", node.GetName(), req.GetId())
+		}
+
+		machineIDs = append(machineIDs, machineSetNode.Metadata().ID())
+	}
+
+	if err = s.resize(ctx, req.GetId(), func(machineSet *omni.MachineSet, machineSetNodes safe.List[*omni.MachineSetNode]) error {
+		machineClass := machineSet.TypedSpec().Value.MachineClass
+		size := int64(machineClass.MachineCount) - int64(len(machineIDs))
+
+		if minSize := machineSet.TypedSpec().Value.Autoscaling.MinSize; size < int64(minSize) {
+			return status.Errorf(codes.FailedPrecondition, "the new size %d is less than the min size %d", size, minSize)
+		}
+
+		// keep the machines marked by the previous calls which are still in the machine set
+		scaleDown := map[string]struct{}{}
+
+		if value, ok := machineSet.Metadata().Annotations().Get(omni.ScaleDownMachines); ok && value != "" {
+			for _, id := range strings.Split(value, ",") {
+				scaleDown[id] = struct{}{}
+			}
+		}
+
+		current := make(map[string]struct{}, machineSetNodes.Len())
+
+		machineSetNodes.ForEach(func(machineSetNode *omni.MachineSetNode) {
+			current[machineSetNode.Metadata().ID()] = struct{}{}
+		})
+
+		for id := range scaleDown {
+			if _, ok := current[id]; !ok {
+				delete(scaleDown, id)
+			}
+		}
+
+		for _, id := range machineIDs {
+			scaleDown[id] = struct{}{}
+		}
+
+		ids := maps.Keys(scaleDown)
+		slices.Sort(ids)
+
+		machineSet.Metadata().Annotations().Set(omni.ScaleDownMachines, strings.Join(ids, ","))
+
+		machineClass.MachineCount = uint32(size)
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("deleting node group nodes", zap.String("node_group", req.GetId()), zap.Strings("machines", machineIDs))
+
+	return &autoscaler.NodeGroupDeleteNodesResponse{}, nil
+}
+
+// NodeGroupNodes implements autoscaler.CloudProviderServer.
+func (s *Server) NodeGroupNodes(ctx context.Context, req *autoscaler.NodeGroupNodesRequest) (*autoscaler.NodeGroupNodesResponse, error) {
+	machineSet, err := s.nodeGroup(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	machineSetNodes, err := safe.StateListAll[*omni.MachineSetNode](ctx, s.state,
+		state.WithLabelQuery(resource.LabelEqual(omni.LabelMachineSet, machineSet.Metadata().ID())),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterMachineStatuses, err := safe.StateListAll[*omni.ClusterMachineStatus](ctx, s.state,
+		state.WithLabelQuery(resource.LabelEqual(omni.LabelMachineSet, machineSet.Metadata().ID())),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	running := map[resource.ID]struct{}{}
+
+	clusterMachineStatuses.ForEach(func(clusterMachineStatus *omni.ClusterMachineStatus) {
+		if clusterMachineStatus.TypedSpec().Value.Stage == specs.ClusterMachineStatusSpec_RUNNING {
+			running[clusterMachineStatus.Metadata().ID()] = struct{}{}
+		}
+	})
+
+	resp := &autoscaler.NodeGroupNodesResponse{}
+
+	machineSetNodes.ForEach(func(machineSetNode *omni.MachineSetNode) {
+		instanceState := autoscaler.InstanceStatus_instanceCreating
+
+		if machineSetNode.Metadata().Phase() == resource.PhaseTearingDown {
+			instanceState = autoscaler.InstanceStatus_instanceDeleting
+		} else if _, ok := running[machineSetNode.Metadata().ID()]; ok {
+			instanceState = autoscaler.InstanceStatus_instanceRunning
+		}
+
+		resp.Instances = append(resp.Instances, &autoscaler.Instance{
+			Id: omni.AutoscalerProviderID(machineSetNode.Metadata().ID()),
+			Status: &autoscaler.InstanceStatus{
+				InstanceState: instanceState,
+			},
+		})
+	})
+
+	return resp, nil
+}
+
+// nodeGroup returns the autoscaled machine set of the cluster of the caller.
+func (s *Server) nodeGroup(ctx context.Context, id string) (*omni.MachineSet, error) {
+	clusterID, err := clusterFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	machineSet, err := safe.StateGetByID[*omni.MachineSet](actor.MarkContextAsInternalActor(ctx), s.state, id)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "node group %q not found", id)
+		}
+
+		return nil, err
+	}
+
+	if !isNodeGroup(machineSet, clusterID) {
+		return nil, status.Errorf(codes.NotFound, "node group %q not found", id)
+	}
+
+	return machineSet, nil
+}
+
+// resize updates the machine set of the node group, the update function gets the current machine set nodes.
+func (s *Server) resize(ctx context.Context, id string, update func(*omni.MachineSet, safe.List[*omni.MachineSetNode]) error) error {
+	machineSet, err := s.nodeGroup(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	clusterID, _ := machineSet.Metadata().Labels().Get(omni.LabelCluster)
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	machineSetNodes, err := safe.StateListAll[*omni.MachineSetNode](ctx, s.state,
+		state.WithLabelQuery(resource.LabelEqual(omni.LabelMachineSet, id)),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = safe.StateUpdateWithConflicts(ctx, s.state, machineSet.Metadata(), func(res *omni.MachineSet) error {
+		if !isNodeGroup(res, clusterID) {
+			return status.Errorf(codes.NotFound, "node group %q not found", id)
+		}
+
+		return update(res, machineSetNodes)
+	})
+
+	return err
+}
+
+// machineSetNodeForNode finds the machine of the Kubernetes node in the cluster, nil is returned if there is no such machine.
+func (s *Server) machineSetNodeForNode(ctx context.Context, clusterID string, node *autoscaler.ExternalGrpcNode) (*omni.MachineSetNode, error) {
+	machineID, ok := omni.MachineIDFromAutoscalerProviderID(node.GetProviderID())
+	if !ok {
+		if node.GetName() == "" {
+			return nil, nil //nolint:nilnil
+		}
+
+		clusterMachineStatuses, err := safe.StateListAll[*omni.ClusterMachineStatus](ctx, s.state,
+			state.WithLabelQuery(
+				resource.LabelEqual(omni.LabelCluster, clusterID),
+				resource.LabelEqual(omni.ClusterMachineStatusLabelNodeName, node.GetName()),
+			),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if clusterMachineStatuses.Len() != 1 {
+			return nil, nil //nolint:nilnil
+		}
+
+		machineID = clusterMachineStatuses.Get(0).Metadata().ID()
+	}
+
+	machineSetNode, err := safe.StateGetByID[*omni.MachineSetNode](ctx, s.state, machineID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, nil //nolint:nilnil
+		}
+
+		return nil, err
+	}
+
+	if cluster, _ := machineSetNode.Metadata().Labels().Get(omni.LabelCluster); cluster != clusterID {
+		return nil, nil //nolint:nilnil
+	}
+
+	return machineSetNode, nil
+}
+
+// clusterFromContext returns the ID of the cluster the autoscaler manages from its client certificate.
+func clusterFromContext(ctx context.Context) (string, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "peer is not known")
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return "", status.Error(codes.Unauthenticated, "client certificate is required")
+	}
+
+	clusterID := tlsInfo.State.VerifiedChains[0][0].Subject.CommonName
+	if clusterID == "" {
+		return "", status.Error(codes.Unauthenticated, "client certificate common name is empty")
+	}
+
+	return clusterID, nil
+}
+
+func isNodeGroup(machineSet *omni.MachineSet, clusterID string) bool {
+	if machineSet.Metadata().Phase() == resource.PhaseTearingDown {
+		return false
+	}
+
+	if cluster, _ := machineSet.Metadata().Labels().Get(omni.LabelCluster); cluster != clusterID {
+		return false
+	}
+
+	if _, isWorker := machineSet.Metadata().Labels().Get(omni.LabelWorkerRole); !isWorker {
+		return false
+	}
+
+	spec := machineSet.TypedSpec().Value
+
+	return spec.Autoscaling != nil && spec.MachineClass != nil && spec.MachineClass.AllocationType == specs.MachineSetSpec_MachineClass_Static
+}
+
+func toNodeGroup(machineSet *omni.MachineSet) *autoscaler.NodeGroup {
+	spec := machineSet.TypedSpec().Value
+
+	return &autoscaler.NodeGroup{
+		Id:      machineSet.Metadata().ID(),
+		MinSize: int32(spec.Autoscaling.MinSize),
+		MaxSize: int32(spec.Autoscaling.MaxSize),
+		Debug:   fmt.Sprintf("machine set %s, machine class %s, machine count %d", machineSet.Metadata().ID(), spec.MachineClass.Name, spec.MachineClass.MachineCount),
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package autoscaler_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	autoscalerapi "github.com/siderolabs/omni/client/api/omni/autoscaler"
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/autoscaler"
+)
+
+func clusterContext(ctx context.Context, clusterID string) context.Context {
+	return peer.NewContext(ctx, &peer.Peer{
+		AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{
+				VerifiedChains: [][]*x509.Certificate{{{Subject: pkix.Name{CommonName: clusterID}}}},
+			},
+		},
+	})
+}
+
+func createMachineSet(ctx context.Context, t *testing.T, st state.State, id, clusterID string, autoscaling *specs.MachineSetSpec_Autoscaling, machines ...string) {
+	machineSet := omni.NewMachineSet(resources.DefaultNamespace, id)
+
+	machineSet.Metadata().Labels().Set(omni.LabelCluster, clusterID)
+	machineSet.Metadata().Labels().Set(omni.LabelWorkerRole, "")
+
+	machineSet.TypedSpec().Value.MachineClass = &specs.MachineSetSpec_MachineClass{
+		Name:         "workers",
+		MachineCount: uint32(len(machines)),
+	}
+	machineSet.TypedSpec().Value.Autoscaling = autoscaling
+
+	require.NoError(t, st.Create(ctx, machineSet))
+
+	for _, machine := range machines {
+		require.NoError(t, st.Create(ctx, omni.NewMachineSetNode(resources.DefaultNamespace, machine, machineSet)))
+	}
+}
+
+func TestServer(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	createMachineSet(ctx, t, st, "c1-workers", "c1", &specs.MachineSetSpec_Autoscaling{MinSize: 1, MaxSize: 4}, "m1", "m2")
+	createMachineSet(ctx, t, st, "c1-static", "c1", nil, "m3")
+	createMachineSet(ctx, t, st, "c2-workers", "c2", &specs.MachineSetSpec_Autoscaling{MinSize: 0, MaxSize: 2}, "m4")
+
+	clusterMachineStatus := omni.NewClusterMachineStatus(resources.DefaultNamespace, "m1")
+	clusterMachineStatus.Metadata().Labels().Set(omni.LabelCluster, "c1")
+	clusterMachineStatus.Metadata().Labels().Set(omni.LabelMachineSet, "c1-workers")
+	clusterMachineStatus.Metadata().Labels().Set(omni.ClusterMachineStatusLabelNodeName, "node-1")
+	clusterMachineStatus.TypedSpec().Value.Stage = specs.ClusterMachineStatusSpec_RUNNING
+
+	require.NoError(t, st.Create(ctx, clusterMachineStatus))
+
+	server := autoscaler.NewServer(st, zaptest.NewLogger(t))
+
+	_, err := server.NodeGroups(ctx, &autoscalerapi.NodeGroupsRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	c1 := clusterContext(ctx, "c1")
+
+	nodeGroups, err := server.NodeGroups(c1, &autoscalerapi.NodeGroupsRequest{})
+	require.NoError(t, err)
+
+	require.Len(t, nodeGroups.NodeGroups, 1)
+	assert.Equal(t, "c1-workers", nodeGroups.NodeGroups[0].Id)
+	assert.EqualValues(t, 1, nodeGroups.NodeGroups[0].MinSize)
+	assert.EqualValues(t, 4, nodeGroups.NodeGroups[0].MaxSize)
+
+	for _, tt := range []struct {
+		node     *autoscalerapi.ExternalGrpcNode
+		ctx      context.Context //nolint:containedctx
+		expected string
+	}{
+		{node: &autoscalerapi.ExternalGrpcNode{Name: "node-1"}, ctx: c1, expected: "c1-workers"},
+		{node: &autoscalerapi.ExternalGrpcNode{ProviderID: omni.AutoscalerProviderID("m2")}, ctx: c1, expected: "c1-workers"},
+		{node: &autoscalerapi.ExternalGrpcNode{ProviderID: omni.AutoscalerProviderID("m3")}, ctx: c1},
+		{node: &autoscalerapi.ExternalGrpcNode{Name: "unknown"}, ctx: c1},
+		// the machines of the other clusters are not visible
+		{node: &autoscalerapi.ExternalGrpcNode{ProviderID: omni.AutoscalerProviderID("m1")}, ctx: clusterContext(ctx, "c2")},
+	} {
+		resp, nodeErr := server.NodeGroupForNode(tt.ctx, &autoscalerapi.NodeGroupForNodeRequest{Node: tt.node})
+		require.NoError(t, nodeErr)
+
+		assert.Equal(t, tt.expected, resp.NodeGroup.Id, tt.node.String())
+	}
+
+	_, err = server.NodeGroupTargetSize(c1, &autoscalerapi.NodeGroupTargetSizeRequest{Id: "c2-workers"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	assertTargetSize := func(expected int32) {
+		t.Helper()
+
+		resp, sizeErr := server.NodeGroupTargetSize(c1, &autoscalerapi.NodeGroupTargetSizeRequest{Id: "c1-workers"})
+		require.NoError(t, sizeErr)
+
+		assert.Equal(t, expected, resp.TargetSize)
+	}
+
+	assertTargetSize(2)
+
+	_, err = server.NodeGroupIncreaseSize(c1, &autoscalerapi.NodeGroupIncreaseSizeRequest{Id: "c1-workers", Delta: 3})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = server.NodeGroupIncreaseSize(c1, &autoscalerapi.NodeGroupIncreaseSizeRequest{Id: "c1-workers", Delta: 2})
+	require.NoError(t, err)
+
+	assertTargetSize(4)
+
+	// the existing machines can't be removed by decreasing the target size
+	_, err = server.NodeGroupDecreaseTargetSize(c1, &autoscalerapi.NodeGroupDecreaseTargetSizeRequest{Id: "c1-workers", Delta: -3})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = server.NodeGroupDecreaseTargetSize(c1, &autoscalerapi.NodeGroupDecreaseTargetSizeRequest{Id: "c1-workers", Delta: -2})
+	require.NoError(t, err)
+
+	assertTargetSize(2)
+
+	nodes, err := server.NodeGroupNodes(c1, &autoscalerapi.NodeGroupNodesRequest{Id: "c1-workers"})
+	require.NoError(t, err)
+
+	require.Len(t, nodes.Instances, 2)
+	assert.Equal(t, omni.AutoscalerProviderID("m1"), nodes.Instances[0].Id)
+	assert.Equal(t, autoscalerapi.InstanceStatus_instanceRunning, nodes.Instances[0].Status.InstanceState)
+	assert.Equal(t, omni.AutoscalerProviderID("m2"), nodes.Instances[1].Id)
+	assert.Equal(t, autoscalerapi.InstanceStatus_instanceCreating, nodes.Instances[1].Status.InstanceState)
+
+	_, err = server.NodeGroupDeleteNodes(c1, &autoscalerapi.NodeGroupDeleteNodesRequest{
+		Id:    "c1-workers",
+		Nodes: []*autoscalerapi.ExternalGrpcNode{{ProviderID: omni.AutoscalerProviderID("m3")}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = server.NodeGroupDeleteNodes(c1, &autoscalerapi.NodeGroupDeleteNodesRequest{
+		Id:    "c1-workers",
+		Nodes: []*autoscalerapi.ExternalGrpcNode{{Name: "node-1"}},
+	})
+	require.NoError(t, err)
+
+	assertTargetSize(1)
+
+	machineSet, err := safe.StateGetByID[*omni.MachineSet](ctx, st, "c1-workers")
+	require.NoError(t, err)
+
+	scaleDown, _ := machineSet.Metadata().Annotations().Get(omni.ScaleDownMachines)
+	assert.Equal(t, "m1", scaleDown)
+
+	// the min size is reached
+	_, err = server.NodeGroupDeleteNodes(c1, &autoscalerapi.NodeGroupDeleteNodesRequest{
+		Id:    "c1-workers",
+		Nodes: []*autoscalerapi.ExternalGrpcNode{{ProviderID: omni.AutoscalerProviderID("m2")}},
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
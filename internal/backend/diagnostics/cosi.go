@@ -0,0 +1,208 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package diagnostics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+)
+
+// COSIDump is the snapshot of the COSI runtime.
+type COSIDump struct {
+	Controllers []Controller    `json:"controllers"`
+	Resources   []ResourceCount `json:"resources"`
+}
+
+// Controller describes the registered controller.
+type Controller struct {
+	Name    string       `json:"name"`
+	Inputs  []Dependency `json:"inputs,omitempty"`
+	Outputs []Dependency `json:"outputs,omitempty"`
+}
+
+// Dependency is the resource type a controller reads or writes.
+type Dependency struct {
+	Namespace string `json:"namespace,omitempty"`
+	Type      string `json:"type"`
+	ID        string `json:"id,omitempty"`
+	Kind      string `json:"kind"`
+}
+
+// ResourceCount is the number of the resources of a type stored in its default namespace.
+type ResourceCount struct {
+	Type      string `json:"type"`
+	Namespace string `json:"namespace"`
+	Count     int    `json:"count"`
+}
+
+// EdgeKind returns the human-readable name of the dependency edge type.
+func EdgeKind(edgeType controller.DependencyEdgeType) string {
+	switch edgeType {
+	case controller.EdgeOutputExclusive:
+		return "exclusive"
+	case controller.EdgeOutputShared:
+		return "shared"
+	case controller.EdgeInputStrong:
+		return "strong"
+	case controller.EdgeInputWeak:
+		return "weak"
+	case controller.EdgeInputDestroyReady:
+		return "destroy-ready"
+	case controller.EdgeInputQPrimary:
+		return "primary"
+	case controller.EdgeInputQMapped:
+		return "mapped"
+	case controller.EdgeInputQMappedDestroyReady:
+		return "mapped-destroy-ready"
+	default:
+		return fmt.Sprintf("unknown(%d)", edgeType)
+	}
+}
+
+func isOutput(edgeType controller.DependencyEdgeType) bool {
+	return edgeType == controller.EdgeOutputExclusive || edgeType == controller.EdgeOutputShared
+}
+
+func (handler *Handler) handleCOSIDump(w http.ResponseWriter, r *http.Request) {
+	dump, err := handler.cosiDump(actor.MarkContextAsInternalActor(r.Context()))
+	if err != nil {
+		handler.logger.Error("failed to build COSI runtime dump", zap.Error(err))
+
+		http.Error(w, fmt.Sprintf("Internal server error: %s", err), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	if err = encoder.Encode(dump); err != nil {
+		handler.logger.Error("failed to write COSI runtime dump", zap.Error(err))
+	}
+}
+
+func (handler *Handler) cosiDump(ctx context.Context) (*COSIDump, error) {
+	depGraph, err := handler.runtime.GetDependencyGraph()
+	if err != nil {
+		return nil, err
+	}
+
+	dump := &COSIDump{
+		Controllers: buildControllers(depGraph),
+	}
+
+	err = listResources(ctx, handler.state, resourceTypes(depGraph), func(resourceType resource.Type, namespace resource.Namespace, items []resource.Resource) {
+		dump.Resources = append(dump.Resources, ResourceCount{
+			Type:      resourceType,
+			Namespace: namespace,
+			Count:     len(items),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return dump, nil
+}
+
+// buildControllers groups the dependency graph edges by the controller, the controllers are sorted by the name.
+func buildControllers(depGraph *controller.DependencyGraph) []Controller {
+	controllers := map[string]*Controller{}
+
+	for _, edge := range depGraph.Edges {
+		ctrl, ok := controllers[edge.ControllerName]
+		if !ok {
+			ctrl = &Controller{Name: edge.ControllerName}
+			controllers[edge.ControllerName] = ctrl
+		}
+
+		dependency := Dependency{
+			Namespace: edge.ResourceNamespace,
+			Type:      edge.ResourceType,
+			ID:        edge.ResourceID,
+			Kind:      EdgeKind(edge.EdgeType),
+		}
+
+		if isOutput(edge.EdgeType) {
+			ctrl.Outputs = append(ctrl.Outputs, dependency)
+		} else {
+			ctrl.Inputs = append(ctrl.Inputs, dependency)
+		}
+	}
+
+	result := make([]Controller, 0, len(controllers))
+
+	for _, ctrl := range controllers {
+		// the outputs are exported from the maps, sort the dependencies to keep the output stable
+		slices.SortStableFunc(ctrl.Inputs, compareDependencies)
+		slices.SortStableFunc(ctrl.Outputs, compareDependencies)
+
+		result = append(result, *ctrl)
+	}
+
+	slices.SortFunc(result, func(a, b Controller) int { return strings.Compare(a.Name, b.Name) })
+
+	return result
+}
+
+func compareDependencies(a, b Dependency) int {
+	if c := strings.Compare(a.Type, b.Type); c != 0 {
+		return c
+	}
+
+	return strings.Compare(a.Kind, b.Kind)
+}
+
+// resourceTypes returns the sorted resource types referenced in the dependency graph.
+func resourceTypes(depGraph *controller.DependencyGraph) []resource.Type {
+	types := make([]resource.Type, 0, len(depGraph.Edges))
+
+	for _, edge := range depGraph.Edges {
+		types = append(types, edge.ResourceType)
+	}
+
+	slices.Sort(types)
+
+	return slices.Compact(types)
+}
+
+// listResources lists the resources of each type in its default namespace.
+func listResources(ctx context.Context, st state.State, types []resource.Type, fn func(resource.Type, resource.Namespace, []resource.Resource)) error {
+	for _, resourceType := range types {
+		rd, err := safe.StateGet[*meta.ResourceDefinition](ctx, st,
+			resource.NewMetadata(meta.NamespaceName, meta.ResourceDefinitionType, strings.ToLower(resourceType), resource.VersionUndefined),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to get the resource definition of %q: %w", resourceType, err)
+		}
+
+		namespace := rd.TypedSpec().DefaultNamespace
+
+		items, err := st.List(ctx, resource.NewMetadata(namespace, resourceType, "", resource.VersionUndefined))
+		if err != nil {
+			return fmt.Errorf("failed to list %q: %w", resourceType, err)
+		}
+
+		fn(resourceType, namespace, items.Items)
+	}
+
+	return nil
+}
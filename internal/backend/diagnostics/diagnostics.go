@@ -0,0 +1,134 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package diagnostics provides the admin-only HTTP endpoints exposing the runtime diagnostics of Omni:
+// pprof profiles, goroutine dumps, the COSI runtime state, the controller dependency graph and the calls blocked in the dry-run mode.
+package diagnostics
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/pprof"
+	runtimepprof "runtime/pprof"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/controller/runtime"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/internal/backend/dryrun"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+// Prefix is the path prefix the handler is mounted on.
+const Prefix = "/diagnostics/"
+
+// Handler of diagnostics requests.
+type Handler struct {
+	runtime *runtime.Runtime
+	state   state.State
+	logger  *zap.Logger
+}
+
+// NewHandler creates a new diagnostics handler.
+//
+// The handler expects the auth configuration and the request signature to be already verified and stored in the request context.
+func NewHandler(runtime *runtime.Runtime, state state.State, logger *zap.Logger) *Handler {
+	return &Handler{
+		runtime: runtime,
+		state:   state,
+		logger:  logger,
+	}
+}
+
+// ServeHTTP handles diagnostics requests.
+func (handler *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	io.Copy(io.Discard, r.Body) //nolint:errcheck
+	r.Body.Close()              //nolint:errcheck
+
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	checkResult, err := auth.Check(r.Context(), auth.WithRole(role.Admin))
+	if err != nil {
+		switch {
+		case errors.Is(err, auth.ErrUnauthenticated):
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		case errors.Is(err, auth.ErrUnauthorized):
+			http.Error(w, "Forbidden", http.StatusForbidden)
+		default:
+			http.Error(w, "Internal server error", http.StatusInternalServerError)
+		}
+
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, Prefix)
+
+	handler.logger.Info("diagnostics requested", zap.String("path", path), zap.String("identity", checkResult.Identity))
+
+	switch {
+	case path == "goroutines":
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+
+		if err = runtimepprof.Lookup("goroutine").WriteTo(w, 2); err != nil {
+			handler.logger.Error("failed to write goroutine dump", zap.Error(err))
+		}
+	case path == "cosi":
+		handler.handleCOSIDump(w, r)
+	case path == "controller-graph":
+		handler.handleControllerGraph(w, r)
+	case path == "dry-run":
+		handler.handleDryRun(w)
+	case strings.HasPrefix(path, "pprof/"):
+		handler.handlePprof(w, r, strings.TrimPrefix(path, "pprof/"))
+	default:
+		http.Error(w, "Not found", http.StatusNotFound)
+	}
+}
+
+func (handler *Handler) handlePprof(w http.ResponseWriter, r *http.Request, name string) {
+	switch name {
+	case "profile":
+		// CPU profile, the duration is set by the "seconds" query parameter
+		pprof.Profile(w, r)
+	case "trace":
+		pprof.Trace(w, r)
+	case "symbol":
+		pprof.Symbol(w, r)
+	default:
+		// the index and cmdline handlers are not exposed: the former is bound to /debug/pprof/, the latter might reveal the secrets passed as flags
+		if runtimepprof.Lookup(name) == nil {
+			http.Error(w, "Unknown profile", http.StatusNotFound)
+
+			return
+		}
+
+		pprof.Handler(name).ServeHTTP(w, r)
+	}
+}
+
+func (handler *Handler) handleDryRun(w http.ResponseWriter) {
+	if !dryrun.Enabled() {
+		http.Error(w, "Dry-run mode is not enabled", http.StatusNotFound)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(dryrun.Records()); err != nil {
+		handler.logger.Error("failed to write dry-run records", zap.Error(err))
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package diagnostics_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/omni/internal/backend/diagnostics"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	handler := diagnostics.NewHandler(nil, nil, zaptest.NewLogger(t))
+
+	withRole := func(r role.Role) context.Context {
+		ctx := context.WithValue(context.Background(), auth.EnabledAuthContextKey{}, true)
+
+		return context.WithValue(ctx, auth.RoleContextKey{}, r)
+	}
+
+	for _, tt := range []struct {
+		ctx          context.Context //nolint:containedctx
+		name         string
+		method       string
+		path         string
+		expectedCode int
+	}{
+		{
+			name:         "no auth config",
+			ctx:          context.Background(),
+			path:         "/diagnostics/goroutines",
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "not authenticated",
+			ctx:          context.WithValue(context.Background(), auth.EnabledAuthContextKey{}, true),
+			path:         "/diagnostics/goroutines",
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "operator",
+			ctx:          withRole(role.Operator),
+			path:         "/diagnostics/goroutines",
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "admin goroutines",
+			ctx:          withRole(role.Admin),
+			path:         "/diagnostics/goroutines",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "admin heap profile",
+			ctx:          withRole(role.Admin),
+			path:         "/diagnostics/pprof/heap",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "unknown profile",
+			ctx:          withRole(role.Admin),
+			path:         "/diagnostics/pprof/cmdline",
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:         "method not allowed",
+			ctx:          withRole(role.Admin),
+			method:       http.MethodPost,
+			path:         "/diagnostics/goroutines",
+			expectedCode: http.StatusMethodNotAllowed,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			method := tt.method
+			if method == "" {
+				method = http.MethodGet
+			}
+
+			req := httptest.NewRequest(method, tt.path, nil).WithContext(tt.ctx)
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code, w.Body.String())
+
+			if tt.expectedCode == http.StatusOK {
+				assert.NotEmpty(t, w.Body.Bytes())
+			}
+		})
+	}
+}
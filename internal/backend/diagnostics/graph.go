@@ -0,0 +1,244 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package diagnostics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/controller/runtime"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/emicklei/dot"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+)
+
+// Graph formats.
+const (
+	FormatJSON = "json"
+	FormatDOT  = "dot"
+)
+
+// Graph is the controller dependency graph.
+type Graph struct {
+	Controllers []Controller `json:"controllers"`
+
+	// Finalizers are the finalizers currently set on the resources, they are collected only from the running Omni.
+	Finalizers []Finalizer `json:"finalizers,omitempty"`
+}
+
+// Finalizer is a finalizer set on the resources of a type.
+type Finalizer struct {
+	Type  string `json:"type"`
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// BuildGraph builds the controller dependency graph from the controllers registered in the runtime.
+//
+// If the state is set, the finalizers of the resources are collected as well.
+func BuildGraph(ctx context.Context, rt *runtime.Runtime, st state.State) (*Graph, error) {
+	depGraph, err := rt.GetDependencyGraph()
+	if err != nil {
+		return nil, err
+	}
+
+	graph := &Graph{
+		Controllers: buildControllers(depGraph),
+	}
+
+	if st == nil {
+		return graph, nil
+	}
+
+	err = listResources(ctx, st, resourceTypes(depGraph), func(resourceType resource.Type, _ resource.Namespace, items []resource.Resource) {
+		counts := map[string]int{}
+
+		for _, item := range items {
+			for _, fin := range *item.Metadata().Finalizers() {
+				counts[fin]++
+			}
+		}
+
+		for name, count := range counts {
+			graph.Finalizers = append(graph.Finalizers, Finalizer{
+				Type:  resourceType,
+				Name:  name,
+				Count: count,
+			})
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(graph.Finalizers, func(a, b Finalizer) int {
+		if c := strings.Compare(a.Type, b.Type); c != 0 {
+			return c
+		}
+
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return graph, nil
+}
+
+// Write writes the graph in the given format.
+func (graph *Graph) Write(w io.Writer, format string) error {
+	switch format {
+	case FormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+
+		return encoder.Encode(graph)
+	case FormatDOT:
+		graph.dot().Write(w)
+
+		return nil
+	default:
+		return fmt.Errorf("unsupported graph format %q", format)
+	}
+}
+
+// dot renders the graph: the controllers are boxes, the resource types are notes.
+//
+// Solid edges are the strong inputs and the outputs (bold if exclusive), dotted edges are the weak inputs,
+// dashed edges are the teardown dependencies, the red edges are the finalizers set by the controllers on the resources.
+func (graph *Graph) dot() *dot.Graph {
+	g := dot.NewGraph(dot.Directed)
+
+	resourceNode := func(resourceType resource.Type) dot.Node {
+		return g.Node(resourceType).
+			Attr("shape", "note").
+			Attr("fillcolor", "azure2").
+			Attr("style", "filled")
+	}
+
+	controllers := make(map[string]struct{}, len(graph.Controllers))
+
+	for _, ctrl := range graph.Controllers {
+		controllers[ctrl.Name] = struct{}{}
+
+		ctrlNode := g.Node(ctrl.Name).Box()
+
+		for _, output := range ctrl.Outputs {
+			edge := g.Edge(ctrlNode, resourceNode(output.Type))
+
+			if output.Kind == EdgeKind(controller.EdgeOutputExclusive) {
+				edge.Bold()
+			}
+		}
+
+		for _, input := range ctrl.Inputs {
+			var labels []string
+
+			if input.ID != "" {
+				labels = append(labels, input.ID)
+			}
+
+			edge := g.Edge(resourceNode(input.Type), ctrlNode, labels...)
+
+			switch input.Kind {
+			case EdgeKind(controller.EdgeInputWeak), EdgeKind(controller.EdgeInputQMapped):
+				edge.Dotted()
+			case EdgeKind(controller.EdgeInputDestroyReady), EdgeKind(controller.EdgeInputQMappedDestroyReady):
+				edge.Dashed()
+			}
+		}
+	}
+
+	for _, fin := range graph.Finalizers {
+		// only the finalizers set by the controllers are the part of the graph
+		if _, ok := controllers[fin.Name]; !ok {
+			continue
+		}
+
+		g.Edge(g.Node(fin.Name), resourceNode(fin.Type), "finalizer ("+strconv.Itoa(fin.Count)+")").
+			Attr("color", "red").
+			Dashed()
+	}
+
+	return g
+}
+
+// ExportGraph writes the controller dependency graph to the file, the format is detected by the file extension.
+func ExportGraph(path string, rt *runtime.Runtime) error {
+	format := strings.TrimPrefix(filepath.Ext(path), ".")
+	if format == "gv" {
+		format = FormatDOT
+	}
+
+	if format != FormatDOT && format != FormatJSON {
+		return fmt.Errorf("unsupported controller graph file extension %q, expected .dot, .gv or .json", filepath.Ext(path))
+	}
+
+	graph, err := BuildGraph(context.Background(), rt, nil)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err = graph.Write(f, format); err != nil {
+		f.Close() //nolint:errcheck
+
+		return err
+	}
+
+	return f.Close()
+}
+
+func (handler *Handler) handleControllerGraph(w http.ResponseWriter, r *http.Request) {
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = FormatJSON
+	}
+
+	if format != FormatDOT && format != FormatJSON {
+		http.Error(w, fmt.Sprintf("Unsupported format %q", format), http.StatusBadRequest)
+
+		return
+	}
+
+	var st state.State
+
+	if finalizers, _ := strconv.ParseBool(r.URL.Query().Get("finalizers")); finalizers { //nolint:errcheck
+		st = handler.state
+	}
+
+	graph, err := BuildGraph(actor.MarkContextAsInternalActor(r.Context()), handler.runtime, st)
+	if err != nil {
+		handler.logger.Error("failed to build controller graph", zap.Error(err))
+
+		http.Error(w, fmt.Sprintf("Internal server error: %s", err), http.StatusInternalServerError)
+
+		return
+	}
+
+	if format == FormatJSON {
+		w.Header().Set("Content-Type", "application/json")
+	} else {
+		w.Header().Set("Content-Type", "text/vnd.graphviz")
+	}
+
+	if err = graph.Write(w, format); err != nil {
+		handler.logger.Error("failed to write controller graph", zap.Error(err))
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package diagnostics_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/controller/conformance"
+	"github.com/cosi-project/runtime/pkg/controller/runtime"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/omni/internal/backend/diagnostics"
+)
+
+func newRuntime(t *testing.T) *runtime.Runtime {
+	t.Helper()
+
+	rt, err := runtime.NewRuntime(state.WrapCore(namespaced.NewState(inmem.Build)), zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	require.NoError(t, rt.RegisterController(&conformance.IntToStrController{
+		SourceNamespace: "ints",
+		TargetNamespace: "strings",
+	}))
+
+	return rt
+}
+
+func TestBuildGraph(t *testing.T) {
+	t.Parallel()
+
+	graph, err := diagnostics.BuildGraph(context.Background(), newRuntime(t), nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []diagnostics.Controller{
+		{
+			Name: "IntToStrController",
+			Inputs: []diagnostics.Dependency{
+				{Namespace: "ints", Type: conformance.IntResourceType, Kind: "strong"},
+				{Namespace: "strings", Type: conformance.StrResourceType, Kind: "destroy-ready"},
+			},
+			Outputs: []diagnostics.Dependency{
+				{Type: conformance.StrResourceType, Kind: "exclusive"},
+			},
+		},
+	}, graph.Controllers)
+
+	var sb strings.Builder
+
+	require.NoError(t, graph.Write(&sb, diagnostics.FormatDOT))
+	assert.Contains(t, sb.String(), "IntToStrController")
+	assert.Contains(t, sb.String(), conformance.StrResourceType)
+
+	require.Error(t, graph.Write(&sb, "svg"))
+}
+
+func TestExportGraph(t *testing.T) {
+	t.Parallel()
+
+	rt := newRuntime(t)
+	path := filepath.Join(t.TempDir(), "graph.json")
+
+	require.NoError(t, diagnostics.ExportGraph(path, rt))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var graph diagnostics.Graph
+
+	require.NoError(t, json.Unmarshal(data, &graph))
+	require.Len(t, graph.Controllers, 1)
+	assert.Equal(t, "IntToStrController", graph.Controllers[0].Name)
+
+	require.Error(t, diagnostics.ExportGraph(filepath.Join(t.TempDir(), "graph.png"), rt))
+}
@@ -7,6 +7,7 @@ package discovery
 
 import (
 	"context"
+	"crypto/cipher"
 	"crypto/tls"
 	"fmt"
 	"net"
@@ -69,6 +70,57 @@ func (client *Client) AffiliateDelete(ctx context.Context, cluster, affiliate st
 	return nil
 }
 
+// Affiliate is a cluster member registered in the discovery service.
+type Affiliate struct {
+	ID              string
+	Hostname        string
+	Nodename        string
+	MachineType     string
+	OperatingSystem string
+	KubeSpanKey     string
+	Addresses       []string
+	Endpoints       []string
+	ControlPlane    bool
+
+	// Decrypted is false if the affiliate data can't be decrypted with the cluster secrets, only the ID is set then.
+	Decrypted bool
+}
+
+// ListAffiliates lists the affiliates of the given cluster, decrypting their data with the first matching cluster secret.
+//
+// The cluster secret is the base64 encoded encryption key of the discovery service data, as in the Talos machine config.
+// Several secrets are accepted while the key is being rotated.
+func (client *Client) ListAffiliates(ctx context.Context, cluster string, clusterSecrets ...string) ([]Affiliate, error) {
+	ciphers := make([]cipher.AEAD, 0, len(clusterSecrets))
+
+	for _, clusterSecret := range clusterSecrets {
+		aead, err := newCipher(clusterSecret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create cipher for cluster %q: %w", cluster, err)
+		}
+
+		ciphers = append(ciphers, aead)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, callTimeout)
+	defer cancel()
+
+	resp, err := client.clusterClient.List(ctx, &serverpb.ListRequest{
+		ClusterId: cluster,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list affiliates for cluster %q: %w", cluster, err)
+	}
+
+	affiliates := make([]Affiliate, 0, len(resp.GetAffiliates()))
+
+	for _, affiliate := range resp.GetAffiliates() {
+		affiliates = append(affiliates, decodeAffiliate(ciphers, affiliate))
+	}
+
+	return affiliates, nil
+}
+
 // Close closes the underlying connection to the discovery service.
 func (client *Client) Close() error {
 	return client.conn.Close()
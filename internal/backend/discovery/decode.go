@@ -0,0 +1,110 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package discovery
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
+	"errors"
+	"net/netip"
+
+	clientpb "github.com/siderolabs/discovery-api/api/v1alpha1/client/pb"
+	serverpb "github.com/siderolabs/discovery-api/api/v1alpha1/server/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// newCipher creates the cipher used by Talos to encrypt the discovery service data.
+func newCipher(clusterSecret string) (cipher.AEAD, error) {
+	key, err := base64.StdEncoding.DecodeString(clusterSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// decrypt decrypts the data, which is prefixed with the nonce.
+func decrypt(aead cipher.AEAD, data []byte) ([]byte, error) {
+	if len(data) < aead.NonceSize() {
+		return nil, errors.New("data is too short")
+	}
+
+	return aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], nil)
+}
+
+func decodeAffiliate(ciphers []cipher.AEAD, affiliate *serverpb.Affiliate) Affiliate {
+	result := Affiliate{
+		ID: affiliate.GetId(),
+	}
+
+	var (
+		aead cipher.AEAD
+		data []byte
+		err  error
+	)
+
+	for _, aead = range ciphers {
+		if data, err = decrypt(aead, affiliate.GetData()); err == nil {
+			break
+		}
+	}
+
+	if aead == nil || err != nil {
+		return result
+	}
+
+	var spec clientpb.Affiliate
+
+	if err = proto.Unmarshal(data, &spec); err != nil {
+		return result
+	}
+
+	result.Decrypted = true
+	result.Hostname = spec.GetHostname()
+	result.Nodename = spec.GetNodename()
+	result.MachineType = spec.GetMachineType()
+	result.OperatingSystem = spec.GetOperatingSystem()
+	result.KubeSpanKey = spec.GetKubespan().GetPublicKey()
+	result.ControlPlane = spec.GetControlPlane() != nil
+
+	for _, addr := range spec.GetAddresses() {
+		var ip netip.Addr
+
+		if err = ip.UnmarshalBinary(addr); err == nil {
+			result.Addresses = append(result.Addresses, ip.String())
+		}
+	}
+
+	// endpoints are encrypted separately, as they might be submitted by the other affiliates
+	for _, endpointData := range affiliate.GetEndpoints() {
+		data, err = decrypt(aead, endpointData)
+		if err != nil {
+			continue
+		}
+
+		var endpoint clientpb.Endpoint
+
+		if err = proto.Unmarshal(data, &endpoint); err != nil {
+			continue
+		}
+
+		var ip netip.Addr
+
+		if err = ip.UnmarshalBinary(endpoint.GetIp()); err != nil {
+			continue
+		}
+
+		result.Endpoints = append(result.Endpoints, netip.AddrPortFrom(ip, uint16(endpoint.GetPort())).String()) //nolint:gosec
+	}
+
+	return result
+}
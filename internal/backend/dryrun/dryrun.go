@@ -0,0 +1,287 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package dryrun implements the dry-run mode of the Omni backend.
+//
+// In the dry-run mode the controllers reconcile as usual, but the calls which would change the machines are not sent:
+// the mutating Talos API calls and the Kubernetes API writes are blocked, logged and recorded.
+// It is useful to verify that the Omni restored from a backup doesn't immediately mutate the fleet.
+package dryrun
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	cosiv1alpha1 "github.com/cosi-project/runtime/api/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/api/cluster"
+	"github.com/siderolabs/talos/pkg/machinery/api/inspect"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/api/storage"
+	timeapi "github.com/siderolabs/talos/pkg/machinery/api/time"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/client-go/rest"
+)
+
+// Targets of the blocked calls.
+const (
+	TargetTalos      = "talos"
+	TargetKubernetes = "kubernetes"
+)
+
+// readOnlyTalosMethods are the Talos API methods which don't change the machine state.
+var readOnlyTalosMethods = map[string]struct{}{
+	cosiv1alpha1.State_Get_FullMethodName:                               {},
+	cosiv1alpha1.State_List_FullMethodName:                              {},
+	cosiv1alpha1.State_Watch_FullMethodName:                             {},
+	cluster.ClusterService_HealthCheck_FullMethodName:                   {},
+	inspect.InspectService_ControllerRuntimeDependencies_FullMethodName: {},
+	machine.MachineService_CPUInfo_FullMethodName:                       {},
+	machine.MachineService_Containers_FullMethodName:                    {},
+	machine.MachineService_Copy_FullMethodName:                          {},
+	machine.MachineService_DiskStats_FullMethodName:                     {},
+	machine.MachineService_DiskUsage_FullMethodName:                     {},
+	machine.MachineService_Dmesg_FullMethodName:                         {},
+	machine.MachineService_EtcdAlarmList_FullMethodName:                 {},
+	machine.MachineService_EtcdMemberList_FullMethodName:                {},
+	machine.MachineService_EtcdSnapshot_FullMethodName:                  {},
+	machine.MachineService_EtcdStatus_FullMethodName:                    {},
+	machine.MachineService_Events_FullMethodName:                        {},
+	machine.MachineService_GenerateClientConfiguration_FullMethodName:   {},
+	machine.MachineService_Hostname_FullMethodName:                      {},
+	machine.MachineService_ImageList_FullMethodName:                     {},
+	machine.MachineService_Kubeconfig_FullMethodName:                    {},
+	machine.MachineService_List_FullMethodName:                          {},
+	machine.MachineService_LoadAvg_FullMethodName:                       {},
+	machine.MachineService_Logs_FullMethodName:                          {},
+	machine.MachineService_LogsContainers_FullMethodName:                {},
+	machine.MachineService_Memory_FullMethodName:                        {},
+	machine.MachineService_Mounts_FullMethodName:                        {},
+	machine.MachineService_Netstat_FullMethodName:                       {},
+	machine.MachineService_NetworkDeviceStats_FullMethodName:            {},
+	machine.MachineService_Processes_FullMethodName:                     {},
+	machine.MachineService_Read_FullMethodName:                          {},
+	machine.MachineService_ServiceList_FullMethodName:                   {},
+	machine.MachineService_Stats_FullMethodName:                         {},
+	machine.MachineService_SystemStat_FullMethodName:                    {},
+	machine.MachineService_Version_FullMethodName:                       {},
+	storage.StorageService_Disks_FullMethodName:                         {},
+	timeapi.TimeService_Time_FullMethodName:                             {},
+	timeapi.TimeService_TimeCheck_FullMethodName:                        {},
+}
+
+// Record is a blocked call.
+type Record struct {
+	Time   time.Time `json:"time"`
+	Target string    `json:"target"`
+	Method string    `json:"method"`
+
+	// Details describe the changes which would have been made, if known.
+	Details string `json:"details,omitempty"`
+}
+
+// Guard blocks the mutating calls and keeps the latest records of them.
+type Guard struct {
+	logger *zap.Logger
+
+	records []Record
+	size    int
+	next    int
+	mu      sync.Mutex
+}
+
+// NewGuard creates a new Guard which keeps the given number of the latest records.
+func NewGuard(size int, logger *zap.Logger) *Guard {
+	return &Guard{
+		logger:  logger,
+		size:    size,
+		records: make([]Record, 0, size),
+	}
+}
+
+// Records returns the recorded blocked calls starting with the oldest one.
+func (g *Guard) Records() []Record {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	result := make([]Record, 0, len(g.records))
+
+	for i := range g.records {
+		result = append(result, g.records[(g.next+i)%len(g.records)])
+	}
+
+	return result
+}
+
+func (g *Guard) record(target, method, details string) {
+	g.logger.Warn("dry-run: blocked the call", zap.String("target", target), zap.String("method", method), zap.String("details", details))
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	rec := Record{
+		Time:    time.Now(),
+		Target:  target,
+		Method:  method,
+		Details: details,
+	}
+
+	if len(g.records) < g.size {
+		g.records = append(g.records, rec)
+
+		return
+	}
+
+	if g.size == 0 {
+		return
+	}
+
+	g.records[g.next] = rec
+	g.next = (g.next + 1) % len(g.records)
+}
+
+func blockedError(method string) error {
+	return status.Errorf(codes.FailedPrecondition, "%s is not allowed in the dry-run mode", method)
+}
+
+// UnaryClientInterceptor returns the Talos API client interceptor blocking the mutating unary calls.
+//
+// The configuration is applied to the machine in the Talos dry-run mode instead, and the reported changes are recorded.
+func (g *Guard) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if _, ok := readOnlyTalosMethods[method]; ok {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		applyReq, ok := req.(*machine.ApplyConfigurationRequest)
+		if !ok {
+			g.record(TargetTalos, method, "")
+
+			return blockedError(method)
+		}
+
+		if applyReq.DryRun {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		dryRunReq := proto.Clone(applyReq).(*machine.ApplyConfigurationRequest) //nolint:forcetypeassert,errcheck
+		dryRunReq.DryRun = true
+
+		var dryRunResp machine.ApplyConfigurationResponse
+
+		if err := invoker(ctx, method, dryRunReq, &dryRunResp, cc, opts...); err != nil {
+			g.record(TargetTalos, method, fmt.Sprintf("failed to compute the changes: %s", err))
+
+			return blockedError(method)
+		}
+
+		for _, msg := range dryRunResp.GetMessages() {
+			details := msg.GetModeDetails()
+			if hostname := msg.GetMetadata().GetHostname(); hostname != "" {
+				details = hostname + ": " + details
+			}
+
+			g.record(TargetTalos, method, details)
+		}
+
+		return blockedError(method)
+	}
+}
+
+// StreamClientInterceptor returns the Talos API client interceptor blocking the mutating streaming calls.
+func (g *Guard) StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if _, ok := readOnlyTalosMethods[method]; ok {
+			return streamer(ctx, desc, cc, method, opts...)
+		}
+
+		g.record(TargetTalos, method, "")
+
+		return nil, blockedError(method)
+	}
+}
+
+// RoundTripper wraps the Kubernetes API transport, blocking the requests other than reads.
+func (g *Guard) RoundTripper(rt http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		switch req.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			return rt.RoundTrip(req)
+		}
+
+		method := req.Method + " " + req.URL.Path
+
+		g.record(TargetKubernetes, method, "")
+
+		return nil, fmt.Errorf("%s is not allowed in the dry-run mode", method)
+	})
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// recordsSize is the number of the latest blocked calls kept in the dry-run mode.
+const recordsSize = 1000
+
+// defaultGuard is set when the dry-run mode is enabled.
+var defaultGuard atomic.Pointer[Guard]
+
+// Enable turns on the dry-run mode for the whole backend.
+func Enable(logger *zap.Logger) {
+	defaultGuard.Store(NewGuard(recordsSize, logger))
+
+	logger.Warn("dry-run mode is enabled, the changes are not pushed to the machines")
+}
+
+// Enabled checks if the dry-run mode is enabled.
+func Enabled() bool {
+	return defaultGuard.Load() != nil
+}
+
+// Records returns the calls blocked in the dry-run mode.
+func Records() []Record {
+	guard := defaultGuard.Load()
+	if guard == nil {
+		return nil
+	}
+
+	return guard.Records()
+}
+
+// TalosClientOptions returns the Talos client options blocking the mutating calls if the dry-run mode is enabled.
+func TalosClientOptions() []client.OptionFunc {
+	guard := defaultGuard.Load()
+	if guard == nil {
+		return nil
+	}
+
+	return []client.OptionFunc{
+		client.WithGRPCDialOptions(
+			grpc.WithChainUnaryInterceptor(guard.UnaryClientInterceptor()),
+			grpc.WithChainStreamInterceptor(guard.StreamClientInterceptor()),
+		),
+	}
+}
+
+// WrapKubernetesConfig makes the Kubernetes client config block the writes if the dry-run mode is enabled.
+func WrapKubernetesConfig(config *rest.Config) {
+	guard := defaultGuard.Load()
+	if guard == nil {
+		return
+	}
+
+	config.Wrap(guard.RoundTripper)
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package dryrun_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/siderolabs/omni/internal/backend/dryrun"
+)
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	t.Parallel()
+
+	guard := dryrun.NewGuard(10, zaptest.NewLogger(t))
+	interceptor := guard.UnaryClientInterceptor()
+
+	var invoked []string
+
+	invoker := func(_ context.Context, method string, req, reply any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		invoked = append(invoked, method)
+
+		if applyReq, ok := req.(*machine.ApplyConfigurationRequest); ok {
+			if !applyReq.DryRun {
+				return status.Error(codes.Internal, "configuration applied")
+			}
+
+			reply.(*machine.ApplyConfigurationResponse).Messages = []*machine.ApplyConfiguration{ //nolint:forcetypeassert,errcheck
+				{
+					Metadata:    &common.Metadata{Hostname: "node-1"},
+					ModeDetails: "Config diff:\n+ machine.install.disk: /dev/vda",
+				},
+			}
+		}
+
+		return nil
+	}
+
+	ctx := context.Background()
+
+	// reads are passed through
+	require.NoError(t, interceptor(ctx, machine.MachineService_Version_FullMethodName,
+		&emptypb.Empty{}, &machine.VersionResponse{}, nil, invoker))
+
+	// writes are blocked
+	err := interceptor(ctx, machine.MachineService_Reboot_FullMethodName, &machine.RebootRequest{}, &machine.RebootResponse{}, nil, invoker)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// configuration is applied in the Talos dry-run mode
+	err = interceptor(ctx, machine.MachineService_ApplyConfiguration_FullMethodName,
+		&machine.ApplyConfigurationRequest{Data: []byte("config")}, &machine.ApplyConfigurationResponse{}, nil, invoker)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	assert.Equal(t, []string{machine.MachineService_Version_FullMethodName, machine.MachineService_ApplyConfiguration_FullMethodName}, invoked)
+
+	records := guard.Records()
+	require.Len(t, records, 2)
+
+	assert.Equal(t, dryrun.TargetTalos, records[0].Target)
+	assert.Equal(t, machine.MachineService_Reboot_FullMethodName, records[0].Method)
+	assert.Empty(t, records[0].Details)
+
+	assert.Equal(t, machine.MachineService_ApplyConfiguration_FullMethodName, records[1].Method)
+	assert.Equal(t, "node-1: Config diff:\n+ machine.install.disk: /dev/vda", records[1].Details)
+}
+
+func TestRoundTripper(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	t.Cleanup(server.Close)
+
+	guard := dryrun.NewGuard(10, zaptest.NewLogger(t))
+	httpClient := &http.Client{Transport: guard.RoundTripper(http.DefaultTransport)}
+
+	resp, err := httpClient.Get(server.URL + "/api/v1/nodes")
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodDelete, server.URL+"/api/v1/nodes/node-1", nil)
+	require.NoError(t, err)
+
+	_, err = httpClient.Do(req) //nolint:bodyclose
+	require.Error(t, err)
+
+	records := guard.Records()
+	require.Len(t, records, 1)
+	assert.Equal(t, dryrun.TargetKubernetes, records[0].Target)
+	assert.Equal(t, "DELETE /api/v1/nodes/node-1", records[0].Method)
+}
+
+func TestRecordsRingBuffer(t *testing.T) {
+	t.Parallel()
+
+	guard := dryrun.NewGuard(3, zaptest.NewLogger(t))
+	interceptor := guard.StreamClientInterceptor()
+
+	for i := range 5 {
+		_, err := interceptor(context.Background(), &grpc.StreamDesc{}, nil, "/machine.MachineService/Method"+strconv.Itoa(i), nil)
+		require.Error(t, err)
+	}
+
+	records := guard.Records()
+	require.Len(t, records, 3)
+
+	for i, record := range records {
+		assert.Equal(t, "/machine.MachineService/Method"+strconv.Itoa(i+2), record.Method)
+	}
+}
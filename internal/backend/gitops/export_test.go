@@ -0,0 +1,26 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package gitops
+
+import "context"
+
+func (s *Syncer) Sync(ctx context.Context, changedPaths ...string) error {
+	var changed map[string]struct{}
+
+	if len(changedPaths) > 0 {
+		changed = make(map[string]struct{}, len(changedPaths))
+
+		for _, path := range changedPaths {
+			changed[path] = struct{}{}
+		}
+	}
+
+	return s.sync(ctx, changed)
+}
+
+func (s *Syncer) TakePending() (map[string]struct{}, bool) {
+	return s.takePending()
+}
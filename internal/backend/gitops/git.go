@@ -0,0 +1,137 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package gitops
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+// repository is a local checkout of the remote Git repository.
+//
+// All operations are delegated to the git binary.
+type repository struct {
+	params config.GitOpsParams
+	dir    string
+}
+
+func newRepository(params config.GitOpsParams) (*repository, error) {
+	dir, err := filepath.Abs(params.WorkDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &repository{
+		params: params,
+		dir:    dir,
+	}, nil
+}
+
+// update fetches the configured branch and checks it out, returns the commit hash of the checkout.
+func (repo *repository) update(ctx context.Context) (string, error) {
+	env, err := repo.env()
+	if err != nil {
+		return "", err
+	}
+
+	if _, err = os.Stat(filepath.Join(repo.dir, ".git")); err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return "", err
+		}
+
+		if err = os.MkdirAll(repo.dir, 0o700); err != nil {
+			return "", err
+		}
+
+		if _, err = repo.git(ctx, env, "init", "--quiet"); err != nil {
+			return "", err
+		}
+	}
+
+	if _, err = repo.git(ctx, env, "fetch", "--quiet", "--force", "--prune", repo.params.Repository, repo.params.Branch); err != nil {
+		return "", fmt.Errorf("failed to fetch the branch %q: %w", repo.params.Branch, err)
+	}
+
+	if _, err = repo.git(ctx, env, "checkout", "--quiet", "--force", "--detach", "FETCH_HEAD"); err != nil {
+		return "", err
+	}
+
+	if _, err = repo.git(ctx, env, "clean", "--quiet", "--force", "-d", "-x"); err != nil {
+		return "", err
+	}
+
+	commit, err := repo.git(ctx, env, "rev-parse", "HEAD")
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(commit)), nil
+}
+
+// env builds the environment passing the credentials to git.
+//
+// The credentials are never written to the repository config and are not visible in the process arguments.
+func (repo *repository) env() ([]string, error) {
+	env := []string{
+		"GIT_TERMINAL_PROMPT=0",
+	}
+
+	switch {
+	case repo.params.SSHKeyPath != "":
+		sshCommand := []string{"ssh", "-i", repo.params.SSHKeyPath, "-o", "IdentitiesOnly=yes", "-o", "BatchMode=yes"}
+
+		if repo.params.SSHKnownHostsPath != "" {
+			sshCommand = append(sshCommand, "-o", "StrictHostKeyChecking=yes", "-o", "UserKnownHostsFile="+repo.params.SSHKnownHostsPath)
+		} else {
+			sshCommand = append(sshCommand, "-o", "StrictHostKeyChecking=accept-new", "-o", "UserKnownHostsFile="+filepath.Join(repo.dir, ".git", "known_hosts"))
+		}
+
+		env = append(env, "GIT_SSH_COMMAND="+strings.Join(sshCommand, " "))
+	case repo.params.TokenPath != "":
+		token, err := os.ReadFile(repo.params.TokenPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the Git access token: %w", err)
+		}
+
+		credentials := base64.StdEncoding.EncodeToString([]byte(repo.params.TokenUsername + ":" + strings.TrimSpace(string(token))))
+
+		env = append(env,
+			"GIT_CONFIG_COUNT=1",
+			"GIT_CONFIG_KEY_0=http.extraHeader",
+			"GIT_CONFIG_VALUE_0=Authorization: Basic "+credentials,
+		)
+	}
+
+	return env, nil
+}
+
+func (repo *repository) git(ctx context.Context, env []string, args ...string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, repo.params.GitPath, append([]string{"-C", repo.dir}, args...)...)
+	cmd.Env = append(os.Environ(), env...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("git %s: %w: %s", args[0], err, msg)
+		}
+
+		return nil, fmt.Errorf("git %s: %w", args[0], err)
+	}
+
+	return stdout.Bytes(), nil
+}
@@ -0,0 +1,357 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package gitops implements syncing the cluster templates from a Git repository.
+package gitops
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/template"
+	"github.com/siderolabs/omni/client/pkg/template/operations"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+// Syncer periodically pulls the Git repository and syncs the cluster templates found there to Omni.
+//
+// The result of the sync of each template is reported in the TemplateSyncStatus resource.
+type Syncer struct {
+	state     state.State
+	repo      *repository
+	logger    *zap.Logger
+	triggerCh chan struct{}
+
+	// pendingPaths are the changed files reported since the last sync, nil if all templates should be synced
+	pendingPaths map[string]struct{}
+	params       config.GitOpsParams
+	pendingMu    sync.Mutex
+	pending      bool
+}
+
+// NewSyncer creates a new Syncer.
+func NewSyncer(st state.State, params config.GitOpsParams, logger *zap.Logger) (*Syncer, error) {
+	if params.Repository == "" {
+		return nil, errors.New("git repository is not set")
+	}
+
+	if params.PollInterval <= 0 {
+		return nil, fmt.Errorf("invalid poll interval %s", params.PollInterval)
+	}
+
+	repo, err := newRepository(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Syncer{
+		state:     st,
+		repo:      repo,
+		logger:    logger,
+		triggerCh: make(chan struct{}, 1),
+		params:    params,
+	}, nil
+}
+
+// Trigger schedules an immediate sync without waiting for the poll interval.
+//
+// If the changed files are given, only the templates affected by the changes are synced,
+// the paths are relative to the repository root.
+func (s *Syncer) Trigger(changedPaths ...string) {
+	s.pendingMu.Lock()
+
+	switch {
+	case len(changedPaths) == 0:
+		s.pendingPaths = nil
+	case !s.pending || s.pendingPaths != nil:
+		if s.pendingPaths == nil {
+			s.pendingPaths = make(map[string]struct{}, len(changedPaths))
+		}
+
+		for _, path := range changedPaths {
+			s.pendingPaths[path] = struct{}{}
+		}
+	}
+
+	s.pending = true
+
+	s.pendingMu.Unlock()
+
+	select {
+	case s.triggerCh <- struct{}{}:
+	default:
+	}
+}
+
+// Run the sync loop.
+func (s *Syncer) Run(ctx context.Context) error {
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	ticker := time.NewTicker(s.params.PollInterval)
+	defer ticker.Stop()
+
+	var changedPaths map[string]struct{}
+
+	for {
+		if err := s.sync(ctx, changedPaths); err != nil {
+			if errors.Is(err, context.Canceled) {
+				return nil
+			}
+
+			s.logger.Error("failed to sync cluster templates", zap.Error(err))
+		}
+
+		var ok bool
+
+		if changedPaths, ok = s.wait(ctx, ticker); !ok {
+			return nil
+		}
+	}
+}
+
+// wait for the next sync, returns the changed files if only the affected templates should be synced.
+func (s *Syncer) wait(ctx context.Context, ticker *time.Ticker) (map[string]struct{}, bool) {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, false
+		case <-ticker.C:
+			// the periodic sync covers all pending changes
+			s.takePending()
+
+			return nil, true
+		case <-s.triggerCh:
+			if changedPaths, pending := s.takePending(); pending {
+				return changedPaths, true
+			}
+		}
+	}
+}
+
+func (s *Syncer) takePending() (map[string]struct{}, bool) {
+	s.pendingMu.Lock()
+	defer s.pendingMu.Unlock()
+
+	changedPaths, pending := s.pendingPaths, s.pending
+
+	s.pendingPaths, s.pending = nil, false
+
+	return changedPaths, pending
+}
+
+// sync the templates from the repository, if the changed files are set, only the templates affected by them are synced.
+//
+//nolint:gocognit,gocyclo,cyclop
+func (s *Syncer) sync(ctx context.Context, changedPaths map[string]struct{}) error {
+	commit, err := s.repo.update(ctx)
+	if err != nil {
+		return err
+	}
+
+	templates, err := findTemplates(s.repo.dir, s.params.Path)
+	if err != nil {
+		return fmt.Errorf("failed to look up cluster templates: %w", err)
+	}
+
+	statuses, err := safe.StateListAll[*omni.TemplateSyncStatus](ctx, s.state)
+	if err != nil {
+		return err
+	}
+
+	// cluster ID -> template path, to detect the clusters defined in more than one template
+	clusters := map[string]string{}
+	touched := map[resource.ID]struct{}{}
+
+	for _, path := range templates {
+		id := templateSyncStatusID(path)
+		touched[id] = struct{}{}
+
+		if changedPaths != nil && !s.affected(path, changedPaths) {
+			if status, ok := statuses.Find(func(status *omni.TemplateSyncStatus) bool { return status.Metadata().ID() == id }); ok {
+				if cluster := status.TypedSpec().Value.Cluster; cluster != "" {
+					clusters[cluster] = path
+				}
+			}
+
+			continue
+		}
+
+		cluster, syncErr := s.syncTemplate(ctx, path, clusters)
+		if syncErr != nil {
+			if errors.Is(syncErr, context.Canceled) {
+				return syncErr
+			}
+
+			s.logger.Warn("failed to sync cluster template", zap.String("path", path), zap.String("commit", commit), zap.Error(syncErr))
+		}
+
+		if err = s.updateSyncStatus(ctx, omni.NewTemplateSyncStatus(resources.DefaultNamespace, id), func(res *omni.TemplateSyncStatus) error {
+			res.TypedSpec().Value.Path = path
+			res.TypedSpec().Value.Commit = commit
+			res.TypedSpec().Value.LastSyncTime = timestamppb.Now()
+			res.TypedSpec().Value.Phase = specs.TemplateSyncStatusSpec_Synced
+			res.TypedSpec().Value.Error = ""
+
+			if cluster != "" {
+				res.TypedSpec().Value.Cluster = cluster
+			}
+
+			if syncErr != nil {
+				res.TypedSpec().Value.Phase = specs.TemplateSyncStatusSpec_Failed
+				res.TypedSpec().Value.Error = syncErr.Error()
+			}
+
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	return statuses.ForEachErr(func(status *omni.TemplateSyncStatus) error {
+		if _, ok := touched[status.Metadata().ID()]; ok {
+			return nil
+		}
+
+		// the cluster definition might have been moved to another template
+		_, defined := clusters[status.TypedSpec().Value.Cluster]
+
+		return s.removeTemplate(ctx, status, defined)
+	})
+}
+
+// affected checks if the template or any of the files it includes or the patch files it references is changed.
+func (s *Syncer) affected(path string, changedPaths map[string]struct{}) bool {
+	if _, ok := changedPaths[path]; ok {
+		return true
+	}
+
+	_, dependencies, err := s.loadTemplate(path)
+	if err != nil {
+		// sync the template to report the error
+		return true
+	}
+
+	for _, dependency := range dependencies {
+		if _, ok := changedPaths[dependency]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// loadTemplate returns the expanded template along with the repository paths of the files it depends on.
+func (s *Syncer) loadTemplate(path string) ([]byte, []string, error) {
+	data, included, err := expandTemplate(s.repo.dir, path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, patchFiles, err := resolvePatchFiles(s.repo.dir, path, data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return data, append(included, patchFiles...), nil
+}
+
+func (s *Syncer) syncTemplate(ctx context.Context, path string, clusters map[string]string) (string, error) {
+	data, _, err := s.loadTemplate(path)
+	if err != nil {
+		return "", err
+	}
+
+	tmpl, err := template.Load(bytes.NewReader(data))
+	if err != nil {
+		return "", fmt.Errorf("error loading template: %w", err)
+	}
+
+	cluster, err := tmpl.ClusterName()
+	if err != nil {
+		return "", err
+	}
+
+	if otherPath, ok := clusters[cluster]; ok {
+		return cluster, fmt.Errorf("cluster %q is already defined in the template %q", cluster, otherPath)
+	}
+
+	clusters[cluster] = path
+
+	var out strings.Builder
+
+	if err = operations.SyncTemplate(ctx, bytes.NewReader(data), &out, s.state, operations.SyncOptions{}); err != nil {
+		return cluster, err
+	}
+
+	if out.Len() > 0 {
+		s.logger.Info("synced cluster template", zap.String("path", path), zap.String("cluster", cluster), zap.String("changes", out.String()))
+	}
+
+	return cluster, nil
+}
+
+// removeTemplate handles the template removed from the repository.
+//
+// The cluster created from the template is destroyed only if the pruning is enabled and no other template defines it.
+func (s *Syncer) removeTemplate(ctx context.Context, status *omni.TemplateSyncStatus, clusterDefined bool) error {
+	cluster := status.TypedSpec().Value.Cluster
+
+	switch {
+	case cluster == "", clusterDefined:
+	case s.params.Prune:
+		s.logger.Info("destroying the cluster, the template was removed from the repository",
+			zap.String("path", status.TypedSpec().Value.Path), zap.String("cluster", cluster))
+
+		var out strings.Builder
+
+		if err := operations.DeleteCluster(ctx, cluster, &out, s.state, operations.SyncOptions{}); err != nil {
+			return fmt.Errorf("failed to destroy the cluster %q: %w", cluster, err)
+		}
+	default:
+		s.logger.Warn("the cluster template was removed from the repository, the cluster is no longer synced",
+			zap.String("path", status.TypedSpec().Value.Path), zap.String("cluster", cluster))
+	}
+
+	if err := s.state.Destroy(ctx, status.Metadata()); err != nil && !state.IsNotFoundError(err) {
+		return err
+	}
+
+	return nil
+}
+
+// updateSyncStatus updates the TemplateSyncStatus, creating it if it doesn't exist yet.
+func (s *Syncer) updateSyncStatus(ctx context.Context, status *omni.TemplateSyncStatus, update func(*omni.TemplateSyncStatus) error) error {
+	_, err := safe.StateUpdateWithConflicts(ctx, s.state, status.Metadata(), update)
+	if !state.IsNotFoundError(err) {
+		return err
+	}
+
+	if err = update(status); err != nil {
+		return err
+	}
+
+	return s.state.Create(ctx, status)
+}
+
+// templateSyncStatusID derives the TemplateSyncStatus ID from the template path.
+func templateSyncStatusID(path string) resource.ID {
+	return strings.ReplaceAll(filepath.ToSlash(path), "/", ".")
+}
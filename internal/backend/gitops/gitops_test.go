@@ -0,0 +1,209 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package gitops_test
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/gitops"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+const clusterTemplate = `kind: Cluster
+name: gitops
+kubernetes:
+  version: v1.30.1
+talos:
+  version: v1.7.4
+patches:
+  - file: patches/cluster.yaml
+---
+kind: ControlPlane
+machines:
+  - 430d882a-51a8-48b3-ae00-90c5b0b5b0b0
+`
+
+const clusterPatch = `machine:
+  network:
+    hostname: gitops
+`
+
+const updatedClusterPatch = `machine:
+  network:
+    hostname: gitops-updated
+`
+
+const includingTemplate = `kind: Include
+file: ../partials/cluster.yaml
+params:
+  name: included
+  talos: v1.7.4
+`
+
+const partialTemplate = `kind: Cluster
+name: ${name}
+kubernetes:
+  version: v1.30.1
+talos:
+  version: ${talos}
+---
+kind: ControlPlane
+machines:
+  - 4aed1106-6f44-4be9-9796-d4b5b0b5b0b0
+`
+
+const brokenTemplate = `kind: Cluster
+name: broken
+patches:
+  - file: ../../outside.yaml
+`
+
+func git(t *testing.T, dir string, args ...string) {
+	t.Helper()
+
+	cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+	cmd.Env = append(os.Environ(),
+		"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+		"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com",
+	)
+
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+}
+
+func writeFile(t *testing.T, path, contents string) {
+	t.Helper()
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0o644))
+}
+
+func TestSync(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not available")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	t.Cleanup(cancel)
+
+	remote := t.TempDir()
+
+	git(t, remote, "init", "--quiet", "--initial-branch", "main")
+
+	writeFile(t, filepath.Join(remote, "clusters", "gitops", "cluster.yaml"), clusterTemplate)
+	writeFile(t, filepath.Join(remote, "clusters", "gitops", "patches", "cluster.yaml"), clusterPatch)
+	writeFile(t, filepath.Join(remote, "clusters", "included", "cluster.yaml"), includingTemplate)
+	writeFile(t, filepath.Join(remote, "clusters", "partials", "cluster.yaml"), partialTemplate)
+	writeFile(t, filepath.Join(remote, "clusters", "broken.yaml"), brokenTemplate)
+	writeFile(t, filepath.Join(remote, "README.md"), "templates")
+
+	git(t, remote, "add", "-A")
+	git(t, remote, "commit", "--quiet", "-m", "initial")
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	syncer, err := gitops.NewSyncer(st, config.GitOpsParams{
+		Repository:   remote,
+		Branch:       "main",
+		Path:         "clusters",
+		WorkDir:      t.TempDir(),
+		GitPath:      "git",
+		PollInterval: time.Minute,
+	}, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	require.NoError(t, syncer.Sync(ctx))
+
+	cluster, err := safe.StateGetByID[*omni.Cluster](ctx, st, "gitops")
+	require.NoError(t, err)
+
+	assert.Equal(t, "1.7.4", cluster.TypedSpec().Value.TalosVersion)
+
+	patches, err := safe.StateListAll[*omni.ConfigPatch](ctx, st)
+	require.NoError(t, err)
+	require.Equal(t, 1, patches.Len())
+
+	// the patch is read from the file relative to the template, the name is kept as in the template
+	patch := patches.Get(0)
+	assert.Equal(t, clusterPatch, patch.TypedSpec().Value.Data)
+
+	name, _ := patch.Metadata().Annotations().Get("name")
+	assert.Equal(t, "patches/cluster.yaml", name)
+
+	status, err := safe.StateGetByID[*omni.TemplateSyncStatus](ctx, st, "clusters.gitops.cluster.yaml")
+	require.NoError(t, err)
+
+	assert.Equal(t, specs.TemplateSyncStatusSpec_Synced, status.TypedSpec().Value.Phase)
+	assert.Equal(t, "gitops", status.TypedSpec().Value.Cluster)
+	assert.NotEmpty(t, status.TypedSpec().Value.Commit)
+
+	// the patch outside of the repository is never read
+	status, err = safe.StateGetByID[*omni.TemplateSyncStatus](ctx, st, "clusters.broken.yaml")
+	require.NoError(t, err)
+
+	assert.Equal(t, specs.TemplateSyncStatusSpec_Failed, status.TypedSpec().Value.Phase)
+	assert.NotEmpty(t, status.TypedSpec().Value.Error)
+
+	rtestutils.AssertNoResource[*omni.Cluster](ctx, t, st, "broken")
+
+	// the included partial is a part of the including template, it isn't synced on its own
+	cluster, err = safe.StateGetByID[*omni.Cluster](ctx, st, "included")
+	require.NoError(t, err)
+
+	assert.Equal(t, "1.7.4", cluster.TypedSpec().Value.TalosVersion)
+
+	rtestutils.AssertNoResource[*omni.TemplateSyncStatus](ctx, t, st, "clusters.partials.cluster.yaml")
+
+	// the template including the changed partial is synced
+	writeFile(t, filepath.Join(remote, "clusters", "partials", "cluster.yaml"), strings.ReplaceAll(partialTemplate, "v1.30.1", "v1.30.2"))
+
+	git(t, remote, "commit", "--quiet", "-a", "-m", "update partial")
+
+	require.NoError(t, syncer.Sync(ctx, "clusters/partials/cluster.yaml"))
+
+	cluster, err = safe.StateGetByID[*omni.Cluster](ctx, st, "included")
+	require.NoError(t, err)
+
+	assert.Equal(t, "1.30.2", cluster.TypedSpec().Value.KubernetesVersion)
+
+	// the template referencing the changed patch file is synced
+	writeFile(t, filepath.Join(remote, "clusters", "gitops", "patches", "cluster.yaml"), updatedClusterPatch)
+
+	git(t, remote, "commit", "--quiet", "-a", "-m", "update patch")
+
+	require.NoError(t, syncer.Sync(ctx, "clusters/gitops/patches/cluster.yaml"))
+
+	patch, err = safe.StateGetByID[*omni.ConfigPatch](ctx, st, patch.Metadata().ID())
+	require.NoError(t, err)
+
+	assert.Equal(t, updatedClusterPatch, patch.TypedSpec().Value.Data)
+
+	// the removed template stops being tracked, the cluster is kept as the pruning is disabled
+	git(t, remote, "rm", "--quiet", "clusters/broken.yaml")
+	git(t, remote, "commit", "--quiet", "-m", "remove broken")
+
+	require.NoError(t, syncer.Sync(ctx))
+
+	rtestutils.AssertNoResource[*omni.TemplateSyncStatus](ctx, t, st, "clusters.broken.yaml")
+	rtestutils.AssertResources(ctx, t, st, []string{"gitops"}, func(*omni.Cluster, *assert.Assertions) {})
+}
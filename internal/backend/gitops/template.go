@@ -0,0 +1,257 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package gitops
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/omni/client/pkg/template"
+)
+
+// findTemplates returns the paths of the cluster templates in the directory relative to the root.
+//
+// Any YAML file which contains a Cluster or an Include document is considered to be a template, other YAML files (e.g. config patches) are skipped.
+// The files included by other templates are partials, they are not synced on their own.
+func findTemplates(root, dir string) ([]string, error) {
+	var templates []string
+
+	err := filepath.WalkDir(filepath.Join(root, dir), func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			if strings.HasPrefix(d.Name(), ".") && path != filepath.Join(root, dir) {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if ext := filepath.Ext(path); ext != ".yaml" && ext != ".yml" {
+			return nil
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		if !isTemplate(data) {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+
+		templates = append(templates, filepath.ToSlash(relPath))
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	partials := map[string]struct{}{}
+
+	for _, path := range templates {
+		// the broken templates are kept, the error is reported when the template is loaded
+		_, included, _ := expandTemplate(root, path)
+
+		for _, includedPath := range included {
+			partials[includedPath] = struct{}{}
+		}
+	}
+
+	return slices.DeleteFunc(templates, func(path string) bool {
+		_, ok := partials[path]
+
+		return ok
+	}), nil
+}
+
+// expandTemplate reads the template and expands the files it includes.
+//
+// The included files must reside in the repository, the paths of the included files relative to the repository root are returned.
+func expandTemplate(root, templatePath string) ([]byte, []string, error) {
+	path := filepath.Join(root, templatePath)
+
+	data, included, err := template.ExpandFile(path, template.WithIncludeRoot(root), template.WithPatchDir(filepath.Dir(path)))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	relPaths := make([]string, 0, len(included))
+
+	for _, includedPath := range included {
+		relPath, err := filepath.Rel(root, includedPath)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		relPaths = append(relPaths, filepath.ToSlash(relPath))
+	}
+
+	return data, relPaths, nil
+}
+
+func isTemplate(data []byte) bool {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+
+	for {
+		var doc struct {
+			Kind string `yaml:"kind"`
+		}
+
+		if err := dec.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				return false
+			}
+
+			// keep the broken templates, so that the error is reported in the sync status
+			return bytes.Contains(data, []byte("kind: Cluster"))
+		}
+
+		if doc.Kind == "Cluster" || doc.Kind == template.KindInclude {
+			return true
+		}
+	}
+}
+
+// resolvePatchFiles rewrites the config patch file references in the template to the absolute paths.
+//
+// The patch files are resolved relative to the template directory and must reside in the repository,
+// the patch name defaults to the path from the template, so the resulting config patch IDs are the same
+// as if the template was synced with omnictl from the template directory.
+//
+// The paths of the patch files relative to the repository root are returned along with the rewritten template.
+func resolvePatchFiles(root, templatePath string, data []byte) ([]byte, []string, error) {
+	realRoot, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	templateDir := filepath.Dir(filepath.Join(root, templatePath))
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+
+	var (
+		out        bytes.Buffer
+		patchFiles []string
+	)
+
+	enc := yaml.NewEncoder(&out)
+	enc.SetIndent(2)
+
+	for {
+		var doc yaml.Node
+
+		if err = dec.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return nil, nil, fmt.Errorf("error decoding template: %w", err)
+		}
+
+		if len(doc.Content) == 1 && doc.Content[0].Kind == yaml.MappingNode {
+			var documentPatchFiles []string
+
+			if documentPatchFiles, err = resolveDocumentPatches(realRoot, templateDir, doc.Content[0]); err != nil {
+				return nil, nil, err
+			}
+
+			patchFiles = append(patchFiles, documentPatchFiles...)
+		}
+
+		if err = enc.Encode(&doc); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err = enc.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	return out.Bytes(), patchFiles, nil
+}
+
+func resolveDocumentPatches(root, templateDir string, document *yaml.Node) ([]string, error) {
+	patches := mappingValue(document, "patches")
+	if patches == nil || patches.Kind != yaml.SequenceNode {
+		return nil, nil
+	}
+
+	var patchFiles []string
+
+	for _, patch := range patches.Content {
+		if patch.Kind != yaml.MappingNode {
+			continue
+		}
+
+		file := mappingValue(patch, "file")
+		if file == nil || file.Kind != yaml.ScalarNode || file.Value == "" {
+			continue
+		}
+
+		path, relPath, err := resolvePath(root, templateDir, file.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		if mappingValue(patch, "name") == nil {
+			patch.Content = append(patch.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "name"},
+				&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: file.Value},
+			)
+		}
+
+		file.Value = path
+		patchFiles = append(patchFiles, relPath)
+	}
+
+	return patchFiles, nil
+}
+
+func resolvePath(root, templateDir, file string) (path, relPath string, err error) {
+	if filepath.IsAbs(file) {
+		return "", "", fmt.Errorf("patch file %q must be relative to the template", file)
+	}
+
+	path, err = filepath.EvalSymlinks(filepath.Join(templateDir, file))
+	if err != nil {
+		return "", "", fmt.Errorf("failed to access %q: %w", file, err)
+	}
+
+	relPath, err = filepath.Rel(root, path)
+	if err != nil || relPath == ".." || strings.HasPrefix(relPath, ".."+string(filepath.Separator)) {
+		return "", "", fmt.Errorf("patch file %q is outside of the repository", file)
+	}
+
+	return path, filepath.ToSlash(relPath), nil
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package gitops
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// maxWebhookPayloadSize limits the size of the push event payload.
+const maxWebhookPayloadSize = 25 * 1024 * 1024
+
+// pushEvent is the subset of the push event payload, which is the same for GitHub and GitLab.
+type pushEvent struct {
+	Ref string `json:"ref"`
+	// TotalCommitsCount is set only by GitLab, the list of the commits in the payload is truncated to 20 commits.
+	TotalCommitsCount int `json:"total_commits_count"`
+	Commits           []struct {
+		Added    []string `json:"added"`
+		Modified []string `json:"modified"`
+		Removed  []string `json:"removed"`
+	} `json:"commits"`
+}
+
+// WebhookHandler triggers the sync of the cluster templates on the GitHub and GitLab push events.
+//
+// GitHub requests are authenticated by the HMAC signature of the payload, GitLab requests by the secret token.
+type WebhookHandler struct {
+	syncer *Syncer
+	logger *zap.Logger
+	secret []byte
+}
+
+// NewWebhookHandler creates a new WebhookHandler.
+func NewWebhookHandler(syncer *Syncer, secret []byte, logger *zap.Logger) *WebhookHandler {
+	return &WebhookHandler{
+		syncer: syncer,
+		secret: secret,
+		logger: logger,
+	}
+}
+
+// ServeHTTP implements http.Handler.
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	payload, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookPayloadSize))
+	if err != nil {
+		http.Error(w, "failed to read the request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if !h.authenticate(r, payload) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+
+		return
+	}
+
+	switch eventType(r) {
+	case "ping":
+		w.WriteHeader(http.StatusOK)
+
+		return
+	case "push":
+	default:
+		// other events don't change the repository contents
+		w.WriteHeader(http.StatusAccepted)
+
+		return
+	}
+
+	var event pushEvent
+
+	if err = json.Unmarshal(payload, &event); err != nil {
+		http.Error(w, "invalid push event payload", http.StatusBadRequest)
+
+		return
+	}
+
+	if event.Ref != "refs/heads/"+h.syncer.params.Branch {
+		w.WriteHeader(http.StatusAccepted)
+
+		return
+	}
+
+	changedPaths := make([]string, 0, len(event.Commits))
+
+	for _, commit := range event.Commits {
+		changedPaths = append(changedPaths, commit.Added...)
+		changedPaths = append(changedPaths, commit.Modified...)
+		changedPaths = append(changedPaths, commit.Removed...)
+	}
+
+	h.logger.Info("push event received, syncing cluster templates", zap.String("ref", event.Ref), zap.Int("changed_files", len(changedPaths)))
+
+	if len(changedPaths) == 0 || event.TotalCommitsCount > len(event.Commits) {
+		// the list of changes is not complete (e.g. force push), sync all templates
+		h.syncer.Trigger()
+	} else {
+		h.syncer.Trigger(changedPaths...)
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *WebhookHandler) authenticate(r *http.Request, payload []byte) bool {
+	if len(h.secret) == 0 {
+		return false
+	}
+
+	if signature := r.Header.Get("X-Hub-Signature-256"); signature != "" {
+		expected, err := hex.DecodeString(strings.TrimPrefix(signature, "sha256="))
+		if err != nil {
+			return false
+		}
+
+		mac := hmac.New(sha256.New, h.secret)
+		mac.Write(payload) //nolint:errcheck
+
+		return hmac.Equal(mac.Sum(nil), expected)
+	}
+
+	if token := r.Header.Get("X-Gitlab-Token"); token != "" {
+		return subtle.ConstantTimeCompare([]byte(token), h.secret) == 1
+	}
+
+	return false
+}
+
+func eventType(r *http.Request) string {
+	if event := r.Header.Get("X-GitHub-Event"); event != "" {
+		return event
+	}
+
+	if r.Header.Get("X-Gitlab-Event") == "Push Hook" {
+		return "push"
+	}
+
+	return ""
+}
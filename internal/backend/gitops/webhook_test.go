@@ -0,0 +1,158 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package gitops_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/omni/internal/backend/gitops"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+const pushPayload = `{
+  "ref": "refs/heads/main",
+  "commits": [
+    {"added": ["clusters/new.yaml"], "modified": ["clusters/patches/cp.yaml"], "removed": []}
+  ]
+}`
+
+func sign(secret, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload)) //nolint:errcheck
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestWebhook(t *testing.T) {
+	syncer, err := gitops.NewSyncer(state.WrapCore(namespaced.NewState(inmem.Build)), config.GitOpsParams{
+		Repository:   "https://example.com/clusters.git",
+		Branch:       "main",
+		WorkDir:      t.TempDir(),
+		GitPath:      "git",
+		PollInterval: time.Minute,
+	}, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	handler := gitops.NewWebhookHandler(syncer, []byte("secret"), zaptest.NewLogger(t))
+
+	for _, tt := range []struct {
+		headers       map[string]string
+		name          string
+		payload       string
+		expectedPaths []string
+		expectedCode  int
+		triggered     bool
+	}{
+		{
+			name:    "github push",
+			payload: pushPayload,
+			headers: map[string]string{
+				"X-GitHub-Event":      "push",
+				"X-Hub-Signature-256": sign("secret", pushPayload),
+			},
+			expectedCode:  http.StatusAccepted,
+			triggered:     true,
+			expectedPaths: []string{"clusters/new.yaml", "clusters/patches/cp.yaml"},
+		},
+		{
+			name:    "gitlab push",
+			payload: pushPayload,
+			headers: map[string]string{
+				"X-Gitlab-Event": "Push Hook",
+				"X-Gitlab-Token": "secret",
+			},
+			expectedCode:  http.StatusAccepted,
+			triggered:     true,
+			expectedPaths: []string{"clusters/new.yaml", "clusters/patches/cp.yaml"},
+		},
+		{
+			name:    "github push without the list of changes",
+			payload: `{"ref": "refs/heads/main"}`,
+			headers: map[string]string{
+				"X-GitHub-Event":      "push",
+				"X-Hub-Signature-256": sign("secret", `{"ref": "refs/heads/main"}`),
+			},
+			expectedCode: http.StatusAccepted,
+			triggered:    true,
+		},
+		{
+			name:    "other branch",
+			payload: `{"ref": "refs/heads/feature"}`,
+			headers: map[string]string{
+				"X-GitHub-Event":      "push",
+				"X-Hub-Signature-256": sign("secret", `{"ref": "refs/heads/feature"}`),
+			},
+			expectedCode: http.StatusAccepted,
+		},
+		{
+			name:    "invalid signature",
+			payload: pushPayload,
+			headers: map[string]string{
+				"X-GitHub-Event":      "push",
+				"X-Hub-Signature-256": sign("wrong", pushPayload),
+			},
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:    "invalid token",
+			payload: pushPayload,
+			headers: map[string]string{
+				"X-Gitlab-Event": "Push Hook",
+				"X-Gitlab-Token": "wrong",
+			},
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "unauthenticated",
+			payload:      pushPayload,
+			headers:      map[string]string{"X-GitHub-Event": "push"},
+			expectedCode: http.StatusUnauthorized,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/gitops/webhook", strings.NewReader(tt.payload))
+
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedCode, rec.Code)
+
+			changedPaths, triggered := syncer.TakePending()
+			assert.Equal(t, tt.triggered, triggered)
+
+			if tt.expectedPaths == nil {
+				assert.Nil(t, changedPaths)
+
+				return
+			}
+
+			for _, path := range tt.expectedPaths {
+				assert.Contains(t, changedPaths, path)
+			}
+
+			assert.Len(t, changedPaths, len(tt.expectedPaths))
+		})
+	}
+}
@@ -18,22 +18,28 @@ import (
 	"github.com/cosi-project/runtime/pkg/state"
 	gateway "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	authpb "github.com/siderolabs/go-api-signature/api/auth"
+	"github.com/siderolabs/go-api-signature/pkg/message"
+	"github.com/siderolabs/go-api-signature/pkg/pgp"
 	"github.com/siderolabs/go-pointer"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/constants"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
 	"github.com/siderolabs/omni/internal/pkg/auth"
 	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/auth0"
 	"github.com/siderolabs/omni/internal/pkg/auth/role"
 	"github.com/siderolabs/omni/internal/pkg/config"
+	"github.com/siderolabs/omni/internal/pkg/settings"
 )
 
 const (
@@ -43,6 +49,9 @@ const (
 	publicKeyIDQueryParam = "public-key-id"
 
 	awaitPublicKeyConfirmationTimeout = 5 * time.Minute
+
+	// hardwareKeyMaxAllowedLifetime is the maximum allowed lifetime of a key stored on a hardware token.
+	hardwareKeyMaxAllowedLifetime = 5 * 365 * 24 * time.Hour
 )
 
 type authServer struct {
@@ -67,7 +76,16 @@ func (s *authServer) RegisterPublicKey(ctx context.Context, request *authpb.Regi
 
 	email := strings.ToLower(request.GetIdentity().GetEmail())
 
-	pubKey, err := validatePublicKey(request.GetPublicKey())
+	// the long-lived keys are accepted only if the client registers them as stored on a hardware token
+	hardwareKey := config.Config.Auth.HardwareKeys.Enabled && isHardwareKeyRequest(ctx)
+
+	var validationOpts []pgp.ValidationOption
+
+	if hardwareKey {
+		validationOpts = append(validationOpts, pgp.WithMaxAllowedLifetime(hardwareKeyMaxAllowedLifetime))
+	}
+
+	pubKey, err := validatePublicKey(request.GetPublicKey(), validationOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -129,21 +147,40 @@ func (s *authServer) RegisterPublicKey(ctx context.Context, request *authpb.Regi
 		return nil, fmt.Errorf("failed to parse role for public key: %w", err)
 	}
 
+	settingsSpec, err := settings.Get(ctx, s.state)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	expiration := limitPublicKeyExpiration(pubKey.expiration, pubKeyRole, settingsSpec, now)
+
+	if hardwareKey {
+		if maxExpiration := now.Add(config.Config.Auth.HardwareKeys.SessionLifetime); expiration.After(maxExpiration) {
+			expiration = maxExpiration
+		}
+	}
+
 	setPubKeyAttributes := func(k *authres.PublicKey) {
 		k.Metadata().Labels().Set(authres.LabelPublicKeyUserID, userID)
 
+		if hardwareKey {
+			k.Metadata().Labels().Set(authres.LabelPublicKeyHardware, "")
+		}
+
 		k.TypedSpec().Value.Confirmed = false
 		k.TypedSpec().Value.PublicKey = pubKey.data
-		k.TypedSpec().Value.Expiration = timestamppb.New(pubKey.expiration)
+		k.TypedSpec().Value.Expiration = timestamppb.New(expiration)
 		k.TypedSpec().Value.Role = string(pubKeyRole)
 		k.TypedSpec().Value.Identity = &specs.Identity{
 			Email: email,
 		}
+		k.TypedSpec().Value.ClientInfo = clientInfo(ctx)
 	}
 
 	newPubKey := authres.NewPublicKey(resources.DefaultNamespace, pubKey.id)
 
-	_, err = safe.StateGet[*authres.PublicKey](ctx, s.state, newPubKey.Metadata())
+	existingPubKey, err := safe.StateGet[*authres.PublicKey](ctx, s.state, newPubKey.Metadata())
 	if state.IsNotFoundError(err) {
 		setPubKeyAttributes(newPubKey)
 
@@ -155,7 +192,7 @@ func (s *authServer) RegisterPublicKey(ctx context.Context, request *authpb.Regi
 		s.logger.Info("new public key registered",
 			zap.String("email", email),
 			zap.String("fingerprint", pubKey.id),
-			zap.Time("expiration", pubKey.expiration),
+			zap.Time("expiration", expiration),
 			zap.String("role", newPubKey.TypedSpec().Value.GetRole()),
 		)
 
@@ -166,6 +203,27 @@ func (s *authServer) RegisterPublicKey(ctx context.Context, request *authpb.Regi
 		return nil, err
 	}
 
+	_, registeredAsHardwareKey := existingPubKey.Metadata().Labels().Get(authres.LabelPublicKeyHardware)
+
+	// the session of a long-lived key has expired, but the key itself is still valid, so start a new session
+	if hardwareKey && registeredAsHardwareKey && existingPubKey.TypedSpec().Value.GetExpiration().AsTime().Before(now) && expiration.After(now) {
+		if _, err = safe.StateUpdateWithConflicts(ctx, s.state, existingPubKey.Metadata(), func(k *authres.PublicKey) error {
+			setPubKeyAttributes(k)
+
+			return nil
+		}, state.WithUpdateOwner(existingPubKey.Metadata().Owner())); err != nil {
+			return nil, err
+		}
+
+		s.logger.Info("public key session renewed",
+			zap.String("email", email),
+			zap.String("fingerprint", pubKey.id),
+			zap.Time("expiration", expiration),
+		)
+
+		return result, nil
+	}
+
 	// it already exists, do nothing
 
 	return result, nil
@@ -238,6 +296,7 @@ func (s *authServer) ConfirmPublicKey(ctx context.Context, request *authpb.Confi
 
 	_, err = safe.StateUpdateWithConflicts(ctx, s.state, pubKey.Metadata(), func(pk *authres.PublicKey) error {
 		pk.TypedSpec().Value.Confirmed = true
+		pk.TypedSpec().Value.MfaVerified = mfaVerified(ctx)
 
 		return nil
 	}, state.WithUpdateOwner(pointer.To(omni.KeyPrunerController{}).Name()))
@@ -255,6 +314,21 @@ func (s *authServer) ConfirmPublicKey(ctx context.Context, request *authpb.Confi
 	return &emptypb.Empty{}, nil
 }
 
+// mfaVerified checks if the identity token of the request was issued after the multi-factor authentication.
+func mfaVerified(ctx context.Context) bool {
+	msg, ok := ctx.Value(auth.GRPCMessageContextKey{}).(*message.GRPC)
+	if !ok {
+		return false
+	}
+
+	values := msg.Metadata.Get(message.AuthorizationHeaderKey)
+	if len(values) == 0 || !strings.HasPrefix(values[0], message.BearerPrefix) {
+		return false
+	}
+
+	return auth0.MFAVerified(strings.TrimPrefix(values[0], message.BearerPrefix))
+}
+
 func verifiedEmail(ctx context.Context) (string, error) {
 	if email := debugEmail(ctx); email != "" {
 		return email, nil
@@ -284,3 +358,47 @@ func (s *authServer) buildLoginURL(pgpKeyID string) (string, error) {
 
 	return loginURL.String(), nil
 }
+
+// limitPublicKeyExpiration shortens the public key expiration to the maximum lifetime configured for the role.
+func limitPublicKeyExpiration(expiration time.Time, keyRole role.Role, settingsSpec *specs.SettingsSpec, now time.Time) time.Time {
+	maxLifetime := settings.PublicKeyMaxLifetime(settingsSpec, keyRole)
+	if maxLifetime <= 0 {
+		return expiration
+	}
+
+	if maxExpiration := now.Add(maxLifetime); expiration.After(maxExpiration) {
+		return maxExpiration
+	}
+
+	return expiration
+}
+
+// isHardwareKeyRequest checks if the client registers the public key as stored on a hardware token.
+func isHardwareKeyRequest(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+
+	values := md.Get(constants.HardwareKeyHeader)
+
+	return len(values) > 0 && values[0] == "true"
+}
+
+// clientInfo returns the user agent of the client which sent the request.
+//
+// Requests coming through the gRPC gateway carry the original user agent in a separate header.
+func clientInfo(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	for _, key := range []string{"grpcgateway-user-agent", "user-agent"} {
+		if values := md.Get(key); len(values) > 0 {
+			return values[0]
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	authpb "github.com/siderolabs/go-api-signature/api/auth"
+	"github.com/siderolabs/go-api-signature/pkg/pgp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/siderolabs/omni/client/pkg/constants"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/internal/backend/grpc"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+func TestLimitPublicKeyExpiration(t *testing.T) {
+	oldLifetimes := config.Config.Auth.PublicKeyMaxLifetimes
+
+	t.Cleanup(func() {
+		config.Config.Auth.PublicKeyMaxLifetimes = oldLifetimes
+	})
+
+	config.Config.Auth.PublicKeyMaxLifetimes = config.RoleDurations{
+		string(role.Admin):  8 * time.Hour,
+		string(role.Reader): 7 * 24 * time.Hour,
+	}
+
+	now := time.Now()
+
+	// longer than the limit, shortened
+	assert.Equal(t, now.Add(8*time.Hour), grpc.LimitPublicKeyExpiration(now.Add(24*time.Hour), role.Admin, now))
+
+	// within the limit, kept as is
+	assert.Equal(t, now.Add(4*time.Hour), grpc.LimitPublicKeyExpiration(now.Add(4*time.Hour), role.Admin, now))
+	assert.Equal(t, now.Add(24*time.Hour), grpc.LimitPublicKeyExpiration(now.Add(24*time.Hour), role.Reader, now))
+
+	// no limit for the role
+	assert.Equal(t, now.Add(30*24*time.Hour), grpc.LimitPublicKeyExpiration(now.Add(30*24*time.Hour), role.Operator, now))
+}
+
+func TestRegisterHardwareKey(t *testing.T) {
+	oldHardwareKeys := config.Config.Auth.HardwareKeys
+
+	t.Cleanup(func() {
+		config.Config.Auth.HardwareKeys = oldHardwareKeys
+	})
+
+	config.Config.Auth.HardwareKeys = config.HardwareKeysParams{
+		Enabled:         true,
+		SessionLifetime: time.Hour,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	identity := authres.NewIdentity(resources.DefaultNamespace, "user@example.org")
+	identity.TypedSpec().Value.UserId = "user"
+
+	user := authres.NewUser(resources.DefaultNamespace, "user")
+	user.TypedSpec().Value.Role = string(role.Operator)
+
+	require.NoError(t, st.Create(ctx, identity))
+	require.NoError(t, st.Create(ctx, user))
+
+	srv := grpc.NewAuthServer(st, zaptest.NewLogger(t))
+
+	register := func(ctx context.Context, key *pgp.Key) (string, error) {
+		armored, err := key.ArmorPublic()
+		require.NoError(t, err)
+
+		resp, err := srv.RegisterPublicKey(ctx, &authpb.RegisterPublicKeyRequest{
+			PublicKey: &authpb.PublicKey{PgpData: []byte(armored)},
+			Identity:  &authpb.Identity{Email: "user@example.org"},
+		})
+		if err != nil {
+			return "", err
+		}
+
+		return resp.PublicKeyId, nil
+	}
+
+	key, err := pgp.GenerateKey("", "", "user@example.org", 30*24*time.Hour)
+	require.NoError(t, err)
+
+	// the long-lived key is rejected, unless it is registered as a hardware key
+	_, err = register(ctx, key)
+	require.Error(t, err)
+
+	hardwareCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(constants.HardwareKeyHeader, "true"))
+
+	keyID, err := register(hardwareCtx, key)
+	require.NoError(t, err)
+
+	pubKey, err := safe.StateGetByID[*authres.PublicKey](ctx, st, keyID)
+	require.NoError(t, err)
+
+	_, hardware := pubKey.Metadata().Labels().Get(authres.LabelPublicKeyHardware)
+	assert.True(t, hardware)
+
+	// the session of the hardware key is limited
+	assert.WithinDuration(t, time.Now().Add(time.Hour), pubKey.TypedSpec().Value.Expiration.AsTime(), time.Minute)
+
+	// the header is ignored if the hardware keys are disabled
+	config.Config.Auth.HardwareKeys.Enabled = false
+
+	otherKey, err := pgp.GenerateKey("", "", "user@example.org", 30*24*time.Hour)
+	require.NoError(t, err)
+
+	_, err = register(hardwareCtx, otherKey)
+	require.Error(t, err)
+
+	// the short-lived keys are not labeled
+	shortKey, err := pgp.GenerateKey("", "", "user@example.org", 4*time.Hour)
+	require.NoError(t, err)
+
+	keyID, err = register(ctx, shortKey)
+	require.NoError(t, err)
+
+	pubKey, err = safe.StateGetByID[*authres.PublicKey](ctx, st, keyID)
+	require.NoError(t, err)
+
+	_, hardware = pubKey.Metadata().Labels().Get(authres.LabelPublicKeyHardware)
+	assert.False(t, hardware)
+}
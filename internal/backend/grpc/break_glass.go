@@ -0,0 +1,235 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-api-signature/pkg/pgp"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	pkgaccess "github.com/siderolabs/omni/client/pkg/access"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	omniCtrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/breakglass"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+const (
+	// breakGlassUserID is the ID of the user all break-glass identities belong to.
+	breakGlassUserID = "break-glass"
+
+	breakGlassReasonMaxLength = 1024
+)
+
+// CreateBreakGlassToken implements ManagementServer.
+//
+// The request is not authenticated, instead it must have both the break-glass secret and the current TOTP code.
+// The public key of the request is granted the access until it expires, the issued token is logged with the reason and the client address.
+func (s *managementServer) CreateBreakGlassToken(ctx context.Context, req *management.CreateBreakGlassTokenRequest) (*management.CreateBreakGlassTokenResponse, error) {
+	if s.breakGlassVerifier == nil {
+		return nil, status.Error(codes.FailedPrecondition, "break-glass tokens are disabled")
+	}
+
+	reason := strings.TrimSpace(req.GetReason())
+
+	if reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason is required")
+	}
+
+	if len(reason) > breakGlassReasonMaxLength {
+		return nil, status.Errorf(codes.InvalidArgument, "reason is too long: %d > %d", len(reason), breakGlassReasonMaxLength)
+	}
+
+	tokenRole := role.Admin
+
+	if req.GetRole() != "" {
+		var err error
+
+		if tokenRole, err = role.Parse(req.GetRole()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid role: %s", err)
+		}
+	}
+
+	key, err := validatePGPPublicKey(
+		[]byte(req.GetArmoredPgpPublicKey()),
+		pgp.WithMaxAllowedLifetime(config.Config.BreakGlass.MaxTTL),
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid public key: %s", err)
+	}
+
+	email := key.username + pkgaccess.BreakGlassNameSuffix
+	address := clientAddress(ctx, s.breakGlassProxies)
+
+	auditLogger := s.logger.With(
+		zap.String("audit", "break_glass"),
+		zap.String("identity", email),
+		zap.String("reason", reason),
+		zap.String("client_address", address),
+	)
+
+	if err = s.breakGlassVerifier.Verify(address, req.GetSecret(), req.GetTotpCode()); err != nil {
+		auditLogger.Warn("break-glass token request denied", zap.Error(err))
+
+		switch {
+		case errors.Is(err, breakglass.ErrDenied):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case errors.Is(err, breakglass.ErrLockedOut):
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		default:
+			return nil, err
+		}
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	// the user role caps the role of the public keys, the role of each token is set on its public key
+	user := authres.NewUser(resources.DefaultNamespace, breakGlassUserID)
+
+	setUserRole := func(res *authres.User) error {
+		res.TypedSpec().Value.Role = string(role.Admin)
+
+		return nil
+	}
+
+	if _, err = safe.StateUpdateWithConflicts(ctx, s.omniState, user.Metadata(), setUserRole); err != nil {
+		if !state.IsNotFoundError(err) {
+			return nil, err
+		}
+
+		if err = setUserRole(user); err != nil {
+			return nil, err
+		}
+
+		if err = s.omniState.Create(ctx, user); err != nil {
+			return nil, err
+		}
+	}
+
+	publicKey := authres.NewPublicKey(resources.DefaultNamespace, key.id)
+	publicKey.Metadata().Labels().Set(authres.LabelPublicKeyUserID, breakGlassUserID)
+
+	publicKey.TypedSpec().Value.PublicKey = key.data
+	publicKey.TypedSpec().Value.Expiration = timestamppb.New(key.expiration)
+	publicKey.TypedSpec().Value.Role = string(tokenRole)
+	publicKey.TypedSpec().Value.Confirmed = true
+	publicKey.TypedSpec().Value.ClientInfo = clientInfo(ctx)
+	publicKey.TypedSpec().Value.Identity = &specs.Identity{
+		Email: email,
+	}
+
+	// the key pruner removes the public key once it expires
+	if err = s.omniState.Create(ctx, publicKey, state.WithCreateOwner(pointer.To(omniCtrl.KeyPrunerController{}).Name())); err != nil {
+		if state.IsConflictError(err) {
+			return nil, status.Errorf(codes.AlreadyExists, "public key %q is already registered", key.id)
+		}
+
+		return nil, err
+	}
+
+	token := authres.NewBreakGlassToken(resources.DefaultNamespace, key.id)
+
+	token.TypedSpec().Value.Identity = email
+	token.TypedSpec().Value.Role = string(tokenRole)
+	token.TypedSpec().Value.Reason = reason
+	token.TypedSpec().Value.Expiration = timestamppb.New(key.expiration)
+	token.TypedSpec().Value.ClientAddress = address
+
+	if err = s.omniState.Create(ctx, token); err != nil {
+		return nil, err
+	}
+
+	auditLogger.Info("break-glass token issued",
+		zap.String("fingerprint", key.id),
+		zap.String("role", string(tokenRole)),
+		zap.Time("expiration", key.expiration),
+	)
+
+	return &management.CreateBreakGlassTokenResponse{
+		PublicKeyId: key.id,
+		Identity:    email,
+		Expiration:  timestamppb.New(key.expiration),
+	}, nil
+}
+
+// clientAddress returns the IP address of the client.
+//
+// The forwarded address is used only if the request comes from one of the trusted proxies, the other clients could spoof it.
+func clientAddress(ctx context.Context, trustedProxies []netip.Prefix) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	addrPort, err := netip.ParseAddrPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+
+	peerAddr := addrPort.Addr().Unmap()
+
+	if !slices.ContainsFunc(trustedProxies, func(prefix netip.Prefix) bool { return prefix.Contains(peerAddr) }) {
+		return peerAddr.String()
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	if values := md.Get("x-real-ip"); len(values) > 0 && values[0] != "" {
+		return strings.TrimSpace(values[0])
+	}
+
+	// the proxy appends the address of its client to the list, so the last entry is the one the proxy has seen
+	if values := md.Get("x-forwarded-for"); len(values) > 0 {
+		forwarded := strings.Split(values[len(values)-1], ",")
+
+		if last := strings.TrimSpace(forwarded[len(forwarded)-1]); last != "" {
+			return last
+		}
+	}
+
+	return peerAddr.String()
+}
+
+// parseTrustedProxies parses the addresses and the CIDRs of the trusted reverse proxies.
+func parseTrustedProxies(proxies []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(proxies))
+
+	for _, proxy := range proxies {
+		if addr, err := netip.ParseAddr(proxy); err == nil {
+			prefixes = append(prefixes, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", proxy, err)
+		}
+
+		prefixes = append(prefixes, prefix.Masked())
+	}
+
+	return prefixes, nil
+}
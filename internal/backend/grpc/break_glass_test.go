@@ -0,0 +1,82 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	grpcomni "github.com/siderolabs/omni/internal/backend/grpc"
+)
+
+func TestClientAddress(t *testing.T) {
+	t.Parallel()
+
+	trustedProxies := []string{"10.5.0.0/16", "::1"}
+
+	for _, tt := range []struct {
+		name     string
+		peer     string
+		md       metadata.MD
+		expected string
+	}{
+		{
+			name:     "direct",
+			peer:     "192.0.2.1:4242",
+			expected: "192.0.2.1",
+		},
+		{
+			name:     "spoofed headers",
+			peer:     "192.0.2.1:4242",
+			md:       metadata.Pairs("x-forwarded-for", "198.51.100.1", "x-real-ip", "198.51.100.2"),
+			expected: "192.0.2.1",
+		},
+		{
+			name:     "trusted proxy real ip",
+			peer:     "10.5.0.2:4242",
+			md:       metadata.Pairs("x-forwarded-for", "198.51.100.1", "x-real-ip", "198.51.100.2"),
+			expected: "198.51.100.2",
+		},
+		{
+			name:     "trusted proxy forwarded for",
+			peer:     "[::1]:4242",
+			md:       metadata.Pairs("x-forwarded-for", "203.0.113.1, 198.51.100.1"),
+			expected: "198.51.100.1",
+		},
+		{
+			name:     "trusted proxy without headers",
+			peer:     "10.5.0.2:4242",
+			expected: "10.5.0.2",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			addr, err := net.ResolveTCPAddr("tcp", tt.peer)
+			require.NoError(t, err)
+
+			ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+
+			address, err := grpcomni.ClientAddress(ctx, trustedProxies)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, address)
+		})
+	}
+
+	_, err := grpcomni.ClientAddress(context.Background(), []string{"not an address"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+
+	"github.com/siderolabs/gen/xslices"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/constants"
+	"github.com/siderolabs/omni/internal/backend/apiversion"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/features"
+	"github.com/siderolabs/omni/internal/version"
+)
+
+// GetCapabilities implements ManagementServer.
+//
+// The clients call it on connect to adapt to the features and the limits of the server.
+func (s *managementServer) GetCapabilities(ctx context.Context, _ *emptypb.Empty) (*management.GetCapabilitiesResponse, error) {
+	// capabilities are the same for all users, any authenticated client can read them
+	if _, err := auth.CheckGRPC(ctx, auth.WithValidSignature(true)); err != nil {
+		return nil, err
+	}
+
+	return &management.GetCapabilitiesResponse{
+		Version:  version.Tag,
+		Features: features.Enabled(),
+		Limits: &management.GetCapabilitiesResponse_Limits{
+			MaxMessageSize: constants.GRPCMaxMessageSize,
+		},
+		Deprecations: xslices.Map(apiversion.Deprecations(), func(deprecation apiversion.Deprecation) *management.GetCapabilitiesResponse_Deprecation {
+			return &management.GetCapabilitiesResponse_Deprecation{
+				Api:            deprecation.Method,
+				Message:        deprecation.Message,
+				RemovalVersion: deprecation.RemovalVersion,
+			}
+		}),
+	}, nil
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+// GetClusterAvailability implements ManagementServer.
+//
+// It computes the uptime percentages of the cluster from the heartbeats recorded in the ClusterAvailability.
+func (s *managementServer) GetClusterAvailability(ctx context.Context, req *management.GetClusterAvailabilityRequest) (*management.GetClusterAvailabilityResponse, error) {
+	clusterID := req.GetCluster()
+	if clusterID == "" {
+		return nil, status.Error(codes.InvalidArgument, "cluster is required")
+	}
+
+	ctx, err := s.applyClusterAccessPolicy(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = s.authCheckGRPC(ctx, auth.WithRole(role.Reader)); err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	availability, err := safe.StateGetByID[*omnires.ClusterAvailability](ctx, s.omniState, clusterID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "no availability data for the cluster %q", clusterID)
+		}
+
+		return nil, err
+	}
+
+	return clusterAvailability(availability.TypedSpec().Value, req.GetDays(), time.Now()), nil
+}
+
+// clusterAvailability computes the availability percentages over the given number of the latest days, all days are used if days is zero.
+func clusterAvailability(spec *specs.ClusterAvailabilitySpec, days uint32, now time.Time) *management.GetClusterAvailabilityResponse {
+	var (
+		since                                       time.Time
+		controlPlaneReachable, allMachinesConnected uint64
+	)
+
+	if days > 0 {
+		since = now.UTC().Truncate(24*time.Hour).AddDate(0, 0, -int(days)+1)
+	}
+
+	response := &management.GetClusterAvailabilityResponse{}
+
+	for _, day := range spec.GetDays() {
+		if day.GetDate().AsTime().Before(since) || day.GetHeartbeats() == 0 {
+			continue
+		}
+
+		response.Heartbeats += uint64(day.GetHeartbeats())
+		controlPlaneReachable += uint64(day.GetControlPlaneReachable())
+		allMachinesConnected += uint64(day.GetAllMachinesConnected())
+
+		response.Days = append(response.Days, &management.GetClusterAvailabilityResponse_Day{
+			Date:                  day.GetDate(),
+			ControlPlaneReachable: percentage(uint64(day.GetControlPlaneReachable()), uint64(day.GetHeartbeats())),
+			AllMachinesConnected:  percentage(uint64(day.GetAllMachinesConnected()), uint64(day.GetHeartbeats())),
+		})
+	}
+
+	response.ControlPlaneReachable = percentage(controlPlaneReachable, response.Heartbeats)
+	response.AllMachinesConnected = percentage(allMachinesConnected, response.Heartbeats)
+
+	return response
+}
+
+func percentage(value, total uint64) float64 {
+	if total == 0 {
+		return 0
+	}
+
+	return float64(value) / float64(total) * 100
+}
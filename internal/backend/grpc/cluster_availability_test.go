@@ -0,0 +1,97 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	grpcomni "github.com/siderolabs/omni/internal/backend/grpc"
+)
+
+func TestClusterAvailability(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)
+	today := now.Truncate(24 * time.Hour)
+
+	spec := &specs.ClusterAvailabilitySpec{
+		Days: []*specs.ClusterAvailabilitySpec_Day{
+			{
+				Date:                  timestamppb.New(today.AddDate(0, 0, -2)),
+				Heartbeats:            100,
+				ControlPlaneReachable: 50,
+				AllMachinesConnected:  0,
+			},
+			{
+				Date:                  timestamppb.New(today.AddDate(0, 0, -1)),
+				Heartbeats:            100,
+				ControlPlaneReachable: 100,
+				AllMachinesConnected:  90,
+			},
+			{
+				Date:                  timestamppb.New(today),
+				Heartbeats:            200,
+				ControlPlaneReachable: 200,
+				AllMachinesConnected:  150,
+			},
+		},
+	}
+
+	for _, tt := range []struct {
+		name                  string
+		days                  uint32
+		expectedHeartbeats    uint64
+		expectedDays          int
+		controlPlaneReachable float64
+		allMachinesConnected  float64
+	}{
+		{
+			name:                  "all days",
+			expectedHeartbeats:    400,
+			expectedDays:          3,
+			controlPlaneReachable: 87.5,
+			allMachinesConnected:  60,
+		},
+		{
+			name:                  "last two days",
+			days:                  2,
+			expectedHeartbeats:    300,
+			expectedDays:          2,
+			controlPlaneReachable: 100,
+			allMachinesConnected:  80,
+		},
+		{
+			name:                  "today",
+			days:                  1,
+			expectedHeartbeats:    200,
+			expectedDays:          1,
+			controlPlaneReachable: 100,
+			allMachinesConnected:  75,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			response := grpcomni.ClusterAvailability(spec, tt.days, now)
+
+			assert.Equal(t, tt.expectedHeartbeats, response.Heartbeats)
+			assert.InDelta(t, tt.controlPlaneReachable, response.ControlPlaneReachable, 0.001)
+			assert.InDelta(t, tt.allMachinesConnected, response.AllMachinesConnected, 0.001)
+			require.Len(t, response.Days, tt.expectedDays)
+		})
+	}
+
+	response := grpcomni.ClusterAvailability(&specs.ClusterAvailabilitySpec{}, 0, now)
+
+	assert.Zero(t, response.Heartbeats)
+	assert.Zero(t, response.ControlPlaneReachable)
+}
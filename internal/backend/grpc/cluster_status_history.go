@@ -0,0 +1,86 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+// GetClusterStatusHistory implements ManagementServer.
+//
+// It returns the time series of the key cluster status fields recorded in the ClusterStatusHistory.
+func (s *managementServer) GetClusterStatusHistory(ctx context.Context, req *management.GetClusterStatusHistoryRequest) (*management.GetClusterStatusHistoryResponse, error) {
+	clusterID := req.GetCluster()
+	if clusterID == "" {
+		return nil, status.Error(codes.InvalidArgument, "cluster is required")
+	}
+
+	ctx, err := s.applyClusterAccessPolicy(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = s.authCheckGRPC(ctx, auth.WithRole(role.Reader)); err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	history, err := safe.StateGetByID[*omnires.ClusterStatusHistory](ctx, s.omniState, clusterID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "no status history for the cluster %q", clusterID)
+		}
+
+		return nil, err
+	}
+
+	return clusterStatusHistory(history.TypedSpec().Value, req.GetRange())
+}
+
+// clusterStatusHistory converts the samples of the requested range to the time series points.
+func clusterStatusHistory(spec *specs.ClusterStatusHistorySpec, historyRange management.GetClusterStatusHistoryRequest_Range) (*management.GetClusterStatusHistoryResponse, error) {
+	var samples []*specs.ClusterStatusHistorySpec_Sample
+
+	switch historyRange {
+	case management.GetClusterStatusHistoryRequest_LAST_DAY:
+		samples = spec.GetRecent()
+	case management.GetClusterStatusHistoryRequest_LAST_WEEK:
+		samples = spec.GetHourly()
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown range %q", historyRange)
+	}
+
+	response := &management.GetClusterStatusHistoryResponse{
+		Points: make([]*management.GetClusterStatusHistoryResponse_Point, 0, len(samples)),
+	}
+
+	for _, sample := range samples {
+		response.Points = append(response.Points, &management.GetClusterStatusHistoryResponse_Point{
+			Timestamp:         sample.GetTimestamp(),
+			Phase:             sample.GetPhase().String(),
+			MachinesTotal:     sample.GetMachinesTotal(),
+			MachinesConnected: sample.GetMachinesConnected(),
+			MachinesHealthy:   sample.GetMachinesHealthy(),
+			NodesTotal:        sample.GetNodesTotal(),
+			NodesReady:        sample.GetNodesReady(),
+		})
+	}
+
+	return response, nil
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	grpcomni "github.com/siderolabs/omni/internal/backend/grpc"
+)
+
+func TestClusterStatusHistory(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)
+
+	spec := &specs.ClusterStatusHistorySpec{
+		Recent: []*specs.ClusterStatusHistorySpec_Sample{
+			{Timestamp: timestamppb.New(now.Add(-5 * time.Minute)), Phase: specs.ClusterStatusSpec_SCALING_UP, MachinesTotal: 3, MachinesConnected: 2},
+			{Timestamp: timestamppb.New(now), Phase: specs.ClusterStatusSpec_RUNNING, MachinesTotal: 3, MachinesConnected: 3, NodesTotal: 3, NodesReady: 3},
+		},
+		Hourly: []*specs.ClusterStatusHistorySpec_Sample{
+			{Timestamp: timestamppb.New(now), Phase: specs.ClusterStatusSpec_RUNNING, MachinesTotal: 3, MachinesConnected: 3, NodesTotal: 3, NodesReady: 3},
+		},
+	}
+
+	response, err := grpcomni.ClusterStatusHistory(spec, management.GetClusterStatusHistoryRequest_LAST_DAY)
+	require.NoError(t, err)
+	require.Len(t, response.Points, 2)
+
+	assert.Equal(t, "SCALING_UP", response.Points[0].Phase)
+	assert.EqualValues(t, 2, response.Points[0].MachinesConnected)
+	assert.Equal(t, "RUNNING", response.Points[1].Phase)
+	assert.EqualValues(t, 3, response.Points[1].NodesReady)
+
+	response, err = grpcomni.ClusterStatusHistory(spec, management.GetClusterStatusHistoryRequest_LAST_WEEK)
+	require.NoError(t, err)
+	require.Len(t, response.Points, 1)
+
+	assert.True(t, response.Points[0].Timestamp.AsTime().Equal(now))
+
+	_, err = grpcomni.ClusterStatusHistory(spec, management.GetClusterStatusHistoryRequest_Range(42))
+	assert.Error(t, err)
+}
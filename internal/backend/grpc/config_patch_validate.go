@@ -0,0 +1,240 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/hexops/gotextdiff"
+	"github.com/hexops/gotextdiff/myers"
+	"github.com/hexops/gotextdiff/span"
+	"github.com/siderolabs/crypto/x509"
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/configpatcher"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omniCtrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+// ValidateConfigPatch implements ManagementServer.
+//
+// It applies the config patch on top of the current machine configs of the target machines and validates the result,
+// nothing is persisted.
+func (s *managementServer) ValidateConfigPatch(ctx context.Context, req *management.ValidateConfigPatchRequest) (*management.ValidateConfigPatchResponse, error) {
+	if (req.GetMachine() == "") == (req.GetMachineSet() == "") {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of machine or machine set is required")
+	}
+
+	if err := omnires.ValidateConfigPatch(req.GetData()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if _, err := s.authCheckGRPC(ctx, auth.WithValidSignature(true)); err != nil {
+		return nil, err
+	}
+
+	clusterMachines, clusterID, err := s.configPatchTargets(actor.MarkContextAsInternalActor(ctx), req)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = s.applyClusterAccessPolicy(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = s.authCheckGRPC(ctx, auth.WithRole(role.Reader)); err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	isTemplate := omnires.IsConfigPatchTemplate(req.GetData())
+
+	patch, err := configpatcher.LoadPatch([]byte(req.GetData()))
+	if err != nil && !isTemplate {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	response := &management.ValidateConfigPatchResponse{
+		Results: make([]*management.ValidateConfigPatchResponse_Result, 0, len(clusterMachines)),
+	}
+
+	for _, clusterMachineID := range clusterMachines {
+		result := &management.ValidateConfigPatchResponse_Result{
+			Machine: clusterMachineID,
+		}
+
+		machineConfig, getErr := safe.StateGetByID[*omnires.ClusterMachineConfig](ctx, s.omniState, clusterMachineID)
+		if getErr != nil && !state.IsNotFoundError(getErr) {
+			return nil, getErr
+		}
+
+		machinePatch := patch
+
+		if isTemplate {
+			var renderErr error
+
+			// the template is rendered with the facts of each machine
+			machinePatch, renderErr = s.renderConfigPatchTemplate(ctx, clusterMachineID, req.GetData())
+			if renderErr != nil {
+				result.Error = renderErr.Error()
+				response.Results = append(response.Results, result)
+
+				continue
+			}
+		}
+
+		if machineConfig == nil || len(machineConfig.TypedSpec().Value.GetData()) == 0 {
+			result.Error = "the machine config is not generated yet"
+		} else {
+			var validateErr error
+
+			result.Diff, result.Warnings, validateErr = validatePatchedConfig(clusterMachineID, machineConfig.TypedSpec().Value.GetData(), machinePatch)
+			if validateErr != nil {
+				result.Error = validateErr.Error()
+			}
+		}
+
+		response.Results = append(response.Results, result)
+	}
+
+	return response, nil
+}
+
+// renderConfigPatchTemplate renders the config patch template with the facts of the cluster machine.
+func (s *managementServer) renderConfigPatchTemplate(ctx context.Context, clusterMachineID resource.ID, data string) (configpatcher.Patch, error) {
+	clusterMachine, err := safe.StateGetByID[*omnires.ClusterMachine](ctx, s.omniState, clusterMachineID)
+	if err != nil {
+		return nil, err
+	}
+
+	templateData, err := omniCtrl.ConfigPatchTemplateData(ctx, s.omniState, clusterMachine)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the machine facts: %w", err)
+	}
+
+	rendered, err := omnires.RenderConfigPatchTemplate(data, *templateData)
+	if err != nil {
+		return nil, err
+	}
+
+	return configpatcher.LoadPatch([]byte(rendered))
+}
+
+// configPatchTargets returns the IDs of the cluster machines the config patch is validated against and the cluster they belong to.
+func (s *managementServer) configPatchTargets(ctx context.Context, req *management.ValidateConfigPatchRequest) ([]resource.ID, resource.ID, error) {
+	if req.GetMachine() != "" {
+		clusterMachine, err := safe.StateGetByID[*omnires.ClusterMachine](ctx, s.omniState, req.GetMachine())
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				return nil, "", status.Errorf(codes.NotFound, "machine %q is not part of any cluster", req.GetMachine())
+			}
+
+			return nil, "", err
+		}
+
+		clusterID, _ := clusterMachine.Metadata().Labels().Get(omnires.LabelCluster)
+
+		return []resource.ID{clusterMachine.Metadata().ID()}, clusterID, nil
+	}
+
+	machineSet, err := safe.StateGetByID[*omnires.MachineSet](ctx, s.omniState, req.GetMachineSet())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, "", status.Errorf(codes.NotFound, "machine set %q doesn't exist", req.GetMachineSet())
+		}
+
+		return nil, "", err
+	}
+
+	clusterID, _ := machineSet.Metadata().Labels().Get(omnires.LabelCluster)
+
+	clusterMachines, err := safe.StateListAll[*omnires.ClusterMachine](ctx, s.omniState, state.WithLabelQuery(resource.LabelEqual(omnires.LabelMachineSet, machineSet.Metadata().ID())))
+	if err != nil {
+		return nil, "", err
+	}
+
+	ids := make([]resource.ID, 0, clusterMachines.Len())
+
+	for iter := clusterMachines.Iterator(); iter.Next(); {
+		ids = append(ids, iter.Value().Metadata().ID())
+	}
+
+	return ids, clusterID, nil
+}
+
+// configValidationMode is the runtime mode the patched config is validated in.
+//
+// The installation checks are skipped, as the machines might be running without the installation.
+type configValidationMode struct{}
+
+func (configValidationMode) String() string        { return "metal" }
+func (configValidationMode) RequiresInstall() bool { return false }
+func (configValidationMode) InContainer() bool     { return false }
+
+// validatePatchedConfig applies the patch on top of the machine config and returns the diff with the secrets redacted and the validation warnings.
+func validatePatchedConfig(clusterMachineID string, data []byte, patch configpatcher.Patch) (string, []string, error) {
+	current, err := configloader.NewFromBytes(data)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to load the current machine config: %w", err)
+	}
+
+	patched, err := configpatcher.Apply(configpatcher.WithConfig(current), []configpatcher.Patch{patch})
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to apply the patch: %w", err)
+	}
+
+	patchedConfig, err := patched.Config()
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to load the patched machine config: %w", err)
+	}
+
+	warnings, validationErr := patchedConfig.Validate(configValidationMode{}, validation.WithLocal())
+
+	currentData, err := redactedConfig(current)
+	if err != nil {
+		return "", nil, err
+	}
+
+	patchedData, err := redactedConfig(patchedConfig)
+	if err != nil {
+		return "", nil, err
+	}
+
+	oldPath, newPath := clusterMachineID+" (current)", clusterMachineID+" (patched)"
+
+	edits := myers.ComputeEdits(span.URIFromPath(oldPath), currentData, patchedData)
+
+	diff := gotextdiff.ToUnified(oldPath, newPath, currentData, edits)
+	if len(diff.Hunks) == 0 {
+		return "", warnings, validationErr
+	}
+
+	return fmt.Sprint(diff), warnings, validationErr
+}
+
+func redactedConfig(cfg config.Provider) (string, error) {
+	data, err := cfg.RedactSecrets(x509.Redacted).EncodeBytes(encoder.WithComments(encoder.CommentsDisabled))
+	if err != nil {
+		return "", fmt.Errorf("failed to encode the machine config: %w", err)
+	}
+
+	return string(data), nil
+}
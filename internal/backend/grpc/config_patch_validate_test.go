@@ -0,0 +1,72 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc_test
+
+import (
+	"testing"
+
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configpatcher"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
+	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	grpcomni "github.com/siderolabs/omni/internal/backend/grpc"
+)
+
+func TestValidatePatchedConfig(t *testing.T) {
+	t.Parallel()
+
+	bundle, err := secrets.NewBundle(secrets.NewClock(), config.TalosVersionCurrent)
+	require.NoError(t, err)
+
+	input, err := generate.NewInput("test", "https://127.0.0.1:6443", "1.30.0", generate.WithSecretsBundle(bundle))
+	require.NoError(t, err)
+
+	cfg, err := input.Config(machine.TypeWorker)
+	require.NoError(t, err)
+
+	data, err := cfg.Bytes()
+	require.NoError(t, err)
+
+	loadPatch := func(patch string) configpatcher.Patch {
+		p, loadErr := configpatcher.LoadPatch([]byte(patch))
+		require.NoError(t, loadErr)
+
+		return p
+	}
+
+	diff, _, err := grpcomni.ValidatePatchedConfig("machine-1", data, loadPatch(`machine:
+  network:
+    hostname: patched-hostname
+`))
+	require.NoError(t, err)
+
+	assert.Contains(t, diff, "--- machine-1 (current)")
+	assert.Contains(t, diff, "+++ machine-1 (patched)")
+	assert.Contains(t, diff, "-    network: {}")
+	assert.Contains(t, diff, "+        hostname: patched-hostname")
+	assert.NotContains(t, diff, bundle.Secrets.BootstrapToken)
+
+	// the patch which doesn't change anything produces no diff
+	diff, _, err = grpcomni.ValidatePatchedConfig("machine-1", data, loadPatch(`machine:
+  kubelet: {}
+`))
+	require.NoError(t, err)
+	assert.Empty(t, diff)
+
+	// the patched config is validated
+	_, _, err = grpcomni.ValidatePatchedConfig("machine-1", data, loadPatch(`machine:
+  network:
+    interfaces:
+      - interface: eth0
+        addresses:
+          - not-an-address
+`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+// GetConfigReloadStatus implements ManagementServer.
+//
+// It reports which settings changed by the latest config file reload were applied and which require a restart.
+func (s *managementServer) GetConfigReloadStatus(ctx context.Context, _ *emptypb.Empty) (*management.GetConfigReloadStatusResponse, error) {
+	if _, err := auth.CheckGRPC(ctx, auth.WithRole(role.Admin)); err != nil {
+		return nil, err
+	}
+
+	reloader := config.DefaultReloader()
+	if reloader == nil {
+		return &management.GetConfigReloadStatusResponse{}, nil
+	}
+
+	status := reloader.Status()
+
+	response := &management.GetConfigReloadStatusResponse{
+		ConfigPath:      reloader.Path(),
+		Applied:         status.Applied,
+		RestartRequired: status.RestartRequired,
+	}
+
+	if !status.Time.IsZero() {
+		response.ReloadTime = timestamppb.New(status.Time)
+	}
+
+	if status.Err != nil {
+		response.Error = status.Err.Error()
+	}
+
+	return response, nil
+}
@@ -57,8 +57,7 @@ func TestGenerateConfigs(t *testing.T) {
 	st := state.WrapCore(namespaced.NewState(inmem.Build))
 	logger := zaptest.NewLogger(t)
 
-	rt, err := omniruntime.New(nil, nil, nil, nil,
-		nil, nil, nil, st, nil, prometheus.NewRegistry(), nil, nil, logger)
+	rt, err := omniruntime.New(nil, nil, nil, st, prometheus.NewRegistry(), nil, logger)
 	require.NoError(t, err)
 
 	runtime.Install(omniruntime.Name, rt)
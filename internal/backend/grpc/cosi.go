@@ -13,6 +13,8 @@ import (
 	"github.com/cosi-project/runtime/pkg/state/protobuf/server"
 	gateway "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
+
+	"github.com/siderolabs/omni/internal/backend/apiversion"
 )
 
 // COSIResourceServer implements installation of COSI resource API server.
@@ -21,7 +23,7 @@ type COSIResourceServer struct {
 }
 
 func (s *COSIResourceServer) register(srv grpc.ServiceRegistrar) {
-	v1alpha1.RegisterStateServer(srv, server.NewState(s.State))
+	v1alpha1.RegisterStateServer(srv, server.NewState(newPaginatedState(apiversion.WrapState(s.State))))
 }
 
 func (s *COSIResourceServer) gateway(ctx context.Context, mux *gateway.ServeMux, address string, opts []grpc.DialOption) error {
@@ -6,14 +6,33 @@
 package grpc
 
 import (
+	"context"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/talos/pkg/machinery/config/configpatcher"
 	"go.uber.org/zap"
 
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/internal/backend/imagefactory"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+	"github.com/siderolabs/omni/internal/pkg/siderolink"
 )
 
 type ManagementServer = managementServer
 
+type AuthServer = authServer
+
+//nolint:revive
+func NewAuthServer(st state.State, logger *zap.Logger) *AuthServer {
+	return &AuthServer{
+		state:  st,
+		logger: logger,
+	}
+}
+
 //nolint:revive
 func NewManagementServer(st state.State, imageFactoryClient *imagefactory.Client, logger *zap.Logger) *ManagementServer {
 	return &ManagementServer{
@@ -23,6 +42,51 @@ func NewManagementServer(st state.State, imageFactoryClient *imagefactory.Client
 	}
 }
 
+func UpgradeProgress(ctx context.Context, st state.State, clusterID string) (*management.WatchUpgradeProgressResponse, error) {
+	return upgradeProgress(ctx, st, clusterID)
+}
+
+func ClusterAvailability(spec *specs.ClusterAvailabilitySpec, days uint32, now time.Time) *management.GetClusterAvailabilityResponse {
+	return clusterAvailability(spec, days, now)
+}
+
+func ClusterStatusHistory(spec *specs.ClusterStatusHistorySpec, historyRange management.GetClusterStatusHistoryRequest_Range) (*management.GetClusterStatusHistoryResponse, error) {
+	return clusterStatusHistory(spec, historyRange)
+}
+
 func GenerateDest(apiurl string) (string, error) {
 	return generateDest(apiurl)
 }
+
+func LimitPublicKeyExpiration(expiration time.Time, keyRole role.Role, now time.Time) time.Time {
+	return limitPublicKeyExpiration(expiration, keyRole, &specs.SettingsSpec{}, now)
+}
+
+func NewPaginatedState(st state.CoreState) state.CoreState { //nolint:ireturn
+	return newPaginatedState(st)
+}
+
+func ValidatePatchedConfig(clusterMachineID string, data []byte, patch configpatcher.Patch) (string, []string, error) {
+	return validatePatchedConfig(clusterMachineID, data, patch)
+}
+
+func JoinISOURL(imageFactoryBaseURL, schematicID, talosVersion, arch string, secureBoot bool) (string, error) {
+	return joinISOURL(imageFactoryBaseURL, schematicID, talosVersion, arch, secureBoot)
+}
+
+func ResourceChanges(existing, res resource.Resource) ([]*management.ValidateResourceResponse_FieldChange, error) {
+	return resourceChanges(existing, res)
+}
+
+func (s *ManagementServer) SetLogHandler(logHandler *siderolink.LogHandler) {
+	s.logHandler = logHandler
+}
+
+func ClientAddress(ctx context.Context, trustedProxies []string) (string, error) {
+	prefixes, err := parseTrustedProxies(trustedProxies)
+	if err != nil {
+		return "", err
+	}
+
+	return clientAddress(ctx, prefixes), nil
+}
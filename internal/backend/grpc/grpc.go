@@ -27,7 +27,9 @@ import (
 	"github.com/siderolabs/omni/internal/backend/imagefactory"
 	"github.com/siderolabs/omni/internal/backend/logging"
 	"github.com/siderolabs/omni/internal/backend/monitoring"
+	"github.com/siderolabs/omni/internal/backend/payloadsampler"
 	"github.com/siderolabs/omni/internal/memconn"
+	"github.com/siderolabs/omni/internal/pkg/auth/breakglass"
 	"github.com/siderolabs/omni/internal/pkg/compress"
 	"github.com/siderolabs/omni/internal/pkg/config"
 	"github.com/siderolabs/omni/internal/pkg/siderolink"
@@ -42,11 +44,13 @@ type ServiceServer interface {
 // MakeServiceServers creates a list of service servers.
 func MakeServiceServers(
 	state state.State,
+	dryRunState state.State,
 	logHandler *siderolink.LogHandler,
 	oidcProvider OIDCProvider,
 	jwtSigningKeyProvider JWTSigningKeyProvider,
 	dnsService *dns.Service,
 	imageFactoryClient *imagefactory.Client,
+	payloadSampler *payloadsampler.Sampler,
 	logger *zap.Logger,
 ) ([]ServiceServer, error) {
 	dest, err := generateDest(config.Config.APIURL)
@@ -54,6 +58,17 @@ func MakeServiceServers(
 		return nil, err
 	}
 
+	var breakGlassVerifier *breakglass.Verifier
+
+	if config.Config.BreakGlass.Enabled() {
+		breakGlassVerifier = breakglass.NewVerifier(config.Config.BreakGlass.SecretPath, config.Config.BreakGlass.TOTPSeedPath)
+	}
+
+	breakGlassTrustedProxies, err := parseTrustedProxies(config.Config.BreakGlass.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
+
 	return []ServiceServer{
 		&ResourceServer{},
 		&oidcServer{
@@ -63,9 +78,13 @@ func MakeServiceServers(
 			logHandler:            logHandler,
 			omniconfigDest:        dest,
 			omniState:             state,
+			dryRunState:           dryRunState,
 			dnsService:            dnsService,
 			jwtSigningKeyProvider: jwtSigningKeyProvider,
+			breakGlassVerifier:    breakGlassVerifier,
+			breakGlassProxies:     breakGlassTrustedProxies,
 			imageFactoryClient:    imageFactoryClient,
+			payloadSampler:        payloadSampler,
 			logger:                logger.With(logging.Component("management_server")),
 		},
 		&authServer{
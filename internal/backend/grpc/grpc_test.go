@@ -33,13 +33,16 @@ import (
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/siderolabs/omni/client/api/common"
 	"github.com/siderolabs/omni/client/api/omni/management"
 	resapi "github.com/siderolabs/omni/client/api/omni/resources"
 	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/constants"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/discovery"
 	"github.com/siderolabs/omni/internal/backend/dns"
 	grpcomni "github.com/siderolabs/omni/internal/backend/grpc"
 	"github.com/siderolabs/omni/internal/backend/imagefactory"
@@ -92,8 +95,8 @@ func (suite *GrpcSuite) SetupTest() {
 
 	workloadProxyReconciler := workloadproxy.NewReconciler(logger, zap.InfoLevel)
 
-	suite.runtime, err = omniruntime.New(clientFactory, dnsService, workloadProxyReconciler, nil,
-		imageFactoryClient, nil, nil, suite.state, nil, prometheus.NewRegistry(), discoveryServiceClientMock, nil, logger)
+	suite.runtime, err = omniruntime.New(clientFactory, dnsService, workloadProxyReconciler, suite.state, prometheus.NewRegistry(),
+		discoveryServiceClientMock, logger, omniruntime.WithImageFactoryClient(imageFactoryClient))
 	suite.Require().NoError(err)
 	runtime.Install(omniruntime.Name, suite.runtime)
 
@@ -275,6 +278,16 @@ func (suite *GrpcSuite) TestConfigValidation() {
 	suite.Require().Equalf(codes.InvalidArgument, status.Code(err), err.Error())
 }
 
+func (suite *GrpcSuite) TestGetCapabilities() {
+	client := management.NewManagementServiceClient(suite.conn)
+
+	capabilities, err := client.GetCapabilities(suite.ctx, &emptypb.Empty{})
+	suite.Require().NoError(err)
+
+	suite.Require().EqualValues(constants.GRPCMaxMessageSize, capabilities.GetLimits().GetMaxMessageSize())
+	suite.Require().NotEmpty(capabilities.GetVersion())
+}
+
 func (suite *GrpcSuite) newServer(imageFactoryClient *imagefactory.Client, logger *zap.Logger, opts ...grpc.ServerOption) error {
 	var err error
 
@@ -320,6 +333,11 @@ func (d *discoveryClientMock) AffiliateDelete(context.Context, string, string) e
 	return nil
 }
 
+// ListAffiliates implements the omni.DiscoveryClient interface.
+func (d *discoveryClientMock) ListAffiliates(context.Context, string, ...string) ([]discovery.Affiliate, error) {
+	return nil, nil
+}
+
 func TestGrpcSuite(t *testing.T) {
 	t.Parallel()
 
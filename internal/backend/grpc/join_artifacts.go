@@ -0,0 +1,113 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/siderolabs/image-factory/pkg/schematic"
+	"github.com/siderolabs/talos/pkg/machinery/imager/quirks"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/siderolink"
+	omniCtrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+// GetJoinArtifacts implements ManagementServer.
+//
+// It returns everything needed to connect a machine to Omni with the join token:
+// the kernel arguments, the image factory ISO URL with the kernel arguments embedded and the partial machine config.
+func (s *managementServer) GetJoinArtifacts(ctx context.Context, req *management.GetJoinArtifactsRequest) (*management.GetJoinArtifactsResponse, error) {
+	// the artifacts contain the join token, so getting them is equivalent to creating a machine
+	if _, err := auth.CheckGRPC(ctx, auth.WithRole(role.Operator)); err != nil {
+		return nil, err
+	}
+
+	talosVersion := strings.TrimLeft(req.GetTalosVersion(), "v")
+	if talosVersion == "" {
+		return nil, status.Error(codes.InvalidArgument, "talos version is required")
+	}
+
+	arch := req.GetArch()
+	if arch == "" {
+		arch = "amd64"
+	}
+
+	if arch != "amd64" && arch != "arm64" {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported architecture %q", arch)
+	}
+
+	params, err := safe.StateGet[*siderolink.ConnectionParams](ctx, s.omniState, siderolink.NewConnectionParams(
+		resources.DefaultNamespace,
+		siderolink.ConfigID,
+	).Metadata())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Omni connection params: %w", err)
+	}
+
+	if req.GetJoinToken() != "" && subtle.ConstantTimeCompare([]byte(req.GetJoinToken()), []byte(params.TypedSpec().Value.JoinToken)) != 1 {
+		return nil, status.Error(codes.NotFound, "join token not found")
+	}
+
+	kernelArgs := siderolink.KernelArgs(params)
+
+	schematicInfo, err := s.imageFactoryClient.EnsureSchematic(ctx, schematic.Schematic{
+		Customization: schematic.Customization{
+			ExtraKernelArgs: kernelArgs,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to ensure schematic: %w", err)
+	}
+
+	isoURL, err := joinISOURL(config.Config.ImageFactoryBaseURL, schematicInfo.FullID, talosVersion, arch, req.GetSecureBoot())
+	if err != nil {
+		return nil, err
+	}
+
+	response := &management.GetJoinArtifactsResponse{
+		KernelArgs:  kernelArgs,
+		IsoUrl:      isoURL,
+		SchematicId: schematicInfo.FullID,
+	}
+
+	if quirks.New(talosVersion).SupportsMultidoc() {
+		response.Config, err = omniCtrl.RenderJoinConfig(params, config.Config.EventSinkPort)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render the join config: %w", err)
+		}
+	}
+
+	return response, nil
+}
+
+// joinISOURL returns the image factory URL of the metal ISO built from the schematic.
+func joinISOURL(imageFactoryBaseURL, schematicID, talosVersion, arch string, secureBoot bool) (string, error) {
+	baseURL, err := url.Parse(imageFactoryBaseURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid URL specified for the image factory: %w", err)
+	}
+
+	media := &specs.InstallationMediaSpec{
+		Profile:      "metal",
+		Architecture: arch,
+		Extension:    "iso",
+	}
+
+	return baseURL.JoinPath("image", schematicID, "v"+talosVersion, media.GenerateFilename(false, secureBoot, true)).String(), nil
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	grpcomni "github.com/siderolabs/omni/internal/backend/grpc"
+)
+
+func TestJoinISOURL(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name       string
+		arch       string
+		expected   string
+		secureBoot bool
+	}{
+		{
+			name:     "amd64",
+			arch:     "amd64",
+			expected: "https://factory.talos.dev/image/abcd/v1.7.6/metal-amd64.iso",
+		},
+		{
+			name:       "arm64 secure boot",
+			arch:       "arm64",
+			secureBoot: true,
+			expected:   "https://factory.talos.dev/image/abcd/v1.7.6/metal-arm64-secureboot.iso",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			isoURL, err := grpcomni.JoinISOURL("https://factory.talos.dev", "abcd", "1.7.6", tt.arch, tt.secureBoot)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, isoURL)
+		})
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+	"maps"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/cosi/labels"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/helpers"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+// UpdateMachineLabels implements ManagementServer.
+//
+// It updates the MachineLabels of all machines matching the selectors, a failure to update a machine doesn't stop
+// the update of the others and is returned in the result of the machine.
+func (s *managementServer) UpdateMachineLabels(ctx context.Context, req *management.UpdateMachineLabelsRequest) (*management.UpdateMachineLabelsResponse, error) {
+	if _, err := s.authCheckGRPC(ctx, auth.WithRole(role.Operator)); err != nil {
+		return nil, err
+	}
+
+	if err := validateMachineLabelsUpdate(req); err != nil {
+		return nil, err
+	}
+
+	queries, err := labels.ParseSelectors(req.GetSelectors())
+	if err != nil {
+		return nil, err
+	}
+
+	listOptions := make([]state.ListOption, 0, len(queries))
+
+	for _, query := range queries {
+		listOptions = append(listOptions, state.WithLabelQuery(resource.RawLabelQuery(query)))
+	}
+
+	machineStatuses, err := safe.StateListAll[*omnires.MachineStatus](ctx, s.omniState, listOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*management.UpdateMachineLabelsResponse_Result, 0, machineStatuses.Len())
+
+	for iter := machineStatuses.Iterator(); iter.Next(); {
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		machineStatus := iter.Value()
+
+		result := &management.UpdateMachineLabelsResponse_Result{
+			MachineId: machineStatus.Metadata().ID(),
+		}
+
+		if result.Updated, err = s.updateMachineLabels(ctx, machineStatus, req); err != nil {
+			result.Error = err.Error()
+		}
+
+		results = append(results, result)
+	}
+
+	return &management.UpdateMachineLabelsResponse{
+		Results: results,
+	}, nil
+}
+
+func validateMachineLabelsUpdate(req *management.UpdateMachineLabelsRequest) error {
+	if len(req.GetSelectors()) == 0 {
+		return status.Error(codes.InvalidArgument, "at least one selector is required")
+	}
+
+	if len(req.GetSet()) == 0 && len(req.GetRemove()) == 0 {
+		return status.Error(codes.InvalidArgument, "no labels to set or remove")
+	}
+
+	for key := range req.GetSet() {
+		if key == "" {
+			return status.Error(codes.InvalidArgument, "label key can't be empty")
+		}
+
+		if strings.HasPrefix(key, omnires.SystemLabelPrefix) {
+			return status.Errorf(codes.InvalidArgument, "system label %q can't be set", key)
+		}
+	}
+
+	for _, key := range req.GetRemove() {
+		if strings.HasPrefix(key, omnires.SystemLabelPrefix) {
+			return status.Errorf(codes.InvalidArgument, "system label %q can't be removed", key)
+		}
+
+		if _, ok := req.GetSet()[key]; ok {
+			return status.Errorf(codes.InvalidArgument, "label %q can't be both set and removed", key)
+		}
+	}
+
+	return nil
+}
+
+// updateMachineLabels applies the update to the MachineLabels of the machine and returns true if the labels were changed.
+//
+// The MachineLabels resource is created on the first update, it inherits the user labels of the machine (e.g. the ones set in the installation media),
+// as the labels of the MachineLabels resource take over them.
+func (s *managementServer) updateMachineLabels(ctx context.Context, machineStatus *omnires.MachineStatus, req *management.UpdateMachineLabelsRequest) (bool, error) {
+	machineLabels, err := safe.StateGetByID[*omnires.MachineLabels](ctx, s.omniState, machineStatus.Metadata().ID())
+	if err != nil && !state.IsNotFoundError(err) {
+		return false, err
+	}
+
+	if machineLabels == nil {
+		machineLabels = omnires.NewMachineLabels(resources.DefaultNamespace, machineStatus.Metadata().ID())
+
+		helpers.CopyUserLabels(machineLabels, machineStatus.Metadata().Labels().Raw())
+
+		if !applyLabelsUpdate(machineLabels, req) {
+			return false, nil
+		}
+
+		return true, s.omniState.Create(ctx, machineLabels)
+	}
+
+	updated := false
+
+	if _, err = safe.StateUpdateWithConflicts(ctx, s.omniState, machineLabels.Metadata(), func(res *omnires.MachineLabels) error {
+		updated = applyLabelsUpdate(res, req)
+
+		return nil
+	}); err != nil {
+		return false, err
+	}
+
+	return updated, nil
+}
+
+func applyLabelsUpdate(res resource.Resource, req *management.UpdateMachineLabelsRequest) bool {
+	current := maps.Clone(res.Metadata().Labels().Raw())
+
+	for key, value := range req.GetSet() {
+		res.Metadata().Labels().Set(key, value)
+	}
+
+	for _, key := range req.GetRemove() {
+		res.Metadata().Labels().Delete(key)
+	}
+
+	return !maps.Equal(current, res.Metadata().Labels().Raw())
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	grpcomni "github.com/siderolabs/omni/internal/backend/grpc"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+)
+
+func TestUpdateMachineLabels(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	ctx = context.WithValue(ctx, auth.EnabledAuthContextKey{}, false)
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	for id, site := range map[string]string{"m1": "a", "m2": "a", "m3": "b"} {
+		machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, id)
+		machineStatus.Metadata().Labels().Set("site", site)
+		machineStatus.Metadata().Labels().Set(omni.MachineStatusLabelConnected, "")
+
+		require.NoError(t, st.Create(ctx, machineStatus))
+	}
+
+	// the existing labels are kept
+	existing := omni.NewMachineLabels(resources.DefaultNamespace, "m2")
+	existing.Metadata().Labels().Set("site", "a")
+	existing.Metadata().Labels().Set("rack", "1")
+
+	require.NoError(t, st.Create(ctx, existing))
+
+	srv := grpcomni.NewManagementServer(st, nil, zaptest.NewLogger(t))
+
+	resp, err := srv.UpdateMachineLabels(ctx, &management.UpdateMachineLabelsRequest{
+		Selectors: []string{"site=a"},
+		Set:       map[string]string{"env": "prod"},
+		Remove:    []string{"rack"},
+	})
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []*management.UpdateMachineLabelsResponse_Result{
+		{MachineId: "m1", Updated: true},
+		{MachineId: "m2", Updated: true},
+	}, resp.Results)
+
+	machineLabels, err := safe.StateGetByID[*omni.MachineLabels](ctx, st, "m1")
+	require.NoError(t, err)
+
+	// the user labels of the machine are copied, the system labels are not
+	assert.Equal(t, map[string]string{"site": "a", "env": "prod"}, machineLabels.Metadata().Labels().Raw())
+
+	machineLabels, err = safe.StateGetByID[*omni.MachineLabels](ctx, st, "m2")
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{"site": "a", "env": "prod"}, machineLabels.Metadata().Labels().Raw())
+
+	_, err = safe.StateGetByID[*omni.MachineLabels](ctx, st, "m3")
+	require.True(t, state.IsNotFoundError(err))
+
+	// the repeated update doesn't change anything
+	resp, err = srv.UpdateMachineLabels(ctx, &management.UpdateMachineLabelsRequest{
+		Selectors: []string{"site=a"},
+		Set:       map[string]string{"env": "prod"},
+	})
+	require.NoError(t, err)
+
+	for _, result := range resp.Results {
+		assert.False(t, result.Updated, result.MachineId)
+		assert.Empty(t, result.Error, result.MachineId)
+	}
+
+	for _, req := range []*management.UpdateMachineLabelsRequest{
+		{Set: map[string]string{"env": "prod"}},
+		{Selectors: []string{"site=a"}},
+		{Selectors: []string{"site=a"}, Set: map[string]string{omni.SystemLabelPrefix + "cluster": "test"}},
+		{Selectors: []string{"site=a"}, Set: map[string]string{"env": "prod"}, Remove: []string{"env"}},
+	} {
+		_, err = srv.UpdateMachineLabels(ctx, req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), req.String())
+	}
+}
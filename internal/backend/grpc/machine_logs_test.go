@@ -0,0 +1,138 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	grpcomni "github.com/siderolabs/omni/internal/backend/grpc"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+	"github.com/siderolabs/omni/internal/pkg/config"
+	"github.com/siderolabs/omni/internal/pkg/siderolink"
+)
+
+type machineLogsServer struct {
+	grpc.ServerStream
+
+	ctx   context.Context //nolint:containedctx
+	lines []string
+}
+
+func (s *machineLogsServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *machineLogsServer) Send(data *common.Data) error {
+	s.lines = append(s.lines, string(data.Bytes))
+
+	return nil
+}
+
+func TestMachineLogsClusterScope(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	ctx = context.WithValue(ctx, auth.EnabledAuthContextKey{}, true)
+	ctx = context.WithValue(ctx, auth.RoleContextKey{}, role.Reader)
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	logHandler, err := siderolink.NewLogHandler(siderolink.NewMachineMap(&siderolink.MapStorage{}), st, &config.MachineLogConfigParams{
+		BufferInitialCapacity: 16,
+		BufferMaxCapacity:     128,
+		BufferSafetyGap:       16,
+		NumCompressedChunks:   5,
+		StorageFlushPeriod:    time.Second,
+	}, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	for machineID, cluster := range map[string]string{"m1": "talos-default", "m2": "other"} {
+		clusterMachine := omni.NewClusterMachine(resources.DefaultNamespace, machineID)
+		clusterMachine.Metadata().Labels().Set(omni.LabelCluster, cluster)
+
+		require.NoError(t, st.Create(ctx, clusterMachine))
+	}
+
+	for _, machineID := range []string{"m1", "m2", "m3"} {
+		require.NoError(t, logHandler.Cache.WriteMessage(siderolink.MachineID(machineID), []byte("log of "+machineID)))
+	}
+
+	srv := grpcomni.NewManagementServer(st, nil, zaptest.NewLogger(t))
+	srv.SetLogHandler(logHandler)
+
+	scopedCtx := context.WithValue(ctx, auth.ClusterContextKey{}, "talos-default")
+
+	for _, tt := range []struct {
+		ctx           context.Context //nolint:containedctx
+		name          string
+		machineID     string
+		expectedLines []string
+		expectedCode  codes.Code
+	}{
+		{
+			name:          "not restricted",
+			ctx:           ctx,
+			machineID:     "m2",
+			expectedLines: []string{"log of m2"},
+		},
+		{
+			name:          "machine of the cluster",
+			ctx:           scopedCtx,
+			machineID:     "m1",
+			expectedLines: []string{"log of m1"},
+		},
+		{
+			name:         "machine of other cluster",
+			ctx:          scopedCtx,
+			machineID:    "m2",
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "machine not in a cluster",
+			ctx:          scopedCtx,
+			machineID:    "m3",
+			expectedCode: codes.PermissionDenied,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			logs := &machineLogsServer{ctx: tt.ctx}
+
+			err := srv.MachineLogs(&management.MachineLogsRequest{MachineId: tt.machineID, TailLines: -1}, logs)
+			require.Equal(t, tt.expectedCode, status.Code(err), "unexpected error: %v", err)
+
+			var lines []string
+
+			for _, line := range logs.lines {
+				if line != "" {
+					lines = append(lines, line)
+				}
+			}
+
+			assert.Equal(t, tt.expectedLines, lines)
+		})
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omniCtrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+// MoveMachine implements ManagementServer.
+//
+// It validates that the worker machine can be moved to the target cluster without reinstalling it
+// and creates the MachineMoveRequest which is then processed by the MachineMoveController.
+//
+//nolint:gocyclo,cyclop
+func (s *managementServer) MoveMachine(ctx context.Context, req *management.MoveMachineRequest) (*emptypb.Empty, error) {
+	if _, err := s.authCheckGRPC(ctx, auth.WithRole(role.Operator)); err != nil {
+		return nil, err
+	}
+
+	if req.GetMachineId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "machine ID is required")
+	}
+
+	if req.GetTargetCluster() == "" {
+		return nil, status.Error(codes.InvalidArgument, "target cluster is required")
+	}
+
+	targetMachineSetID := req.GetTargetMachineSet()
+	if targetMachineSetID == "" {
+		targetMachineSetID = omnires.WorkersResourceID(req.GetTargetCluster())
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	machineSetNode, err := safe.StateGetByID[*omnires.MachineSetNode](ctx, s.omniState, req.GetMachineId())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "machine %q is not allocated to any cluster", req.GetMachineId())
+		}
+
+		return nil, err
+	}
+
+	if machineSetNode.Metadata().Phase() == resource.PhaseTearingDown {
+		return nil, status.Errorf(codes.FailedPrecondition, "machine %q is being removed from the cluster", req.GetMachineId())
+	}
+
+	if _, ok := machineSetNode.Metadata().Labels().Get(omnires.LabelWorkerRole); !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "only worker machines can be moved")
+	}
+
+	// the machines allocated manually or moved before can be moved, the machine class allocations are managed by the controller
+	if owner := machineSetNode.Metadata().Owner(); owner != "" && owner != omniCtrl.MachineMoveControllerName {
+		return nil, status.Errorf(codes.FailedPrecondition, "machine %q is allocated from a machine class and can't be moved", req.GetMachineId())
+	}
+
+	sourceCluster, _ := machineSetNode.Metadata().Labels().Get(omnires.LabelCluster)
+	if sourceCluster == req.GetTargetCluster() {
+		return nil, status.Errorf(codes.InvalidArgument, "machine %q is already in the cluster %q", req.GetMachineId(), sourceCluster)
+	}
+
+	targetCluster, err := safe.StateGetByID[*omnires.Cluster](ctx, s.omniState, req.GetTargetCluster())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "cluster %q doesn't exist", req.GetTargetCluster())
+		}
+
+		return nil, err
+	}
+
+	if targetCluster.Metadata().Phase() == resource.PhaseTearingDown {
+		return nil, status.Errorf(codes.FailedPrecondition, "cluster %q is being destroyed", req.GetTargetCluster())
+	}
+
+	targetMachineSet, err := safe.StateGetByID[*omnires.MachineSet](ctx, s.omniState, targetMachineSetID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "machine set %q doesn't exist", targetMachineSetID)
+		}
+
+		return nil, err
+	}
+
+	if err = validateMoveTargetMachineSet(targetMachineSet, req.GetTargetCluster()); err != nil {
+		return nil, err
+	}
+
+	machineStatus, err := safe.StateGetByID[*omnires.MachineStatus](ctx, s.omniState, req.GetMachineId())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "machine %q doesn't exist", req.GetMachineId())
+		}
+
+		return nil, err
+	}
+
+	if err = omnires.ValidateMachineMove(machineStatus, targetCluster); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "machine %q can't be moved without a reinstall: %s", req.GetMachineId(), err)
+	}
+
+	moveStatus, err := safe.StateGetByID[*omnires.MachineMoveStatus](ctx, s.omniState, req.GetMachineId())
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	if moveStatus != nil && !machineMoveFinished(moveStatus) {
+		return nil, status.Errorf(codes.FailedPrecondition, "machine %q is already being moved", req.GetMachineId())
+	}
+
+	moveRequest := omnires.NewMachineMoveRequest(resources.DefaultNamespace, req.GetMachineId())
+
+	updateMoveRequest := func(res *omnires.MachineMoveRequest) error {
+		res.TypedSpec().Value.TargetCluster = req.GetTargetCluster()
+		res.TypedSpec().Value.TargetMachineSet = targetMachineSetID
+
+		return nil
+	}
+
+	if _, err = safe.StateUpdateWithConflicts(ctx, s.omniState, moveRequest.Metadata(), updateMoveRequest); err != nil {
+		if !state.IsNotFoundError(err) {
+			return nil, fmt.Errorf("failed to update machine move request: %w", err)
+		}
+
+		if err = updateMoveRequest(moveRequest); err != nil {
+			return nil, err
+		}
+
+		if err = s.omniState.Create(ctx, moveRequest); err != nil {
+			return nil, fmt.Errorf("failed to create machine move request: %w", err)
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func validateMoveTargetMachineSet(machineSet *omnires.MachineSet, cluster string) error {
+	if machineSetCluster, _ := machineSet.Metadata().Labels().Get(omnires.LabelCluster); machineSetCluster != cluster {
+		return status.Errorf(codes.InvalidArgument, "machine set %q doesn't belong to the cluster %q", machineSet.Metadata().ID(), cluster)
+	}
+
+	if _, ok := machineSet.Metadata().Labels().Get(omnires.LabelWorkerRole); !ok {
+		return status.Errorf(codes.InvalidArgument, "machine set %q is not a worker machine set", machineSet.Metadata().ID())
+	}
+
+	if machineSet.TypedSpec().Value.MachineClass != nil {
+		return status.Errorf(codes.InvalidArgument, "machine set %q uses a machine class, machines can't be added to it manually", machineSet.Metadata().ID())
+	}
+
+	if machineSet.Metadata().Phase() == resource.PhaseTearingDown {
+		return status.Errorf(codes.FailedPrecondition, "machine set %q is being destroyed", machineSet.Metadata().ID())
+	}
+
+	return nil
+}
+
+func machineMoveFinished(moveStatus *omnires.MachineMoveStatus) bool {
+	switch moveStatus.TypedSpec().Value.Phase {
+	case specs.MachineMoveStatusSpec_Done, specs.MachineMoveStatusSpec_Failed:
+		return true
+	case specs.MachineMoveStatusSpec_Pending,
+		specs.MachineMoveStatusSpec_Removing,
+		specs.MachineMoveStatusSpec_Resetting,
+		specs.MachineMoveStatusSpec_Joining:
+	}
+
+	return false
+}
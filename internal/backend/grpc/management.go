@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/netip"
 	"net/url"
 	"strconv"
 	"strings"
@@ -50,6 +51,7 @@ import (
 	"github.com/siderolabs/omni/internal/backend/dns"
 	"github.com/siderolabs/omni/internal/backend/grpc/router"
 	"github.com/siderolabs/omni/internal/backend/imagefactory"
+	"github.com/siderolabs/omni/internal/backend/payloadsampler"
 	"github.com/siderolabs/omni/internal/backend/runtime"
 	"github.com/siderolabs/omni/internal/backend/runtime/kubernetes"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni"
@@ -58,6 +60,7 @@ import (
 	"github.com/siderolabs/omni/internal/pkg/auth"
 	"github.com/siderolabs/omni/internal/pkg/auth/accesspolicy"
 	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/breakglass"
 	"github.com/siderolabs/omni/internal/pkg/auth/role"
 	"github.com/siderolabs/omni/internal/pkg/config"
 	"github.com/siderolabs/omni/internal/pkg/siderolink"
@@ -73,12 +76,19 @@ type managementServer struct {
 	management.UnimplementedManagementServiceServer
 
 	omniState             state.State
+	dryRunState           state.State
 	jwtSigningKeyProvider JWTSigningKeyProvider
 
+	// breakGlassVerifier is nil if the break-glass tokens are disabled.
+	breakGlassVerifier *breakglass.Verifier
+	// breakGlassProxies are the reverse proxies trusted to set the client address of the break-glass token requests.
+	breakGlassProxies []netip.Prefix
+
 	logHandler         *siderolink.LogHandler
 	logger             *zap.Logger
 	dnsService         *dns.Service
 	imageFactoryClient *imagefactory.Client
+	payloadSampler     *payloadsampler.Sampler
 	omniconfigDest     string
 }
 
@@ -212,7 +222,8 @@ func (s *managementServer) Omniconfig(ctx context.Context, _ *emptypb.Empty) (*m
 
 func (s *managementServer) MachineLogs(request *management.MachineLogsRequest, response management.ManagementService_MachineLogsServer) error {
 	// getting machine logs is equivalent to reading machine resource
-	if _, err := auth.CheckGRPC(response.Context(), auth.WithRole(role.Reader)); err != nil {
+	authResult, err := auth.CheckGRPC(response.Context(), auth.WithRole(role.Reader))
+	if err != nil {
 		return err
 	}
 
@@ -221,16 +232,36 @@ func (s *managementServer) MachineLogs(request *management.MachineLogsRequest, r
 		return status.Error(codes.InvalidArgument, "machine id is required")
 	}
 
+	if err = s.checkMachineClusterScope(response.Context(), authResult, machineID); err != nil {
+		return err
+	}
+
 	tailLines := optional.None[int32]()
 	if request.TailLines >= 0 {
 		tailLines = optional.Some(request.TailLines)
 	}
 
+	var since, until time.Time
+
+	if request.Since != nil {
+		since = request.Since.AsTime()
+	}
+
+	if request.Until != nil {
+		until = request.Until.AsTime()
+	}
+
+	if !since.IsZero() && !until.IsZero() && until.Before(since) {
+		return status.Error(codes.InvalidArgument, "until must not be before since")
+	}
+
 	logReader, err := s.logHandler.GetReader(siderolink.MachineID(machineID), request.Follow, tailLines)
 	if err != nil {
 		return handleError(err)
 	}
 
+	logReader.SetTimeRange(since, until)
+
 	once := sync.Once{}
 	cancel := func() {
 		once.Do(func() {
@@ -260,6 +291,26 @@ func (s *managementServer) MachineLogs(request *management.MachineLogsRequest, r
 	}
 }
 
+// checkMachineClusterScope denies the access to the machines which are not a part of the cluster the identity is restricted to.
+func (s *managementServer) checkMachineClusterScope(ctx context.Context, authResult auth.CheckResult, machineID resource.ID) error {
+	if authResult.Cluster == "" {
+		return nil
+	}
+
+	clusterMachine, err := safe.StateGetByID[*omnires.ClusterMachine](actor.MarkContextAsInternalActor(ctx), s.omniState, machineID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return err
+	}
+
+	if clusterMachine != nil {
+		if cluster, ok := clusterMachine.Metadata().Labels().Get(omnires.LabelCluster); ok && cluster == authResult.Cluster {
+			return nil
+		}
+	}
+
+	return status.Errorf(codes.PermissionDenied, "the identity is restricted to the cluster %q", authResult.Cluster)
+}
+
 func (s *managementServer) ValidateConfig(ctx context.Context, request *management.ValidateConfigRequest) (*emptypb.Empty, error) {
 	// validating machine config is low risk, require any valid signature
 	if _, err := auth.CheckGRPC(ctx, auth.WithValidSignature(true)); err != nil {
@@ -455,6 +506,11 @@ func (s *managementServer) CreateServiceAccount(ctx context.Context, req *manage
 		publicKeyResource.TypedSpec().Value.Role = req.GetRole()
 	}
 
+	// the cluster-scoped service accounts can't manage the instance-wide resources, so the admin role makes no sense for them
+	if req.GetCluster() != "" && publicKeyResource.TypedSpec().Value.GetRole() == string(role.Admin) {
+		return nil, status.Errorf(codes.InvalidArgument, "service account restricted to the cluster %q can not have the role %q", req.GetCluster(), role.Admin)
+	}
+
 	err = s.omniState.Create(ctx, publicKeyResource)
 	if err != nil {
 		return nil, err
@@ -464,6 +520,10 @@ func (s *managementServer) CreateServiceAccount(ctx context.Context, req *manage
 	user := authres.NewUser(resources.DefaultNamespace, newUserID)
 	user.TypedSpec().Value.Role = publicKeyResource.TypedSpec().Value.GetRole()
 
+	if req.GetCluster() != "" {
+		user.Metadata().Labels().Set(authres.LabelServiceAccountCluster, req.GetCluster())
+	}
+
 	err = s.omniState.Create(ctx, user)
 	if err != nil {
 		return nil, err
@@ -475,6 +535,10 @@ func (s *managementServer) CreateServiceAccount(ctx context.Context, req *manage
 	identity.Metadata().Labels().Set(authres.LabelIdentityUserID, newUserID)
 	identity.Metadata().Labels().Set(authres.LabelIdentityTypeServiceAccount, "")
 
+	if req.GetCluster() != "" {
+		identity.Metadata().Labels().Set(authres.LabelServiceAccountCluster, req.GetCluster())
+	}
+
 	err = s.omniState.Create(ctx, identity)
 	if err != nil {
 		return nil, err
@@ -582,11 +646,13 @@ func (s *managementServer) ListServiceAccounts(ctx context.Context, _ *emptypb.E
 		}
 
 		name := strings.TrimSuffix(identity.Metadata().ID(), pkgaccess.ServiceAccountNameSuffix)
+		cluster, _ := user.Metadata().Labels().Get(authres.LabelServiceAccountCluster)
 
 		serviceAccounts = append(serviceAccounts, &management.ListServiceAccountsResponse_ServiceAccount{
 			Name:          name,
 			PgpPublicKeys: publicKeys,
 			Role:          user.TypedSpec().Value.GetRole(),
+			Cluster:       cluster,
 		})
 	}
 
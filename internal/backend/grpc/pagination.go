@@ -0,0 +1,98 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/pkg/constants"
+)
+
+// paginatedState wraps the state served over the COSI state API to support the paginated List calls.
+//
+// The page is selected by the limit and the continue token passed in the gRPC metadata, see [constants.ListLimitHeader].
+// The List calls without the limit return all resources as before.
+type paginatedState struct {
+	state.CoreState
+}
+
+func newPaginatedState(st state.CoreState) *paginatedState {
+	return &paginatedState{
+		CoreState: st,
+	}
+}
+
+// List implements state.CoreState.
+func (st *paginatedState) List(ctx context.Context, kind resource.Kind, opts ...state.ListOption) (resource.List, error) {
+	limit, continueID, err := listPage(ctx)
+	if err != nil {
+		return resource.List{}, err
+	}
+
+	list, err := st.CoreState.List(ctx, kind, opts...)
+	if err != nil || limit == 0 {
+		return list, err
+	}
+
+	return paginate(list, limit, continueID), nil
+}
+
+func listPage(ctx context.Context) (limit int, continueID resource.ID, err error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	if values := md.Get(constants.ListLimitHeader); len(values) > 0 {
+		limit, err = strconv.Atoi(values[0])
+		if err != nil || limit < 0 {
+			return 0, "", status.Errorf(codes.InvalidArgument, "invalid list limit %q", values[0])
+		}
+	}
+
+	if values := md.Get(constants.ListContinueHeader); len(values) > 0 {
+		continueID = values[0]
+	}
+
+	return limit, continueID, nil
+}
+
+// paginate returns at most limit resources with the IDs after the continueID.
+func paginate(list resource.List, limit int, continueID resource.ID) resource.List {
+	items := slices.Clone(list.Items)
+
+	slices.SortFunc(items, func(a, b resource.Resource) int {
+		return strings.Compare(a.Metadata().ID(), b.Metadata().ID())
+	})
+
+	if continueID != "" {
+		start, _ := slices.BinarySearchFunc(items, continueID, func(r resource.Resource, id resource.ID) int {
+			cmp := strings.Compare(r.Metadata().ID(), id)
+			if cmp == 0 {
+				// the continue ID itself belongs to the previous page
+				return -1
+			}
+
+			return cmp
+		})
+
+		items = items[start:]
+	}
+
+	if len(items) > limit {
+		items = items[:limit]
+	}
+
+	return resource.List{
+		Items: items,
+	}
+}
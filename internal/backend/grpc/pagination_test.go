@@ -0,0 +1,71 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/pkg/constants"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	grpcomni "github.com/siderolabs/omni/internal/backend/grpc"
+)
+
+func TestPaginatedState(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	innerSt := state.WrapCore(namespaced.NewState(inmem.Build))
+	st := grpcomni.NewPaginatedState(innerSt)
+
+	for i := range 10 {
+		require.NoError(t, innerSt.Create(ctx, omni.NewMachineStatus(resources.DefaultNamespace, fmt.Sprintf("machine-%02d", i))))
+	}
+
+	kind := omni.NewMachineStatus(resources.DefaultNamespace, "").Metadata()
+
+	list := func(headers ...string) []resource.ID {
+		items, err := st.List(metadata.NewIncomingContext(ctx, metadata.Pairs(headers...)), kind)
+		require.NoError(t, err)
+
+		ids := make([]resource.ID, 0, len(items.Items))
+
+		for _, item := range items.Items {
+			ids = append(ids, item.Metadata().ID())
+		}
+
+		return ids
+	}
+
+	assert.Len(t, list(), 10)
+
+	assert.Equal(t, []resource.ID{"machine-00", "machine-01", "machine-02", "machine-03"}, list(constants.ListLimitHeader, "4"))
+	assert.Equal(t, []resource.ID{"machine-04", "machine-05", "machine-06", "machine-07"},
+		list(constants.ListLimitHeader, "4", constants.ListContinueHeader, "machine-03"))
+	assert.Equal(t, []resource.ID{"machine-08", "machine-09"}, list(constants.ListLimitHeader, "4", constants.ListContinueHeader, "machine-07"))
+	assert.Empty(t, list(constants.ListLimitHeader, "4", constants.ListContinueHeader, "machine-09"))
+
+	// the continue token doesn't have to be an existing ID
+	assert.Equal(t, []resource.ID{"machine-05", "machine-06"}, list(constants.ListLimitHeader, "2", constants.ListContinueHeader, "machine-04a"))
+
+	_, err := st.List(metadata.NewIncomingContext(ctx, metadata.Pairs(constants.ListLimitHeader, "-1")), kind)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
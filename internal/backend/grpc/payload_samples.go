@@ -0,0 +1,34 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+// GetPayloadSamples implements ManagementServer.
+//
+// The samples might contain the private data of the other users, so they are available only to the admins.
+func (s *managementServer) GetPayloadSamples(ctx context.Context, req *management.GetPayloadSamplesRequest) (*management.GetPayloadSamplesResponse, error) {
+	if _, err := s.authCheckGRPC(ctx, auth.WithRole(role.Admin)); err != nil {
+		return nil, err
+	}
+
+	if s.payloadSampler == nil || !s.payloadSampler.Enabled() {
+		return nil, status.Error(codes.FailedPrecondition, "payload sampling is disabled")
+	}
+
+	return &management.GetPayloadSamplesResponse{
+		Samples: s.payloadSampler.Samples(req.GetMethod()),
+	}, nil
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/siderolabs/omni/client/pkg/panichandler"
 	"github.com/siderolabs/omni/internal/backend/grpc/router"
 	"github.com/siderolabs/omni/internal/backend/runtime"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni"
 )
 
 // ResourceServer implements resources CRUD API.
@@ -267,6 +268,8 @@ func (s *ResourceServer) Delete(ctx context.Context, in *resources.DeleteRequest
 		return nil, err
 	}
 
+	ctx = omni.WithDestroyReason(ctx, in.Reason)
+
 	opts := withContext(router.ExtractContext(ctx))
 
 	opts = append(opts,
@@ -289,6 +292,8 @@ func (s *ResourceServer) Teardown(ctx context.Context, in *resources.DeleteReque
 		return nil, err
 	}
 
+	ctx = omni.WithDestroyReason(ctx, in.Reason)
+
 	opts := withContext(router.ExtractContext(ctx))
 
 	opts = append(opts,
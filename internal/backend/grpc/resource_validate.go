@@ -0,0 +1,200 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/maps"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+)
+
+// ValidateResource implements ManagementServer.
+//
+// It runs the access checks and the validations of creating or updating the resource and returns the changed fields,
+// nothing is persisted.
+func (s *managementServer) ValidateResource(ctx context.Context, req *management.ValidateResourceRequest) (*management.ValidateResourceResponse, error) {
+	if _, err := s.authCheckGRPC(ctx, auth.WithValidSignature(true)); err != nil {
+		return nil, err
+	}
+
+	var yamlResource protobuf.YAMLResource
+
+	if err := yaml.Unmarshal([]byte(req.GetResource()), &yamlResource); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to decode the resource: %s", err)
+	}
+
+	res := yamlResource.Resource()
+
+	existing, err := s.dryRunState.Get(ctx, res.Metadata())
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	if existing == nil {
+		err = s.dryRunState.Create(ctx, res)
+	} else {
+		res.Metadata().SetVersion(existing.Metadata().Version())
+
+		err = s.dryRunState.Update(ctx, res)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := resourceChanges(existing, res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &management.ValidateResourceResponse{
+		Exists:  existing != nil,
+		Changes: changes,
+	}, nil
+}
+
+// resourceChanges returns the changed fields of the labels, the annotations and the spec, the existing resource is nil if it is created.
+func resourceChanges(existing, res resource.Resource) ([]*management.ValidateResourceResponse_FieldChange, error) {
+	var (
+		oldFields map[string]any
+		err       error
+	)
+
+	if existing != nil {
+		if oldFields, err = resourceFields(existing); err != nil {
+			return nil, err
+		}
+	}
+
+	newFields, err := resourceFields(res)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []*management.ValidateResourceResponse_FieldChange
+
+	if err = diffFields("", oldFields, newFields, &changes); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+func resourceFields(res resource.Resource) (map[string]any, error) {
+	specYAML, err := yaml.Marshal(res.Spec())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the spec: %w", err)
+	}
+
+	var spec any
+
+	if err = yaml.Unmarshal(specYAML, &spec); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the spec: %w", err)
+	}
+
+	fields := map[string]any{
+		"spec": spec,
+	}
+
+	if labels := res.Metadata().Labels().Raw(); len(labels) > 0 {
+		fields["metadata.labels"] = toAnyMap(labels)
+	}
+
+	if annotations := res.Metadata().Annotations().Raw(); len(annotations) > 0 {
+		fields["metadata.annotations"] = toAnyMap(annotations)
+	}
+
+	return fields, nil
+}
+
+func toAnyMap(m map[string]string) map[string]any {
+	res := make(map[string]any, len(m))
+
+	for k, v := range m {
+		res[k] = v
+	}
+
+	return res
+}
+
+// diffFields walks the maps recursively and records the changed values, the other values (e.g. lists) are compared as a whole.
+func diffFields(path string, oldValue, newValue any, changes *[]*management.ValidateResourceResponse_FieldChange) error {
+	oldMap, oldIsMap := oldValue.(map[string]any)
+	newMap, newIsMap := newValue.(map[string]any)
+
+	if (oldIsMap || oldValue == nil) && (newIsMap || newValue == nil) && (oldIsMap || newIsMap) {
+		keys := maps.Keys(oldMap)
+
+		for key := range newMap {
+			if _, ok := oldMap[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+
+		slices.Sort(keys)
+
+		for _, key := range keys {
+			keyPath := key
+			if path != "" {
+				keyPath = path + "." + key
+			}
+
+			if err := diffFields(keyPath, oldMap[key], newMap[key], changes); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if reflect.DeepEqual(oldValue, newValue) {
+		return nil
+	}
+
+	oldYAML, err := fieldYAML(oldValue)
+	if err != nil {
+		return err
+	}
+
+	newYAML, err := fieldYAML(newValue)
+	if err != nil {
+		return err
+	}
+
+	*changes = append(*changes, &management.ValidateResourceResponse_FieldChange{
+		Path: path,
+		Old:  oldYAML,
+		New:  newYAML,
+	})
+
+	return nil
+}
+
+func fieldYAML(value any) (string, error) {
+	if value == nil {
+		return "", nil
+	}
+
+	out, err := yaml.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal the field: %w", err)
+	}
+
+	return strings.TrimSuffix(string(out), "\n"), nil
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	grpcomni "github.com/siderolabs/omni/internal/backend/grpc"
+)
+
+func TestResourceChanges(t *testing.T) {
+	t.Parallel()
+
+	existing := omni.NewCluster(resources.DefaultNamespace, "test")
+	existing.Metadata().Labels().Set("env", "staging")
+	existing.TypedSpec().Value.KubernetesVersion = "1.30.0"
+	existing.TypedSpec().Value.TalosVersion = "1.7.0"
+
+	updated := existing.DeepCopy().(*omni.Cluster) //nolint:forcetypeassert,errcheck
+	updated.Metadata().Labels().Set("env", "production")
+	updated.Metadata().Annotations().Set("note", "upgrade")
+	updated.TypedSpec().Value.KubernetesVersion = "1.30.1"
+
+	changes, err := grpcomni.ResourceChanges(existing, updated)
+	require.NoError(t, err)
+
+	assert.Equal(t, []*management.ValidateResourceResponse_FieldChange{
+		{Path: "metadata.annotations.note", New: "upgrade"},
+		{Path: "metadata.labels.env", Old: "staging", New: "production"},
+		{Path: "spec.kubernetesversion", Old: "1.30.0", New: "1.30.1"},
+	}, changes)
+
+	changes, err = grpcomni.ResourceChanges(existing, existing)
+	require.NoError(t, err)
+
+	assert.Empty(t, changes)
+
+	// all fields are added when the resource is created
+	changes, err = grpcomni.ResourceChanges(nil, existing)
+	require.NoError(t, err)
+
+	assert.Contains(t, changes, &management.ValidateResourceResponse_FieldChange{Path: "spec.talosversion", New: "1.7.0"})
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+// ListUserSessions implements ManagementServer.
+//
+// Each registered public key is a session of the user identity.
+func (s *managementServer) ListUserSessions(ctx context.Context, req *management.ListUserSessionsRequest) (*management.ListUserSessionsResponse, error) {
+	if _, err := s.authCheckGRPC(ctx, auth.WithRole(role.Admin)); err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	publicKeys, err := s.listUserPublicKeys(ctx, req.GetIdentity())
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]*management.ListUserSessionsResponse_Session, 0, len(publicKeys))
+
+	for _, key := range publicKeys {
+		spec := key.TypedSpec().Value
+
+		sessions = append(sessions, &management.ListUserSessionsResponse_Session{
+			PublicKeyId: key.Metadata().ID(),
+			Identity:    spec.GetIdentity().GetEmail(),
+			Role:        spec.GetRole(),
+			Created:     timestamppb.New(key.Metadata().Created()),
+			Expiration:  spec.GetExpiration(),
+			LastUsed:    spec.GetLastUsed(),
+			ClientInfo:  spec.GetClientInfo(),
+			Confirmed:   spec.GetConfirmed(),
+		})
+	}
+
+	return &management.ListUserSessionsResponse{
+		Sessions: sessions,
+	}, nil
+}
+
+// RevokeUserSession implements ManagementServer.
+//
+// It destroys either a single public key or all public keys of the user identity.
+func (s *managementServer) RevokeUserSession(ctx context.Context, req *management.RevokeUserSessionRequest) (*management.RevokeUserSessionResponse, error) {
+	if _, err := s.authCheckGRPC(ctx, auth.WithRole(role.Admin)); err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	var publicKeys []*authres.PublicKey
+
+	switch {
+	case req.GetPublicKeyId() != "" && req.GetIdentity() != "":
+		return nil, status.Error(codes.InvalidArgument, "public key ID and identity are mutually exclusive")
+	case req.GetPublicKeyId() != "":
+		key, err := safe.StateGet[*authres.PublicKey](ctx, s.omniState, authres.NewPublicKey(resources.DefaultNamespace, req.GetPublicKeyId()).Metadata())
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "session %q not found", req.GetPublicKeyId())
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		publicKeys = append(publicKeys, key)
+	case req.GetIdentity() != "":
+		var err error
+
+		publicKeys, err = s.listUserPublicKeys(ctx, req.GetIdentity())
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "either public key ID or identity must be set")
+	}
+
+	revoked := make([]string, 0, len(publicKeys))
+
+	for _, key := range publicKeys {
+		if err := s.omniState.Destroy(ctx, key.Metadata(), state.WithDestroyOwner(key.Metadata().Owner())); err != nil && !state.IsNotFoundError(err) {
+			return nil, err
+		}
+
+		s.logger.Info("revoked user session",
+			zap.String("fingerprint", key.Metadata().ID()),
+			zap.String("email", key.TypedSpec().Value.GetIdentity().GetEmail()),
+		)
+
+		revoked = append(revoked, key.Metadata().ID())
+	}
+
+	return &management.RevokeUserSessionResponse{
+		RevokedPublicKeyIds: revoked,
+	}, nil
+}
+
+func (s *managementServer) listUserPublicKeys(ctx context.Context, identity string) ([]*authres.PublicKey, error) {
+	var opts []state.ListOption
+
+	if identity != "" {
+		identityRes, err := safe.StateGet[*authres.Identity](ctx, s.omniState, authres.NewIdentity(resources.DefaultNamespace, strings.ToLower(identity)).Metadata())
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "identity %q not found", identity)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		opts = append(opts, state.WithLabelQuery(resource.LabelEqual(authres.LabelPublicKeyUserID, identityRes.TypedSpec().Value.GetUserId())))
+	}
+
+	list, err := safe.StateListAll[*authres.PublicKey](ctx, s.omniState, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKeys := make([]*authres.PublicKey, 0, list.Len())
+
+	for iter := list.Iterator(); iter.Next(); {
+		key := iter.Value()
+
+		// the user might have multiple identities, so filter out the keys registered for other identities
+		if identity != "" && !strings.EqualFold(key.TypedSpec().Value.GetIdentity().GetEmail(), identity) {
+			continue
+		}
+
+		publicKeys = append(publicKeys, key)
+	}
+
+	return publicKeys, nil
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	grpcomni "github.com/siderolabs/omni/internal/backend/grpc"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+const keyOwner = "KeyPrunerController"
+
+func setupSessions(ctx context.Context, t *testing.T) state.State {
+	t.Helper()
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	for email, userID := range map[string]string{
+		"alice@example.org": "alice",
+		"bob@example.org":   "bob",
+	} {
+		identity := authres.NewIdentity(resources.DefaultNamespace, email)
+		identity.TypedSpec().Value.UserId = userID
+
+		require.NoError(t, st.Create(ctx, identity))
+	}
+
+	for id, user := range map[string]struct{ email, id string }{
+		"alice-laptop":  {email: "alice@example.org", id: "alice"},
+		"alice-desktop": {email: "alice@example.org", id: "alice"},
+		"bob-laptop":    {email: "bob@example.org", id: "bob"},
+	} {
+		key := authres.NewPublicKey(resources.DefaultNamespace, id)
+		key.Metadata().Labels().Set(authres.LabelPublicKeyUserID, user.id)
+		key.TypedSpec().Value.Identity = &specs.Identity{Email: user.email}
+		key.TypedSpec().Value.Role = string(role.Operator)
+		key.TypedSpec().Value.Confirmed = true
+		key.TypedSpec().Value.Expiration = timestamppb.New(time.Now().Add(time.Hour))
+		key.TypedSpec().Value.ClientInfo = "omnictl"
+
+		require.NoError(t, st.Create(ctx, key, state.WithCreateOwner(keyOwner)))
+	}
+
+	return st
+}
+
+func sessionIDs(sessions []*management.ListUserSessionsResponse_Session) []string {
+	ids := make([]string, 0, len(sessions))
+
+	for _, session := range sessions {
+		ids = append(ids, session.PublicKeyId)
+	}
+
+	return ids
+}
+
+func TestListUserSessions(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	ctx = context.WithValue(ctx, auth.EnabledAuthContextKey{}, true)
+	adminCtx := context.WithValue(ctx, auth.RoleContextKey{}, role.Admin)
+
+	srv := grpcomni.NewManagementServer(setupSessions(ctx, t), nil, zaptest.NewLogger(t))
+
+	resp, err := srv.ListUserSessions(adminCtx, &management.ListUserSessionsRequest{})
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{"alice-laptop", "alice-desktop", "bob-laptop"}, sessionIDs(resp.Sessions))
+
+	resp, err = srv.ListUserSessions(adminCtx, &management.ListUserSessionsRequest{Identity: "Alice@example.org"})
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{"alice-laptop", "alice-desktop"}, sessionIDs(resp.Sessions))
+
+	for _, session := range resp.Sessions {
+		assert.Equal(t, "alice@example.org", session.Identity)
+		assert.Equal(t, string(role.Operator), session.Role)
+		assert.Equal(t, "omnictl", session.ClientInfo)
+		assert.True(t, session.Confirmed)
+	}
+
+	_, err = srv.ListUserSessions(adminCtx, &management.ListUserSessionsRequest{Identity: "carol@example.org"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestRevokeUserSession(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	ctx = context.WithValue(ctx, auth.EnabledAuthContextKey{}, true)
+	adminCtx := context.WithValue(ctx, auth.RoleContextKey{}, role.Admin)
+
+	st := setupSessions(ctx, t)
+	srv := grpcomni.NewManagementServer(st, nil, zaptest.NewLogger(t))
+
+	assertKeys := func(expected ...string) {
+		t.Helper()
+
+		keys, err := safe.StateListAll[*authres.PublicKey](ctx, st)
+		require.NoError(t, err)
+
+		var ids []string
+
+		for iter := keys.Iterator(); iter.Next(); {
+			ids = append(ids, iter.Value().Metadata().ID())
+		}
+
+		assert.ElementsMatch(t, expected, ids)
+	}
+
+	// by the public key ID
+	resp, err := srv.RevokeUserSession(adminCtx, &management.RevokeUserSessionRequest{PublicKeyId: "alice-laptop"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"alice-laptop"}, resp.RevokedPublicKeyIds)
+	assertKeys("alice-desktop", "bob-laptop")
+
+	_, err = srv.RevokeUserSession(adminCtx, &management.RevokeUserSessionRequest{PublicKeyId: "alice-laptop"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// by the identity
+	resp, err = srv.RevokeUserSession(adminCtx, &management.RevokeUserSessionRequest{Identity: "bob@example.org"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"bob-laptop"}, resp.RevokedPublicKeyIds)
+	assertKeys("alice-desktop")
+
+	for _, req := range []*management.RevokeUserSessionRequest{
+		{},
+		{PublicKeyId: "alice-desktop", Identity: "alice@example.org"},
+	} {
+		_, err = srv.RevokeUserSession(adminCtx, req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+
+	assertKeys("alice-desktop")
+}
+
+func TestUserSessionsNonAdmin(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	ctx = context.WithValue(ctx, auth.EnabledAuthContextKey{}, true)
+
+	st := setupSessions(ctx, t)
+	srv := grpcomni.NewManagementServer(st, nil, zaptest.NewLogger(t))
+
+	for _, r := range []role.Role{role.Reader, role.Operator} {
+		roleCtx := context.WithValue(ctx, auth.RoleContextKey{}, r)
+
+		_, err := srv.ListUserSessions(roleCtx, &management.ListUserSessionsRequest{})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err), r)
+
+		_, err = srv.RevokeUserSession(roleCtx, &management.RevokeUserSessionRequest{Identity: "alice@example.org"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err), r)
+	}
+
+	keys, err := safe.StateListAll[*authres.PublicKey](ctx, st)
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, keys.Len())
+}
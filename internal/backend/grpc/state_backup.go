@@ -0,0 +1,167 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"bufio"
+	"errors"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/internal/backend/statebackup"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+	"github.com/siderolabs/omni/internal/version"
+)
+
+// backupChunkSize is the size of the chunks the backup archive is streamed in.
+const backupChunkSize = 1024 * 1024
+
+// BackupState implements ManagementServer.
+//
+// It streams the archive of the user-owned resources of the Omni state, see [statebackup.Backup].
+func (s *managementServer) BackupState(_ *management.BackupStateRequest, srv management.ManagementService_BackupStateServer) error {
+	if _, err := s.authCheckGRPC(srv.Context(), auth.WithRole(role.Admin)); err != nil {
+		return err
+	}
+
+	ctx := actor.MarkContextAsInternalActor(srv.Context())
+
+	w := bufio.NewWriterSize(backupStreamWriter{srv: srv}, backupChunkSize)
+
+	if _, err := statebackup.Backup(ctx, s.omniState, w, version.Tag); err != nil {
+		return err
+	}
+
+	return w.Flush()
+}
+
+type backupStreamWriter struct {
+	srv management.ManagementService_BackupStateServer
+}
+
+func (w backupStreamWriter) Write(p []byte) (int, error) {
+	if err := w.srv.Send(&management.BackupStateResponse{Data: p}); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// RestoreState implements ManagementServer.
+//
+// It receives the backup archive in chunks, restores its resources and replies with the outcome for each of them, see [statebackup.Restore].
+// The restore options are read from the first message of the stream.
+func (s *managementServer) RestoreState(srv management.ManagementService_RestoreStateServer) error {
+	if _, err := s.authCheckGRPC(srv.Context(), auth.WithRole(role.Admin)); err != nil {
+		return err
+	}
+
+	req, err := srv.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return status.Error(codes.InvalidArgument, "the backup archive is empty")
+		}
+
+		return err
+	}
+
+	opts := statebackup.RestoreOptions{
+		DryRun: req.GetDryRun(),
+	}
+
+	switch req.GetConflictResolution() {
+	case management.RestoreStateRequest_SKIP:
+		opts.ConflictResolution = statebackup.ConflictSkip
+	case management.RestoreStateRequest_OVERWRITE:
+		opts.ConflictResolution = statebackup.ConflictOverwrite
+	case management.RestoreStateRequest_FAIL:
+		opts.ConflictResolution = statebackup.ConflictFail
+	default:
+		return status.Errorf(codes.InvalidArgument, "unknown conflict resolution %q", req.GetConflictResolution())
+	}
+
+	r := &restoreStreamReader{srv: srv, buf: req.GetData()}
+
+	archive, err := statebackup.Read(r)
+	if err != nil {
+		if r.err != nil {
+			return r.err
+		}
+
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := actor.MarkContextAsInternalActor(srv.Context())
+
+	var resp management.RestoreStateResponse
+
+	err = statebackup.Restore(ctx, s.omniState, archive, opts, func(result statebackup.Result) error {
+		res := &management.RestoreStateResponse_Result{
+			Type: result.Type,
+			Id:   result.ID,
+		}
+
+		switch result.Action {
+		case statebackup.ActionCreated:
+			res.Action = management.RestoreStateResponse_CREATED
+		case statebackup.ActionUpdated:
+			res.Action = management.RestoreStateResponse_UPDATED
+		case statebackup.ActionSkipped:
+			res.Action = management.RestoreStateResponse_SKIPPED
+		case statebackup.ActionFailed:
+			res.Action = management.RestoreStateResponse_FAILED
+		}
+
+		if result.Err != nil {
+			res.Error = result.Err.Error()
+		}
+
+		resp.Results = append(resp.Results, res)
+
+		return nil
+	})
+	if errors.Is(err, statebackup.ErrConflict) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return srv.SendAndClose(&resp)
+}
+
+// restoreStreamReader reads the backup archive from the chunks of the RestoreState stream.
+type restoreStreamReader struct {
+	srv management.ManagementService_RestoreStateServer
+	err error
+	buf []byte
+}
+
+func (r *restoreStreamReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		req, err := r.srv.Recv()
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				r.err = err
+			}
+
+			return 0, err
+		}
+
+		r.buf = req.GetData()
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+
+	return n, nil
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	grpcomni "github.com/siderolabs/omni/internal/backend/grpc"
+	"github.com/siderolabs/omni/internal/backend/statebackup"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+)
+
+type restoreStateServer struct {
+	grpc.ServerStream
+
+	ctx      context.Context //nolint:containedctx
+	resp     *management.RestoreStateResponse
+	requests []*management.RestoreStateRequest
+}
+
+func (s *restoreStateServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *restoreStateServer) Recv() (*management.RestoreStateRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+
+	return req, nil
+}
+
+func (s *restoreStateServer) SendAndClose(resp *management.RestoreStateResponse) error {
+	s.resp = resp
+
+	return nil
+}
+
+func TestRestoreState(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	ctx = context.WithValue(ctx, auth.EnabledAuthContextKey{}, false)
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	require.NoError(t, st.Create(ctx, omni.NewCluster(resources.DefaultNamespace, "talos-default")))
+	require.NoError(t, st.Create(ctx, omni.NewConfigPatch(resources.DefaultNamespace, "500-user-patch")))
+
+	var archive bytes.Buffer
+
+	_, err := statebackup.Backup(ctx, st, &archive, "v0.0.0")
+	require.NoError(t, err)
+
+	// the archive is split into small chunks, the options are sent only with the first one
+	data := archive.Bytes()
+	requests := []*management.RestoreStateRequest{
+		{Data: data[:10], ConflictResolution: management.RestoreStateRequest_FAIL},
+		{},
+	}
+
+	for chunk := data[10:]; len(chunk) > 0; {
+		n := min(len(chunk), 7)
+
+		requests = append(requests, &management.RestoreStateRequest{Data: chunk[:n]})
+		chunk = chunk[n:]
+	}
+
+	restored := state.WrapCore(namespaced.NewState(inmem.Build))
+	srv := grpcomni.NewManagementServer(restored, nil, zaptest.NewLogger(t))
+
+	stream := &restoreStateServer{ctx: ctx, requests: requests}
+
+	require.NoError(t, srv.RestoreState(stream))
+
+	assert.ElementsMatch(t, []*management.RestoreStateResponse_Result{
+		{Type: omni.ConfigPatchType, Id: "500-user-patch", Action: management.RestoreStateResponse_CREATED},
+		{Type: omni.ClusterType, Id: "talos-default", Action: management.RestoreStateResponse_CREATED},
+	}, stream.resp.Results)
+
+	_, err = safe.StateGetByID[*omni.Cluster](ctx, restored, "talos-default")
+	require.NoError(t, err)
+
+	// the resources exist now, so the restore fails on the conflicts
+	stream = &restoreStateServer{ctx: ctx, requests: []*management.RestoreStateRequest{
+		{Data: data, ConflictResolution: management.RestoreStateRequest_FAIL},
+	}}
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(srv.RestoreState(stream)))
+
+	for _, requests := range [][]*management.RestoreStateRequest{
+		nil,
+		{{Data: []byte("not an archive")}},
+		{{Data: data, ConflictResolution: 42}},
+	} {
+		stream = &restoreStateServer{ctx: ctx, requests: requests}
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(srv.RestoreState(stream)))
+	}
+}
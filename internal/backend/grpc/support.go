@@ -27,9 +27,11 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/cosi/snapshot"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/siderolink"
 	"github.com/siderolabs/omni/client/pkg/panichandler"
+	"github.com/siderolabs/omni/internal/backend/logging"
 	"github.com/siderolabs/omni/internal/backend/runtime"
 	kubernetesruntime "github.com/siderolabs/omni/internal/backend/runtime/kubernetes"
 	"github.com/siderolabs/omni/internal/pkg/auth"
@@ -73,6 +75,10 @@ func (s *managementServer) GetSupportBundle(req *management.GetSupportBundleRequ
 		cols = collectors.WithSource(cols, "omni")
 	}
 
+	if recentLogs := logging.DefaultRecentLogs(); recentLogs != nil {
+		cols = collectors.WithSource(append(cols, s.collectControllerLogs(recentLogs, req.Cluster)), "omni")
+	}
+
 	ctx := actor.MarkContextAsInternalActor(serv.Context())
 
 	talosClient, err := s.getTalosClient(ctx, req.Cluster)
@@ -218,6 +224,17 @@ func (s *managementServer) collectLogs(machineID string) *collectors.Collector {
 	})
 }
 
+// collectControllerLogs collects the recent controller log entries mentioning the cluster.
+func (s *managementServer) collectControllerLogs(recentLogs *logging.RecentLogs, cluster string) *collectors.Collector {
+	return collectors.NewCollector("omni/controller-logs.log", func(context.Context, *bundle.Options) ([]byte, error) {
+		clusterName := []byte(cluster)
+
+		return bytes.Join(recentLogs.Lines(func(line []byte) bool {
+			return bytes.Contains(line, clusterName)
+		}), nil), nil
+	})
+}
+
 //nolint:gocognit
 func (s *managementServer) collectClusterResources(ctx context.Context, cluster string) ([]resource.Resource, error) {
 	st := s.omniState
@@ -268,6 +285,18 @@ func (s *managementServer) collectClusterResources(ctx context.Context, cluster
 			rt: omni.ClusterBootstrapStatusType,
 			id: cluster,
 		},
+		{
+			rt: omni.EtcdBackupStatusType,
+			id: cluster,
+		},
+		{
+			rt: omni.EtcdManualBackupType,
+			id: cluster,
+		},
+		{
+			rt: omni.EtcdBackupOverallStatusType,
+			id: omni.EtcdBackupOverallStatusID,
+		},
 		{
 			rt:          omni.MachineSetType,
 			listOptions: clusterQuery,
@@ -310,7 +339,7 @@ func (s *managementServer) collectClusterResources(ctx context.Context, cluster
 		},
 	}
 
-	machineIDs := map[string]struct{}{}
+	queries := make([]snapshot.Query, 0, len(resourcesToGet))
 
 	for _, r := range resourcesToGet {
 		rd, err := safe.ReaderGetByID[*meta.ResourceDefinition](ctx, st, strings.ToLower(r.rt))
@@ -318,40 +347,33 @@ func (s *managementServer) collectClusterResources(ctx context.Context, cluster
 			return nil, err
 		}
 
-		md := resource.NewMetadata(rd.TypedSpec().DefaultNamespace, r.rt, r.id, resource.VersionUndefined)
-
-		if md.ID() == "" {
-			var list resource.List
+		queries = append(queries, snapshot.Query{
+			Kind:        resource.NewMetadata(rd.TypedSpec().DefaultNamespace, r.rt, r.id, resource.VersionUndefined),
+			ListOptions: r.listOptions,
+		})
+	}
 
-			list, err = st.List(ctx, md, r.listOptions...)
-			if err != nil {
-				return nil, err
-			}
+	// the cluster resources are read from the same point in time to show the consistent state of the cluster
+	snap, err := snapshot.Read(ctx, st, queries...)
+	if err != nil {
+		return nil, err
+	}
 
-			resources = append(resources, list.Items...)
+	machineIDs := map[string]struct{}{}
 
-			switch md.Type() {
-			case omni.ClusterMachineType:
-				fallthrough
-			case omni.MachineSetNodeType:
-				for _, res := range list.Items {
-					machineIDs[res.Metadata().ID()] = struct{}{}
-				}
-			}
+	for i, r := range resourcesToGet {
+		items := snap.Items(i)
 
-			continue
-		}
+		resources = append(resources, items...)
 
-		res, err := st.Get(ctx, md)
-		if err != nil {
-			if state.IsNotFoundError(err) {
-				continue
+		switch r.rt {
+		case omni.ClusterMachineType:
+			fallthrough
+		case omni.MachineSetNodeType:
+			for _, res := range items {
+				machineIDs[res.Metadata().ID()] = struct{}{}
 			}
-
-			return nil, err
 		}
-
-		resources = append(resources, res)
 	}
 
 	for id := range machineIDs {
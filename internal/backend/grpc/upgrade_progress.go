@@ -0,0 +1,362 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+// WatchUpgradeProgress implements ManagementServer.
+//
+// It watches the resources describing the Talos and Kubernetes upgrades of the cluster
+// and sends the upgrade progress each time it changes.
+func (s *managementServer) WatchUpgradeProgress(req *management.WatchUpgradeProgressRequest, srv management.ManagementService_WatchUpgradeProgressServer) error {
+	clusterID := req.GetCluster()
+	if clusterID == "" {
+		return status.Error(codes.InvalidArgument, "cluster is required")
+	}
+
+	ctx, err := s.applyClusterAccessPolicy(srv.Context(), clusterID)
+	if err != nil {
+		return err
+	}
+
+	if _, err = s.authCheckGRPC(ctx, auth.WithRole(role.Reader)); err != nil {
+		return err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	events := make(chan state.Event)
+
+	for _, resourceType := range []resource.Type{
+		omnires.ClusterType,
+		omnires.KubernetesStatusType,
+		omnires.KubernetesUpgradeStatusType,
+		omnires.TalosUpgradeStatusType,
+	} {
+		if err = s.omniState.Watch(ctx, resource.NewMetadata(resources.DefaultNamespace, resourceType, clusterID, resource.VersionUndefined), events); err != nil {
+			return err
+		}
+	}
+
+	for _, resourceType := range []resource.Type{
+		omnires.ClusterMachineType,
+		omnires.ClusterMachineConfigStatusType,
+		omnires.ClusterMachineIdentityType,
+		omnires.ClusterMachineStatusType,
+		omnires.ClusterMachineTalosVersionType,
+	} {
+		if err = s.omniState.WatchKind(ctx, resource.NewMetadata(resources.DefaultNamespace, resourceType, "", resource.VersionUndefined), events,
+			state.WatchWithLabelQuery(resource.LabelEqual(omnires.LabelCluster, clusterID)),
+		); err != nil {
+			return err
+		}
+	}
+
+	var lastProgress *management.WatchUpgradeProgressResponse
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event := <-events:
+			if event.Type == state.Errored {
+				return fmt.Errorf("watch failed: %w", event.Error)
+			}
+		}
+
+		// coalesce the events which came at the same time
+	drain:
+		for {
+			select {
+			case event := <-events:
+				if event.Type == state.Errored {
+					return fmt.Errorf("watch failed: %w", event.Error)
+				}
+			default:
+				break drain
+			}
+		}
+
+		var progress *management.WatchUpgradeProgressResponse
+
+		if progress, err = upgradeProgress(ctx, s.omniState, clusterID); err != nil {
+			return err
+		}
+
+		if proto.Equal(progress, lastProgress) {
+			continue
+		}
+
+		if err = srv.Send(progress); err != nil {
+			return err
+		}
+
+		lastProgress = progress
+	}
+}
+
+// upgradeProgress builds the progress of the Talos and Kubernetes upgrades of the cluster.
+//
+// The machine phases are derived from the machine stage, its config status and the Kubernetes node status.
+//
+//nolint:gocognit,gocyclo,cyclop
+func upgradeProgress(ctx context.Context, st state.State, clusterID resource.ID) (*management.WatchUpgradeProgressResponse, error) {
+	cluster, err := safe.StateGetByID[*omnires.Cluster](ctx, st, clusterID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "cluster %q not found", clusterID)
+		}
+
+		return nil, err
+	}
+
+	progress := &management.WatchUpgradeProgressResponse{}
+
+	talosUpgradeStatus, err := safe.StateGetByID[*omnires.TalosUpgradeStatus](ctx, st, clusterID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	if talosUpgradeStatus != nil {
+		spec := talosUpgradeStatus.TypedSpec().Value
+
+		progress.Talos = &management.WatchUpgradeProgressResponse_Upgrade{
+			Phase:              spec.Phase.String(),
+			Step:               spec.Step,
+			Status:             spec.Status,
+			Error:              spec.Error,
+			TargetVersion:      cluster.TypedSpec().Value.TalosVersion,
+			LastUpgradeVersion: spec.LastUpgradeVersion,
+			QueuePosition:      spec.QueuePosition,
+		}
+	}
+
+	kubernetesUpgradeStatus, err := safe.StateGetByID[*omnires.KubernetesUpgradeStatus](ctx, st, clusterID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	if kubernetesUpgradeStatus != nil {
+		spec := kubernetesUpgradeStatus.TypedSpec().Value
+
+		progress.Kubernetes = &management.WatchUpgradeProgressResponse_Upgrade{
+			Phase:              spec.Phase.String(),
+			Step:               spec.Step,
+			Status:             spec.Status,
+			Error:              spec.Error,
+			TargetVersion:      cluster.TypedSpec().Value.KubernetesVersion,
+			LastUpgradeVersion: spec.LastUpgradeVersion,
+			QueuePosition:      spec.QueuePosition,
+		}
+	}
+
+	kubernetesStatus, err := safe.StateGetByID[*omnires.KubernetesStatus](ctx, st, clusterID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	kubernetesNodes := map[string]*specs.KubernetesStatusSpec_NodeStatus{}
+	staticPods := map[string][]*specs.KubernetesStatusSpec_StaticPodStatus{}
+
+	if kubernetesStatus != nil {
+		for _, node := range kubernetesStatus.TypedSpec().Value.Nodes {
+			kubernetesNodes[node.Nodename] = node
+		}
+
+		for _, nodePods := range kubernetesStatus.TypedSpec().Value.StaticPods {
+			staticPods[nodePods.Nodename] = nodePods.StaticPods
+		}
+	}
+
+	clusterMachines, err := safe.StateListAll[*omnires.ClusterMachine](ctx, st, state.WithLabelQuery(resource.LabelEqual(omnires.LabelCluster, clusterID)))
+	if err != nil {
+		return nil, err
+	}
+
+	for iter := clusterMachines.Iterator(); iter.Next(); {
+		clusterMachine := iter.Value()
+
+		if clusterMachine.Metadata().Phase() == resource.PhaseTearingDown {
+			continue
+		}
+
+		machineID := clusterMachine.Metadata().ID()
+		_, controlPlane := clusterMachine.Metadata().Labels().Get(omnires.LabelControlPlaneRole)
+
+		var (
+			identity      *omnires.ClusterMachineIdentity
+			machineStatus *omnires.ClusterMachineStatus
+			configStatus  *omnires.ClusterMachineConfigStatus
+			talosVersion  *omnires.ClusterMachineTalosVersion
+		)
+
+		if identity, err = safe.StateGetByID[*omnires.ClusterMachineIdentity](ctx, st, machineID); err != nil && !state.IsNotFoundError(err) {
+			return nil, err
+		}
+
+		if machineStatus, err = safe.StateGetByID[*omnires.ClusterMachineStatus](ctx, st, machineID); err != nil && !state.IsNotFoundError(err) {
+			return nil, err
+		}
+
+		if configStatus, err = safe.StateGetByID[*omnires.ClusterMachineConfigStatus](ctx, st, machineID); err != nil && !state.IsNotFoundError(err) {
+			return nil, err
+		}
+
+		if talosVersion, err = safe.StateGetByID[*omnires.ClusterMachineTalosVersion](ctx, st, machineID); err != nil && !state.IsNotFoundError(err) {
+			return nil, err
+		}
+
+		var nodename string
+
+		if identity != nil {
+			nodename = identity.TypedSpec().Value.Nodename
+		}
+
+		node := kubernetesNodes[nodename]
+
+		progress.TalosMachines = append(progress.TalosMachines,
+			talosMachineProgress(machineID, nodename, controlPlane, cluster.TypedSpec().Value.TalosVersion, talosVersion, configStatus, machineStatus, node),
+		)
+
+		var kubernetesStep string
+
+		if kubernetesUpgradeStatus != nil && kubernetesUpgradeStatus.TypedSpec().Value.Phase == specs.KubernetesUpgradeStatusSpec_Upgrading {
+			kubernetesStep = kubernetesUpgradeStatus.TypedSpec().Value.Step
+		}
+
+		progress.KubernetesMachines = append(progress.KubernetesMachines,
+			kubernetesMachineProgress(machineID, nodename, controlPlane, cluster.TypedSpec().Value.KubernetesVersion, kubernetesStep, node, staticPods[nodename]),
+		)
+	}
+
+	for _, machines := range [][]*management.WatchUpgradeProgressResponse_Machine{progress.TalosMachines, progress.KubernetesMachines} {
+		slices.SortFunc(machines, func(a, b *management.WatchUpgradeProgressResponse_Machine) int {
+			if a.ControlPlane != b.ControlPlane {
+				if a.ControlPlane {
+					return -1
+				}
+
+				return 1
+			}
+
+			return cmp.Or(cmp.Compare(a.Nodename, b.Nodename), cmp.Compare(a.Id, b.Id))
+		})
+	}
+
+	return progress, nil
+}
+
+func talosMachineProgress(id, nodename string, controlPlane bool, targetVersion string,
+	talosVersion *omnires.ClusterMachineTalosVersion,
+	configStatus *omnires.ClusterMachineConfigStatus,
+	machineStatus *omnires.ClusterMachineStatus,
+	node *specs.KubernetesStatusSpec_NodeStatus,
+) *management.WatchUpgradeProgressResponse_Machine {
+	machine := &management.WatchUpgradeProgressResponse_Machine{
+		Id:           id,
+		Nodename:     nodename,
+		ControlPlane: controlPlane,
+	}
+
+	if configStatus != nil {
+		machine.Version = configStatus.TypedSpec().Value.TalosVersion
+		machine.Error = configStatus.TypedSpec().Value.LastConfigError
+	}
+
+	var stage specs.ClusterMachineStatusSpec_Stage
+
+	ready := false
+
+	if machineStatus != nil {
+		stage = machineStatus.TypedSpec().Value.Stage
+		ready = machineStatus.TypedSpec().Value.Ready
+	}
+
+	switch {
+	case machine.Version == targetVersion:
+		if ready && stage == specs.ClusterMachineStatusSpec_RUNNING {
+			machine.Phase = management.WatchUpgradeProgressResponse_Machine_DONE
+		} else {
+			machine.Phase = management.WatchUpgradeProgressResponse_Machine_VERIFYING
+		}
+	case machine.Error != "":
+		machine.Phase = management.WatchUpgradeProgressResponse_Machine_FAILED
+	case talosVersion == nil || talosVersion.TypedSpec().Value.TalosVersion != targetVersion:
+		machine.Phase = management.WatchUpgradeProgressResponse_Machine_PENDING
+	case stage == specs.ClusterMachineStatusSpec_REBOOTING || stage == specs.ClusterMachineStatusSpec_BOOTING:
+		machine.Phase = management.WatchUpgradeProgressResponse_Machine_REBOOTING
+	case stage == specs.ClusterMachineStatusSpec_UPGRADING && node != nil && node.Ready:
+		// the kubelet keeps running while the node is drained, it's stopped right before the new version is installed
+		machine.Phase = management.WatchUpgradeProgressResponse_Machine_DRAINING
+	default:
+		machine.Phase = management.WatchUpgradeProgressResponse_Machine_UPGRADING
+	}
+
+	return machine
+}
+
+func kubernetesMachineProgress(id, nodename string, controlPlane bool, targetVersion, step string,
+	node *specs.KubernetesStatusSpec_NodeStatus,
+	staticPods []*specs.KubernetesStatusSpec_StaticPodStatus,
+) *management.WatchUpgradeProgressResponse_Machine {
+	machine := &management.WatchUpgradeProgressResponse_Machine{
+		Id:           id,
+		Nodename:     nodename,
+		ControlPlane: controlPlane,
+	}
+
+	if node == nil {
+		return machine
+	}
+
+	machine.Version = node.KubeletVersion
+
+	upToDate := node.KubeletVersion == targetVersion
+	ready := node.Ready
+
+	for _, pod := range staticPods {
+		upToDate = upToDate && pod.Version == targetVersion
+		ready = ready && pod.Ready
+	}
+
+	switch {
+	// the upgrade steps are described as "<nodename>: updating <component> to <version>"
+	case nodename != "" && strings.HasPrefix(step, nodename+":"):
+		machine.Phase = management.WatchUpgradeProgressResponse_Machine_UPGRADING
+	case !upToDate:
+		machine.Phase = management.WatchUpgradeProgressResponse_Machine_PENDING
+	case !ready:
+		machine.Phase = management.WatchUpgradeProgressResponse_Machine_VERIFYING
+	default:
+		machine.Phase = management.WatchUpgradeProgressResponse_Machine_DONE
+	}
+
+	return machine
+}
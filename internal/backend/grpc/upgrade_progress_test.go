@@ -0,0 +1,129 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	grpcomni "github.com/siderolabs/omni/internal/backend/grpc"
+)
+
+func TestUpgradeProgress(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	const clusterID = "upgrading"
+
+	cluster := omni.NewCluster(resources.DefaultNamespace, clusterID)
+	cluster.TypedSpec().Value.TalosVersion = "1.7.4"
+	cluster.TypedSpec().Value.KubernetesVersion = "1.30.1"
+	require.NoError(t, st.Create(ctx, cluster))
+
+	talosUpgradeStatus := omni.NewTalosUpgradeStatus(resources.DefaultNamespace, clusterID)
+	talosUpgradeStatus.TypedSpec().Value.Phase = specs.TalosUpgradeStatusSpec_Upgrading
+	talosUpgradeStatus.TypedSpec().Value.Step = "current machine worker-1"
+	talosUpgradeStatus.TypedSpec().Value.LastUpgradeVersion = "1.7.0"
+	require.NoError(t, st.Create(ctx, talosUpgradeStatus))
+
+	kubernetesStatus := omni.NewKubernetesStatus(resources.DefaultNamespace, clusterID)
+	kubernetesStatus.TypedSpec().Value.Nodes = []*specs.KubernetesStatusSpec_NodeStatus{
+		{Nodename: "cp-1", KubeletVersion: "1.30.1", Ready: true},
+		{Nodename: "worker-1", KubeletVersion: "1.30.1"},
+		{Nodename: "worker-2", KubeletVersion: "1.30.0", Ready: true},
+	}
+	require.NoError(t, st.Create(ctx, kubernetesStatus))
+
+	for _, machine := range []struct {
+		id                  string
+		nodename            string
+		desiredTalos        string
+		runningTalos        string
+		stage               specs.ClusterMachineStatusSpec_Stage
+		controlPlane, ready bool
+	}{
+		{id: "m3", nodename: "worker-2", desiredTalos: "1.7.0", runningTalos: "1.7.0", stage: specs.ClusterMachineStatusSpec_RUNNING, ready: true},
+		{id: "m2", nodename: "worker-1", desiredTalos: "1.7.4", runningTalos: "1.7.0", stage: specs.ClusterMachineStatusSpec_REBOOTING},
+		{id: "m1", nodename: "cp-1", desiredTalos: "1.7.4", runningTalos: "1.7.4", stage: specs.ClusterMachineStatusSpec_RUNNING, controlPlane: true, ready: true},
+	} {
+		clusterMachine := omni.NewClusterMachine(resources.DefaultNamespace, machine.id)
+		clusterMachine.Metadata().Labels().Set(omni.LabelCluster, clusterID)
+
+		if machine.controlPlane {
+			clusterMachine.Metadata().Labels().Set(omni.LabelControlPlaneRole, "")
+		}
+
+		require.NoError(t, st.Create(ctx, clusterMachine))
+
+		identity := omni.NewClusterMachineIdentity(resources.DefaultNamespace, machine.id)
+		identity.TypedSpec().Value.Nodename = machine.nodename
+		require.NoError(t, st.Create(ctx, identity))
+
+		talosVersion := omni.NewClusterMachineTalosVersion(resources.DefaultNamespace, machine.id)
+		talosVersion.TypedSpec().Value.TalosVersion = machine.desiredTalos
+		require.NoError(t, st.Create(ctx, talosVersion))
+
+		configStatus := omni.NewClusterMachineConfigStatus(resources.DefaultNamespace, machine.id)
+		configStatus.TypedSpec().Value.TalosVersion = machine.runningTalos
+		require.NoError(t, st.Create(ctx, configStatus))
+
+		machineStatus := omni.NewClusterMachineStatus(resources.DefaultNamespace, machine.id)
+		machineStatus.TypedSpec().Value.Stage = machine.stage
+		machineStatus.TypedSpec().Value.Ready = machine.ready
+		require.NoError(t, st.Create(ctx, machineStatus))
+	}
+
+	progress, err := grpcomni.UpgradeProgress(ctx, st, clusterID)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Upgrading", progress.Talos.Phase)
+	assert.Equal(t, "1.7.4", progress.Talos.TargetVersion)
+	assert.Nil(t, progress.Kubernetes)
+
+	phases := func(machines []*management.WatchUpgradeProgressResponse_Machine) map[string]management.WatchUpgradeProgressResponse_Machine_Phase {
+		result := map[string]management.WatchUpgradeProgressResponse_Machine_Phase{}
+
+		for _, machine := range machines {
+			result[machine.Nodename] = machine.Phase
+		}
+
+		return result
+	}
+
+	require.Len(t, progress.TalosMachines, 3)
+	assert.Equal(t, "cp-1", progress.TalosMachines[0].Nodename)
+	assert.Equal(t, "worker-1", progress.TalosMachines[1].Nodename)
+
+	assert.Equal(t, map[string]management.WatchUpgradeProgressResponse_Machine_Phase{
+		"cp-1":     management.WatchUpgradeProgressResponse_Machine_DONE,
+		"worker-1": management.WatchUpgradeProgressResponse_Machine_REBOOTING,
+		"worker-2": management.WatchUpgradeProgressResponse_Machine_PENDING,
+	}, phases(progress.TalosMachines))
+
+	assert.Equal(t, map[string]management.WatchUpgradeProgressResponse_Machine_Phase{
+		"cp-1":     management.WatchUpgradeProgressResponse_Machine_DONE,
+		"worker-1": management.WatchUpgradeProgressResponse_Machine_VERIFYING,
+		"worker-2": management.WatchUpgradeProgressResponse_Machine_PENDING,
+	}, phases(progress.KubernetesMachines))
+
+	_, err = grpcomni.UpgradeProgress(ctx, st, "missing")
+	assert.Error(t, err)
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/siderolabs/talos/pkg/machinery/imager/quirks"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/siderolink"
+	omniCtrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+// GetMachineUserData implements ManagementServer.
+//
+// It renders the partial machine config which can be passed as the user-data to the cloud machines,
+// so that they join Omni without the SideroLink kernel arguments baked into the image.
+func (s *managementServer) GetMachineUserData(ctx context.Context, req *management.GetMachineUserDataRequest) (*management.GetMachineUserDataResponse, error) {
+	// the user-data contains the join token, so getting it is equivalent to creating a machine
+	if _, err := auth.CheckGRPC(ctx, auth.WithRole(role.Operator)); err != nil {
+		return nil, err
+	}
+
+	if version := strings.TrimLeft(req.GetTalosVersion(), "v"); version != "" && !quirks.New(version).SupportsMultidoc() {
+		return nil, status.Errorf(codes.InvalidArgument, "Talos version %q doesn't support partial machine configs", req.GetTalosVersion())
+	}
+
+	params, err := safe.StateGet[*siderolink.ConnectionParams](ctx, s.omniState, siderolink.NewConnectionParams(
+		resources.DefaultNamespace,
+		siderolink.ConfigID,
+	).Metadata())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Omni connection params: %w", err)
+	}
+
+	userData, err := omniCtrl.RenderJoinConfig(params, config.Config.EventSinkPort)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render the user-data: %w", err)
+	}
+
+	return &management.GetMachineUserDataResponse{
+		UserData: userData,
+	}, nil
+}
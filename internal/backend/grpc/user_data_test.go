@@ -0,0 +1,108 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/siderolink"
+	grpcomni "github.com/siderolabs/omni/internal/backend/grpc"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+func TestGetMachineUserData(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	ctx = context.WithValue(ctx, auth.EnabledAuthContextKey{}, true)
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	connectionParams := siderolink.NewConnectionParams(resources.DefaultNamespace, siderolink.ConfigID)
+	connectionParams.TypedSpec().Value.ApiEndpoint = "grpc://127.0.0.1:8080"
+	connectionParams.TypedSpec().Value.JoinToken = "tttt"
+
+	require.NoError(t, st.Create(ctx, connectionParams))
+
+	srv := grpcomni.NewManagementServer(st, nil, zaptest.NewLogger(t))
+
+	expectedUserData := fmt.Sprintf(`apiVersion: v1alpha1
+kind: SideroLinkConfig
+apiUrl: grpc://127.0.0.1:8080?grpc_tunnel=%t&jointoken=tttt
+---
+apiVersion: v1alpha1
+kind: EventSinkConfig
+endpoint: '[fdae:41e4:649b:9303::1]:%d'
+---
+apiVersion: v1alpha1
+kind: KmsgLogConfig
+name: omni-kmsg
+url: tcp://[fdae:41e4:649b:9303::1]:8092
+`, config.Config.SiderolinkUseGRPCTunnel, config.Config.EventSinkPort)
+
+	for _, tt := range []struct {
+		name         string
+		role         role.Role
+		talosVersion string
+		expectedCode codes.Code
+	}{
+		{
+			name:         "operator",
+			role:         role.Operator,
+			talosVersion: "v1.7.6",
+		},
+		{
+			name:         "admin without version",
+			role:         role.Admin,
+			talosVersion: "",
+		},
+		{
+			name:         "reader",
+			role:         role.Reader,
+			talosVersion: "v1.7.6",
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "unsupported Talos version",
+			role:         role.Operator,
+			talosVersion: "v1.4.8",
+			expectedCode: codes.InvalidArgument,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp, err := srv.GetMachineUserData(context.WithValue(ctx, auth.RoleContextKey{}, tt.role), &management.GetMachineUserDataRequest{
+				TalosVersion: tt.talosVersion,
+			})
+			require.Equal(t, tt.expectedCode, status.Code(err), "unexpected error: %v", err)
+
+			if tt.expectedCode != codes.OK {
+				return
+			}
+
+			assert.Equal(t, expectedUserData, resp.UserData)
+		})
+	}
+}
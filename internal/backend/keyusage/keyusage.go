@@ -0,0 +1,119 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package keyusage records the last used timestamps of the public keys.
+//
+// The usages are collected in memory by the authentication hot path and written to the state in batches,
+// so the authenticated calls are never blocked by the state updates.
+package keyusage
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+)
+
+const (
+	// UpdateInterval is the granularity of the last used timestamp.
+	UpdateInterval = time.Minute
+
+	// flushInterval is the interval between the writes of the collected usages.
+	flushInterval = 10 * time.Second
+)
+
+type usage struct {
+	timestamp time.Time
+	owner     string
+}
+
+// Recorder collects the public key usages and writes them to the state.
+type Recorder struct {
+	state   state.State
+	logger  *zap.Logger
+	pending map[resource.ID]usage
+	mu      sync.Mutex
+}
+
+// NewRecorder creates a new Recorder.
+func NewRecorder(st state.State, logger *zap.Logger) *Recorder {
+	return &Recorder{
+		state:   st,
+		logger:  logger,
+		pending: map[resource.ID]usage{},
+	}
+}
+
+// Record records the usage of the public key, it doesn't block.
+//
+// The usage is skipped if the recorded timestamp of the key is more recent than the UpdateInterval.
+func (r *Recorder) Record(pubKey *authres.PublicKey, now time.Time) {
+	if lastUsed := pubKey.TypedSpec().Value.GetLastUsed(); lastUsed != nil && now.Sub(lastUsed.AsTime()) < UpdateInterval {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.pending[pubKey.Metadata().ID()] = usage{
+		timestamp: now,
+		owner:     pubKey.Metadata().Owner(),
+	}
+}
+
+// Run writes the collected usages periodically until the context is canceled.
+func (r *Recorder) Run(ctx context.Context) error {
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			// write the usages collected since the last flush, the state is still available for a short while
+			flushCtx, cancel := context.WithTimeout(context.Background(), flushInterval)
+
+			r.Flush(flushCtx)
+
+			cancel()
+
+			return nil
+		case <-ticker.C:
+			r.Flush(ctx)
+		}
+	}
+}
+
+// Flush writes the collected usages to the state.
+func (r *Recorder) Flush(ctx context.Context) {
+	r.mu.Lock()
+	pending := r.pending
+	r.pending = map[resource.ID]usage{}
+	r.mu.Unlock()
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	for id, u := range pending {
+		if _, err := safe.StateUpdateWithConflicts(ctx, r.state, authres.NewPublicKey(resources.DefaultNamespace, id).Metadata(), func(k *authres.PublicKey) error {
+			if lastUsed := k.TypedSpec().Value.GetLastUsed(); lastUsed != nil && !lastUsed.AsTime().Before(u.timestamp) {
+				return nil
+			}
+
+			k.TypedSpec().Value.LastUsed = timestamppb.New(u.timestamp)
+
+			return nil
+		}, state.WithUpdateOwner(u.owner)); err != nil && !state.IsNotFoundError(err) {
+			r.logger.Warn("failed to update public key last used time", zap.String("fingerprint", id), zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package keyusage_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/internal/backend/keyusage"
+)
+
+const owner = "KeyPrunerController"
+
+func TestRecorder(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+	now := time.Now()
+
+	for id, lastUsed := range map[string]time.Time{
+		"unused": {},
+		"stale":  now.Add(-time.Hour),
+		"recent": now.Add(-time.Second),
+	} {
+		key := authres.NewPublicKey(resources.DefaultNamespace, id)
+
+		if !lastUsed.IsZero() {
+			key.TypedSpec().Value.LastUsed = timestamppb.New(lastUsed)
+		}
+
+		require.NoError(t, st.Create(ctx, key, state.WithCreateOwner(owner)))
+	}
+
+	recorder := keyusage.NewRecorder(st, zaptest.NewLogger(t))
+
+	for _, id := range []string{"unused", "stale", "recent"} {
+		key, err := safe.StateGetByID[*authres.PublicKey](ctx, st, id)
+		require.NoError(t, err)
+
+		// the same key is used by multiple calls, it is updated once
+		recorder.Record(key, now.Add(-time.Millisecond))
+		recorder.Record(key, now)
+	}
+
+	// the key removed before the flush is skipped
+	removed := authres.NewPublicKey(resources.DefaultNamespace, "removed")
+	recorder.Record(removed, now)
+
+	recorder.Flush(ctx)
+
+	for id, expected := range map[string]time.Time{
+		"unused": now,
+		"stale":  now,
+		"recent": now.Add(-time.Second),
+	} {
+		key, err := safe.StateGetByID[*authres.PublicKey](ctx, st, id)
+		require.NoError(t, err)
+
+		assert.Equal(t, expected.UnixNano(), key.TypedSpec().Value.GetLastUsed().AsTime().UnixNano(), id)
+		assert.Equal(t, owner, key.Metadata().Owner(), id)
+	}
+
+	// nothing is pending after the flush
+	key, err := safe.StateGetByID[*authres.PublicKey](ctx, st, "unused")
+	require.NoError(t, err)
+
+	version := key.Metadata().Version()
+
+	recorder.Flush(ctx)
+
+	key, err = safe.StateGetByID[*authres.PublicKey](ctx, st, "unused")
+	require.NoError(t, err)
+
+	assert.Equal(t, version, key.Metadata().Version())
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package logging
+
+import (
+	"fmt"
+	"slices"
+	"sync/atomic"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// Subsystems which log levels can be changed at runtime.
+const (
+	SubsystemSiderolink    = "siderolink"
+	SubsystemControllers   = "controllers"
+	SubsystemGRPC          = "grpc"
+	SubsystemWorkloadProxy = "workload-proxy"
+)
+
+// componentSubsystems maps the logger components to the subsystems.
+var componentSubsystems = map[string]string{
+	"siderolink":                SubsystemSiderolink,
+	"siderolink_log_handler":    SubsystemSiderolink,
+	"omni_runtime":              SubsystemControllers,
+	"grpc":                      SubsystemGRPC,
+	"management_server":         SubsystemGRPC,
+	"auth_server":               SubsystemGRPC,
+	"workload_proxy_reconciler": SubsystemWorkloadProxy,
+	"workload_proxy_handler":    SubsystemWorkloadProxy,
+}
+
+// Subsystems returns the names of the subsystems which log levels can be changed.
+func Subsystems() []string {
+	return []string{SubsystemControllers, SubsystemGRPC, SubsystemSiderolink, SubsystemWorkloadProxy}
+}
+
+// Levels keeps the log levels of the subsystems.
+//
+// The subsystems without the level set use the default level.
+type Levels struct {
+	overrides    atomic.Pointer[map[string]zapcore.Level]
+	defaultLevel zapcore.Level
+}
+
+// NewLevels creates new Levels.
+func NewLevels(defaultLevel zapcore.Level) *Levels {
+	return &Levels{
+		defaultLevel: defaultLevel,
+	}
+}
+
+// Enabled checks if the level is enabled for the subsystem.
+func (l *Levels) Enabled(subsystem string, level zapcore.Level) bool {
+	if overrides := l.overrides.Load(); overrides != nil {
+		if override, ok := (*overrides)[subsystem]; ok {
+			return override.Enabled(level)
+		}
+	}
+
+	return l.defaultLevel.Enabled(level)
+}
+
+// Set replaces the log levels of the subsystems.
+func (l *Levels) Set(levels map[string]string) error {
+	overrides, err := ParseLevels(levels)
+	if err != nil {
+		return err
+	}
+
+	l.overrides.Store(&overrides)
+
+	return nil
+}
+
+// ParseLevels parses the log levels of the subsystems.
+func ParseLevels(levels map[string]string) (map[string]zapcore.Level, error) {
+	overrides := make(map[string]zapcore.Level, len(levels))
+
+	for subsystem, levelName := range levels {
+		if !slices.Contains(Subsystems(), subsystem) {
+			return nil, fmt.Errorf("unknown subsystem %q, supported subsystems: %v", subsystem, Subsystems())
+		}
+
+		level, err := zapcore.ParseLevel(levelName)
+		if err != nil {
+			return nil, fmt.Errorf("invalid log level for subsystem %q: %w", subsystem, err)
+		}
+
+		overrides[subsystem] = level
+	}
+
+	return overrides, nil
+}
+
+// Get returns the log levels of all subsystems.
+func (l *Levels) Get() map[string]string {
+	result := make(map[string]string, len(Subsystems()))
+
+	for _, subsystem := range Subsystems() {
+		result[subsystem] = l.defaultLevel.String()
+	}
+
+	if overrides := l.overrides.Load(); overrides != nil {
+		for subsystem, level := range *overrides {
+			result[subsystem] = level.String()
+		}
+	}
+
+	return result
+}
+
+// WrapCore returns the zap core wrapper which filters the log entries by the levels of the subsystems.
+//
+// The subsystem of the logger is detected by the component field, so the wrapped core should enable all levels
+// which might be set for the subsystems.
+func (l *Levels) WrapCore(core zapcore.Core) zapcore.Core {
+	return &levelCore{
+		Core:   core,
+		levels: l,
+	}
+}
+
+type levelCore struct {
+	zapcore.Core
+
+	levels    *Levels
+	subsystem string
+}
+
+func (c *levelCore) Enabled(level zapcore.Level) bool {
+	return c.levels.Enabled(c.subsystem, level)
+}
+
+func (c *levelCore) With(fields []zapcore.Field) zapcore.Core {
+	return &levelCore{
+		Core:      c.Core.With(fields),
+		levels:    c.levels,
+		subsystem: fieldsSubsystem(c.subsystem, fields),
+	}
+}
+
+func (c *levelCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if !c.Enabled(entry.Level) {
+		return checked
+	}
+
+	return c.Core.Check(entry, checked)
+}
+
+// fieldsSubsystem detects the subsystem of the logger by the component field, the current subsystem is kept if there is none.
+func fieldsSubsystem(subsystem string, fields []zapcore.Field) string {
+	for _, field := range fields {
+		if field.Key != componentKey || field.Type != zapcore.StringType {
+			continue
+		}
+
+		if s, ok := componentSubsystems[field.String]; ok {
+			subsystem = s
+		}
+	}
+
+	return subsystem
+}
+
+// defaultLevels is set when the logger is built.
+var defaultLevels atomic.Pointer[Levels]
+
+// SetDefaultLevels sets the levels used by the backend logger.
+func SetDefaultLevels(levels *Levels) {
+	defaultLevels.Store(levels)
+}
+
+// DefaultLevels returns the levels used by the backend logger, nil if not set.
+func DefaultLevels() *Levels {
+	return defaultLevels.Load()
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package logging_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/siderolabs/omni/internal/backend/logging"
+)
+
+func TestLevels(t *testing.T) {
+	t.Parallel()
+
+	levels := logging.NewLevels(zapcore.InfoLevel)
+
+	assert.False(t, levels.Enabled(logging.SubsystemGRPC, zapcore.DebugLevel))
+	assert.True(t, levels.Enabled(logging.SubsystemGRPC, zapcore.InfoLevel))
+
+	require.NoError(t, levels.Set(map[string]string{
+		logging.SubsystemGRPC:       "debug",
+		logging.SubsystemSiderolink: "error",
+	}))
+
+	assert.True(t, levels.Enabled(logging.SubsystemGRPC, zapcore.DebugLevel))
+	assert.False(t, levels.Enabled(logging.SubsystemSiderolink, zapcore.WarnLevel))
+	assert.False(t, levels.Enabled(logging.SubsystemControllers, zapcore.DebugLevel))
+
+	assert.Equal(t, map[string]string{
+		logging.SubsystemControllers:   "info",
+		logging.SubsystemGRPC:          "debug",
+		logging.SubsystemSiderolink:    "error",
+		logging.SubsystemWorkloadProxy: "info",
+	}, levels.Get())
+
+	require.ErrorContains(t, levels.Set(map[string]string{"etcd": "debug"}), "unknown subsystem")
+	require.ErrorContains(t, levels.Set(map[string]string{logging.SubsystemGRPC: "verbose"}), "invalid log level")
+
+	// the invalid levels are not applied
+	assert.True(t, levels.Enabled(logging.SubsystemGRPC, zapcore.DebugLevel))
+
+	require.NoError(t, levels.Set(nil))
+
+	assert.False(t, levels.Enabled(logging.SubsystemGRPC, zapcore.DebugLevel))
+}
+
+func TestWrapCore(t *testing.T) {
+	t.Parallel()
+
+	levels := logging.NewLevels(zapcore.InfoLevel)
+
+	core, logs := observer.New(zapcore.DebugLevel)
+	logger := zap.New(levels.WrapCore(core))
+
+	grpcLogger := logger.With(logging.Component("management_server"))
+	controllersLogger := logger.With(logging.Component("omni_runtime")).With(zap.String("controller", "ClusterController"))
+
+	grpcLogger.Debug("grpc debug")
+	controllersLogger.Debug("controllers debug")
+	logger.Info("root info")
+
+	require.NoError(t, levels.Set(map[string]string{logging.SubsystemGRPC: "debug"}))
+
+	// the levels are applied to the existing loggers
+	grpcLogger.Debug("grpc debug")
+	controllersLogger.Debug("controllers debug")
+	logger.Debug("root debug")
+
+	messages := make([]string, 0, logs.Len())
+
+	for _, entry := range logs.All() {
+		messages = append(messages, entry.Message)
+	}
+
+	assert.Equal(t, []string{"root info", "grpc debug"}, messages)
+}
@@ -10,7 +10,9 @@ import (
 	"go.uber.org/zap"
 )
 
+const componentKey = "component"
+
 // Component returns the well-known "component" zap field.
 func Component(name string) zap.Field {
-	return zap.String("component", name)
+	return zap.String(componentKey, name)
 }
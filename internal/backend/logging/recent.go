@@ -0,0 +1,139 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package logging
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// RecentLogsSize is the number of the log entries kept by the backend logger.
+const RecentLogsSize = 10000
+
+// RecentLogs keeps the latest log entries of the controllers encoded as JSON lines.
+type RecentLogs struct {
+	entries [][]byte
+	mu      sync.Mutex
+	next    int
+	full    bool
+}
+
+// NewRecentLogs creates new RecentLogs keeping up to size log entries.
+func NewRecentLogs(size int) *RecentLogs {
+	return &RecentLogs{
+		entries: make([][]byte, size),
+	}
+}
+
+// Lines returns the kept log entries matching the filter in the order they were written.
+func (r *RecentLogs) Lines(filter func(line []byte) bool) [][]byte {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var ordered [][]byte
+
+	if r.full {
+		ordered = append(ordered, r.entries[r.next:]...)
+	}
+
+	ordered = append(ordered, r.entries[:r.next]...)
+
+	result := make([][]byte, 0, len(ordered))
+
+	for _, line := range ordered {
+		if filter == nil || filter(line) {
+			result = append(result, line)
+		}
+	}
+
+	return result
+}
+
+func (r *RecentLogs) add(line []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.entries[r.next] = line
+	r.next++
+
+	if r.next == len(r.entries) {
+		r.next = 0
+		r.full = true
+	}
+}
+
+// WrapCore returns the zap core wrapper which additionally keeps the log entries of the controllers.
+func (r *RecentLogs) WrapCore(core zapcore.Core) zapcore.Core {
+	return zapcore.NewTee(core, &recentCore{
+		encoder: zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig()),
+		logs:    r,
+	})
+}
+
+type recentCore struct {
+	encoder   zapcore.Encoder
+	logs      *RecentLogs
+	subsystem string
+}
+
+func (c *recentCore) Enabled(zapcore.Level) bool {
+	return c.subsystem == SubsystemControllers
+}
+
+func (c *recentCore) With(fields []zapcore.Field) zapcore.Core {
+	encoder := c.encoder.Clone()
+
+	for _, field := range fields {
+		field.AddTo(encoder)
+	}
+
+	return &recentCore{
+		encoder:   encoder,
+		logs:      c.logs,
+		subsystem: fieldsSubsystem(c.subsystem, fields),
+	}
+}
+
+func (c *recentCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+
+	return checked
+}
+
+func (c *recentCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	buf, err := c.encoder.EncodeEntry(entry, fields)
+	if err != nil {
+		return err
+	}
+
+	defer buf.Free()
+
+	c.logs.add(append([]byte(nil), buf.Bytes()...))
+
+	return nil
+}
+
+func (c *recentCore) Sync() error {
+	return nil
+}
+
+// defaultRecentLogs is set when the logger is built.
+var defaultRecentLogs atomic.Pointer[RecentLogs]
+
+// SetDefaultRecentLogs sets the recent log entries kept by the backend logger.
+func SetDefaultRecentLogs(logs *RecentLogs) {
+	defaultRecentLogs.Store(logs)
+}
+
+// DefaultRecentLogs returns the recent log entries kept by the backend logger, nil if not set.
+func DefaultRecentLogs() *RecentLogs {
+	return defaultRecentLogs.Load()
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package logging_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/siderolabs/omni/internal/backend/logging"
+)
+
+func TestRecentLogs(t *testing.T) {
+	t.Parallel()
+
+	recentLogs := logging.NewRecentLogs(3)
+
+	core, observed := observer.New(zapcore.DebugLevel)
+	logger := zap.New(recentLogs.WrapCore(core))
+
+	controllersLogger := logger.With(logging.Component("omni_runtime"))
+
+	for _, cluster := range []string{"cluster1", "cluster2", "cluster1", "cluster1", "cluster2"} {
+		controllersLogger.Info("reconciled", zap.String("cluster", cluster))
+	}
+
+	// the entries of the other subsystems are not kept
+	logger.With(logging.Component("grpc")).Info("request", zap.String("cluster", "cluster1"))
+
+	assert.Equal(t, 6, observed.Len())
+
+	lines := recentLogs.Lines(nil)
+	require.Len(t, lines, 3)
+
+	for i, cluster := range []string{"cluster1", "cluster1", "cluster2"} {
+		assert.Contains(t, string(lines[i]), `"cluster":"`+cluster+`"`)
+		assert.Contains(t, string(lines[i]), `"component":"omni_runtime"`)
+	}
+
+	assert.Len(t, recentLogs.Lines(func(line []byte) bool {
+		return bytes.Contains(line, []byte("cluster1"))
+	}), 2)
+}
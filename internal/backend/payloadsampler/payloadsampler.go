@@ -0,0 +1,300 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package payloadsampler records the payloads of the selected gRPC calls to debug the client integrations.
+//
+// The samples are kept in memory in a ring buffer of the configured size, and are retrieved by the admins via the management API.
+// The sensitive data is redacted before the payloads are recorded.
+package payloadsampler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/config"
+	"github.com/siderolabs/omni/internal/pkg/grpcutil"
+)
+
+// redacted replaces the messages which can't be recorded.
+const redacted = "<redacted>"
+
+// secretResponseMethods are the methods which responses always contain credentials or the private data.
+var secretResponseMethods = map[string]struct{}{
+	management.ManagementService_Kubeconfig_FullMethodName:       {},
+	management.ManagementService_Talosconfig_FullMethodName:      {},
+	management.ManagementService_Omniconfig_FullMethodName:       {},
+	management.ManagementService_GetSupportBundle_FullMethodName: {},
+}
+
+// Sampler records the payloads of the sampled gRPC calls.
+type Sampler struct {
+	methods         map[string]struct{}
+	requestHook     grpcutil.Hook
+	isSensitiveType func(resource.Type) bool
+
+	samples []*management.PayloadSample
+	params  config.PayloadSamplingParams
+	next    int
+	mu      sync.Mutex
+}
+
+// New creates a new Sampler.
+//
+// The requestHook redacts the sensitive data in the requests, the responses of the resource API calls are redacted
+// if isSensitiveType reports the requested resource type as sensitive.
+func New(params config.PayloadSamplingParams, requestHook grpcutil.Hook, isSensitiveType func(resource.Type) bool) (*Sampler, error) {
+	sampler := &Sampler{
+		methods:         make(map[string]struct{}, len(params.Methods)),
+		requestHook:     requestHook,
+		isSensitiveType: isSensitiveType,
+		params:          params,
+	}
+
+	if len(params.Methods) == 0 {
+		return sampler, nil
+	}
+
+	if params.Rate <= 0 || params.Rate > 1 {
+		return nil, fmt.Errorf("invalid payload sampling rate %v, should be in (0, 1]", params.Rate)
+	}
+
+	if params.BufferSize <= 0 {
+		return nil, fmt.Errorf("invalid payload sampling buffer size %d", params.BufferSize)
+	}
+
+	for _, method := range params.Methods {
+		if method == management.ManagementService_GetPayloadSamples_FullMethodName {
+			return nil, errors.New("payload samples retrieval can't be sampled")
+		}
+
+		sampler.methods[method] = struct{}{}
+	}
+
+	sampler.samples = make([]*management.PayloadSample, 0, params.BufferSize)
+
+	return sampler, nil
+}
+
+// Enabled checks if any method is sampled.
+func (s *Sampler) Enabled() bool {
+	return len(s.methods) > 0
+}
+
+// Samples returns the recorded samples starting with the oldest one, optionally filtered by the method.
+func (s *Sampler) Samples(method string) []*management.PayloadSample {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make([]*management.PayloadSample, 0, len(s.samples))
+
+	for i := range s.samples {
+		// once the buffer is full, the oldest sample is the one to be overwritten next
+		sample := s.samples[(s.next+i)%len(s.samples)]
+
+		if method != "" && sample.Method != method {
+			continue
+		}
+
+		result = append(result, sample.CloneVT())
+	}
+
+	return result
+}
+
+func (s *Sampler) add(sample *management.PayloadSample) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.samples) < s.params.BufferSize {
+		s.samples = append(s.samples, sample)
+
+		return
+	}
+
+	s.samples[s.next] = sample
+	s.next = (s.next + 1) % len(s.samples)
+}
+
+func (s *Sampler) shouldSample(method string) bool {
+	if _, ok := s.methods[method]; !ok {
+		return false
+	}
+
+	return s.params.Rate >= 1 || rand.Float64() < s.params.Rate //nolint:gosec
+}
+
+// Unary returns the unary server interceptor recording the sampled calls.
+func (s *Sampler) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !s.shouldSample(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		rec := s.newRecorder(ctx, info.FullMethod)
+		rec.request(req)
+
+		resp, err := handler(ctx, req)
+		if err == nil {
+			rec.response(resp)
+		}
+
+		rec.finish(err)
+
+		return resp, err
+	}
+}
+
+// Stream returns the stream server interceptor recording the sampled calls.
+func (s *Sampler) Stream() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !s.shouldSample(info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		rec := s.newRecorder(ss.Context(), info.FullMethod)
+
+		err := handler(srv, &recordingStream{
+			ServerStream: ss,
+			rec:          rec,
+		})
+
+		rec.finish(err)
+
+		return err
+	}
+}
+
+type recordingStream struct {
+	grpc.ServerStream
+	rec *recorder
+}
+
+func (s *recordingStream) RecvMsg(msg any) error {
+	if err := s.ServerStream.RecvMsg(msg); err != nil {
+		return err
+	}
+
+	s.rec.request(msg)
+
+	return nil
+}
+
+func (s *recordingStream) SendMsg(msg any) error {
+	s.rec.response(msg)
+
+	return s.ServerStream.SendMsg(msg)
+}
+
+// recorder collects the messages of a single call.
+type recorder struct {
+	sampler *Sampler
+	sample  *management.PayloadSample
+	start   time.Time
+
+	// the messages of a stream might be sent and received concurrently
+	mu              sync.Mutex
+	redactResponses bool
+}
+
+func (s *Sampler) newRecorder(ctx context.Context, method string) *recorder {
+	identity, _ := ctx.Value(auth.IdentityContextKey{}).(string) //nolint:errcheck
+
+	_, redactResponses := secretResponseMethods[method]
+
+	return &recorder{
+		sampler: s,
+		sample: &management.PayloadSample{
+			Method:   method,
+			Identity: identity,
+			Time:     timestamppb.Now(),
+		},
+		start:           time.Now(),
+		redactResponses: redactResponses,
+	}
+}
+
+func (r *recorder) request(msg any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// the resource API requests carry the resource type, the responses are redacted if the resources are sensitive
+	if typed, ok := msg.(interface{ GetType() string }); ok && r.sampler.isSensitiveType(typed.GetType()) {
+		r.redactResponses = true
+	}
+
+	if pb, ok := msg.(proto.Message); ok {
+		if redactedMsg := r.sampler.requestHook(pb); redactedMsg != pb {
+			// the created or updated resource is sensitive, and the response contains it
+			msg = redactedMsg
+			r.redactResponses = true
+		}
+	}
+
+	r.sample.Requests = r.append(r.sample.Requests, msg, false)
+}
+
+func (r *recorder) response(msg any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.sample.Responses = r.append(r.sample.Responses, msg, r.redactResponses)
+}
+
+func (r *recorder) append(messages []string, msg any, redact bool) []string {
+	if r.sampler.params.MaxStreamMessages > 0 && len(messages) >= r.sampler.params.MaxStreamMessages {
+		r.sample.Truncated = true
+
+		return messages
+	}
+
+	if redact {
+		return append(messages, redacted)
+	}
+
+	pb, ok := msg.(proto.Message)
+	if !ok {
+		return append(messages, fmt.Sprintf("<%T>", msg))
+	}
+
+	data, err := protojson.Marshal(pb)
+	if err != nil {
+		return append(messages, fmt.Sprintf("<failed to marshal: %s>", err))
+	}
+
+	if limit := r.sampler.params.MessageSizeLimit; limit > 0 && len(data) > limit {
+		data = data[:limit]
+		r.sample.Truncated = true
+	}
+
+	return append(messages, string(data))
+}
+
+func (r *recorder) finish(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.sample.Duration = durationpb.New(time.Since(r.start))
+	r.sample.Code = uint32(status.Code(err))
+
+	if err != nil {
+		r.sample.Error = err.Error()
+	}
+
+	r.sampler.add(r.sample)
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package payloadsampler_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/cosi-project/runtime/api/v1alpha1"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/internal/backend/payloadsampler"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/config"
+	"github.com/siderolabs/omni/internal/pkg/grpcutil"
+)
+
+const sensitiveType = "Secrets.omni.sidero.dev"
+
+func newSampler(t *testing.T, methods ...string) *payloadsampler.Sampler {
+	t.Helper()
+
+	params := config.Config.PayloadSampling
+	params.Methods = methods
+	params.BufferSize = 3
+
+	sampler, err := payloadsampler.New(
+		params,
+		grpcutil.NewHook(grpcutil.NewRewriter(func(req *v1alpha1.CreateRequest) (*v1alpha1.CreateRequest, bool) {
+			if req.GetResource().GetMetadata().GetType() != sensitiveType {
+				return nil, false
+			}
+
+			req.Resource.Spec = nil
+
+			return req, true
+		})),
+		func(resourceType resource.Type) bool { return resourceType == sensitiveType },
+	)
+	require.NoError(t, err)
+
+	return sampler
+}
+
+func call(ctx context.Context, sampler *payloadsampler.Sampler, method string, req, resp proto.Message, err error) error {
+	_, err = sampler.Unary()(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, func(context.Context, any) (any, error) {
+		return resp, err
+	})
+
+	return err
+}
+
+func TestSampling(t *testing.T) {
+	t.Parallel()
+
+	sampler := newSampler(t, v1alpha1.State_Get_FullMethodName)
+
+	require.True(t, sampler.Enabled())
+
+	ctx := context.WithValue(context.Background(), auth.IdentityContextKey{}, "user@example.com")
+
+	require.NoError(t, call(ctx, sampler, v1alpha1.State_Get_FullMethodName,
+		&v1alpha1.GetRequest{Namespace: "default", Type: "Clusters.omni.sidero.dev", Id: "test"},
+		&v1alpha1.GetResponse{Resource: &v1alpha1.Resource{Metadata: &v1alpha1.Metadata{Id: "test"}}},
+		nil,
+	))
+
+	// not sampled
+	require.NoError(t, call(ctx, sampler, v1alpha1.State_List_FullMethodName, &v1alpha1.ListRequest{}, &v1alpha1.ListResponse{}, nil))
+
+	samples := sampler.Samples("")
+	require.Len(t, samples, 1)
+
+	assert.Equal(t, v1alpha1.State_Get_FullMethodName, samples[0].Method)
+	assert.Equal(t, "user@example.com", samples[0].Identity)
+	assert.EqualValues(t, codes.OK, samples[0].Code)
+	require.Len(t, samples[0].Requests, 1)
+	assert.Contains(t, samples[0].Requests[0], `"id":"test"`)
+	require.Len(t, samples[0].Responses, 1)
+	assert.Contains(t, samples[0].Responses[0], `"id":"test"`)
+
+	assert.Empty(t, sampler.Samples(v1alpha1.State_List_FullMethodName))
+}
+
+func TestRedaction(t *testing.T) {
+	t.Parallel()
+
+	sampler := newSampler(t,
+		v1alpha1.State_Get_FullMethodName,
+		v1alpha1.State_Create_FullMethodName,
+		management.ManagementService_Kubeconfig_FullMethodName,
+	)
+
+	ctx := context.Background()
+
+	require.NoError(t, call(ctx, sampler, v1alpha1.State_Get_FullMethodName,
+		&v1alpha1.GetRequest{Namespace: "default", Type: sensitiveType, Id: "test"},
+		&v1alpha1.GetResponse{Resource: &v1alpha1.Resource{Spec: &v1alpha1.Spec{YamlSpec: "secret"}}},
+		nil,
+	))
+
+	require.NoError(t, call(ctx, sampler, v1alpha1.State_Create_FullMethodName,
+		&v1alpha1.CreateRequest{Resource: &v1alpha1.Resource{Metadata: &v1alpha1.Metadata{Type: sensitiveType}, Spec: &v1alpha1.Spec{YamlSpec: "secret"}}},
+		&v1alpha1.CreateResponse{Resource: &v1alpha1.Resource{Spec: &v1alpha1.Spec{YamlSpec: "secret"}}},
+		nil,
+	))
+
+	require.NoError(t, call(ctx, sampler, management.ManagementService_Kubeconfig_FullMethodName,
+		&management.KubeconfigRequest{},
+		&management.KubeconfigResponse{Kubeconfig: []byte("secret")},
+		nil,
+	))
+
+	samples := sampler.Samples("")
+	require.Len(t, samples, 3)
+
+	for _, sample := range samples {
+		for _, msg := range append(sample.Requests, sample.Responses...) {
+			assert.NotContains(t, msg, "secret", sample.Method)
+		}
+
+		assert.Equal(t, []string{"<redacted>"}, sample.Responses, sample.Method)
+	}
+}
+
+func TestRingBuffer(t *testing.T) {
+	t.Parallel()
+
+	sampler := newSampler(t, v1alpha1.State_Get_FullMethodName)
+
+	ctx := context.Background()
+
+	for i := range 5 {
+		err := call(ctx, sampler, v1alpha1.State_Get_FullMethodName,
+			&v1alpha1.GetRequest{Type: "Clusters.omni.sidero.dev", Id: strconv.Itoa(i)},
+			nil,
+			status.Error(codes.NotFound, "not found"),
+		)
+		require.Error(t, err)
+	}
+
+	samples := sampler.Samples("")
+	require.Len(t, samples, 3)
+
+	for i, sample := range samples {
+		assert.Contains(t, sample.Requests[0], `"id":"`+strconv.Itoa(i+2)+`"`)
+		assert.EqualValues(t, codes.NotFound, sample.Code)
+		assert.Equal(t, "rpc error: code = NotFound desc = not found", sample.Error)
+		assert.Empty(t, sample.Responses)
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	hook := grpcutil.NewHook()
+	isSensitiveType := func(resource.Type) bool { return false }
+
+	sampler, err := payloadsampler.New(config.PayloadSamplingParams{}, hook, isSensitiveType)
+	require.NoError(t, err)
+	assert.False(t, sampler.Enabled())
+
+	for _, params := range []config.PayloadSamplingParams{
+		{Methods: []string{v1alpha1.State_Get_FullMethodName}, Rate: 0, BufferSize: 10},
+		{Methods: []string{v1alpha1.State_Get_FullMethodName}, Rate: 2, BufferSize: 10},
+		{Methods: []string{v1alpha1.State_Get_FullMethodName}, Rate: 1, BufferSize: 0},
+		{Methods: []string{management.ManagementService_GetPayloadSamples_FullMethodName}, Rate: 1, BufferSize: 10},
+	} {
+		_, err = payloadsampler.New(params, hook, isSensitiveType)
+		assert.Error(t, err, params)
+	}
+}
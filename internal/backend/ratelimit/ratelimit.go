@@ -0,0 +1,216 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package ratelimit limits the rate of the gRPC calls made by each user and service account.
+//
+// Each identity gets its own token bucket which is refilled at the configured rate up to the configured burst.
+// The calls without the identity, e.g. the ones which don't require the authentication, are not limited.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/pkg/access"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+// sweepInterval is the interval between the removals of the buckets which are full again.
+const sweepInterval = time.Minute
+
+type bucket struct {
+	updated time.Time
+	tokens  float64
+}
+
+type limit struct {
+	rate  float64
+	burst float64
+}
+
+// Limiter limits the rate of the gRPC calls of each identity.
+type Limiter struct {
+	buckets   map[string]*bucket
+	lastSweep time.Time
+
+	user           limit
+	serviceAccount limit
+
+	mu sync.Mutex
+}
+
+// New creates a new Limiter.
+func New(params config.RateLimitParams) (*Limiter, error) {
+	limiter := &Limiter{
+		buckets: map[string]*bucket{},
+	}
+
+	if err := limiter.Update(params); err != nil {
+		return nil, err
+	}
+
+	return limiter, nil
+}
+
+// Update replaces the limits, the buckets of the identities are refilled at the new rates.
+func (l *Limiter) Update(params config.RateLimitParams) error {
+	user, serviceAccount, err := parseLimits(params)
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.user = user
+	l.serviceAccount = serviceAccount
+
+	return nil
+}
+
+func parseLimits(params config.RateLimitParams) (user, serviceAccount limit, err error) {
+	if params.Rate == 0 {
+		return user, serviceAccount, nil
+	}
+
+	if params.Rate < 0 || params.ServiceAccountRate < 0 {
+		return user, serviceAccount, fmt.Errorf("invalid rate limits %v and %v, should be positive", params.Rate, params.ServiceAccountRate)
+	}
+
+	if params.Burst <= 0 || params.ServiceAccountBurst < 0 {
+		return user, serviceAccount, fmt.Errorf("invalid rate limit bursts %d and %d, should be positive", params.Burst, params.ServiceAccountBurst)
+	}
+
+	user = limit{
+		rate:  params.Rate,
+		burst: float64(params.Burst),
+	}
+
+	serviceAccount = user
+
+	if params.ServiceAccountRate > 0 {
+		serviceAccount.rate = params.ServiceAccountRate
+	}
+
+	if params.ServiceAccountBurst > 0 {
+		serviceAccount.burst = float64(params.ServiceAccountBurst)
+	}
+
+	return user, serviceAccount, nil
+}
+
+// Enabled checks if the calls are limited.
+func (l *Limiter) Enabled() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.user.rate > 0
+}
+
+// Allow takes a token from the bucket of the identity, it returns false if the bucket is empty.
+func (l *Limiter) Allow(identity string) bool {
+	if identity == "" {
+		return true
+	}
+
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.user.rate <= 0 {
+		return true
+	}
+
+	lim := l.limitOf(identity)
+
+	if now.Sub(l.lastSweep) >= sweepInterval {
+		l.sweep(now)
+	}
+
+	b, ok := l.buckets[identity]
+	if !ok {
+		b = &bucket{
+			tokens:  lim.burst,
+			updated: now,
+		}
+
+		l.buckets[identity] = b
+	}
+
+	b.tokens = min(lim.burst, b.tokens+now.Sub(b.updated).Seconds()*lim.rate)
+	b.updated = now
+
+	if b.tokens < 1 {
+		return false
+	}
+
+	b.tokens--
+
+	return true
+}
+
+// sweep removes the buckets which are refilled completely, they are recreated full on the next call.
+func (l *Limiter) sweep(now time.Time) {
+	l.lastSweep = now
+
+	for identity, b := range l.buckets {
+		lim := l.limitOf(identity)
+
+		if b.tokens+now.Sub(b.updated).Seconds()*lim.rate >= lim.burst {
+			delete(l.buckets, identity)
+		}
+	}
+}
+
+func (l *Limiter) limitOf(identity string) limit {
+	if strings.HasSuffix(identity, access.ServiceAccountNameSuffix) {
+		return l.serviceAccount
+	}
+
+	return l.user
+}
+
+func (l *Limiter) check(ctx context.Context, method string) error {
+	identity, _ := ctx.Value(auth.IdentityContextKey{}).(string)
+
+	if !l.Allow(identity) {
+		return status.Errorf(codes.ResourceExhausted, "rate limit exceeded for %q calling %s, retry later", identity, method)
+	}
+
+	return nil
+}
+
+// Unary returns the unary server interceptor rejecting the calls over the rate limit.
+func (l *Limiter) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := l.check(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// Stream returns the stream server interceptor rejecting the calls over the rate limit.
+//
+// Only the start of the stream takes a token, the messages sent over the stream are not limited.
+func (l *Limiter) Stream() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := l.check(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
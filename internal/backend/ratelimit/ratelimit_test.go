@@ -0,0 +1,137 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package ratelimit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/pkg/access"
+	"github.com/siderolabs/omni/internal/backend/ratelimit"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+func TestLimiter(t *testing.T) {
+	t.Parallel()
+
+	limiter, err := ratelimit.New(config.RateLimitParams{
+		Rate:                0.001,
+		Burst:               2,
+		ServiceAccountBurst: 3,
+	})
+	require.NoError(t, err)
+	require.True(t, limiter.Enabled())
+
+	// each identity has its own bucket
+	for _, identity := range []string{"alice@example.com", "bob@example.com"} {
+		assert.True(t, limiter.Allow(identity))
+		assert.True(t, limiter.Allow(identity))
+		assert.False(t, limiter.Allow(identity))
+	}
+
+	// the service accounts have their own burst
+	serviceAccount := "automation" + access.ServiceAccountNameSuffix
+
+	for range 3 {
+		assert.True(t, limiter.Allow(serviceAccount))
+	}
+
+	assert.False(t, limiter.Allow(serviceAccount))
+
+	// the calls without the identity are not limited
+	for range 10 {
+		assert.True(t, limiter.Allow(""))
+	}
+}
+
+func TestLimiterRefill(t *testing.T) {
+	t.Parallel()
+
+	limiter, err := ratelimit.New(config.RateLimitParams{
+		Rate:  100,
+		Burst: 1,
+	})
+	require.NoError(t, err)
+
+	assert.True(t, limiter.Allow("alice@example.com"))
+
+	assert.Eventually(t, func() bool {
+		return limiter.Allow("alice@example.com")
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestLimiterDisabled(t *testing.T) {
+	t.Parallel()
+
+	limiter, err := ratelimit.New(config.RateLimitParams{})
+	require.NoError(t, err)
+	require.False(t, limiter.Enabled())
+
+	for range 10 {
+		assert.True(t, limiter.Allow("alice@example.com"))
+	}
+
+	_, err = ratelimit.New(config.RateLimitParams{Rate: 1})
+	assert.ErrorContains(t, err, "invalid rate limit bursts")
+}
+
+func TestLimiterUpdate(t *testing.T) {
+	t.Parallel()
+
+	limiter, err := ratelimit.New(config.RateLimitParams{})
+	require.NoError(t, err)
+
+	require.NoError(t, limiter.Update(config.RateLimitParams{
+		Rate:  0.001,
+		Burst: 1,
+	}))
+	require.True(t, limiter.Enabled())
+
+	assert.True(t, limiter.Allow("alice@example.com"))
+	assert.False(t, limiter.Allow("alice@example.com"))
+
+	// the invalid limits are not applied
+	assert.ErrorContains(t, limiter.Update(config.RateLimitParams{Rate: -1, Burst: 1}), "invalid rate limits")
+	assert.False(t, limiter.Allow("alice@example.com"))
+
+	require.NoError(t, limiter.Update(config.RateLimitParams{}))
+	require.False(t, limiter.Enabled())
+
+	assert.True(t, limiter.Allow("alice@example.com"))
+}
+
+func TestLimiterUnary(t *testing.T) {
+	t.Parallel()
+
+	limiter, err := ratelimit.New(config.RateLimitParams{
+		Rate:  0.001,
+		Burst: 1,
+	})
+	require.NoError(t, err)
+
+	ctx := context.WithValue(context.Background(), auth.IdentityContextKey{}, "alice@example.com")
+	info := &grpc.UnaryServerInfo{FullMethod: "/management.ManagementService/Kubeconfig"}
+
+	handler := func(context.Context, any) (any, error) {
+		return "ok", nil
+	}
+
+	resp, err := limiter.Unary()(ctx, nil, info, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+
+	_, err = limiter.Unary()(ctx, nil, info, handler)
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
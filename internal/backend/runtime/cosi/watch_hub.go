@@ -0,0 +1,448 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package cosi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/siderolabs/gen/channel"
+	"github.com/siderolabs/gen/maps"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/api/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/panichandler"
+	"github.com/siderolabs/omni/internal/backend/runtime"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+)
+
+// maxPendingResponses is the number of the responses a subscriber can fall behind the shared watch before it's disconnected.
+const maxPendingResponses = 1024
+
+// errSlowSubscriber is returned to the subscriber which doesn't keep up with the shared watch, the client is expected to watch again.
+var errSlowSubscriber = errors.New("watch is too slow to keep up with the resource updates")
+
+// WatchAuthorizer checks if the watch is allowed for the caller.
+type WatchAuthorizer func(ctx context.Context, md resource.Metadata, queries []resource.LabelQuery) error
+
+// WatchHub serves the identical watches from a single shared watch of the state.
+//
+// The shared watch keeps the latest version of the watched resources in memory,
+// so the new subscribers get the current resources without reading them from the state,
+// and the resources are encoded once for all the subscribers.
+// The shared watch is started by the first subscriber and stopped when the last subscriber leaves.
+//
+// The shared watch runs as the internal actor, so the access of each subscriber is checked by the authorizer when it joins.
+type WatchHub struct {
+	st        state.State
+	authorize WatchAuthorizer
+	logger    *zap.Logger
+	watches   map[sharedWatchKey]*sharedWatch
+
+	sharedWatches   prometheus.Gauge
+	subscribers     prometheus.Gauge
+	slowSubscribers prometheus.Counter
+
+	mu sync.Mutex
+}
+
+// Check interfaces.
+var _ prometheus.Collector = &WatchHub{}
+
+// NewWatchHub creates a new WatchHub.
+func NewWatchHub(st state.State, authorize WatchAuthorizer, logger *zap.Logger) *WatchHub {
+	return &WatchHub{
+		st:        st,
+		authorize: authorize,
+		logger:    logger,
+		watches:   map[sharedWatchKey]*sharedWatch{},
+
+		sharedWatches: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "omni_shared_watches",
+			Help: "Number of the resource watches shared by the API clients.",
+		}),
+		subscribers: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "omni_shared_watch_subscribers",
+			Help: "Number of the API client watches served from the shared watches.",
+		}),
+		slowSubscribers: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "omni_shared_watch_slow_subscribers_total",
+			Help: "Number of the API client watches disconnected for not keeping up with the shared watch.",
+		}),
+	}
+}
+
+// Watch is the same as the Watch function, but the watch is shared with the other identical watches.
+//
+// The watches with the tail events are not shared, as the tail events are different for each of them.
+func (hub *WatchHub) Watch(ctx context.Context, md resource.Metadata, out chan<- runtime.WatchResponse, tailEvents int, queries []resource.LabelQuery) error {
+	if tailEvents != 0 {
+		return Watch(ctx, hub.st, md, out, tailEvents, queries)
+	}
+
+	if err := hub.authorize(ctx, md, queries); err != nil {
+		return err
+	}
+
+	watch, sub := hub.subscribe(md, queries)
+	defer hub.unsubscribe(watch, sub)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-sub.signal:
+		}
+
+		responses, err := sub.drain()
+
+		for _, resp := range responses {
+			if !channel.SendWithContext(ctx, out, resp) {
+				return nil
+			}
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Describe implements prometheus.Collector interface.
+func (hub *WatchHub) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(hub, ch)
+}
+
+// Collect implements prometheus.Collector interface.
+func (hub *WatchHub) Collect(ch chan<- prometheus.Metric) {
+	hub.mu.Lock()
+
+	hub.sharedWatches.Set(float64(len(hub.watches)))
+
+	var subscribers int
+
+	for _, watch := range hub.watches {
+		watch.mu.Lock()
+		subscribers += len(watch.subscribers)
+		watch.mu.Unlock()
+	}
+
+	hub.mu.Unlock()
+
+	hub.subscribers.Set(float64(subscribers))
+
+	hub.sharedWatches.Collect(ch)
+	hub.subscribers.Collect(ch)
+	hub.slowSubscribers.Collect(ch)
+}
+
+func (hub *WatchHub) subscribe(md resource.Metadata, queries []resource.LabelQuery) (*sharedWatch, *watchSubscriber) {
+	key := sharedWatchKey{
+		namespace: md.Namespace(),
+		typ:       md.Type(),
+		id:        md.ID(),
+		queries:   fmt.Sprintf("%v", queries),
+	}
+
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	watch, ok := hub.watches[key]
+	if !ok {
+		ctx, cancel := context.WithCancel(actor.MarkContextAsInternalActor(context.Background()))
+
+		watch = &sharedWatch{
+			key:         key,
+			cancel:      cancel,
+			items:       map[resource.ID]*sharedItem{},
+			subscribers: map[*watchSubscriber]struct{}{},
+		}
+
+		hub.watches[key] = watch
+
+		panichandler.Go(func() {
+			hub.run(ctx, watch, md, queries)
+		}, hub.logger)
+	}
+
+	return watch, watch.subscribe()
+}
+
+func (hub *WatchHub) unsubscribe(watch *sharedWatch, sub *watchSubscriber) {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	watch.mu.Lock()
+	defer watch.mu.Unlock()
+
+	delete(watch.subscribers, sub)
+
+	if len(watch.subscribers) > 0 {
+		return
+	}
+
+	watch.cancel()
+
+	if hub.watches[watch.key] == watch {
+		delete(hub.watches, watch.key)
+	}
+}
+
+// run watches the state until the last subscriber leaves, the subscribers get the error if the watch fails.
+func (hub *WatchHub) run(ctx context.Context, watch *sharedWatch, md resource.Metadata, queries []resource.LabelQuery) {
+	events := make(chan runtime.WatchResponse)
+	errCh := make(chan error, 1)
+
+	panichandler.Go(func() {
+		errCh <- Watch(ctx, hub.st, md, events, 0, queries)
+	}, hub.logger)
+
+	for {
+		select {
+		case resp := <-events:
+			if slow := watch.publish(resp); slow > 0 {
+				hub.slowSubscribers.Add(float64(slow))
+			}
+		case err := <-errCh:
+			if ctx.Err() != nil {
+				return
+			}
+
+			if err == nil {
+				err = errors.New("watch closed")
+			}
+
+			hub.logger.Warn("shared watch failed", zap.String("type", md.Type()), zap.Error(err))
+
+			// the new subscribers start a new watch
+			hub.mu.Lock()
+
+			if hub.watches[watch.key] == watch {
+				delete(hub.watches, watch.key)
+			}
+
+			hub.mu.Unlock()
+
+			watch.fail(err)
+
+			return
+		}
+	}
+}
+
+type sharedWatchKey struct {
+	namespace resource.Namespace
+	typ       resource.Type
+	id        resource.ID
+	queries   string
+}
+
+// sharedWatch is the watch of the state shared by the subscribers.
+type sharedWatch struct {
+	cancel      context.CancelFunc
+	items       map[resource.ID]*sharedItem
+	subscribers map[*watchSubscriber]struct{}
+	err         error
+	key         sharedWatchKey
+	mu          sync.Mutex
+
+	bootstrapped bool
+}
+
+// sharedItem is the latest version of the watched resource.
+type sharedItem struct {
+	res resource.Resource
+
+	// created is the response sent to the new subscribers, it's encoded on the first use
+	created runtime.WatchResponse
+}
+
+func (item *sharedItem) createdResponse() (runtime.WatchResponse, error) {
+	if item.created != nil {
+		return item.created, nil
+	}
+
+	ev, err := runtime.NewWatchResponseFromCOSIEvent(state.Event{
+		Type:     state.Created,
+		Resource: item.res,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	item.created = NewResponse(item.res.Metadata().ID(), item.res.Metadata().Namespace(), ev, item.res)
+
+	return item.created, nil
+}
+
+// subscribe adds the subscriber which gets the current resources first, and then the updates.
+func (watch *sharedWatch) subscribe() *watchSubscriber {
+	watch.mu.Lock()
+	defer watch.mu.Unlock()
+
+	sub := &watchSubscriber{
+		signal: make(chan struct{}, 1),
+	}
+
+	watch.subscribers[sub] = struct{}{}
+
+	if watch.err != nil {
+		sub.fail(watch.err)
+
+		return sub
+	}
+
+	ids := maps.Keys(watch.items)
+	slices.Sort(ids)
+
+	pending := make([]runtime.WatchResponse, 0, len(ids)+1)
+
+	for _, id := range ids {
+		resp, err := watch.items[id].createdResponse()
+		if err != nil {
+			sub.fail(err)
+
+			return sub
+		}
+
+		pending = append(pending, resp)
+	}
+
+	if watch.bootstrapped {
+		pending = append(pending, newBooststrappedResponse())
+	}
+
+	sub.pending = pending
+	sub.limit = len(pending) + maxPendingResponses
+	sub.notify()
+
+	return sub
+}
+
+// publish updates the resources and sends the response to the subscribers, the number of the disconnected slow subscribers is returned.
+func (watch *sharedWatch) publish(resp runtime.WatchResponse) int {
+	watch.mu.Lock()
+	defer watch.mu.Unlock()
+
+	switch runtime.EventType(resp) {
+	case resources.EventType_CREATED, resources.EventType_UPDATED:
+		item := &sharedItem{}
+
+		if r, ok := resp.(*watchResponse); ok {
+			item.res = r.res
+		}
+
+		if runtime.EventType(resp) == resources.EventType_CREATED {
+			item.created = resp
+		}
+
+		if item.res != nil {
+			watch.items[resp.ID()] = item
+		}
+	case resources.EventType_DESTROYED:
+		delete(watch.items, resp.ID())
+	case resources.EventType_BOOTSTRAPPED:
+		watch.bootstrapped = true
+	case resources.EventType_UNKNOWN:
+	}
+
+	var slow int
+
+	for sub := range watch.subscribers {
+		if !sub.push(resp) {
+			slow++
+		}
+	}
+
+	return slow
+}
+
+func (watch *sharedWatch) fail(err error) {
+	watch.mu.Lock()
+	defer watch.mu.Unlock()
+
+	watch.err = err
+
+	for sub := range watch.subscribers {
+		sub.fail(err)
+	}
+}
+
+// watchSubscriber is the queue of the responses for a single client watch.
+type watchSubscriber struct {
+	signal  chan struct{}
+	err     error
+	pending []runtime.WatchResponse
+	limit   int
+	mu      sync.Mutex
+}
+
+// push queues the response, false is returned if the subscriber fell behind and was disconnected.
+func (sub *watchSubscriber) push(resp runtime.WatchResponse) bool {
+	sub.mu.Lock()
+
+	if sub.err != nil {
+		sub.mu.Unlock()
+
+		return true
+	}
+
+	if len(sub.pending) >= sub.limit {
+		sub.err = errSlowSubscriber
+		sub.pending = nil
+		sub.mu.Unlock()
+
+		sub.notify()
+
+		return false
+	}
+
+	sub.pending = append(sub.pending, resp)
+	sub.mu.Unlock()
+
+	sub.notify()
+
+	return true
+}
+
+func (sub *watchSubscriber) fail(err error) {
+	sub.mu.Lock()
+
+	if sub.err == nil {
+		sub.err = err
+	}
+
+	sub.mu.Unlock()
+
+	sub.notify()
+}
+
+func (sub *watchSubscriber) notify() {
+	select {
+	case sub.signal <- struct{}{}:
+	default:
+	}
+}
+
+// drain returns the queued responses, and the error if the subscriber was disconnected.
+func (sub *watchSubscriber) drain() ([]runtime.WatchResponse, error) {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+
+	pending := sub.pending
+	sub.pending = nil
+
+	// the limit applies to the responses queued after the drain
+	sub.limit = maxPendingResponses
+
+	return pending, sub.err
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package cosi_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/omni/client/api/omni/resources"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime"
+	"github.com/siderolabs/omni/internal/backend/runtime/cosi"
+)
+
+func TestWatchHub(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	require.NoError(t, st.Create(ctx, omni.NewCluster(omnires.DefaultNamespace, "a")))
+
+	errDenied := errors.New("denied")
+
+	hub := cosi.NewWatchHub(st, func(_ context.Context, md resource.Metadata, _ []resource.LabelQuery) error {
+		if md.ID() == "secret" {
+			return errDenied
+		}
+
+		return nil
+	}, zaptest.NewLogger(t))
+
+	md := resource.NewMetadata(omnires.DefaultNamespace, omni.ClusterType, "", resource.VersionUndefined)
+
+	watch := func(ctx context.Context) <-chan runtime.WatchResponse {
+		ch := make(chan runtime.WatchResponse)
+
+		go func() {
+			assert.NoError(t, hub.Watch(ctx, md, ch, 0, nil))
+		}()
+
+		return ch
+	}
+
+	expectEvent := func(ch <-chan runtime.WatchResponse, eventType resources.EventType, id string) {
+		t.Helper()
+
+		select {
+		case <-ctx.Done():
+			require.FailNow(t, "timeout")
+		case resp := <-ch:
+			assert.Equal(t, eventType, runtime.EventType(resp))
+			assert.Equal(t, id, resp.ID())
+		}
+	}
+
+	firstCtx, firstCancel := context.WithCancel(ctx)
+
+	first := watch(firstCtx)
+
+	expectEvent(first, resources.EventType_CREATED, "a")
+	expectEvent(first, resources.EventType_BOOTSTRAPPED, "")
+
+	second := watch(ctx)
+
+	// the second watch gets the current resources from the shared watch
+	expectEvent(second, resources.EventType_CREATED, "a")
+	expectEvent(second, resources.EventType_BOOTSTRAPPED, "")
+
+	require.NoError(t, st.Create(ctx, omni.NewCluster(omnires.DefaultNamespace, "b")))
+
+	expectEvent(first, resources.EventType_CREATED, "b")
+	expectEvent(second, resources.EventType_CREATED, "b")
+
+	assert.NoError(t, testutil.CollectAndCompare(hub, strings.NewReader(`
+# HELP omni_shared_watch_subscribers Number of the API client watches served from the shared watches.
+# TYPE omni_shared_watch_subscribers gauge
+omni_shared_watch_subscribers 2
+# HELP omni_shared_watches Number of the resource watches shared by the API clients.
+# TYPE omni_shared_watches gauge
+omni_shared_watches 1
+`), "omni_shared_watches", "omni_shared_watch_subscribers"))
+
+	firstCancel()
+
+	require.NoError(t, st.Destroy(ctx, omni.NewCluster(omnires.DefaultNamespace, "a").Metadata()))
+
+	expectEvent(second, resources.EventType_DESTROYED, "a")
+
+	// the watch which joins later doesn't get the destroyed resources
+	third := watch(ctx)
+
+	expectEvent(third, resources.EventType_CREATED, "b")
+	expectEvent(third, resources.EventType_BOOTSTRAPPED, "")
+
+	// the access is checked for each watch
+	require.ErrorIs(t, hub.Watch(ctx, resource.NewMetadata(omnires.DefaultNamespace, omni.ClusterType, "secret", resource.VersionUndefined),
+		make(chan runtime.WatchResponse), 0, nil), errDenied)
+}
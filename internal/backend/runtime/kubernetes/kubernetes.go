@@ -41,6 +41,7 @@ import (
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/client/pkg/panichandler"
 	pkgruntime "github.com/siderolabs/omni/client/pkg/runtime"
+	"github.com/siderolabs/omni/internal/backend/dryrun"
 	"github.com/siderolabs/omni/internal/backend/oidc/external"
 	"github.com/siderolabs/omni/internal/backend/runtime"
 	"github.com/siderolabs/omni/internal/backend/runtime/helpers"
@@ -353,7 +354,14 @@ func (r *Runtime) GetKubeconfig(ctx context.Context, context *common.Context) (*
 		return nil, err
 	}
 
-	return clientcmd.RESTConfigFromKubeConfig(kubeconfig.TypedSpec().Value.Data)
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig.TypedSpec().Value.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	dryrun.WrapKubernetesConfig(restConfig)
+
+	return restConfig, nil
 }
 
 // GetClient returns K8s client.
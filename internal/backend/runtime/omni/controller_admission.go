@@ -0,0 +1,154 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+// controllerPriority defines which reconciles are delayed first when the controller runtime is overloaded.
+type controllerPriority string
+
+const (
+	// priorityHigh reconciles are never delayed.
+	priorityHigh controllerPriority = "high"
+	// priorityNormal reconciles are delayed when the runtime is overloaded twice over the limit.
+	priorityNormal controllerPriority = "normal"
+	// priorityLow reconciles are delayed as soon as the runtime is overloaded.
+	priorityLow controllerPriority = "low"
+)
+
+// controllerPriorities are the priorities of the controllers by controller name, the other controllers have the normal priority.
+var controllerPriorities = map[string]controllerPriority{
+	"ClusterAvailabilityController":  priorityHigh,
+	"ClusterMachineStatusController": priorityHigh,
+	"ClusterStatusController":        priorityHigh,
+	"ControlPlaneStatusController":   priorityHigh,
+	"KubernetesStatusController":     priorityHigh,
+	"MachineSetStatusController":     priorityHigh,
+	"MachineStatusController":        priorityHigh,
+	"MachineStatusLinkController":    priorityHigh,
+
+	"ClusterMachineStatusMetricsController": priorityLow,
+	"ClusterStatusHistoryController":        priorityLow,
+	"ClusterStatusMetricsController":        priorityLow,
+	"KeyPrunerController":                   priorityLow,
+	"MachineCleanupController":              priorityLow,
+	"MachineSetScalingHistoryController":    priorityLow,
+	"MachineStatusMetricsController":        priorityLow,
+}
+
+// controllerAdmission tracks the reconciles running at the same time and delays the low priority ones when there are too many of them.
+type controllerAdmission struct {
+	priorities map[string]controllerPriority
+
+	shed       *prometheus.CounterVec
+	overloaded prometheus.Gauge
+	inFlight   prometheus.Gauge
+
+	running     atomic.Int64
+	maxInFlight int64
+	shedDelay   time.Duration
+}
+
+// defaultShedDelay is used if the shed delay is not set.
+const defaultShedDelay = time.Second
+
+func newControllerAdmission(params config.ControllerAdmissionParams, priorities map[string]controllerPriority) *controllerAdmission {
+	shedDelay := params.ShedDelay
+	if shedDelay <= 0 {
+		shedDelay = defaultShedDelay
+	}
+
+	return &controllerAdmission{
+		priorities:  priorities,
+		maxInFlight: int64(params.MaxInFlight),
+		shedDelay:   shedDelay,
+		shed: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "omni_controller_reconciles_shed_total",
+				Help: "Number of the controller reconciles delayed due to the overload by controller name and priority.",
+			},
+			[]string{"controller", "priority"},
+		),
+		overloaded: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Name: "omni_controller_overloaded",
+				Help: "Whether the controller runtime is overloaded and the low priority reconciles are delayed.",
+			},
+		),
+		inFlight: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Name: "omni_controller_reconciles_in_flight",
+				Help: "Number of the controller reconciles running at the same time.",
+			},
+		),
+	}
+}
+
+func (a *controllerAdmission) priority(name string) controllerPriority {
+	if priority, ok := a.priorities[name]; ok {
+		return priority
+	}
+
+	return priorityNormal
+}
+
+// admit checks if the reconcile of the controller can start now, the shed reconciles are counted.
+func (a *controllerAdmission) admit(name string) bool {
+	if a.maxInFlight <= 0 {
+		return true
+	}
+
+	priority := a.priority(name)
+	running := a.running.Load()
+
+	var admitted bool
+
+	switch priority {
+	case priorityHigh:
+		admitted = true
+	case priorityLow:
+		admitted = running < a.maxInFlight
+	case priorityNormal:
+		admitted = running < 2*a.maxInFlight
+	}
+
+	if !admitted {
+		a.shed.WithLabelValues(name, string(priority)).Inc()
+	}
+
+	return admitted
+}
+
+func (a *controllerAdmission) start() {
+	a.running.Add(1)
+}
+
+func (a *controllerAdmission) finish() {
+	a.running.Add(-1)
+}
+
+func (a *controllerAdmission) collect(ch chan<- prometheus.Metric) {
+	running := a.running.Load()
+
+	a.inFlight.Set(float64(running))
+
+	if a.maxInFlight > 0 && running >= a.maxInFlight {
+		a.overloaded.Set(1)
+	} else {
+		a.overloaded.Set(0)
+	}
+
+	a.shed.Collect(ch)
+	a.overloaded.Collect(ch)
+	a.inFlight.Collect(ch)
+}
@@ -0,0 +1,276 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/controller/runtime/metrics"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/pkg/panichandler"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+// controllerMetrics instruments the controllers with the reconcile latency, the reconcile errors and the input queue depth metrics.
+//
+// It also delays the low priority reconciles when the controller runtime is overloaded.
+type controllerMetrics struct {
+	admission *controllerAdmission
+
+	reconcileDuration *prometheus.HistogramVec
+	reconcileErrors   *prometheus.CounterVec
+	queueDepth        *prometheus.GaugeVec
+
+	mu           sync.Mutex
+	qcontrollers []string
+}
+
+// Check interfaces.
+var _ prometheus.Collector = &controllerMetrics{}
+
+func newControllerMetrics(admission config.ControllerAdmissionParams, priorities map[string]controllerPriority) *controllerMetrics {
+	return &controllerMetrics{
+		admission: newControllerAdmission(admission, priorities),
+		reconcileDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "omni_controller_reconcile_duration_seconds",
+				Help:    "Duration of the controller reconciles by controller name.",
+				Buckets: prometheus.ExponentialBuckets(0.001, 4, 10),
+			},
+			[]string{"controller"},
+		),
+		reconcileErrors: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "omni_controller_reconcile_errors_total",
+				Help: "Number of the failed controller reconciles by controller name.",
+			},
+			[]string{"controller"},
+		),
+		queueDepth: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "omni_controller_queue_depth",
+				Help: "Number of the input events waiting to be reconciled by controller name.",
+			},
+			[]string{"controller"},
+		),
+	}
+}
+
+// wrapController instruments the controller.
+//
+// The reconcile of a controller is the time between the reconcile event is received and the controller waits for the next one,
+// so it is measured only for the controllers which call EventCh on every iteration of their loop.
+func (m *controllerMetrics) wrapController(ctrl controller.Controller) controller.Controller { //nolint:ireturn
+	return &instrumentedController{
+		Controller: ctrl,
+		metrics:    m,
+	}
+}
+
+// wrapQController instruments the queue controller.
+func (m *controllerMetrics) wrapQController(ctrl controller.QController) controller.QController { //nolint:ireturn
+	m.mu.Lock()
+	m.qcontrollers = append(m.qcontrollers, ctrl.Name())
+	m.mu.Unlock()
+
+	return &instrumentedQController{
+		QController: ctrl,
+		metrics:     m,
+	}
+}
+
+// Describe implements prometheus.Collector interface.
+func (m *controllerMetrics) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(m, ch)
+}
+
+// Collect implements prometheus.Collector interface.
+func (m *controllerMetrics) Collect(ch chan<- prometheus.Metric) {
+	m.mu.Lock()
+
+	// the queue of the queue controllers is maintained by the controller runtime
+	for _, name := range m.qcontrollers {
+		if queueLength, ok := metrics.QControllerQueueLength.Get(name).(*expvar.Int); ok {
+			m.queueDepth.WithLabelValues(name).Set(float64(queueLength.Value()))
+		}
+	}
+
+	m.mu.Unlock()
+
+	m.reconcileDuration.Collect(ch)
+	m.reconcileErrors.Collect(ch)
+	m.queueDepth.Collect(ch)
+
+	m.admission.collect(ch)
+}
+
+type instrumentedController struct {
+	controller.Controller
+
+	metrics *controllerMetrics
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *instrumentedController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	name := ctrl.Name()
+
+	instrumented := &instrumentedRuntime{
+		Runtime:    r,
+		name:       name,
+		admission:  ctrl.metrics.admission,
+		eventCh:    make(chan controller.ReconcileEvent),
+		duration:   ctrl.metrics.reconcileDuration.WithLabelValues(name),
+		queueDepth: ctrl.metrics.queueDepth.WithLabelValues(name),
+	}
+
+	// the reconcile in progress when the controller returns is finished after the events are no longer forwarded
+	defer instrumented.finishReconcile()
+
+	var wg sync.WaitGroup
+
+	defer wg.Wait()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	wg.Add(1)
+
+	panichandler.Go(func() {
+		defer wg.Done()
+
+		instrumented.forwardEvents(ctx, r.EventCh())
+	}, logger)
+
+	err := ctrl.Controller.Run(ctx, instrumented, logger)
+	if err != nil {
+		ctrl.metrics.reconcileErrors.WithLabelValues(name).Inc()
+	}
+
+	return err
+}
+
+// instrumentedRuntime passes the reconcile events to the controller through its own channel to track the reconciles.
+type instrumentedRuntime struct {
+	controller.Runtime
+
+	admission  *controllerAdmission
+	eventCh    chan controller.ReconcileEvent
+	duration   prometheus.Observer
+	queueDepth prometheus.Gauge
+	name       string
+
+	// reconcileStarted is the time the last event was received by the controller in nanoseconds, zero if it was already observed
+	reconcileStarted atomic.Int64
+
+	// reconciling is set while the controller handles the last event
+	reconciling atomic.Bool
+}
+
+// EventCh implements controller.Runtime interface.
+func (r *instrumentedRuntime) EventCh() <-chan controller.ReconcileEvent {
+	// the controller waits for the next event, so the reconcile of the previous one is finished
+	if started := r.reconcileStarted.Swap(0); started != 0 {
+		r.duration.Observe(time.Since(time.Unix(0, started)).Seconds())
+	}
+
+	r.finishReconcile()
+
+	return r.eventCh
+}
+
+func (r *instrumentedRuntime) finishReconcile() {
+	if r.reconciling.Swap(false) {
+		r.admission.finish()
+	}
+}
+
+func (r *instrumentedRuntime) forwardEvents(ctx context.Context, eventCh <-chan controller.ReconcileEvent) {
+	for {
+		var event controller.ReconcileEvent
+
+		select {
+		case <-ctx.Done():
+			return
+		case event = <-eventCh:
+		}
+
+		r.queueDepth.Set(1)
+
+		// the event is held back while the runtime is overloaded, the controller gets it once its priority is admitted
+		for !r.admission.admit(r.name) {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(r.admission.shedDelay):
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case r.eventCh <- event:
+		}
+
+		r.admission.start()
+		r.reconciling.Store(true)
+		r.reconcileStarted.Store(time.Now().UnixNano())
+		r.queueDepth.Set(0)
+	}
+}
+
+type instrumentedQController struct {
+	controller.QController
+
+	metrics *controllerMetrics
+}
+
+// Reconcile implements controller.QController interface.
+func (ctrl *instrumentedQController) Reconcile(ctx context.Context, logger *zap.Logger, r controller.QRuntime, ptr resource.Pointer) error {
+	admission := ctrl.metrics.admission
+
+	if !admission.admit(ctrl.Name()) {
+		return controller.NewRequeueInterval(admission.shedDelay)
+	}
+
+	admission.start()
+	defer admission.finish()
+
+	start := time.Now()
+
+	err := ctrl.QController.Reconcile(ctx, logger, r, ptr)
+
+	ctrl.metrics.reconcileDuration.WithLabelValues(ctrl.Name()).Observe(time.Since(start).Seconds())
+
+	if isReconcileFailure(err) {
+		ctrl.metrics.reconcileErrors.WithLabelValues(ctrl.Name()).Inc()
+	}
+
+	return err
+}
+
+// isReconcileFailure checks if the reconcile failed, the requeue requests without an error are not failures.
+func isReconcileFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var requeueErr *controller.RequeueError
+
+	if errors.As(err, &requeueErr) {
+		return requeueErr.Err() != nil
+	}
+
+	return true
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest"
+
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+type testController struct {
+	reconciles int
+}
+
+func (ctrl *testController) Name() string                 { return "TestController" }
+func (ctrl *testController) Inputs() []controller.Input   { return nil }
+func (ctrl *testController) Outputs() []controller.Output { return nil }
+
+func (ctrl *testController) Run(ctx context.Context, r controller.Runtime, _ *zap.Logger) error {
+	for range ctrl.reconciles {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	return errors.New("crashed")
+}
+
+type testRuntime struct {
+	controller.Runtime
+
+	eventCh chan controller.ReconcileEvent
+}
+
+func (r *testRuntime) EventCh() <-chan controller.ReconcileEvent {
+	return r.eventCh
+}
+
+type testQController struct {
+	controller.QController
+
+	errs []error
+}
+
+func (ctrl *testQController) Name() string { return "TestQController" }
+
+func (ctrl *testQController) Reconcile(context.Context, *zap.Logger, controller.QRuntime, resource.Pointer) error {
+	err := ctrl.errs[0]
+	ctrl.errs = ctrl.errs[1:]
+
+	return err
+}
+
+type blockingQController struct {
+	controller.QController
+
+	started chan struct{}
+	release chan struct{}
+}
+
+func (ctrl *blockingQController) Name() string { return "BlockingQController" }
+
+func (ctrl *blockingQController) Reconcile(context.Context, *zap.Logger, controller.QRuntime, resource.Pointer) error {
+	close(ctrl.started)
+
+	<-ctrl.release
+
+	return nil
+}
+
+func TestControllerMetrics(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	metrics := omni.NewControllerMetrics(config.ControllerAdmissionParams{}, nil)
+
+	r := &testRuntime{eventCh: make(chan controller.ReconcileEvent, 2)}
+	r.eventCh <- controller.ReconcileEvent{}
+	r.eventCh <- controller.ReconcileEvent{}
+
+	// the second reconcile is not observed, as the controller crashes before waiting for the next event
+	require.EqualError(t, omni.WrapControllerWithMetrics(metrics, &testController{reconciles: 2}).Run(ctx, r, zaptest.NewLogger(t)), "crashed")
+
+	qctrl := omni.WrapQControllerWithMetrics(metrics, &testQController{
+		errs: []error{
+			nil,
+			errors.New("failed"),
+			controller.NewRequeueInterval(time.Second),
+		},
+	})
+
+	for range 3 {
+		qctrl.Reconcile(ctx, zaptest.NewLogger(t), nil, omnires.NewCluster("default", "test").Metadata()) //nolint:errcheck
+	}
+
+	assert.NoError(t, testutil.CollectAndCompare(metrics, strings.NewReader(`
+# HELP omni_controller_reconcile_errors_total Number of the failed controller reconciles by controller name.
+# TYPE omni_controller_reconcile_errors_total counter
+omni_controller_reconcile_errors_total{controller="TestController"} 1
+omni_controller_reconcile_errors_total{controller="TestQController"} 1
+`), "omni_controller_reconcile_errors_total"))
+
+	assert.Equal(t, 2, testutil.CollectAndCount(metrics, "omni_controller_reconcile_duration_seconds"))
+}
+
+func TestControllerAdmission(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	metrics := omni.NewControllerMetrics(config.ControllerAdmissionParams{
+		MaxInFlight: 1,
+		ShedDelay:   time.Minute,
+	}, map[string]string{
+		"TestQController": "low",
+	})
+
+	logger := zaptest.NewLogger(t)
+	ptr := omnires.NewCluster("default", "test").Metadata()
+
+	blocking := &blockingQController{
+		started: make(chan struct{}),
+		release: make(chan struct{}),
+	}
+
+	done := make(chan error, 1)
+
+	go func() {
+		done <- omni.WrapQControllerWithMetrics(metrics, blocking).Reconcile(ctx, logger, nil, ptr)
+	}()
+
+	<-blocking.started
+
+	// the low priority reconcile is delayed while the normal priority one is running
+	qctrl := omni.WrapQControllerWithMetrics(metrics, &testQController{errs: []error{nil}})
+
+	err := qctrl.Reconcile(ctx, logger, nil, ptr)
+
+	var requeueErr *controller.RequeueError
+
+	require.ErrorAs(t, err, &requeueErr)
+	assert.NoError(t, requeueErr.Err())
+	assert.Equal(t, time.Minute, requeueErr.Interval())
+
+	assert.NoError(t, testutil.CollectAndCompare(metrics, strings.NewReader(`
+# HELP omni_controller_overloaded Whether the controller runtime is overloaded and the low priority reconciles are delayed.
+# TYPE omni_controller_overloaded gauge
+omni_controller_overloaded 1
+# HELP omni_controller_reconciles_in_flight Number of the controller reconciles running at the same time.
+# TYPE omni_controller_reconciles_in_flight gauge
+omni_controller_reconciles_in_flight 1
+# HELP omni_controller_reconciles_shed_total Number of the controller reconciles delayed due to the overload by controller name and priority.
+# TYPE omni_controller_reconciles_shed_total counter
+omni_controller_reconciles_shed_total{controller="TestQController",priority="low"} 1
+`), "omni_controller_overloaded", "omni_controller_reconciles_in_flight", "omni_controller_reconciles_shed_total"))
+
+	close(blocking.release)
+	require.NoError(t, <-done)
+
+	// the reconcile is admitted once the runtime is no longer overloaded
+	require.NoError(t, qctrl.Reconcile(ctx, logger, nil, ptr))
+
+	assert.NoError(t, testutil.CollectAndCompare(metrics, strings.NewReader(`
+# HELP omni_controller_overloaded Whether the controller runtime is overloaded and the low priority reconciles are delayed.
+# TYPE omni_controller_overloaded gauge
+omni_controller_overloaded 0
+`), "omni_controller_overloaded"))
+}
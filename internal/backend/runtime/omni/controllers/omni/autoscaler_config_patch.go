@@ -0,0 +1,115 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/controller/generic/qtransform"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/xerrors"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/mappers"
+)
+
+// AutoscalerConfigPatchPrefix is the prefix for the system config patch that sets the Kubernetes node provider ID of the cluster machine.
+const AutoscalerConfigPatchPrefix = "950-autoscaler-"
+
+// AutoscalerConfigPatchController creates the system config patch that sets the Kubernetes node provider ID
+// for the cluster machines of the autoscaled machine sets.
+//
+// The cluster-autoscaler matches the nodes to the machines by the provider ID,
+// the kubelet sets it only when the node is registered, so the machines should be added after the autoscaling is enabled.
+type AutoscalerConfigPatchController = qtransform.QController[*omni.ClusterMachine, *omni.ConfigPatch]
+
+// NewAutoscalerConfigPatchController initializes AutoscalerConfigPatchController.
+func NewAutoscalerConfigPatchController() *AutoscalerConfigPatchController {
+	return qtransform.NewQController(
+		qtransform.Settings[*omni.ClusterMachine, *omni.ConfigPatch]{
+			Name: "AutoscalerConfigPatchController",
+			MapMetadataFunc: func(clusterMachine *omni.ClusterMachine) *omni.ConfigPatch {
+				return omni.NewConfigPatch(resources.DefaultNamespace, AutoscalerConfigPatchPrefix+clusterMachine.Metadata().ID())
+			},
+			UnmapMetadataFunc: func(configPatch *omni.ConfigPatch) *omni.ClusterMachine {
+				id := strings.TrimPrefix(configPatch.Metadata().ID(), AutoscalerConfigPatchPrefix)
+
+				return omni.NewClusterMachine(resources.DefaultNamespace, id)
+			},
+			TransformExtraOutputFunc: func(ctx context.Context, r controller.ReaderWriter, _ *zap.Logger, clusterMachine *omni.ClusterMachine, configPatch *omni.ConfigPatch) error {
+				clusterName, ok := clusterMachine.Metadata().Labels().Get(omni.LabelCluster)
+				if !ok {
+					return xerrors.NewTaggedf[qtransform.DestroyOutputTag]("cluster machine doesn't have the cluster label")
+				}
+
+				machineSetName, ok := clusterMachine.Metadata().Labels().Get(omni.LabelMachineSet)
+				if !ok {
+					return xerrors.NewTaggedf[qtransform.DestroyOutputTag]("cluster machine doesn't have the machine set label")
+				}
+
+				machineSet, err := safe.ReaderGetByID[*omni.MachineSet](ctx, r, machineSetName)
+				if err != nil {
+					if state.IsNotFoundError(err) {
+						return xerrors.NewTaggedf[qtransform.DestroyOutputTag]("machine set doesn't exist")
+					}
+
+					return err
+				}
+
+				if machineSet.TypedSpec().Value.Autoscaling == nil {
+					return xerrors.NewTaggedf[qtransform.DestroyOutputTag]("machine set isn't autoscaled")
+				}
+
+				patchYAML, err := renderAutoscalerPatch(clusterMachine.Metadata().ID())
+				if err != nil {
+					return err
+				}
+
+				configPatch.Metadata().Labels().Set(omni.LabelSystemPatch, "")
+				configPatch.Metadata().Labels().Set(omni.LabelCluster, clusterName)
+				configPatch.Metadata().Labels().Set(omni.LabelClusterMachine, clusterMachine.Metadata().ID())
+
+				configPatch.TypedSpec().Value.Data = patchYAML
+
+				return nil
+			},
+		},
+		qtransform.WithExtraMappedInput(
+			mappers.MapMachineSetToLabeledResources[*omni.MachineSet, *omni.ClusterMachine](),
+		),
+		qtransform.WithConcurrency(2),
+		qtransform.WithOutputKind(controller.OutputShared),
+	)
+}
+
+func renderAutoscalerPatch(machineID string) (string, error) {
+	var sb strings.Builder
+
+	encoder := yaml.NewEncoder(&sb)
+
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(map[string]any{
+		"machine": map[string]any{
+			"kubelet": map[string]any{
+				"extraArgs": map[string]string{
+					"provider-id": omni.AutoscalerProviderID(machineID),
+				},
+			},
+		},
+	}); err != nil {
+		return "", fmt.Errorf("failed to encode patch: %w", err)
+	}
+
+	return sb.String(), nil
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+)
+
+type AutoscalerConfigPatchSuite struct {
+	OmniSuite
+}
+
+func (suite *AutoscalerConfigPatchSuite) TestReconcile() {
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewAutoscalerConfigPatchController()))
+
+	machineSet := omni.NewMachineSet(resources.DefaultNamespace, "test-cluster-workers")
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineSet))
+
+	clusterMachine := omni.NewClusterMachine(resources.DefaultNamespace, "machine-1")
+	clusterMachine.Metadata().Labels().Set(omni.LabelCluster, "test-cluster")
+	clusterMachine.Metadata().Labels().Set(omni.LabelMachineSet, machineSet.Metadata().ID())
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, clusterMachine))
+
+	patchID := omnictrl.AutoscalerConfigPatchPrefix + clusterMachine.Metadata().ID()
+
+	rtestutils.AssertNoResource[*omni.ConfigPatch](suite.ctx, suite.T(), suite.state, patchID)
+
+	_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, machineSet.Metadata(), func(res *omni.MachineSet) error {
+		res.TypedSpec().Value.Autoscaling = &specs.MachineSetSpec_Autoscaling{MinSize: 1, MaxSize: 3}
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	rtestutils.AssertResource[*omni.ConfigPatch](suite.ctx, suite.T(), suite.state, patchID, func(r *omni.ConfigPatch, assertion *assert.Assertions) {
+		clusterMachineID, _ := r.Metadata().Labels().Get(omni.LabelClusterMachine)
+
+		assertion.Equal(clusterMachine.Metadata().ID(), clusterMachineID)
+		assertion.Equal(`machine:
+  kubelet:
+    extraArgs:
+      provider-id: omni://machine-1
+`, r.TypedSpec().Value.Data)
+	})
+
+	_, err = safe.StateUpdateWithConflicts(suite.ctx, suite.state, machineSet.Metadata(), func(res *omni.MachineSet) error {
+		res.TypedSpec().Value.Autoscaling = nil
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	rtestutils.AssertNoResource[*omni.ConfigPatch](suite.ctx, suite.T(), suite.state, patchID)
+}
+
+func TestAutoscalerConfigPatchSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(AutoscalerConfigPatchSuite))
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+// ClusterAvailabilityRetentionDays is the number of the days the cluster availability heartbeats are kept for.
+const ClusterAvailabilityRetentionDays = 90
+
+// ClusterAvailabilityController records the availability heartbeats of the clusters.
+//
+// On each heartbeat, the controller checks the ClusterStatus of every cluster and counts
+// if the control plane was reachable and if all machines of the cluster were connected.
+// The heartbeats are aggregated into the daily buckets.
+type ClusterAvailabilityController struct {
+	heartbeatInterval time.Duration
+}
+
+// NewClusterAvailabilityController creates new ClusterAvailabilityController.
+func NewClusterAvailabilityController(heartbeatInterval time.Duration) *ClusterAvailabilityController {
+	return &ClusterAvailabilityController{
+		heartbeatInterval: heartbeatInterval,
+	}
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *ClusterAvailabilityController) Name() string {
+	return "ClusterAvailabilityController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *ClusterAvailabilityController) Inputs() []controller.Input {
+	return []controller.Input{
+		safe.Input[*omni.ClusterStatus](controller.InputWeak),
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *ClusterAvailabilityController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: omni.ClusterAvailabilityType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *ClusterAvailabilityController) Run(ctx context.Context, r controller.Runtime, _ *zap.Logger) error {
+	ticker := time.NewTicker(ctrl.heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+			// the cluster statuses are checked on the heartbeats only
+			continue
+		case t := <-ticker.C:
+			if err := ctrl.heartbeat(ctx, r, t); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (ctrl *ClusterAvailabilityController) heartbeat(ctx context.Context, r controller.Runtime, now time.Time) error {
+	r.StartTrackingOutputs()
+
+	clusterStatuses, err := safe.ReaderListAll[*omni.ClusterStatus](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing cluster statuses: %w", err)
+	}
+
+	day := now.UTC().Truncate(24 * time.Hour)
+
+	for iter := clusterStatuses.Iterator(); iter.Next(); {
+		clusterStatus := iter.Value().TypedSpec().Value
+
+		if err = safe.WriterModify(ctx, r, omni.NewClusterAvailability(resources.DefaultNamespace, iter.Value().Metadata().ID()),
+			func(res *omni.ClusterAvailability) error {
+				spec := res.TypedSpec().Value
+
+				if len(spec.Days) == 0 || !spec.Days[len(spec.Days)-1].Date.AsTime().Equal(day) {
+					spec.Days = append(spec.Days, &specs.ClusterAvailabilitySpec_Day{
+						Date: timestamppb.New(day),
+					})
+				}
+
+				current := spec.Days[len(spec.Days)-1]
+
+				current.Heartbeats++
+
+				if clusterStatus.KubernetesAPIReady {
+					current.ControlPlaneReachable++
+				}
+
+				if machines := clusterStatus.GetMachines(); machines.GetTotal() > 0 && machines.GetConnected() == machines.GetTotal() {
+					current.AllMachinesConnected++
+				}
+
+				retainedSince := day.AddDate(0, 0, -ClusterAvailabilityRetentionDays+1)
+
+				for len(spec.Days) > 0 && spec.Days[0].Date.AsTime().Before(retainedSince) {
+					spec.Days = spec.Days[1:]
+				}
+
+				return nil
+			},
+		); err != nil {
+			return fmt.Errorf("error updating cluster availability: %w", err)
+		}
+	}
+
+	if err = safe.CleanupOutputs[*omni.ClusterAvailability](ctx, r); err != nil {
+		return fmt.Errorf("error cleaning up cluster availabilities: %w", err)
+	}
+
+	return nil
+}
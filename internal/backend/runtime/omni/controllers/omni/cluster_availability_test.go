@@ -0,0 +1,92 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+)
+
+type ClusterAvailabilitySuite struct {
+	OmniSuite
+}
+
+func (suite *ClusterAvailabilitySuite) TestReconcile() {
+	ctx, cancel := context.WithTimeout(suite.ctx, time.Second*20)
+	defer cancel()
+
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterController(omnictrl.NewClusterAvailabilityController(100 * time.Millisecond)))
+
+	clusterName := "cluster1"
+
+	clusterStatus := omni.NewClusterStatus(resources.DefaultNamespace, clusterName)
+	clusterStatus.TypedSpec().Value.KubernetesAPIReady = true
+	clusterStatus.TypedSpec().Value.Machines = &specs.Machines{
+		Total:     3,
+		Connected: 3,
+	}
+
+	suite.Require().NoError(suite.state.Create(ctx, clusterStatus))
+
+	rtestutils.AssertResources(ctx, suite.T(), suite.state, []string{clusterName},
+		func(res *omni.ClusterAvailability, assertion *assert.Assertions) {
+			if !assertion.Len(res.TypedSpec().Value.Days, 1) {
+				return
+			}
+
+			day := res.TypedSpec().Value.Days[0]
+
+			assertion.Equal(time.Now().UTC().Truncate(24*time.Hour), day.Date.AsTime())
+			assertion.GreaterOrEqual(day.Heartbeats, uint32(3))
+			assertion.Equal(day.Heartbeats, day.ControlPlaneReachable)
+			assertion.Equal(day.Heartbeats, day.AllMachinesConnected)
+		},
+	)
+
+	_, err := safe.StateUpdateWithConflicts(ctx, suite.state, clusterStatus.Metadata(), func(res *omni.ClusterStatus) error {
+		res.TypedSpec().Value.KubernetesAPIReady = false
+		res.TypedSpec().Value.Machines.Connected = 2
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	rtestutils.AssertResources(ctx, suite.T(), suite.state, []string{clusterName},
+		func(res *omni.ClusterAvailability, assertion *assert.Assertions) {
+			if !assertion.NotEmpty(res.TypedSpec().Value.Days) {
+				return
+			}
+
+			day := res.TypedSpec().Value.Days[len(res.TypedSpec().Value.Days)-1]
+
+			assertion.Greater(day.Heartbeats, day.ControlPlaneReachable)
+			assertion.Greater(day.Heartbeats, day.AllMachinesConnected)
+		},
+	)
+
+	rtestutils.DestroyAll[*omni.ClusterStatus](ctx, suite.T(), suite.state)
+
+	rtestutils.AssertNoResource[*omni.ClusterAvailability](ctx, suite.T(), suite.state, clusterName)
+}
+
+func TestClusterAvailabilitySuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(ClusterAvailabilitySuite))
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/dryrun"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup/store"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/mappers"
 	"github.com/siderolabs/omni/internal/backend/runtime/talos"
@@ -175,7 +176,7 @@ func recoverEtcdFromBackup(ctx context.Context, r controller.Reader, talosCli *c
 		return fmt.Errorf("failed to get backup data for cluster %q: %w", clusterID, err)
 	}
 
-	backupStore, err := etcdBackupStoreFactory.GetStore()
+	backupStore, err := store.GetClusterStore(etcdBackupStoreFactory, clusterID)
 	if err != nil {
 		return fmt.Errorf("failed to get backup store: %w", err)
 	}
@@ -237,6 +238,7 @@ func getTalosClientForBootstrap(ctx context.Context, r controller.Reader, cluste
 	}
 
 	opts = append(opts, client.WithConfig(omni.NewTalosClientConfig(talosConfig, managementAddress)))
+	opts = append(opts, dryrun.TalosClientOptions()...)
 
 	result, err := client.New(ctx, opts...)
 	if err != nil {
@@ -7,9 +7,11 @@ package omni
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/url"
+	"slices"
 	"strings"
 	"time"
 
@@ -18,7 +20,9 @@ import (
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/crypto/x509"
 	"github.com/siderolabs/gen/xerrors"
+	"github.com/siderolabs/gen/xslices"
 	"github.com/siderolabs/talos/pkg/machinery/config"
 	documentconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/configpatcher"
@@ -34,6 +38,7 @@ import (
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/helpers"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/mappers"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/patchblob"
 	appconfig "github.com/siderolabs/omni/internal/pkg/config"
 	"github.com/siderolabs/omni/internal/pkg/constants"
 )
@@ -64,12 +69,19 @@ func NewClusterMachineConfigController(defaultGenOptions []generate.Option) *Clu
 		qtransform.WithExtraMappedInput(
 			qtransform.MapperSameID[*omni.ClusterMachineConfigPatches, *omni.ClusterMachine](),
 		),
+		qtransform.WithExtraMappedInput(
+			// the blobs are created before they are referenced and never change
+			qtransform.MapperNone[*omni.ConfigPatchBlob](),
+		),
 		qtransform.WithExtraMappedInput(
 			qtransform.MapperSameID[*omni.MachineSetNode, *omni.ClusterMachine](),
 		),
 		qtransform.WithExtraMappedInput(
 			qtransform.MapperSameID[*omni.MachineConfigGenOptions, *omni.ClusterMachine](),
 		),
+		qtransform.WithExtraMappedInput(
+			qtransform.MapperSameID[*omni.MachineStatus, *omni.ClusterMachine](),
+		),
 		qtransform.WithExtraMappedInput(
 			mappers.MapClusterResourceToLabeledResources[*omni.Cluster, *omni.ClusterMachine](),
 		),
@@ -180,6 +192,25 @@ func reconcileClusterMachineConfig(
 		return err
 	}
 
+	// the blobs are immutable, so the version of the ClusterMachineConfigPatches covers the resolved patches
+	patches, err := patchblob.Resolve(ctx, r, clusterMachineConfigPatches)
+	if err != nil {
+		return err
+	}
+
+	var templateData *omni.ConfigPatchTemplateData
+
+	if slices.ContainsFunc(patches, omni.IsConfigPatchTemplate) {
+		templateData, err = ConfigPatchTemplateData(ctx, r, clusterMachine)
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				return xerrors.NewTagged[qtransform.SkipReconcileTag](err)
+			}
+
+			return err
+		}
+	}
+
 	inputs := []resource.Resource{
 		secrets,
 		clusterMachine,
@@ -189,7 +220,21 @@ func reconcileClusterMachineConfig(
 		machineConfigGenOptions,
 	}
 
-	if !helpers.UpdateInputsVersions(machineConfig, inputs...) {
+	inputVersions := xslices.Map(inputs, func(input resource.Resource) string {
+		return fmt.Sprintf("%s/%s@%s", input.Metadata().Type(), input.Metadata().ID(), input.Metadata().Version())
+	})
+
+	// the machine status changes often, so the config is regenerated only when the facts used by the templates change
+	if templateData != nil {
+		data, marshalErr := json.Marshal(templateData)
+		if marshalErr != nil {
+			return marshalErr
+		}
+
+		inputVersions = append(inputVersions, "template:"+string(data))
+	}
+
+	if !helpers.UpdateInputsAnnotation(machineConfig, inputVersions...) {
 		return xerrors.NewTagged[qtransform.SkipReconcileTag](errors.New("config inputs not changed"))
 	}
 
@@ -222,7 +267,7 @@ func reconcileClusterMachineConfig(
 
 	var helper clusterMachineConfigControllerHelper
 
-	machineConfig.TypedSpec().Value.Data, err = helper.generateConfig(clusterMachine, clusterMachineConfigPatches, secrets, loadBalancerConfig,
+	machineConfig.TypedSpec().Value.Data, err = helper.generateConfig(clusterMachine, patches, templateData, secrets, loadBalancerConfig,
 		cluster, clusterConfigVersion, machineConfigGenOptions, defaultGenOptions)
 	if err != nil {
 		machineConfig.TypedSpec().Value.GenerationError = err.Error()
@@ -236,9 +281,36 @@ func reconcileClusterMachineConfig(
 	return nil
 }
 
+// ConfigPatchTemplateData returns the facts of the cluster machine the config patch templates are rendered with.
+func ConfigPatchTemplateData(ctx context.Context, r controller.Reader, clusterMachine *omni.ClusterMachine) (*omni.ConfigPatchTemplateData, error) {
+	machineStatus, err := safe.ReaderGetByID[*omni.MachineStatus](ctx, r, clusterMachine.Metadata().ID())
+	if err != nil {
+		return nil, err
+	}
+
+	clusterName, _ := clusterMachine.Metadata().Labels().Get(omni.LabelCluster)
+
+	labels := map[string]string{}
+
+	for key, value := range machineStatus.Metadata().Labels().Raw() {
+		if !strings.HasPrefix(key, omni.SystemLabelPrefix) {
+			labels[key] = value
+		}
+	}
+
+	return &omni.ConfigPatchTemplateData{
+		Labels:    labels,
+		MachineID: clusterMachine.Metadata().ID(),
+		Hostname:  machineStatus.TypedSpec().Value.GetNetwork().GetHostname(),
+		Cluster:   clusterName,
+		Addresses: machineStatus.TypedSpec().Value.GetNetwork().GetAddresses(),
+	}, nil
+}
+
 type clusterMachineConfigControllerHelper struct{}
 
-func (clusterMachineConfigControllerHelper) generateConfig(clusterMachine *omni.ClusterMachine, clusterMachineConfigPatches *omni.ClusterMachineConfigPatches, secrets *omni.ClusterSecrets,
+func (clusterMachineConfigControllerHelper) generateConfig(clusterMachine *omni.ClusterMachine, patches []string,
+	templateData *omni.ConfigPatchTemplateData, secrets *omni.ClusterSecrets,
 	loadbalancer *omni.LoadBalancerConfig, cluster *omni.Cluster, clusterConfigVersion *omni.ClusterConfigVersion, configGenOptions *omni.MachineConfigGenOptions, extraGenOptions []generate.Option,
 ) ([]byte, error) {
 	clusterName := cluster.Metadata().ID()
@@ -315,14 +387,35 @@ func (clusterMachineConfigControllerHelper) generateConfig(clusterMachine *omni.
 		return nil, err
 	}
 
-	patches, err := configpatcher.LoadPatches(clusterMachineConfigPatches.TypedSpec().Value.Patches)
-	if err != nil {
-		return nil, err
+	// the machines accept the additional Talos CA while the Talos secrets are rotated
+	if extraCA := secrets.TypedSpec().Value.GetTalosSecretsRotation().GetExtraCaCrt(); len(extraCA) != 0 {
+		cfg.RawV1Alpha1().MachineConfig.MachineAcceptedCAs = []*x509.PEMEncodedCertificate{{Crt: extraCA}}
 	}
 
-	patched, err := configpatcher.Apply(configpatcher.WithConfig(cfg), patches)
-	if err != nil {
-		return nil, err
+	// the patches are applied one by one to point to the patch which can't be applied,
+	// each document of a multi-document patch is merged into the document of the same kind and name
+	patched := configpatcher.WithConfig(cfg)
+
+	for i, rawPatch := range patches {
+		var patch configpatcher.Patch
+
+		// the templates are rendered with the machine facts before they are merged
+		if templateData != nil {
+			rawPatch, err = omni.RenderConfigPatchTemplate(rawPatch, *templateData)
+			if err != nil {
+				return nil, fmt.Errorf("failed to render config patch %d: %w", i, err)
+			}
+		}
+
+		patch, err = configpatcher.LoadPatch([]byte(rawPatch))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load config patch %d: %w", i, err)
+		}
+
+		patched, err = configpatcher.Apply(patched, []configpatcher.Patch{patch})
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply config patch %d: %w", i, err)
+		}
 	}
 
 	patchedConfig, err := patched.Config()
@@ -12,7 +12,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
-	"io"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -26,6 +26,7 @@ import (
 	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/client"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
+	configres "github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -35,7 +36,10 @@ import (
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/siderolink"
+	"github.com/siderolabs/omni/internal/backend/dryrun"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/helpers"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/applyqueue"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/clientpool"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/mappers"
 	talosutils "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/talos"
 	"github.com/siderolabs/omni/internal/backend/runtime/talos"
@@ -44,6 +48,15 @@ import (
 const (
 	gracefulResetAttemptCount = 4
 	etcdLeaveAttemptsLimit    = 2
+
+	// configBackupsLimit is the number of the latest config backups kept for each machine.
+	configBackupsLimit = 5
+
+	// talosClientPoolSize is the number of the machine Talos clients kept open between the reconciles.
+	talosClientPoolSize = 256
+
+	// talosClientIdleTimeout is the time after which the unused machine Talos client is closed.
+	talosClientIdleTimeout = 5 * time.Minute
 )
 
 // ClusterMachineConfigStatusController manages ClusterMachineStatus resource lifecycle.
@@ -53,12 +66,23 @@ type ClusterMachineConfigStatusController = qtransform.QController[*omni.Cluster
 
 // NewClusterMachineConfigStatusController initializes ClusterMachineConfigStatusController.
 //
+// The applyConcurrency limits the number of the machine configs applied concurrently across all clusters.
+// If remediateSchematicDrift is false, the machines running another schematic than the one installed by Omni are not upgraded back.
+//
 //nolint:gocognit,gocyclo,cyclop
-func NewClusterMachineConfigStatusController() *ClusterMachineConfigStatusController {
+func NewClusterMachineConfigStatusController(applyConcurrency int, remediateSchematicDrift bool) *ClusterMachineConfigStatusController {
 	ongoingResets := &ongoingResets{
 		statuses: map[string]*resetStatus{},
 	}
 
+	applyQueue := applyqueue.New(applyConcurrency)
+
+	clientPool := clientpool.New(talosClientPoolSize, talosClientIdleTimeout, func(ctx context.Context, c *client.Client) error {
+		_, err := c.Version(ctx)
+
+		return err
+	})
+
 	return qtransform.NewQController(
 		qtransform.Settings[*omni.ClusterMachineConfig, *omni.ClusterMachineConfigStatus]{
 			Name: "ClusterMachineConfigStatusController",
@@ -68,11 +92,16 @@ func NewClusterMachineConfigStatusController() *ClusterMachineConfigStatusContro
 			UnmapMetadataFunc: func(machineConfigStatus *omni.ClusterMachineConfigStatus) *omni.ClusterMachineConfig {
 				return omni.NewClusterMachineConfig(resources.DefaultNamespace, machineConfigStatus.Metadata().ID())
 			},
-			TransformFunc: func(ctx context.Context, r controller.Reader, logger *zap.Logger, machineConfig *omni.ClusterMachineConfig, configStatus *omni.ClusterMachineConfigStatus) error {
+			TransformExtraOutputFunc: func(ctx context.Context, r controller.ReaderWriter, logger *zap.Logger, machineConfig *omni.ClusterMachineConfig,
+				configStatus *omni.ClusterMachineConfigStatus,
+			) error {
 				handler := clusterMachineConfigStatusControllerHandler{
 					r:             r,
+					w:             r,
 					logger:        logger,
 					ongoingResets: ongoingResets,
+					applyQueue:    applyQueue,
+					clientPool:    clientPool,
 				}
 
 				if machineConfig.TypedSpec().Value.GenerationError != "" {
@@ -103,6 +132,15 @@ func NewClusterMachineConfigStatusController() *ClusterMachineConfigStatusContro
 					return xerrors.NewTaggedf[qtransform.SkipReconcileTag]("'%s' machine is not connected", machineConfig.Metadata().ID())
 				}
 
+				paused, err := clusterPaused(ctx, r, machineConfig)
+				if err != nil {
+					return err
+				}
+
+				if paused {
+					return xerrors.NewTaggedf[qtransform.SkipReconcileTag]("'%s' cluster is paused", machineConfig.Metadata().ID())
+				}
+
 				if machineStatus.TypedSpec().Value.Schematic == nil {
 					logger.Error("machine schematic is not set, skip reconcile")
 
@@ -130,11 +168,27 @@ func NewClusterMachineConfigStatusController() *ClusterMachineConfigStatusContro
 					expectedSchematic = ""
 				}
 
-				versionMismatch := strings.TrimLeft(machineStatus.TypedSpec().Value.TalosVersion, "v") != configStatus.TypedSpec().Value.TalosVersion ||
-					configStatus.TypedSpec().Value.TalosVersion != installImage.TalosVersion ||
+				talosVersionMismatch := strings.TrimLeft(machineStatus.TypedSpec().Value.TalosVersion, "v") != configStatus.TypedSpec().Value.TalosVersion ||
+					configStatus.TypedSpec().Value.TalosVersion != installImage.TalosVersion
+
+				versionMismatch := talosVersionMismatch ||
 					configStatus.TypedSpec().Value.SchematicId != expectedSchematic ||
 					machineStatus.TypedSpec().Value.Schematic.Id != expectedSchematic
 
+				// the machine was upgraded to the expected schematic, but it's running another one now: it is reported by SchematicDriftStatusController
+				schematicDrifted := !talosVersionMismatch &&
+					configStatus.TypedSpec().Value.SchematicId == expectedSchematic &&
+					runningSchematicID(machineStatus) != expectedSchematic
+
+				if schematicDrifted && !remediateSchematicDrift {
+					logger.Debug("the machine schematic is drifted, the remediation is disabled",
+						zap.String("expected_schematic", expectedSchematic),
+						zap.String("running_schematic", runningSchematicID(machineStatus)),
+					)
+
+					versionMismatch = false
+				}
+
 				// don't run the upgrade check if the running version and expected versions match
 				if versionMismatch && installImage.TalosVersion != "" {
 					inSync, err := handler.syncInstallImageAndSchematic(ctx, configStatus, machineStatus, machineConfig, statusSnapshot, installImage)
@@ -187,6 +241,7 @@ func NewClusterMachineConfigStatusController() *ClusterMachineConfigStatusContro
 					r:             r,
 					logger:        logger,
 					ongoingResets: ongoingResets,
+					clientPool:    clientPool,
 				}
 
 				clusterMachine, err := safe.ReaderGet[*omni.ClusterMachine](ctx, r, omni.NewClusterMachine(resources.DefaultNamespace, machineConfig.Metadata().ID()).Metadata())
@@ -225,15 +280,45 @@ func NewClusterMachineConfigStatusController() *ClusterMachineConfigStatusContro
 		qtransform.WithExtraMappedInput(
 			mappers.MapClusterResourceToLabeledResources[*omni.TalosConfig, *omni.ClusterMachineConfig](),
 		),
+		qtransform.WithExtraMappedInput(
+			mappers.MapClusterResourceToLabeledResources[*omni.Cluster, *omni.ClusterMachineConfig](),
+		),
 		qtransform.WithExtraMappedInput(
 			qtransform.MapperNone[*omni.MachineSet](),
 		),
 		qtransform.WithExtraMappedInput(
 			qtransform.MapperNone[*siderolink.ConnectionParams](),
 		),
+		qtransform.WithExtraOutputs(
+			controller.Output{
+				Type: omni.ClusterMachineConfigBackupType,
+				Kind: controller.OutputExclusive,
+			},
+		),
 	)
 }
 
+// clusterPaused checks if the cluster of the machine is paused, the config changes and upgrades are not pushed to the paused clusters.
+func clusterPaused(ctx context.Context, r controller.Reader, machineConfig *omni.ClusterMachineConfig) (bool, error) {
+	clusterName, ok := machineConfig.Metadata().Labels().Get(omni.LabelCluster)
+	if !ok {
+		return false, nil
+	}
+
+	cluster, err := safe.ReaderGetByID[*omni.Cluster](ctx, r, clusterName)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	_, paused := cluster.Metadata().Annotations().Get(omni.ClusterPaused)
+
+	return paused, nil
+}
+
 type resetStatus struct {
 	resetAttempts     uint
 	etcdLeaveAttempts uint
@@ -304,8 +389,11 @@ func (r *ongoingResets) deleteStatus(id resource.ID) {
 
 type clusterMachineConfigStatusControllerHandler struct {
 	r             controller.Reader
+	w             controller.Writer
 	logger        *zap.Logger
 	ongoingResets *ongoingResets
+	applyQueue    *applyqueue.Queue
+	clientPool    *clientpool.Pool[*client.Client]
 }
 
 func (h *clusterMachineConfigStatusControllerHandler) syncInstallImageAndSchematic(inputCtx context.Context, configStatus *omni.ClusterMachineConfigStatus,
@@ -331,12 +419,12 @@ func (h *clusterMachineConfigStatusControllerHandler) syncInstallImageAndSchemat
 		return false, xerrors.NewTagged[qtransform.SkipReconcileTag](fmt.Errorf("machine '%s' does not have talos version", machineConfig.Metadata().ID()))
 	}
 
-	c, err := h.getClient(ctx, maintenance, machineStatus, machineConfig)
+	c, release, err := h.getClient(ctx, maintenance, machineStatus, machineConfig)
 	if err != nil {
 		return false, fmt.Errorf("failed to get client: %w", err)
 	}
 
-	defer logClose(c, h.logger, fmt.Sprintf("machine '%s'", machineConfig.Metadata().ID()))
+	defer release()
 
 	expectedVersion := installImage.TalosVersion
 	expectedSchematic := installImage.SchematicId
@@ -374,6 +462,22 @@ func (h *clusterMachineConfigStatusControllerHandler) syncInstallImageAndSchemat
 		return false, err
 	}
 
+	// the machines in maintenance mode don't have the config yet
+	if !maintenance {
+		reason := fmt.Sprintf("schematic change from %q to %q", schematicInfo.ID, expectedSchematic)
+		if actualVersion != expectedVersion {
+			reason = fmt.Sprintf("Talos version change from %s to %s", actualVersion, expectedVersion)
+		}
+
+		if err = h.backupConfig(ctx, c, machineConfig, &specs.ClusterMachineConfigBackupSpec{
+			Reason:       reason,
+			TalosVersion: actualVersion,
+			SchematicId:  schematicInfo.ID,
+		}); err != nil {
+			return false, fmt.Errorf("failed to back up the machine config: %w", err)
+		}
+	}
+
 	h.logger.Info("upgrading the machine",
 		zap.String("from_version", actualVersion),
 		zap.String("to_version", expectedVersion),
@@ -401,12 +505,76 @@ func (h *clusterMachineConfigStatusControllerHandler) syncInstallImageAndSchemat
 	return false, err
 }
 
+// backupConfig stores the config running on the machine before the destructive operation, only the latest configBackupsLimit backups are kept.
+func (h *clusterMachineConfigStatusControllerHandler) backupConfig(ctx context.Context, c *client.Client, machineConfig *omni.ClusterMachineConfig,
+	backup *specs.ClusterMachineConfigBackupSpec,
+) error {
+	runningConfig, err := safe.StateGetByID[*configres.MachineConfig](ctx, c.COSI, configres.V1Alpha1ID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	data, err := runningConfig.Provider().Bytes()
+	if err != nil {
+		return err
+	}
+
+	backup.Data = string(data)
+
+	backups, err := safe.ReaderListAll[*omni.ClusterMachineConfigBackup](ctx, h.r,
+		state.WithLabelQuery(resource.LabelEqual(omni.LabelClusterMachine, machineConfig.Metadata().ID())),
+	)
+	if err != nil {
+		return err
+	}
+
+	sorted := make([]*omni.ClusterMachineConfigBackup, 0, backups.Len())
+
+	backups.ForEach(func(res *omni.ClusterMachineConfigBackup) {
+		sorted = append(sorted, res)
+	})
+
+	slices.SortFunc(sorted, func(a, b *omni.ClusterMachineConfigBackup) int {
+		return a.Metadata().Created().Compare(b.Metadata().Created())
+	})
+
+	// the upgrade is retried, don't evict the older backups with the copies of the same one
+	if len(sorted) > 0 && sorted[len(sorted)-1].TypedSpec().Value.EqualVT(backup) {
+		return nil
+	}
+
+	id := fmt.Sprintf("%s-%d", machineConfig.Metadata().ID(), time.Now().Unix())
+
+	if err = safe.WriterModify(ctx, h.w, omni.NewClusterMachineConfigBackup(resources.DefaultNamespace, id), func(res *omni.ClusterMachineConfigBackup) error {
+		helpers.CopyLabels(machineConfig, res, omni.LabelCluster)
+
+		res.Metadata().Labels().Set(omni.LabelClusterMachine, machineConfig.Metadata().ID())
+
+		res.TypedSpec().Value = backup
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	h.logger.Info("backed up the machine config", zap.String("machine", machineConfig.Metadata().ID()), zap.String("backup", id), zap.String("reason", backup.Reason))
+
+	for _, outdated := range sorted[:max(len(sorted)+1-configBackupsLimit, 0)] {
+		if err = h.w.Destroy(ctx, outdated.Metadata()); err != nil && !state.IsNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (h *clusterMachineConfigStatusControllerHandler) applyConfig(inputCtx context.Context,
 	machineStatus *omni.MachineStatus, machineConfig *omni.ClusterMachineConfig, statusSnapshot *omni.MachineStatusSnapshot,
 ) error {
-	ctx, cancel := context.WithTimeout(inputCtx, 5*time.Second)
-	defer cancel()
-
 	applyMaintenance := false
 
 	switch statusSnapshot.TypedSpec().Value.GetMachineStatus().GetStage() {
@@ -426,12 +594,32 @@ func (h *clusterMachineConfigStatusControllerHandler) applyConfig(inputCtx conte
 		return xerrors.NewTagged[qtransform.SkipReconcileTag](fmt.Errorf("machine '%s' is in %s stage", machineConfig.Metadata().ID(), statusSnapshot.TypedSpec().Value.GetMachineStatus().GetStage()))
 	}
 
-	c, err := h.getClient(ctx, applyMaintenance, machineStatus, machineConfig)
+	// the config applies across all clusters are rate limited, the control plane machines go first
+	priority := applyqueue.PriorityWorker
+	if _, ok := machineConfig.Metadata().Labels().Get(omni.LabelControlPlaneRole); ok {
+		priority = applyqueue.PriorityControlPlane
+	}
+
+	if waiting := h.applyQueue.Waiting(); waiting > 0 {
+		h.logger.Debug("waiting for the config apply queue", zap.String("machine", machineConfig.Metadata().ID()), zap.Int("waiting", waiting))
+	}
+
+	release, err := h.applyQueue.Acquire(inputCtx, priority)
+	if err != nil {
+		return err
+	}
+
+	defer release()
+
+	ctx, cancel := context.WithTimeout(inputCtx, 5*time.Second)
+	defer cancel()
+
+	c, release, err := h.getClient(ctx, applyMaintenance, machineStatus, machineConfig)
 	if err != nil {
 		return fmt.Errorf("failed to get client: %w", err)
 	}
 
-	defer logClose(c, h.logger, fmt.Sprintf("machine '%s'", machineConfig.Metadata().ID()))
+	defer release()
 
 	_, err = c.Version(ctx)
 	if err != nil {
@@ -473,12 +661,6 @@ func (h *clusterMachineConfigStatusControllerHandler) applyConfig(inputCtx conte
 	return nil
 }
 
-func logClose(c io.Closer, logger *zap.Logger, additional string) {
-	if err := c.Close(); err != nil {
-		logger.Error(additional+": failed to close client", zap.Error(err))
-	}
-}
-
 //nolint:gocyclo,cyclop,gocognit
 func (h *clusterMachineConfigStatusControllerHandler) reset(
 	ctx context.Context,
@@ -537,7 +719,10 @@ func (h *clusterMachineConfigStatusControllerHandler) reset(
 		return fmt.Errorf("failed to get machine status snapshot '%s': %w", machineConfig.Metadata().ID(), err)
 	}
 
-	var c *client.Client
+	var (
+		c       *client.Client
+		release func()
+	)
 
 	machineStage := statusSnapshot.TypedSpec().Value.GetMachineStatus().GetStage()
 
@@ -551,12 +736,12 @@ func (h *clusterMachineConfigStatusControllerHandler) reset(
 
 	if inMaintenance {
 		// verify that we are in maintenance mode
-		c, err = h.getClient(ctx, true, machineStatus, machineConfig)
+		c, release, err = h.getClient(ctx, true, machineStatus, machineConfig)
 		if err != nil {
 			return fmt.Errorf("failed to get maintenance client for machine '%s': %w", machineConfig.Metadata().ID(), err)
 		}
 
-		defer logClose(c, logger, "reset maintenance")
+		defer release()
 
 		_, err = c.Disks(ctx)
 
@@ -567,6 +752,9 @@ func (h *clusterMachineConfigStatusControllerHandler) reset(
 			return nil
 		}
 
+		// the machine might have left the maintenance mode, so the connection is not reused
+		h.clientPool.Invalidate(machineConfig.Metadata().ID())
+
 		// retry next time when MachineStatus updates
 		return xerrors.NewTagged[qtransform.SkipReconcileTag](fmt.Errorf("failed to get disks in maintenance mode for machine '%s': %w", machineConfig.Metadata().ID(), err))
 	}
@@ -599,12 +787,12 @@ func (h *clusterMachineConfigStatusControllerHandler) reset(
 		return xerrors.NewTagged[qtransform.SkipReconcileTag](fmt.Errorf("machine '%s' is in %s stage", machineConfig.Metadata().ID(), machineStage))
 	}
 
-	c, err = h.getClient(ctx, false, machineStatus, machineConfig)
+	c, release, err = h.getClient(ctx, false, machineStatus, machineConfig)
 	if err != nil {
 		return fmt.Errorf("failed to get client for machine '%s': %w", machineConfig.Metadata().ID(), err)
 	}
 
-	defer logClose(c, logger, "reset")
+	defer release()
 
 	err = c.MetaDelete(ctx, meta.StateEncryptionConfig)
 	if err != nil {
@@ -672,36 +860,39 @@ func (h *clusterMachineConfigStatusControllerHandler) gracefulEtcdLeave(ctx cont
 	return nil
 }
 
+// getClient returns the pooled Talos client of the machine, the returned function must be called instead of closing the client.
+//
+// The pooled client is replaced when the machine enters or leaves the maintenance mode, its address changes or the talosconfig is rotated.
 func (h *clusterMachineConfigStatusControllerHandler) getClient(
 	ctx context.Context,
 	useMaintenance bool,
 	machineStatus *omni.MachineStatus,
 	machineConfig *omni.ClusterMachineConfig,
-) (*client.Client, error) {
+) (*client.Client, func(), error) {
 	address := machineStatus.TypedSpec().Value.ManagementAddress
 	opts := talos.GetSocketOptions(address)
 
 	if useMaintenance {
-		return client.New(ctx,
-			append(
-				opts,
-				client.WithTLSConfig(insecureTLSConfig),
-				client.WithEndpoints(address),
-			)...)
+		opts = append(opts, client.WithTLSConfig(insecureTLSConfig), client.WithEndpoints(address))
+		opts = append(opts, dryrun.TalosClientOptions()...)
+
+		return h.clientPool.Acquire(ctx, machineStatus.Metadata().ID(), "maintenance/"+address, func(ctx context.Context) (*client.Client, error) {
+			return client.New(ctx, opts...)
+		})
 	}
 
 	clusterName, ok := machineConfig.Metadata().Labels().Get(omni.LabelCluster)
 	if !ok {
-		return nil, errors.New("no cluster name label")
+		return nil, nil, errors.New("no cluster name label")
 	}
 
 	talosConfig, err := safe.ReaderGet[*omni.TalosConfig](ctx, h.r, omni.NewTalosConfig(resources.DefaultNamespace, clusterName).Metadata())
 	if err != nil {
 		if state.IsNotFoundError(err) {
-			return nil, xerrors.NewTaggedf[qtransform.SkipReconcileTag]("cluster '%s' talosconfig not found: %w", clusterName, err)
+			return nil, nil, xerrors.NewTaggedf[qtransform.SkipReconcileTag]("cluster '%s' talosconfig not found: %w", clusterName, err)
 		}
 
-		return nil, fmt.Errorf("cluster '%s' failed to get talosconfig: %w", clusterName, err)
+		return nil, nil, fmt.Errorf("cluster '%s' failed to get talosconfig: %w", clusterName, err)
 	}
 
 	var endpoints []string
@@ -712,13 +903,18 @@ func (h *clusterMachineConfigStatusControllerHandler) getClient(
 
 	config := omni.NewTalosClientConfig(talosConfig, endpoints...)
 	opts = append(opts, client.WithConfig(config))
+	opts = append(opts, dryrun.TalosClientOptions()...)
 
-	result, err := client.New(ctx, opts...)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create client to machine '%s': %w", machineStatus.Metadata().ID(), err)
-	}
+	generation := fmt.Sprintf("%s/%s/%s", address, clusterName, talosConfig.Metadata().Version())
 
-	return result, nil
+	return h.clientPool.Acquire(ctx, machineStatus.Metadata().ID(), generation, func(ctx context.Context) (*client.Client, error) {
+		result, err := client.New(ctx, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create client to machine '%s': %w", machineStatus.Metadata().ID(), err)
+		}
+
+		return result, nil
+	})
 }
 
 var insecureTLSConfig = &tls.Config{
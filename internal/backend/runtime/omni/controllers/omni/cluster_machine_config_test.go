@@ -39,7 +39,7 @@ func (suite *ClusterMachineConfigSuite) TestReconcile() {
 	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewClusterMachineConfigController(nil)))
 	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewSecretsController(nil)))
 	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewClusterStatusController(false)))
-	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewTalosUpgradeStatusController()))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewTalosUpgradeStatusController(nil)))
 	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewClusterConfigVersionController()))
 	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewMachineConfigGenOptionsController()))
 
@@ -143,7 +143,7 @@ func (suite *ClusterMachineConfigSuite) TestGenerationError() {
 	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewSecretsController(nil)))
 	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewMachineConfigGenOptionsController()))
 	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewClusterStatusController(false)))
-	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewTalosUpgradeStatusController()))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewTalosUpgradeStatusController(nil)))
 	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewClusterConfigVersionController()))
 
 	clusterName := "test-generation-error"
@@ -178,6 +178,70 @@ func (suite *ClusterMachineConfigSuite) TestGenerationError() {
 	)
 }
 
+func (suite *ClusterMachineConfigSuite) TestPatchTemplate() {
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterController(omnictrl.NewClusterController()))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewSchematicConfigurationController(&imageFactoryClientMock{})))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewClusterMachineConfigController(nil)))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewSecretsController(nil)))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewMachineConfigGenOptionsController()))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewClusterStatusController(false)))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewTalosUpgradeStatusController(nil)))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewClusterConfigVersionController()))
+
+	clusterName := "test-patch-template"
+
+	_, machines := suite.createCluster(clusterName, 1, 0)
+	suite.Require().Greater(len(machines), 0)
+
+	setRack := func(rack string) {
+		_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, omni.NewMachineStatus(resources.DefaultNamespace, machines[0].Metadata().ID()).Metadata(),
+			func(res *omni.MachineStatus) error {
+				res.Metadata().Labels().Set("rack", rack)
+
+				return nil
+			},
+		)
+		suite.Require().NoError(err)
+	}
+
+	setRack("r1")
+
+	_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, omni.NewClusterMachineConfigPatches(resources.DefaultNamespace, machines[0].Metadata().ID()).Metadata(),
+		func(config *omni.ClusterMachineConfigPatches) error {
+			config.TypedSpec().Value.Patches = append(config.TypedSpec().Value.Patches, `# omni:template
+machine:
+  network:
+    hostname: {{ .Cluster }}-{{ index .Labels "rack" }}`)
+
+			return nil
+		},
+	)
+
+	suite.Require().NoError(err)
+
+	assertHostname := func(expected string) {
+		assertResource(
+			&suite.OmniSuite,
+			*omni.NewClusterMachineConfig(resources.DefaultNamespace, machines[0].Metadata().ID()).Metadata(),
+			func(cfg *omni.ClusterMachineConfig, assertions *assert.Assertions) {
+				machineconfig, loadErr := configloader.NewFromBytes(cfg.TypedSpec().Value.Data)
+				suite.Require().NoError(loadErr)
+
+				assertions.Equal(expected, machineconfig.Machine().Network().Hostname())
+			},
+		)
+	}
+
+	assertHostname(clusterName + "-r1")
+
+	// the config is rendered again when the machine facts change
+	setRack("r2")
+
+	assertHostname(clusterName + "-r2")
+}
+
 func TestClusterMachineConfigSuite(t *testing.T) {
 	t.Parallel()
 
@@ -91,9 +91,10 @@ func NewClusterMachineStatusController() *ClusterMachineStatusController {
 					return err
 				}
 
-				var locked bool
+				var locked, held bool
 				if machineSetNode != nil {
 					_, locked = machineSetNode.Metadata().Annotations().Get(omni.MachineLocked)
+					_, held = machineSetNode.Metadata().Annotations().Get(omni.MachineUpgradeHold)
 				}
 
 				if locked {
@@ -102,6 +103,12 @@ func NewClusterMachineStatusController() *ClusterMachineStatusController {
 					clusterMachineStatus.Metadata().Annotations().Delete(omni.MachineLocked)
 				}
 
+				if held {
+					clusterMachineStatus.Metadata().Annotations().Set(omni.MachineUpgradeHold, "")
+				} else {
+					clusterMachineStatus.Metadata().Annotations().Delete(omni.MachineUpgradeHold)
+				}
+
 				if machineStatus.TypedSpec().Value.Network != nil {
 					clusterMachineStatus.Metadata().Labels().Set(omni.LabelHostname, machineStatus.TypedSpec().Value.Network.Hostname)
 				}
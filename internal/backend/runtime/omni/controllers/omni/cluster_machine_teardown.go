@@ -8,7 +8,6 @@ package omni
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/controller/generic"
@@ -21,6 +20,7 @@ import (
 
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/discovery"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/mappers"
 )
 
@@ -31,19 +31,22 @@ const ClusterMachineTeardownControllerName = "ClusterMachineTeardownController"
 type ClusterMachineTeardownController struct {
 	defaultDiscoveryClient  DiscoveryClient
 	embeddedDiscoveryClient DiscoveryClient
+	tunables                *Tunables
 	generic.NamedController
 }
 
 // DiscoveryClient is an interface for interacting with the discovery service.
 type DiscoveryClient interface {
 	AffiliateDelete(ctx context.Context, cluster, affiliate string) error
+	ListAffiliates(ctx context.Context, cluster string, clusterSecrets ...string) ([]discovery.Affiliate, error)
 }
 
 // NewClusterMachineTeardownController initializes ClusterMachineTeardownController.
-func NewClusterMachineTeardownController(defaultDiscoveryClient, embeddedDiscoveryClient DiscoveryClient) *ClusterMachineTeardownController {
+func NewClusterMachineTeardownController(defaultDiscoveryClient, embeddedDiscoveryClient DiscoveryClient, tunables *Tunables) *ClusterMachineTeardownController {
 	return &ClusterMachineTeardownController{
 		defaultDiscoveryClient:  defaultDiscoveryClient,
 		embeddedDiscoveryClient: embeddedDiscoveryClient,
+		tunables:                tunables,
 
 		NamedController: generic.NamedController{
 			ControllerName: ClusterMachineTeardownControllerName,
@@ -168,7 +171,7 @@ func (ctrl *ClusterMachineTeardownController) Reconcile(ctx context.Context, log
 		return r.RemoveFinalizer(ctx, ptr, ctrl.Name())
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, time.Second*20)
+	ctx, cancel := context.WithTimeout(ctx, ctrl.tunables.Get().MachineTeardownTimeout)
 	defer cancel()
 
 	// teardown the discovery service affiliate and the Kubernetes node for the cluster machine
@@ -0,0 +1,170 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/controller/generic/qtransform"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/mappers"
+)
+
+// ClusterNodeVersionsController aggregates the versions of the components running on the nodes of a cluster.
+//
+// Talos versions are taken from the MachineStatus resources, kubelet, container runtime and OS versions
+// are taken from the Kubernetes nodes reported in KubernetesStatus.
+type ClusterNodeVersionsController = qtransform.QController[*omni.ClusterUUID, *omni.ClusterNodeVersions]
+
+// NewClusterNodeVersionsController initializes ClusterNodeVersionsController.
+func NewClusterNodeVersionsController() *ClusterNodeVersionsController {
+	return qtransform.NewQController(
+		qtransform.Settings[*omni.ClusterUUID, *omni.ClusterNodeVersions]{
+			Name: "ClusterNodeVersionsController",
+			MapMetadataFunc: func(clusterUUID *omni.ClusterUUID) *omni.ClusterNodeVersions {
+				return omni.NewClusterNodeVersions(resources.DefaultNamespace, clusterUUID.Metadata().ID())
+			},
+			UnmapMetadataFunc: func(clusterNodeVersions *omni.ClusterNodeVersions) *omni.ClusterUUID {
+				return omni.NewClusterUUID(clusterNodeVersions.Metadata().ID())
+			},
+			TransformFunc: func(ctx context.Context, r controller.Reader, _ *zap.Logger, clusterUUID *omni.ClusterUUID, clusterNodeVersions *omni.ClusterNodeVersions) error {
+				clusterName := clusterUUID.Metadata().ID()
+				clusterQuery := state.WithLabelQuery(resource.LabelEqual(omni.LabelCluster, clusterName))
+
+				clusterMachines, err := safe.ReaderListAll[*omni.ClusterMachine](ctx, r, clusterQuery)
+				if err != nil {
+					return fmt.Errorf("error listing cluster machines: %w", err)
+				}
+
+				identities, err := safe.ReaderListAll[*omni.ClusterMachineIdentity](ctx, r, clusterQuery)
+				if err != nil {
+					return fmt.Errorf("error listing cluster machine identities: %w", err)
+				}
+
+				machineStatuses, err := safe.ReaderListAll[*omni.MachineStatus](ctx, r, clusterQuery)
+				if err != nil {
+					return fmt.Errorf("error listing machine statuses: %w", err)
+				}
+
+				kubernetesStatus, err := safe.ReaderGetByID[*omni.KubernetesStatus](ctx, r, clusterName)
+				if err != nil && !state.IsNotFoundError(err) {
+					return fmt.Errorf("error getting kubernetes status: %w", err)
+				}
+
+				nodenames := map[resource.ID]string{}
+
+				identities.ForEach(func(identity *omni.ClusterMachineIdentity) {
+					nodenames[identity.Metadata().ID()] = identity.TypedSpec().Value.Nodename
+				})
+
+				talosVersions := map[resource.ID]string{}
+
+				machineStatuses.ForEach(func(machineStatus *omni.MachineStatus) {
+					talosVersions[machineStatus.Metadata().ID()] = strings.TrimLeft(machineStatus.TypedSpec().Value.TalosVersion, "v")
+				})
+
+				kubernetesNodes := map[string]*specs.KubernetesStatusSpec_NodeStatus{}
+
+				if kubernetesStatus != nil {
+					for _, node := range kubernetesStatus.TypedSpec().Value.Nodes {
+						kubernetesNodes[node.Nodename] = node
+					}
+				}
+
+				nodes := make([]*specs.ClusterNodeVersionsSpec_Node, 0, clusterMachines.Len())
+
+				for iter := clusterMachines.Iterator(); iter.Next(); {
+					clusterMachine := iter.Value()
+
+					if clusterMachine.Metadata().Phase() == resource.PhaseTearingDown {
+						continue
+					}
+
+					node := &specs.ClusterNodeVersionsSpec_Node{
+						MachineId:         clusterMachine.Metadata().ID(),
+						Nodename:          nodenames[clusterMachine.Metadata().ID()],
+						TalosVersion:      talosVersions[clusterMachine.Metadata().ID()],
+						KubernetesVersion: clusterMachine.TypedSpec().Value.KubernetesVersion,
+					}
+
+					if kubernetesNode, ok := kubernetesNodes[node.Nodename]; ok && node.Nodename != "" {
+						setKubernetesNodeVersions(node, kubernetesNode)
+
+						delete(kubernetesNodes, node.Nodename)
+					}
+
+					nodes = append(nodes, node)
+				}
+
+				// the nodes which joined the cluster without being managed by Omni are reported as well
+				for _, kubernetesNode := range kubernetesNodes {
+					node := &specs.ClusterNodeVersionsSpec_Node{
+						Nodename: kubernetesNode.Nodename,
+					}
+
+					setKubernetesNodeVersions(node, kubernetesNode)
+
+					nodes = append(nodes, node)
+				}
+
+				slices.SortFunc(nodes, func(a, b *specs.ClusterNodeVersionsSpec_Node) int {
+					return cmp.Or(cmp.Compare(a.Nodename, b.Nodename), cmp.Compare(a.MachineId, b.MachineId))
+				})
+
+				spec := clusterNodeVersions.TypedSpec().Value
+
+				spec.Nodes = nodes
+				spec.TalosVersions = distinctVersions(nodes, (*specs.ClusterNodeVersionsSpec_Node).GetTalosVersion)
+				spec.KubeletVersions = distinctVersions(nodes, (*specs.ClusterNodeVersionsSpec_Node).GetKubeletVersion)
+				spec.ContainerRuntimeVersions = distinctVersions(nodes, (*specs.ClusterNodeVersionsSpec_Node).GetContainerRuntimeVersion)
+				spec.VersionDrift = len(spec.TalosVersions) > 1 || len(spec.KubeletVersions) > 1 || len(spec.ContainerRuntimeVersions) > 1 ||
+					slices.ContainsFunc(nodes, func(node *specs.ClusterNodeVersionsSpec_Node) bool {
+						return node.KubeletVersion != "" && node.KubernetesVersion != "" && node.KubeletVersion != node.KubernetesVersion
+					})
+
+				return nil
+			},
+		},
+		qtransform.WithExtraMappedInput(mappers.MapByClusterLabel[*omni.ClusterMachine, *omni.ClusterUUID]()),
+		qtransform.WithExtraMappedInput(mappers.MapByClusterLabel[*omni.ClusterMachineIdentity, *omni.ClusterUUID]()),
+		qtransform.WithExtraMappedInput(mappers.MapByClusterLabel[*omni.MachineStatus, *omni.ClusterUUID]()),
+		qtransform.WithExtraMappedInput(qtransform.MapperSameID[*omni.KubernetesStatus, *omni.ClusterUUID]()),
+	)
+}
+
+func setKubernetesNodeVersions(node *specs.ClusterNodeVersionsSpec_Node, kubernetesNode *specs.KubernetesStatusSpec_NodeStatus) {
+	node.KubeletVersion = kubernetesNode.KubeletVersion
+	node.ContainerRuntimeVersion = kubernetesNode.ContainerRuntimeVersion
+	node.OsImage = kubernetesNode.OsImage
+	node.KernelVersion = kubernetesNode.KernelVersion
+}
+
+// distinctVersions returns the sorted list of the non-empty versions of the nodes.
+func distinctVersions(nodes []*specs.ClusterNodeVersionsSpec_Node, version func(*specs.ClusterNodeVersionsSpec_Node) string) []string {
+	versions := make([]string, 0, len(nodes))
+
+	for _, node := range nodes {
+		if v := version(node); v != "" {
+			versions = append(versions, v)
+		}
+	}
+
+	slices.Sort(versions)
+
+	return slices.Compact(versions)
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+)
+
+type ClusterNodeVersionsSuite struct {
+	OmniSuite
+}
+
+func (suite *ClusterNodeVersionsSuite) TestReconcile() {
+	require := suite.Require()
+
+	suite.startRuntime()
+
+	require.NoError(suite.runtime.RegisterQController(omnictrl.NewClusterNodeVersionsController()))
+
+	clusterName := "versions"
+
+	require.NoError(suite.state.Create(suite.ctx, omni.NewClusterUUID(clusterName)))
+
+	for i, talosVersion := range []string{"v1.7.4", "v1.7.0"} {
+		id := []string{"machine-1", "machine-2"}[i]
+
+		clusterMachine := omni.NewClusterMachine(resources.DefaultNamespace, id)
+		clusterMachine.Metadata().Labels().Set(omni.LabelCluster, clusterName)
+		clusterMachine.TypedSpec().Value.KubernetesVersion = "1.30.1"
+
+		require.NoError(suite.state.Create(suite.ctx, clusterMachine))
+
+		identity := omni.NewClusterMachineIdentity(resources.DefaultNamespace, id)
+		identity.Metadata().Labels().Set(omni.LabelCluster, clusterName)
+		identity.TypedSpec().Value.Nodename = "node-" + id
+
+		require.NoError(suite.state.Create(suite.ctx, identity))
+
+		machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, id)
+		machineStatus.Metadata().Labels().Set(omni.LabelCluster, clusterName)
+		machineStatus.TypedSpec().Value.TalosVersion = talosVersion
+
+		require.NoError(suite.state.Create(suite.ctx, machineStatus))
+	}
+
+	kubernetesStatus := omni.NewKubernetesStatus(resources.DefaultNamespace, clusterName)
+	kubernetesStatus.TypedSpec().Value.Nodes = []*specs.KubernetesStatusSpec_NodeStatus{
+		{Nodename: "node-machine-1", KubeletVersion: "1.30.1", ContainerRuntimeVersion: "containerd://1.7.16", OsImage: "Talos (v1.7.4)", KernelVersion: "6.6.32-talos"},
+		{Nodename: "node-machine-2", KubeletVersion: "1.30.0", ContainerRuntimeVersion: "containerd://1.7.13", OsImage: "Talos (v1.7.0)", KernelVersion: "6.6.29-talos"},
+		{Nodename: "external", KubeletVersion: "1.30.1", ContainerRuntimeVersion: "containerd://1.7.16"},
+	}
+
+	require.NoError(suite.state.Create(suite.ctx, kubernetesStatus))
+
+	rtestutils.AssertResource[*omni.ClusterNodeVersions](suite.ctx, suite.T(), suite.state, clusterName, func(res *omni.ClusterNodeVersions, assertion *assert.Assertions) {
+		spec := res.TypedSpec().Value
+
+		if !assertion.Len(spec.Nodes, 3) {
+			return
+		}
+
+		assertion.Equal("external", spec.Nodes[0].Nodename)
+		assertion.Empty(spec.Nodes[0].MachineId)
+
+		assertion.Equal("machine-2", spec.Nodes[2].MachineId)
+		assertion.Equal("1.7.0", spec.Nodes[2].TalosVersion)
+		assertion.Equal("1.30.1", spec.Nodes[2].KubernetesVersion)
+		assertion.Equal("1.30.0", spec.Nodes[2].KubeletVersion)
+		assertion.Equal("Talos (v1.7.0)", spec.Nodes[2].OsImage)
+		assertion.Equal("6.6.29-talos", spec.Nodes[2].KernelVersion)
+
+		assertion.Equal([]string{"1.7.0", "1.7.4"}, spec.TalosVersions)
+		assertion.Equal([]string{"1.30.0", "1.30.1"}, spec.KubeletVersions)
+		assertion.Equal([]string{"containerd://1.7.13", "containerd://1.7.16"}, spec.ContainerRuntimeVersions)
+		assertion.True(spec.VersionDrift)
+	})
+
+	// the second machine is upgraded, the external node is removed
+	_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, omni.NewMachineStatus(resources.DefaultNamespace, "machine-2").Metadata(), func(res *omni.MachineStatus) error {
+		res.TypedSpec().Value.TalosVersion = "v1.7.4"
+
+		return nil
+	})
+	require.NoError(err)
+
+	_, err = safe.StateUpdateWithConflicts(suite.ctx, suite.state, kubernetesStatus.Metadata(), func(res *omni.KubernetesStatus) error {
+		res.TypedSpec().Value.Nodes = res.TypedSpec().Value.Nodes[:2]
+		res.TypedSpec().Value.Nodes[1] = &specs.KubernetesStatusSpec_NodeStatus{
+			Nodename: "node-machine-2", KubeletVersion: "1.30.1", ContainerRuntimeVersion: "containerd://1.7.16", OsImage: "Talos (v1.7.4)", KernelVersion: "6.6.32-talos",
+		}
+
+		return nil
+	})
+	require.NoError(err)
+
+	rtestutils.AssertResource[*omni.ClusterNodeVersions](suite.ctx, suite.T(), suite.state, clusterName, func(res *omni.ClusterNodeVersions, assertion *assert.Assertions) {
+		spec := res.TypedSpec().Value
+
+		assertion.Len(spec.Nodes, 2)
+		assertion.Equal([]string{"1.7.4"}, spec.TalosVersions)
+		assertion.Equal([]string{"1.30.1"}, spec.KubeletVersions)
+		assertion.Equal([]string{"containerd://1.7.16"}, spec.ContainerRuntimeVersions)
+		assertion.False(spec.VersionDrift)
+	})
+}
+
+func TestClusterNodeVersionsSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(ClusterNodeVersionsSuite))
+}
@@ -8,6 +8,7 @@ package omni
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/blang/semver"
 	"github.com/cosi-project/runtime/pkg/controller"
@@ -16,6 +17,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
@@ -89,6 +91,7 @@ func NewClusterStatusController(embeddedDiscoveryServiceEnabled bool) *ClusterSt
 
 					machines.Total += machineSetStatus.GetMachines().GetTotal()
 					machines.Healthy += machineSetStatus.GetMachines().GetHealthy()
+					machines.Connected += machineSetStatus.GetMachines().GetConnected()
 
 					_, isControlPlane := iter.Value().Metadata().Labels().Get(omni.LabelControlPlaneRole)
 					if isControlPlane {
@@ -147,6 +150,24 @@ func NewClusterStatusController(embeddedDiscoveryServiceEnabled bool) *ClusterSt
 					phase = specs.ClusterStatusSpec_RUNNING
 				}
 
+				machineSetNodes, err := safe.ReaderListAll[*omni.MachineSetNode](
+					ctx, r,
+					state.WithLabelQuery(resource.LabelEqual(omni.LabelCluster, cluster.Metadata().ID())),
+				)
+				if err != nil {
+					return err
+				}
+
+				var heldMachines []string
+
+				machineSetNodes.ForEach(func(machineSetNode *omni.MachineSetNode) {
+					if _, held := machineSetNode.Metadata().Annotations().Get(omni.MachineUpgradeHold); held {
+						heldMachines = append(heldMachines, machineSetNode.Metadata().ID())
+					}
+				})
+
+				slices.Sort(heldMachines)
+
 				useEmbeddedDiscoveryService, err := shouldUseEmbeddedDiscoveryService()
 				if err != nil {
 					return fmt.Errorf("failed to determine if embedded discovery service should be used: %w", err)
@@ -161,6 +182,11 @@ func NewClusterStatusController(embeddedDiscoveryServiceEnabled bool) *ClusterSt
 					Machines:                    &machines,
 					HasConnectedControlPlanes:   clusterHasConnectedControlPlanes,
 					UseEmbeddedDiscoveryService: useEmbeddedDiscoveryService,
+					HeldMachines:                heldMachines,
+				}
+
+				if ttl := cluster.TypedSpec().Value.GetTtl(); ttl != nil {
+					clusterStatus.TypedSpec().Value.ExpiresAt = timestamppb.New(cluster.Metadata().Created().Add(ttl.AsDuration()))
 				}
 
 				helpers.CopyUserLabels(clusterStatus, cluster.Metadata().Labels().Raw())
@@ -177,6 +203,9 @@ func NewClusterStatusController(embeddedDiscoveryServiceEnabled bool) *ClusterSt
 		qtransform.WithExtraMappedInput(
 			mappers.MapByClusterLabel[*omni.ControlPlaneStatus, *omni.Cluster](),
 		),
+		qtransform.WithExtraMappedInput(
+			mappers.MapByClusterLabel[*omni.MachineSetNode, *omni.Cluster](),
+		),
 		qtransform.WithIgnoreTeardownUntil(), // keep ClusterStatus alive until every other controller is done with Cluster
 	)
 }
@@ -0,0 +1,157 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+const (
+	// ClusterStatusHistoryRecentRetention is the period the samples of the ClusterStatusHistory are kept for in full resolution.
+	ClusterStatusHistoryRecentRetention = 24 * time.Hour
+
+	// ClusterStatusHistoryHourlyRetention is the period the hourly samples of the ClusterStatusHistory are kept for.
+	ClusterStatusHistoryHourlyRetention = 7 * 24 * time.Hour
+)
+
+// ClusterStatusHistoryController samples the key fields of the ClusterStatus and the KubernetesStatus of the clusters.
+//
+// The samples are taken on each sample interval and kept for a day,
+// the first sample of each hour is also kept for a week.
+type ClusterStatusHistoryController struct {
+	sampleInterval time.Duration
+}
+
+// NewClusterStatusHistoryController creates new ClusterStatusHistoryController.
+func NewClusterStatusHistoryController(sampleInterval time.Duration) *ClusterStatusHistoryController {
+	return &ClusterStatusHistoryController{
+		sampleInterval: sampleInterval,
+	}
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *ClusterStatusHistoryController) Name() string {
+	return "ClusterStatusHistoryController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *ClusterStatusHistoryController) Inputs() []controller.Input {
+	return []controller.Input{
+		safe.Input[*omni.ClusterStatus](controller.InputWeak),
+		safe.Input[*omni.KubernetesStatus](controller.InputWeak),
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *ClusterStatusHistoryController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: omni.ClusterStatusHistoryType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *ClusterStatusHistoryController) Run(ctx context.Context, r controller.Runtime, _ *zap.Logger) error {
+	ticker := time.NewTicker(ctrl.sampleInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+			// the statuses are sampled on the ticks only
+			continue
+		case t := <-ticker.C:
+			if err := ctrl.sample(ctx, r, t); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (ctrl *ClusterStatusHistoryController) sample(ctx context.Context, r controller.Runtime, now time.Time) error {
+	r.StartTrackingOutputs()
+
+	clusterStatuses, err := safe.ReaderListAll[*omni.ClusterStatus](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing cluster statuses: %w", err)
+	}
+
+	for iter := clusterStatuses.Iterator(); iter.Next(); {
+		clusterStatus := iter.Value()
+
+		sample := &specs.ClusterStatusHistorySpec_Sample{
+			Timestamp:         timestamppb.New(now),
+			Phase:             clusterStatus.TypedSpec().Value.Phase,
+			MachinesTotal:     clusterStatus.TypedSpec().Value.GetMachines().GetTotal(),
+			MachinesConnected: clusterStatus.TypedSpec().Value.GetMachines().GetConnected(),
+			MachinesHealthy:   clusterStatus.TypedSpec().Value.GetMachines().GetHealthy(),
+		}
+
+		kubernetesStatus, err := safe.ReaderGetByID[*omni.KubernetesStatus](ctx, r, clusterStatus.Metadata().ID())
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting kubernetes status: %w", err)
+		}
+
+		if kubernetesStatus != nil {
+			for _, node := range kubernetesStatus.TypedSpec().Value.Nodes {
+				sample.NodesTotal++
+
+				if node.Ready {
+					sample.NodesReady++
+				}
+			}
+		}
+
+		if err = safe.WriterModify(ctx, r, omni.NewClusterStatusHistory(resources.DefaultNamespace, clusterStatus.Metadata().ID()),
+			func(res *omni.ClusterStatusHistory) error {
+				spec := res.TypedSpec().Value
+
+				spec.Recent = appendClusterStatusSample(spec.Recent, sample, now.Add(-ClusterStatusHistoryRecentRetention))
+
+				if len(spec.Hourly) == 0 || !spec.Hourly[len(spec.Hourly)-1].Timestamp.AsTime().Truncate(time.Hour).Equal(now.Truncate(time.Hour)) {
+					spec.Hourly = appendClusterStatusSample(spec.Hourly, sample, now.Add(-ClusterStatusHistoryHourlyRetention))
+				}
+
+				return nil
+			},
+		); err != nil {
+			return fmt.Errorf("error updating cluster status history: %w", err)
+		}
+	}
+
+	if err = safe.CleanupOutputs[*omni.ClusterStatusHistory](ctx, r); err != nil {
+		return fmt.Errorf("error cleaning up cluster status histories: %w", err)
+	}
+
+	return nil
+}
+
+// appendClusterStatusSample appends the sample and drops the samples taken before the cutoff.
+func appendClusterStatusSample(samples []*specs.ClusterStatusHistorySpec_Sample, sample *specs.ClusterStatusHistorySpec_Sample, cutoff time.Time) []*specs.ClusterStatusHistorySpec_Sample {
+	samples = append(samples, sample)
+
+	for len(samples) > 0 && samples[0].Timestamp.AsTime().Before(cutoff) {
+		samples = samples[1:]
+	}
+
+	return samples
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+)
+
+type ClusterStatusHistorySuite struct {
+	OmniSuite
+}
+
+func (suite *ClusterStatusHistorySuite) TestReconcile() {
+	ctx, cancel := context.WithTimeout(suite.ctx, time.Second*20)
+	defer cancel()
+
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterController(omnictrl.NewClusterStatusHistoryController(100 * time.Millisecond)))
+
+	clusterName := "cluster1"
+
+	clusterStatus := omni.NewClusterStatus(resources.DefaultNamespace, clusterName)
+	clusterStatus.TypedSpec().Value.Phase = specs.ClusterStatusSpec_RUNNING
+	clusterStatus.TypedSpec().Value.Machines = &specs.Machines{
+		Total:     3,
+		Connected: 2,
+		Healthy:   1,
+	}
+
+	suite.Require().NoError(suite.state.Create(ctx, clusterStatus))
+
+	kubernetesStatus := omni.NewKubernetesStatus(resources.DefaultNamespace, clusterName)
+	kubernetesStatus.TypedSpec().Value.Nodes = []*specs.KubernetesStatusSpec_NodeStatus{
+		{Nodename: "node-1", Ready: true},
+		{Nodename: "node-2"},
+	}
+
+	suite.Require().NoError(suite.state.Create(ctx, kubernetesStatus))
+
+	rtestutils.AssertResources(ctx, suite.T(), suite.state, []string{clusterName},
+		func(res *omni.ClusterStatusHistory, assertion *assert.Assertions) {
+			spec := res.TypedSpec().Value
+
+			if !assertion.GreaterOrEqual(len(spec.Recent), 3) {
+				return
+			}
+
+			// only the first sample of the hour is kept in the hourly history
+			assertion.LessOrEqual(len(spec.Hourly), 2)
+
+			last := spec.Recent[len(spec.Recent)-1]
+
+			assertion.Equal(specs.ClusterStatusSpec_RUNNING, last.Phase)
+			assertion.EqualValues(3, last.MachinesTotal)
+			assertion.EqualValues(2, last.MachinesConnected)
+			assertion.EqualValues(1, last.MachinesHealthy)
+			assertion.EqualValues(2, last.NodesTotal)
+			assertion.EqualValues(1, last.NodesReady)
+		},
+	)
+
+	rtestutils.DestroyAll[*omni.ClusterStatus](ctx, suite.T(), suite.state)
+
+	rtestutils.AssertNoResource[*omni.ClusterStatusHistory](ctx, suite.T(), suite.state, clusterName)
+}
+
+func TestClusterStatusHistorySuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(ClusterStatusHistorySuite))
+}
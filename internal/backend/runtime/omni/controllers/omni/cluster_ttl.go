@@ -0,0 +1,136 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+// ClusterTTLController tears down the clusters which outlived their TTL.
+//
+// The warning is logged once the cluster is about to expire, so that the TTL can still be extended.
+type ClusterTTLController struct {
+	// warned tracks the expiration time of the clusters for which the warning was already logged.
+	warned map[resource.ID]time.Time
+
+	pollInterval  time.Duration
+	warningPeriod time.Duration
+}
+
+// NewClusterTTLController creates new ClusterTTLController.
+func NewClusterTTLController(pollInterval, warningPeriod time.Duration) *ClusterTTLController {
+	return &ClusterTTLController{
+		warned:        map[resource.ID]time.Time{},
+		pollInterval:  pollInterval,
+		warningPeriod: warningPeriod,
+	}
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *ClusterTTLController) Name() string {
+	return "ClusterTTLController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *ClusterTTLController) Inputs() []controller.Input {
+	return []controller.Input{
+		safe.Input[*omni.Cluster](controller.InputWeak),
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *ClusterTTLController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: omni.ClusterType,
+			Kind: controller.OutputShared,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *ClusterTTLController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	ticker := time.NewTicker(ctrl.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		if err := ctrl.reconcile(ctx, r, logger); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *ClusterTTLController) reconcile(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	clusters, err := safe.ReaderListAll[*omni.Cluster](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing clusters: %w", err)
+	}
+
+	now := time.Now()
+	expiring := make(map[resource.ID]struct{}, len(ctrl.warned))
+
+	for iter := clusters.Iterator(); iter.Next(); {
+		cluster := iter.Value()
+
+		ttl := cluster.TypedSpec().Value.GetTtl()
+		if ttl == nil || cluster.Metadata().Phase() == resource.PhaseTearingDown {
+			continue
+		}
+
+		expiresAt := cluster.Metadata().Created().Add(ttl.AsDuration())
+		clusterLogger := logger.With(zap.String("cluster", cluster.Metadata().ID()), zap.Time("expires_at", expiresAt))
+
+		if now.Before(expiresAt) {
+			expiring[cluster.Metadata().ID()] = struct{}{}
+
+			// the warning is logged again if the TTL was changed
+			if expiresAt.Sub(now) <= ctrl.warningPeriod && !ctrl.warned[cluster.Metadata().ID()].Equal(expiresAt) {
+				clusterLogger.Warn("cluster is going to be destroyed as it reaches its TTL", zap.Duration("expires_in", expiresAt.Sub(now).Round(time.Second)))
+
+				ctrl.warned[cluster.Metadata().ID()] = expiresAt
+			}
+
+			continue
+		}
+
+		if _, err = r.Teardown(ctx, cluster.Metadata(), controller.WithOwner("")); err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error tearing down expired cluster %q: %w", cluster.Metadata().ID(), err)
+		}
+
+		clusterLogger.Info("destroying the cluster which reached its TTL")
+	}
+
+	for id := range ctrl.warned {
+		if _, ok := expiring[id]; !ok {
+			delete(ctrl.warned, id)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+)
+
+type ClusterTTLSuite struct {
+	OmniSuite
+}
+
+func (suite *ClusterTTLSuite) TestReconcile() {
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterController(omnictrl.NewClusterTTLController(100*time.Millisecond, time.Minute)))
+
+	expiring := omni.NewCluster(resources.DefaultNamespace, "expiring")
+	expiring.TypedSpec().Value.Ttl = durationpb.New(time.Second)
+
+	permanent := omni.NewCluster(resources.DefaultNamespace, "permanent")
+
+	longLived := omni.NewCluster(resources.DefaultNamespace, "long-lived")
+	longLived.TypedSpec().Value.Ttl = durationpb.New(time.Hour)
+
+	for _, cluster := range []*omni.Cluster{expiring, permanent, longLived} {
+		suite.Require().NoError(suite.state.Create(suite.ctx, cluster))
+	}
+
+	rtestutils.AssertResource[*omni.Cluster](suite.ctx, suite.T(), suite.state, expiring.Metadata().ID(), func(r *omni.Cluster, assertion *assert.Assertions) {
+		assertion.Equal(resource.PhaseTearingDown, r.Metadata().Phase())
+	})
+
+	time.Sleep(300 * time.Millisecond)
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []string{permanent.Metadata().ID(), longLived.Metadata().ID()}, func(r *omni.Cluster, assertion *assert.Assertions) {
+		assertion.Equal(resource.PhaseRunning, r.Metadata().Phase())
+	})
+}
+
+func TestClusterTTLSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(ClusterTTLSuite))
+}
@@ -23,12 +23,22 @@ import (
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/mappers"
+	"github.com/siderolabs/omni/internal/backend/workloadproxy"
 )
 
 // WorkloadProxyReconciler reconciles the workload proxies for a cluster.
 type WorkloadProxyReconciler interface {
 	// Reconcile reconciles the workload proxies for a cluster.
 	Reconcile(cluster resource.ID, aliasToUpstreamAddresses map[string][]string) error
+
+	// ReconcileCustomDomains reconciles the custom domains of the exposed services of a cluster.
+	ReconcileCustomDomains(cluster resource.ID, customDomainToAlias map[string]string) error
+
+	// ReconcileHealthChecks reconciles the health checks of the exposed services of a cluster.
+	ReconcileHealthChecks(cluster resource.ID, aliasToHealthCheck map[string]workloadproxy.HealthCheck) error
+
+	// ReconcileStickySessions reconciles the exposed services of a cluster which pin the clients to the same upstream.
+	ReconcileStickySessions(cluster resource.ID, aliases []string) error
 }
 
 // ClusterWorkloadProxyStatusControllerName is the name of the controller.
@@ -83,6 +93,18 @@ func (helper *clusterWorkloadProxyStatusControllerHelper) transform(ctx context.
 			return fmt.Errorf("failed to reconcile load balancers (feature disabled): %w", err)
 		}
 
+		if err = helper.workloadProxyReconciler.ReconcileCustomDomains(cluster.Metadata().ID(), nil); err != nil {
+			return fmt.Errorf("failed to reconcile custom domains (feature disabled): %w", err)
+		}
+
+		if err = helper.workloadProxyReconciler.ReconcileHealthChecks(cluster.Metadata().ID(), nil); err != nil {
+			return fmt.Errorf("failed to reconcile health checks (feature disabled): %w", err)
+		}
+
+		if err = helper.workloadProxyReconciler.ReconcileStickySessions(cluster.Metadata().ID(), nil); err != nil {
+			return fmt.Errorf("failed to reconcile sticky sessions (feature disabled): %w", err)
+		}
+
 		status.TypedSpec().Value.NumExposedServices = 0
 
 		return nil
@@ -124,6 +146,10 @@ func (helper *clusterWorkloadProxyStatusControllerHelper) transform(ctx context.
 	}
 
 	aliasToUpstreamAddresses := make(map[string][]string, svcList.Len())
+	customDomainToAlias := map[string]string{}
+	aliasToHealthCheck := map[string]workloadproxy.HealthCheck{}
+
+	var stickySessionAliases []string
 
 	for iter := svcList.Iterator(); iter.Next(); {
 		svc := iter.Value()
@@ -150,12 +176,40 @@ func (helper *clusterWorkloadProxyStatusControllerHelper) transform(ctx context.
 		aliasToUpstreamAddresses[alias] = xslices.Map(healthyTargetHosts, func(host string) string {
 			return net.JoinHostPort(host, strconv.Itoa(int(svc.TypedSpec().Value.Port)))
 		})
+
+		if customDomain := svc.TypedSpec().Value.CustomDomain; customDomain != "" {
+			customDomainToAlias[customDomain] = alias
+		}
+
+		if healthCheckPath := svc.TypedSpec().Value.HealthCheckPath; healthCheckPath != "" {
+			aliasToHealthCheck[alias] = workloadproxy.HealthCheck{
+				Path:     healthCheckPath,
+				Interval: svc.TypedSpec().Value.GetHealthCheckInterval().AsDuration(),
+			}
+		}
+
+		if svc.TypedSpec().Value.StickySessions {
+			stickySessionAliases = append(stickySessionAliases, alias)
+		}
 	}
 
 	if err = helper.workloadProxyReconciler.Reconcile(cluster.Metadata().ID(), aliasToUpstreamAddresses); err != nil {
 		return fmt.Errorf("failed to reconcile load balancers: %w", err)
 	}
 
+	if err = helper.workloadProxyReconciler.ReconcileCustomDomains(cluster.Metadata().ID(), customDomainToAlias); err != nil {
+		// the conflicting custom domains are skipped, it doesn't affect the other services
+		logger.Warn("failed to reconcile custom domains", zap.Error(err))
+	}
+
+	if err = helper.workloadProxyReconciler.ReconcileHealthChecks(cluster.Metadata().ID(), aliasToHealthCheck); err != nil {
+		return fmt.Errorf("failed to reconcile health checks: %w", err)
+	}
+
+	if err = helper.workloadProxyReconciler.ReconcileStickySessions(cluster.Metadata().ID(), stickySessionAliases); err != nil {
+		return fmt.Errorf("failed to reconcile sticky sessions: %w", err)
+	}
+
 	status.TypedSpec().Value.NumExposedServices = uint32(len(aliasToUpstreamAddresses))
 
 	return nil
@@ -192,5 +246,17 @@ func (helper *clusterWorkloadProxyStatusControllerHelper) teardown(ctx context.C
 		logger.Error("failed to reconcile load balancers", zap.Error(err))
 	}
 
+	if err = helper.workloadProxyReconciler.ReconcileCustomDomains(cluster.Metadata().ID(), nil); err != nil {
+		logger.Error("failed to reconcile custom domains", zap.Error(err))
+	}
+
+	if err = helper.workloadProxyReconciler.ReconcileHealthChecks(cluster.Metadata().ID(), nil); err != nil {
+		logger.Error("failed to reconcile health checks", zap.Error(err))
+	}
+
+	if err = helper.workloadProxyReconciler.ReconcileStickySessions(cluster.Metadata().ID(), nil); err != nil {
+		logger.Error("failed to reconcile sticky sessions", zap.Error(err))
+	}
+
 	return nil
 }
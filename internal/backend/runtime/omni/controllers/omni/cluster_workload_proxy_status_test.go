@@ -17,11 +17,13 @@ import (
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+	"github.com/siderolabs/omni/internal/backend/workloadproxy"
 )
 
 type ClusterWorkloadProxyStatusSuite struct {
@@ -149,6 +151,101 @@ func (suite *ClusterWorkloadProxyStatusSuite) TestReconcileMappedInputDeletion()
 	})
 }
 
+func (suite *ClusterWorkloadProxyStatusSuite) TestReconcileCustomDomains() {
+	suite.startRuntime()
+
+	ctx, cancel := context.WithTimeout(suite.ctx, time.Second*5)
+	defer cancel()
+
+	workloadProxyReconciler := &mockWorkloadProxyReconciler{}
+
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewClusterWorkloadProxyStatusController(workloadProxyReconciler)))
+
+	clusterID := "test-cluster"
+	cluster := omni.NewCluster(resources.DefaultNamespace, clusterID)
+	cluster.TypedSpec().Value.Features = &specs.ClusterSpec_Features{
+		EnableWorkloadProxy: true,
+	}
+
+	suite.Require().NoError(suite.state.Create(ctx, cluster))
+
+	suite.createExposedService(clusterID, "test-exposed-service-1", 12345)
+
+	exposedService := suite.createExposedService(clusterID, "test-exposed-service-2", 12346)
+
+	_, err := safe.StateUpdateWithConflicts(ctx, suite.state, exposedService.Metadata(), func(res *omni.ExposedService) error {
+		res.TypedSpec().Value.CustomDomain = "grafana.example.org"
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	workloadProxyReconciler.assertCustomDomains(suite.T(), map[resource.ID]map[string]string{
+		clusterID: {
+			"grafana.example.org": "test-exposed-service-2-alias",
+		},
+	})
+
+	rtestutils.Destroy[*omni.ExposedService](ctx, suite.T(), suite.state, []string{exposedService.Metadata().ID()})
+
+	workloadProxyReconciler.assertCustomDomains(suite.T(), nil)
+}
+
+func (suite *ClusterWorkloadProxyStatusSuite) TestReconcileHealthChecks() {
+	suite.startRuntime()
+
+	ctx, cancel := context.WithTimeout(suite.ctx, time.Second*5)
+	defer cancel()
+
+	workloadProxyReconciler := &mockWorkloadProxyReconciler{}
+
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewClusterWorkloadProxyStatusController(workloadProxyReconciler)))
+
+	clusterID := "test-cluster"
+	cluster := omni.NewCluster(resources.DefaultNamespace, clusterID)
+	cluster.TypedSpec().Value.Features = &specs.ClusterSpec_Features{
+		EnableWorkloadProxy: true,
+	}
+
+	suite.Require().NoError(suite.state.Create(ctx, cluster))
+
+	suite.createExposedService(clusterID, "test-exposed-service-1", 12345)
+
+	exposedService := suite.createExposedService(clusterID, "test-exposed-service-2", 12346)
+
+	_, err := safe.StateUpdateWithConflicts(ctx, suite.state, exposedService.Metadata(), func(res *omni.ExposedService) error {
+		res.TypedSpec().Value.HealthCheckPath = "/healthz"
+		res.TypedSpec().Value.HealthCheckInterval = durationpb.New(10 * time.Second)
+		res.TypedSpec().Value.StickySessions = true
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	workloadProxyReconciler.assertHealthChecks(suite.T(), map[resource.ID]map[string]workloadproxy.HealthCheck{
+		clusterID: {
+			"test-exposed-service-2-alias": {
+				Path:     "/healthz",
+				Interval: 10 * time.Second,
+			},
+		},
+	})
+
+	workloadProxyReconciler.assertStickySessions(suite.T(), map[resource.ID][]string{
+		clusterID: {"test-exposed-service-2-alias"},
+	})
+
+	_, err = safe.StateUpdateWithConflicts(ctx, suite.state, cluster.Metadata(), func(res *omni.Cluster) error {
+		res.TypedSpec().Value.Features.EnableWorkloadProxy = false
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	workloadProxyReconciler.assertHealthChecks(suite.T(), nil)
+	workloadProxyReconciler.assertStickySessions(suite.T(), nil)
+}
+
 //nolint:unparam
 func (suite *ClusterWorkloadProxyStatusSuite) createClusterMachineStatus(clusterID string, id resource.ID) *omni.ClusterMachineStatus {
 	suite.T().Helper()
@@ -187,8 +284,11 @@ func TestClusterWorkloadProxyStatusSuite(t *testing.T) {
 }
 
 type mockWorkloadProxyReconciler struct {
-	data map[resource.ID]map[string][]string
-	mu   sync.Mutex
+	data           map[resource.ID]map[string][]string
+	customDomains  map[resource.ID]map[string]string
+	healthChecks   map[resource.ID]map[string]workloadproxy.HealthCheck
+	stickySessions map[resource.ID][]string
+	mu             sync.Mutex
 }
 
 func (m *mockWorkloadProxyReconciler) Reconcile(cluster resource.ID, aliasToUpstreamAddresses map[string][]string) error {
@@ -214,6 +314,108 @@ func (m *mockWorkloadProxyReconciler) Reconcile(cluster resource.ID, aliasToUpst
 	return nil
 }
 
+func (m *mockWorkloadProxyReconciler) ReconcileCustomDomains(cluster resource.ID, customDomainToAlias map[string]string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(customDomainToAlias) == 0 {
+		delete(m.customDomains, cluster)
+
+		if len(m.customDomains) == 0 {
+			m.customDomains = nil
+		}
+
+		return nil
+	}
+
+	if m.customDomains == nil {
+		m.customDomains = map[resource.ID]map[string]string{}
+	}
+
+	m.customDomains[cluster] = customDomainToAlias
+
+	return nil
+}
+
+func (m *mockWorkloadProxyReconciler) ReconcileHealthChecks(cluster resource.ID, aliasToHealthCheck map[string]workloadproxy.HealthCheck) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(aliasToHealthCheck) == 0 {
+		delete(m.healthChecks, cluster)
+
+		if len(m.healthChecks) == 0 {
+			m.healthChecks = nil
+		}
+
+		return nil
+	}
+
+	if m.healthChecks == nil {
+		m.healthChecks = map[resource.ID]map[string]workloadproxy.HealthCheck{}
+	}
+
+	m.healthChecks[cluster] = aliasToHealthCheck
+
+	return nil
+}
+
+func (m *mockWorkloadProxyReconciler) ReconcileStickySessions(cluster resource.ID, aliases []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(aliases) == 0 {
+		delete(m.stickySessions, cluster)
+
+		if len(m.stickySessions) == 0 {
+			m.stickySessions = nil
+		}
+
+		return nil
+	}
+
+	if m.stickySessions == nil {
+		m.stickySessions = map[resource.ID][]string{}
+	}
+
+	m.stickySessions[cluster] = aliases
+
+	return nil
+}
+
+func (m *mockWorkloadProxyReconciler) assertStickySessions(t *testing.T, expected map[resource.ID][]string) {
+	t.Helper()
+
+	assert.EventuallyWithT(t, func(collect *assert.CollectT) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		assert.Equal(collect, expected, m.stickySessions)
+	}, time.Second*1, time.Millisecond*50)
+}
+
+func (m *mockWorkloadProxyReconciler) assertHealthChecks(t *testing.T, expected map[resource.ID]map[string]workloadproxy.HealthCheck) {
+	t.Helper()
+
+	assert.EventuallyWithT(t, func(collect *assert.CollectT) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		assert.Equal(collect, expected, m.healthChecks)
+	}, time.Second*1, time.Millisecond*50)
+}
+
+func (m *mockWorkloadProxyReconciler) assertCustomDomains(t *testing.T, expected map[resource.ID]map[string]string) {
+	t.Helper()
+
+	assert.EventuallyWithT(t, func(collect *assert.CollectT) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		assert.Equal(collect, expected, m.customDomains)
+	}, time.Second*1, time.Millisecond*50)
+}
+
 func (m *mockWorkloadProxyReconciler) assertState(t *testing.T, expected map[resource.ID]map[string][]string) {
 	t.Helper()
 
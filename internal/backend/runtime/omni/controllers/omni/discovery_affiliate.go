@@ -0,0 +1,258 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/discovery"
+)
+
+// DiscoveryAffiliateController mirrors the affiliates of the clusters using the embedded discovery service
+// into [omni.DiscoveryAffiliate] resources.
+//
+// The affiliates which don't belong to any machine of the cluster for longer than the stale timeout are deleted
+// from the discovery service, so that they don't linger until their TTL expires.
+type DiscoveryAffiliateController struct {
+	discoveryClient DiscoveryClient
+
+	// staleSince tracks the time when the affiliate was first seen without the matching cluster machine.
+	staleSince map[resource.ID]time.Time
+
+	pollInterval time.Duration
+	staleTimeout time.Duration
+}
+
+// NewDiscoveryAffiliateController creates new DiscoveryAffiliateController.
+//
+// The discovery client should be the client of the embedded discovery service.
+// Stale affiliates are not deleted if the stale timeout is zero.
+func NewDiscoveryAffiliateController(discoveryClient DiscoveryClient, pollInterval, staleTimeout time.Duration) *DiscoveryAffiliateController {
+	return &DiscoveryAffiliateController{
+		discoveryClient: discoveryClient,
+		staleSince:      map[resource.ID]time.Time{},
+		pollInterval:    pollInterval,
+		staleTimeout:    staleTimeout,
+	}
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *DiscoveryAffiliateController) Name() string {
+	return "DiscoveryAffiliateController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *DiscoveryAffiliateController) Inputs() []controller.Input {
+	return []controller.Input{
+		safe.Input[*omni.ClusterStatus](controller.InputWeak),
+		safe.Input[*omni.ClusterSecrets](controller.InputWeak),
+		safe.Input[*omni.ClusterMachineIdentity](controller.InputWeak),
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *DiscoveryAffiliateController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: omni.DiscoveryAffiliateType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *DiscoveryAffiliateController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	ticker := time.NewTicker(ctrl.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		if err := ctrl.reconcile(ctx, r, logger); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *DiscoveryAffiliateController) reconcile(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	clusterStatuses, err := safe.ReaderListAll[*omni.ClusterStatus](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing cluster statuses: %w", err)
+	}
+
+	touched := map[resource.ID]struct{}{}
+
+	// the affiliates of the clusters which failed to be listed are kept as is until the next poll
+	unavailableClusters := map[string]struct{}{}
+
+	for iter := clusterStatuses.Iterator(); iter.Next(); {
+		clusterStatus := iter.Value()
+
+		if clusterStatus.Metadata().Phase() == resource.PhaseTearingDown || !clusterStatus.TypedSpec().Value.UseEmbeddedDiscoveryService {
+			continue
+		}
+
+		clusterName := clusterStatus.Metadata().ID()
+
+		discoveryClusterID, affiliates, err := ctrl.listAffiliates(ctx, r, clusterName)
+		if err != nil {
+			logger.Warn("failed to list the discovery service affiliates", zap.String("cluster", clusterName), zap.Error(err))
+
+			unavailableClusters[clusterName] = struct{}{}
+
+			continue
+		}
+
+		nodeIdentities, err := ctrl.nodeIdentities(ctx, r, clusterName)
+		if err != nil {
+			return err
+		}
+
+		for _, affiliate := range affiliates {
+			if _, ok := nodeIdentities[affiliate.ID]; !ok {
+				deleted, deleteErr := ctrl.deleteIfStale(ctx, discoveryClusterID, affiliate.ID)
+				if deleteErr != nil {
+					logger.Warn("failed to delete the stale discovery service affiliate", zap.String("cluster", clusterName), zap.String("affiliate", affiliate.ID), zap.Error(deleteErr))
+				}
+
+				if deleted {
+					logger.Info("deleted the stale discovery service affiliate", zap.String("cluster", clusterName), zap.String("affiliate", affiliate.ID))
+
+					continue
+				}
+			} else {
+				delete(ctrl.staleSince, affiliate.ID)
+			}
+
+			if err = safe.WriterModify(ctx, r, omni.NewDiscoveryAffiliate(resources.EphemeralNamespace, affiliate.ID), func(res *omni.DiscoveryAffiliate) error {
+				res.Metadata().Labels().Set(omni.LabelCluster, clusterName)
+
+				spec := res.TypedSpec().Value
+
+				spec.Decrypted = affiliate.Decrypted
+				spec.Hostname = affiliate.Hostname
+				spec.Nodename = affiliate.Nodename
+				spec.MachineType = affiliate.MachineType
+				spec.OperatingSystem = affiliate.OperatingSystem
+				spec.Addresses = affiliate.Addresses
+				spec.Endpoints = affiliate.Endpoints
+				spec.KubespanPublicKey = affiliate.KubeSpanKey
+				spec.ControlPlane = affiliate.ControlPlane
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error updating discovery affiliate %q: %w", affiliate.ID, err)
+			}
+
+			touched[affiliate.ID] = struct{}{}
+		}
+	}
+
+	return cleanupOutputs(ctx, r, func(res *omni.DiscoveryAffiliate) bool {
+		if _, ok := touched[res.Metadata().ID()]; ok {
+			return true
+		}
+
+		clusterName, _ := res.Metadata().Labels().Get(omni.LabelCluster)
+
+		if _, ok := unavailableClusters[clusterName]; ok {
+			return true
+		}
+
+		delete(ctrl.staleSince, res.Metadata().ID())
+
+		return false
+	})
+}
+
+// deleteIfStale deletes the affiliate without the matching cluster machine from the discovery service once the stale timeout passes.
+func (ctrl *DiscoveryAffiliateController) deleteIfStale(ctx context.Context, discoveryClusterID, affiliateID string) (bool, error) {
+	if ctrl.staleTimeout == 0 {
+		return false, nil
+	}
+
+	staleSince, ok := ctrl.staleSince[affiliateID]
+	if !ok {
+		ctrl.staleSince[affiliateID] = time.Now()
+
+		return false, nil
+	}
+
+	if time.Since(staleSince) < ctrl.staleTimeout {
+		return false, nil
+	}
+
+	if err := ctrl.discoveryClient.AffiliateDelete(ctx, discoveryClusterID, affiliateID); err != nil {
+		return false, err
+	}
+
+	delete(ctrl.staleSince, affiliateID)
+
+	return true, nil
+}
+
+// nodeIdentities returns the set of the node identities of the cluster machines, which match the IDs of their affiliates.
+func (ctrl *DiscoveryAffiliateController) nodeIdentities(ctx context.Context, r controller.Reader, clusterName string) (map[string]struct{}, error) {
+	identities, err := safe.ReaderListAll[*omni.ClusterMachineIdentity](ctx, r, state.WithLabelQuery(resource.LabelEqual(omni.LabelCluster, clusterName)))
+	if err != nil {
+		return nil, fmt.Errorf("error listing cluster machine identities: %w", err)
+	}
+
+	nodeIdentities := make(map[string]struct{}, identities.Len())
+
+	for iter := identities.Iterator(); iter.Next(); {
+		if nodeIdentity := iter.Value().TypedSpec().Value.NodeIdentity; nodeIdentity != "" {
+			nodeIdentities[nodeIdentity] = struct{}{}
+		}
+	}
+
+	return nodeIdentities, nil
+}
+
+// listAffiliates returns the ID of the cluster in the discovery service and its affiliates.
+func (ctrl *DiscoveryAffiliateController) listAffiliates(ctx context.Context, r controller.Reader, clusterName string) (string, []discovery.Affiliate, error) {
+	secrets, err := safe.ReaderGetByID[*omni.ClusterSecrets](ctx, r, clusterName)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return "", nil, nil
+		}
+
+		return "", nil, fmt.Errorf("error getting cluster secrets: %w", err)
+	}
+
+	bundle, err := omni.ToSecretsBundle(secrets)
+	if err != nil {
+		return "", nil, fmt.Errorf("error converting cluster secrets to bundle: %w", err)
+	}
+
+	clusterSecrets := []string{bundle.Cluster.Secret}
+
+	// accept the affiliates of the machines which are not updated yet while the key is being rotated
+	if previousKey := secrets.TypedSpec().Value.PreviousDiscoveryKey; previousKey != "" {
+		clusterSecrets = append(clusterSecrets, previousKey)
+	}
+
+	affiliates, err := ctrl.discoveryClient.ListAffiliates(ctx, bundle.Cluster.ID, clusterSecrets...)
+
+	return bundle.Cluster.ID, affiliates, err
+}
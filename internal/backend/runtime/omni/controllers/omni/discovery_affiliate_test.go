@@ -0,0 +1,197 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	talossecrets "github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/discovery"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+)
+
+type affiliateListerMock struct {
+	err        error
+	affiliates map[string][]discovery.Affiliate
+	deleted    []string
+	mu         sync.Mutex
+}
+
+func (m *affiliateListerMock) AffiliateDelete(_ context.Context, cluster, affiliate string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.affiliates[cluster] = slices.DeleteFunc(m.affiliates[cluster], func(a discovery.Affiliate) bool { return a.ID == affiliate })
+	m.deleted = append(m.deleted, affiliate)
+
+	return nil
+}
+
+func (m *affiliateListerMock) getDeleted() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return slices.Clone(m.deleted)
+}
+
+func (m *affiliateListerMock) ListAffiliates(_ context.Context, cluster string, _ ...string) ([]discovery.Affiliate, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return slices.Clone(m.affiliates[cluster]), m.err
+}
+
+func (m *affiliateListerMock) set(cluster string, affiliates []discovery.Affiliate, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.affiliates[cluster] = affiliates
+	m.err = err
+}
+
+type DiscoveryAffiliateSuite struct {
+	OmniSuite
+}
+
+func (suite *DiscoveryAffiliateSuite) createClusterSecrets(clusterName string) *talossecrets.Bundle {
+	bundle, err := talossecrets.NewBundle(talossecrets.NewClock(), config.TalosVersionCurrent)
+	suite.Require().NoError(err)
+
+	data, err := json.Marshal(bundle)
+	suite.Require().NoError(err)
+
+	secrets := omni.NewClusterSecrets(resources.DefaultNamespace, clusterName)
+	secrets.TypedSpec().Value.Data = data
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, secrets))
+
+	return bundle
+}
+
+func (suite *DiscoveryAffiliateSuite) TestReconcile() {
+	suite.startRuntime()
+
+	clusterName := "test-cluster"
+
+	bundle := suite.createClusterSecrets(clusterName)
+
+	client := &affiliateListerMock{
+		affiliates: map[string][]discovery.Affiliate{},
+	}
+
+	client.set(bundle.Cluster.ID, []discovery.Affiliate{
+		{
+			ID:           "affiliate-1",
+			Hostname:     "node-1",
+			Addresses:    []string{"10.5.0.2"},
+			Endpoints:    []string{"10.5.0.2:51820"},
+			ControlPlane: true,
+			Decrypted:    true,
+		},
+		{
+			ID: "affiliate-2",
+		},
+	}, nil)
+
+	suite.Require().NoError(suite.runtime.RegisterController(omnictrl.NewDiscoveryAffiliateController(client, 100*time.Millisecond, 0)))
+
+	clusterStatus := omni.NewClusterStatus(resources.DefaultNamespace, clusterName)
+	clusterStatus.TypedSpec().Value.UseEmbeddedDiscoveryService = true
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, clusterStatus))
+
+	rtestutils.AssertResource[*omni.DiscoveryAffiliate](suite.ctx, suite.T(), suite.state, "affiliate-1", func(r *omni.DiscoveryAffiliate, assertion *assert.Assertions) {
+		cluster, _ := r.Metadata().Labels().Get(omni.LabelCluster)
+
+		assertion.Equal(clusterName, cluster)
+		assertion.Equal("node-1", r.TypedSpec().Value.Hostname)
+		assertion.Equal([]string{"10.5.0.2"}, r.TypedSpec().Value.Addresses)
+		assertion.Equal([]string{"10.5.0.2:51820"}, r.TypedSpec().Value.Endpoints)
+		assertion.True(r.TypedSpec().Value.ControlPlane)
+		assertion.True(r.TypedSpec().Value.Decrypted)
+	})
+
+	rtestutils.AssertResource[*omni.DiscoveryAffiliate](suite.ctx, suite.T(), suite.state, "affiliate-2", func(r *omni.DiscoveryAffiliate, assertion *assert.Assertions) {
+		assertion.False(r.TypedSpec().Value.Decrypted)
+	})
+
+	// the affiliates are kept while the discovery service is unavailable
+	client.set(bundle.Cluster.ID, nil, errors.New("unavailable"))
+
+	time.Sleep(300 * time.Millisecond)
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []string{"affiliate-1", "affiliate-2"}, func(*omni.DiscoveryAffiliate, *assert.Assertions) {})
+
+	// the affiliate which left the cluster is removed
+	client.set(bundle.Cluster.ID, []discovery.Affiliate{{ID: "affiliate-1"}}, nil)
+
+	rtestutils.AssertNoResource[*omni.DiscoveryAffiliate](suite.ctx, suite.T(), suite.state, "affiliate-2")
+
+	// the cluster stops using the embedded discovery service
+	_, err := safe.StateUpdateWithConflicts[*omni.ClusterStatus](suite.ctx, suite.state, clusterStatus.Metadata(), func(res *omni.ClusterStatus) error {
+		res.TypedSpec().Value.UseEmbeddedDiscoveryService = false
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	rtestutils.AssertNoResource[*omni.DiscoveryAffiliate](suite.ctx, suite.T(), suite.state, "affiliate-1")
+}
+
+func (suite *DiscoveryAffiliateSuite) TestDeleteStale() {
+	suite.startRuntime()
+
+	clusterName := "test-cluster-stale"
+
+	bundle := suite.createClusterSecrets(clusterName)
+
+	client := &affiliateListerMock{
+		affiliates: map[string][]discovery.Affiliate{},
+	}
+
+	client.set(bundle.Cluster.ID, []discovery.Affiliate{{ID: "affiliate-active"}, {ID: "affiliate-stale"}}, nil)
+
+	identity := omni.NewClusterMachineIdentity(resources.DefaultNamespace, "machine-1")
+	identity.Metadata().Labels().Set(omni.LabelCluster, clusterName)
+	identity.TypedSpec().Value.NodeIdentity = "affiliate-active"
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, identity))
+
+	suite.Require().NoError(suite.runtime.RegisterController(omnictrl.NewDiscoveryAffiliateController(client, 100*time.Millisecond, 500*time.Millisecond)))
+
+	clusterStatus := omni.NewClusterStatus(resources.DefaultNamespace, clusterName)
+	clusterStatus.TypedSpec().Value.UseEmbeddedDiscoveryService = true
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, clusterStatus))
+
+	// the stale affiliate is kept until the timeout passes
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []string{"affiliate-active", "affiliate-stale"}, func(*omni.DiscoveryAffiliate, *assert.Assertions) {})
+
+	rtestutils.AssertNoResource[*omni.DiscoveryAffiliate](suite.ctx, suite.T(), suite.state, "affiliate-stale")
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []string{"affiliate-active"}, func(*omni.DiscoveryAffiliate, *assert.Assertions) {})
+
+	suite.Assert().Equal([]string{"affiliate-stale"}, client.getDeleted())
+}
+
+func TestDiscoveryAffiliateSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(DiscoveryAffiliateSuite))
+}
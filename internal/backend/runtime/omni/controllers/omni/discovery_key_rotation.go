@@ -0,0 +1,174 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/controller/generic/qtransform"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/xerrors"
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/mappers"
+)
+
+// DiscoveryKeyRotationController reports the progress of the discovery service encryption key rotation.
+//
+// The rotation goes through the following phases:
+//   - Generating: SecretsController generates the new key and keeps the old one in the cluster secrets;
+//   - Publishing: both keys are accepted by Omni, the machine configs are regenerated with the new key;
+//   - Rolling: the machine configs with the new key are applied to the machines;
+//   - Retiring: all machines use the new key, SecretsController drops the old key;
+//   - Done.
+type DiscoveryKeyRotationController = qtransform.QController[*omni.DiscoveryKeyRotation, *omni.DiscoveryKeyRotationStatus]
+
+// NewDiscoveryKeyRotationController initializes DiscoveryKeyRotationController.
+func NewDiscoveryKeyRotationController() *DiscoveryKeyRotationController {
+	return qtransform.NewQController(
+		qtransform.Settings[*omni.DiscoveryKeyRotation, *omni.DiscoveryKeyRotationStatus]{
+			Name: "DiscoveryKeyRotationController",
+			MapMetadataFunc: func(rotation *omni.DiscoveryKeyRotation) *omni.DiscoveryKeyRotationStatus {
+				return omni.NewDiscoveryKeyRotationStatus(rotation.Metadata().ID())
+			},
+			UnmapMetadataFunc: func(rotationStatus *omni.DiscoveryKeyRotationStatus) *omni.DiscoveryKeyRotation {
+				return omni.NewDiscoveryKeyRotation(rotationStatus.Metadata().ID())
+			},
+			TransformFunc: func(ctx context.Context, r controller.Reader, _ *zap.Logger, rotation *omni.DiscoveryKeyRotation, rotationStatus *omni.DiscoveryKeyRotationStatus) error {
+				clusterName := rotation.Metadata().ID()
+
+				secrets, err := safe.ReaderGetByID[*omni.ClusterSecrets](ctx, r, clusterName)
+				if err != nil {
+					if state.IsNotFoundError(err) {
+						return xerrors.NewTaggedf[qtransform.SkipReconcileTag]("cluster %q secrets are not created yet", clusterName)
+					}
+
+					return fmt.Errorf("error getting cluster secrets: %w", err)
+				}
+
+				rotationStatus.Metadata().Labels().Set(omni.LabelCluster, clusterName)
+
+				spec := rotationStatus.TypedSpec().Value
+				secretsSpec := secrets.TypedSpec().Value
+
+				spec.RequestedAt = rotation.TypedSpec().Value.RequestedAt
+
+				if spec.RequestedAt == nil || !proto.Equal(spec.RequestedAt, secretsSpec.DiscoveryKeyRotationRequestedAt) {
+					spec.Phase = specs.DiscoveryKeyRotationStatusSpec_Generating
+					spec.UpdatedMachines = 0
+					spec.TotalMachines = 0
+
+					return nil
+				}
+
+				bundle, err := omni.ToSecretsBundle(secrets)
+				if err != nil {
+					return fmt.Errorf("error converting cluster secrets to bundle: %w", err)
+				}
+
+				regenerated, updated, total, err := countMachineConfigUpdates(ctx, r, clusterName, func(cfg config.Provider) bool {
+					return cfg.Cluster().Secret() == bundle.Cluster.Secret
+				})
+				if err != nil {
+					return err
+				}
+
+				spec.UpdatedMachines = updated
+				spec.TotalMachines = total
+
+				switch {
+				case secretsSpec.PreviousDiscoveryKey == "":
+					spec.Phase = specs.DiscoveryKeyRotationStatusSpec_Done
+				case updated == total:
+					spec.Phase = specs.DiscoveryKeyRotationStatusSpec_Retiring
+				case regenerated == total:
+					spec.Phase = specs.DiscoveryKeyRotationStatusSpec_Rolling
+				default:
+					spec.Phase = specs.DiscoveryKeyRotationStatusSpec_Publishing
+				}
+
+				return nil
+			},
+		},
+		qtransform.WithExtraMappedInput(
+			qtransform.MapperSameID[*omni.ClusterSecrets, *omni.DiscoveryKeyRotation](),
+		),
+		qtransform.WithExtraMappedInput(
+			mappers.MapByClusterLabel[*omni.ClusterMachineConfig, *omni.DiscoveryKeyRotation](),
+		),
+		qtransform.WithExtraMappedInput(
+			mappers.MapByClusterLabel[*omni.ClusterMachineConfigStatus, *omni.DiscoveryKeyRotation](),
+		),
+	)
+}
+
+// countMachineConfigUpdates counts the machine configs of the cluster which match the given predicate,
+// and the machines which have these configs applied.
+func countMachineConfigUpdates(ctx context.Context, r controller.Reader, clusterName string, matches func(config.Provider) bool) (regenerated, updated, total uint32, err error) {
+	machineConfigs, err := safe.ReaderListAll[*omni.ClusterMachineConfig](ctx, r, state.WithLabelQuery(resource.LabelEqual(omni.LabelCluster, clusterName)))
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("error listing cluster machine configs: %w", err)
+	}
+
+	for iter := machineConfigs.Iterator(); iter.Next(); {
+		machineConfig := iter.Value()
+
+		// the machine config which is being torn down won't be applied anymore
+		if machineConfig.Metadata().Phase() == resource.PhaseTearingDown {
+			continue
+		}
+
+		total++
+
+		data := machineConfig.TypedSpec().Value.GetData()
+		if data == nil {
+			continue
+		}
+
+		var cfg config.Provider
+
+		cfg, err = configloader.NewFromBytes(data)
+		if err != nil {
+			return 0, 0, 0, fmt.Errorf("error loading machine config %q: %w", machineConfig.Metadata().ID(), err)
+		}
+
+		if !matches(cfg) {
+			continue
+		}
+
+		regenerated++
+
+		var configStatus *omni.ClusterMachineConfigStatus
+
+		configStatus, err = safe.ReaderGetByID[*omni.ClusterMachineConfigStatus](ctx, r, machineConfig.Metadata().ID())
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return 0, 0, 0, fmt.Errorf("error getting cluster machine config status: %w", err)
+		}
+
+		shaSum := sha256.Sum256(data)
+
+		if configStatus.TypedSpec().Value.ClusterMachineConfigSha256 == hex.EncodeToString(shaSum[:]) {
+			updated++
+		}
+	}
+
+	return regenerated, updated, total, nil
+}
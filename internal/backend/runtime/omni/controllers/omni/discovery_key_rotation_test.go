@@ -0,0 +1,87 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+)
+
+type DiscoveryKeyRotationSuite struct {
+	OmniSuite
+}
+
+func (suite *DiscoveryKeyRotationSuite) TestRotation() {
+	require := suite.Require()
+
+	suite.startRuntime()
+
+	require.NoError(suite.runtime.RegisterQController(omnictrl.NewSecretsController(nil)))
+	require.NoError(suite.runtime.RegisterQController(omnictrl.NewDiscoveryKeyRotationController()))
+
+	clusterName := "rotation"
+
+	// the machine config which is not generated yet blocks the rotation
+	machineConfig := suite.createSecretsCluster(clusterName, "1.7.0")
+
+	var oldKey string
+
+	rtestutils.AssertResource[*omni.ClusterSecrets](suite.ctx, suite.T(), suite.state, clusterName, func(res *omni.ClusterSecrets, assertion *assert.Assertions) {
+		bundle, err := omni.ToSecretsBundle(res)
+		assertion.NoError(err)
+
+		oldKey = bundle.Cluster.Secret
+	})
+
+	rotation := omni.NewDiscoveryKeyRotation(clusterName)
+	rotation.TypedSpec().Value.RequestedAt = timestamppb.Now()
+
+	require.NoError(suite.state.Create(suite.ctx, rotation))
+
+	rtestutils.AssertResource[*omni.DiscoveryKeyRotationStatus](suite.ctx, suite.T(), suite.state, clusterName,
+		func(res *omni.DiscoveryKeyRotationStatus, assertion *assert.Assertions) {
+			assertion.Equal(specs.DiscoveryKeyRotationStatusSpec_Publishing, res.TypedSpec().Value.Phase)
+			assertion.EqualValues(0, res.TypedSpec().Value.UpdatedMachines)
+			assertion.EqualValues(1, res.TypedSpec().Value.TotalMachines)
+		},
+	)
+
+	rtestutils.AssertResource[*omni.ClusterSecrets](suite.ctx, suite.T(), suite.state, clusterName, func(res *omni.ClusterSecrets, assertion *assert.Assertions) {
+		bundle, err := omni.ToSecretsBundle(res)
+		assertion.NoError(err)
+
+		assertion.NotEqual(oldKey, bundle.Cluster.Secret)
+		assertion.Equal(oldKey, res.TypedSpec().Value.PreviousDiscoveryKey)
+	})
+
+	// all machines are updated, the old key is retired
+	rtestutils.Destroy[*omni.ClusterMachineConfig](suite.ctx, suite.T(), suite.state, []resource.ID{machineConfig.Metadata().ID()})
+
+	rtestutils.AssertResource[*omni.DiscoveryKeyRotationStatus](suite.ctx, suite.T(), suite.state, clusterName,
+		func(res *omni.DiscoveryKeyRotationStatus, assertion *assert.Assertions) {
+			assertion.Equal(specs.DiscoveryKeyRotationStatusSpec_Done, res.TypedSpec().Value.Phase)
+		},
+	)
+
+	rtestutils.AssertResource[*omni.ClusterSecrets](suite.ctx, suite.T(), suite.state, clusterName, func(res *omni.ClusterSecrets, assertion *assert.Assertions) {
+		assertion.Empty(res.TypedSpec().Value.PreviousDiscoveryKey)
+	})
+}
+
+func TestDiscoveryKeyRotationSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(DiscoveryKeyRotationSuite))
+}
@@ -267,7 +267,7 @@ func (ctrl *EtcdBackupController) findClustersToBackup(ctx context.Context, r co
 			continue
 		}
 
-		latestBackupTime, err := ctrl.latestBackupTime(ctx, backupData.TypedSpec().Value.ClusterUuid)
+		latestBackupTime, err := ctrl.latestBackupTime(ctx, clusterID, backupData.TypedSpec().Value.ClusterUuid)
 		if err != nil {
 			ctrl.updateBackupStatus(ctx, r, clusterID, ctrl.settings.Clock.Now(), err, logger)
 
@@ -316,12 +316,12 @@ func (ctrl *EtcdBackupController) shouldManualBackup(emb *omni.EtcdManualBackup)
 	return true
 }
 
-func (ctrl *EtcdBackupController) latestBackupTime(ctx context.Context, clusterUUID string) (time.Time, error) {
+func (ctrl *EtcdBackupController) latestBackupTime(ctx context.Context, clusterID, clusterUUID string) (time.Time, error) {
 	if latestBackupTime, ok := ctrl.lastBackup.Get(clusterUUID); ok {
 		return latestBackupTime, nil
 	}
 
-	st, err := ctrl.settings.StoreFactory.GetStore()
+	st, err := store.GetClusterStore(ctrl.settings.StoreFactory, clusterID)
 	if err != nil {
 		return time.Time{}, fmt.Errorf("failed to get store: %w", err)
 	}
@@ -368,7 +368,7 @@ func (ctrl *EtcdBackupController) doBackup(
 
 	now := ctrl.settings.Clock.Now()
 
-	st, err := ctrl.settings.StoreFactory.GetStore()
+	st, err := store.GetClusterStore(ctrl.settings.StoreFactory, clusterName)
 	if err != nil {
 		return fmt.Errorf("failed to get store: %w", err)
 	}
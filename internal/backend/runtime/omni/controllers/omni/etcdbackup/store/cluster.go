@@ -0,0 +1,146 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/panichandler"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup"
+)
+
+// ClusterFactory is a factory which can use a different etcd backup store for each cluster.
+type ClusterFactory interface {
+	Factory
+	GetClusterStore(cluster resource.ID) (etcdbackup.Store, error)
+}
+
+// GetClusterStore returns the etcd backup store of the cluster.
+//
+// The factories which don't support the cluster stores return the same store for all clusters.
+func GetClusterStore(factory Factory, cluster resource.ID) (etcdbackup.Store, error) {
+	if clusterFactory, ok := factory.(ClusterFactory); ok {
+		return clusterFactory.GetClusterStore(cluster)
+	}
+
+	return factory.GetStore()
+}
+
+type clusterStore struct {
+	store etcdbackup.Store
+	err   error
+}
+
+// clusterStoreFactory uses the stores configured by [omni.EtcdBackupStorageConfig] for the clusters which have it,
+// the rest of the clusters use the store of the wrapped factory.
+type clusterStoreFactory struct {
+	Factory
+
+	stores map[resource.ID]clusterStore
+	mx     sync.Mutex
+}
+
+// Check interfaces.
+var _ ClusterFactory = &clusterStoreFactory{}
+
+func newClusterStoreFactory(factory Factory) *clusterStoreFactory {
+	return &clusterStoreFactory{
+		Factory: factory,
+		stores:  map[resource.ID]clusterStore{},
+	}
+}
+
+// GetClusterStore implements [ClusterFactory].
+func (f *clusterStoreFactory) GetClusterStore(cluster resource.ID) (etcdbackup.Store, error) {
+	f.mx.Lock()
+	stored, ok := f.stores[cluster]
+	f.mx.Unlock()
+
+	if !ok {
+		return f.Factory.GetStore()
+	}
+
+	return stored.store, stored.err
+}
+
+// Start starts the wrapped factory and watches the state for [omni.EtcdBackupStorageConfig] updates.
+func (f *clusterStoreFactory) Start(ctx context.Context, st state.State, logger *zap.Logger) error {
+	eg, ctx := panichandler.ErrGroupWithContext(ctx)
+
+	eg.Go(func() error {
+		return f.Factory.Start(ctx, st, logger)
+	})
+
+	eg.Go(func() error {
+		return f.watch(ctx, st, logger)
+	})
+
+	return eg.Wait()
+}
+
+func (f *clusterStoreFactory) watch(ctx context.Context, st state.State, logger *zap.Logger) error {
+	eventCh := make(chan safe.WrappedStateEvent[*omni.EtcdBackupStorageConfig])
+
+	if err := safe.StateWatchKind(ctx, st, omni.NewEtcdBackupStorageConfig("").Metadata(), eventCh, state.WithBootstrapContents(true)); err != nil {
+		return fmt.Errorf("failed to watch EtcdBackupStorageConfig: %w", err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.Canceled) {
+				return nil
+			}
+
+			return fmt.Errorf("cluster store factory context error: %w", ctx.Err())
+		case ev := <-eventCh:
+			switch ev.Type() { //nolint:exhaustive
+			case state.Errored:
+				return fmt.Errorf("cluster store factory error: %w", ev.Error())
+			case state.Bootstrapped:
+				continue
+			case state.Destroyed:
+				res, err := ev.Resource()
+				if err != nil {
+					return fmt.Errorf("cluster store: got incorrect resource: %w", err)
+				}
+
+				f.mx.Lock()
+				delete(f.stores, res.Metadata().ID())
+				f.mx.Unlock()
+
+				logger.Debug("etcd backup storage of the cluster is reset", zap.String("cluster", res.Metadata().ID()))
+			case state.Created, state.Updated:
+				res, err := ev.Resource()
+				if err != nil {
+					return fmt.Errorf("cluster store: got incorrect resource: %w", err)
+				}
+
+				store, err := NewStoreFromConfig(ctx, res.TypedSpec().Value)
+				if err != nil {
+					logger.Warn("failed to set up etcd backup storage of the cluster", zap.String("cluster", res.Metadata().ID()), zap.Error(err))
+
+					err = fmt.Errorf("etcd backup storage of the cluster %q is misconfigured: %w", res.Metadata().ID(), err)
+				}
+
+				f.mx.Lock()
+				f.stores[res.Metadata().ID()] = clusterStore{store: store, err: err}
+				f.mx.Unlock()
+
+				logger.Debug("etcd backup storage of the cluster is set", zap.String("cluster", res.Metadata().ID()))
+			}
+		}
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/etcdbackup/azstore"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/etcdbackup/crypt"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/etcdbackup/fstore"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/etcdbackup/s3store"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+// gcsEndpoint is the endpoint of the S3 compatible XML API of Google Cloud Storage.
+const gcsEndpoint = "https://storage.googleapis.com"
+
+// Driver creates the etcd backup stores of a single storage kind from the cluster storage config.
+type Driver interface {
+	// Name returns the name of the storage kind.
+	Name() string
+	// Configured returns true if the storage config selects the driver.
+	Configured(spec *specs.EtcdBackupStorageConfigSpec) bool
+	// Validate checks the storage config without accessing the storage.
+	Validate(spec *specs.EtcdBackupStorageConfigSpec) error
+	// NewStore creates the store, the returned store doesn't encrypt the backups.
+	NewStore(ctx context.Context, spec *specs.EtcdBackupStorageConfigSpec) (etcdbackup.Store, error)
+}
+
+// Drivers is the list of the supported storage drivers.
+var Drivers = []Driver{
+	s3Driver{},
+	gcsDriver{},
+	azureDriver{},
+	localDriver{},
+}
+
+// ConfiguredDriver returns the driver selected by the storage config, exactly one driver should be configured.
+func ConfiguredDriver(spec *specs.EtcdBackupStorageConfigSpec) (Driver, error) {
+	var (
+		result Driver
+		names  []string
+	)
+
+	for _, driver := range Drivers {
+		if driver.Configured(spec) {
+			result = driver
+
+			names = append(names, driver.Name())
+		}
+	}
+
+	switch len(names) {
+	case 0:
+		return nil, errors.New("no etcd backup storage is configured")
+	case 1:
+		return result, nil
+	default:
+		return nil, fmt.Errorf("exactly one etcd backup storage should be configured, got: %s", strings.Join(names, ", "))
+	}
+}
+
+// ValidateStorageConfig checks the storage config without accessing the storage.
+func ValidateStorageConfig(spec *specs.EtcdBackupStorageConfigSpec) error {
+	driver, err := ConfiguredDriver(spec)
+	if err != nil {
+		return err
+	}
+
+	if err = driver.Validate(spec); err != nil {
+		return fmt.Errorf("invalid %s storage config: %w", driver.Name(), err)
+	}
+
+	return nil
+}
+
+// NewStoreFromConfig creates the encrypting etcd backup store from the storage config.
+func NewStoreFromConfig(ctx context.Context, spec *specs.EtcdBackupStorageConfigSpec) (etcdbackup.Store, error) {
+	driver, err := ConfiguredDriver(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = driver.Validate(spec); err != nil {
+		return nil, fmt.Errorf("invalid %s storage config: %w", driver.Name(), err)
+	}
+
+	store, err := driver.NewStore(ctx, spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s store: %w", driver.Name(), err)
+	}
+
+	return crypt.NewStore(store), nil
+}
+
+type s3Driver struct{}
+
+func (s3Driver) Name() string { return "s3" }
+
+func (s3Driver) Configured(spec *specs.EtcdBackupStorageConfigSpec) bool {
+	return spec.GetS3() != nil
+}
+
+func (s3Driver) Validate(spec *specs.EtcdBackupStorageConfigSpec) error {
+	if spec.GetS3().GetBucket() == "" {
+		return errors.New("bucket must be specified")
+	}
+
+	return nil
+}
+
+func (s3Driver) NewStore(ctx context.Context, spec *specs.EtcdBackupStorageConfigSpec) (etcdbackup.Store, error) {
+	return newS3Store(ctx, spec.GetS3())
+}
+
+// gcsDriver uses the S3 compatible API of Google Cloud Storage with the HMAC keys.
+type gcsDriver struct{}
+
+func (gcsDriver) Name() string { return "gcs" }
+
+func (gcsDriver) Configured(spec *specs.EtcdBackupStorageConfigSpec) bool {
+	return spec.GetGcs() != nil
+}
+
+func (gcsDriver) Validate(spec *specs.EtcdBackupStorageConfigSpec) error {
+	gcs := spec.GetGcs()
+
+	switch {
+	case gcs.GetBucket() == "":
+		return errors.New("bucket must be specified")
+	case gcs.GetAccessKeyId() == "" || gcs.GetSecretAccessKey() == "":
+		return errors.New("HMAC access key and secret must be specified")
+	}
+
+	return nil
+}
+
+func (gcsDriver) NewStore(ctx context.Context, spec *specs.EtcdBackupStorageConfigSpec) (etcdbackup.Store, error) {
+	gcs := spec.GetGcs()
+
+	return newS3Store(ctx, &specs.EtcdBackupS3ConfSpec{
+		Bucket:          gcs.GetBucket(),
+		Region:          "auto",
+		Endpoint:        gcsEndpoint,
+		AccessKeyId:     gcs.GetAccessKeyId(),
+		SecretAccessKey: gcs.GetSecretAccessKey(),
+	})
+}
+
+func newS3Store(ctx context.Context, spec *specs.EtcdBackupS3ConfSpec) (etcdbackup.Store, error) {
+	client, bucket, err := S3ClientFromSpec(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return s3store.NewStore(client, manager.NewUploader(client), bucket), nil
+}
+
+type azureDriver struct{}
+
+func (azureDriver) Name() string { return "azure" }
+
+func (azureDriver) Configured(spec *specs.EtcdBackupStorageConfigSpec) bool {
+	return spec.GetAzure() != nil
+}
+
+func (azureDriver) Validate(spec *specs.EtcdBackupStorageConfigSpec) error {
+	azure := spec.GetAzure()
+
+	switch {
+	case azure.GetContainerUrl() == "":
+		return errors.New("container URL must be specified")
+	case azure.GetSasToken() == "":
+		return errors.New("SAS token must be specified")
+	}
+
+	return nil
+}
+
+func (azureDriver) NewStore(_ context.Context, spec *specs.EtcdBackupStorageConfigSpec) (etcdbackup.Store, error) {
+	return azstore.NewStore(http.DefaultClient, spec.GetAzure().GetContainerUrl(), spec.GetAzure().GetSasToken())
+}
+
+// localDriver stores the backups in the subdirectory of the local etcd backup path configured for Omni.
+type localDriver struct{}
+
+func (localDriver) Name() string { return "local" }
+
+func (localDriver) Configured(spec *specs.EtcdBackupStorageConfigSpec) bool {
+	return spec.GetLocal() != nil
+}
+
+func (localDriver) Validate(spec *specs.EtcdBackupStorageConfigSpec) error {
+	if config.Config.EtcdBackup.LocalPath == "" {
+		return errors.New("local etcd backup path is not configured for Omni")
+	}
+
+	if dir := spec.GetLocal().GetPath(); dir == "" || !filepath.IsLocal(dir) {
+		return fmt.Errorf("path %q must be a relative path inside the local etcd backup path", dir)
+	}
+
+	return nil
+}
+
+func (localDriver) NewStore(_ context.Context, spec *specs.EtcdBackupStorageConfigSpec) (etcdbackup.Store, error) {
+	return fstore.NewFileStore(filepath.Join(config.Config.EtcdBackup.LocalPath, spec.GetLocal().GetPath())), nil
+}
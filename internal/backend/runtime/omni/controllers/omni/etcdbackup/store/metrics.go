@@ -9,6 +9,7 @@ import (
 	"context"
 	"io"
 
+	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
@@ -18,7 +19,7 @@ import (
 
 // FactoryWithMetrics is a factory for etcd backup stores with metrics.
 type FactoryWithMetrics interface {
-	Factory
+	ClusterFactory
 	prometheus.Collector
 }
 
@@ -79,6 +80,18 @@ func (s *factoryWithMetrics) GetStore() (etcdbackup.Store, error) {
 	}, nil
 }
 
+func (s *factoryWithMetrics) GetClusterStore(cluster resource.ID) (etcdbackup.Store, error) {
+	store, err := GetClusterStore(s.factory, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	return &storeWithMetrics{
+		store:   store,
+		metrics: s,
+	}, nil
+}
+
 func (s *factoryWithMetrics) Description() string {
 	return s.factory.Description()
 }
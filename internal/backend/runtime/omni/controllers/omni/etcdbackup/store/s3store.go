@@ -25,6 +25,7 @@ import (
 	"github.com/siderolabs/go-pointer"
 	"go.uber.org/zap"
 
+	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup/store/internal/s3middleware"
@@ -144,14 +145,19 @@ func (sf *S3StoreFactory) updateStore(ctx context.Context, st state.State, resou
 
 // S3ClientFromResource returns an S3 client and a bucket name.
 func S3ClientFromResource(ctx context.Context, s3Conf *omni.EtcdBackupS3Conf) (*s3.Client, string, error) {
-	bucket := s3Conf.TypedSpec().Value.GetBucket()
+	return S3ClientFromSpec(ctx, s3Conf.TypedSpec().Value)
+}
+
+// S3ClientFromSpec returns an S3 client and a bucket name.
+func S3ClientFromSpec(ctx context.Context, spec *specs.EtcdBackupS3ConfSpec) (*s3.Client, string, error) {
+	bucket := spec.GetBucket()
 	if bucket == "" {
 		return nil, "", errors.New("bucket must be specified")
 	}
 
-	accessKey := s3Conf.TypedSpec().Value.GetAccessKeyId()
-	secretKey := s3Conf.TypedSpec().Value.GetSecretAccessKey()
-	sessionToken := s3Conf.TypedSpec().Value.GetSessionToken()
+	accessKey := spec.GetAccessKeyId()
+	secretKey := spec.GetSecretAccessKey()
+	sessionToken := spec.GetSessionToken()
 
 	if (accessKey == "" && secretKey != "") || (accessKey != "" && secretKey == "") {
 		return nil, "", errors.New("access key and secret key must be specified together")
@@ -165,14 +171,14 @@ func S3ClientFromResource(ctx context.Context, s3Conf *omni.EtcdBackupS3Conf) (*
 		opts = append(opts, awsConfig.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(accessKey, secretKey, sessionToken)))
 	}
 
-	region := s3Conf.TypedSpec().Value.GetRegion()
+	region := spec.GetRegion()
 	if region != "" {
 		opts = append(opts, awsConfig.WithRegion(region))
 	}
 
 	var baseEndpoint string
 
-	if endpoint := s3Conf.TypedSpec().Value.GetEndpoint(); endpoint != "" {
+	if endpoint := spec.GetEndpoint(); endpoint != "" {
 		if strings.Contains(endpoint, "storage.googleapis.com") {
 			opts = append(opts, func(o *awsConfig.LoadOptions) error {
 				s3middleware.IgnoreSigningHeaders(o, []string{"Accept-Encoding"})
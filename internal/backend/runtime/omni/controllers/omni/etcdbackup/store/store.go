@@ -51,7 +51,7 @@ var newEtcdBackupStoreFactory = sync.OnceValues(func() (FactoryWithMetrics, erro
 		return nil, fmt.Errorf("unknown storage type: %q", storageType)
 	}
 
-	return newFactoryWithMetrics(result), nil
+	return newFactoryWithMetrics(newClusterStoreFactory(result)), nil
 })
 
 func setStatus(ctx context.Context, st state.State, confName, errString string) error {
@@ -6,6 +6,8 @@
 package omni
 
 import (
+	"time"
+
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/siderolabs/talos/pkg/machinery/compatibility"
 	"github.com/siderolabs/talos/pkg/machinery/config"
@@ -28,3 +30,7 @@ func GetMachineSetNodeSortFunction(machineStatuses map[resource.ID]*omni.Machine
 func StripTalosAPIAccessOSAdminRole(cfg config.Provider) (config.Provider, error) {
 	return stripTalosAPIAccessOSAdminRole(cfg)
 }
+
+func ParseHealthCheck(path, interval string) (string, time.Duration, error) {
+	return parseHealthCheck(path, interval)
+}
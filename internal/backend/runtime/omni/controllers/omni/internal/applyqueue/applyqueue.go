@@ -0,0 +1,128 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package applyqueue limits the number of the concurrent machine config applies across all clusters.
+package applyqueue
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Priority of the apply request, the lower values go first.
+type Priority int
+
+// Priorities.
+const (
+	PriorityControlPlane Priority = iota
+	PriorityWorker
+
+	numPriorities
+)
+
+// Queue is a semaphore which grants the slots to the waiters in the order of their priority, then in the FIFO order.
+type Queue struct {
+	waiters     [numPriorities][]chan struct{}
+	concurrency int
+	active      int
+	mu          sync.Mutex
+}
+
+// New creates a new Queue allowing the given number of the concurrent applies, at least one.
+func New(concurrency int) *Queue {
+	return &Queue{
+		concurrency: max(concurrency, 1),
+	}
+}
+
+// Acquire waits for a free slot, the returned function must be called to release it.
+//
+// The slot is not acquired if the context is canceled while waiting.
+func (q *Queue) Acquire(ctx context.Context, priority Priority) (func(), error) {
+	if priority < 0 || priority >= numPriorities {
+		return nil, fmt.Errorf("invalid priority %d", priority)
+	}
+
+	q.mu.Lock()
+
+	if q.active < q.concurrency && q.waitingLocked() == 0 {
+		q.active++
+		q.mu.Unlock()
+
+		return sync.OnceFunc(q.release), nil
+	}
+
+	ch := make(chan struct{})
+	q.waiters[priority] = append(q.waiters[priority], ch)
+
+	q.mu.Unlock()
+
+	select {
+	case <-ch:
+		return sync.OnceFunc(q.release), nil
+	case <-ctx.Done():
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	select {
+	case <-ch:
+		// the slot was granted concurrently with the cancellation, pass it on
+		q.releaseLocked()
+	default:
+		for i, waiter := range q.waiters[priority] {
+			if waiter == ch {
+				q.waiters[priority] = append(q.waiters[priority][:i], q.waiters[priority][i+1:]...)
+
+				break
+			}
+		}
+	}
+
+	return nil, ctx.Err()
+}
+
+// Waiting returns the number of the waiters.
+func (q *Queue) Waiting() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.waitingLocked()
+}
+
+func (q *Queue) waitingLocked() int {
+	count := 0
+
+	for _, waiters := range q.waiters {
+		count += len(waiters)
+	}
+
+	return count
+}
+
+func (q *Queue) release() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.releaseLocked()
+}
+
+func (q *Queue) releaseLocked() {
+	for priority, waiters := range q.waiters {
+		if len(waiters) == 0 {
+			continue
+		}
+
+		// the slot is handed over to the next waiter, so the number of the active applies doesn't change
+		close(waiters[0])
+		q.waiters[priority] = waiters[1:]
+
+		return
+	}
+
+	q.active--
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package applyqueue_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/applyqueue"
+)
+
+func TestPriority(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	queue := applyqueue.New(1)
+
+	release, err := queue.Acquire(ctx, applyqueue.PriorityWorker)
+	require.NoError(t, err)
+
+	var (
+		order  []string
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		queued int
+	)
+
+	acquire := func(name string, priority applyqueue.Priority) {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			releaseWaiter, acquireErr := queue.Acquire(ctx, priority)
+			if !assert.NoError(t, acquireErr) {
+				return
+			}
+
+			mu.Lock()
+			order = append(order, name)
+			mu.Unlock()
+
+			releaseWaiter()
+		}()
+
+		queued++
+
+		// wait for the waiter to be queued to keep the FIFO order stable
+		require.Eventually(t, func() bool { return queue.Waiting() == queued }, time.Second, time.Millisecond)
+	}
+
+	acquire("worker-1", applyqueue.PriorityWorker)
+	acquire("control-plane-1", applyqueue.PriorityControlPlane)
+	acquire("worker-2", applyqueue.PriorityWorker)
+	acquire("control-plane-2", applyqueue.PriorityControlPlane)
+
+	assert.Equal(t, 4, queue.Waiting())
+
+	release()
+	release() // no-op
+
+	wg.Wait()
+
+	assert.Equal(t, []string{"control-plane-1", "control-plane-2", "worker-1", "worker-2"}, order)
+	assert.Equal(t, 0, queue.Waiting())
+}
+
+func TestCancel(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	queue := applyqueue.New(1)
+
+	release, err := queue.Acquire(ctx, applyqueue.PriorityControlPlane)
+	require.NoError(t, err)
+
+	waitCtx, waitCancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer waitCancel()
+
+	_, err = queue.Acquire(waitCtx, applyqueue.PriorityControlPlane)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 0, queue.Waiting())
+
+	release()
+
+	// the slot is free again
+	release, err = queue.Acquire(ctx, applyqueue.PriorityWorker)
+	require.NoError(t, err)
+
+	release()
+}
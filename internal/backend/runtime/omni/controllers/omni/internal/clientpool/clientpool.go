@@ -0,0 +1,208 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package clientpool reuses the connections to the machines across the reconciles.
+package clientpool
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+)
+
+// healthCheckAfter is the idle time after which the pooled client is checked before it is reused.
+const healthCheckAfter = 10 * time.Second
+
+// HealthCheck verifies that the pooled client is still usable.
+type HealthCheck[T io.Closer] func(ctx context.Context, client T) error
+
+// Pool is a size-bounded cache of the clients keyed by the machine ID.
+//
+// Each client is created for a generation of the machine connection parameters, e.g. the maintenance mode and the talosconfig version,
+// the client is replaced when the machine is acquired with another generation.
+// The clients which were not used for the idle timeout are closed on the next Acquire.
+type Pool[T io.Closer] struct {
+	clients     map[string]*entry[T]
+	healthCheck HealthCheck[T]
+	size        int
+	idleTimeout time.Duration
+	mu          sync.Mutex
+}
+
+type entry[T io.Closer] struct {
+	lastUsed   time.Time
+	client     T
+	generation string
+	refs       int
+	evicted    bool
+}
+
+// New creates a new Pool keeping at most size clients, at least one.
+func New[T io.Closer](size int, idleTimeout time.Duration, healthCheck HealthCheck[T]) *Pool[T] {
+	return &Pool[T]{
+		clients:     map[string]*entry[T]{},
+		healthCheck: healthCheck,
+		size:        max(size, 1),
+		idleTimeout: idleTimeout,
+	}
+}
+
+// Acquire returns the pooled client of the machine for the generation, or creates a new one.
+//
+// The returned function must be called when the client is no longer used, the client must not be closed by the caller.
+// If the pool is full of the clients in use, the new client is not pooled and it is closed on release.
+func (p *Pool[T]) Acquire(ctx context.Context, id, generation string, create func(ctx context.Context) (T, error)) (T, func(), error) {
+	p.mu.Lock()
+
+	p.evictIdleLocked()
+
+	e, ok := p.clients[id]
+	if ok && e.generation != generation {
+		p.evictLocked(id, e)
+
+		ok = false
+	}
+
+	if ok {
+		e.refs++
+
+		needsCheck := p.healthCheck != nil && time.Since(e.lastUsed) > healthCheckAfter
+
+		p.mu.Unlock()
+
+		if !needsCheck {
+			return e.client, p.releaseFunc(e), nil
+		}
+
+		if err := p.healthCheck(ctx, e.client); err == nil {
+			return e.client, p.releaseFunc(e), nil
+		}
+
+		p.mu.Lock()
+
+		if p.clients[id] == e {
+			p.evictLocked(id, e)
+		}
+
+		p.releaseLocked(e)
+	}
+
+	p.mu.Unlock()
+
+	client, err := create(ctx)
+	if err != nil {
+		var zero T
+
+		return zero, nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	e = &entry[T]{
+		client:     client,
+		generation: generation,
+		refs:       1,
+	}
+
+	if previous, ok := p.clients[id]; ok {
+		// the client was created concurrently, the latest one wins
+		p.evictLocked(id, previous)
+	}
+
+	if len(p.clients) >= p.size && !p.evictLeastRecentlyUsedLocked() {
+		e.evicted = true
+
+		return client, p.releaseFunc(e), nil
+	}
+
+	p.clients[id] = e
+
+	return client, p.releaseFunc(e), nil
+}
+
+// Invalidate closes the client of the machine as soon as it is no longer used.
+func (p *Pool[T]) Invalidate(id string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if e, ok := p.clients[id]; ok {
+		p.evictLocked(id, e)
+	}
+}
+
+// Len returns the number of the pooled clients.
+func (p *Pool[T]) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return len(p.clients)
+}
+
+func (p *Pool[T]) releaseFunc(e *entry[T]) func() {
+	return sync.OnceFunc(func() {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		p.releaseLocked(e)
+	})
+}
+
+func (p *Pool[T]) releaseLocked(e *entry[T]) {
+	e.refs--
+	e.lastUsed = time.Now()
+
+	if e.evicted && e.refs == 0 {
+		e.client.Close() //nolint:errcheck
+	}
+}
+
+func (p *Pool[T]) evictLocked(id string, e *entry[T]) {
+	delete(p.clients, id)
+
+	e.evicted = true
+
+	if e.refs == 0 {
+		e.client.Close() //nolint:errcheck
+	}
+}
+
+func (p *Pool[T]) evictIdleLocked() {
+	if p.idleTimeout <= 0 {
+		return
+	}
+
+	for id, e := range p.clients {
+		if e.refs == 0 && time.Since(e.lastUsed) > p.idleTimeout {
+			p.evictLocked(id, e)
+		}
+	}
+}
+
+func (p *Pool[T]) evictLeastRecentlyUsedLocked() bool {
+	var (
+		oldestID string
+		oldest   *entry[T]
+	)
+
+	for id, e := range p.clients {
+		if e.refs > 0 {
+			continue
+		}
+
+		if oldest == nil || e.lastUsed.Before(oldest.lastUsed) {
+			oldestID, oldest = id, e
+		}
+	}
+
+	if oldest == nil {
+		return false
+	}
+
+	p.evictLocked(oldestID, oldest)
+
+	return true
+}
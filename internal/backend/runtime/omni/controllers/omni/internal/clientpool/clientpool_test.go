@@ -0,0 +1,150 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package clientpool_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/clientpool"
+)
+
+type testClient struct {
+	name   string
+	closed bool
+}
+
+func (c *testClient) Close() error {
+	c.closed = true
+
+	return nil
+}
+
+func acquire(t *testing.T, pool *clientpool.Pool[*testClient], id, generation string) (*testClient, func()) {
+	t.Helper()
+
+	c, release, err := pool.Acquire(context.Background(), id, generation, func(context.Context) (*testClient, error) {
+		return &testClient{name: id + "/" + generation}, nil
+	})
+	require.NoError(t, err)
+
+	return c, release
+}
+
+func TestReuse(t *testing.T) {
+	t.Parallel()
+
+	pool := clientpool.New[*testClient](10, time.Minute, nil)
+
+	c1, release1 := acquire(t, pool, "machine-1", "1")
+	c2, release2 := acquire(t, pool, "machine-1", "1")
+
+	assert.Same(t, c1, c2)
+
+	release1()
+	release2()
+
+	c3, release3 := acquire(t, pool, "machine-1", "1")
+	defer release3()
+
+	assert.Same(t, c1, c3)
+	assert.False(t, c1.closed)
+}
+
+func TestGeneration(t *testing.T) {
+	t.Parallel()
+
+	pool := clientpool.New[*testClient](10, time.Minute, nil)
+
+	c1, release1 := acquire(t, pool, "machine-1", "maintenance")
+
+	// the client in use is closed only when it is released
+	c2, release2 := acquire(t, pool, "machine-1", "running")
+	defer release2()
+
+	assert.NotSame(t, c1, c2)
+	assert.False(t, c1.closed)
+
+	release1()
+
+	assert.True(t, c1.closed)
+	assert.Equal(t, 1, pool.Len())
+}
+
+func TestSize(t *testing.T) {
+	t.Parallel()
+
+	pool := clientpool.New[*testClient](2, time.Minute, nil)
+
+	c1, release1 := acquire(t, pool, "machine-1", "1")
+	release1()
+
+	c2, release2 := acquire(t, pool, "machine-2", "1")
+	defer release2()
+
+	// the least recently used idle client is evicted
+	c3, release3 := acquire(t, pool, "machine-3", "1")
+
+	assert.True(t, c1.closed)
+	assert.Equal(t, 2, pool.Len())
+
+	// all pooled clients are in use, so the new one is not pooled
+	c4, release4 := acquire(t, pool, "machine-4", "1")
+
+	assert.Equal(t, 2, pool.Len())
+
+	release4()
+
+	assert.True(t, c4.closed)
+
+	release3()
+
+	assert.False(t, c2.closed)
+	assert.False(t, c3.closed)
+}
+
+func TestIdleTimeout(t *testing.T) {
+	t.Parallel()
+
+	pool := clientpool.New[*testClient](10, 10*time.Millisecond, nil)
+
+	c1, release1 := acquire(t, pool, "machine-1", "1")
+	release1()
+
+	time.Sleep(20 * time.Millisecond)
+
+	c2, release2 := acquire(t, pool, "machine-2", "1")
+	defer release2()
+
+	assert.True(t, c1.closed)
+	assert.False(t, c2.closed)
+	assert.Equal(t, 1, pool.Len())
+}
+
+func TestInvalidate(t *testing.T) {
+	t.Parallel()
+
+	pool := clientpool.New[*testClient](10, time.Minute, nil)
+
+	c1, release1 := acquire(t, pool, "machine-1", "1")
+
+	pool.Invalidate("machine-1")
+
+	assert.False(t, c1.closed)
+
+	release1()
+
+	assert.True(t, c1.closed)
+
+	c2, release2 := acquire(t, pool, "machine-1", "1")
+	defer release2()
+
+	assert.NotSame(t, c1, c2)
+}
@@ -0,0 +1,271 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package azstore implements [etcdbackup.Store] which stores backups in an Azure Blob Storage container.
+package azstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup"
+)
+
+const (
+	apiVersion = "2021-08-06"
+
+	// blockSize is the size of the blocks the backups are uploaded in.
+	blockSize = 8 * 1024 * 1024
+)
+
+// Store stores etcd backups in the Azure Blob Storage container.
+//
+// The requests are authorized with the SAS token, which should allow reading, writing and listing the blobs.
+type Store struct {
+	client       *http.Client
+	containerURL *url.URL
+	sasQuery     url.Values
+}
+
+// NewStore initializes [Store].
+func NewStore(client *http.Client, containerURL, sasToken string) (*Store, error) {
+	parsed, err := url.Parse(containerURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid container URL: %w", err)
+	}
+
+	if parsed.Scheme != "https" && parsed.Scheme != "http" {
+		return nil, fmt.Errorf("invalid container URL scheme %q", parsed.Scheme)
+	}
+
+	sasQuery, err := url.ParseQuery(strings.TrimPrefix(sasToken, "?"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid SAS token: %w", err)
+	}
+
+	return &Store{
+		client:       client,
+		containerURL: parsed,
+		sasQuery:     sasQuery,
+	}, nil
+}
+
+// Upload stores the data from [io.Reader] in a blob. Implements [etcdbackup.Store].
+func (s *Store) Upload(ctx context.Context, descr etcdbackup.Description, r io.Reader) error {
+	name := path.Join(descr.ClusterUUID, etcdbackup.CreateSnapshotName(descr.Timestamp))
+
+	var blockList bytes.Buffer
+
+	blockList.WriteString(`<?xml version="1.0" encoding="utf-8"?><BlockList>`)
+
+	buf := make([]byte, blockSize)
+
+	for i := 0; ; i++ {
+		n, err := io.ReadFull(r, buf)
+		if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("failed to read backup: %w", err)
+		}
+
+		if n == 0 {
+			break
+		}
+
+		blockID := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%08d", i)))
+
+		if err = s.do(ctx, http.MethodPut, name, url.Values{"comp": {"block"}, "blockid": {blockID}}, buf[:n], nil); err != nil {
+			return fmt.Errorf("failed to upload backup block: %w", err)
+		}
+
+		fmt.Fprintf(&blockList, "<Latest>%s</Latest>", blockID)
+
+		if n < len(buf) {
+			break
+		}
+	}
+
+	blockList.WriteString("</BlockList>")
+
+	if err := s.do(ctx, http.MethodPut, name, url.Values{"comp": {"blocklist"}}, blockList.Bytes(), nil); err != nil {
+		return fmt.Errorf("failed to commit backup blocks: %w", err)
+	}
+
+	return nil
+}
+
+// Download downloads the backup with the specified name. Implements [etcdbackup.Store].
+func (s *Store) Download(ctx context.Context, _ []byte, clusterUUID, snapshotName string) (etcdbackup.BackupData, io.ReadCloser, error) {
+	body, err := s.get(ctx, path.Join(clusterUUID, snapshotName))
+	if err != nil {
+		return etcdbackup.BackupData{}, nil, fmt.Errorf("failed to get blob: %w", err)
+	}
+
+	return etcdbackup.BackupData{}, body, nil
+}
+
+type listBlobsResult struct {
+	NextMarker string `xml:"NextMarker"`
+	Blobs      []struct {
+		Name       string `xml:"Name"`
+		Properties struct {
+			ContentLength int64 `xml:"Content-Length"`
+		} `xml:"Properties"`
+	} `xml:"Blobs>Blob"`
+}
+
+// ListBackups returns a list of backups. Implements [etcdbackup.Store].
+func (s *Store) ListBackups(ctx context.Context, clusterUUID string) (etcdbackup.InfoIterator, error) {
+	var infos []etcdbackup.Info
+
+	for marker := ""; ; {
+		query := url.Values{
+			"restype": {"container"},
+			"comp":    {"list"},
+			"prefix":  {clusterUUID + "/"},
+		}
+
+		if marker != "" {
+			query.Set("marker", marker)
+		}
+
+		var result listBlobsResult
+
+		if err := s.do(ctx, http.MethodGet, "", query, nil, &result); err != nil {
+			return nil, fmt.Errorf("failed to list backups: %w", err)
+		}
+
+		for _, blob := range result.Blobs {
+			uuidStr, snapshotName, found := strings.Cut(blob.Name, "/")
+			if !found {
+				continue
+			}
+
+			if _, err := uuid.Parse(uuidStr); err != nil {
+				continue
+			}
+
+			timestamp, err := etcdbackup.ParseSnapshotName(snapshotName)
+			if err != nil {
+				return nil, err
+			}
+
+			name := blob.Name
+
+			infos = append(infos, etcdbackup.Info{
+				Snapshot:  snapshotName,
+				Timestamp: timestamp,
+				Reader: func() (io.ReadCloser, error) {
+					return s.get(ctx, name)
+				},
+				Size: blob.Properties.ContentLength,
+			})
+		}
+
+		if result.NextMarker == "" {
+			break
+		}
+
+		marker = result.NextMarker
+	}
+
+	return func() (etcdbackup.Info, bool, error) {
+		if len(infos) == 0 {
+			return etcdbackup.Info{}, false, nil
+		}
+
+		info := infos[0]
+		infos = infos[1:]
+
+		return info, true, nil
+	}, nil
+}
+
+func (s *Store) get(ctx context.Context, name string) (io.ReadCloser, error) {
+	resp, err := s.send(ctx, http.MethodGet, name, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Body, nil
+}
+
+func (s *Store) do(ctx context.Context, method, name string, query url.Values, body []byte, result any) error {
+	resp, err := s.send(ctx, method, name, query, body)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	if result == nil {
+		_, err = io.Copy(io.Discard, resp.Body)
+
+		return err
+	}
+
+	return xml.NewDecoder(resp.Body).Decode(result)
+}
+
+func (s *Store) send(ctx context.Context, method, name string, query url.Values, body []byte) (*http.Response, error) {
+	u := *s.containerURL
+
+	if name != "" {
+		u.Path = strings.TrimSuffix(u.Path, "/") + "/" + name
+	}
+
+	values := url.Values{}
+
+	for k, v := range s.sasQuery {
+		values[k] = v
+	}
+
+	for k, v := range query {
+		values[k] = v
+	}
+
+	u.RawQuery = values.Encode()
+
+	var bodyReader io.Reader
+
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bodyReader)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("x-ms-version", apiVersion)
+
+	if method == http.MethodPut {
+		req.Header.Set("x-ms-blob-type", "BlockBlob")
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		defer resp.Body.Close() //nolint:errcheck
+
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+
+		return nil, fmt.Errorf("unexpected status %s: %s", resp.Status, strings.TrimSpace(string(message)))
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,170 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package azstore_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/etcdbackup/azstore"
+)
+
+// fakeContainer implements the subset of the Azure Blob Storage API used by the store.
+type fakeContainer struct {
+	blocks map[string][]byte
+	blobs  map[string][]byte
+	mu     sync.Mutex
+}
+
+func (c *fakeContainer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if r.URL.Query().Get("sig") != "secret" {
+		w.WriteHeader(http.StatusForbidden)
+
+		return
+	}
+
+	name := strings.TrimPrefix(r.URL.Path, "/container/")
+	query := r.URL.Query()
+
+	switch {
+	case r.Method == http.MethodGet && query.Get("comp") == "list":
+		var names []string
+
+		for blobName := range c.blobs {
+			if strings.HasPrefix(blobName, query.Get("prefix")) {
+				names = append(names, blobName)
+			}
+		}
+
+		sort.Strings(names)
+
+		var result bytes.Buffer
+
+		result.WriteString("<EnumerationResults><Blobs>")
+
+		for _, blobName := range names {
+			fmt.Fprintf(&result, "<Blob><Name>%s</Name><Properties><Content-Length>%d</Content-Length></Properties></Blob>", blobName, len(c.blobs[blobName]))
+		}
+
+		result.WriteString("</Blobs><NextMarker/></EnumerationResults>")
+
+		w.Write(result.Bytes()) //nolint:errcheck
+	case r.Method == http.MethodGet:
+		data, ok := c.blobs[name]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		w.Write(data) //nolint:errcheck
+	case r.Method == http.MethodPut && query.Get("comp") == "block":
+		data, _ := io.ReadAll(r.Body)
+
+		c.blocks[name+"/"+query.Get("blockid")] = data
+	case r.Method == http.MethodPut && query.Get("comp") == "blocklist":
+		var blockList struct {
+			Latest []string `xml:"Latest"`
+		}
+
+		if err := xml.NewDecoder(r.Body).Decode(&blockList); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		var data []byte
+
+		for _, blockID := range blockList.Latest {
+			data = append(data, c.blocks[name+"/"+blockID]...)
+		}
+
+		c.blobs[name] = data
+
+		w.WriteHeader(http.StatusCreated)
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+func TestStore(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	server := httptest.NewServer(&fakeContainer{
+		blocks: map[string][]byte{},
+		blobs:  map[string][]byte{},
+	})
+	t.Cleanup(server.Close)
+
+	store, err := azstore.NewStore(server.Client(), server.URL+"/container", "?sv=2021-08-06&sig=secret")
+	require.NoError(t, err)
+
+	clusterUUID := uuid.NewString()
+	data := bytes.Repeat([]byte("snapshot"), 2*1024*1024)
+
+	timestamps := []time.Time{time.Unix(1700000000, 0), time.Unix(1700003600, 0)}
+
+	for _, timestamp := range timestamps {
+		require.NoError(t, store.Upload(ctx, etcdbackup.Description{
+			Timestamp:   timestamp,
+			ClusterUUID: clusterUUID,
+		}, bytes.NewReader(data)))
+	}
+
+	iter, err := store.ListBackups(ctx, clusterUUID)
+	require.NoError(t, err)
+
+	// the newest backups are listed first
+	for _, timestamp := range []time.Time{timestamps[1], timestamps[0]} {
+		info, ok, iterErr := iter()
+		require.NoError(t, iterErr)
+		require.True(t, ok)
+
+		assert.Equal(t, timestamp.Unix(), info.Timestamp.Unix())
+		assert.Equal(t, int64(len(data)), info.Size)
+	}
+
+	_, ok, err := iter()
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	_, readCloser, err := store.Download(ctx, nil, clusterUUID, etcdbackup.CreateSnapshotName(timestamps[0]))
+	require.NoError(t, err)
+
+	downloaded, err := io.ReadAll(readCloser)
+	require.NoError(t, err)
+	require.NoError(t, readCloser.Close())
+
+	assert.Equal(t, data, downloaded)
+
+	// the requests without the valid SAS token are rejected
+	store, err = azstore.NewStore(server.Client(), server.URL+"/container", "sig=invalid")
+	require.NoError(t, err)
+
+	_, err = store.ListBackups(ctx, clusterUUID)
+	assert.ErrorContains(t, err, "403")
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package kubernetes
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/blang/semver"
+	"github.com/siderolabs/gen/maps"
+	"github.com/siderolabs/go-kubernetes/kubernetes/upgrade"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/compatibility"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+// kubeletMaxSkew returns the number of the minor versions the kubelet may be older than the kube-apiserver.
+//
+// The skew was extended from two to three minor versions in Kubernetes 1.28.
+func kubeletMaxSkew(apiServerVersion semver.Version) uint64 {
+	if apiServerVersion.Major == 1 && apiServerVersion.Minor < 28 {
+		return 2
+	}
+
+	return 3
+}
+
+// CheckVersionSkew returns the violations of the version skew policy caused by upgrading the cluster to the desired Kubernetes version.
+//
+// The control plane components are upgraded to the next minor version at most,
+// the kubelets of the cluster, including the ones held on the previous version, can't be newer than the control plane
+// and can't fall behind it by more than the supported skew, and the desired version has to be supported by the Talos versions of the machines.
+func CheckVersionSkew(kubernetesStatus *omni.KubernetesStatus, talosVersions []string, desiredVersion string) ([]string, error) {
+	desired, err := semver.ParseTolerant(desiredVersion)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing Kubernetes version %q: %w", desiredVersion, err)
+	}
+
+	var violations []string
+
+	controlPlaneVersions := map[string]semver.Version{}
+
+	for _, nodePods := range kubernetesStatus.TypedSpec().Value.StaticPods {
+		for _, pod := range nodePods.StaticPods {
+			if version, parseErr := semver.ParseTolerant(pod.Version); parseErr == nil {
+				controlPlaneVersions[pod.Version] = version
+			}
+		}
+	}
+
+	for _, currentVersion := range sortedVersions(controlPlaneVersions) {
+		path, pathErr := upgrade.NewPath(currentVersion, desiredVersion)
+		if pathErr != nil {
+			return nil, pathErr
+		}
+
+		if !path.IsSupported() {
+			violations = append(violations, fmt.Sprintf("the control plane can't be upgraded from %s to %s, the minor versions can't be skipped or downgraded", currentVersion, desiredVersion))
+		}
+	}
+
+	maxSkew := kubeletMaxSkew(desired)
+
+	for _, node := range kubernetesStatus.TypedSpec().Value.Nodes {
+		kubeletVersion, parseErr := semver.ParseTolerant(node.KubeletVersion)
+		if parseErr != nil {
+			continue
+		}
+
+		switch {
+		case kubeletVersion.Major != desired.Major:
+			violations = append(violations, fmt.Sprintf("the kubelet %s of the node %q has a different major version than %s", node.KubeletVersion, node.Nodename, desiredVersion))
+		case kubeletVersion.Minor > desired.Minor:
+			violations = append(violations, fmt.Sprintf("the kubelet %s of the node %q would be newer than the control plane %s", node.KubeletVersion, node.Nodename, desiredVersion))
+		case desired.Minor-kubeletVersion.Minor > maxSkew:
+			violations = append(violations, fmt.Sprintf("the kubelet %s of the node %q would be more than %d minor versions older than the control plane %s",
+				node.KubeletVersion, node.Nodename, maxSkew, desiredVersion))
+		}
+	}
+
+	k8sVersion, err := compatibility.ParseKubernetesVersion(desiredVersion)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing Kubernetes version %q: %w", desiredVersion, err)
+	}
+
+	talosVersions = slices.Clone(talosVersions)
+	slices.Sort(talosVersions)
+
+	for _, talosTag := range slices.Compact(talosVersions) {
+		talosVersion, parseErr := compatibility.ParseTalosVersion(&machine.VersionInfo{
+			Tag: talosTag,
+		})
+		if parseErr != nil {
+			return nil, fmt.Errorf("error parsing Talos version %q: %w", talosTag, parseErr)
+		}
+
+		if supportErr := k8sVersion.SupportedWith(talosVersion); supportErr != nil {
+			violations = append(violations, supportErr.Error())
+		}
+	}
+
+	return violations, nil
+}
+
+func sortedVersions(versions map[string]semver.Version) []string {
+	result := maps.Keys(versions)
+
+	slices.SortFunc(result, func(a, b string) int {
+		return versions[a].Compare(versions[b])
+	})
+
+	return result
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package kubernetes_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/kubernetes"
+)
+
+func TestCheckVersionSkew(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name                string
+		controlPlaneVersion string
+		kubeletVersions     map[string]string
+		talosVersions       []string
+		desiredVersion      string
+
+		expected []string
+	}{
+		{
+			name:                "next minor",
+			controlPlaneVersion: "1.29.3",
+			kubeletVersions:     map[string]string{"cp-1": "1.29.3", "worker-1": "1.29.3"},
+			talosVersions:       []string{"1.7.4", "1.7.4"},
+			desiredVersion:      "1.30.1",
+		},
+		{
+			name:                "revert patch",
+			controlPlaneVersion: "1.30.1",
+			kubeletVersions:     map[string]string{"cp-1": "1.30.1", "worker-1": "1.30.1"},
+			talosVersions:       []string{"1.7.4"},
+			desiredVersion:      "1.30.0",
+		},
+		{
+			name:                "skip minor",
+			controlPlaneVersion: "1.28.2",
+			kubeletVersions:     map[string]string{"cp-1": "1.28.2"},
+			talosVersions:       []string{"1.7.4"},
+			desiredVersion:      "1.30.1",
+			expected: []string{
+				"the control plane can't be upgraded from 1.28.2 to 1.30.1, the minor versions can't be skipped or downgraded",
+			},
+		},
+		{
+			name:                "held kubelet",
+			controlPlaneVersion: "1.29.3",
+			kubeletVersions:     map[string]string{"cp-1": "1.29.3", "worker-1": "1.26.5", "worker-2": "1.27.0"},
+			talosVersions:       []string{"1.7.4"},
+			desiredVersion:      "1.30.1",
+			expected: []string{
+				`the kubelet 1.26.5 of the node "worker-1" would be more than 3 minor versions older than the control plane 1.30.1`,
+			},
+		},
+		{
+			name:                "downgrade",
+			controlPlaneVersion: "1.30.1",
+			kubeletVersions:     map[string]string{"cp-1": "1.30.1"},
+			talosVersions:       []string{"1.7.4"},
+			desiredVersion:      "1.29.3",
+			expected: []string{
+				"the control plane can't be upgraded from 1.30.1 to 1.29.3, the minor versions can't be skipped or downgraded",
+				`the kubelet 1.30.1 of the node "cp-1" would be newer than the control plane 1.29.3`,
+			},
+		},
+		{
+			name:                "unsupported by talos",
+			controlPlaneVersion: "1.29.3",
+			kubeletVersions:     map[string]string{"cp-1": "1.29.3"},
+			talosVersions:       []string{"1.7.4", "1.5.5"},
+			desiredVersion:      "1.30.1",
+			expected: []string{
+				"version of Kubernetes 1.30.1 is too new to be used with Talos 1.5.5",
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			kubernetesStatus := omni.NewKubernetesStatus(resources.DefaultNamespace, "cluster")
+
+			kubernetesStatus.TypedSpec().Value.StaticPods = []*specs.KubernetesStatusSpec_NodeStaticPods{
+				{
+					Nodename: "cp-1",
+					StaticPods: []*specs.KubernetesStatusSpec_StaticPodStatus{
+						{App: "kube-apiserver", Version: test.controlPlaneVersion, Ready: true},
+						{App: "kube-controller-manager", Version: test.controlPlaneVersion, Ready: true},
+						{App: "kube-scheduler", Version: test.controlPlaneVersion, Ready: true},
+					},
+				},
+			}
+
+			for _, nodename := range []string{"cp-1", "worker-1", "worker-2"} {
+				if version, ok := test.kubeletVersions[nodename]; ok {
+					kubernetesStatus.TypedSpec().Value.Nodes = append(kubernetesStatus.TypedSpec().Value.Nodes, &specs.KubernetesStatusSpec_NodeStatus{
+						Nodename:       nodename,
+						KubeletVersion: version,
+						Ready:          true,
+					})
+				}
+			}
+
+			violations, err := kubernetes.CheckVersionSkew(kubernetesStatus, test.talosVersions, test.desiredVersion)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, violations)
+		})
+	}
+}
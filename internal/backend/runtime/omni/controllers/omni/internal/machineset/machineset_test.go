@@ -6,6 +6,8 @@
 package machineset_test
 
 import (
+	"time"
+
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/kvutils"
 	"github.com/siderolabs/gen/pair"
@@ -54,6 +56,18 @@ func withClusterMachineVersionSetter(cmcs *omni.ClusterMachineConfigStatus, vers
 	})
 }
 
+func withUpdated[T resource.Resource](res T, updated time.Time) T {
+	res.Metadata().SetUpdated(updated)
+
+	return res
+}
+
+func withReady(cms *omni.ClusterMachineStatus, ready bool) *omni.ClusterMachineStatus {
+	return withSpecSetter(cms, func(cms *omni.ClusterMachineStatus) {
+		cms.TypedSpec().Value.Ready = ready
+	})
+}
+
 func withLabels[T resource.Resource](r T, labels ...pair.Pair[string, string]) T {
 	r.Metadata().Labels().Do(func(temp kvutils.TempKV) {
 		for _, label := range labels {
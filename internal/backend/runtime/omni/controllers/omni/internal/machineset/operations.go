@@ -19,6 +19,7 @@ import (
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/helpers"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/patchblob"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/set"
 )
 
@@ -47,7 +48,10 @@ func (c *Create) Apply(ctx context.Context, r controller.ReaderWriter, logger *z
 	clusterMachineConfigPatches.Metadata().Labels().Set(omni.LabelMachineSet, machineSet.Metadata().ID())
 
 	helpers.UpdateInputsVersions(clusterMachine, configPatches...)
-	setPatches(clusterMachineConfigPatches, configPatches)
+
+	if err := setPatches(ctx, r, machineSet, clusterMachineConfigPatches, configPatches); err != nil {
+		return err
+	}
 
 	var err error
 
@@ -129,9 +133,7 @@ func (u *Update) Apply(ctx context.Context, r controller.ReaderWriter, logger *z
 	// update ClusterMachineConfigPatches resource with the list of matching patches for the machine
 	err := safe.WriterModify(ctx, r, omni.NewClusterMachineConfigPatches(resources.DefaultNamespace, u.ID),
 		func(clusterMachineConfigPatches *omni.ClusterMachineConfigPatches) error {
-			setPatches(clusterMachineConfigPatches, configPatches)
-
-			return nil
+			return setPatches(ctx, r, rc.GetMachineSet(), clusterMachineConfigPatches, configPatches)
 		},
 	)
 	if err != nil {
@@ -205,16 +207,85 @@ func (d *Destroy) Apply(ctx context.Context, r controller.ReaderWriter, logger *
 	return nil
 }
 
-func setPatches(clusterMachineConfigPatches *omni.ClusterMachineConfigPatches, patches []*omni.ConfigPatch) {
+func setPatches(ctx context.Context, r controller.ReaderWriter, machineSet *omni.MachineSet,
+	clusterMachineConfigPatches *omni.ClusterMachineConfigPatches, patches []*omni.ConfigPatch,
+) error {
 	patchesRaw := make([]string, 0, len(patches))
+	blobIDs := make([]string, 0, len(patches))
+	shared := false
 
 	for _, patch := range patches {
 		data := patch.TypedSpec().Value.Data
 
-		if strings.TrimSpace(data) != "" {
+		if strings.TrimSpace(data) == "" {
+			continue
+		}
+
+		if !patchblob.Shared(data) {
 			patchesRaw = append(patchesRaw, data)
+			blobIDs = append(blobIDs, "")
+
+			continue
+		}
+
+		id, err := patchblob.Store(ctx, r, machineSet, data)
+		if err != nil {
+			return err
 		}
+
+		patchesRaw = append(patchesRaw, "")
+		blobIDs = append(blobIDs, id)
+		shared = true
+	}
+
+	if !shared {
+		blobIDs = nil
 	}
 
 	clusterMachineConfigPatches.TypedSpec().Value.Patches = patchesRaw
+	clusterMachineConfigPatches.TypedSpec().Value.BlobIds = blobIDs
+
+	return nil
+}
+
+// CleanupConfigPatchBlobs destroys the ConfigPatchBlob resources of the machine set which are not referenced anymore.
+func CleanupConfigPatchBlobs(ctx context.Context, r controller.ReaderWriter, rc *ReconciliationContext) error {
+	machineSetID := rc.GetMachineSet().Metadata().ID()
+
+	// the reconciliation context is built before the operations are applied,
+	// so keep both the blobs referenced by the current ClusterMachineConfigPatches and the blobs for the current config patches
+	referenced := set.Set[string]{}
+
+	for _, clusterMachineConfigPatches := range rc.clusterMachineConfigPatchesMap {
+		for _, id := range clusterMachineConfigPatches.TypedSpec().Value.BlobIds {
+			if id != "" {
+				referenced.Add(id)
+			}
+		}
+	}
+
+	for _, patches := range rc.patchesByMachine {
+		for _, patch := range patches {
+			if data := patch.TypedSpec().Value.Data; patchblob.Shared(data) {
+				referenced.Add(patchblob.ID(machineSetID, data))
+			}
+		}
+	}
+
+	blobs, err := safe.ReaderListAll[*omni.ConfigPatchBlob](ctx, r, state.WithLabelQuery(resource.LabelEqual(omni.LabelMachineSet, machineSetID)))
+	if err != nil {
+		return fmt.Errorf("failed to list config patch blobs for the machine set %q: %w", machineSetID, err)
+	}
+
+	return blobs.ForEachErr(func(blob *omni.ConfigPatchBlob) error {
+		if referenced.Contains(blob.Metadata().ID()) {
+			return nil
+		}
+
+		if err := r.Destroy(ctx, blob.Metadata()); err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("failed to destroy config patch blob %q: %w", blob.Metadata().ID(), err)
+		}
+
+		return nil
+	})
 }
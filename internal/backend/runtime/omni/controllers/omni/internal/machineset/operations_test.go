@@ -7,6 +7,7 @@ package machineset_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
 	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/siderolabs/gen/xslices"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
@@ -25,6 +27,7 @@ import (
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/helpers"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/machineset"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/patchblob"
 )
 
 var logger *zap.Logger
@@ -421,6 +424,104 @@ func TestDestroy(t *testing.T) {
 	})
 }
 
+// TestConfigPatchBlobs creates two machines sharing a large patch, checks that the patch is stored once
+// and that the blob is cleaned up when it is not referenced anymore.
+func TestConfigPatchBlobs(t *testing.T) {
+	rt := createRuntime()
+
+	cluster := omni.NewCluster(resources.DefaultNamespace, "test")
+	cluster.TypedSpec().Value.KubernetesVersion = "v1.6.2"
+
+	machineSet := omni.NewMachineSet(resources.DefaultNamespace, "machineset")
+	machineSet.Metadata().Labels().Set(omni.LabelCluster, cluster.Metadata().ID())
+
+	require := require.New(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	largePatch := omni.NewConfigPatch(resources.DefaultNamespace, "large")
+	largePatch.TypedSpec().Value.Data = "machine:\n  files:\n    - content: " + strings.Repeat("a", patchblob.MinSize) + "\n      path: /var/a\n      op: create\n"
+
+	smallPatch := omni.NewConfigPatch(resources.DefaultNamespace, "small")
+	smallPatch.TypedSpec().Value.Data = `machine:
+  network:
+    kubespan:
+      enabled: true`
+
+	machineIDs := []string{"aa", "bb"}
+
+	rc, err := machineset.NewReconciliationContext(
+		cluster,
+		machineSet,
+		newHealthyLB(cluster.Metadata().ID()),
+		&fakePatchHelper{
+			patches: map[string][]*omni.ConfigPatch{
+				"aa": {largePatch, smallPatch},
+				"bb": {largePatch, smallPatch},
+			},
+		},
+		xslices.Map(machineIDs, func(id string) *omni.MachineSetNode {
+			return omni.NewMachineSetNode(resources.DefaultNamespace, id, machineSet)
+		}),
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	require.NoError(err)
+
+	for _, id := range machineIDs {
+		create := machineset.Create{ID: id}
+
+		require.NoError(create.Apply(ctx, rt, logger, rc))
+	}
+
+	blobID := patchblob.ID(machineSet.Metadata().ID(), largePatch.TypedSpec().Value.Data)
+
+	rtestutils.AssertResources(ctx, t, rt.State, []string{blobID}, func(r *omni.ConfigPatchBlob, assertion *assert.Assertions) {
+		machineSetID, _ := r.Metadata().Labels().Get(omni.LabelMachineSet)
+
+		assertion.Equal(machineSet.Metadata().ID(), machineSetID)
+		assertion.Less(len(r.TypedSpec().Value.CompressedData), len(largePatch.TypedSpec().Value.Data))
+	})
+
+	for _, id := range machineIDs {
+		clusterMachineConfigPatches, getErr := safe.ReaderGetByID[*omni.ClusterMachineConfigPatches](ctx, rt, id)
+		require.NoError(getErr)
+
+		require.Equal([]string{"", smallPatch.TypedSpec().Value.Data}, clusterMachineConfigPatches.TypedSpec().Value.Patches)
+		require.Equal([]string{blobID, ""}, clusterMachineConfigPatches.TypedSpec().Value.BlobIds)
+
+		patches, resolveErr := patchblob.Resolve(ctx, rt, clusterMachineConfigPatches)
+		require.NoError(resolveErr)
+
+		require.Equal([]string{largePatch.TypedSpec().Value.Data, smallPatch.TypedSpec().Value.Data}, patches)
+	}
+
+	// the blob is still referenced by the patches
+	require.NoError(machineset.CleanupConfigPatchBlobs(ctx, rt, rc))
+
+	rtestutils.AssertResources(ctx, t, rt.State, []string{blobID}, func(*omni.ConfigPatchBlob, *assert.Assertions) {})
+
+	rc, err = machineset.NewReconciliationContext(
+		cluster,
+		machineSet,
+		newHealthyLB(cluster.Metadata().ID()),
+		&fakePatchHelper{},
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	require.NoError(err)
+
+	require.NoError(machineset.CleanupConfigPatchBlobs(ctx, rt, rc))
+
+	rtestutils.AssertNoResource[*omni.ConfigPatchBlob](ctx, t, rt.State, blobID)
+}
+
 func init() {
 	var err error
 
@@ -9,6 +9,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/resource"
@@ -84,6 +85,8 @@ type ReconciliationContext struct {
 	idsOutdated               set.Set[string]
 	idsDestroyReady           set.Set[string]
 	idsUpdateLocked           set.Set[string]
+	idsUnhealthy              set.Set[string]
+	idsWaitingForHealthy      set.Set[string]
 
 	idsToTeardown []string
 	idsToCreate   []string
@@ -190,10 +193,17 @@ func NewReconciliationContext(
 		patchesByMachine: map[resource.ID][]*omni.ConfigPatch{},
 	}
 
+	var clusterPaused bool
+
+	if cluster != nil {
+		_, clusterPaused = cluster.Metadata().Annotations().Get(omni.ClusterPaused)
+	}
+
+	// all machines of the paused cluster are locked
 	checkLocked := func(r *omni.MachineSetNode) bool {
 		_, ok := r.Metadata().Annotations().Get(omni.MachineLocked)
 
-		return ok
+		return ok || clusterPaused
 	}
 
 	checkTearingDown := func(r *omni.ClusterMachine) bool {
@@ -243,7 +253,12 @@ func NewReconciliationContext(
 		),
 	)
 
-	rc.idsToCreate = set.Values(set.Difference(rc.runningMachineSetNodesSet, clusterMachinesSet))
+	// no scaling changes are done while the cluster is paused
+	if clusterPaused {
+		rc.idsToTeardown = nil
+	} else {
+		rc.idsToCreate = set.Values(set.Difference(rc.runningMachineSetNodesSet, clusterMachinesSet))
+	}
 
 	rc.idsTearingDown = set.Difference(tearingDownMachinesSet, rc.idsDestroyReady)
 
@@ -301,8 +316,20 @@ func NewReconciliationContext(
 
 	rc.idsOutdated = make(set.Set[string])
 	rc.idsUnconfigured = make(set.Set[string])
+	rc.idsUnhealthy = make(set.Set[string])
+	rc.idsWaitingForHealthy = make(set.Set[string])
+
+	waitForHealthyTimeout := getRollingUpdateConfig(machineSet.TypedSpec().Value).GetWaitForHealthyTimeout().AsDuration()
+	now := time.Now()
 
 	for id := range set.Difference(clusterMachinesSet, tearingDownMachinesSet) {
+		clusterMachineStatus, ok := rc.clusterMachineStatusesMap[id]
+		ready := ok && clusterMachineStatus.TypedSpec().Value.Ready
+
+		if !ready {
+			rc.idsUnhealthy.Add(id)
+		}
+
 		clusterMachineConfigStatus, ok := rc.clusterMachineConfigStatusesMap[id]
 		if !ok {
 			rc.idsUnconfigured.Add(id)
@@ -312,6 +339,13 @@ func NewReconciliationContext(
 
 		if isUpdating(rc.clusterMachinesMap[id], clusterMachineConfigStatus) {
 			rc.idsOutdated.Add(id)
+
+			continue
+		}
+
+		// the machine which has got the new config recently keeps its update slot until it becomes healthy
+		if !ready && now.Sub(clusterMachineConfigStatus.Metadata().Updated()) < waitForHealthyTimeout {
+			rc.idsWaitingForHealthy.Add(id)
 		}
 	}
 
@@ -360,6 +394,19 @@ func (rc *ReconciliationContext) GetUpdatingMachines() set.Set[string] {
 	)
 }
 
+// GetWaitingForHealthyMachines returns the list of machines which are configured, but didn't become healthy yet.
+func (rc *ReconciliationContext) GetWaitingForHealthyMachines() set.Set[string] {
+	return rc.idsWaitingForHealthy
+}
+
+// GetUnavailableMachines returns the list of machines which are either not healthy or are being updated.
+func (rc *ReconciliationContext) GetUnavailableMachines() set.Set[string] {
+	return set.Union(
+		rc.idsUnhealthy,
+		rc.GetUpdatingMachines(),
+	)
+}
+
 // GetOutdatedMachines returns the list of machines which are currently being configured.
 func (rc *ReconciliationContext) GetOutdatedMachines() set.Set[string] {
 	return rc.idsOutdated
@@ -477,18 +524,31 @@ func (rc *ReconciliationContext) CalculateQuota() ChangeQuota {
 		}
 	}
 
-	// final update quota is MaxParallelism minus currently updated machines count
+	// final update quota is MaxParallelism minus currently updated machines count and the machines waiting to become healthy
 	if quota.Update > 0 {
-		quota.Update -= len(rc.GetUpdatingMachines())
+		quota.Update -= len(rc.GetUpdatingMachines()) + len(rc.idsWaitingForHealthy)
 
 		if quota.Update < 0 {
 			quota.Update = 0
 		}
 	}
 
+	// the update doesn't go on while MaxUnavailable machines are unavailable
+	if maxUnavailable := int(getRollingUpdateConfig(machineSetSpec).GetMaxUnavailable()); maxUnavailable > 0 {
+		quota.Update = max(0, min(quota.Update, maxUnavailable-len(rc.GetUnavailableMachines())))
+	}
+
 	return quota
 }
 
+func getRollingUpdateConfig(machineSetSpec *specs.MachineSetSpec) *specs.MachineSetSpec_RollingUpdateStrategyConfig {
+	if machineSetSpec.UpdateStrategy != specs.MachineSetSpec_Rolling {
+		return nil
+	}
+
+	return machineSetSpec.GetUpdateStrategyConfig().GetRolling()
+}
+
 func getParallelismOrDefault(strategyType specs.MachineSetSpec_UpdateStrategy, strategy *specs.MachineSetSpec_UpdateStrategyConfig, def int) int {
 	if strategyType == specs.MachineSetSpec_Rolling {
 		if strategy == nil {
@@ -7,10 +7,12 @@ package machineset_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
@@ -56,10 +58,12 @@ func TestReconciliationContext(t *testing.T) {
 		name                         string
 		machineSet                   *specs.MachineSetSpec
 		lbUnhealthy                  bool
+		clusterPaused                bool
 		machineSetNodes              []*omni.MachineSetNode
 		clusterMachines              []*omni.ClusterMachine
 		clusterMachineConfigStatuses []*omni.ClusterMachineConfigStatus
 		clusterMachineConfigPatches  []*omni.ClusterMachineConfigPatches
+		clusterMachineStatuses       []*omni.ClusterMachineStatus
 		expectedQuota                machineset.ChangeQuota
 		expectedTearingDown          []string
 		expectedUpdating             []string
@@ -207,6 +211,34 @@ func TestReconciliationContext(t *testing.T) {
 			},
 			expectedToUpdate: []string{"c"},
 		},
+		{
+			name: "paused cluster",
+			machineSet: &specs.MachineSetSpec{
+				UpdateStrategy: specs.MachineSetSpec_Rolling,
+				DeleteStrategy: specs.MachineSetSpec_Unset,
+			},
+			clusterPaused: true,
+			machineSetNodes: []*omni.MachineSetNode{
+				omni.NewMachineSetNode(resources.DefaultNamespace, "a", omni.NewMachineSet("", "")),
+				omni.NewMachineSetNode(resources.DefaultNamespace, "c", omni.NewMachineSet("", "")),
+			},
+			clusterMachines: []*omni.ClusterMachine{
+				withVersion(omni.NewClusterMachine(resources.DefaultNamespace, "b"), version),
+				withVersion(omni.NewClusterMachine(resources.DefaultNamespace, "c"), version),
+			},
+			clusterMachineConfigStatuses: []*omni.ClusterMachineConfigStatus{
+				withClusterMachineVersionSetter(omni.NewClusterMachineConfigStatus(resources.DefaultNamespace, "b"), version),
+				withClusterMachineVersionSetter(omni.NewClusterMachineConfigStatus(resources.DefaultNamespace, "c"), version),
+			},
+			clusterMachineConfigPatches: []*omni.ClusterMachineConfigPatches{
+				omni.NewClusterMachineConfigPatches(resources.DefaultNamespace, "b"),
+				omni.NewClusterMachineConfigPatches(resources.DefaultNamespace, "c"),
+			},
+			expectedQuota: machineset.ChangeQuota{
+				Teardown: -1,
+				Update:   1,
+			},
+		},
 		{
 			name: "tearing down machines",
 			machineSet: &specs.MachineSetSpec{
@@ -303,6 +335,93 @@ func TestReconciliationContext(t *testing.T) {
 			},
 			expectedToDestroy: []string{"a"},
 		},
+		{
+			name: "wait for healthy",
+			machineSet: &specs.MachineSetSpec{
+				UpdateStrategy: specs.MachineSetSpec_Rolling,
+				UpdateStrategyConfig: &specs.MachineSetSpec_UpdateStrategyConfig{
+					Rolling: &specs.MachineSetSpec_RollingUpdateStrategyConfig{
+						MaxParallelism:        2,
+						WaitForHealthyTimeout: durationpb.New(time.Hour),
+					},
+				},
+				DeleteStrategy: specs.MachineSetSpec_Unset,
+			},
+			machineSetNodes: []*omni.MachineSetNode{
+				omni.NewMachineSetNode(resources.DefaultNamespace, "a", omni.NewMachineSet("", "")),
+				omni.NewMachineSetNode(resources.DefaultNamespace, "b", omni.NewMachineSet("", "")),
+				omni.NewMachineSetNode(resources.DefaultNamespace, "c", omni.NewMachineSet("", "")),
+			},
+			clusterMachines: []*omni.ClusterMachine{
+				withUpdateInputVersions(withVersion(omni.NewClusterMachine(resources.DefaultNamespace, "a"), version), configPatches...),
+				withUpdateInputVersions(withVersion(omni.NewClusterMachine(resources.DefaultNamespace, "b"), version), configPatches...),
+				withUpdateInputVersions(withVersion(omni.NewClusterMachine(resources.DefaultNamespace, "c"), version), configPatches...),
+			},
+			clusterMachineConfigStatuses: []*omni.ClusterMachineConfigStatus{
+				withClusterMachineVersionSetter(omni.NewClusterMachineConfigStatus(resources.DefaultNamespace, "a"), version),
+				withClusterMachineVersionSetter(omni.NewClusterMachineConfigStatus(resources.DefaultNamespace, "b"), version),
+				withUpdated(withClusterMachineVersionSetter(omni.NewClusterMachineConfigStatus(resources.DefaultNamespace, "c"), version), time.Now().Add(-2*time.Hour)),
+			},
+			clusterMachineConfigPatches: []*omni.ClusterMachineConfigPatches{
+				omni.NewClusterMachineConfigPatches(resources.DefaultNamespace, "a"),
+				omni.NewClusterMachineConfigPatches(resources.DefaultNamespace, "b"),
+				omni.NewClusterMachineConfigPatches(resources.DefaultNamespace, "c"),
+			},
+			// "a" was configured recently and isn't ready yet, "c" didn't become ready within the timeout
+			clusterMachineStatuses: []*omni.ClusterMachineStatus{
+				withReady(omni.NewClusterMachineStatus(resources.DefaultNamespace, "a"), false),
+				withReady(omni.NewClusterMachineStatus(resources.DefaultNamespace, "b"), true),
+				withReady(omni.NewClusterMachineStatus(resources.DefaultNamespace, "c"), false),
+			},
+			expectedQuota: machineset.ChangeQuota{
+				Teardown: -1,
+				Update:   1,
+			},
+		},
+		{
+			name: "max unavailable",
+			machineSet: &specs.MachineSetSpec{
+				UpdateStrategy: specs.MachineSetSpec_Rolling,
+				UpdateStrategyConfig: &specs.MachineSetSpec_UpdateStrategyConfig{
+					Rolling: &specs.MachineSetSpec_RollingUpdateStrategyConfig{
+						MaxParallelism: 3,
+						MaxUnavailable: 3,
+					},
+				},
+				DeleteStrategy: specs.MachineSetSpec_Unset,
+			},
+			machineSetNodes: []*omni.MachineSetNode{
+				omni.NewMachineSetNode(resources.DefaultNamespace, "a", omni.NewMachineSet("", "")),
+				omni.NewMachineSetNode(resources.DefaultNamespace, "b", omni.NewMachineSet("", "")),
+				omni.NewMachineSetNode(resources.DefaultNamespace, "c", omni.NewMachineSet("", "")),
+			},
+			clusterMachines: []*omni.ClusterMachine{
+				withUpdateInputVersions(withVersion(omni.NewClusterMachine(resources.DefaultNamespace, "a"), version), configPatches...),
+				withUpdateInputVersions(withVersion(omni.NewClusterMachine(resources.DefaultNamespace, "b"), version), configPatches...),
+				withUpdateInputVersions(withVersion(omni.NewClusterMachine(resources.DefaultNamespace, "c"), version), configPatches...),
+			},
+			clusterMachineConfigStatuses: []*omni.ClusterMachineConfigStatus{
+				omni.NewClusterMachineConfigStatus(resources.DefaultNamespace, "a"),
+				withClusterMachineVersionSetter(omni.NewClusterMachineConfigStatus(resources.DefaultNamespace, "b"), version),
+				withClusterMachineVersionSetter(omni.NewClusterMachineConfigStatus(resources.DefaultNamespace, "c"), version),
+			},
+			clusterMachineConfigPatches: []*omni.ClusterMachineConfigPatches{
+				omni.NewClusterMachineConfigPatches(resources.DefaultNamespace, "a"),
+				omni.NewClusterMachineConfigPatches(resources.DefaultNamespace, "b"),
+				omni.NewClusterMachineConfigPatches(resources.DefaultNamespace, "c"),
+			},
+			// "a" is being updated and "b" is not ready, so only one more machine can become unavailable
+			clusterMachineStatuses: []*omni.ClusterMachineStatus{
+				withReady(omni.NewClusterMachineStatus(resources.DefaultNamespace, "a"), true),
+				withReady(omni.NewClusterMachineStatus(resources.DefaultNamespace, "b"), false),
+				withReady(omni.NewClusterMachineStatus(resources.DefaultNamespace, "c"), true),
+			},
+			expectedQuota: machineset.ChangeQuota{
+				Teardown: -1,
+				Update:   1,
+			},
+			expectedUpdating: []string{"a"},
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
@@ -318,6 +437,10 @@ func TestReconciliationContext(t *testing.T) {
 			cluster.TypedSpec().Value.TalosVersion = "v1.6.4"
 			cluster.TypedSpec().Value.KubernetesVersion = "v1.29.0"
 
+			if tt.clusterPaused {
+				cluster.Metadata().Annotations().Set(omni.ClusterPaused, "")
+			}
+
 			var loadbalancerStatus *omni.LoadBalancerStatus
 
 			if !tt.lbUnhealthy {
@@ -334,7 +457,7 @@ func TestReconciliationContext(t *testing.T) {
 				tt.clusterMachines,
 				tt.clusterMachineConfigStatuses,
 				tt.clusterMachineConfigPatches,
-				nil,
+				tt.clusterMachineStatuses,
 			)
 
 			require.NoError(err)
@@ -94,3 +94,43 @@ func MapMachineSetToLabeledResources[I generic.ResourceWithRD, O generic.Resourc
 		})
 	}
 }
+
+// MapClusterLabelToLabeledResources returns a mapper that maps a resource with a cluster label to all resources with the same cluster label.
+func MapClusterLabelToLabeledResources[I generic.ResourceWithRD, O generic.ResourceWithRD]() qtransform.MapperFuncGeneric[I] {
+	return func(ctx context.Context, _ *zap.Logger, r controller.QRuntime, i I) ([]resource.Pointer, error) {
+		clusterName, ok := i.Metadata().Labels().Get(omni.LabelCluster)
+		if !ok {
+			return nil, nil
+		}
+
+		items, err := safe.ReaderListAll[O](ctx, r, state.WithLabelQuery(resource.LabelEqual(omni.LabelCluster, clusterName)))
+		if err != nil {
+			return nil, err
+		}
+
+		return safe.Map(items, func(item O) (resource.Pointer, error) {
+			return item.Metadata(), nil
+		})
+	}
+}
+
+// MapMachineSetToClusterMachineIDs returns a mapper that maps a machine set resource to the resources with the same IDs as the cluster machines of the machine set.
+//
+// It is used for the resources which don't have the machine set label themselves, but share the ID with a cluster machine.
+func MapMachineSetToClusterMachineIDs[I generic.ResourceWithRD, O generic.ResourceWithRD]() qtransform.MapperFuncGeneric[I] {
+	var zeroOutput O
+
+	outputNamespace := zeroOutput.ResourceDefinition().DefaultNamespace
+	outputType := zeroOutput.ResourceDefinition().Type
+
+	return func(ctx context.Context, _ *zap.Logger, r controller.QRuntime, i I) ([]resource.Pointer, error) {
+		clusterMachines, err := safe.ReaderListAll[*omni.ClusterMachine](ctx, r, state.WithLabelQuery(resource.LabelEqual(omni.LabelMachineSet, i.Metadata().ID())))
+		if err != nil {
+			return nil, err
+		}
+
+		return safe.Map(clusterMachines, func(clusterMachine *omni.ClusterMachine) (resource.Pointer, error) {
+			return resource.NewMetadata(outputNamespace, outputType, clusterMachine.Metadata().ID(), resource.VersionUndefined), nil
+		})
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package patchblob implements the content-addressed storage for the large config patches shared by the machines of a machine set.
+//
+// The same patch is usually applied to every machine of a machine set, so instead of keeping N copies of it
+// in the ClusterMachineConfigPatches resources, it is stored once in the compressed ConfigPatchBlob resource
+// referenced by the hash of the patch contents.
+package patchblob
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/helpers"
+)
+
+// MinSize is the size of the patch starting from which it is stored in the ConfigPatchBlob instead of being inlined.
+const MinSize = 1024
+
+var (
+	encoder = sync.OnceValue(func() *zstd.Encoder {
+		enc, err := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			panic(err)
+		}
+
+		return enc
+	})
+
+	decoder = sync.OnceValue(func() *zstd.Decoder {
+		dec, err := zstd.NewReader(nil, zstd.WithDecoderConcurrency(0))
+		if err != nil {
+			panic(err)
+		}
+
+		return dec
+	})
+)
+
+// Shared returns true if the patch should be stored in the ConfigPatchBlob.
+func Shared(data string) bool {
+	return len(data) >= MinSize
+}
+
+// ID returns the ID of the ConfigPatchBlob keeping the patch of the machine set.
+func ID(machineSetID resource.ID, data string) resource.ID {
+	hash := sha256.Sum256([]byte(data))
+
+	return machineSetID + "-" + hex.EncodeToString(hash[:])
+}
+
+// Store creates the ConfigPatchBlob for the patch of the machine set if it doesn't exist yet and returns its ID.
+func Store(ctx context.Context, r controller.ReaderWriter, machineSet *omni.MachineSet, data string) (resource.ID, error) {
+	id := ID(machineSet.Metadata().ID(), data)
+
+	if err := safe.WriterModify(ctx, r, omni.NewConfigPatchBlob(resources.DefaultNamespace, id), func(blob *omni.ConfigPatchBlob) error {
+		helpers.CopyLabels(machineSet, blob, omni.LabelCluster)
+
+		blob.Metadata().Labels().Set(omni.LabelMachineSet, machineSet.Metadata().ID())
+
+		// the contents are addressed by the hash, so the existing blob never changes
+		if len(blob.TypedSpec().Value.CompressedData) == 0 {
+			blob.TypedSpec().Value.CompressedData = encoder().EncodeAll([]byte(data), nil)
+		}
+
+		return nil
+	}); err != nil {
+		return "", fmt.Errorf("failed to store config patch blob %q: %w", id, err)
+	}
+
+	return id, nil
+}
+
+// Resolve returns the final list of patches of the cluster machine, reading the shared ones from the ConfigPatchBlob resources.
+func Resolve(ctx context.Context, r controller.Reader, clusterMachineConfigPatches *omni.ClusterMachineConfigPatches) ([]string, error) {
+	spec := clusterMachineConfigPatches.TypedSpec().Value
+
+	if len(spec.BlobIds) == 0 {
+		return spec.Patches, nil
+	}
+
+	if len(spec.BlobIds) != len(spec.Patches) {
+		return nil, fmt.Errorf("config patches of %q reference %d blobs for %d patches", clusterMachineConfigPatches.Metadata().ID(), len(spec.BlobIds), len(spec.Patches))
+	}
+
+	patches := make([]string, 0, len(spec.Patches))
+
+	for i, id := range spec.BlobIds {
+		if id == "" {
+			patches = append(patches, spec.Patches[i])
+
+			continue
+		}
+
+		blob, err := safe.ReaderGetByID[*omni.ConfigPatchBlob](ctx, r, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get config patch blob %q: %w", id, err)
+		}
+
+		data, err := decoder().DecodeAll(blob.TypedSpec().Value.CompressedData, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress config patch blob %q: %w", id, err)
+		}
+
+		patches = append(patches, string(data))
+	}
+
+	return patches, nil
+}
@@ -37,6 +37,7 @@ import (
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/client/pkg/panichandler"
+	"github.com/siderolabs/omni/internal/backend/dryrun"
 	"github.com/siderolabs/omni/internal/backend/runtime/talos"
 )
 
@@ -321,6 +322,7 @@ func (spec IdentityCollectorTaskSpec) getClient(ctx context.Context) (*client.Cl
 	}
 
 	opts = append(opts, client.WithConfig(spec.config))
+	opts = append(opts, dryrun.TalosClientOptions()...)
 
 	c, err := client.New(ctx, opts...)
 	if err != nil {
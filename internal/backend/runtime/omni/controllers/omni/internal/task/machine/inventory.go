@@ -0,0 +1,257 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package machine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"google.golang.org/grpc/codes"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+)
+
+const (
+	pciDevicesPath = "/sys/bus/pci/devices"
+	nvmePath       = "/sys/class/nvme"
+	numaNodesPath  = "/sys/devices/system/node"
+)
+
+// pciVendors are the names of the well known PCI vendors.
+var pciVendors = map[string]string{
+	"10de": "nvidia",
+	"1002": "amd",
+	"8086": "intel",
+	"1a03": "aspeed",
+	"14e4": "broadcom",
+	"15b3": "mellanox",
+	"1af4": "virtio",
+}
+
+// gpuVendors are the vendors whose display controllers are considered GPUs.
+//
+// The display controllers of the other vendors are the simple framebuffers, e.g. of the BMC.
+var gpuVendors = []string{"nvidia", "amd", "intel"}
+
+// pollHardwareInventory reads the PCI devices, the NVMe drives and the NUMA topology from sysfs.
+//
+// The hardware doesn't change without a reboot, which restarts the task, so it is enough to read it once.
+// The inventory is not collected in the maintenance mode, as the file access API is not available.
+func pollHardwareInventory(ctx context.Context, c *client.Client, info *Info) error {
+	inventory := &specs.MachineHardwareInventorySpec{}
+
+	for _, poll := range []func(context.Context, *client.Client, *specs.MachineHardwareInventorySpec) error{
+		pollPCIDevices,
+		pollNVMeDrives,
+		pollNUMANodes,
+	} {
+		if err := poll(ctx, c, inventory); err != nil {
+			if code := client.StatusCode(err); code == codes.Unimplemented || code == codes.PermissionDenied {
+				return nil
+			}
+
+			return err
+		}
+	}
+
+	info.HardwareInventory = inventory
+
+	return nil
+}
+
+func pollPCIDevices(ctx context.Context, c *client.Client, inventory *specs.MachineHardwareInventorySpec) error {
+	addresses, err := listSysfsDir(ctx, c, pciDevicesPath)
+	if err != nil {
+		return err
+	}
+
+	for _, address := range addresses {
+		device := &specs.MachineHardwareInventorySpec_PCIDevice{
+			Address:  address,
+			NumaNode: -1,
+		}
+
+		for file, value := range map[string]*string{
+			"vendor": &device.VendorId,
+			"device": &device.ProductId,
+			"class":  &device.Class,
+		} {
+			if *value, err = readSysfsValue(ctx, c, filepath.Join(pciDevicesPath, address, file)); err != nil {
+				return err
+			}
+
+			*value = strings.TrimPrefix(*value, "0x")
+		}
+
+		device.Vendor = pciVendors[device.VendorId]
+
+		// the NUMA node is not reported on some platforms
+		if numaNode, readErr := readSysfsValue(ctx, c, filepath.Join(pciDevicesPath, address, "numa_node")); readErr == nil {
+			if id, parseErr := strconv.ParseInt(numaNode, 10, 32); parseErr == nil {
+				device.NumaNode = int32(id)
+			}
+		}
+
+		inventory.PciDevices = append(inventory.PciDevices, device)
+
+		if isGPU(device) {
+			inventory.Gpus = append(inventory.Gpus, device)
+		}
+	}
+
+	return nil
+}
+
+// isGPU returns true for the display controllers of the GPU vendors and for the processing accelerators.
+func isGPU(device *specs.MachineHardwareInventorySpec_PCIDevice) bool {
+	switch {
+	case strings.HasPrefix(device.Class, "12"):
+		return true
+	case strings.HasPrefix(device.Class, "03"):
+		return slices.Contains(gpuVendors, device.Vendor)
+	default:
+		return false
+	}
+}
+
+func pollNVMeDrives(ctx context.Context, c *client.Client, inventory *specs.MachineHardwareInventorySpec) error {
+	names, err := listSysfsDir(ctx, c, nvmePath)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		drive := &specs.MachineHardwareInventorySpec_NVMeDrive{
+			Name: name,
+		}
+
+		for file, value := range map[string]*string{
+			"model":        &drive.Model,
+			"serial":       &drive.Serial,
+			"firmware_rev": &drive.FirmwareVersion,
+		} {
+			if *value, err = readSysfsValue(ctx, c, filepath.Join(nvmePath, name, file)); err != nil {
+				return err
+			}
+		}
+
+		// the fabrics attached controllers have no PCI address
+		drive.Address, _ = readSysfsValue(ctx, c, filepath.Join(nvmePath, name, "address"))
+
+		inventory.NvmeDrives = append(inventory.NvmeDrives, drive)
+	}
+
+	return nil
+}
+
+func pollNUMANodes(ctx context.Context, c *client.Client, inventory *specs.MachineHardwareInventorySpec) error {
+	names, err := listSysfsDir(ctx, c, numaNodesPath)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		id, parseErr := strconv.ParseUint(strings.TrimPrefix(name, "node"), 10, 32)
+		if !strings.HasPrefix(name, "node") || parseErr != nil {
+			continue
+		}
+
+		node := &specs.MachineHardwareInventorySpec_NUMANode{
+			Id: uint32(id),
+		}
+
+		if node.Cpus, err = readSysfsValue(ctx, c, filepath.Join(numaNodesPath, name, "cpulist")); err != nil {
+			return err
+		}
+
+		meminfo, readErr := readSysfsValue(ctx, c, filepath.Join(numaNodesPath, name, "meminfo"))
+		if readErr != nil {
+			return readErr
+		}
+
+		node.MemoryBytes = parseNUMAMemTotal(meminfo)
+
+		inventory.NumaNodes = append(inventory.NumaNodes, node)
+	}
+
+	return nil
+}
+
+// parseNUMAMemTotal parses the total memory of the NUMA node from its meminfo, e.g. "Node 0 MemTotal: 32768000 kB".
+func parseNUMAMemTotal(meminfo string) uint64 {
+	for _, line := range strings.Split(meminfo, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 4 || fields[2] != "MemTotal:" {
+			continue
+		}
+
+		kilobytes, err := strconv.ParseUint(fields[3], 10, 64)
+		if err != nil {
+			return 0
+		}
+
+		return kilobytes * 1024
+	}
+
+	return 0
+}
+
+// listSysfsDir returns the sorted names of the entries of the directory, the missing directory has no entries.
+func listSysfsDir(ctx context.Context, c *client.Client, path string) ([]string, error) {
+	stream, err := c.LS(ctx, &machineapi.ListRequest{
+		Root: path,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+
+	for {
+		fileInfo, recvErr := stream.Recv()
+		if recvErr != nil {
+			if errors.Is(recvErr, io.EOF) {
+				break
+			}
+
+			return nil, recvErr
+		}
+
+		if fileInfo.GetError() != "" || fileInfo.GetRelativeName() == "" || fileInfo.GetRelativeName() == "." {
+			continue
+		}
+
+		names = append(names, fileInfo.GetRelativeName())
+	}
+
+	slices.Sort(names)
+
+	return names, nil
+}
+
+func readSysfsValue(ctx context.Context, c *client.Client, path string) (string, error) {
+	reader, err := c.Read(ctx, path)
+	if err != nil {
+		return "", err
+	}
+
+	defer reader.Close() //nolint:errcheck
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return "", fmt.Errorf("error reading %q: %w", path, err)
+	}
+
+	return strings.TrimSpace(string(data)), nil
+}
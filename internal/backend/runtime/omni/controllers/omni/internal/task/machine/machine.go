@@ -11,6 +11,7 @@ import (
 	"crypto/tls"
 	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 	"time"
 
@@ -28,6 +29,7 @@ import (
 
 	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/dryrun"
 	talosschematic "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/talos"
 	"github.com/siderolabs/omni/internal/backend/runtime/talos"
 )
@@ -65,11 +67,136 @@ type Info struct { //nolint:govet
 
 	DefaultKernelArgs []string
 	SecureBootStatus  *specs.SecureBootStatus
+
+	BootID *string
+
+	HardwareInventory *specs.MachineHardwareInventorySpec
+}
+
+// Merge returns the info with the delta collected by a later poll applied on top of it.
+//
+// The fields which were not collected by the later poll are kept,
+// the error, the access and the mode flags are always taken from the later info.
+//
+//nolint:gocyclo,cyclop
+func (info Info) Merge(delta Info) Info {
+	merged := info
+
+	if delta.TalosVersion != nil {
+		merged.TalosVersion = delta.TalosVersion
+	}
+
+	if delta.Arch != nil {
+		merged.Arch = delta.Arch
+	}
+
+	if delta.MachineLabels != nil {
+		merged.MachineLabels = delta.MachineLabels
+	}
+
+	if delta.Hostname != nil {
+		merged.Hostname = delta.Hostname
+	}
+
+	if delta.Domainname != nil {
+		merged.Domainname = delta.Domainname
+	}
+
+	if delta.Addresses != nil {
+		merged.Addresses = delta.Addresses
+	}
+
+	if delta.DefaultGateways != nil {
+		merged.DefaultGateways = delta.DefaultGateways
+	}
+
+	if delta.NetworkLinks != nil {
+		merged.NetworkLinks = delta.NetworkLinks
+	}
+
+	if delta.ImageLabels != nil {
+		merged.ImageLabels = delta.ImageLabels
+	}
+
+	if delta.Processors != nil {
+		merged.Processors = delta.Processors
+	}
+
+	if delta.MemoryModules != nil {
+		merged.MemoryModules = delta.MemoryModules
+	}
+
+	if delta.Blockdevices != nil {
+		merged.Blockdevices = delta.Blockdevices
+	}
+
+	if delta.PlatformMetadata != nil {
+		merged.PlatformMetadata = delta.PlatformMetadata
+	}
+
+	if delta.Schematic != nil {
+		merged.Schematic = delta.Schematic
+	}
+
+	if delta.SecureBootStatus != nil {
+		merged.SecureBootStatus = delta.SecureBootStatus
+	}
+
+	if delta.BootID != nil {
+		merged.BootID = delta.BootID
+	}
+
+	if delta.HardwareInventory != nil {
+		merged.HardwareInventory = delta.HardwareInventory
+	}
+
+	merged.LastError = delta.LastError
+	merged.MachineID = delta.MachineID
+	merged.MaintenanceMode = delta.MaintenanceMode
+	merged.NoAccess = delta.NoAccess
+	merged.DefaultKernelArgs = delta.DefaultKernelArgs
+
+	return merged
 }
 
 // InfoChan is a channel for sending machine info from tasks back to the controller.
 type InfoChan chan<- Info
 
+const (
+	// defaultPollInterval is used if the minimum poll interval is not set.
+	defaultPollInterval = time.Second
+
+	// batchInterval is the interval the changed resources reported by the watches are polled in batches at.
+	batchInterval = time.Second
+)
+
+// PollIntervals are the bounds of the interval between the periodic polls of the machine resources which can't be watched,
+// and between the retries of the failed connections and polls.
+//
+// The poll interval is reset to the Min whenever a poll finds changes (the machine is in transition),
+// and doubles after each periodic poll which found no changes up to the Max (the machine is stable).
+// The retry interval doubles after each failure up to the Max, and is reset once the machine is polled successfully.
+type PollIntervals struct {
+	Min time.Duration
+	Max time.Duration
+}
+
+// Next returns the interval to wait for after the poll which was done after waiting for the current interval.
+func (intervals PollIntervals) Next(current time.Duration, changed bool) time.Duration {
+	minInterval := intervals.Min
+	if minInterval <= 0 {
+		minInterval = defaultPollInterval
+	}
+
+	maxInterval := max(intervals.Max, minInterval)
+
+	if changed || current < minInterval {
+		return minInterval
+	}
+
+	return min(current*2, maxInterval)
+}
+
 // CollectTaskSpec describes a task to collect machine information.
 type CollectTaskSpec struct {
 	_ [0]func() // make uncomparable
@@ -79,6 +206,7 @@ type CollectTaskSpec struct {
 	Endpoint                   string
 	MachineID                  string
 	DefaultSchematicKernelArgs []string
+	PollIntervals              PollIntervals
 	MaintenanceMode            bool
 }
 
@@ -101,7 +229,7 @@ func resourceEqual[T any, S interface {
 //
 // If the task spec changes, the task will be restarted.
 func (spec CollectTaskSpec) Equal(other CollectTaskSpec) bool {
-	if spec.Endpoint != other.Endpoint || spec.MaintenanceMode != other.MaintenanceMode {
+	if spec.Endpoint != other.Endpoint || spec.MaintenanceMode != other.MaintenanceMode || spec.PollIntervals != other.PollIntervals {
 		return false
 	}
 
@@ -149,15 +277,41 @@ func (spec CollectTaskSpec) sendInfo(ctx context.Context, info Info, notifyCh In
 // It creates either a maintenance Talos API client or a regular one (depends on the spec).
 //
 // It subscribes to resource updates and polls for resources that can't be watched.
+// The failed connections and polls are retried at the growing intervals, see PollIntervals.
+func (spec CollectTaskSpec) RunTask(ctx context.Context, logger *zap.Logger, notifyCh InfoChan) error {
+	var retryInterval time.Duration
+
+	for {
+		polled, err := spec.collect(ctx, logger, notifyCh)
+		if err == nil {
+			return nil
+		}
+
+		retryInterval = spec.PollIntervals.Next(retryInterval, polled)
+
+		logger.Warn("failed to collect machine info, retrying", zap.Duration("interval", retryInterval), zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+// collect connects to the machine and collects its info until the context is canceled or an error occurs.
+//
+// It reports whether the machine was polled successfully at least once.
 //
 //nolint:gocyclo,cyclop,gocognit
-func (spec CollectTaskSpec) RunTask(ctx context.Context, logger *zap.Logger, notifyCh InfoChan) error {
+func (spec CollectTaskSpec) collect(ctx context.Context, logger *zap.Logger, notifyCh InfoChan) (bool, error) {
 	var (
-		c   *client.Client
-		err error
+		c      *client.Client
+		err    error
+		polled bool
 	)
 
-	opts := talos.GetSocketOptions(spec.Endpoint)
+	opts := append(talos.GetSocketOptions(spec.Endpoint), dryrun.TalosClientOptions()...)
 
 	if spec.MaintenanceMode {
 		opts = append(opts, client.WithTLSConfig(insecureTLSConfig), client.WithEndpoints(spec.Endpoint))
@@ -165,7 +319,7 @@ func (spec CollectTaskSpec) RunTask(ctx context.Context, logger *zap.Logger, not
 		c, err = client.New(ctx, opts...)
 	} else {
 		if spec.TalosConfig == nil {
-			return errors.New("no talosconfig, and not in maintenance mode")
+			return false, errors.New("no talosconfig, and not in maintenance mode")
 		}
 
 		config := omni.NewTalosClientConfig(spec.TalosConfig, spec.Endpoint)
@@ -176,7 +330,7 @@ func (spec CollectTaskSpec) RunTask(ctx context.Context, logger *zap.Logger, not
 	}
 
 	if err != nil {
-		return fmt.Errorf("error building Talos API client: %w", err)
+		return false, fmt.Errorf("error building Talos API client: %w", err)
 	}
 
 	defer c.Close() //nolint:errcheck
@@ -184,22 +338,23 @@ func (spec CollectTaskSpec) RunTask(ctx context.Context, logger *zap.Logger, not
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	const (
-		disksPollInterval = 5 * time.Minute
-		minPolInterval    = time.Second
-	)
+	// the disks can't be watched, so they are polled periodically, the stable machines are polled less often
+	disksPollInterval := spec.PollIntervals.Next(0, true)
 
 	disksTicker := time.NewTicker(disksPollInterval)
 	defer disksTicker.Stop()
 
-	pollTicker := time.NewTicker(minPolInterval)
+	pollTicker := time.NewTicker(batchInterval)
 	defer pollTicker.Stop()
 
+	// collected is the info gathered by all polls so far, used to detect whether the machine is changing
+	var collected Info
+
 	watchCh := make(chan state.Event)
 
 	registeredTypes, err := QueryRegisteredTypes(ctx, c.COSI)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	// as Talos < 1.3.0 doesn't support Bootstrapped event, we use a mixed approach:
@@ -257,7 +412,7 @@ func (spec CollectTaskSpec) RunTask(ctx context.Context, logger *zap.Logger, not
 				continue
 			}
 
-			return fmt.Errorf("error watching COSI resource: %w", err)
+			return false, fmt.Errorf("error watching COSI resource: %w", err)
 		}
 	}
 
@@ -281,21 +436,36 @@ func (spec CollectTaskSpec) RunTask(ctx context.Context, logger *zap.Logger, not
 			info, err := spec.poll(ctx, c, maps.Keys(dirtyPollers))
 
 			if !spec.sendInfo(ctx, info, notifyCh, err) {
-				return nil
+				return polled, nil
 			}
 
 			if err != nil {
-				return fmt.Errorf("poll failed: %w", err)
+				return polled, fmt.Errorf("poll failed: %w", err)
 			}
 
+			polled = true
+
+			_, disksPolled := dirtyPollers["disks"]
+
 			dirtyPollers = map[string]struct{}{}
+
+			merged := collected.Merge(info)
+			changed := !reflect.DeepEqual(collected, merged)
+			collected = merged
+
+			// the interval grows only after the periodic polls, the changes found by the watches only shorten it
+			if next := spec.PollIntervals.Next(disksPollInterval, changed); next < disksPollInterval || disksPolled && next > disksPollInterval {
+				disksPollInterval = next
+
+				disksTicker.Reset(disksPollInterval)
+			}
 		}
 
 	waitLoop:
 		for {
 			select {
 			case <-ctx.Done():
-				return nil
+				return polled, nil
 			case <-disksTicker.C:
 				// poll disks as we have no way to watch for changes
 				dirtyPollers["disks"] = struct{}{}
@@ -304,7 +474,7 @@ func (spec CollectTaskSpec) RunTask(ctx context.Context, logger *zap.Logger, not
 			case event := <-watchCh:
 				switch event.Type {
 				case state.Errored:
-					return fmt.Errorf("error watching COSI resource: %w", event.Error)
+					return polled, fmt.Errorf("error watching COSI resource: %w", event.Error)
 				case state.Bootstrapped:
 					// ignore
 				case state.Created, state.Updated, state.Destroyed:
@@ -0,0 +1,99 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package machine_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/task/machine"
+)
+
+func TestInfoMerge(t *testing.T) {
+	t.Parallel()
+
+	first := machine.Info{
+		MachineID:    "test",
+		TalosVersion: pointer.To("v1.7.0"),
+		Hostname:     pointer.To("old"),
+		Addresses:    []string{"10.5.0.2/24"},
+		LastError:    errors.New("failed"),
+		NoAccess:     true,
+	}
+
+	merged := first.Merge(machine.Info{
+		MachineID:       "test",
+		Hostname:        pointer.To("new"),
+		MaintenanceMode: true,
+	})
+
+	assert.Equal(t, "v1.7.0", *merged.TalosVersion)
+	assert.Equal(t, "new", *merged.Hostname)
+	assert.Equal(t, []string{"10.5.0.2/24"}, merged.Addresses)
+	assert.NoError(t, merged.LastError)
+	assert.False(t, merged.NoAccess)
+	assert.True(t, merged.MaintenanceMode)
+
+	// the original info is not modified
+	assert.Equal(t, "old", *first.Hostname)
+}
+
+func TestPollIntervalsNext(t *testing.T) {
+	t.Parallel()
+
+	intervals := machine.PollIntervals{Min: time.Second, Max: 5 * time.Second}
+
+	interval := intervals.Next(0, true)
+	assert.Equal(t, time.Second, interval)
+
+	var backoff []time.Duration
+
+	for range 4 {
+		interval = intervals.Next(interval, false)
+
+		backoff = append(backoff, interval)
+	}
+
+	assert.Equal(t, []time.Duration{2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}, backoff)
+
+	// the changes reset the interval
+	assert.Equal(t, time.Second, intervals.Next(interval, true))
+
+	// the zero bounds keep polling every second
+	assert.Equal(t, time.Second, machine.PollIntervals{}.Next(time.Second, false))
+}
+
+func TestPollIntervalsIdleMachine(t *testing.T) {
+	t.Parallel()
+
+	intervals := machine.PollIntervals{Min: 15 * time.Second, Max: 15 * time.Minute}
+
+	// countPolls returns the number of the periodic polls done in an hour
+	countPolls := func(changing bool) int {
+		var (
+			polls   int
+			elapsed time.Duration
+		)
+
+		for interval := intervals.Next(0, true); elapsed+interval <= time.Hour; interval = intervals.Next(interval, changing) {
+			elapsed += interval
+			polls++
+		}
+
+		return polls
+	}
+
+	// the machine in transition is polled at the min interval
+	assert.Equal(t, 240, countPolls(true))
+
+	// the idle machine is polled less often than by the fixed 5 minute interval which was used before
+	assert.Equal(t, 8, countPolls(false))
+	assert.Less(t, countPolls(false), 12)
+}
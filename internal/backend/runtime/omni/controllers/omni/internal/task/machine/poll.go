@@ -54,6 +54,9 @@ var machinePollers = map[string]machinePollFunction{
 	"version": pollVersion,
 	"disks":   pollDisks,
 
+	// the boot ID changes only on reboots, which restart the task, so it is enough to read it once
+	"bootID": pollBootID,
+
 	// we do not use a resource poller for the secure boot status, as we want to mark
 	// secure boot explicitly to disabled (contrary to leaving it nil) if the feature is not available (i.e., older Talos versions).
 	//
@@ -61,6 +64,9 @@ var machinePollers = map[string]machinePollFunction{
 	//
 	// furthermore, by doing this, we skip watching this resource, which is what we want, since it does not change over time.
 	"secureBootStatus": pollSecureBootStatus,
+
+	// the hardware inventory is read once, as the hardware doesn't change without a reboot
+	"hardwareInventory": pollHardwareInventory,
 }
 
 var allPollers = merged(resourcePollers, machinePollers)
@@ -96,6 +102,32 @@ func pollVersion(ctx context.Context, c *client.Client, info *Info) error {
 	return nil
 }
 
+func pollBootID(ctx context.Context, c *client.Client, info *Info) error {
+	reader, err := c.Read(ctx, "/proc/sys/kernel/random/boot_id")
+	if err != nil {
+		if code := status.Code(err); code == codes.Unimplemented || code == codes.PermissionDenied {
+			return nil
+		}
+
+		return err
+	}
+
+	defer reader.Close() //nolint:errcheck
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		if code := status.Code(err); code == codes.Unimplemented || code == codes.PermissionDenied {
+			return nil
+		}
+
+		return err
+	}
+
+	info.BootID = pointer.To(strings.TrimSpace(string(data)))
+
+	return nil
+}
+
 func pollHostname(ctx context.Context, c *client.Client, info *Info) error {
 	return forEachResource(
 		ctx,
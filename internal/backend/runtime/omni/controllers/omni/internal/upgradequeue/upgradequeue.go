@@ -0,0 +1,182 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package upgradequeue limits the number of the clusters running upgrades at the same time.
+package upgradequeue
+
+import (
+	"slices"
+	"sync"
+)
+
+// Limits of the concurrent upgrades, zero means unlimited.
+type Limits struct {
+	// Concurrency is the maximum number of the clusters upgraded at the same time.
+	Concurrency int
+	// GroupConcurrency is the maximum number of the clusters in the same group upgraded at the same time.
+	GroupConcurrency int
+}
+
+// Queue grants the upgrade slots to the clusters in the FIFO order.
+//
+// Unlike a semaphore, the Queue never blocks: the controllers poll it until the slot is granted,
+// as the upgrades span many reconciles.
+type Queue struct {
+	active  map[string]*entry
+	waiting []*entry
+	limits  Limits
+	mu      sync.Mutex
+}
+
+type entry struct {
+	owners map[string]struct{}
+	id     string
+	group  string
+}
+
+// New creates a new Queue.
+func New(limits Limits) *Queue {
+	return &Queue{
+		active: map[string]*entry{},
+		limits: limits,
+	}
+}
+
+// Acquire takes the upgrade slot of the cluster on behalf of the owner.
+//
+// The slot is shared by all owners of the cluster. If there is no free slot, the cluster is queued,
+// and Acquire returns its position in the queue starting from 1. Zero means the slot is acquired.
+func (q *Queue) Acquire(id, group, owner string) int {
+	if q.unlimited() {
+		return 0
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if e, ok := q.active[id]; ok {
+		e.owners[owner] = struct{}{}
+
+		return 0
+	}
+
+	idx := slices.IndexFunc(q.waiting, func(e *entry) bool { return e.id == id })
+	if idx == -1 {
+		q.waiting = append(q.waiting, &entry{id: id, group: group, owners: map[string]struct{}{}})
+		idx = len(q.waiting) - 1
+	}
+
+	q.waiting[idx].owners[owner] = struct{}{}
+
+	total := len(q.active)
+	groups := map[string]int{}
+
+	for _, e := range q.active {
+		groups[e.group]++
+	}
+
+	for i, e := range q.waiting {
+		if !q.fits(total, groups, e.group) {
+			if i == idx {
+				return i + 1
+			}
+
+			continue
+		}
+
+		if i == idx {
+			q.waiting = slices.Delete(q.waiting, i, i+1)
+			q.active[id] = e
+
+			return 0
+		}
+
+		// the slot is kept for the cluster which is ahead in the queue until it comes to take it
+		total++
+		groups[e.group]++
+	}
+
+	return idx + 1
+}
+
+// Resume takes the upgrade slot of the cluster ignoring the limits.
+//
+// It is used for the upgrades which were already running, e.g. before the restart.
+func (q *Queue) Resume(id, group, owner string) {
+	if q.unlimited() {
+		return
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	e, ok := q.active[id]
+	if !ok {
+		e = &entry{id: id, group: group, owners: map[string]struct{}{}}
+
+		if idx := slices.IndexFunc(q.waiting, func(e *entry) bool { return e.id == id }); idx != -1 {
+			e = q.waiting[idx]
+			q.waiting = slices.Delete(q.waiting, idx, idx+1)
+		}
+
+		q.active[id] = e
+	}
+
+	e.owners[owner] = struct{}{}
+}
+
+// Release releases the upgrade slot of the cluster or removes it from the queue on behalf of the owner.
+//
+// The slot is freed when all owners release it.
+func (q *Queue) Release(id, owner string) {
+	if q.unlimited() {
+		return
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if e, ok := q.active[id]; ok {
+		delete(e.owners, owner)
+
+		if len(e.owners) == 0 {
+			delete(q.active, id)
+		}
+
+		return
+	}
+
+	if idx := slices.IndexFunc(q.waiting, func(e *entry) bool { return e.id == id }); idx != -1 {
+		delete(q.waiting[idx].owners, owner)
+
+		if len(q.waiting[idx].owners) == 0 {
+			q.waiting = slices.Delete(q.waiting, idx, idx+1)
+		}
+	}
+}
+
+// Active returns the number of the clusters holding the upgrade slots.
+func (q *Queue) Active() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return len(q.active)
+}
+
+func (q *Queue) unlimited() bool {
+	return q.limits.Concurrency <= 0 && q.limits.GroupConcurrency <= 0
+}
+
+func (q *Queue) fits(total int, groups map[string]int, group string) bool {
+	if q.limits.Concurrency > 0 && total >= q.limits.Concurrency {
+		return false
+	}
+
+	if q.limits.GroupConcurrency > 0 && group != "" && groups[group] >= q.limits.GroupConcurrency {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package upgradequeue_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/upgradequeue"
+)
+
+const (
+	talos      = "talos"
+	kubernetes = "kubernetes"
+)
+
+func TestFIFO(t *testing.T) {
+	t.Parallel()
+
+	queue := upgradequeue.New(upgradequeue.Limits{Concurrency: 1})
+
+	assert.Equal(t, 0, queue.Acquire("cluster-1", "", talos))
+	assert.Equal(t, 1, queue.Acquire("cluster-2", "", talos))
+	assert.Equal(t, 2, queue.Acquire("cluster-3", "", kubernetes))
+
+	// the slot is shared by all upgrades of the cluster
+	assert.Equal(t, 0, queue.Acquire("cluster-1", "", kubernetes))
+
+	queue.Release("cluster-1", talos)
+
+	assert.Equal(t, 1, queue.Acquire("cluster-2", "", talos))
+
+	queue.Release("cluster-1", kubernetes)
+
+	// the slot is kept for cluster-2 until it takes it
+	assert.Equal(t, 2, queue.Acquire("cluster-3", "", kubernetes))
+	assert.Equal(t, 0, queue.Acquire("cluster-2", "", talos))
+	assert.Equal(t, 1, queue.Acquire("cluster-3", "", kubernetes))
+
+	// the queued cluster is removed from the queue
+	queue.Release("cluster-3", kubernetes)
+	queue.Release("cluster-2", talos)
+
+	assert.Equal(t, 0, queue.Active())
+	assert.Equal(t, 0, queue.Acquire("cluster-4", "", talos))
+}
+
+func TestGroups(t *testing.T) {
+	t.Parallel()
+
+	queue := upgradequeue.New(upgradequeue.Limits{Concurrency: 3, GroupConcurrency: 1})
+
+	assert.Equal(t, 0, queue.Acquire("cluster-1", "us", talos))
+	assert.Equal(t, 1, queue.Acquire("cluster-2", "us", talos))
+
+	// the other groups are not blocked by the queued cluster
+	assert.Equal(t, 0, queue.Acquire("cluster-3", "eu", talos))
+	assert.Equal(t, 0, queue.Acquire("cluster-4", "", talos))
+
+	// the global limit is reached
+	assert.Equal(t, 2, queue.Acquire("cluster-5", "asia", talos))
+
+	queue.Release("cluster-1", talos)
+
+	assert.Equal(t, 0, queue.Acquire("cluster-2", "us", talos))
+	assert.Equal(t, 1, queue.Acquire("cluster-5", "asia", talos))
+}
+
+func TestResume(t *testing.T) {
+	t.Parallel()
+
+	queue := upgradequeue.New(upgradequeue.Limits{Concurrency: 1})
+
+	assert.Equal(t, 0, queue.Acquire("cluster-1", "", talos))
+
+	queue.Resume("cluster-2", "", talos)
+
+	assert.Equal(t, 2, queue.Active())
+	assert.Equal(t, 1, queue.Acquire("cluster-3", "", talos))
+
+	queue.Release("cluster-1", talos)
+
+	assert.Equal(t, 1, queue.Acquire("cluster-3", "", talos))
+
+	queue.Release("cluster-2", talos)
+
+	assert.Equal(t, 0, queue.Acquire("cluster-3", "", talos))
+}
+
+func TestUnlimited(t *testing.T) {
+	t.Parallel()
+
+	queue := upgradequeue.New(upgradequeue.Limits{})
+
+	for _, id := range []string{"cluster-1", "cluster-2", "cluster-3"} {
+		assert.Equal(t, 0, queue.Acquire(id, "", talos))
+	}
+
+	assert.Equal(t, 0, queue.Active())
+}
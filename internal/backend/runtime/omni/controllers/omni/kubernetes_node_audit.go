@@ -41,11 +41,11 @@ type GetKubernetesClientFunc func(ctx context.Context, cluster string) (Kubernet
 type KubernetesNodeAuditController = qtransform.QController[*omni.ClusterKubernetesNodes, *omni.KubernetesNodeAuditResult]
 
 // NewKubernetesNodeAuditController initializes KubernetesNodeAuditController.
-func NewKubernetesNodeAuditController(getKubernetesClientFunc GetKubernetesClientFunc, deleteOlderThan time.Duration) *KubernetesNodeAuditController {
-	requeueAfter := deleteOlderThan + time.Second
+//
+// The Kubernetes nodes without the cluster machines are deleted after the KubernetesNodeDeleteTimeout of the tunables.
+func NewKubernetesNodeAuditController(getKubernetesClientFunc GetKubernetesClientFunc, tunables *Tunables) *KubernetesNodeAuditController {
 	auditor := &nodeAuditor{
-		deleteOlderThan: deleteOlderThan,
-		requeueAfter:    requeueAfter,
+		tunables: tunables,
 	}
 
 	if getKubernetesClientFunc == nil {
@@ -92,7 +92,7 @@ func NewKubernetesNodeAuditController(getKubernetesClientFunc GetKubernetesClien
 						logger.Info("there are invalid nodes that are not ready to be deleted yet, requeue the audit")
 
 						// there are invalid nodes that are not ready to be deleted yet - requeue the audit
-						return controller.NewRequeueInterval(requeueAfter)
+						return controller.NewRequeueInterval(auditor.requeueAfter())
 					}
 
 					logger.Debug("no nodes are ready to be deleted in this run, skip updating the result")
@@ -112,13 +112,13 @@ func NewKubernetesNodeAuditController(getKubernetesClientFunc GetKubernetesClien
 				if requeue {
 					logger.Warn("not all nodes could be deleted, requeue the audit", zap.Strings("deleted_nodes", deleted))
 
-					return controller.NewRequeueInterval(requeueAfter)
+					return controller.NewRequeueInterval(auditor.requeueAfter())
 				}
 
 				if len(pending) > 0 {
 					logger.Info("there are still nodes that are not ready to be deleted yet, requeue the audit")
 
-					return controller.NewRequeueInterval(requeueAfter)
+					return controller.NewRequeueInterval(auditor.requeueAfter())
 				}
 
 				return nil
@@ -136,12 +136,19 @@ type nodeAuditor struct {
 	// clusterID -> nodeID -> invalidSince
 	clusterToInvalidNodes map[string]map[string]time.Time
 
-	deleteOlderThan time.Duration
-	requeueAfter    time.Duration
+	tunables *Tunables
 
 	lock sync.Mutex
 }
 
+func (auditor *nodeAuditor) deleteOlderThan() time.Duration {
+	return auditor.tunables.Get().KubernetesNodeDeleteTimeout
+}
+
+func (auditor *nodeAuditor) requeueAfter() time.Duration {
+	return auditor.deleteOlderThan() + time.Second
+}
+
 // calculateNodesStatus calculates the status of the nodes in the given cluster.
 //
 // It returns:
@@ -193,7 +200,7 @@ func (auditor *nodeAuditor) calculateNodesStatus(cluster string, desiredNodes, a
 	}
 
 	for node, invalidSince := range currentInvalidNodes {
-		if time.Since(invalidSince) > auditor.deleteOlderThan {
+		if time.Since(invalidSince) > auditor.deleteOlderThan() {
 			readyToDelete = append(readyToDelete, node)
 
 			continue
@@ -249,7 +256,7 @@ func (auditor *nodeAuditor) deleteNodes(ctx context.Context, logger *zap.Logger,
 
 	if len(deleted) == 0 {
 		// no nodes could be deleted in this run - skip updating the result and requeue with an error
-		return nil, false, controller.NewRequeueErrorf(auditor.requeueAfter, "failed to delete any of the nodes (%q) for cluster %q",
+		return nil, false, controller.NewRequeueErrorf(auditor.requeueAfter(), "failed to delete any of the nodes (%q) for cluster %q",
 			nodesToDelete, cluster)
 	}
 
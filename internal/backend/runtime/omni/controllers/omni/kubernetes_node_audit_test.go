@@ -33,7 +33,9 @@ func (suite *KubernetesNodeAuditSuite) TestReconcile() {
 		return kubernetesClient, nil
 	}
 
-	kubernetesNodeAuditController := omnictrl.NewKubernetesNodeAuditController(getKubernetesClient, 2*time.Second)
+	kubernetesNodeAuditController := omnictrl.NewKubernetesNodeAuditController(getKubernetesClient, omnictrl.NewTunables(omnictrl.TunableValues{
+		KubernetesNodeDeleteTimeout: 2 * time.Second,
+	}))
 
 	suite.Require().NoError(suite.runtime.RegisterQController(kubernetesNodeAuditController))
 
@@ -15,10 +15,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/url"
 	"slices"
 	"strconv"
 	"strings"
 	"sync/atomic"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/resource"
@@ -29,10 +31,12 @@ import (
 	"github.com/siderolabs/gen/maps"
 	"github.com/siderolabs/gen/optional"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/durationpb"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/tools/cache"
 
@@ -42,6 +46,7 @@ import (
 	"github.com/siderolabs/omni/client/pkg/panichandler"
 	"github.com/siderolabs/omni/internal/backend/runtime"
 	"github.com/siderolabs/omni/internal/backend/runtime/kubernetes"
+	"github.com/siderolabs/omni/internal/backend/workloadproxy"
 	"github.com/siderolabs/omni/internal/pkg/config"
 	"github.com/siderolabs/omni/internal/pkg/image"
 )
@@ -61,23 +66,61 @@ const (
 	//
 	// tsgen:ServiceIconAnnotationKey
 	ServiceIconAnnotationKey = "omni-kube-service-exposer.sidero.dev/icon"
+
+	// ServiceCustomDomainAnnotationKey is the annotation to define the custom domain of Kubernetes Services to expose them on.
+	//
+	// tsgen:ServiceCustomDomainAnnotationKey
+	ServiceCustomDomainAnnotationKey = "omni-kube-service-exposer.sidero.dev/custom-domain"
+
+	// ServiceHealthCheckPathAnnotationKey is the annotation to define the HTTP path of Kubernetes Services probed by the workload proxy.
+	//
+	// tsgen:ServiceHealthCheckPathAnnotationKey
+	ServiceHealthCheckPathAnnotationKey = "omni-kube-service-exposer.sidero.dev/health-check-path"
+
+	// ServiceHealthCheckIntervalAnnotationKey is the annotation to define the interval of the health checks of Kubernetes Services.
+	//
+	// tsgen:ServiceHealthCheckIntervalAnnotationKey
+	ServiceHealthCheckIntervalAnnotationKey = "omni-kube-service-exposer.sidero.dev/health-check-interval"
+
+	// ServiceStickySessionsAnnotationKey is the annotation to pin the clients of Kubernetes Services to the same node using a cookie.
+	//
+	// tsgen:ServiceStickySessionsAnnotationKey
+	ServiceStickySessionsAnnotationKey = "omni-kube-service-exposer.sidero.dev/sticky-sessions"
+)
+
+const (
+	defaultHealthCheckInterval = 30 * time.Second
+	minHealthCheckInterval     = time.Second
 )
 
+// ExposedServiceHealthProvider provides the results of the health checks of the exposed services.
+type ExposedServiceHealthProvider interface {
+	// ServiceHealth returns the result of the latest health check of the exposed service, false if it wasn't checked yet.
+	ServiceHealth(cluster resource.ID, alias string) (workloadproxy.ServiceHealth, bool)
+
+	// HealthUpdates returns the channel receiving the clusters with the changed health of the exposed services.
+	HealthUpdates() <-chan resource.ID
+}
+
 // KubernetesStatusController manages KubernetesStatus resource lifecycle.
 //
 // KubernetesStatusController plays the role of machine discovery.
 type KubernetesStatusController struct {
-	watchers map[string]*kubernetesWatcher
+	watchers       map[string]*kubernetesWatcher
+	healthProvider ExposedServiceHealthProvider
 
 	advertisedAPIURL       string
 	workloadProxySubdomain string
 }
 
 // NewKubernetesStatusController creates a new KubernetesStatusController.
-func NewKubernetesStatusController(advertisedAPIURL, workloadProxySubdomain string) *KubernetesStatusController {
+//
+// The health of the exposed services is not reported if the health provider is nil.
+func NewKubernetesStatusController(advertisedAPIURL, workloadProxySubdomain string, healthProvider ExposedServiceHealthProvider) *KubernetesStatusController {
 	return &KubernetesStatusController{
 		advertisedAPIURL:       advertisedAPIURL,
 		workloadProxySubdomain: workloadProxySubdomain,
+		healthProvider:         healthProvider,
 	}
 }
 
@@ -144,6 +187,12 @@ func (ctrl *KubernetesStatusController) Run(ctx context.Context, r controller.Ru
 
 	notifyCh := make(chan kubernetesWatcherNotify)
 
+	var healthUpdateCh <-chan resource.ID
+
+	if ctrl.healthProvider != nil {
+		healthUpdateCh = ctrl.healthProvider.HealthUpdates()
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -152,6 +201,10 @@ func (ctrl *KubernetesStatusController) Run(ctx context.Context, r controller.Ru
 			if err := ctrl.reconcileRunners(ctx, r, logger, notifyCh); err != nil {
 				return fmt.Errorf("error reconciling runners: %w", err)
 			}
+		case cluster := <-healthUpdateCh:
+			if err := ctrl.updateExposedServicesHealth(ctx, r, cluster); err != nil {
+				return fmt.Errorf("error updating exposed services health: %w", err)
+			}
 		case ev := <-notifyCh:
 			if _, running := ctrl.watchers[ev.cluster]; !running {
 				// skip notifications for not running watchers (notification came late)
@@ -192,6 +245,7 @@ func (ctrl *KubernetesStatusController) updateExposedServices(ctx context.Contex
 	tracker := trackResource(r, resources.DefaultNamespace, omni.ExposedServiceType, state.WithLabelQuery(resource.LabelEqual(omni.LabelCluster, cluster)))
 
 	usedAliases := make(map[string]struct{})
+	usedCustomDomains := make(map[string]struct{})
 
 	for _, service := range services {
 		svcID := service.Name + "." + service.Namespace
@@ -218,6 +272,31 @@ func (ctrl *KubernetesStatusController) updateExposedServices(ctx context.Contex
 			svcLogger.Debug("invalid icon on Service", zap.Error(err))
 		}
 
+		customDomain, err := ctrl.parseCustomDomain(service.Annotations[ServiceCustomDomainAnnotationKey])
+		if err != nil {
+			svcLogger.Warn("invalid custom domain on Service", zap.Error(err))
+		}
+
+		healthCheckPath, healthCheckInterval, err := parseHealthCheck(service.Annotations[ServiceHealthCheckPathAnnotationKey],
+			service.Annotations[ServiceHealthCheckIntervalAnnotationKey])
+		if err != nil {
+			svcLogger.Warn("invalid health check on Service", zap.Error(err))
+		}
+
+		var stickySessions bool
+
+		if value, ok := service.Annotations[ServiceStickySessionsAnnotationKey]; ok {
+			if stickySessions, err = strconv.ParseBool(value); err != nil {
+				svcLogger.Warn("invalid sticky sessions on Service", zap.String("sticky_sessions", value))
+			}
+		}
+
+		if _, used := usedCustomDomains[customDomain]; used && customDomain != "" {
+			svcLogger.Warn("custom domain is already used by another Service", zap.String("custom_domain", customDomain))
+
+			customDomain = ""
+		}
+
 		var alias string
 
 		if err = safe.WriterModify(ctx, r, exposedService, func(res *omni.ExposedService) error {
@@ -239,11 +318,24 @@ func (ctrl *KubernetesStatusController) updateExposedServices(ctx context.Contex
 			res.TypedSpec().Value.Label = label
 			res.TypedSpec().Value.IconBase64 = icon
 
-			res.TypedSpec().Value.Url, err = ctrl.buildExposedServiceURL(alias)
+			res.TypedSpec().Value.CustomDomain = customDomain
+
+			res.TypedSpec().Value.Url, err = ctrl.buildExposedServiceURL(alias, customDomain)
 			if err != nil {
 				return fmt.Errorf("error building exposed service URL: %w", err)
 			}
 
+			res.TypedSpec().Value.HealthCheckPath = healthCheckPath
+			res.TypedSpec().Value.HealthCheckInterval = nil
+
+			if healthCheckPath != "" {
+				res.TypedSpec().Value.HealthCheckInterval = durationpb.New(healthCheckInterval)
+			}
+
+			ctrl.setExposedServiceHealth(res.TypedSpec().Value, cluster, alias)
+
+			res.TypedSpec().Value.StickySessions = stickySessions
+
 			return nil
 		}); err != nil {
 			return fmt.Errorf("error updating exposed service: %w", err)
@@ -251,13 +343,103 @@ func (ctrl *KubernetesStatusController) updateExposedServices(ctx context.Contex
 
 		usedAliases[alias] = struct{}{}
 
+		if customDomain != "" {
+			usedCustomDomains[customDomain] = struct{}{}
+		}
+
 		tracker.keep(exposedService)
 	}
 
 	return tracker.cleanup(ctx)
 }
 
-func (ctrl *KubernetesStatusController) buildExposedServiceURL(alias string) (string, error) {
+// updateExposedServicesHealth updates the health of the exposed services of the cluster from the latest health checks.
+func (ctrl *KubernetesStatusController) updateExposedServicesHealth(ctx context.Context, r controller.Runtime, cluster string) error {
+	if _, running := ctrl.watchers[cluster]; !running {
+		return nil
+	}
+
+	services, err := safe.ReaderListAll[*omni.ExposedService](ctx, r, state.WithLabelQuery(resource.LabelEqual(omni.LabelCluster, cluster)))
+	if err != nil {
+		return err
+	}
+
+	for iter := services.Iterator(); iter.Next(); {
+		service := iter.Value()
+
+		if service.Metadata().Phase() == resource.PhaseTearingDown {
+			continue
+		}
+
+		alias, ok := service.Metadata().Labels().Get(omni.LabelExposedServiceAlias)
+		if !ok {
+			continue
+		}
+
+		if err = safe.WriterModify(ctx, r, service, func(res *omni.ExposedService) error {
+			ctrl.setExposedServiceHealth(res.TypedSpec().Value, cluster, alias)
+
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ctrl *KubernetesStatusController) setExposedServiceHealth(spec *specs.ExposedServiceSpec, cluster, alias string) {
+	spec.HealthStatus = specs.ExposedServiceSpec_UNKNOWN
+	spec.HealthError = ""
+
+	if spec.HealthCheckPath == "" || ctrl.healthProvider == nil {
+		return
+	}
+
+	health, ok := ctrl.healthProvider.ServiceHealth(cluster, alias)
+	if !ok {
+		return
+	}
+
+	if health.Healthy {
+		spec.HealthStatus = specs.ExposedServiceSpec_HEALTHY
+
+		return
+	}
+
+	spec.HealthStatus = specs.ExposedServiceSpec_UNHEALTHY
+	spec.HealthError = health.Error
+}
+
+// parseHealthCheck validates the health check of the exposed service.
+//
+// The health checks are disabled if the path is not set.
+func parseHealthCheck(path, interval string) (string, time.Duration, error) {
+	if path == "" {
+		return "", 0, nil
+	}
+
+	if !strings.HasPrefix(path, "/") {
+		return "", 0, fmt.Errorf("health check path %q is not absolute", path)
+	}
+
+	if interval == "" {
+		return path, defaultHealthCheckInterval, nil
+	}
+
+	parsedInterval, err := time.ParseDuration(interval)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid health check interval %q: %w", interval, err)
+	}
+
+	if parsedInterval < minHealthCheckInterval {
+		return "", 0, fmt.Errorf("health check interval %s is less than %s", parsedInterval, minHealthCheckInterval)
+	}
+
+	return path, parsedInterval, nil
+}
+
+func (ctrl *KubernetesStatusController) buildExposedServiceURL(alias, customDomain string) (string, error) {
 	apiURLParts := strings.SplitN(ctrl.advertisedAPIURL, "//", 2)
 	if len(apiURLParts) != 2 {
 		return "", fmt.Errorf("invalid advertised API URL protocol: %s", ctrl.advertisedAPIURL)
@@ -266,6 +448,11 @@ func (ctrl *KubernetesStatusController) buildExposedServiceURL(alias string) (st
 	protocol := apiURLParts[0]
 	rest := apiURLParts[1]
 
+	if customDomain != "" {
+		// example: https://grafana.example.org
+		return protocol + "//" + customDomain, nil
+	}
+
 	restParts := strings.SplitN(rest, ".", 2)
 	if len(restParts) != 2 {
 		return "", fmt.Errorf("invalid advertised API URL: %s", ctrl.advertisedAPIURL)
@@ -275,9 +462,42 @@ func (ctrl *KubernetesStatusController) buildExposedServiceURL(alias string) (st
 	rest = restParts[1]
 
 	// example: g3a4ana-demo.proxy-us.omni.siderolabs.io
-	url := protocol + "//" + alias + "-" + instanceName + "." + ctrl.workloadProxySubdomain + "." + rest
+	serviceURL := protocol + "//" + alias + "-" + instanceName + "." + ctrl.workloadProxySubdomain + "." + rest
+
+	return serviceURL, nil
+}
+
+// parseCustomDomain validates the custom domain of the exposed service.
+//
+// The domains of Omni itself can't be used, as the requests to them would be routed to the exposed service.
+func (ctrl *KubernetesStatusController) parseCustomDomain(domain string) (string, error) {
+	if domain == "" || !config.Config.WorkloadProxying.CustomDomains.Enabled {
+		return "", nil
+	}
+
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+
+	if errs := validation.IsDNS1123Subdomain(domain); len(errs) > 0 {
+		return "", fmt.Errorf("invalid domain %q: %s", domain, strings.Join(errs, ", "))
+	}
+
+	if !strings.Contains(domain, ".") {
+		return "", fmt.Errorf("domain %q is not fully qualified", domain)
+	}
+
+	apiURL, err := url.Parse(ctrl.advertisedAPIURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid advertised API URL: %w", err)
+	}
+
+	// example: omni.siderolabs.io for demo.omni.siderolabs.io
+	_, omniDomain, _ := strings.Cut(apiURL.Hostname(), ".")
+
+	if domain == apiURL.Hostname() || (omniDomain != "" && (domain == omniDomain || strings.HasSuffix(domain, "."+omniDomain))) {
+		return "", fmt.Errorf("domain %q belongs to Omni", domain)
+	}
 
-	return url, nil
+	return domain, nil
 }
 
 func (ctrl *KubernetesStatusController) parseIcon(iconBase64 string) (string, error) {
@@ -612,8 +832,11 @@ func (w *kubernetesWatcher) nodesSync(ctx context.Context, notifyCh chan<- kuber
 
 	for i, node := range nodes {
 		status.Nodes[i] = &specs.KubernetesStatusSpec_NodeStatus{
-			Nodename:       node.Name,
-			KubeletVersion: strings.TrimLeft(node.Status.NodeInfo.KubeletVersion, "v"),
+			Nodename:                node.Name,
+			KubeletVersion:          strings.TrimLeft(node.Status.NodeInfo.KubeletVersion, "v"),
+			ContainerRuntimeVersion: node.Status.NodeInfo.ContainerRuntimeVersion,
+			OsImage:                 node.Status.NodeInfo.OSImage,
+			KernelVersion:           node.Status.NodeInfo.KernelVersion,
 		}
 
 		for _, condition := range node.Status.Conditions {
@@ -780,7 +1003,15 @@ func IsExposedServiceEvent(k8sObject, oldK8sObject any, logger *zap.Logger) bool
 	oldAnnotations := oldK8sObject.(*corev1.Service).GetObjectMeta().GetAnnotations() //nolint:forcetypeassert
 	newAnnotations := k8sObject.(*corev1.Service).GetObjectMeta().GetAnnotations()    //nolint:forcetypeassert
 
-	for _, key := range []string{ServiceLabelAnnotationKey, ServicePortAnnotationKey, ServiceIconAnnotationKey} {
+	for _, key := range []string{
+		ServiceLabelAnnotationKey,
+		ServicePortAnnotationKey,
+		ServiceIconAnnotationKey,
+		ServiceCustomDomainAnnotationKey,
+		ServiceHealthCheckPathAnnotationKey,
+		ServiceHealthCheckIntervalAnnotationKey,
+		ServiceStickySessionsAnnotationKey,
+	} {
 		if oldAnnotations[key] != newAnnotations[key] {
 			return true
 		}
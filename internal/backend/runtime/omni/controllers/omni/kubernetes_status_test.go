@@ -7,8 +7,10 @@ package omni_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -111,3 +113,65 @@ func TestIsExposedServiceEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestParseHealthCheck(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name             string
+		path             string
+		interval         string
+		expectedPath     string
+		expectedInterval time.Duration
+		expectError      bool
+	}{
+		{
+			name: "disabled",
+		},
+		{
+			name:             "default interval",
+			path:             "/healthz",
+			expectedPath:     "/healthz",
+			expectedInterval: 30 * time.Second,
+		},
+		{
+			name:             "custom interval",
+			path:             "/healthz",
+			interval:         "1m",
+			expectedPath:     "/healthz",
+			expectedInterval: time.Minute,
+		},
+		{
+			name:        "relative path",
+			path:        "healthz",
+			expectError: true,
+		},
+		{
+			name:        "invalid interval",
+			path:        "/healthz",
+			interval:    "often",
+			expectError: true,
+		},
+		{
+			name:        "too short interval",
+			path:        "/healthz",
+			interval:    "100ms",
+			expectError: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path, interval, err := omni.ParseHealthCheck(tt.path, tt.interval)
+			if tt.expectError {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPath, path)
+			assert.Equal(t, tt.expectedInterval, interval)
+		})
+	}
+}
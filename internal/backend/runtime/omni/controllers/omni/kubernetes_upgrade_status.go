@@ -9,12 +9,16 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/controller/generic/qtransform"
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/maps"
 	"github.com/siderolabs/gen/xerrors"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
 	"go.uber.org/zap"
@@ -37,8 +41,10 @@ const KubernetesUpgradeStatusControllerName = "KubernetesUpgradeStatusController
 
 // NewKubernetesUpgradeStatusController initializes KubernetesUpgradeStatusController.
 //
+// The upgradeQueue limits the number of the clusters upgraded at the same time, the upgrades are not limited if it's nil.
+//
 //nolint:gocognit,cyclop,gocyclo,maintidx
-func NewKubernetesUpgradeStatusController() *KubernetesUpgradeStatusController {
+func NewKubernetesUpgradeStatusController(upgradeQueue *UpgradeQueue) *KubernetesUpgradeStatusController {
 	return qtransform.NewQController(
 		qtransform.Settings[*omni.Cluster, *omni.KubernetesUpgradeStatus]{
 			Name: KubernetesUpgradeStatusControllerName,
@@ -58,6 +64,10 @@ func NewKubernetesUpgradeStatusController() *KubernetesUpgradeStatusController {
 					return err
 				}
 
+				// the upgrade which was started before keeps its slot in the upgrade queue
+				upgradeRunning := upgradeStatus.TypedSpec().Value.Phase == specs.KubernetesUpgradeStatusSpec_Upgrading && upgradeStatus.TypedSpec().Value.QueuePosition == 0 &&
+					upgradeStatus.TypedSpec().Value.NextMaintenanceWindow == nil
+
 				// if not reverting to the previous successful version, perform pre-checks on each step
 				versionMismatch := cluster.TypedSpec().Value.KubernetesVersion != upgradeStatus.TypedSpec().Value.LastUpgradeVersion
 
@@ -69,6 +79,23 @@ func NewKubernetesUpgradeStatusController() *KubernetesUpgradeStatusController {
 					upgradeStatus.TypedSpec().Value.CurrentUpgradeVersion = ""
 				}
 
+				if versionMismatch {
+					var rejected bool
+
+					rejected, err = checkVersionSkew(ctx, r, cluster, kubernetesStatus, upgradeStatus)
+					if err != nil {
+						return err
+					}
+
+					if rejected {
+						upgradeQueue.release(cluster.Metadata().ID(), KubernetesUpgradeStatusControllerName)
+
+						upgradeStatus.Metadata().Labels().Set(omni.LabelCluster, cluster.Metadata().ID())
+
+						return nil
+					}
+				}
+
 				clusterStatus, err := safe.ReaderGet[*omni.ClusterStatus](ctx, r, omni.NewClusterStatus(resources.DefaultNamespace, cluster.Metadata().ID()).Metadata())
 				if err != nil {
 					if state.IsNotFoundError(err) {
@@ -109,16 +136,29 @@ func NewKubernetesUpgradeStatusController() *KubernetesUpgradeStatusController {
 				// check if all Kubernetes components are ready
 				upgradePath := kubernetes.CalculateUpgradePath(nodenameToMachineMap, kubernetesStatus, cluster.TypedSpec().Value.KubernetesVersion)
 
+				upgradeStatus.TypedSpec().Value.HeldMachines, err = skipHeld(ctx, r, cluster, upgradePath)
+				if err != nil {
+					return err
+				}
+
 				upgradeStatus.Metadata().Labels().Set(omni.LabelCluster, cluster.Metadata().ID())
 
 				switch {
 				case len(upgradePath.Steps) == 0 && upgradePath.AllComponentsReady:
 					// upgrade fully completed
+					upgradeQueue.release(cluster.Metadata().ID(), KubernetesUpgradeStatusControllerName)
+
+					upgradeStatus.TypedSpec().Value.QueuePosition = 0
+					upgradeStatus.TypedSpec().Value.NextMaintenanceWindow = nil
 					upgradeStatus.TypedSpec().Value.Phase = specs.KubernetesUpgradeStatusSpec_Done
 					upgradeStatus.TypedSpec().Value.Step = ""
 					upgradeStatus.TypedSpec().Value.Status = ""
 					upgradeStatus.TypedSpec().Value.Error = ""
 					upgradeStatus.TypedSpec().Value.LastUpgradeVersion = cluster.TypedSpec().Value.KubernetesVersion
+
+					if len(upgradeStatus.TypedSpec().Value.HeldMachines) > 0 {
+						upgradeStatus.TypedSpec().Value.Status = fmt.Sprintf("%d machine(s) held on the previous version", len(upgradeStatus.TypedSpec().Value.HeldMachines))
+					}
 					upgradeStatus.TypedSpec().Value.CurrentUpgradeVersion = ""
 				case len(upgradePath.Steps) > 0 && !versionMismatch && upgradeStatus.TypedSpec().Value.Phase != specs.KubernetesUpgradeStatusSpec_Done:
 					// reverting an upgrade (?)
@@ -136,6 +176,42 @@ func NewKubernetesUpgradeStatusController() *KubernetesUpgradeStatusController {
 						upgradeStatus.TypedSpec().Value.Error = ""
 					}
 				case len(upgradePath.Steps) > 0 && upgradePath.AllComponentsReady:
+					// the initial version rollout of the new clusters is not an upgrade, so it's not queued
+					if versionMismatch && upgradeStatus.TypedSpec().Value.LastUpgradeVersion != "" {
+						// the control plane components and the kubelets are restarted by the upgrade, so it waits for the maintenance window
+						wait, next, windowErr := maintenanceWindowWait(ctx, r, cluster.Metadata().ID(), time.Now())
+						if windowErr != nil {
+							return windowErr
+						}
+
+						if wait {
+							upgradeQueue.release(cluster.Metadata().ID(), KubernetesUpgradeStatusControllerName)
+
+							upgradeStatus.TypedSpec().Value.QueuePosition = 0
+							upgradeStatus.TypedSpec().Value.NextMaintenanceWindow = maintenanceWindowTimestamp(next)
+							upgradeStatus.TypedSpec().Value.Phase = specs.KubernetesUpgradeStatusSpec_Upgrading
+							upgradeStatus.TypedSpec().Value.Step = "upgrade queued"
+							upgradeStatus.TypedSpec().Value.Status = maintenanceWindowStatus(next)
+							upgradeStatus.TypedSpec().Value.Error = ""
+
+							return maintenanceWindowRequeue(next, upgradeQueue.pollInterval())
+						}
+
+						upgradeStatus.TypedSpec().Value.NextMaintenanceWindow = nil
+
+						if position := upgradeQueue.acquire(cluster, KubernetesUpgradeStatusControllerName, upgradeRunning); position > 0 {
+							upgradeStatus.TypedSpec().Value.QueuePosition = uint32(position)
+							upgradeStatus.TypedSpec().Value.Phase = specs.KubernetesUpgradeStatusSpec_Upgrading
+							upgradeStatus.TypedSpec().Value.Step = "upgrade queued"
+							upgradeStatus.TypedSpec().Value.Status = fmt.Sprintf("waiting for other clusters to finish upgrading, position in the queue: %d", position)
+							upgradeStatus.TypedSpec().Value.Error = ""
+
+							return controller.NewRequeueInterval(upgradeQueue.pollInterval())
+						}
+
+						upgradeStatus.TypedSpec().Value.QueuePosition = 0
+					}
+
 					prePullStatus, prePullDone, prePullErr := updateImagePullRequest(ctx, r, upgradePath)
 					if prePullErr != nil {
 						return prePullErr
@@ -167,7 +243,7 @@ func NewKubernetesUpgradeStatusController() *KubernetesUpgradeStatusController {
 
 					var skip bool
 
-					skip, err = skipLocked(ctx, r, patch, upgradeStatus)
+					skip, err = skipLocked(ctx, r, cluster, patch, upgradeStatus)
 					if err != nil {
 						return err
 					}
@@ -206,6 +282,8 @@ func NewKubernetesUpgradeStatusController() *KubernetesUpgradeStatusController {
 				return nil
 			},
 			FinalizerRemovalExtraOutputFunc: func(ctx context.Context, r controller.ReaderWriter, _ *zap.Logger, cluster *omni.Cluster) error {
+				upgradeQueue.release(cluster.Metadata().ID(), KubernetesUpgradeStatusControllerName)
+
 				// drop any config patches created by this controller
 				configPatches, err := safe.ReaderListAll[*omni.ConfigPatch](ctx, r, state.WithLabelQuery(
 					resource.LabelEqual(omni.LabelCluster, cluster.Metadata().ID()),
@@ -244,6 +322,9 @@ func NewKubernetesUpgradeStatusController() *KubernetesUpgradeStatusController {
 		qtransform.WithExtraMappedInput(
 			qtransform.MapperSameID[*omni.ClusterStatus, *omni.Cluster](),
 		),
+		qtransform.WithExtraMappedInput(
+			qtransform.MapperSameID[*omni.MaintenanceWindow, *omni.Cluster](),
+		),
 		qtransform.WithExtraMappedInput(
 			qtransform.MapperSameID[*omni.KubernetesStatus, *omni.Cluster](),
 		),
@@ -269,6 +350,9 @@ func NewKubernetesUpgradeStatusController() *KubernetesUpgradeStatusController {
 		qtransform.WithExtraMappedInput(
 			mappers.MapByClusterLabel[*omni.MachineSetNode, *omni.Cluster](),
 		),
+		qtransform.WithExtraMappedInput(
+			mappers.MapByClusterLabel[*omni.ClusterMachineTalosVersion, *omni.Cluster](),
+		),
 		qtransform.WithExtraOutputs(
 			controller.Output{
 				Type: omni.ConfigPatchType,
@@ -283,6 +367,43 @@ func NewKubernetesUpgradeStatusController() *KubernetesUpgradeStatusController {
 	)
 }
 
+// checkVersionSkew rejects the upgrade which violates the version skew policy before any of the components is upgraded.
+//
+// The rejected upgrade is marked as failed with the violations as the error, it is checked again when the cluster or its machines change.
+func checkVersionSkew(ctx context.Context, r controller.Reader, cluster *omni.Cluster, kubernetesStatus *omni.KubernetesStatus, upgradeStatus *omni.KubernetesUpgradeStatus) (bool, error) {
+	talosVersions := []string{cluster.TypedSpec().Value.TalosVersion}
+
+	clusterMachineTalosVersions, err := safe.ReaderListAll[*omni.ClusterMachineTalosVersion](ctx, r, state.WithLabelQuery(resource.LabelEqual(omni.LabelCluster, cluster.Metadata().ID())))
+	if err != nil {
+		return false, err
+	}
+
+	clusterMachineTalosVersions.ForEach(func(res *omni.ClusterMachineTalosVersion) {
+		if res.TypedSpec().Value.TalosVersion != "" {
+			talosVersions = append(talosVersions, res.TypedSpec().Value.TalosVersion)
+		}
+	})
+
+	violations, err := kubernetes.CheckVersionSkew(kubernetesStatus, talosVersions, cluster.TypedSpec().Value.KubernetesVersion)
+	if err != nil {
+		return false, err
+	}
+
+	if len(violations) == 0 {
+		return false, nil
+	}
+
+	upgradeStatus.TypedSpec().Value.Phase = specs.KubernetesUpgradeStatusSpec_Failed
+	upgradeStatus.TypedSpec().Value.Step = "version skew policy check"
+	upgradeStatus.TypedSpec().Value.Status = fmt.Sprintf("the upgrade to %s violates the version skew policy", cluster.TypedSpec().Value.KubernetesVersion)
+	upgradeStatus.TypedSpec().Value.Error = strings.Join(violations, "; ")
+	upgradeStatus.TypedSpec().Value.QueuePosition = 0
+	upgradeStatus.TypedSpec().Value.NextMaintenanceWindow = nil
+	upgradeStatus.TypedSpec().Value.UpgradeVersions = nil
+
+	return true, nil
+}
+
 func updateImagePullRequest(ctx context.Context, r controller.ReaderWriter, upgradePath *kubernetes.UpgradePath) (sts *omni.ImagePullStatus, done bool, err error) {
 	request, err := safe.WriterModifyWithResult[*omni.ImagePullRequest](ctx, r, omni.NewImagePullRequest(resources.DefaultNamespace, upgradePath.ClusterID), func(r *omni.ImagePullRequest) error {
 		var nodeImageList []*specs.ImagePullRequestSpec_NodeImageList
@@ -371,7 +492,52 @@ func applyUpgradePatches(ctx context.Context, r controller.Writer, cluster *omni
 	return anyPatchApplied, nil
 }
 
-func skipLocked(ctx context.Context, r controller.Reader, patch kubernetes.UpgradeStep, upgradeStatus *omni.KubernetesUpgradeStatus) (bool, error) {
+// skipHeld drops the upgrade steps of the machines which have the upgrade hold annotation, and returns the IDs of these machines.
+func skipHeld(ctx context.Context, r controller.Reader, cluster *omni.Cluster, upgradePath *kubernetes.UpgradePath) ([]string, error) {
+	machineSetNodes, err := safe.ReaderListAll[*omni.MachineSetNode](ctx, r, state.WithLabelQuery(resource.LabelEqual(omni.LabelCluster, cluster.Metadata().ID())))
+	if err != nil {
+		return nil, err
+	}
+
+	heldMachines := map[string]struct{}{}
+
+	machineSetNodes.ForEach(func(machineSetNode *omni.MachineSetNode) {
+		if _, held := machineSetNode.Metadata().Annotations().Get(omni.MachineUpgradeHold); held {
+			heldMachines[machineSetNode.Metadata().ID()] = struct{}{}
+		}
+	})
+
+	if len(heldMachines) == 0 {
+		return nil, nil
+	}
+
+	skipped := map[string]struct{}{}
+
+	upgradePath.Steps = slices.DeleteFunc(upgradePath.Steps, func(step kubernetes.UpgradeStep) bool {
+		_, held := heldMachines[step.MachineID]
+		if held {
+			skipped[step.MachineID] = struct{}{}
+		}
+
+		return held
+	})
+
+	result := maps.Keys(skipped)
+	slices.Sort(result)
+
+	return result, nil
+}
+
+func skipLocked(ctx context.Context, r controller.Reader, cluster *omni.Cluster, patch kubernetes.UpgradeStep, upgradeStatus *omni.KubernetesUpgradeStatus) (bool, error) {
+	if _, paused := cluster.Metadata().Annotations().Get(omni.ClusterPaused); paused {
+		upgradeStatus.TypedSpec().Value.Phase = specs.KubernetesUpgradeStatusSpec_Upgrading
+		upgradeStatus.TypedSpec().Value.Step = patch.Description
+		upgradeStatus.TypedSpec().Value.Status = "waiting for the cluster to be resumed"
+		upgradeStatus.TypedSpec().Value.Error = ""
+
+		return true, nil
+	}
+
 	machineSetNode, err := r.Get(ctx, resource.NewMetadata(resources.DefaultNamespace, omni.MachineSetNodeType, patch.MachineID, resource.VersionUndefined))
 	if err != nil && !state.IsNotFoundError(err) {
 		return false, err
@@ -0,0 +1,79 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/logging"
+)
+
+// LogLevelController applies the log levels of the subsystems from the [omni.LogLevelConfig] to the backend logger.
+type LogLevelController struct {
+	levels *logging.Levels
+}
+
+// NewLogLevelController creates new LogLevelController.
+func NewLogLevelController(levels *logging.Levels) *LogLevelController {
+	return &LogLevelController{
+		levels: levels,
+	}
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *LogLevelController) Name() string {
+	return "LogLevelController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *LogLevelController) Inputs() []controller.Input {
+	return []controller.Input{
+		safe.Input[*omni.LogLevelConfig](controller.InputWeak),
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *LogLevelController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *LogLevelController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		var levels map[string]string
+
+		logLevelConfig, err := safe.ReaderGetByID[*omni.LogLevelConfig](ctx, r, omni.LogLevelConfigID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting log level config: %w", err)
+		}
+
+		if logLevelConfig != nil {
+			levels = logLevelConfig.TypedSpec().Value.GetLevels()
+		}
+
+		if err = ctrl.levels.Set(levels); err != nil {
+			// the config is validated on write, so it can be invalid only if the supported subsystems have changed
+			logger.Warn("failed to apply the log levels", zap.Error(err))
+
+			continue
+		}
+
+		logger.Info("applied the log levels", zap.Any("levels", ctrl.levels.Get()))
+	}
+}
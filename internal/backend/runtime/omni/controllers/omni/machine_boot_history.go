@@ -0,0 +1,95 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/controller/generic/qtransform"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+// machineBootHistoryMaxBoots is the number of the latest boots kept in the MachineBootHistory.
+const machineBootHistoryMaxBoots = 20
+
+// MachineBootHistoryController manages MachineBootHistory resource lifecycle.
+//
+// MachineBootHistoryController records the boot IDs reported in the MachineStatus,
+// and marks the machine as crash looping if it has booted crashLoopBoots times within the crashLoopWindow.
+type MachineBootHistoryController = qtransform.QController[*omni.MachineStatus, *omni.MachineBootHistory]
+
+// NewMachineBootHistoryController initializes MachineBootHistoryController.
+//
+// The crash loop detection is disabled if crashLoopBoots is zero.
+func NewMachineBootHistoryController(crashLoopBoots int, crashLoopWindow time.Duration) *MachineBootHistoryController {
+	return qtransform.NewQController(
+		qtransform.Settings[*omni.MachineStatus, *omni.MachineBootHistory]{
+			Name: "MachineBootHistoryController",
+			MapMetadataFunc: func(machineStatus *omni.MachineStatus) *omni.MachineBootHistory {
+				return omni.NewMachineBootHistory(resources.DefaultNamespace, machineStatus.Metadata().ID())
+			},
+			UnmapMetadataFunc: func(bootHistory *omni.MachineBootHistory) *omni.MachineStatus {
+				return omni.NewMachineStatus(resources.DefaultNamespace, bootHistory.Metadata().ID())
+			},
+			TransformFunc: func(_ context.Context, _ controller.Reader, logger *zap.Logger, machineStatus *omni.MachineStatus, bootHistory *omni.MachineBootHistory) error {
+				spec := bootHistory.TypedSpec().Value
+				bootID := machineStatus.TypedSpec().Value.BootId
+
+				now := time.Now()
+
+				if bootID != "" && (len(spec.Boots) == 0 || spec.Boots[0].BootId != bootID) {
+					spec.Boots = append([]*specs.MachineBootHistorySpec_Boot{
+						{
+							BootId:     bootID,
+							DetectedAt: timestamppb.New(now),
+						},
+					}, spec.Boots...)
+
+					if len(spec.Boots) > machineBootHistoryMaxBoots {
+						spec.Boots = spec.Boots[:machineBootHistoryMaxBoots]
+					}
+				}
+
+				crashLoop := crashLoopBoots > 0 && len(spec.Boots) >= crashLoopBoots &&
+					now.Sub(spec.Boots[crashLoopBoots-1].DetectedAt.AsTime()) < crashLoopWindow
+
+				switch {
+				case !crashLoop:
+					if spec.CrashLoop {
+						logger.Info("machine is not crash looping anymore")
+					}
+
+					spec.CrashLoop = false
+					spec.CrashLoopSince = nil
+
+					bootHistory.Metadata().Labels().Delete(omni.MachineBootHistoryLabelCrashLoop)
+
+					return nil
+				case !spec.CrashLoop:
+					spec.CrashLoop = true
+					spec.CrashLoopSince = timestamppb.New(now)
+
+					logger.Warn("machine is crash looping",
+						zap.Int("boots", crashLoopBoots),
+						zap.Duration("window", crashLoopWindow),
+					)
+				}
+
+				bootHistory.Metadata().Labels().Set(omni.MachineBootHistoryLabelCrashLoop, "")
+
+				// re-check once the oldest boot of the crash loop leaves the window
+				return controller.NewRequeueInterval(crashLoopWindow - now.Sub(spec.Boots[crashLoopBoots-1].DetectedAt.AsTime()))
+			},
+		},
+	)
+}
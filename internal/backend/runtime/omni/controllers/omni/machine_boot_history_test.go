@@ -0,0 +1,103 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+)
+
+type MachineBootHistorySuite struct {
+	OmniSuite
+}
+
+func (suite *MachineBootHistorySuite) TestReconcile() {
+	ctx, cancel := context.WithTimeout(suite.ctx, time.Second*20)
+	defer cancel()
+
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewMachineBootHistoryController(3, 3*time.Second)))
+
+	machineName := "machine1"
+
+	machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, machineName)
+	machineStatus.TypedSpec().Value.BootId = "boot-0"
+
+	suite.Require().NoError(suite.state.Create(ctx, machineStatus))
+
+	rtestutils.AssertResources(ctx, suite.T(), suite.state, []string{machineName},
+		func(res *omni.MachineBootHistory, assertion *assert.Assertions) {
+			assertion.Len(res.TypedSpec().Value.Boots, 1)
+			assertion.False(res.TypedSpec().Value.CrashLoop)
+		},
+	)
+
+	reboot := func(bootID string) {
+		_, err := safe.StateUpdateWithConflicts(ctx, suite.state, machineStatus.Metadata(), func(res *omni.MachineStatus) error {
+			res.TypedSpec().Value.BootId = bootID
+
+			return nil
+		})
+		suite.Require().NoError(err)
+
+		rtestutils.AssertResources(ctx, suite.T(), suite.state, []string{machineName},
+			func(res *omni.MachineBootHistory, assertion *assert.Assertions) {
+				if assertion.NotEmpty(res.TypedSpec().Value.Boots) {
+					assertion.Equal(bootID, res.TypedSpec().Value.Boots[0].BootId)
+				}
+			},
+		)
+	}
+
+	for i := 1; i < 3; i++ {
+		reboot(fmt.Sprintf("boot-%d", i))
+	}
+
+	rtestutils.AssertResources(ctx, suite.T(), suite.state, []string{machineName},
+		func(res *omni.MachineBootHistory, assertion *assert.Assertions) {
+			assertion.Len(res.TypedSpec().Value.Boots, 3)
+			assertion.True(res.TypedSpec().Value.CrashLoop)
+			assertion.NotNil(res.TypedSpec().Value.CrashLoopSince)
+
+			_, crashLoop := res.Metadata().Labels().Get(omni.MachineBootHistoryLabelCrashLoop)
+			assertion.True(crashLoop)
+		},
+	)
+
+	// the crash loop is cleared once the boots leave the window
+	rtestutils.AssertResources(ctx, suite.T(), suite.state, []string{machineName},
+		func(res *omni.MachineBootHistory, assertion *assert.Assertions) {
+			assertion.Len(res.TypedSpec().Value.Boots, 3)
+			assertion.False(res.TypedSpec().Value.CrashLoop)
+			assertion.Nil(res.TypedSpec().Value.CrashLoopSince)
+
+			_, crashLoop := res.Metadata().Labels().Get(omni.MachineBootHistoryLabelCrashLoop)
+			assertion.False(crashLoop)
+		},
+	)
+
+	rtestutils.DestroyAll[*omni.MachineStatus](ctx, suite.T(), suite.state)
+
+	rtestutils.AssertNoResource[*omni.MachineBootHistory](ctx, suite.T(), suite.state, machineName)
+}
+
+func TestMachineBootHistorySuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(MachineBootHistorySuite))
+}
@@ -7,9 +7,11 @@ package omni
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/controller/generic/qtransform"
+	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
 	"go.uber.org/zap"
@@ -17,6 +19,7 @@ import (
 	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/mappers"
 )
 
 // MachineConfigGenOptionsControllerName is the name of the MachineConfigGenOptionsController.
@@ -45,20 +48,61 @@ func NewMachineConfigGenOptionsController() *MachineConfigGenOptionsController {
 					return err
 				}
 
-				GenInstallConfig(machineStatus, clusterMachineTalosVersion, options)
+				installDiskPolicy, err := getInstallDiskPolicy(ctx, r, machineStatus.Metadata().ID())
+				if err != nil {
+					return err
+				}
 
-				return nil
+				return GenInstallConfig(machineStatus, clusterMachineTalosVersion, installDiskPolicy, options)
 			},
 		},
 		qtransform.WithExtraMappedInput(
 			qtransform.MapperSameID[*omni.ClusterMachineTalosVersion, *omni.MachineStatus](),
 		),
+		qtransform.WithExtraMappedInput(
+			qtransform.MapperSameID[*omni.ClusterMachine, *omni.MachineStatus](),
+		),
+		qtransform.WithExtraMappedInput(
+			mappers.MapMachineSetToClusterMachineIDs[*omni.MachineSet, *omni.MachineStatus](),
+		),
 		qtransform.WithIgnoreTeardownUntil(), // keep the resource until everyone else is done with Machine
 	)
 }
 
+// getInstallDiskPolicy returns the install disk policy of the machine set the machine is allocated to.
+func getInstallDiskPolicy(ctx context.Context, r controller.Reader, machineID resource.ID) (*specs.MachineSetSpec_InstallDiskPolicy, error) {
+	clusterMachine, err := safe.ReaderGetByID[*omni.ClusterMachine](ctx, r, machineID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	machineSetID, ok := clusterMachine.Metadata().Labels().Get(omni.LabelMachineSet)
+	if !ok {
+		return nil, nil
+	}
+
+	machineSet, err := safe.ReaderGetByID[*omni.MachineSet](ctx, r, machineSetID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return machineSet.TypedSpec().Value.InstallDiskPolicy, nil
+}
+
 // GenInstallConfig creates a config patch with an automatically picked install disk.
-func GenInstallConfig(machineStatus *omni.MachineStatus, clusterMachineTalosVersion *omni.ClusterMachineTalosVersion, genOptions *omni.MachineConfigGenOptions) {
+//
+// If the machine is not installed yet, the install disk is picked using the install disk policy, if it is set.
+func GenInstallConfig(machineStatus *omni.MachineStatus, clusterMachineTalosVersion *omni.ClusterMachineTalosVersion,
+	installDiskPolicy *specs.MachineSetSpec_InstallDiskPolicy, genOptions *omni.MachineConfigGenOptions,
+) error {
 	if clusterMachineTalosVersion != nil {
 		if genOptions.TypedSpec().Value.InstallImage == nil {
 			genOptions.TypedSpec().Value.InstallImage = &specs.MachineConfigGenOptionsSpec_InstallImage{}
@@ -73,13 +117,22 @@ func GenInstallConfig(machineStatus *omni.MachineStatus, clusterMachineTalosVers
 	}
 
 	if machineStatus.TypedSpec().Value.Hardware == nil {
-		return
+		return nil
 	}
 
 	installDisk := omni.GetMachineStatusSystemDisk(machineStatus)
 
 	diskSize := ^uint64(0)
 
+	if installDisk == "" && installDiskPolicy != nil {
+		var err error
+
+		installDisk, err = omni.SelectInstallDisk(installDiskPolicy, machineStatus.TypedSpec().Value.Hardware.Blockdevices, installDiskMinSize)
+		if err != nil {
+			return fmt.Errorf("failed to pick the install disk for the machine %q: %w", machineStatus.Metadata().ID(), err)
+		}
+	}
+
 	if installDisk == "" {
 		for _, disk := range machineStatus.TypedSpec().Value.Hardware.Blockdevices {
 			if disk.Size >= installDiskMinSize && disk.Size < diskSize {
@@ -91,4 +144,6 @@ func GenInstallConfig(machineStatus *omni.MachineStatus, clusterMachineTalosVers
 	}
 
 	genOptions.TypedSpec().Value.InstallDisk = installDisk
+
+	return nil
 }
@@ -0,0 +1,87 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+)
+
+type MachineConfigGenOptionsSuite struct {
+	OmniSuite
+}
+
+func (suite *MachineConfigGenOptionsSuite) TestInstallDiskPolicy() {
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewMachineConfigGenOptionsController()))
+
+	machineSet := omni.NewMachineSet(resources.DefaultNamespace, "cluster-workers")
+	machineSet.TypedSpec().Value.InstallDiskPolicy = &specs.MachineSetSpec_InstallDiskPolicy{
+		Prefer: specs.MachineSetSpec_InstallDiskPolicy_Smallest,
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineSet))
+
+	machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, "machine")
+	machineStatus.TypedSpec().Value.Hardware = &specs.MachineStatusSpec_HardwareStatus{
+		Blockdevices: []*specs.MachineStatusSpec_HardwareStatus_BlockDevice{
+			{LinuxName: "/dev/sda", Size: 100e9, Type: "HDD"},
+			{LinuxName: "/dev/nvme0n1", Size: 50e9, Type: "NVME"},
+			{LinuxName: "/dev/sdb", Size: 1e9, Type: "HDD"},
+		},
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineStatus))
+
+	clusterMachine := omni.NewClusterMachine(resources.DefaultNamespace, "machine")
+	clusterMachine.Metadata().Labels().Set(omni.LabelCluster, "cluster")
+	clusterMachine.Metadata().Labels().Set(omni.LabelMachineSet, machineSet.Metadata().ID())
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, clusterMachine))
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []string{"machine"}, func(options *omni.MachineConfigGenOptions, assertion *assert.Assertions) {
+		assertion.Equal("/dev/nvme0n1", options.TypedSpec().Value.InstallDisk)
+	})
+
+	// the machine set update is picked up by the machines of the machine set
+	_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, machineSet.Metadata(), func(res *omni.MachineSet) error {
+		res.TypedSpec().Value.InstallDiskPolicy.Prefer = specs.MachineSetSpec_InstallDiskPolicy_Largest
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []string{"machine"}, func(options *omni.MachineConfigGenOptions, assertion *assert.Assertions) {
+		assertion.Equal("/dev/sda", options.TypedSpec().Value.InstallDisk)
+	})
+
+	_, err = safe.StateUpdateWithConflicts(suite.ctx, suite.state, machineSet.Metadata(), func(res *omni.MachineSet) error {
+		res.TypedSpec().Value.InstallDiskPolicy.Types = []string{"nvme"}
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	rtestutils.AssertResources(suite.ctx, suite.T(), suite.state, []string{"machine"}, func(options *omni.MachineConfigGenOptions, assertion *assert.Assertions) {
+		assertion.Equal("/dev/nvme0n1", options.TypedSpec().Value.InstallDisk)
+	})
+}
+
+func TestMachineConfigGenOptionsSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(MachineConfigGenOptionsSuite))
+}
@@ -0,0 +1,294 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/siderolabs/siderolink/pkg/wireguard"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/siderolink"
+	siderolinkmanager "github.com/siderolabs/omni/internal/pkg/siderolink"
+)
+
+// NewMachineLinkStatusController creates new MachineLinkStatusController.
+func NewMachineLinkStatusController(linkSampleCh <-chan siderolinkmanager.LinkSamples) *MachineLinkStatusController {
+	return &MachineLinkStatusController{
+		sampleCh: linkSampleCh,
+		links:    map[resource.ID]*linkQuality{},
+	}
+}
+
+// MachineLinkStatusController turns the WireGuard peer statistics sampled by the SideroLink manager into [omni.MachineLinkStatus] resources
+// and the link quality metrics.
+//
+//nolint:govet
+type MachineLinkStatusController struct {
+	sampleCh <-chan siderolinkmanager.LinkSamples
+
+	// links is accessed only in Run
+	links map[resource.ID]*linkQuality
+
+	metricsOnce            sync.Once
+	metricFlaps            prometheus.Counter
+	metricKeepaliveLatency prometheus.Histogram
+	metricDegradedLinks    prometheus.Gauge
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *MachineLinkStatusController) Name() string {
+	return "MachineLinkStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *MachineLinkStatusController) Inputs() []controller.Input {
+	return []controller.Input{
+		safe.Input[*siderolink.Link](controller.InputWeak),
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *MachineLinkStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: omni.MachineLinkStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+func (ctrl *MachineLinkStatusController) initMetrics() {
+	ctrl.metricsOnce.Do(func() {
+		ctrl.metricFlaps = prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "omni_siderolink_link_flaps_total",
+			Help: "Number of times the SideroLink connections of the machines went down.",
+		})
+
+		ctrl.metricKeepaliveLatency = prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name: "omni_siderolink_keepalive_latency_seconds",
+			Help: "Estimated delay of the keepalives of the SideroLink connections of the machines.",
+			Buckets: []float64{
+				0,       // keepalives arrive in time
+				30,      // a single keepalive is missed
+				60,      // a few keepalives are missed
+				2 * 60,  // the handshake is late
+				4 * 60,  // the link is about to be considered down
+				16 * 60, // the link is down
+			},
+		})
+
+		ctrl.metricDegradedLinks = prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "omni_siderolink_degraded_links",
+			Help: "Number of SideroLink connections of the machines which are down or miss the keepalives.",
+		})
+	})
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *MachineLinkStatusController) Run(ctx context.Context, r controller.Runtime, _ *zap.Logger) error {
+	ctrl.initMetrics()
+
+	for {
+		var samples siderolinkmanager.LinkSamples
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case samples = <-ctrl.sampleCh:
+		}
+
+		links, err := safe.ReaderListAll[*siderolink.Link](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing links: %w", err)
+		}
+
+		linkIDs := make(map[resource.ID]struct{}, links.Len())
+
+		for iter := links.Iterator(); iter.Next(); {
+			if iter.Value().Metadata().Phase() == resource.PhaseTearingDown {
+				continue
+			}
+
+			linkIDs[iter.Value().Metadata().ID()] = struct{}{}
+		}
+
+		for id, sample := range samples {
+			if _, ok := linkIDs[id]; !ok {
+				continue
+			}
+
+			if err = ctrl.updateLinkStatus(ctx, r, id, sample); err != nil {
+				return err
+			}
+		}
+
+		for id := range ctrl.links {
+			if _, ok := linkIDs[id]; !ok {
+				delete(ctrl.links, id)
+			}
+		}
+
+		if samples != nil {
+			ctrl.updateDegradedLinks()
+		}
+
+		if err = cleanupOutputs(ctx, r, func(res *omni.MachineLinkStatus) bool {
+			_, ok := linkIDs[res.Metadata().ID()]
+
+			return ok
+		}); err != nil {
+			return fmt.Errorf("error cleaning up MachineLinkStatus resources: %w", err)
+		}
+	}
+}
+
+func (ctrl *MachineLinkStatusController) updateLinkStatus(ctx context.Context, r controller.Runtime, id resource.ID, sample siderolinkmanager.LinkSample) error {
+	quality, ok := ctrl.links[id]
+	if !ok {
+		quality = &linkQuality{}
+
+		ctrl.links[id] = quality
+	}
+
+	flaps := quality.flaps
+
+	quality.update(sample)
+
+	ctrl.metricFlaps.Add(float64(quality.flaps - flaps))
+
+	ctrl.metricKeepaliveLatency.Observe(quality.keepaliveLatency.Seconds())
+
+	if err := safe.WriterModify(ctx, r, omni.NewMachineLinkStatus(resources.EphemeralNamespace, id), func(res *omni.MachineLinkStatus) error {
+		quality.fill(res.TypedSpec().Value)
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("error updating MachineLinkStatus (id: %s) resource: %w", id, err)
+	}
+
+	return nil
+}
+
+func (ctrl *MachineLinkStatusController) updateDegradedLinks() {
+	degraded := 0
+
+	for _, quality := range ctrl.links {
+		if !quality.connected || quality.keepaliveLatency > 0 {
+			degraded++
+		}
+	}
+
+	ctrl.metricDegradedLinks.Set(float64(degraded))
+}
+
+// Describe implements prom.Collector interface.
+func (ctrl *MachineLinkStatusController) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(ctrl, ch)
+}
+
+// Collect implements prom.Collector interface.
+func (ctrl *MachineLinkStatusController) Collect(ch chan<- prometheus.Metric) {
+	ctrl.initMetrics()
+
+	ctrl.metricFlaps.Collect(ch)
+	ctrl.metricKeepaliveLatency.Collect(ch)
+	ctrl.metricDegradedLinks.Collect(ch)
+}
+
+var _ prometheus.Collector = &MachineLinkStatusController{}
+
+// linkQuality accumulates the samples of a single link.
+type linkQuality struct {
+	previous         siderolinkmanager.LinkSample
+	lastReceive      time.Time
+	lastFlap         time.Time
+	receiveRate      float64
+	sendRate         float64
+	keepaliveLatency time.Duration
+	flaps            uint32
+	connected        bool
+}
+
+func (q *linkQuality) update(sample siderolinkmanager.LinkSample) {
+	initialized := !q.previous.SampledAt.IsZero()
+
+	connected := !sample.LastHandshake.IsZero() && sample.SampledAt.Sub(sample.LastHandshake) < wireguard.PeerDownInterval
+
+	q.receiveRate, q.sendRate = 0, 0
+
+	switch {
+	case !initialized:
+		// nothing is known about the traffic before the first sample, so the handshake is the latest activity
+		q.lastReceive = sample.LastHandshake
+	case sample.BytesReceived < q.previous.BytesReceived || sample.BytesSent < q.previous.BytesSent:
+		// the counters are reset when the peer is re-added to the WireGuard device
+		q.lastReceive = sample.LastHandshake
+	default:
+		if sample.BytesReceived > q.previous.BytesReceived {
+			q.lastReceive = sample.SampledAt
+		}
+
+		if interval := sample.SampledAt.Sub(q.previous.SampledAt).Seconds(); interval > 0 {
+			q.receiveRate = float64(sample.BytesReceived-q.previous.BytesReceived) / interval
+			q.sendRate = float64(sample.BytesSent-q.previous.BytesSent) / interval
+		}
+	}
+
+	if sample.LastHandshake.After(q.lastReceive) {
+		q.lastReceive = sample.LastHandshake
+	}
+
+	q.keepaliveLatency = 0
+
+	if sample.KeepaliveInterval > 0 && !q.lastReceive.IsZero() {
+		q.keepaliveLatency = max(sample.SampledAt.Sub(q.lastReceive)-sample.KeepaliveInterval, 0)
+	}
+
+	if initialized && q.connected && !connected {
+		q.flaps++
+		q.lastFlap = sample.SampledAt
+	}
+
+	q.connected = connected
+	q.previous = sample
+}
+
+func (q *linkQuality) fill(spec *specs.MachineLinkStatusSpec) {
+	spec.Connected = q.connected
+	spec.LastHandshake = optionalTimestamp(q.previous.LastHandshake)
+	spec.Endpoint = q.previous.Endpoint
+	spec.BytesReceived = uint64(max(q.previous.BytesReceived, 0))
+	spec.BytesSent = uint64(max(q.previous.BytesSent, 0))
+	spec.ReceiveRate = q.receiveRate
+	spec.SendRate = q.sendRate
+	spec.KeepaliveInterval = durationpb.New(q.previous.KeepaliveInterval)
+	spec.KeepaliveLatency = durationpb.New(q.keepaliveLatency)
+	spec.Flaps = q.flaps
+	spec.LastFlap = optionalTimestamp(q.lastFlap)
+	spec.SampledAt = timestamppb.New(q.previous.SampledAt)
+}
+
+func optionalTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(t)
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/siderolink"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+	siderolinkmanager "github.com/siderolabs/omni/internal/pkg/siderolink"
+)
+
+type MachineLinkStatusSuite struct {
+	OmniSuite
+	sampleCh chan siderolinkmanager.LinkSamples
+}
+
+func (suite *MachineLinkStatusSuite) SetupTest() {
+	suite.OmniSuite.SetupTest()
+
+	suite.startRuntime()
+
+	suite.sampleCh = make(chan siderolinkmanager.LinkSamples)
+
+	suite.Require().NoError(suite.runtime.RegisterController(omnictrl.NewMachineLinkStatusController(suite.sampleCh)))
+}
+
+func (suite *MachineLinkStatusSuite) TestLinkQuality() {
+	link := siderolink.NewLink(resources.DefaultNamespace, testID, &specs.SiderolinkSpec{})
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, link))
+
+	start := time.Unix(1257894000, 0)
+
+	sample := func(offset time.Duration, lastHandshake time.Time, received, sent int64) siderolinkmanager.LinkSamples {
+		return siderolinkmanager.LinkSamples{
+			testID: {
+				SampledAt:         start.Add(offset),
+				LastHandshake:     lastHandshake,
+				Endpoint:          "1.2.3.4:51820",
+				BytesReceived:     received,
+				BytesSent:         sent,
+				KeepaliveInterval: 25 * time.Second,
+			},
+		}
+	}
+
+	handshake := start.Add(-10 * time.Second)
+
+	suite.sampleCh <- sample(0, handshake, 100, 50)
+
+	assertResource(&suite.OmniSuite, makeMD[*omni.MachineLinkStatus](testID), func(res *omni.MachineLinkStatus, asrt *assert.Assertions) {
+		spec := res.TypedSpec().Value
+
+		asrt.True(spec.Connected)
+		asrt.Equal("1.2.3.4:51820", spec.Endpoint)
+		asrt.Equal(handshake.Unix(), spec.GetLastHandshake().AsTime().Unix())
+		asrt.EqualValues(100, spec.BytesReceived)
+		asrt.EqualValues(50, spec.BytesSent)
+		asrt.Zero(spec.GetKeepaliveLatency().AsDuration())
+	})
+
+	suite.sampleCh <- sample(30*time.Second, handshake, 400, 110)
+
+	assertResource(&suite.OmniSuite, makeMD[*omni.MachineLinkStatus](testID), func(res *omni.MachineLinkStatus, asrt *assert.Assertions) {
+		spec := res.TypedSpec().Value
+
+		asrt.InDelta(10, spec.ReceiveRate, 0.001)
+		asrt.InDelta(2, spec.SendRate, 0.001)
+		asrt.Zero(spec.GetKeepaliveLatency().AsDuration())
+	})
+
+	// no traffic for a minute, two keepalives are missed
+	suite.sampleCh <- sample(90*time.Second, handshake, 400, 170)
+
+	assertResource(&suite.OmniSuite, makeMD[*omni.MachineLinkStatus](testID), func(res *omni.MachineLinkStatus, asrt *assert.Assertions) {
+		spec := res.TypedSpec().Value
+
+		asrt.True(spec.Connected)
+		asrt.Zero(spec.ReceiveRate)
+		asrt.Equal(35*time.Second, spec.GetKeepaliveLatency().AsDuration())
+	})
+
+	// the handshake is too old, the link is down
+	suite.sampleCh <- sample(30*time.Minute, handshake, 400, 170)
+
+	assertResource(&suite.OmniSuite, makeMD[*omni.MachineLinkStatus](testID), func(res *omni.MachineLinkStatus, asrt *assert.Assertions) {
+		spec := res.TypedSpec().Value
+
+		asrt.False(spec.Connected)
+		asrt.EqualValues(1, spec.Flaps)
+		asrt.Equal(start.Add(30*time.Minute).Unix(), spec.GetLastFlap().AsTime().Unix())
+	})
+
+	rtestutils.Destroy[*siderolink.Link](suite.ctx, suite.T(), suite.state, []string{testID})
+
+	assertNoResource(&suite.OmniSuite, omni.NewMachineLinkStatus(resources.EphemeralNamespace, testID))
+}
+
+func TestMachineLinkStatusSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(MachineLinkStatusSuite))
+}
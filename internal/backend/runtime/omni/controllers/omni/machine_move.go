@@ -0,0 +1,335 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+// MachineMoveControllerName is the name of MachineMoveController.
+const MachineMoveControllerName = "MachineMoveController"
+
+// MachineMoveController moves worker machines between the clusters.
+//
+// MachineMoveController removes the machine set node from the source cluster, which resets the machine,
+// waits for the cluster machine to be destroyed and then adds the machine to the target machine set,
+// so that the machine rejoins with the new cluster config without a reinstall.
+// The machine set node created in the target machine set is owned by the controller.
+type MachineMoveController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *MachineMoveController) Name() string {
+	return MachineMoveControllerName
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *MachineMoveController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: resources.DefaultNamespace,
+			Type:      omni.MachineMoveRequestType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: resources.DefaultNamespace,
+			Type:      omni.MachineSetNodeType,
+			Kind:      controller.InputDestroyReady,
+		},
+		{
+			Namespace: resources.DefaultNamespace,
+			Type:      omni.MachineSetType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: resources.DefaultNamespace,
+			Type:      omni.ClusterType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: resources.DefaultNamespace,
+			Type:      omni.MachineStatusType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: resources.DefaultNamespace,
+			Type:      omni.ClusterMachineType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: resources.DefaultNamespace,
+			Type:      omni.ClusterMachineStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *MachineMoveController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: omni.MachineMoveStatusType,
+			Kind: controller.OutputExclusive,
+		},
+		{
+			Type: omni.MachineSetNodeType,
+			Kind: controller.OutputShared,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *MachineMoveController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		tracker := trackResource(r, resources.DefaultNamespace, omni.MachineMoveStatusType)
+
+		requests, err := safe.ReaderListAll[*omni.MachineMoveRequest](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing machine move requests: %w", err)
+		}
+
+		if err = requests.ForEachErr(func(request *omni.MachineMoveRequest) error {
+			tracker.keep(request)
+
+			return ctrl.reconcileRequest(ctx, r, request, logger)
+		}); err != nil {
+			return err
+		}
+
+		if err = tracker.cleanup(ctx); err != nil {
+			return err
+		}
+	}
+}
+
+func (ctrl *MachineMoveController) reconcileRequest(ctx context.Context, r controller.Runtime, request *omni.MachineMoveRequest, logger *zap.Logger) error {
+	machineID := request.Metadata().ID()
+	spec := request.TypedSpec().Value
+
+	moveStatus, err := safe.ReaderGetByID[*omni.MachineMoveStatus](ctx, r, machineID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return err
+	}
+
+	var sourceCluster string
+
+	if moveStatus != nil {
+		statusSpec := moveStatus.TypedSpec().Value
+
+		// the move is complete, the request is kept only to report the result
+		if statusSpec.Phase == specs.MachineMoveStatusSpec_Done &&
+			statusSpec.TargetCluster == spec.TargetCluster &&
+			statusSpec.TargetMachineSet == spec.TargetMachineSet {
+			return nil
+		}
+
+		sourceCluster = statusSpec.SourceCluster
+	}
+
+	progress, err := ctrl.move(ctx, r, machineID, spec)
+	if err != nil {
+		return err
+	}
+
+	if progress.sourceCluster != "" {
+		sourceCluster = progress.sourceCluster
+	}
+
+	if moveStatus == nil || moveStatus.TypedSpec().Value.Phase != progress.phase {
+		logger.Info("machine move progress",
+			zap.String("machine", machineID),
+			zap.String("phase", progress.phase.String()),
+			zap.String("target_cluster", spec.TargetCluster),
+			zap.Error(progress.err),
+		)
+	}
+
+	return safe.WriterModify(ctx, r, omni.NewMachineMoveStatus(resources.DefaultNamespace, machineID), func(res *omni.MachineMoveStatus) error {
+		res.TypedSpec().Value.Phase = progress.phase
+		res.TypedSpec().Value.SourceCluster = sourceCluster
+		res.TypedSpec().Value.TargetCluster = spec.TargetCluster
+		res.TypedSpec().Value.TargetMachineSet = spec.TargetMachineSet
+		res.TypedSpec().Value.Error = ""
+
+		if progress.err != nil {
+			res.TypedSpec().Value.Error = progress.err.Error()
+		}
+
+		return nil
+	})
+}
+
+type machineMoveProgress struct {
+	err           error
+	sourceCluster string
+	phase         specs.MachineMoveStatusSpec_Phase
+}
+
+func failedMachineMove(err error) machineMoveProgress {
+	return machineMoveProgress{
+		phase: specs.MachineMoveStatusSpec_Failed,
+		err:   err,
+	}
+}
+
+//nolint:gocyclo,cyclop
+func (ctrl *MachineMoveController) move(ctx context.Context, r controller.Runtime, machineID resource.ID, spec *specs.MachineMoveRequestSpec) (machineMoveProgress, error) {
+	targetMachineSet, err := safe.ReaderGetByID[*omni.MachineSet](ctx, r, spec.TargetMachineSet)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return failedMachineMove(fmt.Errorf("machine set %q doesn't exist", spec.TargetMachineSet)), nil
+		}
+
+		return machineMoveProgress{}, err
+	}
+
+	if cluster, _ := targetMachineSet.Metadata().Labels().Get(omni.LabelCluster); cluster != spec.TargetCluster {
+		return failedMachineMove(fmt.Errorf("machine set %q doesn't belong to the cluster %q", spec.TargetMachineSet, spec.TargetCluster)), nil
+	}
+
+	machineSetNode, err := safe.ReaderGetByID[*omni.MachineSetNode](ctx, r, machineID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return machineMoveProgress{}, err
+	}
+
+	if machineSetNode != nil {
+		machineSet, _ := machineSetNode.Metadata().Labels().Get(omni.LabelMachineSet)
+
+		if machineSet == targetMachineSet.Metadata().ID() {
+			if machineSetNode.Metadata().Phase() == resource.PhaseTearingDown {
+				return failedMachineMove(errors.New("machine is being removed from the target cluster")), nil
+			}
+
+			return ctrl.joinStatus(ctx, r, machineID, spec.TargetCluster)
+		}
+
+		sourceCluster, _ := machineSetNode.Metadata().Labels().Get(omni.LabelCluster)
+
+		if machineSetNode.Metadata().Phase() == resource.PhaseRunning {
+			// the machine is still a part of the source cluster, check that it can be moved before removing it from there
+			if err = ctrl.validate(ctx, r, machineID, spec.TargetCluster, targetMachineSet); err != nil {
+				progress := failedMachineMove(err)
+				progress.sourceCluster = sourceCluster
+
+				return progress, nil
+			}
+		}
+
+		owner := controller.WithOwner(machineSetNode.Metadata().Owner())
+
+		var ready bool
+
+		ready, err = r.Teardown(ctx, machineSetNode.Metadata(), owner)
+		if err != nil && !state.IsNotFoundError(err) {
+			return machineMoveProgress{}, err
+		}
+
+		if ready {
+			if err = r.Destroy(ctx, machineSetNode.Metadata(), owner); err != nil && !state.IsNotFoundError(err) {
+				return machineMoveProgress{}, err
+			}
+		}
+
+		return machineMoveProgress{
+			phase:         specs.MachineMoveStatusSpec_Removing,
+			sourceCluster: sourceCluster,
+		}, nil
+	}
+
+	// the cluster machine is destroyed only after the machine is reset
+	clusterMachine, err := safe.ReaderGetByID[*omni.ClusterMachine](ctx, r, machineID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return machineMoveProgress{}, err
+	}
+
+	if clusterMachine != nil {
+		return machineMoveProgress{
+			phase: specs.MachineMoveStatusSpec_Resetting,
+		}, nil
+	}
+
+	if targetMachineSet.Metadata().Phase() == resource.PhaseTearingDown {
+		return failedMachineMove(fmt.Errorf("machine set %q is being destroyed", spec.TargetMachineSet)), nil
+	}
+
+	if err = r.Create(ctx, omni.NewMachineSetNode(resources.DefaultNamespace, machineID, targetMachineSet)); err != nil && !state.IsConflictError(err) {
+		return machineMoveProgress{}, err
+	}
+
+	return machineMoveProgress{
+		phase: specs.MachineMoveStatusSpec_Joining,
+	}, nil
+}
+
+func (ctrl *MachineMoveController) validate(ctx context.Context, r controller.Runtime, machineID resource.ID, clusterID resource.ID, targetMachineSet *omni.MachineSet) error {
+	if targetMachineSet.Metadata().Phase() == resource.PhaseTearingDown {
+		return fmt.Errorf("machine set %q is being destroyed", targetMachineSet.Metadata().ID())
+	}
+
+	cluster, err := safe.ReaderGetByID[*omni.Cluster](ctx, r, clusterID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return fmt.Errorf("cluster %q doesn't exist", clusterID)
+		}
+
+		return err
+	}
+
+	machineStatus, err := safe.ReaderGetByID[*omni.MachineStatus](ctx, r, machineID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return fmt.Errorf("machine %q doesn't exist", machineID)
+		}
+
+		return err
+	}
+
+	if err = omni.ValidateMachineMove(machineStatus, cluster); err != nil {
+		return fmt.Errorf("machine can't be moved without a reinstall: %w", err)
+	}
+
+	return nil
+}
+
+func (ctrl *MachineMoveController) joinStatus(ctx context.Context, r controller.Runtime, machineID resource.ID, clusterID resource.ID) (machineMoveProgress, error) {
+	clusterMachineStatus, err := safe.ReaderGetByID[*omni.ClusterMachineStatus](ctx, r, machineID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return machineMoveProgress{}, err
+	}
+
+	if clusterMachineStatus != nil {
+		cluster, _ := clusterMachineStatus.Metadata().Labels().Get(omni.LabelCluster)
+
+		if cluster == clusterID &&
+			clusterMachineStatus.TypedSpec().Value.Ready &&
+			clusterMachineStatus.TypedSpec().Value.Stage == specs.ClusterMachineStatusSpec_RUNNING {
+			return machineMoveProgress{
+				phase: specs.MachineMoveStatusSpec_Done,
+			}, nil
+		}
+	}
+
+	return machineMoveProgress{
+		phase: specs.MachineMoveStatusSpec_Joining,
+	}, nil
+}
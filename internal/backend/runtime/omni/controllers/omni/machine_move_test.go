@@ -0,0 +1,139 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+)
+
+type MachineMoveSuite struct {
+	OmniSuite
+}
+
+func (suite *MachineMoveSuite) createWorkerMachineSet(ctx context.Context, clusterName string) *omni.MachineSet {
+	cluster := omni.NewCluster(resources.DefaultNamespace, clusterName)
+	cluster.TypedSpec().Value.TalosVersion = "1.7.0"
+
+	suite.Require().NoError(suite.state.Create(ctx, cluster))
+
+	machineSet := omni.NewMachineSet(resources.DefaultNamespace, omni.WorkersResourceID(clusterName))
+	machineSet.Metadata().Labels().Set(omni.LabelCluster, clusterName)
+	machineSet.Metadata().Labels().Set(omni.LabelWorkerRole, "")
+
+	suite.Require().NoError(suite.state.Create(ctx, machineSet))
+
+	return machineSet
+}
+
+func (suite *MachineMoveSuite) createMachine(ctx context.Context, id, talosVersion string, sourceMachineSet *omni.MachineSet) {
+	machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, id)
+	machineStatus.TypedSpec().Value.TalosVersion = talosVersion
+	machineStatus.TypedSpec().Value.Hardware = &specs.MachineStatusSpec_HardwareStatus{
+		Blockdevices: []*specs.MachineStatusSpec_HardwareStatus_BlockDevice{
+			{
+				LinuxName:  "/dev/vda",
+				SystemDisk: true,
+			},
+		},
+	}
+
+	suite.Require().NoError(suite.state.Create(ctx, machineStatus))
+	suite.Require().NoError(suite.state.Create(ctx, omni.NewMachineSetNode(resources.DefaultNamespace, id, sourceMachineSet)))
+
+	clusterMachine := omni.NewClusterMachine(resources.DefaultNamespace, id)
+	clusterMachine.Metadata().Labels().Set(omni.LabelCluster, "source")
+
+	suite.Require().NoError(suite.state.Create(ctx, clusterMachine))
+}
+
+func (suite *MachineMoveSuite) assertPhase(id string, phase specs.MachineMoveStatusSpec_Phase) {
+	assertResource[*omni.MachineMoveStatus](&suite.OmniSuite, omni.NewMachineMoveStatus(resources.DefaultNamespace, id).Metadata(),
+		func(res *omni.MachineMoveStatus, assertion *assert.Assertions) {
+			assertion.Equal(phase, res.TypedSpec().Value.Phase)
+			assertion.Equal("source", res.TypedSpec().Value.SourceCluster)
+		},
+	)
+}
+
+func (suite *MachineMoveSuite) TestMove() {
+	suite.startRuntime()
+
+	ctx, cancel := context.WithTimeout(suite.ctx, time.Second*10)
+	defer cancel()
+
+	suite.Require().NoError(suite.runtime.RegisterController(&omnictrl.MachineMoveController{}))
+
+	sourceMachineSet := suite.createWorkerMachineSet(ctx, "source")
+	targetMachineSet := suite.createWorkerMachineSet(ctx, "target")
+
+	suite.createMachine(ctx, "compatible", "v1.7.2", sourceMachineSet)
+	suite.createMachine(ctx, "newer", "v1.8.0", sourceMachineSet)
+
+	for _, id := range []string{"compatible", "newer"} {
+		request := omni.NewMachineMoveRequest(resources.DefaultNamespace, id)
+		request.TypedSpec().Value.TargetCluster = "target"
+		request.TypedSpec().Value.TargetMachineSet = targetMachineSet.Metadata().ID()
+
+		suite.Require().NoError(suite.state.Create(ctx, request))
+	}
+
+	// the machine running newer Talos can't join the target cluster without a reinstall, it stays in the source cluster
+	suite.assertPhase("newer", specs.MachineMoveStatusSpec_Failed)
+
+	assertResource[*omni.MachineSetNode](&suite.OmniSuite, omni.NewMachineSetNode(resources.DefaultNamespace, "newer", sourceMachineSet).Metadata(),
+		func(res *omni.MachineSetNode, assertion *assert.Assertions) {
+			machineSet, _ := res.Metadata().Labels().Get(omni.LabelMachineSet)
+
+			assertion.Equal(sourceMachineSet.Metadata().ID(), machineSet)
+		},
+	)
+
+	// the compatible machine is removed from the source cluster and waits for the reset
+	suite.assertPhase("compatible", specs.MachineMoveStatusSpec_Resetting)
+
+	rtestutils.Destroy[*omni.ClusterMachine](ctx, suite.T(), suite.state, []string{"compatible"})
+
+	suite.assertPhase("compatible", specs.MachineMoveStatusSpec_Joining)
+
+	assertResource[*omni.MachineSetNode](&suite.OmniSuite, omni.NewMachineSetNode(resources.DefaultNamespace, "compatible", targetMachineSet).Metadata(),
+		func(res *omni.MachineSetNode, assertion *assert.Assertions) {
+			machineSet, _ := res.Metadata().Labels().Get(omni.LabelMachineSet)
+			cluster, _ := res.Metadata().Labels().Get(omni.LabelCluster)
+
+			assertion.Equal(targetMachineSet.Metadata().ID(), machineSet)
+			assertion.Equal("target", cluster)
+		},
+	)
+
+	clusterMachineStatus := omni.NewClusterMachineStatus(resources.DefaultNamespace, "compatible")
+	clusterMachineStatus.Metadata().Labels().Set(omni.LabelCluster, "target")
+	clusterMachineStatus.TypedSpec().Value.Ready = true
+	clusterMachineStatus.TypedSpec().Value.Stage = specs.ClusterMachineStatusSpec_RUNNING
+
+	suite.Require().NoError(suite.state.Create(ctx, clusterMachineStatus))
+
+	suite.assertPhase("compatible", specs.MachineMoveStatusSpec_Done)
+
+	rtestutils.Destroy[*omni.MachineMoveRequest](ctx, suite.T(), suite.state, []string{"compatible", "newer"})
+
+	rtestutils.AssertNoResource[*omni.MachineMoveStatus](ctx, suite.T(), suite.state, "compatible")
+	rtestutils.AssertNoResource[*omni.MachineMoveStatus](ctx, suite.T(), suite.state, "newer")
+}
+
+func TestMachineMoveSuite(t *testing.T) {
+	suite.Run(t, new(MachineMoveSuite))
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"slices"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/controller/generic/qtransform"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/helpers"
+)
+
+// MachineSetDefaultExtensionsController manages MachineSetDefaultExtensions resource lifecycle.
+//
+// MachineSetDefaultExtensionsController captures the DefaultExtensions when the machine set is created,
+// so the later changes of the defaults are not rolled out to the existing machine sets.
+type MachineSetDefaultExtensionsController = qtransform.QController[*omni.MachineSet, *omni.MachineSetDefaultExtensions]
+
+// NewMachineSetDefaultExtensionsController initializes MachineSetDefaultExtensionsController.
+func NewMachineSetDefaultExtensionsController() *MachineSetDefaultExtensionsController {
+	return qtransform.NewQController(
+		qtransform.Settings[*omni.MachineSet, *omni.MachineSetDefaultExtensions]{
+			Name: "MachineSetDefaultExtensionsController",
+			MapMetadataFunc: func(machineSet *omni.MachineSet) *omni.MachineSetDefaultExtensions {
+				return omni.NewMachineSetDefaultExtensions(resources.DefaultNamespace, machineSet.Metadata().ID())
+			},
+			UnmapMetadataFunc: func(defaultExtensions *omni.MachineSetDefaultExtensions) *omni.MachineSet {
+				return omni.NewMachineSet(resources.DefaultNamespace, defaultExtensions.Metadata().ID())
+			},
+			TransformFunc: func(ctx context.Context, r controller.Reader, _ *zap.Logger, machineSet *omni.MachineSet, machineSetDefaultExtensions *omni.MachineSetDefaultExtensions) error {
+				helpers.CopyLabels(machineSet, machineSetDefaultExtensions, omni.LabelCluster)
+
+				machineSetDefaultExtensions.Metadata().Labels().Set(omni.LabelMachineSet, machineSet.Metadata().ID())
+
+				// the extensions are captured only once, when the machine set is created
+				_, err := safe.ReaderGetByID[*omni.MachineSetDefaultExtensions](ctx, r, machineSet.Metadata().ID())
+				if err == nil {
+					return nil
+				}
+
+				if !state.IsNotFoundError(err) {
+					return err
+				}
+
+				defaultExtensions, err := safe.ReaderGetByID[*omni.DefaultExtensions](ctx, r, omni.DefaultExtensionsID)
+				if err != nil {
+					if state.IsNotFoundError(err) {
+						return nil
+					}
+
+					return err
+				}
+
+				machineSetDefaultExtensions.TypedSpec().Value.Extensions = slices.Clone(defaultExtensions.TypedSpec().Value.Extensions)
+
+				return nil
+			},
+		},
+		qtransform.WithExtraMappedInput(
+			qtransform.MapperNone[*omni.DefaultExtensions](),
+		),
+	)
+}
@@ -35,6 +35,7 @@ import (
 
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/dryrun"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/mappers"
 	"github.com/siderolabs/omni/internal/backend/runtime/talos"
 )
@@ -47,17 +48,13 @@ var errSkipNode = errors.New("skip node audit")
 
 // NewMachineSetEtcdAuditController initializes MachineSetEtcdAuditController.
 //
-// memberRemoveTimeout defines the interval between two checks: member is removed if two consequent checks mark it as orphaned.
-func NewMachineSetEtcdAuditController(talosClientFactory *talos.ClientFactory, memberRemoveTimeout time.Duration) *MachineSetEtcdAuditController {
-	var (
-		requeueAfterDuration = memberRemoveTimeout + time.Second
-		auditor              = etcdAuditor{
-			talosClientFactory:       talosClientFactory,
-			memberRemoveTimeout:      memberRemoveTimeout,
-			requeueAfterDuration:     requeueAfterDuration,
-			clusterToOrphanedMembers: map[string]map[uint64]time.Time{},
-		}
-	)
+// EtcdMemberRemoveTimeout of the tunables defines the interval between two checks: member is removed if two consequent checks mark it as orphaned.
+func NewMachineSetEtcdAuditController(talosClientFactory *talos.ClientFactory, tunables *Tunables) *MachineSetEtcdAuditController {
+	auditor := etcdAuditor{
+		talosClientFactory:       talosClientFactory,
+		tunables:                 tunables,
+		clusterToOrphanedMembers: map[string]map[uint64]time.Time{},
+	}
 
 	return qtransform.NewQController(
 		qtransform.Settings[*omni.MachineSet, *omni.EtcdAuditResult]{
@@ -121,7 +118,7 @@ func NewMachineSetEtcdAuditController(talosClientFactory *talos.ClientFactory, m
 				// there are orphans, but none of them are ready to be removed yet, requeue
 				if len(membersToRemove) == 0 {
 					// return an error here instead of a simple Requeue request, so that the etcdAuditResult stays unchanged
-					return controller.NewRequeueErrorf(requeueAfterDuration, "no orphaned etcd members ready to be removed, requeue")
+					return controller.NewRequeueErrorf(auditor.requeueAfterDuration(), "no orphaned etcd members ready to be removed, requeue")
 				}
 
 				removedMembers := make([]uint64, 0, len(membersToRemove))
@@ -140,7 +137,7 @@ func NewMachineSetEtcdAuditController(talosClientFactory *talos.ClientFactory, m
 
 				if len(removedMembers) == 0 {
 					// no members were removed in this run - skip updating the etcdAuditResult and requeue with an error
-					return controller.NewRequeueErrorf(requeueAfterDuration, "failed to remove any of the orphaned etcd members")
+					return controller.NewRequeueErrorf(auditor.requeueAfterDuration(), "failed to remove any of the orphaned etcd members")
 				}
 
 				// there was at least one removed member
@@ -151,7 +148,7 @@ func NewMachineSetEtcdAuditController(talosClientFactory *talos.ClientFactory, m
 
 				if len(removedMembers) < len(membersToRemove) {
 					// not all members were removed - requeue the audit without an explicit error, so that etcdAuditResult will still be updated with the last removed members
-					return controller.NewRequeueInterval(requeueAfterDuration)
+					return controller.NewRequeueInterval(auditor.requeueAfterDuration())
 				}
 
 				// all orphans were removed successfully
@@ -196,8 +193,15 @@ type etcdAuditor struct {
 	clusterToOrphanedMembers     map[string]map[uint64]time.Time
 	clusterToOrphanedMembersLock sync.Mutex
 
-	memberRemoveTimeout  time.Duration
-	requeueAfterDuration time.Duration
+	tunables *Tunables
+}
+
+func (auditor *etcdAuditor) memberRemoveTimeout() time.Duration {
+	return auditor.tunables.Get().EtcdMemberRemoveTimeout
+}
+
+func (auditor *etcdAuditor) requeueAfterDuration() time.Duration {
+	return auditor.memberRemoveTimeout() + time.Second
 }
 
 func (auditor *etcdAuditor) updateOrphanMembers(cluster string, currentOrphanedMembers map[uint64]struct{}, logger *zap.Logger) (membersToRemove []uint64) {
@@ -251,7 +255,7 @@ func (auditor *etcdAuditor) updateOrphanMembers(cluster string, currentOrphanedM
 	membersToRemove = make([]uint64, 0, len(auditor.clusterToOrphanedMembers[cluster]))
 
 	for orphanedMember, orphanedAt := range auditor.clusterToOrphanedMembers[cluster] {
-		if time.Since(orphanedAt) >= auditor.memberRemoveTimeout {
+		if time.Since(orphanedAt) >= auditor.memberRemoveTimeout() {
 			membersToRemove = append(membersToRemove, orphanedMember)
 		}
 	}
@@ -376,13 +380,13 @@ func (auditor *etcdAuditor) auditMember(ctx context.Context, r controller.Reader
 	if !ephemeralMounted {
 		requeueErr := fmt.Errorf("etcd audit skipped: machine %q from cluster %q doesn't have ephemeral partition mounted", machine, clusterName)
 
-		return 0, controller.NewRequeueError(requeueErr, auditor.requeueAfterDuration)
+		return 0, controller.NewRequeueError(requeueErr, auditor.requeueAfterDuration())
 	}
 
 	if hasEtcdDirectory && etcdMember == nil {
 		requeueErr := fmt.Errorf("etcd audit skipped: machine %q from cluster %q still joining the cluster", machine, clusterName)
 
-		return 0, controller.NewRequeueError(requeueErr, auditor.requeueAfterDuration)
+		return 0, controller.NewRequeueError(requeueErr, auditor.requeueAfterDuration())
 	}
 
 	id, err := etcd.ParseMemberID(etcdMember.TypedSpec().MemberID)
@@ -447,6 +451,7 @@ func (auditor *etcdAuditor) getNodeClient(ctx context.Context, r controller.Read
 	}
 
 	opts = append(opts, client.WithConfig(omni.NewTalosClientConfig(talosConfig, endpoints...)))
+	opts = append(opts, dryrun.TalosClientOptions()...)
 
 	result, err := client.New(ctx, opts...)
 	if err != nil {
@@ -118,7 +118,9 @@ func (suite *MachineSetEtcdAuditSuite) SetupTest() {
 	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewClusterEndpointController()))
 	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewMachineSetEtcdAuditController(
 		suite.clientFactory,
-		time.Millisecond*100,
+		omnictrl.NewTunables(omnictrl.TunableValues{
+			EtcdMemberRemoveTimeout: time.Millisecond * 100,
+		}),
 	)))
 }
 
@@ -24,6 +24,7 @@ import (
 	"github.com/siderolabs/omni/client/pkg/cosi/labels"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/pkg/quota"
 )
 
 // MachineSetNodeController manages MachineSetNode resource lifecycle.
@@ -64,6 +65,11 @@ func (ctrl *MachineSetNodeController) Inputs() []controller.Input {
 			Type:      omni.MachineClassType,
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: resources.DefaultNamespace,
+			Type:      omni.QuotaType,
+			Kind:      controller.InputWeak,
+		},
 		{
 			Namespace: resources.DefaultNamespace,
 			Type:      omni.MachineSetNodeType,
@@ -218,7 +224,7 @@ func (ctrl *MachineSetNodeController) reconcileMachineSet(
 		if diff < 0 {
 			logger.Info("scaling machine set down", zap.Int("pending", -diff), zap.String("machine_set", machineSet.Metadata().ID()))
 
-			return ctrl.deleteNodes(ctx, r, existingMachineSetNodes, machineStatusMap, -diff, logger)
+			return ctrl.deleteNodes(ctx, r, existingMachineSetNodes, machineStatusMap, scaleDownMachines(machineSet), -diff, logger)
 		}
 
 		logger.Info("scaling machine set up", zap.Int("pending", diff), zap.String("machine_set", machineSet.Metadata().ID()))
@@ -260,6 +266,29 @@ func (ctrl *MachineSetNodeController) createNodes(
 		return err
 	}
 
+	// do not allocate new machines to the paused cluster
+	if _, paused := cluster.Metadata().Annotations().Get(omni.ClusterPaused); paused {
+		return nil
+	}
+
+	// the machines allocated to the cluster count towards the quota of the cluster owner
+	owner, _ := cluster.Metadata().Annotations().Get(omni.CreatedBy)
+
+	limits, err := quota.Get(ctx, r, owner)
+	if err != nil {
+		return err
+	}
+
+	if remaining := limits.RemainingMachines(); remaining >= 0 && remaining < count {
+		if remaining == 0 {
+			logger.Info("machine quota is exhausted, not allocating machines", zap.String("machine_set", machineSet.Metadata().ID()), zap.String("owner", owner))
+
+			return nil
+		}
+
+		count = remaining
+	}
+
 	clusterVersion, err := semver.Parse(cluster.TypedSpec().Value.TalosVersion)
 	if err != nil {
 		return fmt.Errorf("failed to parse talos version of the cluster %w", err)
@@ -329,6 +358,7 @@ func (ctrl *MachineSetNodeController) deleteNodes(
 	r controller.Runtime,
 	machineSetNodes safe.List[*omni.MachineSetNode],
 	machineStatuses map[string]*omni.MachineStatus,
+	preferred map[resource.ID]struct{},
 	machinesToDestroyCount int,
 	logger *zap.Logger,
 ) error {
@@ -346,6 +376,21 @@ func (ctrl *MachineSetNodeController) deleteNodes(
 
 	slices.SortStableFunc(usedMachineSetNodes, getSortFunction(machineStatuses))
 
+	// the machines picked by the autoscaler are removed first
+	slices.SortStableFunc(usedMachineSetNodes, func(a, b *omni.MachineSetNode) int {
+		_, preferredA := preferred[a.Metadata().ID()]
+		_, preferredB := preferred[b.Metadata().ID()]
+
+		switch {
+		case preferredA && !preferredB:
+			return -1
+		case !preferredA && preferredB:
+			return 1
+		default:
+			return 0
+		}
+	})
+
 	// destroy all machines which are currently in tearing down phase and have no finalizers
 	if err = machineSetNodes.ForEachErr(func(machineSetNode *omni.MachineSetNode) error {
 		if machineSetNode.Metadata().Phase() == resource.PhaseRunning {
@@ -391,6 +436,16 @@ func (ctrl *MachineSetNodeController) deleteNodes(
 	return nil
 }
 
+// scaleDownMachines returns the machines which should be removed first when the machine set is scaled down.
+func scaleDownMachines(machineSet *omni.MachineSet) map[resource.ID]struct{} {
+	value, ok := machineSet.Metadata().Annotations().Get(omni.ScaleDownMachines)
+	if !ok || value == "" {
+		return nil
+	}
+
+	return xslices.ToSet(strings.Split(value, ","))
+}
+
 func getSortFunction(machineStatuses map[resource.ID]*omni.MachineStatus) func(a, b *omni.MachineSetNode) int {
 	return func(a, b *omni.MachineSetNode) int {
 		ms1, ok1 := machineStatuses[a.Metadata().ID()]
@@ -78,7 +78,7 @@ func (suite *MachineSetNodeSuite) TestReconcile() {
 	defer cancel()
 
 	suite.Require().NoError(suite.runtime.RegisterController(&omnictrl.MachineSetNodeController{}))
-	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewMachineSetStatusController()))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewMachineSetStatusController(nil)))
 
 	machines := suite.createMachines(
 		map[string]string{
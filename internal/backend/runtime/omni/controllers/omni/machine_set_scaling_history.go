@@ -0,0 +1,237 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/helpers"
+)
+
+// MachineSetScalingHistoryMaxEvents is the number of the latest scaling events kept for each machine set.
+const MachineSetScalingHistoryMaxEvents = 100
+
+// MachineSetScalingHistoryController records the changes of the requested machine count of the machine sets.
+//
+// The initiator of the change is derived from the authorship annotations set by the API:
+// the changes made by the user have the identity of the user, the rest is attributed to Omni itself.
+type MachineSetScalingHistoryController struct {
+	clock func() time.Time
+}
+
+// NewMachineSetScalingHistoryController creates new MachineSetScalingHistoryController.
+func NewMachineSetScalingHistoryController() *MachineSetScalingHistoryController {
+	return &MachineSetScalingHistoryController{
+		clock: time.Now,
+	}
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *MachineSetScalingHistoryController) Name() string {
+	return "MachineSetScalingHistoryController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *MachineSetScalingHistoryController) Inputs() []controller.Input {
+	return []controller.Input{
+		safe.Input[*omni.MachineSetStatus](controller.InputWeak),
+		safe.Input[*omni.MachineSet](controller.InputWeak),
+		safe.Input[*omni.MachineSetNode](controller.InputWeak),
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *MachineSetScalingHistoryController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: omni.MachineSetScalingHistoryType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *MachineSetScalingHistoryController) Run(ctx context.Context, r controller.Runtime, _ *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		if err := ctrl.reconcile(ctx, r); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *MachineSetScalingHistoryController) reconcile(ctx context.Context, r controller.Runtime) error {
+	r.StartTrackingOutputs()
+
+	machineSetStatuses, err := safe.ReaderListAll[*omni.MachineSetStatus](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing machine set statuses: %w", err)
+	}
+
+	for iter := machineSetStatuses.Iterator(); iter.Next(); {
+		machineSetStatus := iter.Value()
+
+		if err = ctrl.record(ctx, r, machineSetStatus); err != nil {
+			return err
+		}
+	}
+
+	if err = safe.CleanupOutputs[*omni.MachineSetScalingHistory](ctx, r); err != nil {
+		return fmt.Errorf("error cleaning up machine set scaling histories: %w", err)
+	}
+
+	return nil
+}
+
+func (ctrl *MachineSetScalingHistoryController) record(ctx context.Context, r controller.Runtime, machineSetStatus *omni.MachineSetStatus) error {
+	id := machineSetStatus.Metadata().ID()
+	requested := machineSetStatus.TypedSpec().Value.GetMachines().GetRequested()
+
+	machineSet, err := safe.ReaderGetByID[*omni.MachineSet](ctx, r, id)
+	if err != nil && !state.IsNotFoundError(err) {
+		return fmt.Errorf("error getting machine set: %w", err)
+	}
+
+	machineSetNodes, err := safe.ReaderListAll[*omni.MachineSetNode](ctx, r, state.WithLabelQuery(resource.LabelEqual(omni.LabelMachineSet, id)))
+	if err != nil {
+		return fmt.Errorf("error listing machine set nodes: %w", err)
+	}
+
+	if err = safe.WriterModify(ctx, r, omni.NewMachineSetScalingHistory(resources.DefaultNamespace, id), func(res *omni.MachineSetScalingHistory) error {
+		helpers.CopyAllLabels(machineSetStatus, res)
+
+		spec := res.TypedSpec().Value
+
+		if spec.RequestedMachines == requested {
+			return nil
+		}
+
+		var since time.Time
+
+		if len(spec.Events) > 0 {
+			since = spec.Events[len(spec.Events)-1].Timestamp.AsTime()
+		}
+
+		initiator, identity := scalingInitiator(machineSet, machineSetNodes, requested > spec.RequestedMachines, since)
+
+		spec.Events = append(spec.Events, &specs.MachineSetScalingHistorySpec_Event{
+			Timestamp: timestamppb.New(ctrl.clock()),
+			OldCount:  spec.RequestedMachines,
+			NewCount:  requested,
+			Initiator: initiator,
+			Identity:  identity,
+		})
+
+		if len(spec.Events) > MachineSetScalingHistoryMaxEvents {
+			spec.Events = spec.Events[len(spec.Events)-MachineSetScalingHistoryMaxEvents:]
+		}
+
+		spec.RequestedMachines = requested
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("error updating machine set scaling history: %w", err)
+	}
+
+	return nil
+}
+
+// scalingInitiator determines who changed the requested machine count of the machine set after the previous scaling event.
+func scalingInitiator(machineSet *omni.MachineSet, machineSetNodes safe.List[*omni.MachineSetNode], scaledUp bool,
+	since time.Time,
+) (specs.MachineSetScalingHistorySpec_Initiator, string) {
+	if machineSet == nil {
+		return specs.MachineSetScalingHistorySpec_UNKNOWN, ""
+	}
+
+	if machineClass := machineSet.TypedSpec().Value.GetMachineClass(); machineClass != nil {
+		// the machine set follows the machines available in the machine class
+		if machineClass.AllocationType == specs.MachineSetSpec_MachineClass_Unlimited {
+			return specs.MachineSetScalingHistorySpec_AUTOSCALER, ""
+		}
+
+		// the machine count was changed through the API after the previous event
+		if updated, ok := apiUpdateTime(machineSet); ok && !updated.Before(since.Truncate(time.Second)) {
+			identity, _ := machineSet.Metadata().Annotations().Get(omni.UpdatedBy)
+
+			return specs.MachineSetScalingHistorySpec_USER, identity
+		}
+
+		return specs.MachineSetScalingHistorySpec_AUTOSCALER, ""
+	}
+
+	if scaledUp {
+		var newest *omni.MachineSetNode
+
+		for iter := machineSetNodes.Iterator(); iter.Next(); {
+			node := iter.Value()
+
+			if node.Metadata().Phase() == resource.PhaseRunning && (newest == nil || node.Metadata().Created().After(newest.Metadata().Created())) {
+				newest = node
+			}
+		}
+
+		if newest != nil {
+			if identity, ok := newest.Metadata().Annotations().Get(omni.CreatedBy); ok {
+				return specs.MachineSetScalingHistorySpec_USER, identity
+			}
+		}
+
+		return specs.MachineSetScalingHistorySpec_UNKNOWN, ""
+	}
+
+	// the nodes being removed are still around while their finalizers are being removed
+	for iter := machineSetNodes.Iterator(); iter.Next(); {
+		node := iter.Value()
+
+		if node.Metadata().Phase() != resource.PhaseTearingDown {
+			continue
+		}
+
+		if identity, ok := node.Metadata().Annotations().Get(omni.UpdatedBy); ok {
+			return specs.MachineSetScalingHistorySpec_USER, identity
+		}
+
+		// the node was removed by Omni, e.g. because the machine is gone
+		return specs.MachineSetScalingHistorySpec_SELF_HEAL, ""
+	}
+
+	return specs.MachineSetScalingHistorySpec_UNKNOWN, ""
+}
+
+// apiUpdateTime returns the time of the last update of the resource made through the API.
+func apiUpdateTime(res resource.Resource) (time.Time, bool) {
+	value, ok := res.Metadata().Annotations().Get(omni.UpdatedAt)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return t, true
+}
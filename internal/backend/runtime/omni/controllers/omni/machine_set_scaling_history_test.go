@@ -0,0 +1,144 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+)
+
+type MachineSetScalingHistorySuite struct {
+	OmniSuite
+}
+
+func (suite *MachineSetScalingHistorySuite) TestReconcile() {
+	ctx, cancel := context.WithTimeout(suite.ctx, time.Second*20)
+	defer cancel()
+
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterController(omnictrl.NewMachineSetScalingHistoryController()))
+
+	machineSet := omni.NewMachineSet(resources.DefaultNamespace, "cluster1-workers")
+	machineSet.Metadata().Labels().Set(omni.LabelCluster, "cluster1")
+
+	suite.Require().NoError(suite.state.Create(ctx, machineSet))
+
+	machineSetNode := omni.NewMachineSetNode(resources.DefaultNamespace, "node-1", machineSet)
+	machineSetNode.Metadata().Annotations().Set(omni.CreatedBy, "alice@example.com")
+	machineSetNode.Metadata().Finalizers().Add("test")
+
+	suite.Require().NoError(suite.state.Create(ctx, machineSetNode))
+
+	machineSetStatus := omni.NewMachineSetStatus(resources.DefaultNamespace, machineSet.Metadata().ID())
+	machineSetStatus.Metadata().Labels().Set(omni.LabelCluster, "cluster1")
+	machineSetStatus.TypedSpec().Value.Machines = &specs.Machines{}
+
+	suite.Require().NoError(suite.state.Create(ctx, machineSetStatus))
+
+	setRequested := func(count uint32) {
+		_, err := safe.StateUpdateWithConflicts(ctx, suite.state, machineSetStatus.Metadata(), func(res *omni.MachineSetStatus) error {
+			res.TypedSpec().Value.Machines.Requested = count
+
+			return nil
+		})
+		suite.Require().NoError(err)
+	}
+
+	assertEvents := func(expected ...*specs.MachineSetScalingHistorySpec_Event) {
+		rtestutils.AssertResources(ctx, suite.T(), suite.state, []string{machineSet.Metadata().ID()},
+			func(res *omni.MachineSetScalingHistory, assertion *assert.Assertions) {
+				events := res.TypedSpec().Value.Events
+
+				if !assertion.Len(events, len(expected)) {
+					return
+				}
+
+				for i, event := range events {
+					assertion.Equal(expected[i].OldCount, event.OldCount)
+					assertion.Equal(expected[i].NewCount, event.NewCount)
+					assertion.Equal(expected[i].Initiator, event.Initiator)
+					assertion.Equal(expected[i].Identity, event.Identity)
+				}
+
+				assertion.Equal("cluster1", res.Metadata().Labels().Raw()[omni.LabelCluster])
+			},
+		)
+	}
+
+	setRequested(1)
+
+	scaleUp := &specs.MachineSetScalingHistorySpec_Event{
+		OldCount:  0,
+		NewCount:  1,
+		Initiator: specs.MachineSetScalingHistorySpec_USER,
+		Identity:  "alice@example.com",
+	}
+
+	assertEvents(scaleUp)
+
+	// the node removed by the user
+	_, err := safe.StateUpdateWithConflicts(ctx, suite.state, machineSetNode.Metadata(), func(res *omni.MachineSetNode) error {
+		res.Metadata().Annotations().Set(omni.UpdatedBy, "bob@example.com")
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	_, err = suite.state.Teardown(ctx, machineSetNode.Metadata())
+	suite.Require().NoError(err)
+
+	setRequested(0)
+
+	scaleDown := &specs.MachineSetScalingHistorySpec_Event{
+		OldCount:  1,
+		NewCount:  0,
+		Initiator: specs.MachineSetScalingHistorySpec_USER,
+		Identity:  "bob@example.com",
+	}
+
+	assertEvents(scaleUp, scaleDown)
+
+	// the machine count of the machine class changed by Omni itself
+	_, err = safe.StateUpdateWithConflicts(ctx, suite.state, machineSet.Metadata(), func(res *omni.MachineSet) error {
+		res.TypedSpec().Value.MachineClass = &specs.MachineSetSpec_MachineClass{
+			Name:         "class",
+			MachineCount: 3,
+		}
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	setRequested(3)
+
+	assertEvents(scaleUp, scaleDown, &specs.MachineSetScalingHistorySpec_Event{
+		OldCount:  0,
+		NewCount:  3,
+		Initiator: specs.MachineSetScalingHistorySpec_AUTOSCALER,
+	})
+
+	rtestutils.DestroyAll[*omni.MachineSetStatus](ctx, suite.T(), suite.state)
+
+	rtestutils.AssertNoResource[*omni.MachineSetScalingHistory](ctx, suite.T(), suite.state, machineSet.Metadata().ID())
+}
+
+func TestMachineSetScalingHistorySuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(MachineSetScalingHistorySuite))
+}
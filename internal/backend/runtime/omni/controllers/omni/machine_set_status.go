@@ -7,7 +7,6 @@ package omni
 
 import (
 	"context"
-	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/controller/generic/qtransform"
@@ -29,10 +28,10 @@ import (
 // MachineSetStatusController creates and deletes cluster machines, handles rolling updates.
 type MachineSetStatusController = qtransform.QController[*omni.MachineSet, *omni.MachineSetStatus]
 
-const requeueInterval = time.Second * 30
-
 // NewMachineSetStatusController creates new MachineSetStatusController.
-func NewMachineSetStatusController() *MachineSetStatusController {
+//
+// The nil tunables use the default polling interval.
+func NewMachineSetStatusController(tunables *Tunables) *MachineSetStatusController {
 	mapMachineIDToMachineSet := func(ctx context.Context, r controller.QRuntime, res resource.Resource, label string) ([]resource.Pointer, error) {
 		id, ok := res.Metadata().Labels().Get(label)
 		if !ok {
@@ -56,7 +55,9 @@ func NewMachineSetStatusController() *MachineSetStatusController {
 		}, nil
 	}
 
-	handler := &machineSetStatusHandler{}
+	handler := &machineSetStatusHandler{
+		tunables: tunables,
+	}
 
 	return qtransform.NewQController(
 		qtransform.Settings[*omni.MachineSet, *omni.MachineSetStatus]{
@@ -169,11 +170,17 @@ func NewMachineSetStatusController() *MachineSetStatusController {
 				Type: omni.ClusterMachineConfigPatchesType,
 				Kind: controller.OutputExclusive,
 			},
+			controller.Output{
+				Type: omni.ConfigPatchBlobType,
+				Kind: controller.OutputExclusive,
+			},
 		),
 	)
 }
 
-type machineSetStatusHandler struct{}
+type machineSetStatusHandler struct {
+	tunables *Tunables
+}
 
 func (handler *machineSetStatusHandler) reconcileRunning(ctx context.Context, r controller.ReaderWriter, logger *zap.Logger,
 	machineSet *omni.MachineSet, machineSetStatus *omni.MachineSetStatus,
@@ -199,7 +206,7 @@ func (handler *machineSetStatusHandler) reconcileRunning(ctx context.Context, r
 	}
 
 	if requeue {
-		return controller.NewRequeueInterval(requeueInterval)
+		return controller.NewRequeueInterval(handler.tunables.Get().MachineSetStatusPollInterval)
 	}
 
 	return nil
@@ -216,7 +223,7 @@ func (handler *machineSetStatusHandler) reconcileTearingDown(ctx context.Context
 	if clusterMachinesCount == 0 {
 		logger.Info("machineset torn down", zap.String("machineset", machineSet.Metadata().ID()))
 
-		return nil
+		return machineset.CleanupConfigPatchBlobs(ctx, r, rc)
 	}
 
 	err = safe.WriterModify[*omni.MachineSetStatus](ctx, r, omni.NewMachineSetStatus(resources.DefaultNamespace, machineSet.Metadata().ID()), func(status *omni.MachineSetStatus) error {
@@ -242,7 +249,7 @@ func (handler *machineSetStatusHandler) reconcileTearingDown(ctx context.Context
 		return nil
 	}
 
-	return controller.NewRequeueErrorf(requeueInterval, "the machine set still has cluster machines")
+	return controller.NewRequeueErrorf(handler.tunables.Get().MachineSetStatusPollInterval, "the machine set still has cluster machines")
 }
 
 func (handler *machineSetStatusHandler) reconcileMachines(ctx context.Context, r controller.ReaderWriter, logger *zap.Logger, rc *machineset.ReconciliationContext) (bool, error) {
@@ -251,5 +258,10 @@ func (handler *machineSetStatusHandler) reconcileMachines(ctx context.Context, r
 	}
 
 	// return requeue as separate flag and return requeue in the end of the function
-	return machineset.ReconcileMachines(ctx, r, logger, rc)
+	requeue, err := machineset.ReconcileMachines(ctx, r, logger, rc)
+	if err != nil {
+		return false, err
+	}
+
+	return requeue, machineset.CleanupConfigPatchBlobs(ctx, r, rc)
 }
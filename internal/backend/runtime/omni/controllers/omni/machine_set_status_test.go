@@ -251,7 +251,7 @@ func (suite *MachineSetStatusSuite) SetupTest() {
 
 	suite.startRuntime()
 
-	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewMachineSetStatusController()))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewMachineSetStatusController(nil)))
 
 	// create siderolink config as it's endpoint is used while generating kubernetes endpoint
 	siderolink := siderolink.NewConfig(resources.DefaultNamespace)
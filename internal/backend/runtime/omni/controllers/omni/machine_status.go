@@ -8,6 +8,7 @@ package omni
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/controller/generic"
@@ -45,10 +46,14 @@ type MachineStatusController struct {
 	runner             *task.Runner[machinetask.InfoChan, machinetask.CollectTaskSpec]
 	notifyCh           chan machinetask.Info
 	generic.NamedController
+	pollIntervals machinetask.PollIntervals
 }
 
 // NewMachineStatusController initializes MachineStatusController.
-func NewMachineStatusController(imageFactoryClient SchematicEnsurer) *MachineStatusController {
+//
+// The resources of each machine which can't be watched are polled from the Talos API every minPollInterval while the machine is changing,
+// the interval grows up to the maxPollInterval while the machine is stable. The failed polls are retried at the same growing intervals.
+func NewMachineStatusController(imageFactoryClient SchematicEnsurer, minPollInterval, maxPollInterval time.Duration) *MachineStatusController {
 	return &MachineStatusController{
 		NamedController: generic.NamedController{
 			ControllerName: MachineStatusControllerName,
@@ -56,6 +61,10 @@ func NewMachineStatusController(imageFactoryClient SchematicEnsurer) *MachineSta
 		notifyCh:           make(chan machinetask.Info),
 		runner:             task.NewEqualRunner[machinetask.CollectTaskSpec](),
 		ImageFactoryClient: imageFactoryClient,
+		pollIntervals: machinetask.PollIntervals{
+			Min: minPollInterval,
+			Max: maxPollInterval,
+		},
 	}
 }
 
@@ -98,6 +107,11 @@ func (ctrl *MachineStatusController) Settings() controller.QSettings {
 				Type:      omni.MachineLabelsType,
 				Kind:      controller.InputQMapped,
 			},
+			{
+				Namespace: resources.DefaultNamespace,
+				Type:      omni.MachineWipeStatusType,
+				Kind:      controller.InputQMapped,
+			},
 			{
 				Namespace: resources.DefaultNamespace,
 				Type:      siderolink.ConnectionParamsType,
@@ -109,6 +123,10 @@ func (ctrl *MachineStatusController) Settings() controller.QSettings {
 				Kind: controller.OutputExclusive,
 				Type: omni.MachineStatusType,
 			},
+			{
+				Kind: controller.OutputExclusive,
+				Type: omni.MachineHardwareInventoryType,
+			},
 		},
 		Concurrency: optional.Some[uint](4),
 		RunHook: func(ctx context.Context, _ *zap.Logger, r controller.QRuntime) error {
@@ -117,8 +135,10 @@ func (ctrl *MachineStatusController) Settings() controller.QSettings {
 				case <-ctx.Done():
 					return nil
 				case event := <-ctrl.notifyCh:
-					if err := ctrl.handleNotification(ctx, r, event); err != nil {
-						return err
+					for _, info := range ctrl.coalesceNotifications(event) {
+						if err := ctrl.handleNotification(ctx, r, info); err != nil {
+							return err
+						}
 					}
 				}
 			}
@@ -151,6 +171,8 @@ func (ctrl *MachineStatusController) MapInput(ctx context.Context, _ *zap.Logger
 		fallthrough
 	case omni.MachineLabelsType:
 		fallthrough
+	case omni.MachineWipeStatusType:
+		fallthrough
 	case omni.MachineStatusSnapshotType:
 		return []resource.Pointer{
 			omni.NewMachine(resources.DefaultNamespace, ptr.ID()).Metadata(),
@@ -232,6 +254,7 @@ func (ctrl *MachineStatusController) reconcileRunning(ctx context.Context, r con
 		MachineID:                  machine.Metadata().ID(),
 		MachineLabels:              inputs.machineLabels,
 		DefaultSchematicKernelArgs: siderolink.KernelArgs(params),
+		PollIntervals:              ctrl.pollIntervals,
 	}
 
 	if !machine.TypedSpec().Value.Connected {
@@ -283,6 +306,11 @@ func (ctrl *MachineStatusController) reconcileTearingDown(ctx context.Context, r
 		return err
 	}
 
+	// nothing depends on the hardware inventory, so it's removed right away
+	if err = r.Destroy(ctx, omni.NewMachineHardwareInventory(resources.DefaultNamespace, machine.Metadata().ID()).Metadata()); err != nil && !state.IsNotFoundError(err) {
+		return err
+	}
+
 	md := omni.NewMachineStatus(resources.DefaultNamespace, machine.Metadata().ID()).Metadata()
 
 	ready, err := r.Teardown(ctx, md)
@@ -305,9 +333,40 @@ func (ctrl *MachineStatusController) reconcileTearingDown(ctx context.Context, r
 	return r.RemoveFinalizer(ctx, machine.Metadata(), ctrl.Name())
 }
 
+// maxCoalescedNotifications limits the number of the queued notifications merged before the machine statuses are written.
+const maxCoalescedNotifications = 64
+
+// coalesceNotifications merges the notifications which are already queued by the collect tasks into the event.
+//
+// Each notification carries only the fields collected by a single poll, so merging the queued ones per machine
+// makes a burst of polls result in a single MachineStatus write instead of a full rewrite per poll.
+func (ctrl *MachineStatusController) coalesceNotifications(event machinetask.Info) []machinetask.Info {
+	pending := []machinetask.Info{event}
+	index := map[string]int{event.MachineID: 0}
+
+	for range maxCoalescedNotifications - 1 {
+		select {
+		case event = <-ctrl.notifyCh:
+		default:
+			return pending
+		}
+
+		if i, ok := index[event.MachineID]; ok {
+			pending[i] = pending[i].Merge(event)
+
+			continue
+		}
+
+		index[event.MachineID] = len(pending)
+		pending = append(pending, event)
+	}
+
+	return pending
+}
+
 //nolint:gocyclo,cyclop,gocognit
 func (ctrl *MachineStatusController) handleNotification(ctx context.Context, r controller.QRuntime, event machinetask.Info) error {
-	if err := safe.WriterModify(ctx, r, omni.NewMachineStatus(resources.DefaultNamespace, event.MachineID), func(m *omni.MachineStatus) error {
+	err := safe.WriterModify(ctx, r, omni.NewMachineStatus(resources.DefaultNamespace, event.MachineID), func(m *omni.MachineStatus) error {
 		spec := m.TypedSpec().Value
 
 		if event.LastError != nil {
@@ -392,6 +451,10 @@ func (ctrl *MachineStatusController) handleNotification(ctx context.Context, r c
 			spec.SecureBootStatus = event.SecureBootStatus
 		}
 
+		if event.BootID != nil {
+			spec.BootId = *event.BootID
+		}
+
 		if event.Schematic != nil {
 			if spec.Schematic == nil {
 				spec.Schematic = &specs.MachineStatusSpec_Schematic{}
@@ -427,11 +490,27 @@ func (ctrl *MachineStatusController) handleNotification(ctx context.Context, r c
 
 		omni.MachineStatusReconcileLabels(m)
 
+		if event.HardwareInventory != nil {
+			omni.MachineStatusReconcileGPULabels(m, event.HardwareInventory)
+		}
+
 		return nil
-	}); err != nil && !state.IsPhaseConflictError(err) {
+	})
+	if err != nil && !state.IsPhaseConflictError(err) {
 		return fmt.Errorf("error modifying resource: %w", err)
 	}
 
+	// the hardware inventory is not recreated if the machine status is being torn down
+	if event.HardwareInventory != nil && err == nil {
+		if err = safe.WriterModify(ctx, r, omni.NewMachineHardwareInventory(resources.DefaultNamespace, event.MachineID), func(res *omni.MachineHardwareInventory) error {
+			res.TypedSpec().Value = event.HardwareInventory
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error modifying hardware inventory: %w", err)
+		}
+	}
+
 	if event.Schematic != nil && event.Schematic.ID != "" {
 		machineSchematic := schematic.Schematic{
 			Customization: schematic.Customization{
@@ -444,7 +523,7 @@ func (ctrl *MachineStatusController) handleNotification(ctx context.Context, r c
 			Overlay: event.Schematic.Overlay,
 		}
 
-		if _, err := ctrl.ImageFactoryClient.EnsureSchematic(ctx, machineSchematic); err != nil {
+		if _, err = ctrl.ImageFactoryClient.EnsureSchematic(ctx, machineSchematic); err != nil {
 			return fmt.Errorf("error ensuring schematic: %w", err)
 		}
 	}
@@ -457,6 +536,7 @@ type inputs struct {
 	machineSetNode        *omni.MachineSetNode
 	machineLabels         *omni.MachineLabels
 	machineStatusSnapshot *omni.MachineStatusSnapshot
+	machineWipeStatus     *omni.MachineWipeStatus
 	talosConfig           *omni.TalosConfig
 }
 
@@ -486,6 +566,12 @@ func (ctrl *MachineStatusController) handleInputs(ctx context.Context, r control
 		return in, err
 	}
 
+	// the wipe status is written by the MachineWipeStatusController based on the MachineStatus, so it doesn't get a finalizer
+	in.machineWipeStatus, err = safe.ReaderGetByID[*omni.MachineWipeStatus](ctx, r, machine.Metadata().ID())
+	if err != nil && !state.IsNotFoundError(err) {
+		return in, err
+	}
+
 	if in.machineSetNode != nil {
 		clusterName, ok := in.machineSetNode.Metadata().Labels().Get(omni.LabelCluster)
 		if ok {
@@ -531,7 +617,12 @@ func (ctrl *MachineStatusController) setClusterRelation(in inputs, machineStatus
 		machineStatus.TypedSpec().Value.Cluster = ""
 		machineStatus.TypedSpec().Value.Role = specs.MachineStatusSpec_NONE
 
-		machineStatus.Metadata().Labels().Set(omni.MachineStatusLabelAvailable, "")
+		// the machine removed from the cluster is available only after its wipe is verified
+		if wipeVerified(in.machineWipeStatus) {
+			machineStatus.Metadata().Labels().Set(omni.MachineStatusLabelAvailable, "")
+		} else {
+			machineStatus.Metadata().Labels().Delete(omni.MachineStatusLabelAvailable)
+		}
 
 		machineStatus.Metadata().Labels().Delete(omni.LabelCluster)
 
@@ -565,3 +656,14 @@ func (ctrl *MachineStatusController) setClusterRelation(in inputs, machineStatus
 
 	return nil
 }
+
+// wipeVerified returns true if the machine doesn't need the wipe verification or its wipe is verified.
+func wipeVerified(wipeStatus *omni.MachineWipeStatus) bool {
+	if wipeStatus == nil {
+		return true
+	}
+
+	wipeState := wipeStatus.TypedSpec().Value.State
+
+	return wipeState == specs.MachineWipeStatusSpec_VERIFIED || wipeState == specs.MachineWipeStatusSpec_UNSUPPORTED
+}
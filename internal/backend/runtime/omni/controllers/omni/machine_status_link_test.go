@@ -39,6 +39,8 @@ func (suite *MachineStatusLinkSuite) SetupTest() {
 
 	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewMachineStatusController(
 		&imageFactoryClientMock{},
+		time.Second,
+		time.Second,
 	)))
 	suite.Require().NoError(suite.runtime.RegisterController(omnictrl.NewMachineStatusLinkController(suite.deltaCh)))
 }
@@ -0,0 +1,208 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/controller/generic/qtransform"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/xerrors"
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/dryrun"
+	"github.com/siderolabs/omni/internal/backend/runtime/talos"
+)
+
+// MachineWipeStatusController manages MachineWipeStatus resource lifecycle.
+//
+// MachineWipeStatusController verifies that the machine removed from the cluster was reset:
+// once the machine is back in the maintenance mode, the discovered volumes of the machine are inspected,
+// and the STATE and EPHEMERAL partitions are expected to have no filesystem.
+// The machine is not marked as available until the wipe is verified, see MachineStatusController.
+type MachineWipeStatusController = qtransform.QController[*omni.MachineStatus, *omni.MachineWipeStatus]
+
+// NewMachineWipeStatusController initializes MachineWipeStatusController.
+func NewMachineWipeStatusController() *MachineWipeStatusController {
+	return qtransform.NewQController(
+		qtransform.Settings[*omni.MachineStatus, *omni.MachineWipeStatus]{
+			Name: "MachineWipeStatusController",
+			MapMetadataFunc: func(machineStatus *omni.MachineStatus) *omni.MachineWipeStatus {
+				return omni.NewMachineWipeStatus(resources.DefaultNamespace, machineStatus.Metadata().ID())
+			},
+			UnmapMetadataFunc: func(wipeStatus *omni.MachineWipeStatus) *omni.MachineStatus {
+				return omni.NewMachineStatus(resources.DefaultNamespace, wipeStatus.Metadata().ID())
+			},
+			TransformExtraOutputFunc: func(ctx context.Context, r controller.ReaderWriter, logger *zap.Logger, machineStatus *omni.MachineStatus, wipeStatus *omni.MachineWipeStatus) error {
+				spec := wipeStatus.TypedSpec().Value
+
+				if cluster := machineStatus.TypedSpec().Value.Cluster; cluster != "" {
+					spec.State = specs.MachineWipeStatusSpec_PENDING
+					spec.Message = "the machine is allocated to the cluster"
+					spec.Cluster = cluster
+					spec.VerifiedAt = nil
+					spec.Partitions = nil
+
+					return nil
+				}
+
+				// the machines which were never allocated since the status is tracked don't need the verification
+				_, err := safe.ReaderGetByID[*omni.MachineWipeStatus](ctx, r, wipeStatus.Metadata().ID())
+				if err != nil {
+					if state.IsNotFoundError(err) {
+						return xerrors.NewTaggedf[qtransform.SkipReconcileTag]("machine was never allocated")
+					}
+
+					return err
+				}
+
+				if spec.State == specs.MachineWipeStatusSpec_VERIFIED || spec.State == specs.MachineWipeStatusSpec_UNSUPPORTED {
+					return nil
+				}
+
+				if !machineStatus.TypedSpec().Value.Connected {
+					spec.Message = "waiting for the machine to connect"
+
+					return nil
+				}
+
+				statusSnapshot, err := safe.ReaderGetByID[*omni.MachineStatusSnapshot](ctx, r, machineStatus.Metadata().ID())
+				if err != nil && !state.IsNotFoundError(err) {
+					return err
+				}
+
+				if statusSnapshot == nil || statusSnapshot.TypedSpec().Value.GetMachineStatus().GetStage() != machineapi.MachineStatusEvent_MAINTENANCE {
+					spec.Message = "waiting for the machine to be reset to the maintenance mode"
+
+					return nil
+				}
+
+				return verifyWipe(ctx, logger, machineStatus, spec)
+			},
+		},
+		qtransform.WithExtraMappedInput(
+			qtransform.MapperSameID[*omni.MachineStatusSnapshot, *omni.MachineStatus](),
+		),
+	)
+}
+
+// verifyWipe inspects the discovered volumes of the machine in the maintenance mode and updates the wipe status.
+func verifyWipe(ctx context.Context, logger *zap.Logger, machineStatus *omni.MachineStatus, spec *specs.MachineWipeStatusSpec) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	address := machineStatus.TypedSpec().Value.ManagementAddress
+
+	opts := talos.GetSocketOptions(address)
+	opts = append(opts, client.WithTLSConfig(insecureTLSConfig), client.WithEndpoints(address))
+	opts = append(opts, dryrun.TalosClientOptions()...)
+
+	c, err := client.New(ctx, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to create maintenance client to machine '%s': %w", machineStatus.Metadata().ID(), err)
+	}
+
+	defer c.Close() //nolint:errcheck
+
+	volumes, err := listSystemVolumes(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	spec.VerifiedAt = timestamppb.Now()
+
+	if volumes == nil {
+		spec.State = specs.MachineWipeStatusSpec_UNSUPPORTED
+		spec.Message = "the Talos version of the machine doesn't report the discovered volumes"
+		spec.Partitions = nil
+
+		logger.Warn("can't verify the machine wipe", zap.String("reason", spec.Message))
+
+		return nil
+	}
+
+	spec.Partitions = make([]string, 0, len(volumes))
+
+	var notWiped []string
+
+	for _, volume := range volumes {
+		partition := fmt.Sprintf("%s (%s)", volume.TypedSpec().DevicePath, volume.TypedSpec().PartitionLabel)
+
+		spec.Partitions = append(spec.Partitions, partition)
+
+		if volume.TypedSpec().Name != "" {
+			notWiped = append(notWiped, fmt.Sprintf("%s: %s filesystem", partition, volume.TypedSpec().Name))
+		}
+	}
+
+	if len(notWiped) > 0 {
+		spec.State = specs.MachineWipeStatusSpec_FAILED
+		spec.Message = "the system partitions were not wiped: " + strings.Join(notWiped, ", ")
+
+		logger.Error("machine wipe verification failed", zap.Strings("partitions", notWiped))
+
+		return nil
+	}
+
+	spec.State = specs.MachineWipeStatusSpec_VERIFIED
+	spec.Message = ""
+
+	logger.Info("machine wipe verified", zap.Strings("partitions", spec.Partitions))
+
+	return nil
+}
+
+// listSystemVolumes returns the STATE and EPHEMERAL partitions discovered by Talos.
+//
+// It returns nil if the Talos version of the machine doesn't support the discovered volumes.
+func listSystemVolumes(ctx context.Context, c *client.Client) ([]*block.DiscoveredVolume, error) {
+	_, err := safe.StateGetByID[*meta.ResourceDefinition](ctx, c.COSI, strings.ToLower(block.DiscoveredVolumeType))
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get the discovered volumes resource definition: %w", err)
+	}
+
+	volumes, err := safe.StateListAll[*block.DiscoveredVolume](ctx, c.COSI)
+	if err != nil {
+		//nolint:exhaustive
+		switch status.Code(err) {
+		case codes.PermissionDenied, codes.Unimplemented:
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to list the discovered volumes: %w", err)
+	}
+
+	result := make([]*block.DiscoveredVolume, 0, 2)
+
+	volumes.ForEach(func(volume *block.DiscoveredVolume) {
+		switch volume.TypedSpec().PartitionLabel {
+		case constants.StatePartitionLabel, constants.EphemeralPartitionLabel:
+			result = append(result, volume)
+		}
+	})
+
+	return result, nil
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state/registry"
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+)
+
+type MachineWipeStatusSuite struct {
+	OmniSuite
+}
+
+func (suite *MachineWipeStatusSuite) createMachine(ctx context.Context, id, address string) *omni.MachineStatus {
+	snapshot := omni.NewMachineStatusSnapshot(resources.DefaultNamespace, id)
+	snapshot.TypedSpec().Value.MachineStatus = &machineapi.MachineStatusEvent{
+		Stage: machineapi.MachineStatusEvent_MAINTENANCE,
+	}
+
+	suite.Require().NoError(suite.state.Create(ctx, snapshot))
+
+	machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, id)
+	machineStatus.TypedSpec().Value.Connected = true
+	machineStatus.TypedSpec().Value.ManagementAddress = address
+	machineStatus.TypedSpec().Value.Cluster = "talos-default"
+
+	suite.Require().NoError(suite.state.Create(ctx, machineStatus))
+
+	rtestutils.AssertResources(ctx, suite.T(), suite.state, []string{id},
+		func(res *omni.MachineWipeStatus, assertion *assert.Assertions) {
+			assertion.Equal(specs.MachineWipeStatusSpec_PENDING, res.TypedSpec().Value.State)
+			assertion.Equal("talos-default", res.TypedSpec().Value.Cluster)
+		},
+	)
+
+	return machineStatus
+}
+
+func (suite *MachineWipeStatusSuite) setCluster(ctx context.Context, machineStatus *omni.MachineStatus, cluster string) {
+	_, err := safe.StateUpdateWithConflicts(ctx, suite.state, machineStatus.Metadata(), func(res *omni.MachineStatus) error {
+		res.TypedSpec().Value.Cluster = cluster
+
+		return nil
+	})
+	suite.Require().NoError(err)
+}
+
+func (suite *MachineWipeStatusSuite) TestReconcile() {
+	ctx, cancel := context.WithTimeout(suite.ctx, time.Second*20)
+	defer cancel()
+
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewMachineWipeStatusController()))
+
+	suite.Require().NoError(registry.NewResourceRegistry(suite.machineService.state).Register(ctx, &block.DiscoveredVolume{}))
+
+	statePartition := block.NewDiscoveredVolume(block.NamespaceName, "sda4")
+	statePartition.TypedSpec().DevicePath = "/dev/sda4"
+	statePartition.TypedSpec().PartitionLabel = constants.StatePartitionLabel
+	statePartition.TypedSpec().Name = "xfs"
+
+	suite.Require().NoError(suite.machineService.state.Create(ctx, statePartition))
+
+	// the machines which were never allocated don't get the wipe status
+	suite.Require().NoError(suite.state.Create(ctx, omni.NewMachineStatus(resources.DefaultNamespace, "available")))
+
+	machineStatus := suite.createMachine(ctx, "machine1", suite.socketConnectionString)
+
+	suite.setCluster(ctx, machineStatus, "")
+
+	rtestutils.AssertResources(ctx, suite.T(), suite.state, []string{"machine1"},
+		func(res *omni.MachineWipeStatus, assertion *assert.Assertions) {
+			assertion.Equal(specs.MachineWipeStatusSpec_FAILED, res.TypedSpec().Value.State)
+			assertion.Equal("the system partitions were not wiped: /dev/sda4 (STATE): xfs filesystem", res.TypedSpec().Value.Message)
+			assertion.Equal([]string{"/dev/sda4 (STATE)"}, res.TypedSpec().Value.Partitions)
+		},
+	)
+
+	_, err := safe.StateUpdateWithConflicts(ctx, suite.machineService.state, statePartition.Metadata(), func(res *block.DiscoveredVolume) error {
+		res.TypedSpec().Name = ""
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	// the verification is retried on the next machine status update
+	_, err = safe.StateUpdateWithConflicts(ctx, suite.state, machineStatus.Metadata(), func(res *omni.MachineStatus) error {
+		res.TypedSpec().Value.BootId = "boot-1"
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	rtestutils.AssertResources(ctx, suite.T(), suite.state, []string{"machine1"},
+		func(res *omni.MachineWipeStatus, assertion *assert.Assertions) {
+			assertion.Equal(specs.MachineWipeStatusSpec_VERIFIED, res.TypedSpec().Value.State)
+			assertion.Equal("talos-default", res.TypedSpec().Value.Cluster)
+			assertion.NotNil(res.TypedSpec().Value.VerifiedAt)
+		},
+	)
+
+	// the verification is reset once the machine is allocated to the next cluster
+	suite.setCluster(ctx, machineStatus, "talos-other")
+
+	rtestutils.AssertResources(ctx, suite.T(), suite.state, []string{"machine1"},
+		func(res *omni.MachineWipeStatus, assertion *assert.Assertions) {
+			assertion.Equal(specs.MachineWipeStatusSpec_PENDING, res.TypedSpec().Value.State)
+			assertion.Equal("talos-other", res.TypedSpec().Value.Cluster)
+			assertion.Nil(res.TypedSpec().Value.VerifiedAt)
+		},
+	)
+
+	rtestutils.AssertNoResource[*omni.MachineWipeStatus](ctx, suite.T(), suite.state, "available")
+
+	rtestutils.DestroyAll[*omni.MachineStatus](ctx, suite.T(), suite.state)
+
+	rtestutils.AssertNoResource[*omni.MachineWipeStatus](ctx, suite.T(), suite.state, "machine1")
+}
+
+func (suite *MachineWipeStatusSuite) TestUnsupported() {
+	ctx, cancel := context.WithTimeout(suite.ctx, time.Second*20)
+	defer cancel()
+
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewMachineWipeStatusController()))
+
+	// the discovered volumes are not registered in the Talos state of the machine
+	machineStatus := suite.createMachine(ctx, "machine1", suite.socketConnectionString)
+
+	suite.setCluster(ctx, machineStatus, "")
+
+	rtestutils.AssertResources(ctx, suite.T(), suite.state, []string{"machine1"},
+		func(res *omni.MachineWipeStatus, assertion *assert.Assertions) {
+			assertion.Equal(specs.MachineWipeStatusSpec_UNSUPPORTED, res.TypedSpec().Value.State)
+			assertion.Empty(res.TypedSpec().Value.Partitions)
+		},
+	)
+}
+
+func TestMachineWipeStatusSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(MachineWipeStatusSuite))
+}
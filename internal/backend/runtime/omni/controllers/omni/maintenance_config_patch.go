@@ -78,14 +78,26 @@ func UpdateMaintenanceConfigPatch(configPatch *omni.ConfigPatch, machineStatus *
 	configPatch.Metadata().Labels().Set(omni.LabelSystemPatch, "")
 	configPatch.Metadata().Labels().Set(omni.LabelMachine, machineStatus.Metadata().ID())
 
-	url, err := siderolink.APIURL(connectionParams, config.Config.SiderolinkUseGRPCTunnel)
+	data, err := RenderJoinConfig(connectionParams, eventSinkPort)
 	if err != nil {
 		return err
 	}
 
+	configPatch.TypedSpec().Value.Data = data
+
+	return nil
+}
+
+// RenderJoinConfig renders the partial machine config which connects the machine to Omni over SideroLink.
+func RenderJoinConfig(connectionParams *siderolink.ConnectionParams, eventSinkPort int) (string, error) {
+	url, err := siderolink.APIURL(connectionParams, config.Config.SiderolinkUseGRPCTunnel)
+	if err != nil {
+		return "", err
+	}
+
 	template, err := template.New("patch").Parse(maintenanceConfigPatchData)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	var buffer bytes.Buffer
@@ -97,10 +109,8 @@ func UpdateMaintenanceConfigPatch(configPatch *omni.ConfigPatch, machineStatus *
 		APIURL:        url,
 		EventSinkPort: eventSinkPort,
 	}); err != nil {
-		return err
+		return "", err
 	}
 
-	configPatch.TypedSpec().Value.Data = buffer.String()
-
-	return nil
+	return buffer.String(), nil
 }
@@ -0,0 +1,76 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+// maintenanceWindowWait checks if the disruptive operations of the cluster wait for its maintenance window.
+//
+// The operations don't wait if the cluster has no maintenance window or the window is open.
+// The returned time is the start of the next window, zero if it doesn't wait.
+func maintenanceWindowWait(ctx context.Context, r controller.Reader, clusterID resource.ID, now time.Time) (bool, time.Time, error) {
+	window, err := safe.ReaderGetByID[*omni.MaintenanceWindow](ctx, r, clusterID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return false, time.Time{}, nil
+		}
+
+		return false, time.Time{}, err
+	}
+
+	if window.Metadata().Phase() == resource.PhaseTearingDown {
+		return false, time.Time{}, nil
+	}
+
+	open, next, err := omni.MaintenanceWindowOpen(window.TypedSpec().Value, now)
+	if err != nil {
+		return false, time.Time{}, fmt.Errorf("failed to check the maintenance window of the cluster %q: %w", clusterID, err)
+	}
+
+	if open {
+		return false, time.Time{}, nil
+	}
+
+	return true, next, nil
+}
+
+// maintenanceWindowStatus describes the operation waiting for the maintenance window starting at next.
+func maintenanceWindowStatus(next time.Time) string {
+	if next.IsZero() {
+		return "queued until the next maintenance window"
+	}
+
+	return fmt.Sprintf("queued until the next maintenance window at %s", next.UTC().Format(time.RFC3339))
+}
+
+func maintenanceWindowTimestamp(next time.Time) *timestamppb.Timestamp {
+	if next.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(next)
+}
+
+// maintenanceWindowRequeue requeues the reconcile at the start of the next maintenance window.
+func maintenanceWindowRequeue(next time.Time, pollInterval time.Duration) error {
+	if next.IsZero() {
+		return controller.NewRequeueInterval(pollInterval)
+	}
+
+	return controller.NewRequeueInterval(max(time.Until(next), time.Second))
+}
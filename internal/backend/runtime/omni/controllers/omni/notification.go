@@ -0,0 +1,292 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/panichandler"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/notification"
+)
+
+// NotificationController posts the cluster lifecycle events to the webhooks configured by the NotificationConfigs.
+//
+// The events are detected by comparing the resources with their state observed on the previous reconcile,
+// so the changes which happen while Omni is not running are not notified.
+type NotificationController struct {
+	sender   *notification.Sender
+	observed *notificationState
+
+	deliveries sync.WaitGroup
+}
+
+// notificationState is the state of the resources the events are detected from.
+type notificationState struct {
+	clusters           map[resource.ID]struct{}
+	clusterMachines    map[resource.ID]string
+	talosUpgrades      map[resource.ID]*specs.TalosUpgradeStatusSpec
+	kubernetesUpgrades map[resource.ID]*specs.KubernetesUpgradeStatusSpec
+	etcdBackups        map[resource.ID]*specs.EtcdBackupStatusSpec
+}
+
+// NewNotificationController creates new NotificationController.
+func NewNotificationController(sender *notification.Sender) *NotificationController {
+	return &NotificationController{
+		sender: sender,
+	}
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *NotificationController) Name() string {
+	return "NotificationController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *NotificationController) Inputs() []controller.Input {
+	return []controller.Input{
+		safe.Input[*omni.NotificationConfig](controller.InputWeak),
+		safe.Input[*omni.Cluster](controller.InputWeak),
+		safe.Input[*omni.ClusterMachine](controller.InputWeak),
+		safe.Input[*omni.TalosUpgradeStatus](controller.InputWeak),
+		safe.Input[*omni.KubernetesUpgradeStatus](controller.InputWeak),
+		safe.Input[*omni.EtcdBackupStatus](controller.InputWeak),
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *NotificationController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *NotificationController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// the deliveries in progress are aborted by the context cancellation
+	defer ctrl.deliveries.Wait()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		current, err := ctrl.observe(ctx, r)
+		if err != nil {
+			return err
+		}
+
+		// the first observed state is the baseline, the resources which already exist are not notified
+		if ctrl.observed == nil {
+			ctrl.observed = current
+
+			continue
+		}
+
+		events := ctrl.observed.diff(current)
+
+		ctrl.observed = current
+
+		if len(events) == 0 {
+			continue
+		}
+
+		configs, err := safe.ReaderListAll[*omni.NotificationConfig](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing notification configs: %w", err)
+		}
+
+		for _, event := range events {
+			for iter := configs.Iterator(); iter.Next(); {
+				ctrl.deliver(ctx, logger, iter.Value(), event)
+			}
+		}
+	}
+}
+
+func (ctrl *NotificationController) deliver(ctx context.Context, logger *zap.Logger, config *omni.NotificationConfig, event eventWithType) {
+	spec := config.TypedSpec().Value
+
+	if !notification.Matches(spec, event.eventType) {
+		return
+	}
+
+	logger = logger.With(zap.String("notification_config", config.Metadata().ID()), zap.String("event", event.Type))
+
+	ctrl.deliveries.Add(1)
+
+	panichandler.Go(func() {
+		defer ctrl.deliveries.Done()
+
+		if err := ctrl.sender.Send(ctx, spec, event.Event); err != nil {
+			logger.Warn("failed to deliver the notification", zap.Error(err))
+
+			return
+		}
+
+		logger.Debug("delivered the notification")
+	}, logger)
+}
+
+func (ctrl *NotificationController) observe(ctx context.Context, r controller.Reader) (*notificationState, error) {
+	st := &notificationState{
+		clusters:           map[resource.ID]struct{}{},
+		clusterMachines:    map[resource.ID]string{},
+		talosUpgrades:      map[resource.ID]*specs.TalosUpgradeStatusSpec{},
+		kubernetesUpgrades: map[resource.ID]*specs.KubernetesUpgradeStatusSpec{},
+		etcdBackups:        map[resource.ID]*specs.EtcdBackupStatusSpec{},
+	}
+
+	clusters, err := safe.ReaderListAll[*omni.Cluster](ctx, r)
+	if err != nil {
+		return nil, fmt.Errorf("error listing clusters: %w", err)
+	}
+
+	clusters.ForEach(func(cluster *omni.Cluster) {
+		st.clusters[cluster.Metadata().ID()] = struct{}{}
+	})
+
+	clusterMachines, err := safe.ReaderListAll[*omni.ClusterMachine](ctx, r)
+	if err != nil {
+		return nil, fmt.Errorf("error listing cluster machines: %w", err)
+	}
+
+	clusterMachines.ForEach(func(clusterMachine *omni.ClusterMachine) {
+		clusterName, _ := clusterMachine.Metadata().Labels().Get(omni.LabelCluster)
+
+		st.clusterMachines[clusterMachine.Metadata().ID()] = clusterName
+	})
+
+	talosUpgrades, err := safe.ReaderListAll[*omni.TalosUpgradeStatus](ctx, r)
+	if err != nil {
+		return nil, fmt.Errorf("error listing talos upgrade statuses: %w", err)
+	}
+
+	talosUpgrades.ForEach(func(status *omni.TalosUpgradeStatus) {
+		st.talosUpgrades[status.Metadata().ID()] = status.TypedSpec().Value
+	})
+
+	kubernetesUpgrades, err := safe.ReaderListAll[*omni.KubernetesUpgradeStatus](ctx, r)
+	if err != nil {
+		return nil, fmt.Errorf("error listing kubernetes upgrade statuses: %w", err)
+	}
+
+	kubernetesUpgrades.ForEach(func(status *omni.KubernetesUpgradeStatus) {
+		st.kubernetesUpgrades[status.Metadata().ID()] = status.TypedSpec().Value
+	})
+
+	etcdBackups, err := safe.ReaderListAll[*omni.EtcdBackupStatus](ctx, r)
+	if err != nil {
+		return nil, fmt.Errorf("error listing etcd backup statuses: %w", err)
+	}
+
+	etcdBackups.ForEach(func(status *omni.EtcdBackupStatus) {
+		st.etcdBackups[status.Metadata().ID()] = status.TypedSpec().Value
+	})
+
+	return st, nil
+}
+
+type eventWithType struct {
+	notification.Event
+
+	eventType specs.NotificationConfigSpec_Event
+}
+
+func newEventWithType(eventType specs.NotificationConfigSpec_Event, cluster, machine, format string, args ...any) eventWithType {
+	return eventWithType{
+		Event:     notification.NewEvent(eventType, cluster, machine, fmt.Sprintf(format, args...)),
+		eventType: eventType,
+	}
+}
+
+// diff returns the events which happened between the previous and the current state.
+//
+//nolint:gocognit,gocyclo,cyclop
+func (previous *notificationState) diff(current *notificationState) []eventWithType {
+	var events []eventWithType
+
+	for id := range current.clusters {
+		if _, ok := previous.clusters[id]; !ok {
+			events = append(events, newEventWithType(specs.NotificationConfigSpec_ClusterCreated, id, "", "cluster %q was created", id))
+		}
+	}
+
+	for id := range previous.clusters {
+		if _, ok := current.clusters[id]; !ok {
+			events = append(events, newEventWithType(specs.NotificationConfigSpec_ClusterDestroyed, id, "", "cluster %q was destroyed", id))
+		}
+	}
+
+	for id, cluster := range current.clusterMachines {
+		if _, ok := previous.clusterMachines[id]; !ok {
+			events = append(events, newEventWithType(specs.NotificationConfigSpec_MachineJoined, cluster, id, "machine %q joined the cluster %q", id, cluster))
+		}
+	}
+
+	for id, cluster := range previous.clusterMachines {
+		if _, ok := current.clusterMachines[id]; !ok {
+			events = append(events, newEventWithType(specs.NotificationConfigSpec_MachineLeft, cluster, id, "machine %q left the cluster %q", id, cluster))
+		}
+	}
+
+	for id, status := range current.talosUpgrades {
+		previousPhase := previous.talosUpgrades[id].GetPhase()
+
+		switch {
+		case status.Phase == specs.TalosUpgradeStatusSpec_Upgrading && previousPhase != specs.TalosUpgradeStatusSpec_Upgrading:
+			events = append(events, newEventWithType(specs.NotificationConfigSpec_UpgradeStarted, id, "",
+				"Talos upgrade of the cluster %q to %s started", id, status.CurrentUpgradeVersion))
+		case status.Phase == specs.TalosUpgradeStatusSpec_Done && previousPhase == specs.TalosUpgradeStatusSpec_Upgrading:
+			events = append(events, newEventWithType(specs.NotificationConfigSpec_UpgradeFinished, id, "",
+				"Talos upgrade of the cluster %q to %s finished", id, status.LastUpgradeVersion))
+		case status.Phase == specs.TalosUpgradeStatusSpec_Failed && previousPhase != specs.TalosUpgradeStatusSpec_Failed:
+			events = append(events, newEventWithType(specs.NotificationConfigSpec_UpgradeFinished, id, "",
+				"Talos upgrade of the cluster %q failed: %s", id, status.Error))
+		}
+	}
+
+	for id, status := range current.kubernetesUpgrades {
+		previousPhase := previous.kubernetesUpgrades[id].GetPhase()
+
+		switch {
+		case status.Phase == specs.KubernetesUpgradeStatusSpec_Upgrading && previousPhase != specs.KubernetesUpgradeStatusSpec_Upgrading:
+			events = append(events, newEventWithType(specs.NotificationConfigSpec_UpgradeStarted, id, "",
+				"Kubernetes upgrade of the cluster %q to %s started", id, status.CurrentUpgradeVersion))
+		case status.Phase == specs.KubernetesUpgradeStatusSpec_Done && previousPhase == specs.KubernetesUpgradeStatusSpec_Upgrading:
+			events = append(events, newEventWithType(specs.NotificationConfigSpec_UpgradeFinished, id, "",
+				"Kubernetes upgrade of the cluster %q to %s finished", id, status.LastUpgradeVersion))
+		case status.Phase == specs.KubernetesUpgradeStatusSpec_Failed && previousPhase != specs.KubernetesUpgradeStatusSpec_Failed:
+			events = append(events, newEventWithType(specs.NotificationConfigSpec_UpgradeFinished, id, "",
+				"Kubernetes upgrade of the cluster %q failed: %s", id, status.Error))
+		}
+	}
+
+	for id, status := range current.etcdBackups {
+		if status.Status != specs.EtcdBackupStatusSpec_Error {
+			continue
+		}
+
+		// each failed attempt is notified once
+		if previousStatus, ok := previous.etcdBackups[id]; ok && previousStatus.Status == specs.EtcdBackupStatusSpec_Error &&
+			previousStatus.GetLastBackupAttempt().AsTime().Equal(status.GetLastBackupAttempt().AsTime()) {
+			continue
+		}
+
+		events = append(events, newEventWithType(specs.NotificationConfigSpec_EtcdBackupFailed, id, "",
+			"etcd backup of the cluster %q failed: %s", id, status.Error))
+	}
+
+	return events
+}
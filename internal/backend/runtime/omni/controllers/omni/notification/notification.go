@@ -0,0 +1,188 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package notification renders the cluster lifecycle events and posts them to the webhooks.
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"text/template"
+	"time"
+
+	"github.com/siderolabs/go-retry/retry"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+)
+
+// Event is a cluster lifecycle event, it is the data the payload templates are rendered with.
+type Event struct {
+	Time    time.Time `json:"time"`
+	Type    string    `json:"event"`
+	Cluster string    `json:"cluster,omitempty"`
+	Machine string    `json:"machine,omitempty"`
+	Message string    `json:"message"`
+}
+
+// NewEvent creates a new event which happened now.
+func NewEvent(eventType specs.NotificationConfigSpec_Event, cluster, machine, message string) Event {
+	return Event{
+		Time:    time.Now().UTC(),
+		Type:    eventType.String(),
+		Cluster: cluster,
+		Machine: machine,
+		Message: message,
+	}
+}
+
+var templateFuncs = template.FuncMap{
+	// json encodes the value as JSON, e.g. to quote the strings put into the JSON payloads
+	"json": func(v any) (string, error) {
+		data, err := json.Marshal(v)
+
+		return string(data), err
+	},
+}
+
+// ValidateConfig checks that the webhook URL, the events and the payload template are valid.
+func ValidateConfig(spec *specs.NotificationConfigSpec) error {
+	webhookURL, err := url.Parse(spec.Url)
+	if err != nil {
+		return fmt.Errorf("invalid webhook URL: %w", err)
+	}
+
+	if webhookURL.Scheme != "http" && webhookURL.Scheme != "https" {
+		return errors.New("webhook URL scheme must be http or https")
+	}
+
+	if webhookURL.Host == "" {
+		return errors.New("webhook URL host can not be empty")
+	}
+
+	for _, event := range spec.Events {
+		if _, ok := specs.NotificationConfigSpec_Event_name[int32(event)]; !ok || event == specs.NotificationConfigSpec_Unknown {
+			return fmt.Errorf("unknown event %d", event)
+		}
+	}
+
+	if _, ok := specs.NotificationConfigSpec_Format_name[int32(spec.Format)]; !ok {
+		return fmt.Errorf("unknown format %d", spec.Format)
+	}
+
+	if spec.Template != "" {
+		if _, err = template.New("payload").Funcs(templateFuncs).Parse(spec.Template); err != nil {
+			return fmt.Errorf("invalid payload template: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Matches checks if the webhook is notified about the event.
+func Matches(spec *specs.NotificationConfigSpec, eventType specs.NotificationConfigSpec_Event) bool {
+	return len(spec.Events) == 0 || slices.Contains(spec.Events, eventType)
+}
+
+// Render renders the payload of the event in the format of the webhook.
+func Render(spec *specs.NotificationConfigSpec, event Event) ([]byte, error) {
+	if spec.Template != "" {
+		tmpl, err := template.New("payload").Funcs(templateFuncs).Parse(spec.Template)
+		if err != nil {
+			return nil, fmt.Errorf("invalid payload template: %w", err)
+		}
+
+		var buf bytes.Buffer
+
+		if err = tmpl.Execute(&buf, event); err != nil {
+			return nil, fmt.Errorf("failed to render the payload template: %w", err)
+		}
+
+		return buf.Bytes(), nil
+	}
+
+	switch spec.Format {
+	case specs.NotificationConfigSpec_Slack:
+		return json.Marshal(map[string]string{
+			"text": event.Message,
+		})
+	case specs.NotificationConfigSpec_Generic:
+		return json.Marshal(event)
+	default:
+		return nil, fmt.Errorf("unknown format %d", spec.Format)
+	}
+}
+
+// Sender posts the events to the webhooks.
+type Sender struct {
+	client       *http.Client
+	retryTimeout time.Duration
+	retryUnit    time.Duration
+}
+
+// NewSender creates a new Sender.
+//
+// The failed deliveries are retried with the exponential backoff starting with the retryUnit until the retryTimeout expires.
+func NewSender(retryTimeout, retryUnit time.Duration) *Sender {
+	return &Sender{
+		client: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+		retryTimeout: retryTimeout,
+		retryUnit:    retryUnit,
+	}
+}
+
+// Send renders the event and posts it to the webhook.
+//
+// The network errors, the rate limit responses and the server errors are retried, other client errors are not.
+func (s *Sender) Send(ctx context.Context, spec *specs.NotificationConfigSpec, event Event) error {
+	payload, err := Render(spec, event)
+	if err != nil {
+		return err
+	}
+
+	return retry.Exponential(s.retryTimeout, retry.WithUnits(s.retryUnit), retry.WithJitter(s.retryUnit)).RetryWithContext(ctx, func(ctx context.Context) error {
+		return s.post(ctx, spec, payload)
+	})
+}
+
+func (s *Sender) post(ctx context.Context, spec *specs.NotificationConfigSpec, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, spec.Url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	for name, value := range spec.Headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return retry.ExpectedError(err)
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	// drain the body to reuse the connection
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024)) //nolint:errcheck
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return retry.ExpectedErrorf("webhook responded with %s", resp.Status)
+	default:
+		return fmt.Errorf("webhook responded with %s", resp.Status)
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package notification_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/notification"
+)
+
+func TestValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name          string
+		spec          *specs.NotificationConfigSpec
+		expectedError string
+	}{
+		{
+			name: "valid",
+			spec: &specs.NotificationConfigSpec{
+				Url:      "https://hooks.example.com/services/abc",
+				Events:   []specs.NotificationConfigSpec_Event{specs.NotificationConfigSpec_ClusterCreated},
+				Template: `{"text": {{ json .Message }}}`,
+			},
+		},
+		{
+			name: "bad scheme",
+			spec: &specs.NotificationConfigSpec{
+				Url: "ftp://hooks.example.com",
+			},
+			expectedError: "webhook URL scheme must be http or https",
+		},
+		{
+			name: "no host",
+			spec: &specs.NotificationConfigSpec{
+				Url: "https:///path",
+			},
+			expectedError: "webhook URL host can not be empty",
+		},
+		{
+			name: "unknown event",
+			spec: &specs.NotificationConfigSpec{
+				Url:    "https://hooks.example.com",
+				Events: []specs.NotificationConfigSpec_Event{specs.NotificationConfigSpec_Unknown},
+			},
+			expectedError: "unknown event 0",
+		},
+		{
+			name: "bad template",
+			spec: &specs.NotificationConfigSpec{
+				Url:      "https://hooks.example.com",
+				Template: `{{ .Message`,
+			},
+			expectedError: "invalid payload template",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := notification.ValidateConfig(tt.spec)
+			if tt.expectedError == "" {
+				require.NoError(t, err)
+
+				return
+			}
+
+			assert.ErrorContains(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	event := notification.NewEvent(specs.NotificationConfigSpec_MachineJoined, "talos-default", "machine-1", `machine "machine-1" joined the cluster`)
+
+	payload, err := notification.Render(&specs.NotificationConfigSpec{}, event)
+	require.NoError(t, err)
+
+	var generic map[string]string
+
+	require.NoError(t, json.Unmarshal(payload, &generic))
+	assert.Equal(t, "MachineJoined", generic["event"])
+	assert.Equal(t, "talos-default", generic["cluster"])
+	assert.Equal(t, "machine-1", generic["machine"])
+
+	payload, err = notification.Render(&specs.NotificationConfigSpec{Format: specs.NotificationConfigSpec_Slack}, event)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"text": "machine \"machine-1\" joined the cluster"}`, string(payload))
+
+	payload, err = notification.Render(&specs.NotificationConfigSpec{
+		Format:   specs.NotificationConfigSpec_Slack,
+		Template: `{"content": {{ json .Message }}, "cluster": "{{ .Cluster }}"}`,
+	}, event)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"content": "machine \"machine-1\" joined the cluster", "cluster": "talos-default"}`, string(payload))
+}
+
+func TestSend(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	var (
+		attempts atomic.Int32
+		body     atomic.Value
+	)
+
+	// the webhook fails twice before accepting the payload
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		data, _ := io.ReadAll(r.Body) //nolint:errcheck
+
+		body.Store(string(data))
+	}))
+	t.Cleanup(srv.Close)
+
+	sender := notification.NewSender(5*time.Second, 10*time.Millisecond)
+	spec := &specs.NotificationConfigSpec{
+		Url:     srv.URL,
+		Format:  specs.NotificationConfigSpec_Slack,
+		Headers: map[string]string{"Authorization": "Bearer secret"},
+	}
+
+	require.NoError(t, sender.Send(ctx, spec, notification.NewEvent(specs.NotificationConfigSpec_ClusterCreated, "talos-default", "", "cluster created")))
+	assert.EqualValues(t, 3, attempts.Load())
+	assert.JSONEq(t, `{"text": "cluster created"}`, body.Load().(string)) //nolint:forcetypeassert,errcheck
+
+	// the client errors are not retried
+	spec.Headers = nil
+
+	require.Error(t, sender.Send(ctx, spec, notification.NewEvent(specs.NotificationConfigSpec_ClusterCreated, "talos-default", "", "cluster created")))
+	assert.EqualValues(t, 4, attempts.Load())
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/notification"
+)
+
+type NotificationSuite struct {
+	OmniSuite
+}
+
+func (suite *NotificationSuite) TestReconcile() {
+	ctx, cancel := context.WithTimeout(suite.ctx, time.Second*20)
+	defer cancel()
+
+	received := make(chan notification.Event, 100)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		var event notification.Event
+
+		suite.Assert().NoError(json.NewDecoder(r.Body).Decode(&event))
+
+		received <- event
+	}))
+	defer srv.Close()
+
+	notificationConfig := omni.NewNotificationConfig("webhook")
+	notificationConfig.TypedSpec().Value.Url = srv.URL
+	notificationConfig.TypedSpec().Value.Events = []specs.NotificationConfigSpec_Event{
+		specs.NotificationConfigSpec_ClusterCreated,
+		specs.NotificationConfigSpec_ClusterDestroyed,
+		specs.NotificationConfigSpec_EtcdBackupFailed,
+	}
+
+	suite.Require().NoError(suite.state.Create(ctx, notificationConfig))
+
+	// the clusters which exist when the controller starts are not notified
+	suite.Require().NoError(suite.state.Create(ctx, omni.NewCluster(resources.DefaultNamespace, "existing")))
+
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterController(omnictrl.NewNotificationController(notification.NewSender(time.Second, 10*time.Millisecond))))
+
+	// the first reconcile only records the state, so the clusters are created until the controller starts notifying
+	var clusterName string
+
+	suite.Require().Eventually(func() bool {
+		clusterName = fmt.Sprintf("cluster-%d", time.Now().UnixNano())
+
+		if !suite.Assert().NoError(suite.state.Create(ctx, omni.NewCluster(resources.DefaultNamespace, clusterName))) {
+			return false
+		}
+
+		select {
+		case event := <-received:
+			suite.Assert().Equal("ClusterCreated", event.Type)
+			suite.Assert().NotEqual("existing", event.Cluster)
+
+			clusterName = event.Cluster
+
+			return true
+		case <-time.After(100 * time.Millisecond):
+			return false
+		}
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// drain the events of the clusters created after the notified one
+	for {
+		select {
+		case event := <-received:
+			suite.Require().Equal("ClusterCreated", event.Type)
+
+			continue
+		case <-time.After(200 * time.Millisecond):
+		}
+
+		break
+	}
+
+	// the events which are not configured are not notified
+	clusterMachine := omni.NewClusterMachine(resources.DefaultNamespace, "machine-1")
+	clusterMachine.Metadata().Labels().Set(omni.LabelCluster, clusterName)
+
+	suite.Require().NoError(suite.state.Create(ctx, clusterMachine))
+
+	etcdBackupStatus := omni.NewEtcdBackupStatus(clusterName)
+	etcdBackupStatus.TypedSpec().Value.Status = specs.EtcdBackupStatusSpec_Error
+	etcdBackupStatus.TypedSpec().Value.Error = "bucket not found"
+	etcdBackupStatus.TypedSpec().Value.LastBackupAttempt = timestamppb.Now()
+
+	suite.Require().NoError(suite.state.Create(ctx, etcdBackupStatus))
+
+	suite.assertEvent(received, "EtcdBackupFailed", clusterName)
+
+	rtestutils.Destroy[*omni.Cluster](ctx, suite.T(), suite.state, []string{clusterName})
+
+	suite.assertEvent(received, "ClusterDestroyed", clusterName)
+}
+
+func (suite *NotificationSuite) assertEvent(received <-chan notification.Event, eventType, cluster string) {
+	select {
+	case event := <-received:
+		suite.Assert().Equal(eventType, event.Type)
+		suite.Assert().Equal(cluster, event.Cluster)
+	case <-time.After(5 * time.Second):
+		suite.FailNow("timed out waiting for the event", eventType)
+	}
+}
+
+func TestNotificationSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(NotificationSuite))
+}
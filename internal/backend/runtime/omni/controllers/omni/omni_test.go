@@ -532,6 +532,29 @@ func (suite *OmniSuite) createCluster(clusterName string, controlPlanes, workers
 	return cluster, machines
 }
 
+// createSecretsCluster creates a cluster with the labeled control plane machine set, which is enough for the ClusterSecrets to be generated.
+//
+// It also creates a ClusterMachineConfig of the cluster, which blocks the secrets rotations until it's destroyed.
+func (suite *OmniSuite) createSecretsCluster(clusterName, talosVersion string) *omni.ClusterMachineConfig {
+	cpMachineSet := omni.NewMachineSet(resources.DefaultNamespace, omni.ControlPlanesResourceID(clusterName))
+	cpMachineSet.Metadata().Labels().Set(omni.LabelCluster, clusterName)
+	cpMachineSet.Metadata().Labels().Set(omni.LabelControlPlaneRole, "")
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cpMachineSet))
+
+	machineConfig := omni.NewClusterMachineConfig(resources.DefaultNamespace, clusterName+"-machine-1")
+	machineConfig.Metadata().Labels().Set(omni.LabelCluster, clusterName)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineConfig))
+
+	cluster := omni.NewCluster(resources.DefaultNamespace, clusterName)
+	cluster.TypedSpec().Value.TalosVersion = talosVersion
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cluster))
+
+	return machineConfig
+}
+
 func (suite *OmniSuite) destroyCluster(cluster *omni.Cluster) {
 	suite.destroyClusterByID(cluster.Metadata().ID())
 }
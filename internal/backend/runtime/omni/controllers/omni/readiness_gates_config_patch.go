@@ -0,0 +1,224 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/controller/generic/qtransform"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/xerrors"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/cosi/labels"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/mappers"
+)
+
+// ReadinessGatesConfigPatchPrefix is the prefix for the system config patch that taints the node of the cluster machine which hasn't passed the readiness gates.
+const ReadinessGatesConfigPatchPrefix = "950-readiness-gates-"
+
+// ReadinessGatesConfigPatchController creates the system config patch that keeps the node of the cluster machine unschedulable
+// until the machine passes the readiness gates defined in its machine set.
+//
+// The node is tainted with the NoSchedule taint by Talos, the taint is removed when the patch is removed.
+// The pending gates are listed in the description of the patch.
+type ReadinessGatesConfigPatchController = qtransform.QController[*omni.ClusterMachine, *omni.ConfigPatch]
+
+// NewReadinessGatesConfigPatchController initializes ReadinessGatesConfigPatchController.
+func NewReadinessGatesConfigPatchController() *ReadinessGatesConfigPatchController {
+	return qtransform.NewQController(
+		qtransform.Settings[*omni.ClusterMachine, *omni.ConfigPatch]{
+			Name: "ReadinessGatesConfigPatchController",
+			MapMetadataFunc: func(clusterMachine *omni.ClusterMachine) *omni.ConfigPatch {
+				return omni.NewConfigPatch(resources.DefaultNamespace, ReadinessGatesConfigPatchPrefix+clusterMachine.Metadata().ID())
+			},
+			UnmapMetadataFunc: func(configPatch *omni.ConfigPatch) *omni.ClusterMachine {
+				id := strings.TrimPrefix(configPatch.Metadata().ID(), ReadinessGatesConfigPatchPrefix)
+
+				return omni.NewClusterMachine(resources.DefaultNamespace, id)
+			},
+			TransformExtraOutputFunc: func(ctx context.Context, r controller.ReaderWriter, _ *zap.Logger, clusterMachine *omni.ClusterMachine, configPatch *omni.ConfigPatch) error {
+				clusterName, ok := clusterMachine.Metadata().Labels().Get(omni.LabelCluster)
+				if !ok {
+					return xerrors.NewTaggedf[qtransform.DestroyOutputTag]("cluster machine doesn't have the cluster label")
+				}
+
+				machineSetName, ok := clusterMachine.Metadata().Labels().Get(omni.LabelMachineSet)
+				if !ok {
+					return xerrors.NewTaggedf[qtransform.DestroyOutputTag]("cluster machine doesn't have the machine set label")
+				}
+
+				machineSet, err := safe.ReaderGetByID[*omni.MachineSet](ctx, r, machineSetName)
+				if err != nil {
+					if state.IsNotFoundError(err) {
+						return xerrors.NewTaggedf[qtransform.DestroyOutputTag]("machine set doesn't exist")
+					}
+
+					return err
+				}
+
+				gates := machineSet.TypedSpec().Value.ReadinessGates
+				if gates == nil {
+					return xerrors.NewTaggedf[qtransform.DestroyOutputTag]("machine set doesn't have readiness gates")
+				}
+
+				machineStatus, err := safe.ReaderGetByID[*omni.MachineStatus](ctx, r, clusterMachine.Metadata().ID())
+				if err != nil {
+					if state.IsNotFoundError(err) {
+						return xerrors.NewTagged[qtransform.SkipReconcileTag](err)
+					}
+
+					return err
+				}
+
+				pending, err := pendingReadinessGates(ctx, r, gates, clusterName, machineStatus)
+				if err != nil {
+					return err
+				}
+
+				if len(pending) == 0 {
+					return xerrors.NewTaggedf[qtransform.DestroyOutputTag]("machine passed the readiness gates")
+				}
+
+				patchYAML, err := renderReadinessGatesPatch()
+				if err != nil {
+					return err
+				}
+
+				configPatch.Metadata().Labels().Set(omni.LabelSystemPatch, "")
+				configPatch.Metadata().Labels().Set(omni.LabelCluster, clusterName)
+				configPatch.Metadata().Labels().Set(omni.LabelClusterMachine, clusterMachine.Metadata().ID())
+
+				configPatch.Metadata().Annotations().Set(omni.ConfigPatchDescription, "Pending readiness gates: "+strings.Join(pending, "; "))
+
+				configPatch.TypedSpec().Value.Data = patchYAML
+
+				return nil
+			},
+		},
+		qtransform.WithExtraMappedInput(
+			mappers.MapMachineSetToLabeledResources[*omni.MachineSet, *omni.ClusterMachine](),
+		),
+		qtransform.WithExtraMappedInput(
+			mappers.MapClusterResourceToLabeledResources[*omni.Cluster, *omni.ClusterMachine](),
+		),
+		qtransform.WithExtraMappedInput(
+			qtransform.MapperSameID[*omni.MachineStatus, *omni.ClusterMachine](),
+		),
+		qtransform.WithExtraMappedInput(
+			qtransform.MapperSameID[*omni.ClusterMachineConfigStatus, *omni.ClusterMachine](),
+		),
+		qtransform.WithConcurrency(2),
+		qtransform.WithOutputKind(controller.OutputShared),
+	)
+}
+
+// pendingReadinessGates returns the descriptions of the readiness gates the machine hasn't passed.
+func pendingReadinessGates(ctx context.Context, r controller.Reader, gates *specs.MachineSetSpec_ReadinessGates, clusterName string, machineStatus *omni.MachineStatus) ([]string, error) {
+	var pending []string
+
+	if len(gates.Extensions) > 0 {
+		schematic := machineStatus.TypedSpec().Value.Schematic
+
+		for _, extension := range gates.Extensions {
+			if schematic == nil || !slices.Contains(schematic.Extensions, extension) {
+				pending = append(pending, fmt.Sprintf("extension %q is not installed", extension))
+			}
+		}
+	}
+
+	if gates.DiskEncryption {
+		encrypted, err := diskEncryptionActive(ctx, r, clusterName, machineStatus.Metadata().ID())
+		if err != nil {
+			return nil, err
+		}
+
+		if !encrypted {
+			pending = append(pending, "disk encryption is not active")
+		}
+	}
+
+	for _, probe := range gates.Probes {
+		query, err := labels.ParseQuery(probe)
+		if err != nil {
+			return nil, fmt.Errorf("invalid readiness gate probe selector %q: %w", probe, err)
+		}
+
+		if !matchesLabelQuery(machineStatus.Metadata().Labels(), query) {
+			pending = append(pending, fmt.Sprintf("probe %q is not passed", probe))
+		}
+	}
+
+	return pending, nil
+}
+
+// diskEncryptionActive checks that the cluster has the disk encryption enabled and the machine runs the config generated with it.
+func diskEncryptionActive(ctx context.Context, r controller.Reader, clusterName, machineID string) (bool, error) {
+	cluster, err := safe.ReaderGetByID[*omni.Cluster](ctx, r, clusterName)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	if !cluster.TypedSpec().Value.GetFeatures().GetDiskEncryption() {
+		return false, nil
+	}
+
+	configStatus, err := safe.ReaderGetByID[*omni.ClusterMachineConfigStatus](ctx, r, machineID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return configStatus.TypedSpec().Value.ClusterMachineConfigSha256 != "" && configStatus.TypedSpec().Value.LastConfigError == "", nil
+}
+
+func matchesLabelQuery(resourceLabels *resource.Labels, query *resource.LabelQuery) bool {
+	for _, term := range query.Terms {
+		if !resourceLabels.Matches(term) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func renderReadinessGatesPatch() (string, error) {
+	var sb strings.Builder
+
+	encoder := yaml.NewEncoder(&sb)
+
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(map[string]any{
+		"machine": map[string]any{
+			"nodeTaints": map[string]string{
+				omni.ReadinessGatesTaint: "NoSchedule",
+			},
+		},
+	}); err != nil {
+		return "", errors.Join(errors.New("failed to encode patch"), err)
+	}
+
+	return sb.String(), nil
+}
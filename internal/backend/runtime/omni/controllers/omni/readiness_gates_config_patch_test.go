@@ -0,0 +1,124 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+)
+
+type ReadinessGatesConfigPatchSuite struct {
+	OmniSuite
+}
+
+func (suite *ReadinessGatesConfigPatchSuite) TestReconcile() {
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewReadinessGatesConfigPatchController()))
+
+	cluster := omni.NewCluster(resources.DefaultNamespace, "test-cluster")
+	cluster.TypedSpec().Value.Features = &specs.ClusterSpec_Features{DiskEncryption: true}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cluster))
+
+	machineSet := omni.NewMachineSet(resources.DefaultNamespace, "test-cluster-workers")
+	machineSet.TypedSpec().Value.ReadinessGates = &specs.MachineSetSpec_ReadinessGates{
+		Extensions:     []string{"siderolabs/gvisor"},
+		DiskEncryption: true,
+		Probes:         []string{"compliance.example.com/cis=passed"},
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineSet))
+
+	machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, "machine-1")
+	machineStatus.TypedSpec().Value.Schematic = &specs.MachineStatusSpec_Schematic{
+		Extensions: []string{"siderolabs/gvisor"},
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineStatus))
+
+	clusterMachine := omni.NewClusterMachine(resources.DefaultNamespace, "machine-1")
+	clusterMachine.Metadata().Labels().Set(omni.LabelCluster, cluster.Metadata().ID())
+	clusterMachine.Metadata().Labels().Set(omni.LabelMachineSet, machineSet.Metadata().ID())
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, clusterMachine))
+
+	patchID := omnictrl.ReadinessGatesConfigPatchPrefix + clusterMachine.Metadata().ID()
+
+	// the config isn't applied and the probe isn't passed, so the node is tainted
+	rtestutils.AssertResource[*omni.ConfigPatch](suite.ctx, suite.T(), suite.state, patchID, func(r *omni.ConfigPatch, assertion *assert.Assertions) {
+		clusterMachineID, _ := r.Metadata().Labels().Get(omni.LabelClusterMachine)
+		description, _ := r.Metadata().Annotations().Get(omni.ConfigPatchDescription)
+
+		assertion.Equal(clusterMachine.Metadata().ID(), clusterMachineID)
+		assertion.Contains(description, "disk encryption")
+		assertion.Contains(description, "compliance.example.com/cis=passed")
+		assertion.NotContains(description, "siderolabs/gvisor")
+		assertion.Equal(`machine:
+  nodeTaints:
+    omni.sidero.dev/readiness-gates: NoSchedule
+`, r.TypedSpec().Value.Data)
+	})
+
+	configStatus := omni.NewClusterMachineConfigStatus(resources.DefaultNamespace, clusterMachine.Metadata().ID())
+	configStatus.TypedSpec().Value.ClusterMachineConfigSha256 = "abcd"
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, configStatus))
+
+	rtestutils.AssertResource[*omni.ConfigPatch](suite.ctx, suite.T(), suite.state, patchID, func(r *omni.ConfigPatch, assertion *assert.Assertions) {
+		description, _ := r.Metadata().Annotations().Get(omni.ConfigPatchDescription)
+
+		assertion.NotContains(description, "disk encryption")
+		assertion.Contains(description, "compliance.example.com/cis=passed")
+	})
+
+	_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, machineStatus.Metadata(), func(res *omni.MachineStatus) error {
+		res.Metadata().Labels().Set("compliance.example.com/cis", "passed")
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	// all gates are passed, the taint is removed
+	rtestutils.AssertNoResource[*omni.ConfigPatch](suite.ctx, suite.T(), suite.state, patchID)
+
+	_, err = safe.StateUpdateWithConflicts(suite.ctx, suite.state, machineSet.Metadata(), func(res *omni.MachineSet) error {
+		res.TypedSpec().Value.ReadinessGates.Extensions = append(res.TypedSpec().Value.ReadinessGates.Extensions, "siderolabs/nvidia-container-toolkit")
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	rtestutils.AssertResource[*omni.ConfigPatch](suite.ctx, suite.T(), suite.state, patchID, func(r *omni.ConfigPatch, assertion *assert.Assertions) {
+		description, _ := r.Metadata().Annotations().Get(omni.ConfigPatchDescription)
+
+		assertion.Equal(`Pending readiness gates: extension "siderolabs/nvidia-container-toolkit" is not installed`, description)
+	})
+
+	_, err = safe.StateUpdateWithConflicts(suite.ctx, suite.state, machineSet.Metadata(), func(res *omni.MachineSet) error {
+		res.TypedSpec().Value.ReadinessGates = nil
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	rtestutils.AssertNoResource[*omni.ConfigPatch](suite.ctx, suite.T(), suite.state, patchID)
+}
+
+func TestReadinessGatesConfigPatchSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(ReadinessGatesConfigPatchSuite))
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+// TunableValues are the timeouts and the intervals of the controllers.
+type TunableValues struct {
+	// MachineTeardownTimeout limits the removal of the discovery service affiliate and the Kubernetes node of a removed cluster machine.
+	MachineTeardownTimeout time.Duration
+	// EtcdMemberRemoveTimeout is the time an etcd member should stay orphaned before it's removed from the etcd cluster.
+	EtcdMemberRemoveTimeout time.Duration
+	// KubernetesNodeDeleteTimeout is the time a Kubernetes node without a cluster machine should exist before it's deleted.
+	KubernetesNodeDeleteTimeout time.Duration
+	// MachineSetStatusPollInterval is the interval of the machine set status checks while the machine set is changing.
+	MachineSetStatusPollInterval time.Duration
+	// UpgradeQueuePollInterval is the interval of the checks of the clusters waiting in the upgrade queue.
+	UpgradeQueuePollInterval time.Duration
+}
+
+// DefaultTunableValues returns the default timeouts and intervals of the controllers.
+func DefaultTunableValues() TunableValues {
+	return TunableValues{
+		MachineTeardownTimeout:       20 * time.Second,
+		EtcdMemberRemoveTimeout:      time.Minute,
+		KubernetesNodeDeleteTimeout:  time.Minute,
+		MachineSetStatusPollInterval: 30 * time.Second,
+		UpgradeQueuePollInterval:     15 * time.Second,
+	}
+}
+
+// Tunables keeps the timeouts and the intervals of the controllers, which can be overridden at runtime by the [omni.RuntimeConfiguration].
+//
+// A nil Tunables always returns the default values.
+type Tunables struct {
+	values   atomic.Pointer[TunableValues]
+	defaults TunableValues
+}
+
+// NewTunables creates new Tunables, the zero fields of the defaults are replaced by [DefaultTunableValues].
+func NewTunables(defaults TunableValues) *Tunables {
+	tunables := &Tunables{
+		defaults: mergeTunableValues(DefaultTunableValues(), defaults),
+	}
+
+	tunables.values.Store(&tunables.defaults)
+
+	return tunables
+}
+
+// Get returns the current values.
+func (t *Tunables) Get() TunableValues {
+	if t == nil {
+		return DefaultTunableValues()
+	}
+
+	return *t.values.Load()
+}
+
+// Set overrides the defaults with the values set in the runtime configuration, a nil spec resets the values to the defaults.
+func (t *Tunables) Set(spec *specs.RuntimeConfigurationSpec) {
+	values := mergeTunableValues(t.defaults, TunableValues{
+		MachineTeardownTimeout:       durationValue(spec.GetMachineTeardownTimeout()),
+		EtcdMemberRemoveTimeout:      durationValue(spec.GetEtcdMemberRemoveTimeout()),
+		KubernetesNodeDeleteTimeout:  durationValue(spec.GetKubernetesNodeDeleteTimeout()),
+		MachineSetStatusPollInterval: durationValue(spec.GetMachineSetStatusPollInterval()),
+		UpgradeQueuePollInterval:     durationValue(spec.GetUpgradeQueuePollInterval()),
+	})
+
+	t.values.Store(&values)
+}
+
+func mergeTunableValues(values, overrides TunableValues) TunableValues {
+	mergeDuration := func(value *time.Duration, override time.Duration) {
+		if override > 0 {
+			*value = override
+		}
+	}
+
+	mergeDuration(&values.MachineTeardownTimeout, overrides.MachineTeardownTimeout)
+	mergeDuration(&values.EtcdMemberRemoveTimeout, overrides.EtcdMemberRemoveTimeout)
+	mergeDuration(&values.KubernetesNodeDeleteTimeout, overrides.KubernetesNodeDeleteTimeout)
+	mergeDuration(&values.MachineSetStatusPollInterval, overrides.MachineSetStatusPollInterval)
+	mergeDuration(&values.UpgradeQueuePollInterval, overrides.UpgradeQueuePollInterval)
+
+	return values
+}
+
+func durationValue(d *durationpb.Duration) time.Duration {
+	if d == nil {
+		return 0
+	}
+
+	return d.AsDuration()
+}
+
+// RuntimeConfigurationController applies the [omni.RuntimeConfiguration] to the [Tunables] of the controllers.
+type RuntimeConfigurationController struct {
+	tunables *Tunables
+}
+
+// NewRuntimeConfigurationController creates new RuntimeConfigurationController.
+func NewRuntimeConfigurationController(tunables *Tunables) *RuntimeConfigurationController {
+	return &RuntimeConfigurationController{
+		tunables: tunables,
+	}
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *RuntimeConfigurationController) Name() string {
+	return "RuntimeConfigurationController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *RuntimeConfigurationController) Inputs() []controller.Input {
+	return []controller.Input{
+		safe.Input[*omni.RuntimeConfiguration](controller.InputWeak),
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *RuntimeConfigurationController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *RuntimeConfigurationController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		var spec *specs.RuntimeConfigurationSpec
+
+		runtimeConfiguration, err := safe.ReaderGetByID[*omni.RuntimeConfiguration](ctx, r, omni.RuntimeConfigurationID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting runtime configuration: %w", err)
+		}
+
+		if runtimeConfiguration != nil {
+			spec = runtimeConfiguration.TypedSpec().Value
+		}
+
+		ctrl.tunables.Set(spec)
+
+		logger.Info("applied the runtime configuration", zap.Any("values", ctrl.tunables.Get()))
+	}
+}
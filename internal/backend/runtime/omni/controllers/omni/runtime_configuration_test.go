@@ -0,0 +1,65 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+)
+
+type RuntimeConfigurationSuite struct {
+	OmniSuite
+}
+
+func (suite *RuntimeConfigurationSuite) TestReconcile() {
+	suite.startRuntime()
+
+	tunables := omnictrl.NewTunables(omnictrl.TunableValues{
+		MachineTeardownTimeout: time.Minute,
+	})
+
+	suite.Require().NoError(suite.runtime.RegisterController(omnictrl.NewRuntimeConfigurationController(tunables)))
+
+	defaults := omnictrl.DefaultTunableValues()
+	defaults.MachineTeardownTimeout = time.Minute
+
+	suite.Require().Equal(defaults, tunables.Get())
+
+	runtimeConfiguration := omni.NewRuntimeConfiguration()
+	runtimeConfiguration.TypedSpec().Value.EtcdMemberRemoveTimeout = durationpb.New(5 * time.Minute)
+	runtimeConfiguration.TypedSpec().Value.UpgradeQueuePollInterval = durationpb.New(time.Minute)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, runtimeConfiguration))
+
+	expected := defaults
+	expected.EtcdMemberRemoveTimeout = 5 * time.Minute
+	expected.UpgradeQueuePollInterval = time.Minute
+
+	suite.EventuallyWithT(func(collect *assert.CollectT) {
+		assert.Equal(collect, expected, tunables.Get())
+	}, time.Second*5, time.Millisecond*100)
+
+	rtestutils.Destroy[*omni.RuntimeConfiguration](suite.ctx, suite.T(), suite.state, []resource.ID{omni.RuntimeConfigurationID})
+
+	suite.EventuallyWithT(func(collect *assert.CollectT) {
+		assert.Equal(collect, defaults, tunables.Get())
+	}, time.Second*5, time.Millisecond*100)
+}
+
+func TestRuntimeConfigurationSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(RuntimeConfigurationSuite))
+}
@@ -35,6 +35,8 @@ import (
 const SchematicConfigurationControllerName = "SchematicConfigurationController"
 
 // SchematicConfigurationController combines MachineExtensions resource, MachineStatus overlay into SchematicConfiguration for each existing ClusterMachine.
+// The machines without MachineExtensions use the default extensions captured by their machine set.
+// The kernel arguments of the cluster and the machine set KernelArgsConfigurations are appended to the schematic kernel arguments.
 // Ensures schematic exists in the image factory.
 type SchematicConfigurationController = qtransform.QController[*omni.ClusterMachine, *omni.SchematicConfiguration]
 
@@ -103,6 +105,12 @@ func NewSchematicConfigurationController(imageFactoryClient SchematicEnsurer) *S
 		qtransform.WithExtraMappedInput(
 			qtransform.MapperSameID[*omni.MachineExtensions, *omni.ClusterMachine](),
 		),
+		qtransform.WithExtraMappedInput(
+			mappers.MapMachineSetToLabeledResources[*omni.MachineSetDefaultExtensions, *omni.ClusterMachine](),
+		),
+		qtransform.WithExtraMappedInput(
+			mappers.MapClusterLabelToLabeledResources[*omni.KernelArgsConfiguration, *omni.ClusterMachine](),
+		),
 		qtransform.WithExtraMappedInput(
 			qtransform.MapperNone[*siderolink.ConnectionParams](),
 		),
@@ -185,11 +193,23 @@ func (helper *schematicConfigurationHelper) reconcile(
 		return nil, err
 	}
 
-	machineExtensions, err := newMachineExtensions(cluster, ms, extensions, secureBootStatus.Enabled)
+	defaultExtensions, err := getMachineSetDefaultExtensions(ctx, r, clusterMachine)
 	if err != nil {
 		return nil, err
 	}
 
+	machineExtensions, err := newMachineExtensions(cluster, ms, extensions, defaultExtensions, secureBootStatus.Enabled)
+	if err != nil {
+		return nil, err
+	}
+
+	customKernelArgs, err := getCustomKernelArgs(ctx, r, clusterMachine)
+	if err != nil {
+		return nil, err
+	}
+
+	machineExtensions.setCustomKernelArgs(customKernelArgs)
+
 	schematicConfiguration.TypedSpec().Value.TalosVersion = cluster.TypedSpec().Value.TalosVersion
 
 	if !shouldGenerateSchematicID(cluster, machineExtensions, ms, overlay) {
@@ -269,6 +289,58 @@ func getOverlay(ms *omni.MachineStatus) schematic.Overlay {
 	}
 }
 
+func getMachineSetDefaultExtensions(ctx context.Context, r controller.Reader, clusterMachine *omni.ClusterMachine) ([]string, error) {
+	machineSet, ok := clusterMachine.Metadata().Labels().Get(omni.LabelMachineSet)
+	if !ok {
+		return nil, nil
+	}
+
+	defaultExtensions, err := safe.ReaderGetByID[*omni.MachineSetDefaultExtensions](ctx, r, machineSet)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return defaultExtensions.TypedSpec().Value.Extensions, nil
+}
+
+// getCustomKernelArgs renders the kernel arguments of the cluster machine, the machine set configurations are applied after the cluster ones.
+func getCustomKernelArgs(ctx context.Context, r controller.Reader, clusterMachine *omni.ClusterMachine) ([]string, error) {
+	clusterName, ok := clusterMachine.Metadata().Labels().Get(omni.LabelCluster)
+	if !ok {
+		return nil, nil
+	}
+
+	machineSet, _ := clusterMachine.Metadata().Labels().Get(omni.LabelMachineSet)
+
+	list, err := safe.ReaderListAll[*omni.KernelArgsConfiguration](ctx, r, state.WithLabelQuery(resource.LabelEqual(omni.LabelCluster, clusterName)))
+	if err != nil {
+		return nil, err
+	}
+
+	var clusterConfigs, machineSetConfigs []*omni.KernelArgsConfiguration
+
+	list.ForEach(func(configuration *omni.KernelArgsConfiguration) {
+		if configuration.Metadata().Phase() == resource.PhaseTearingDown {
+			return
+		}
+
+		configMachineSet, ok := configuration.Metadata().Labels().Get(omni.LabelMachineSet)
+
+		switch {
+		case !ok:
+			clusterConfigs = append(clusterConfigs, configuration)
+		case configMachineSet == machineSet:
+			machineSetConfigs = append(machineSetConfigs, configuration)
+		}
+	})
+
+	return omni.RenderKernelArgs(slices.Concat(clusterConfigs, machineSetConfigs)...), nil
+}
+
 func updateFinalizers(ctx context.Context, r controller.ReaderWriter, extensions *omni.MachineExtensions) error {
 	if extensions.Metadata().Phase() == resource.PhaseTearingDown {
 		return r.RemoveFinalizer(ctx, extensions.Metadata(), SchematicConfigurationControllerName)
@@ -289,9 +361,10 @@ type machineExtensions struct {
 	detectedExtensions []string
 	kernelArgs         []string
 	meta               []schematic.MetaValue
+	customKernelArgs   bool
 }
 
-func newMachineExtensions(cluster *omni.Cluster, machineStatus *omni.MachineStatus, extensions *omni.MachineExtensions, secureBootEnabled bool) (machineExtensions, error) {
+func newMachineExtensions(cluster *omni.Cluster, machineStatus *omni.MachineStatus, extensions *omni.MachineExtensions, defaultExtensions []string, secureBootEnabled bool) (machineExtensions, error) {
 	me := machineExtensions{
 		machineStatus: machineStatus,
 		cluster:       cluster,
@@ -326,14 +399,19 @@ func newMachineExtensions(cluster *omni.Cluster, machineStatus *omni.MachineStat
 
 	me.extensionsList = append(me.extensionsList, detected...)
 
+	// the extensions configuration overrides the default extensions
+	requestedExtensions := defaultExtensions
+
 	if me.machineExtensions != nil {
-		for _, e := range me.machineExtensions.TypedSpec().Value.Extensions {
-			if slices.Contains(me.extensionsList, e) {
-				continue
-			}
+		requestedExtensions = me.machineExtensions.TypedSpec().Value.Extensions
+	}
 
-			me.extensionsList = append(me.extensionsList, e)
+	for _, e := range requestedExtensions {
+		if slices.Contains(me.extensionsList, e) {
+			continue
 		}
+
+		me.extensionsList = append(me.extensionsList, e)
 	}
 
 	slices.Sort(me.extensionsList)
@@ -341,10 +419,21 @@ func newMachineExtensions(cluster *omni.Cluster, machineStatus *omni.MachineStat
 	return me, nil
 }
 
+// setCustomKernelArgs appends the custom kernel arguments after the kernel arguments of the machine.
+func (m *machineExtensions) setCustomKernelArgs(args []string) {
+	if len(args) == 0 {
+		return
+	}
+
+	m.kernelArgs = slices.Concat(m.kernelArgs, args)
+	m.customKernelArgs = true
+}
+
 func (m machineExtensions) shouldGenerateSchematic() bool {
 	// generate schematic for the machine extensions when either machine extensions exists
-	// and contains the explicit empty list for the schematics, or when schematic list is not empty
-	return m.machineExtensions != nil || len(m.extensionsList) != 0
+	// and contains the explicit empty list for the schematics, or when schematic list is not empty,
+	// or when the custom kernel arguments are set
+	return m.machineExtensions != nil || len(m.extensionsList) != 0 || m.customKernelArgs
 }
 
 func (m *machineExtensions) isDetected(value string) bool {
@@ -12,6 +12,7 @@ import (
 
 	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
 	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/siderolabs/image-factory/pkg/schematic"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 
@@ -231,6 +232,231 @@ func (suite *SchematicConfigurationSuite) TestReconcile() {
 	)
 }
 
+func (suite *SchematicConfigurationSuite) TestDefaultExtensions() {
+	ctx, cancel := context.WithTimeout(suite.ctx, time.Second*10)
+	defer cancel()
+
+	factory := imageFactoryMock{}
+	suite.Require().NoError(factory.run())
+
+	factory.serve(ctx)
+
+	defer func() {
+		cancel()
+
+		factory.eg.Wait() //nolint:errcheck
+	}()
+
+	imageFactoryClient, err := imagefactory.NewClient(suite.state, factory.address)
+	suite.Require().NoError(err)
+
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewSchematicConfigurationController(imageFactoryClient)))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewMachineExtensionsController()))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewMachineSetDefaultExtensionsController()))
+
+	machineName := "machine1"
+	clusterName := "cluster"
+
+	defaultExtensions := omni.NewDefaultExtensions()
+	defaultExtensions.TypedSpec().Value.Extensions = []string{"siderolabs/something"}
+
+	suite.Require().NoError(suite.state.Create(ctx, defaultExtensions))
+
+	cluster := omni.NewCluster(resources.DefaultNamespace, clusterName)
+	cluster.TypedSpec().Value.TalosVersion = "1.7.0"
+
+	suite.Require().NoError(suite.state.Create(ctx, cluster))
+
+	machineSet := omni.NewMachineSet(resources.DefaultNamespace, omni.WorkersResourceID(clusterName))
+	machineSet.Metadata().Labels().Set(omni.LabelCluster, clusterName)
+
+	suite.Require().NoError(suite.state.Create(ctx, machineSet))
+
+	rtestutils.AssertResources(ctx, suite.T(), suite.state, []string{machineSet.Metadata().ID()},
+		func(res *omni.MachineSetDefaultExtensions, assertion *assert.Assertions) {
+			assertion.Equal([]string{"siderolabs/something"}, res.TypedSpec().Value.Extensions)
+
+			clusterLabel, _ := res.Metadata().Labels().Get(omni.LabelCluster)
+			assertion.Equal(clusterName, clusterLabel)
+		},
+	)
+
+	machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, machineName)
+	machineStatus.TypedSpec().Value.Schematic = &specs.MachineStatusSpec_Schematic{
+		InitialSchematic: "00000",
+	}
+	machineStatus.TypedSpec().Value.InitialTalosVersion = "1.7.0"
+	machineStatus.TypedSpec().Value.SecureBootStatus = &specs.SecureBootStatus{
+		Enabled: false,
+	}
+
+	clusterMachine := omni.NewClusterMachine(resources.DefaultNamespace, machineName)
+	clusterMachine.Metadata().Labels().Set(omni.LabelCluster, clusterName)
+	clusterMachine.Metadata().Labels().Set(omni.LabelMachineSet, machineSet.Metadata().ID())
+
+	suite.Require().NoError(suite.state.Create(ctx, machineStatus))
+	suite.Require().NoError(suite.state.Create(ctx, clusterMachine))
+
+	// the default extensions are installed
+	rtestutils.AssertResources(ctx, suite.T(), suite.state, []string{machineName},
+		func(schematicConfiguration *omni.SchematicConfiguration, assertion *assert.Assertions) {
+			assertion.Equal("df7c842f133b05c875f2139ea94b09eae3d425e00a95e6f9f54552f442d9f8c0", schematicConfiguration.TypedSpec().Value.SchematicId)
+		},
+	)
+
+	// the changes of the defaults are applied only to the new machine sets
+	_, err = safe.StateUpdateWithConflicts(ctx, suite.state, defaultExtensions.Metadata(), func(res *omni.DefaultExtensions) error {
+		res.TypedSpec().Value.Extensions = []string{"siderolabs/x11"}
+
+		return nil
+	})
+
+	suite.Require().NoError(err)
+
+	controlPlanes := omni.NewMachineSet(resources.DefaultNamespace, omni.ControlPlanesResourceID(clusterName))
+	controlPlanes.Metadata().Labels().Set(omni.LabelCluster, clusterName)
+
+	suite.Require().NoError(suite.state.Create(ctx, controlPlanes))
+
+	rtestutils.AssertResources(ctx, suite.T(), suite.state, []string{controlPlanes.Metadata().ID()},
+		func(res *omni.MachineSetDefaultExtensions, assertion *assert.Assertions) {
+			assertion.Equal([]string{"siderolabs/x11"}, res.TypedSpec().Value.Extensions)
+		},
+	)
+
+	rtestutils.AssertResources(ctx, suite.T(), suite.state, []string{machineSet.Metadata().ID()},
+		func(res *omni.MachineSetDefaultExtensions, assertion *assert.Assertions) {
+			assertion.Equal([]string{"siderolabs/something"}, res.TypedSpec().Value.Extensions)
+		},
+	)
+
+	// the extensions configuration overrides the defaults
+	extensionsConfiguration := omni.NewExtensionsConfiguration(resources.DefaultNamespace, "test")
+	extensionsConfiguration.TypedSpec().Value.Extensions = []string{}
+	extensionsConfiguration.Metadata().Labels().Set(omni.LabelCluster, clusterName)
+
+	suite.Require().NoError(suite.state.Create(ctx, extensionsConfiguration))
+
+	rtestutils.AssertResources(ctx, suite.T(), suite.state, []string{machineName},
+		func(schematicConfiguration *omni.SchematicConfiguration, assertion *assert.Assertions) {
+			assertion.Equal("376567988ad370138ad8b2698212367b8edcb69b5fd68c80be1f2ec7d603b4ba", schematicConfiguration.TypedSpec().Value.SchematicId)
+		},
+	)
+}
+
+func (suite *SchematicConfigurationSuite) TestKernelArgs() {
+	ctx, cancel := context.WithTimeout(suite.ctx, time.Second*10)
+	defer cancel()
+
+	factory := imageFactoryMock{}
+	suite.Require().NoError(factory.run())
+
+	factory.serve(ctx)
+
+	defer func() {
+		cancel()
+
+		factory.eg.Wait() //nolint:errcheck
+	}()
+
+	imageFactoryClient, err := imagefactory.NewClient(suite.state, factory.address)
+	suite.Require().NoError(err)
+
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewSchematicConfigurationController(imageFactoryClient)))
+
+	machineName := "machine1"
+	clusterName := "cluster"
+	machineSetName := omni.WorkersResourceID(clusterName)
+
+	schematicID := func(kernelArgs ...string) string {
+		sch := schematic.Schematic{
+			Customization: schematic.Customization{
+				ExtraKernelArgs: kernelArgs,
+			},
+		}
+
+		id, idErr := sch.ID()
+
+		suite.Require().NoError(idErr)
+
+		return id
+	}
+
+	cluster := omni.NewCluster(resources.DefaultNamespace, clusterName)
+	cluster.TypedSpec().Value.TalosVersion = "1.7.0"
+
+	suite.Require().NoError(suite.state.Create(ctx, cluster))
+
+	machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, machineName)
+	machineStatus.TypedSpec().Value.Schematic = &specs.MachineStatusSpec_Schematic{
+		InitialSchematic: "00000",
+	}
+	machineStatus.TypedSpec().Value.InitialTalosVersion = "1.7.0"
+	machineStatus.TypedSpec().Value.SecureBootStatus = &specs.SecureBootStatus{
+		Enabled: false,
+	}
+
+	clusterMachine := omni.NewClusterMachine(resources.DefaultNamespace, machineName)
+	clusterMachine.Metadata().Labels().Set(omni.LabelCluster, clusterName)
+	clusterMachine.Metadata().Labels().Set(omni.LabelMachineSet, machineSetName)
+
+	suite.Require().NoError(suite.state.Create(ctx, machineStatus))
+	suite.Require().NoError(suite.state.Create(ctx, clusterMachine))
+
+	rtestutils.AssertResources(ctx, suite.T(), suite.state, []string{machineName},
+		func(schematicConfiguration *omni.SchematicConfiguration, assertion *assert.Assertions) {
+			assertion.Equal("00000", schematicConfiguration.TypedSpec().Value.SchematicId)
+		},
+	)
+
+	clusterKernelArgs := omni.NewKernelArgsConfiguration(resources.DefaultNamespace, "cluster-kernel-args")
+	clusterKernelArgs.Metadata().Labels().Set(omni.LabelCluster, clusterName)
+	clusterKernelArgs.TypedSpec().Value.Add = []string{"console=ttyS0"}
+
+	suite.Require().NoError(suite.state.Create(ctx, clusterKernelArgs))
+
+	rtestutils.AssertResources(ctx, suite.T(), suite.state, []string{machineName},
+		func(schematicConfiguration *omni.SchematicConfiguration, assertion *assert.Assertions) {
+			assertion.Equal(schematicID("console=ttyS0"), schematicConfiguration.TypedSpec().Value.SchematicId)
+		},
+	)
+
+	// the machine set kernel args are applied on top of the cluster ones
+	machineSetKernelArgs := omni.NewKernelArgsConfiguration(resources.DefaultNamespace, "machine-set-kernel-args")
+	machineSetKernelArgs.Metadata().Labels().Set(omni.LabelCluster, clusterName)
+	machineSetKernelArgs.Metadata().Labels().Set(omni.LabelMachineSet, machineSetName)
+	machineSetKernelArgs.TypedSpec().Value.Add = []string{"console=tty0"}
+	machineSetKernelArgs.TypedSpec().Value.Remove = []string{"console"}
+
+	suite.Require().NoError(suite.state.Create(ctx, machineSetKernelArgs))
+
+	rtestutils.AssertResources(ctx, suite.T(), suite.state, []string{machineName},
+		func(schematicConfiguration *omni.SchematicConfiguration, assertion *assert.Assertions) {
+			assertion.Equal(schematicID("console=ttyS0", "-console", "console=tty0"), schematicConfiguration.TypedSpec().Value.SchematicId)
+		},
+	)
+
+	// the kernel args of the other machine sets are ignored
+	otherKernelArgs := omni.NewKernelArgsConfiguration(resources.DefaultNamespace, "other-kernel-args")
+	otherKernelArgs.Metadata().Labels().Set(omni.LabelCluster, clusterName)
+	otherKernelArgs.Metadata().Labels().Set(omni.LabelMachineSet, omni.ControlPlanesResourceID(clusterName))
+	otherKernelArgs.TypedSpec().Value.Add = []string{"nomodeset"}
+
+	suite.Require().NoError(suite.state.Create(ctx, otherKernelArgs))
+
+	rtestutils.Destroy[*omni.KernelArgsConfiguration](ctx, suite.T(), suite.state, []string{clusterKernelArgs.Metadata().ID()})
+
+	rtestutils.AssertResources(ctx, suite.T(), suite.state, []string{machineName},
+		func(schematicConfiguration *omni.SchematicConfiguration, assertion *assert.Assertions) {
+			assertion.Equal(schematicID("-console", "console=tty0"), schematicConfiguration.TypedSpec().Value.SchematicId)
+		},
+	)
+}
+
 func TestSchematicConfigurationSuite(t *testing.T) {
 	t.Parallel()
 
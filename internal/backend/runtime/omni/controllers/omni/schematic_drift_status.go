@@ -0,0 +1,138 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/controller/generic/qtransform"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/xerrors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/helpers"
+)
+
+// SchematicDriftStatusController manages SchematicDriftStatus resource lifecycle.
+//
+// SchematicDriftStatusController compares the schematic running on each cluster machine with the desired SchematicConfiguration.
+// The machine is drifted if it was already upgraded to the desired schematic by Omni, but it reports another schematic now.
+type SchematicDriftStatusController = qtransform.QController[*omni.ClusterMachine, *omni.SchematicDriftStatus]
+
+// NewSchematicDriftStatusController initializes SchematicDriftStatusController.
+//
+// The remediate flag should match the mode of the ClusterMachineConfigStatusController, it only affects the reported phase.
+func NewSchematicDriftStatusController(remediate bool) *SchematicDriftStatusController {
+	return qtransform.NewQController(
+		qtransform.Settings[*omni.ClusterMachine, *omni.SchematicDriftStatus]{
+			Name: "SchematicDriftStatusController",
+			MapMetadataFunc: func(clusterMachine *omni.ClusterMachine) *omni.SchematicDriftStatus {
+				return omni.NewSchematicDriftStatus(resources.DefaultNamespace, clusterMachine.Metadata().ID())
+			},
+			UnmapMetadataFunc: func(driftStatus *omni.SchematicDriftStatus) *omni.ClusterMachine {
+				return omni.NewClusterMachine(resources.DefaultNamespace, driftStatus.Metadata().ID())
+			},
+			TransformFunc: func(ctx context.Context, r controller.Reader, logger *zap.Logger, clusterMachine *omni.ClusterMachine, driftStatus *omni.SchematicDriftStatus) error {
+				schematicConfiguration, err := safe.ReaderGetByID[*omni.SchematicConfiguration](ctx, r, clusterMachine.Metadata().ID())
+				if err != nil {
+					if state.IsNotFoundError(err) {
+						return xerrors.NewTaggedf[qtransform.SkipReconcileTag]("schematic configuration for %q is not created yet", clusterMachine.Metadata().ID())
+					}
+
+					return fmt.Errorf("failed to get schematic configuration: %w", err)
+				}
+
+				machineStatus, err := safe.ReaderGetByID[*omni.MachineStatus](ctx, r, clusterMachine.Metadata().ID())
+				if err != nil {
+					if state.IsNotFoundError(err) {
+						return xerrors.NewTaggedf[qtransform.SkipReconcileTag]("machine status for %q is not created yet", clusterMachine.Metadata().ID())
+					}
+
+					return fmt.Errorf("failed to get machine status: %w", err)
+				}
+
+				if machineStatus.TypedSpec().Value.Schematic == nil {
+					return xerrors.NewTaggedf[qtransform.SkipReconcileTag]("machine status for %q does not have schematic information", clusterMachine.Metadata().ID())
+				}
+
+				configStatus, err := safe.ReaderGetByID[*omni.ClusterMachineConfigStatus](ctx, r, clusterMachine.Metadata().ID())
+				if err != nil && !state.IsNotFoundError(err) {
+					return fmt.Errorf("failed to get cluster machine config status: %w", err)
+				}
+
+				helpers.CopyLabels(clusterMachine, driftStatus, omni.LabelCluster, omni.LabelMachineSet)
+
+				spec := driftStatus.TypedSpec().Value
+
+				spec.DesiredSchematicId = schematicConfiguration.TypedSpec().Value.SchematicId
+				spec.ActualSchematicId = runningSchematicID(machineStatus)
+
+				var appliedSchematicID string
+
+				if configStatus != nil {
+					appliedSchematicID = configStatus.TypedSpec().Value.SchematicId
+				}
+
+				switch {
+				// the schematic of the machines having extensions that bypass the image factory can't be detected
+				case machineStatus.TypedSpec().Value.GetSchematic().GetInvalid(),
+					spec.ActualSchematicId == spec.DesiredSchematicId:
+					spec.Phase = specs.SchematicDriftStatusSpec_InSync
+				case appliedSchematicID != spec.DesiredSchematicId:
+					spec.Phase = specs.SchematicDriftStatusSpec_Pending
+				case remediate:
+					spec.Phase = specs.SchematicDriftStatusSpec_Remediating
+				default:
+					spec.Phase = specs.SchematicDriftStatusSpec_Drifted
+				}
+
+				drifted := spec.Phase == specs.SchematicDriftStatusSpec_Drifted || spec.Phase == specs.SchematicDriftStatusSpec_Remediating
+
+				switch {
+				case !drifted:
+					spec.DriftedSince = nil
+				case spec.DriftedSince == nil:
+					spec.DriftedSince = timestamppb.Now()
+
+					logger.Warn("schematic drift detected",
+						zap.String("desired_schematic", spec.DesiredSchematicId),
+						zap.String("actual_schematic", spec.ActualSchematicId),
+						zap.Bool("remediate", remediate),
+					)
+				}
+
+				return nil
+			},
+		},
+		qtransform.WithExtraMappedInput(
+			qtransform.MapperSameID[*omni.SchematicConfiguration, *omni.ClusterMachine](),
+		),
+		qtransform.WithExtraMappedInput(
+			qtransform.MapperSameID[*omni.MachineStatus, *omni.ClusterMachine](),
+		),
+		qtransform.WithExtraMappedInput(
+			qtransform.MapperSameID[*omni.ClusterMachineConfigStatus, *omni.ClusterMachine](),
+		),
+	)
+}
+
+// runningSchematicID returns the ID of the schematic running on the machine, the full ID is used for the secure boot machines.
+func runningSchematicID(machineStatus *omni.MachineStatus) string {
+	schematic := machineStatus.TypedSpec().Value.GetSchematic()
+
+	if machineStatus.TypedSpec().Value.GetSecureBootStatus().GetEnabled() {
+		return schematic.GetFullId()
+	}
+
+	return schematic.GetId()
+}
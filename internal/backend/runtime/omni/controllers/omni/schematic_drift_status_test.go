@@ -0,0 +1,116 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+)
+
+type SchematicDriftStatusSuite struct {
+	OmniSuite
+}
+
+func (suite *SchematicDriftStatusSuite) TestReconcile() {
+	ctx, cancel := context.WithTimeout(suite.ctx, time.Second*10)
+	defer cancel()
+
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewSchematicDriftStatusController(false)))
+
+	machineName := "machine1"
+
+	clusterMachine := omni.NewClusterMachine(resources.DefaultNamespace, machineName)
+	clusterMachine.Metadata().Labels().Set(omni.LabelCluster, "cluster")
+
+	machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, machineName)
+	machineStatus.TypedSpec().Value.Schematic = &specs.MachineStatusSpec_Schematic{
+		Id: "aaaa",
+	}
+
+	schematicConfiguration := omni.NewSchematicConfiguration(resources.DefaultNamespace, machineName)
+	schematicConfiguration.TypedSpec().Value.SchematicId = "aaaa"
+
+	configStatus := omni.NewClusterMachineConfigStatus(resources.DefaultNamespace, machineName)
+	configStatus.TypedSpec().Value.SchematicId = "aaaa"
+
+	suite.Require().NoError(suite.state.Create(ctx, clusterMachine))
+	suite.Require().NoError(suite.state.Create(ctx, machineStatus))
+	suite.Require().NoError(suite.state.Create(ctx, schematicConfiguration))
+	suite.Require().NoError(suite.state.Create(ctx, configStatus))
+
+	assertPhase := func(phase specs.SchematicDriftStatusSpec_Phase, actual string) {
+		rtestutils.AssertResources(ctx, suite.T(), suite.state, []string{machineName},
+			func(res *omni.SchematicDriftStatus, assertion *assert.Assertions) {
+				assertion.Equal(phase, res.TypedSpec().Value.Phase)
+				assertion.Equal(actual, res.TypedSpec().Value.ActualSchematicId)
+
+				if phase == specs.SchematicDriftStatusSpec_Drifted {
+					assertion.NotNil(res.TypedSpec().Value.DriftedSince)
+				} else {
+					assertion.Nil(res.TypedSpec().Value.DriftedSince)
+				}
+			},
+		)
+	}
+
+	assertPhase(specs.SchematicDriftStatusSpec_InSync, "aaaa")
+
+	// the machine was upgraded to another schematic out of band
+	_, err := safe.StateUpdateWithConflicts(ctx, suite.state, machineStatus.Metadata(), func(res *omni.MachineStatus) error {
+		res.TypedSpec().Value.Schematic.Id = "bbbb"
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	assertPhase(specs.SchematicDriftStatusSpec_Drifted, "bbbb")
+
+	// the desired schematic is changed, the machine is not upgraded yet
+	_, err = safe.StateUpdateWithConflicts(ctx, suite.state, schematicConfiguration.Metadata(), func(res *omni.SchematicConfiguration) error {
+		res.TypedSpec().Value.SchematicId = "cccc"
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	assertPhase(specs.SchematicDriftStatusSpec_Pending, "bbbb")
+
+	// the machine is upgraded to the desired schematic
+	_, err = safe.StateUpdateWithConflicts(ctx, suite.state, configStatus.Metadata(), func(res *omni.ClusterMachineConfigStatus) error {
+		res.TypedSpec().Value.SchematicId = "cccc"
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	_, err = safe.StateUpdateWithConflicts(ctx, suite.state, machineStatus.Metadata(), func(res *omni.MachineStatus) error {
+		res.TypedSpec().Value.Schematic.Id = "cccc"
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	assertPhase(specs.SchematicDriftStatusSpec_InSync, "cccc")
+}
+
+func TestSchematicDriftStatusSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(SchematicDriftStatusSuite))
+}
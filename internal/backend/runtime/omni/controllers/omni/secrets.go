@@ -7,6 +7,9 @@ package omni
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -16,10 +19,13 @@ import (
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/crypto/x509"
 	"github.com/siderolabs/gen/xerrors"
 	"github.com/siderolabs/talos/pkg/machinery/config"
 	talossecrets "github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
@@ -47,8 +53,12 @@ func NewSecretsController(etcdBackupStoreFactory store.Factory) *SecretsControll
 			},
 			TransformFunc: func(ctx context.Context, r controller.Reader, _ *zap.Logger, cluster *omni.Cluster, secrets *omni.ClusterSecrets) error {
 				if len(secrets.TypedSpec().Value.GetData()) != 0 {
-					// cluster already has secrets, skipping
-					return nil
+					// cluster already has secrets, only the discovery service encryption key and the Talos secrets might be rotated
+					if err := rotateDiscoveryKey(ctx, r, secrets); err != nil {
+						return err
+					}
+
+					return rotateTalosSecrets(ctx, r, cluster, secrets)
 				}
 
 				versionContract, err := config.ParseContractFromVersion("v" + cluster.TypedSpec().Value.TalosVersion)
@@ -126,15 +136,214 @@ func NewSecretsController(etcdBackupStoreFactory store.Factory) *SecretsControll
 			// no need to requeue anything, just allow the controller to read data (when restoring from another cluster backup)
 			qtransform.MapperNone[*omni.BackupData](),
 		),
+		qtransform.WithExtraMappedInput(
+			qtransform.MapperSameID[*omni.DiscoveryKeyRotation, *omni.Cluster](),
+		),
+		qtransform.WithExtraMappedInput(
+			qtransform.MapperSameID[*omni.DiscoveryKeyRotationStatus, *omni.Cluster](),
+		),
+		qtransform.WithExtraMappedInput(
+			qtransform.MapperSameID[*omni.TalosSecretsRotation, *omni.Cluster](),
+		),
+		qtransform.WithExtraMappedInput(
+			qtransform.MapperSameID[*omni.TalosSecretsRotationStatus, *omni.Cluster](),
+		),
 	)
 }
 
-func getBackupDataFromBootstrapSpec(ctx context.Context, r controller.Reader, etcdBackupStoreFactory store.Factory, spec *specs.MachineSetSpec_BootstrapSpec) (etcdbackup.BackupData, error) {
-	backupStore, err := etcdBackupStoreFactory.GetStore()
+// rotateDiscoveryKey replaces the discovery service encryption key in the cluster secrets when the rotation is requested.
+//
+// The replaced key is kept until all machines are updated, so that Omni can still read the affiliates published with it.
+func rotateDiscoveryKey(ctx context.Context, r controller.Reader, secrets *omni.ClusterSecrets) error {
+	spec := secrets.TypedSpec().Value
+
+	rotation, err := safe.ReaderGetByID[*omni.DiscoveryKeyRotation](ctx, r, secrets.Metadata().ID())
+	if err != nil && !state.IsNotFoundError(err) {
+		return fmt.Errorf("error getting discovery key rotation: %w", err)
+	}
+
+	if rotation == nil {
+		// no rotation in progress
+		spec.PreviousDiscoveryKey = ""
+
+		return nil
+	}
+
+	requestedAt := rotation.TypedSpec().Value.RequestedAt
+
+	if requestedAt != nil && !proto.Equal(requestedAt, spec.DiscoveryKeyRotationRequestedAt) {
+		var bundle *talossecrets.Bundle
+
+		bundle, err = omni.ToSecretsBundle(secrets)
+		if err != nil {
+			return fmt.Errorf("error converting cluster secrets to bundle: %w", err)
+		}
+
+		key := make([]byte, constants.DefaultClusterSecretSize)
+
+		if _, err = rand.Read(key); err != nil {
+			return fmt.Errorf("error generating discovery key: %w", err)
+		}
+
+		spec.PreviousDiscoveryKey = bundle.Cluster.Secret
+		spec.DiscoveryKeyRotationRequestedAt = requestedAt
+
+		bundle.Cluster.Secret = base64.StdEncoding.EncodeToString(key)
+
+		if spec.Data, err = json.Marshal(bundle); err != nil {
+			return fmt.Errorf("error marshaling secrets: %w", err)
+		}
+
+		return nil
+	}
+
+	rotationStatus, err := safe.ReaderGetByID[*omni.DiscoveryKeyRotationStatus](ctx, r, secrets.Metadata().ID())
 	if err != nil {
-		return etcdbackup.BackupData{}, fmt.Errorf("failed to get backup store: %w", err)
+		if state.IsNotFoundError(err) {
+			return nil
+		}
+
+		return fmt.Errorf("error getting discovery key rotation status: %w", err)
 	}
 
+	if rotationStatus.TypedSpec().Value.Phase == specs.DiscoveryKeyRotationStatusSpec_Retiring &&
+		proto.Equal(rotationStatus.TypedSpec().Value.RequestedAt, requestedAt) {
+		// all machines are using the new key
+		spec.PreviousDiscoveryKey = ""
+	}
+
+	return nil
+}
+
+// rotateTalosSecrets moves the Talos secrets rotation to the next stage when the previous one is rolled out to the healthy cluster.
+//
+// Deleting the rotation request cancels the rotation only before the new CA becomes the issuing CA,
+// afterwards the rotation is paused until it's requested again.
+func rotateTalosSecrets(ctx context.Context, r controller.Reader, cluster *omni.Cluster, secrets *omni.ClusterSecrets) error {
+	spec := secrets.TypedSpec().Value
+	rotationState := spec.TalosSecretsRotation
+
+	rotation, err := safe.ReaderGetByID[*omni.TalosSecretsRotation](ctx, r, secrets.Metadata().ID())
+	if err != nil && !state.IsNotFoundError(err) {
+		return fmt.Errorf("error getting Talos secrets rotation: %w", err)
+	}
+
+	stage := rotationState.GetStage()
+
+	if rotation == nil {
+		if stage == specs.ClusterSecretsSpec_TalosSecretsRotation_None || stage == specs.ClusterSecretsSpec_TalosSecretsRotation_PreRotate {
+			spec.TalosSecretsRotation = nil
+		}
+
+		return nil
+	}
+
+	if stage == specs.ClusterSecretsSpec_TalosSecretsRotation_None {
+		requestedAt := rotation.TypedSpec().Value.RequestedAt
+
+		if requestedAt == nil || proto.Equal(requestedAt, rotationState.GetRequestedAt()) {
+			return nil
+		}
+
+		if validateTalosSecretsRotation(cluster) != nil {
+			// the error is reported by TalosSecretsRotationController
+			return nil //nolint:nilerr
+		}
+
+		var ca *x509.CertificateAuthority
+
+		ca, err = talossecrets.NewTalosCA(time.Now())
+		if err != nil {
+			return fmt.Errorf("error generating Talos CA: %w", err)
+		}
+
+		spec.TalosSecretsRotation = &specs.ClusterSecretsSpec_TalosSecretsRotation{
+			Stage:       specs.ClusterSecretsSpec_TalosSecretsRotation_PreRotate,
+			RequestedAt: requestedAt,
+			ExtraCaCrt:  ca.CrtPEM,
+			ExtraCaKey:  ca.KeyPEM,
+		}
+
+		return nil
+	}
+
+	rotationStatus, err := safe.ReaderGetByID[*omni.TalosSecretsRotationStatus](ctx, r, secrets.Metadata().ID())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil
+		}
+
+		return fmt.Errorf("error getting Talos secrets rotation status: %w", err)
+	}
+
+	statusSpec := rotationStatus.TypedSpec().Value
+
+	if !proto.Equal(statusSpec.RequestedAt, rotationState.RequestedAt) || statusSpec.Phase != talosSecretsRotationPhase(stage) ||
+		statusSpec.UpdatedMachines != statusSpec.TotalMachines || !statusSpec.ClusterReady {
+		// the current stage is not rolled out yet
+		return nil
+	}
+
+	switch stage {
+	case specs.ClusterSecretsSpec_TalosSecretsRotation_PreRotate:
+		var bundle *talossecrets.Bundle
+
+		bundle, err = omni.ToSecretsBundle(secrets)
+		if err != nil {
+			return fmt.Errorf("error converting cluster secrets to bundle: %w", err)
+		}
+
+		var token string
+
+		token, err = generateTrustdToken()
+		if err != nil {
+			return fmt.Errorf("error generating trustd token: %w", err)
+		}
+
+		oldCA := bundle.Certs.OS
+
+		bundle.Certs.OS = &x509.PEMEncodedCertificateAndKey{
+			Crt: rotationState.ExtraCaCrt,
+			Key: rotationState.ExtraCaKey,
+		}
+
+		rotationState.ExtraCaCrt = oldCA.Crt
+		rotationState.ExtraCaKey = nil
+		rotationState.PreviousTrustdToken = bundle.TrustdInfo.Token
+		rotationState.Stage = specs.ClusterSecretsSpec_TalosSecretsRotation_Rotate
+
+		bundle.TrustdInfo.Token = token
+
+		if spec.Data, err = json.Marshal(bundle); err != nil {
+			return fmt.Errorf("error marshaling secrets: %w", err)
+		}
+	case specs.ClusterSecretsSpec_TalosSecretsRotation_Rotate:
+		// all machines are using the new CA and token
+		rotationState.ExtraCaCrt = nil
+		rotationState.PreviousTrustdToken = ""
+		rotationState.Stage = specs.ClusterSecretsSpec_TalosSecretsRotation_PostRotate
+	case specs.ClusterSecretsSpec_TalosSecretsRotation_PostRotate:
+		rotationState.Stage = specs.ClusterSecretsSpec_TalosSecretsRotation_None
+	case specs.ClusterSecretsSpec_TalosSecretsRotation_None:
+	}
+
+	return nil
+}
+
+// generateTrustdToken generates the token in the same format as Talos does: 6 and 16 random characters separated by a dot.
+func generateTrustdToken() (string, error) {
+	token := make([]byte, 11)
+
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+
+	encoded := hex.EncodeToString(token)
+
+	return encoded[:6] + "." + encoded[6:], nil
+}
+
+func getBackupDataFromBootstrapSpec(ctx context.Context, r controller.Reader, etcdBackupStoreFactory store.Factory, spec *specs.MachineSetSpec_BootstrapSpec) (etcdbackup.BackupData, error) {
 	clusterUUIDList, err := safe.ReaderListAll[*omni.ClusterUUID](ctx, r, state.WithLabelQuery(resource.LabelEqual(omni.LabelClusterUUID, spec.GetClusterUuid())))
 	if err != nil {
 		return etcdbackup.BackupData{}, fmt.Errorf("failed to list cluster UUIDs for cluster %q: %w", spec.GetClusterUuid(), err)
@@ -154,6 +363,11 @@ func getBackupDataFromBootstrapSpec(ctx context.Context, r controller.Reader, et
 		return etcdbackup.BackupData{}, fmt.Errorf("failed to get backup data for cluster %q: %w", clusterID, err)
 	}
 
+	backupStore, err := store.GetClusterStore(etcdBackupStoreFactory, clusterID)
+	if err != nil {
+		return etcdbackup.BackupData{}, fmt.Errorf("failed to get backup store: %w", err)
+	}
+
 	downloadedBackupData, readCloser, err := backupStore.Download(ctx, backupData.TypedSpec().Value.GetEncryptionKey(), spec.GetClusterUuid(), spec.GetSnapshot())
 	if err != nil {
 		return etcdbackup.BackupData{}, fmt.Errorf("failed to download backup data for cluster %q: %w", clusterID, err)
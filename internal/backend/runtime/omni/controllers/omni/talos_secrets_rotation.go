@@ -0,0 +1,189 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/controller/generic/qtransform"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/xerrors"
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	talossecrets "github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/mappers"
+)
+
+// TalosSecretsRotationController reports the progress of the Talos CA and trustd token rotation.
+//
+// The rotation goes through the following stages, each of them is started by SecretsController
+// when the machine configs of the previous stage are applied to all machines and the cluster is ready:
+//   - PreRotate: the new CA is added to the accepted CAs of the machines;
+//   - Rotate: the new CA becomes the issuing CA, the trustd token is replaced, the old CA is still accepted;
+//   - PostRotate: the old CA is removed from the accepted CAs;
+//   - Done.
+type TalosSecretsRotationController = qtransform.QController[*omni.TalosSecretsRotation, *omni.TalosSecretsRotationStatus]
+
+// NewTalosSecretsRotationController initializes TalosSecretsRotationController.
+func NewTalosSecretsRotationController() *TalosSecretsRotationController {
+	return qtransform.NewQController(
+		qtransform.Settings[*omni.TalosSecretsRotation, *omni.TalosSecretsRotationStatus]{
+			Name: "TalosSecretsRotationController",
+			MapMetadataFunc: func(rotation *omni.TalosSecretsRotation) *omni.TalosSecretsRotationStatus {
+				return omni.NewTalosSecretsRotationStatus(rotation.Metadata().ID())
+			},
+			UnmapMetadataFunc: func(rotationStatus *omni.TalosSecretsRotationStatus) *omni.TalosSecretsRotation {
+				return omni.NewTalosSecretsRotation(rotationStatus.Metadata().ID())
+			},
+			TransformFunc: func(ctx context.Context, r controller.Reader, _ *zap.Logger, rotation *omni.TalosSecretsRotation, rotationStatus *omni.TalosSecretsRotationStatus) error {
+				clusterName := rotation.Metadata().ID()
+
+				cluster, err := safe.ReaderGetByID[*omni.Cluster](ctx, r, clusterName)
+				if err != nil {
+					if state.IsNotFoundError(err) {
+						return xerrors.NewTaggedf[qtransform.SkipReconcileTag]("cluster %q doesn't exist", clusterName)
+					}
+
+					return fmt.Errorf("error getting cluster: %w", err)
+				}
+
+				secrets, err := safe.ReaderGetByID[*omni.ClusterSecrets](ctx, r, clusterName)
+				if err != nil {
+					if state.IsNotFoundError(err) {
+						return xerrors.NewTaggedf[qtransform.SkipReconcileTag]("cluster %q secrets are not created yet", clusterName)
+					}
+
+					return fmt.Errorf("error getting cluster secrets: %w", err)
+				}
+
+				rotationStatus.Metadata().Labels().Set(omni.LabelCluster, clusterName)
+
+				spec := rotationStatus.TypedSpec().Value
+				rotationState := secrets.TypedSpec().Value.TalosSecretsRotation
+
+				spec.Error = ""
+
+				// the ongoing rotation is reported even if it was requested again, the new request is handled when it's done
+				if rotationState.GetStage() == specs.ClusterSecretsSpec_TalosSecretsRotation_None &&
+					(rotation.TypedSpec().Value.RequestedAt == nil || !proto.Equal(rotation.TypedSpec().Value.RequestedAt, rotationState.GetRequestedAt())) {
+					spec.Phase = specs.TalosSecretsRotationStatusSpec_Pending
+					spec.RequestedAt = rotation.TypedSpec().Value.RequestedAt
+					spec.UpdatedMachines = 0
+					spec.TotalMachines = 0
+					spec.ClusterReady = false
+
+					if err = validateTalosSecretsRotation(cluster); err != nil {
+						spec.Phase = specs.TalosSecretsRotationStatusSpec_Failed
+						spec.Error = err.Error()
+					}
+
+					return nil
+				}
+
+				spec.RequestedAt = rotationState.GetRequestedAt()
+
+				bundle, err := omni.ToSecretsBundle(secrets)
+				if err != nil {
+					return fmt.Errorf("error converting cluster secrets to bundle: %w", err)
+				}
+
+				_, updated, total, err := countMachineConfigUpdates(ctx, r, clusterName, func(cfg config.Provider) bool {
+					return talosSecretsRotated(cfg, bundle, rotationState.GetExtraCaCrt())
+				})
+				if err != nil {
+					return err
+				}
+
+				spec.UpdatedMachines = updated
+				spec.TotalMachines = total
+
+				clusterStatus, err := safe.ReaderGetByID[*omni.ClusterStatus](ctx, r, clusterName)
+				if err != nil && !state.IsNotFoundError(err) {
+					return fmt.Errorf("error getting cluster status: %w", err)
+				}
+
+				spec.ClusterReady = clusterStatus != nil && clusterStatus.TypedSpec().Value.Ready
+
+				spec.Phase = talosSecretsRotationPhase(rotationState.GetStage())
+
+				return nil
+			},
+		},
+		qtransform.WithExtraMappedInput(
+			qtransform.MapperSameID[*omni.Cluster, *omni.TalosSecretsRotation](),
+		),
+		qtransform.WithExtraMappedInput(
+			qtransform.MapperSameID[*omni.ClusterSecrets, *omni.TalosSecretsRotation](),
+		),
+		qtransform.WithExtraMappedInput(
+			qtransform.MapperSameID[*omni.ClusterStatus, *omni.TalosSecretsRotation](),
+		),
+		qtransform.WithExtraMappedInput(
+			mappers.MapByClusterLabel[*omni.ClusterMachineConfig, *omni.TalosSecretsRotation](),
+		),
+		qtransform.WithExtraMappedInput(
+			mappers.MapByClusterLabel[*omni.ClusterMachineConfigStatus, *omni.TalosSecretsRotation](),
+		),
+	)
+}
+
+// validateTalosSecretsRotation checks that the secrets of the cluster can be rotated.
+func validateTalosSecretsRotation(cluster *omni.Cluster) error {
+	versionContract, err := config.ParseContractFromVersion("v" + cluster.TypedSpec().Value.TalosVersion)
+	if err != nil {
+		return err
+	}
+
+	// accepted CAs are supported since Talos 1.7
+	if !versionContract.Greater(config.TalosVersion1_6) {
+		return fmt.Errorf("secrets rotation requires Talos 1.7 or later, the cluster runs Talos %s", cluster.TypedSpec().Value.TalosVersion)
+	}
+
+	return nil
+}
+
+// talosSecretsRotated checks that the machine config has the Talos CA and the trustd token of the current rotation stage.
+func talosSecretsRotated(cfg config.Provider, bundle *talossecrets.Bundle, extraCA []byte) bool {
+	security := cfg.Machine().Security()
+
+	if security.IssuingCA() == nil || !bytes.Equal(security.IssuingCA().Crt, bundle.Certs.OS.Crt) {
+		return false
+	}
+
+	if security.Token() != bundle.TrustdInfo.Token {
+		return false
+	}
+
+	acceptedCAs := security.AcceptedCAs()
+
+	if len(extraCA) == 0 {
+		return len(acceptedCAs) == 0
+	}
+
+	return len(acceptedCAs) == 1 && bytes.Equal(acceptedCAs[0].Crt, extraCA)
+}
+
+func talosSecretsRotationPhase(stage specs.ClusterSecretsSpec_TalosSecretsRotation_Stage) specs.TalosSecretsRotationStatusSpec_Phase {
+	switch stage {
+	case specs.ClusterSecretsSpec_TalosSecretsRotation_PreRotate:
+		return specs.TalosSecretsRotationStatusSpec_PreRotate
+	case specs.ClusterSecretsSpec_TalosSecretsRotation_Rotate:
+		return specs.TalosSecretsRotationStatusSpec_Rotate
+	case specs.ClusterSecretsSpec_TalosSecretsRotation_PostRotate:
+		return specs.TalosSecretsRotationStatusSpec_PostRotate
+	case specs.ClusterSecretsSpec_TalosSecretsRotation_None:
+	}
+
+	return specs.TalosSecretsRotationStatusSpec_Done
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+)
+
+type TalosSecretsRotationSuite struct {
+	OmniSuite
+}
+
+func (suite *TalosSecretsRotationSuite) TestRotation() {
+	require := suite.Require()
+
+	suite.startRuntime()
+
+	require.NoError(suite.runtime.RegisterQController(omnictrl.NewSecretsController(nil)))
+	require.NoError(suite.runtime.RegisterQController(omnictrl.NewTalosSecretsRotationController()))
+
+	clusterName := "rotation"
+
+	// the machine config which is not generated yet blocks the rotation
+	machineConfig := suite.createSecretsCluster(clusterName, "1.7.0")
+
+	clusterStatus := omni.NewClusterStatus(resources.DefaultNamespace, clusterName)
+	clusterStatus.TypedSpec().Value.Ready = true
+	require.NoError(suite.state.Create(suite.ctx, clusterStatus))
+
+	var oldCA []byte
+
+	var oldToken string
+
+	rtestutils.AssertResource[*omni.ClusterSecrets](suite.ctx, suite.T(), suite.state, clusterName, func(res *omni.ClusterSecrets, assertion *assert.Assertions) {
+		bundle, err := omni.ToSecretsBundle(res)
+		assertion.NoError(err)
+
+		oldCA = bundle.Certs.OS.Crt
+		oldToken = bundle.TrustdInfo.Token
+	})
+
+	rotation := omni.NewTalosSecretsRotation(clusterName)
+	rotation.TypedSpec().Value.RequestedAt = timestamppb.Now()
+
+	require.NoError(suite.state.Create(suite.ctx, rotation))
+
+	rtestutils.AssertResource[*omni.TalosSecretsRotationStatus](suite.ctx, suite.T(), suite.state, clusterName,
+		func(res *omni.TalosSecretsRotationStatus, assertion *assert.Assertions) {
+			assertion.Equal(specs.TalosSecretsRotationStatusSpec_PreRotate, res.TypedSpec().Value.Phase)
+			assertion.EqualValues(0, res.TypedSpec().Value.UpdatedMachines)
+			assertion.EqualValues(1, res.TypedSpec().Value.TotalMachines)
+			assertion.True(res.TypedSpec().Value.ClusterReady)
+		},
+	)
+
+	rtestutils.AssertResource[*omni.ClusterSecrets](suite.ctx, suite.T(), suite.state, clusterName, func(res *omni.ClusterSecrets, assertion *assert.Assertions) {
+		bundle, err := omni.ToSecretsBundle(res)
+		assertion.NoError(err)
+
+		// the new CA is only accepted yet
+		assertion.Equal(oldCA, bundle.Certs.OS.Crt)
+		assertion.Equal(oldToken, bundle.TrustdInfo.Token)
+		assertion.NotEmpty(res.TypedSpec().Value.GetTalosSecretsRotation().GetExtraCaCrt())
+	})
+
+	// all machines are updated, the rotation goes through the rest of the stages
+	rtestutils.Destroy[*omni.ClusterMachineConfig](suite.ctx, suite.T(), suite.state, []resource.ID{machineConfig.Metadata().ID()})
+
+	rtestutils.AssertResource[*omni.TalosSecretsRotationStatus](suite.ctx, suite.T(), suite.state, clusterName,
+		func(res *omni.TalosSecretsRotationStatus, assertion *assert.Assertions) {
+			assertion.Equal(specs.TalosSecretsRotationStatusSpec_Done, res.TypedSpec().Value.Phase)
+		},
+	)
+
+	rtestutils.AssertResource[*omni.ClusterSecrets](suite.ctx, suite.T(), suite.state, clusterName, func(res *omni.ClusterSecrets, assertion *assert.Assertions) {
+		bundle, err := omni.ToSecretsBundle(res)
+		assertion.NoError(err)
+
+		assertion.NotEqual(oldCA, bundle.Certs.OS.Crt)
+		assertion.NotEqual(oldToken, bundle.TrustdInfo.Token)
+		assertion.Empty(res.TypedSpec().Value.GetTalosSecretsRotation().GetExtraCaCrt())
+		assertion.Empty(res.TypedSpec().Value.GetTalosSecretsRotation().GetPreviousTrustdToken())
+	})
+}
+
+func (suite *TalosSecretsRotationSuite) TestUnsupportedVersion() {
+	require := suite.Require()
+
+	suite.startRuntime()
+
+	require.NoError(suite.runtime.RegisterQController(omnictrl.NewSecretsController(nil)))
+	require.NoError(suite.runtime.RegisterQController(omnictrl.NewTalosSecretsRotationController()))
+
+	clusterName := "old-talos"
+
+	suite.createSecretsCluster(clusterName, "1.6.4")
+
+	rotation := omni.NewTalosSecretsRotation(clusterName)
+	rotation.TypedSpec().Value.RequestedAt = timestamppb.Now()
+
+	require.NoError(suite.state.Create(suite.ctx, rotation))
+
+	rtestutils.AssertResource[*omni.TalosSecretsRotationStatus](suite.ctx, suite.T(), suite.state, clusterName,
+		func(res *omni.TalosSecretsRotationStatus, assertion *assert.Assertions) {
+			assertion.Equal(specs.TalosSecretsRotationStatusSpec_Failed, res.TypedSpec().Value.Phase)
+			assertion.Contains(res.TypedSpec().Value.Error, "requires Talos 1.7 or later")
+		},
+	)
+
+	rtestutils.AssertResource[*omni.ClusterSecrets](suite.ctx, suite.T(), suite.state, clusterName, func(res *omni.ClusterSecrets, assertion *assert.Assertions) {
+		assertion.Nil(res.TypedSpec().Value.TalosSecretsRotation)
+	})
+}
+
+func TestTalosSecretsRotationSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(TalosSecretsRotationSuite))
+}
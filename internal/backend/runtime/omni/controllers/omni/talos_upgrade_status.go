@@ -10,8 +10,10 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"strings"
 	"time"
 
+	"github.com/blang/semver"
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/controller/generic/qtransform"
 	"github.com/cosi-project/runtime/pkg/resource"
@@ -42,7 +44,9 @@ type TalosUpgradeStatusController = qtransform.QController[*omni.Cluster, *omni.
 const TalosUpgradeStatusControllerName = "TalosUpgradeStatusController"
 
 // NewTalosUpgradeStatusController initializes TalosUpgradeStatusController.
-func NewTalosUpgradeStatusController() *TalosUpgradeStatusController {
+//
+// The upgradeQueue limits the number of the clusters upgraded at the same time, the upgrades are not limited if it's nil.
+func NewTalosUpgradeStatusController(upgradeQueue *UpgradeQueue) *TalosUpgradeStatusController {
 	return qtransform.NewQController(
 		qtransform.Settings[*omni.Cluster, *omni.TalosUpgradeStatus]{
 			Name: TalosUpgradeStatusControllerName,
@@ -75,9 +79,11 @@ func NewTalosUpgradeStatusController() *TalosUpgradeStatusController {
 					return err
 				}
 
-				return reconcileTalosUpdateStatus(ctx, r, logger, upgradeStatus, cluster, clusterMachines, machineSetNodes)
+				return reconcileTalosUpdateStatus(ctx, r, logger, upgradeQueue, upgradeStatus, cluster, clusterMachines, machineSetNodes)
 			},
 			FinalizerRemovalExtraOutputFunc: func(ctx context.Context, r controller.ReaderWriter, _ *zap.Logger, cluster *omni.Cluster) error {
+				upgradeQueue.release(cluster.Metadata().ID(), TalosUpgradeStatusControllerName)
+
 				if err := updateSchematicsFinalizers(ctx, r, cluster); err != nil {
 					return err
 				}
@@ -115,12 +121,18 @@ func NewTalosUpgradeStatusController() *TalosUpgradeStatusController {
 		qtransform.WithExtraMappedInput(
 			qtransform.MapperSameID[*omni.ClusterStatus, *omni.Cluster](),
 		),
+		qtransform.WithExtraMappedInput(
+			qtransform.MapperSameID[*omni.MaintenanceWindow, *omni.Cluster](),
+		),
 		qtransform.WithExtraMappedInput(
 			mappers.MapByClusterLabel[*omni.ClusterMachineIdentity, *omni.Cluster](),
 		),
 		qtransform.WithExtraMappedInput(
 			mappers.MapByClusterLabel[*omni.MachineSetNode, *omni.Cluster](),
 		),
+		qtransform.WithExtraMappedInput(
+			mappers.MapByClusterLabel[*omni.MachineSet, *omni.Cluster](),
+		),
 		qtransform.WithExtraMappedInput(
 			mappers.MapByClusterLabel[*omni.ClusterMachine, *omni.Cluster](),
 		),
@@ -158,6 +170,10 @@ func NewTalosUpgradeStatusController() *TalosUpgradeStatusController {
 	)
 }
 
+// updateMachines updates the first unlocked machine in the order of the machine set nodes creation.
+//
+// The worker machines are updated in batches: the machines of the same machine set which follow the first one
+// are updated together with it according to the rolling update strategy of the machine set.
 func updateMachines(
 	ctx context.Context,
 	r controller.ReaderWriter,
@@ -165,7 +181,7 @@ func updateMachines(
 	upgradeStatus *omni.TalosUpgradeStatus,
 	machinesToUpdate []*omni.ClusterMachineTalosVersion,
 	machineSetNodes safe.List[*omni.MachineSetNode],
-) (string, error) {
+) ([]string, error) {
 	var machineToUpdate *omni.ClusterMachineTalosVersion
 
 	lockedMachines := map[resource.ID]struct{}{}
@@ -196,21 +212,104 @@ func updateMachines(
 
 	id, err := getMachineName(ctx, r, machineToUpdate.Metadata().ID())
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	if _, locked := lockedMachines[machineToUpdate.Metadata().ID()]; locked {
 		upgradeStatus.TypedSpec().Value.Status = "upgrade paused"
 		upgradeStatus.TypedSpec().Value.Step = fmt.Sprintf("waiting for the machine %s to be unlocked", id)
 
-		return "", errMachineLocked
+		return nil, errMachineLocked
 	}
 
-	if err := updateMachine(ctx, r, logger, machineToUpdate); err != nil {
-		return "", err
+	batchSize, err := getUpgradeBatchSize(ctx, r, machineToUpdate)
+	if err != nil {
+		return nil, err
+	}
+
+	machineSetID, _ := machineToUpdate.Metadata().Labels().Get(omni.LabelMachineSet)
+	updatedMachines := make([]string, 0, batchSize)
+
+	for _, machine := range machinesToUpdate {
+		if len(updatedMachines) == batchSize {
+			break
+		}
+
+		if _, locked := lockedMachines[machine.Metadata().ID()]; locked {
+			continue
+		}
+
+		if machine != machineToUpdate {
+			if machineSet, _ := machine.Metadata().Labels().Get(omni.LabelMachineSet); machineSet != machineSetID {
+				continue
+			}
+
+			if id, err = getMachineName(ctx, r, machine.Metadata().ID()); err != nil {
+				return nil, err
+			}
+		}
+
+		if err = updateMachine(ctx, r, logger, machine); err != nil {
+			return nil, err
+		}
+
+		updatedMachines = append(updatedMachines, id)
+	}
+
+	return updatedMachines, nil
+}
+
+// getUpgradeBatchSize returns the number of the machines of the machine set which are updated at the same time.
+//
+// The control plane machines are always updated one by one.
+func getUpgradeBatchSize(ctx context.Context, r controller.Reader, machine *omni.ClusterMachineTalosVersion) (int, error) {
+	machineSetID, ok := machine.Metadata().Labels().Get(omni.LabelMachineSet)
+	if !ok {
+		return 1, nil
+	}
+
+	if _, controlPlane := machine.Metadata().Labels().Get(omni.LabelControlPlaneRole); controlPlane {
+		return 1, nil
+	}
+
+	machineSet, err := safe.ReaderGetByID[*omni.MachineSet](ctx, r, machineSetID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return 1, nil
+		}
+
+		return 0, err
+	}
+
+	if machineSet.TypedSpec().Value.UpdateStrategy != specs.MachineSetSpec_Rolling {
+		return 1, nil
+	}
+
+	rolling := machineSet.TypedSpec().Value.GetUpdateStrategyConfig().GetRolling()
+
+	batchSize := max(1, int(rolling.GetMaxParallelism()))
+
+	// the cluster is healthy when the batch starts, so the updated machines are the only unavailable ones
+	if maxUnavailable := int(rolling.GetMaxUnavailable()); maxUnavailable > 0 {
+		batchSize = min(batchSize, maxUnavailable)
+	}
+
+	return batchSize, nil
+}
+
+// isOlderVersion returns true if the version is older than the target version.
+func isOlderVersion(version, target string) bool {
+	parsedVersion, err := semver.ParseTolerant(version)
+	if err != nil {
+		return false
+	}
+
+	parsedTarget, err := semver.ParseTolerant(target)
+	if err != nil {
+		return false
 	}
 
-	return id, nil
+	return parsedVersion.LT(parsedTarget)
 }
 
 func getMachineName(ctx context.Context, r controller.ReaderWriter, id resource.ID) (string, error) {
@@ -233,12 +332,17 @@ func getMachineName(ctx context.Context, r controller.ReaderWriter, id resource.
 //nolint:gocognit,cyclop,gocyclo,maintidx
 func reconcileTalosUpdateStatus(ctx context.Context, r controller.ReaderWriter,
 	logger *zap.Logger,
+	upgradeQueue *UpgradeQueue,
 	upgradeStatus *omni.TalosUpgradeStatus, cluster *omni.Cluster,
 	clusterMachines safe.List[*omni.ClusterMachine],
 	machineSetNodes safe.List[*omni.MachineSetNode],
 ) error {
 	talosVersion := cluster.TypedSpec().Value.TalosVersion
 
+	// the upgrade which was started before keeps its slot in the upgrade queue
+	upgradeRunning := upgradeStatus.TypedSpec().Value.Phase == specs.TalosUpgradeStatusSpec_Upgrading && upgradeStatus.TypedSpec().Value.QueuePosition == 0 &&
+		upgradeStatus.TypedSpec().Value.NextMaintenanceWindow == nil
+
 	// if not reverting to the previous successful version, perform pre-checks on each step
 	versionMismatch := talosVersion != upgradeStatus.TypedSpec().Value.LastUpgradeVersion
 
@@ -252,9 +356,19 @@ func reconcileTalosUpdateStatus(ctx context.Context, r controller.ReaderWriter,
 		upgradeStatus.TypedSpec().Value.CurrentUpgradeVersion = ""
 	}
 
+	heldMachines := map[resource.ID]struct{}{}
+
+	machineSetNodes.ForEach(func(machineSetNode *omni.MachineSetNode) {
+		if _, held := machineSetNode.Metadata().Annotations().Get(omni.MachineUpgradeHold); held {
+			heldMachines[machineSetNode.Metadata().ID()] = struct{}{}
+		}
+	})
+
 	var (
 		machinesToUpdate []*omni.ClusterMachineTalosVersion
+		skippedMachines  []resource.ID
 		configsReady     = true
+		allBehind        = true
 		schematicsReady  = true
 		err              error
 	)
@@ -345,7 +459,15 @@ func reconcileTalosUpdateStatus(ctx context.Context, r controller.ReaderWriter,
 
 		if resourceNeedsUpdate || configStatus.TypedSpec().Value.TalosVersion != talosVersion ||
 			configStatus.TypedSpec().Value.SchematicId != schematicID {
+			if _, held := heldMachines[machine.Metadata().ID()]; held {
+				skippedMachines = append(skippedMachines, machine.Metadata().ID())
+
+				continue
+			}
+
 			machinesToUpdate = append(machinesToUpdate, clusterMachineTalosVersion)
+
+			allBehind = allBehind && isOlderVersion(configStatus.TypedSpec().Value.TalosVersion, talosVersion)
 		}
 	}
 
@@ -355,9 +477,22 @@ func reconcileTalosUpdateStatus(ctx context.Context, r controller.ReaderWriter,
 
 	upgradeStatus.Metadata().Labels().Set(omni.LabelCluster, cluster.Metadata().ID())
 
+	slices.Sort(skippedMachines)
+
+	upgradeStatus.TypedSpec().Value.HeldMachines = skippedMachines
+
 	if len(machinesToUpdate) == 0 {
+		upgradeQueue.release(cluster.Metadata().ID(), TalosUpgradeStatusControllerName)
+
+		upgradeStatus.TypedSpec().Value.QueuePosition = 0
+		upgradeStatus.TypedSpec().Value.NextMaintenanceWindow = nil
 		upgradeStatus.TypedSpec().Value.Phase = specs.TalosUpgradeStatusSpec_Done
 		upgradeStatus.TypedSpec().Value.Status = ""
+
+		if len(skippedMachines) > 0 {
+			upgradeStatus.TypedSpec().Value.Status = fmt.Sprintf("%d machine(s) held on the previous version", len(skippedMachines))
+		}
+
 		upgradeStatus.TypedSpec().Value.Step = ""
 		upgradeStatus.TypedSpec().Value.CurrentUpgradeVersion = ""
 		upgradeStatus.TypedSpec().Value.LastUpgradeVersion = talosVersion
@@ -375,6 +510,49 @@ func reconcileTalosUpdateStatus(ctx context.Context, r controller.ReaderWriter,
 	// upgrade is going, or failed, so clear the upgrade versions
 	upgradeStatus.TypedSpec().Value.UpgradeVersions = nil
 
+	// the upgrades of the running clusters reboot the machines, so they wait for the maintenance window,
+	// the machines are not rebooted after the window closes, and the upgrade gives up its slot in the upgrade queue until the next window
+	if upgradeStatus.TypedSpec().Value.LastUpgradeVersion != "" {
+		wait, next, windowErr := maintenanceWindowWait(ctx, r, cluster.Metadata().ID(), time.Now())
+		if windowErr != nil {
+			return windowErr
+		}
+
+		if wait {
+			upgradeQueue.release(cluster.Metadata().ID(), TalosUpgradeStatusControllerName)
+
+			upgradeStatus.TypedSpec().Value.QueuePosition = 0
+			upgradeStatus.TypedSpec().Value.NextMaintenanceWindow = maintenanceWindowTimestamp(next)
+			upgradeStatus.TypedSpec().Value.Step = "upgrade queued"
+			upgradeStatus.TypedSpec().Value.Status = maintenanceWindowStatus(next)
+
+			return maintenanceWindowRequeue(next, upgradeQueue.pollInterval())
+		}
+
+		if upgradeStatus.TypedSpec().Value.NextMaintenanceWindow != nil {
+			upgradeStatus.TypedSpec().Value.NextMaintenanceWindow = nil
+			upgradeStatus.TypedSpec().Value.Step = ""
+			upgradeStatus.TypedSpec().Value.Status = ""
+		}
+	}
+
+	// the initial version rollout of the new clusters is not an upgrade, so it's not queued
+	if versionMismatch && upgradeStatus.TypedSpec().Value.LastUpgradeVersion != "" {
+		if position := upgradeQueue.acquire(cluster, TalosUpgradeStatusControllerName, upgradeRunning); position > 0 {
+			upgradeStatus.TypedSpec().Value.QueuePosition = uint32(position)
+			upgradeStatus.TypedSpec().Value.Step = "upgrade queued"
+			upgradeStatus.TypedSpec().Value.Status = fmt.Sprintf("waiting for other clusters to finish upgrading, position in the queue: %d", position)
+
+			return controller.NewRequeueInterval(upgradeQueue.pollInterval())
+		}
+
+		if upgradeStatus.TypedSpec().Value.QueuePosition > 0 {
+			upgradeStatus.TypedSpec().Value.QueuePosition = 0
+			upgradeStatus.TypedSpec().Value.Step = ""
+			upgradeStatus.TypedSpec().Value.Status = ""
+		}
+	}
+
 	if clusterStatus.TypedSpec().Value.Phase != specs.ClusterStatusSpec_RUNNING || !clusterStatus.TypedSpec().Value.Ready || !configsReady {
 		// if the cluster set is not ready, and doing "normal" upgrade, set the status to 'waiting for the cluster set to be ready'
 		if versionMismatch {
@@ -388,6 +566,13 @@ func reconcileTalosUpdateStatus(ctx context.Context, r controller.ReaderWriter,
 		}
 	}
 
+	if _, paused := cluster.Metadata().Annotations().Get(omni.ClusterPaused); paused {
+		upgradeStatus.TypedSpec().Value.Step = "update paused"
+		upgradeStatus.TypedSpec().Value.Status = "waiting for the cluster to be resumed"
+
+		return nil
+	}
+
 	controlPlanes := xslices.Filter(machinesToUpdate, func(res *omni.ClusterMachineTalosVersion) bool {
 		_, matches := res.Metadata().Labels().Get(omni.LabelControlPlaneRole)
 
@@ -403,16 +588,21 @@ func reconcileTalosUpdateStatus(ctx context.Context, r controller.ReaderWriter,
 	totalMachines := clusterMachines.Len()
 	pendingMachines := totalMachines - len(machinesToUpdate) + 1
 
-	if versionMismatch || schematicUpdates {
+	switch {
+	case versionMismatch || schematicUpdates:
 		upgradeStatus.TypedSpec().Value.Status = fmt.Sprintf("updating machines %d/%d", pendingMachines, totalMachines)
-	} else {
+	case allBehind:
+		// the machines which were held on the previous version catch up with the cluster version after the hold is removed
+		upgradeStatus.TypedSpec().Value.Phase = specs.TalosUpgradeStatusSpec_Upgrading
+		upgradeStatus.TypedSpec().Value.Status = fmt.Sprintf("updating previously held machines %d/%d", pendingMachines, totalMachines)
+	default:
 		upgradeStatus.TypedSpec().Value.Phase = specs.TalosUpgradeStatusSpec_Reverting
 		upgradeStatus.TypedSpec().Value.Status = fmt.Sprintf("reverting update %d/%d", pendingMachines, totalMachines)
 	}
 
 	for _, machines := range [][]*omni.ClusterMachineTalosVersion{controlPlanes, workers} {
 		if len(machines) > 0 {
-			machine, err := updateMachines(ctx, r, logger, upgradeStatus, machines, machineSetNodes)
+			updatedMachines, err := updateMachines(ctx, r, logger, upgradeStatus, machines, machineSetNodes)
 			if err != nil {
 				if errors.Is(err, errMachineLocked) {
 					return nil
@@ -421,7 +611,11 @@ func reconcileTalosUpdateStatus(ctx context.Context, r controller.ReaderWriter,
 				return err
 			}
 
-			upgradeStatus.TypedSpec().Value.Step = fmt.Sprintf("current machine %s", machine)
+			if len(updatedMachines) == 1 {
+				upgradeStatus.TypedSpec().Value.Step = fmt.Sprintf("current machine %s", updatedMachines[0])
+			} else {
+				upgradeStatus.TypedSpec().Value.Step = fmt.Sprintf("current machines %s", strings.Join(updatedMachines, ", "))
+			}
 
 			break
 		}
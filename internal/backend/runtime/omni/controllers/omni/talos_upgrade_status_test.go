@@ -8,6 +8,7 @@ package omni_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
@@ -15,6 +16,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
@@ -41,7 +43,7 @@ func (suite *TalosUpgradeStatusSuite) TestReconcile() {
 
 	suite.Require().NoError(suite.state.Create(suite.ctx, clusterStatus))
 
-	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewTalosUpgradeStatusController()))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewTalosUpgradeStatusController(nil)))
 	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewSchematicConfigurationController(&imageFactoryClientMock{})))
 
 	for _, res := range machines {
@@ -190,8 +192,8 @@ func (suite *TalosUpgradeStatusSuite) TestReconcile() {
 func (suite *TalosUpgradeStatusSuite) TestUpdateVersionsMaintenance() {
 	suite.startRuntime()
 
-	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewTalosUpgradeStatusController()))
-	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewMachineSetStatusController()))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewTalosUpgradeStatusController(nil)))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewMachineSetStatusController(nil)))
 	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewSchematicConfigurationController(&imageFactoryClientMock{})))
 
 	clusterName := "talos-upgrade-cluster-2"
@@ -253,6 +255,105 @@ func (suite *TalosUpgradeStatusSuite) TestUpdateVersionsMaintenance() {
 	}
 }
 
+// TestReconcileRollingWorkers checks that the workers are upgraded in batches according to the update strategy of the machine set.
+func (suite *TalosUpgradeStatusSuite) TestReconcileRollingWorkers() {
+	suite.startRuntime()
+
+	clusterName := "talos-upgrade-rolling"
+
+	cluster, machines := suite.createCluster(clusterName, 1, 3)
+
+	_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, omni.NewMachineSet(resources.DefaultNamespace, omni.WorkersResourceID(clusterName)).Metadata(),
+		func(res *omni.MachineSet) error {
+			res.TypedSpec().Value.UpdateStrategyConfig = &specs.MachineSetSpec_UpdateStrategyConfig{
+				Rolling: &specs.MachineSetSpec_RollingUpdateStrategyConfig{
+					MaxParallelism: 2,
+				},
+			}
+
+			return nil
+		},
+	)
+	suite.Require().NoError(err)
+
+	clusterStatus := omni.NewClusterStatus(resources.DefaultNamespace, clusterName)
+	clusterStatus.TypedSpec().Value.Available = true
+	clusterStatus.TypedSpec().Value.Ready = true
+	clusterStatus.TypedSpec().Value.Phase = specs.ClusterStatusSpec_RUNNING
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, clusterStatus))
+
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewTalosUpgradeStatusController(nil)))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewSchematicConfigurationController(&imageFactoryClientMock{})))
+
+	for _, res := range machines {
+		configStatus := omni.NewClusterMachineConfigStatus(resources.DefaultNamespace, res.Metadata().ID())
+
+		helpers.CopyAllLabels(res, configStatus)
+
+		configStatus.TypedSpec().Value.ClusterMachineConfigSha256 = "aaaa"
+		configStatus.TypedSpec().Value.TalosVersion = cluster.TypedSpec().Value.TalosVersion
+		configStatus.TypedSpec().Value.SchematicId = defaultSchematic
+
+		suite.Require().NoError(suite.state.Create(suite.ctx, configStatus))
+	}
+
+	assertResource(
+		&suite.OmniSuite,
+		*omni.NewTalosUpgradeStatus(resources.DefaultNamespace, clusterName).Metadata(),
+		func(res *omni.TalosUpgradeStatus, assertions *assert.Assertions) {
+			assertions.Equal(TalosVersion, res.TypedSpec().Value.LastUpgradeVersion)
+		},
+	)
+
+	_, err = safe.StateUpdateWithConflicts(suite.ctx, suite.state, cluster.Metadata(), func(res *omni.Cluster) error {
+		res.TypedSpec().Value.TalosVersion = "1.3.6"
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	// the control plane goes first
+	assertResource(
+		&suite.OmniSuite,
+		*omni.NewTalosUpgradeStatus(resources.DefaultNamespace, clusterName).Metadata(),
+		func(res *omni.TalosUpgradeStatus, assertions *assert.Assertions) {
+			assertions.Equal(fmt.Sprintf("current machine %s", machines[0].Metadata().ID()), res.TypedSpec().Value.Step)
+		},
+	)
+
+	_, err = safe.StateUpdateWithConflicts(suite.ctx, suite.state, omni.NewClusterMachineConfigStatus(resources.DefaultNamespace, machines[0].Metadata().ID()).Metadata(),
+		func(res *omni.ClusterMachineConfigStatus) error {
+			res.TypedSpec().Value.TalosVersion = "1.3.6"
+
+			return nil
+		},
+	)
+	suite.Require().NoError(err)
+
+	// then two workers are upgraded at once
+	assertResource(
+		&suite.OmniSuite,
+		*omni.NewTalosUpgradeStatus(resources.DefaultNamespace, clusterName).Metadata(),
+		func(res *omni.TalosUpgradeStatus, assertions *assert.Assertions) {
+			assertions.Equal(fmt.Sprintf("current machines %s, %s", machines[1].Metadata().ID(), machines[2].Metadata().ID()), res.TypedSpec().Value.Step)
+		},
+	)
+
+	for i, res := range machines[1:] {
+		expectedVersion := "1.3.6"
+		if i == 2 {
+			expectedVersion = TalosVersion
+		}
+
+		rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, res.Metadata().ID(),
+			func(r *omni.ClusterMachineTalosVersion, assertion *assert.Assertions) {
+				assertion.Equal(expectedVersion, r.TypedSpec().Value.TalosVersion)
+			},
+		)
+	}
+}
+
 func (suite *TalosUpgradeStatusSuite) TestReconcileLocked() {
 	suite.startRuntime()
 
@@ -284,10 +385,10 @@ func (suite *TalosUpgradeStatusSuite) TestReconcileLocked() {
 
 		cmtv.TypedSpec().Value.TalosVersion = cluster.TypedSpec().Value.TalosVersion
 
-		suite.Require().NoError(suite.state.Create(suite.ctx, cmtv, state.WithCreateOwner(omnictrl.NewTalosUpgradeStatusController().ControllerName)))
+		suite.Require().NoError(suite.state.Create(suite.ctx, cmtv, state.WithCreateOwner(omnictrl.NewTalosUpgradeStatusController(nil).ControllerName)))
 	}
 
-	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewTalosUpgradeStatusController()))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewTalosUpgradeStatusController(nil)))
 	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewSchematicConfigurationController(&imageFactoryClientMock{})))
 
 	for _, res := range machines {
@@ -311,6 +412,204 @@ func (suite *TalosUpgradeStatusSuite) TestReconcileLocked() {
 	)
 }
 
+func (suite *TalosUpgradeStatusSuite) TestReconcileHeld() {
+	suite.startRuntime()
+
+	clusterName := "talos-upgrade-held"
+
+	cluster, machines := suite.createCluster(clusterName, 3, 1)
+
+	heldMachine := machines[3]
+
+	clusterStatus := omni.NewClusterStatus(resources.DefaultNamespace, clusterName)
+	clusterStatus.TypedSpec().Value.Available = true
+	clusterStatus.TypedSpec().Value.Ready = true
+	clusterStatus.TypedSpec().Value.Phase = specs.ClusterStatusSpec_RUNNING
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, clusterStatus))
+
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewTalosUpgradeStatusController(nil)))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewSchematicConfigurationController(&imageFactoryClientMock{})))
+
+	for _, res := range machines {
+		rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, res.Metadata().ID(),
+			func(version *omni.ClusterMachineTalosVersion, assertions *assert.Assertions) {
+				assertions.Equal(TalosVersion, version.TypedSpec().Value.TalosVersion)
+			},
+		)
+
+		configStatus := omni.NewClusterMachineConfigStatus(resources.DefaultNamespace, res.Metadata().ID())
+
+		helpers.CopyAllLabels(res, configStatus)
+
+		configStatus.TypedSpec().Value.ClusterMachineConfigSha256 = "aaaa"
+		configStatus.TypedSpec().Value.TalosVersion = TalosVersion
+		configStatus.TypedSpec().Value.SchematicId = defaultSchematic
+
+		suite.Require().NoError(suite.state.Create(suite.ctx, configStatus))
+	}
+
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, clusterName, func(res *omni.TalosUpgradeStatus, assertions *assert.Assertions) {
+		assertions.Equal(TalosVersion, res.TypedSpec().Value.LastUpgradeVersion)
+	})
+
+	setHold := func(hold bool) {
+		_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, resource.NewMetadata(resources.DefaultNamespace, omni.MachineSetNodeType, heldMachine.Metadata().ID(), resource.VersionUndefined),
+			func(res *omni.MachineSetNode) error {
+				if hold {
+					res.Metadata().Annotations().Set(omni.MachineUpgradeHold, "")
+				} else {
+					res.Metadata().Annotations().Delete(omni.MachineUpgradeHold)
+				}
+
+				return nil
+			},
+		)
+		suite.Require().NoError(err)
+	}
+
+	upgradeMachine := func(id resource.ID) {
+		rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, id, func(version *omni.ClusterMachineTalosVersion, assertions *assert.Assertions) {
+			assertions.Equal("1.3.6", version.TypedSpec().Value.TalosVersion)
+		})
+
+		_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, omni.NewClusterMachineConfigStatus(resources.DefaultNamespace, id).Metadata(),
+			func(res *omni.ClusterMachineConfigStatus) error {
+				res.TypedSpec().Value.TalosVersion = "1.3.6"
+
+				return nil
+			},
+		)
+		suite.Require().NoError(err)
+	}
+
+	setHold(true)
+
+	_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, cluster.Metadata(), func(res *omni.Cluster) error {
+		res.TypedSpec().Value.TalosVersion = "1.3.6"
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	for _, res := range machines[:3] {
+		upgradeMachine(res.Metadata().ID())
+	}
+
+	// the upgrade is done without the held machine
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, clusterName, func(res *omni.TalosUpgradeStatus, assertions *assert.Assertions) {
+		assertions.Equal(specs.TalosUpgradeStatusSpec_Done, res.TypedSpec().Value.Phase)
+		assertions.Equal("1.3.6", res.TypedSpec().Value.LastUpgradeVersion)
+		assertions.Equal([]string{heldMachine.Metadata().ID()}, res.TypedSpec().Value.HeldMachines)
+		assertions.Equal("1 machine(s) held on the previous version", res.TypedSpec().Value.Status)
+	})
+
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, heldMachine.Metadata().ID(), func(version *omni.ClusterMachineTalosVersion, assertions *assert.Assertions) {
+		assertions.Equal(TalosVersion, version.TypedSpec().Value.TalosVersion)
+	})
+
+	// the held machine is upgraded after the hold is removed
+	setHold(false)
+
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, clusterName, func(res *omni.TalosUpgradeStatus, assertions *assert.Assertions) {
+		assertions.Equal(specs.TalosUpgradeStatusSpec_Upgrading, res.TypedSpec().Value.Phase)
+		assertions.Equal("updating previously held machines 4/4", res.TypedSpec().Value.Status)
+		assertions.Empty(res.TypedSpec().Value.HeldMachines)
+	})
+
+	upgradeMachine(heldMachine.Metadata().ID())
+
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, clusterName, func(res *omni.TalosUpgradeStatus, assertions *assert.Assertions) {
+		assertions.Equal(specs.TalosUpgradeStatusSpec_Done, res.TypedSpec().Value.Phase)
+		assertions.Empty(res.TypedSpec().Value.Status)
+	})
+}
+
+func (suite *TalosUpgradeStatusSuite) TestReconcileMaintenanceWindow() {
+	suite.startRuntime()
+
+	clusterName := "talos-upgrade-maintenance-window"
+
+	cluster, machines := suite.createCluster(clusterName, 1, 1)
+
+	clusterStatus := omni.NewClusterStatus(resources.DefaultNamespace, clusterName)
+	clusterStatus.TypedSpec().Value.Available = true
+	clusterStatus.TypedSpec().Value.Ready = true
+	clusterStatus.TypedSpec().Value.Phase = specs.ClusterStatusSpec_RUNNING
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, clusterStatus))
+
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewTalosUpgradeStatusController(nil)))
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewSchematicConfigurationController(&imageFactoryClientMock{})))
+
+	for _, res := range machines {
+		rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, res.Metadata().ID(),
+			func(version *omni.ClusterMachineTalosVersion, assertions *assert.Assertions) {
+				assertions.Equal(TalosVersion, version.TypedSpec().Value.TalosVersion)
+			},
+		)
+
+		configStatus := omni.NewClusterMachineConfigStatus(resources.DefaultNamespace, res.Metadata().ID())
+
+		helpers.CopyAllLabels(res, configStatus)
+
+		configStatus.TypedSpec().Value.ClusterMachineConfigSha256 = "aaaa"
+		configStatus.TypedSpec().Value.TalosVersion = TalosVersion
+		configStatus.TypedSpec().Value.SchematicId = defaultSchematic
+
+		suite.Require().NoError(suite.state.Create(suite.ctx, configStatus))
+	}
+
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, clusterName, func(res *omni.TalosUpgradeStatus, assertions *assert.Assertions) {
+		assertions.Equal(TalosVersion, res.TypedSpec().Value.LastUpgradeVersion)
+	})
+
+	// the window opens in 12 hours
+	opensAt := time.Now().UTC().Add(12 * time.Hour)
+
+	window := omni.NewMaintenanceWindow(resources.DefaultNamespace, clusterName)
+	window.TypedSpec().Value.Schedule = fmt.Sprintf("%d %d * * *", opensAt.Minute(), opensAt.Hour())
+	window.TypedSpec().Value.Duration = durationpb.New(time.Hour)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, window))
+
+	_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, cluster.Metadata(), func(res *omni.Cluster) error {
+		res.TypedSpec().Value.TalosVersion = "1.3.6"
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, clusterName, func(res *omni.TalosUpgradeStatus, assertions *assert.Assertions) {
+		assertions.Equal(specs.TalosUpgradeStatusSpec_Upgrading, res.TypedSpec().Value.Phase)
+		assertions.Equal("upgrade queued", res.TypedSpec().Value.Step)
+		assertions.Contains(res.TypedSpec().Value.Status, "queued until the next maintenance window")
+		assertions.NotNil(res.TypedSpec().Value.NextMaintenanceWindow)
+	})
+
+	for _, res := range machines {
+		rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, res.Metadata().ID(), func(version *omni.ClusterMachineTalosVersion, assertions *assert.Assertions) {
+			assertions.Equal(TalosVersion, version.TypedSpec().Value.TalosVersion)
+		})
+	}
+
+	// the upgrade starts once the window is open
+	_, err = safe.StateUpdateWithConflicts(suite.ctx, suite.state, window.Metadata(), func(res *omni.MaintenanceWindow) error {
+		res.TypedSpec().Value.Schedule = "* * * * *"
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, machines[0].Metadata().ID(), func(version *omni.ClusterMachineTalosVersion, assertions *assert.Assertions) {
+		assertions.Equal("1.3.6", version.TypedSpec().Value.TalosVersion)
+	})
+
+	rtestutils.AssertResource(suite.ctx, suite.T(), suite.state, clusterName, func(res *omni.TalosUpgradeStatus, assertions *assert.Assertions) {
+		assertions.Nil(res.TypedSpec().Value.NextMaintenanceWindow)
+	})
+}
+
 func TestTalosUpgradeStatusSuite(t *testing.T) {
 	t.Parallel()
 
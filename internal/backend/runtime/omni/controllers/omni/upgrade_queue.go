@@ -0,0 +1,76 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"time"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/upgradequeue"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+// UpgradeQueue limits the number of the clusters running Talos or Kubernetes upgrades at the same time.
+//
+// It is shared by TalosUpgradeStatusController and KubernetesUpgradeStatusController, both upgrades of the same cluster take a single slot.
+// The nil UpgradeQueue doesn't limit the upgrades.
+type UpgradeQueue struct {
+	queue      *upgradequeue.Queue
+	tunables   *Tunables
+	groupLabel string
+}
+
+// NewUpgradeQueue creates a new UpgradeQueue.
+func NewUpgradeQueue(params config.UpgradeConcurrencyParams, tunables *Tunables) *UpgradeQueue {
+	return &UpgradeQueue{
+		queue: upgradequeue.New(upgradequeue.Limits{
+			Concurrency:      params.Limit,
+			GroupConcurrency: params.GroupLimit,
+		}),
+		tunables:   tunables,
+		groupLabel: params.GroupLabel,
+	}
+}
+
+// pollInterval returns the interval of checking if the queued upgrade can be started.
+func (q *UpgradeQueue) pollInterval() time.Duration {
+	if q == nil {
+		return DefaultTunableValues().UpgradeQueuePollInterval
+	}
+
+	return q.tunables.Get().UpgradeQueuePollInterval
+}
+
+// acquire returns the position of the cluster in the upgrade queue, zero if the upgrade can be started.
+//
+// The upgrades which were already running are resumed without checking the limits, as the queue is not persisted.
+func (q *UpgradeQueue) acquire(cluster *omni.Cluster, owner string, running bool) int {
+	if q == nil {
+		return 0
+	}
+
+	var group string
+
+	if q.groupLabel != "" {
+		group, _ = cluster.Metadata().Labels().Get(q.groupLabel)
+	}
+
+	if running {
+		q.queue.Resume(cluster.Metadata().ID(), group, owner)
+
+		return 0
+	}
+
+	return q.queue.Acquire(cluster.Metadata().ID(), group, owner)
+}
+
+func (q *UpgradeQueue) release(clusterID, owner string) {
+	if q == nil {
+		return
+	}
+
+	q.queue.Release(clusterID, owner)
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/controller/generic/qtransform"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/xerrors"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/mappers"
+)
+
+// UserVolumesConfigPatchPrefix is the prefix for the system config patch that contains the user volumes of the cluster machine.
+const UserVolumesConfigPatchPrefix = "950-user-volumes-"
+
+// UserVolumesConfigPatchController creates the system config patch that contains the user volumes defined in the machine set of the cluster machine.
+//
+// The volumes are validated against the disks of the machine before the patch is created.
+type UserVolumesConfigPatchController = qtransform.QController[*omni.ClusterMachine, *omni.ConfigPatch]
+
+// NewUserVolumesConfigPatchController initializes UserVolumesConfigPatchController.
+func NewUserVolumesConfigPatchController() *UserVolumesConfigPatchController {
+	return qtransform.NewQController(
+		qtransform.Settings[*omni.ClusterMachine, *omni.ConfigPatch]{
+			Name: "UserVolumesConfigPatchController",
+			MapMetadataFunc: func(clusterMachine *omni.ClusterMachine) *omni.ConfigPatch {
+				return omni.NewConfigPatch(resources.DefaultNamespace, UserVolumesConfigPatchPrefix+clusterMachine.Metadata().ID())
+			},
+			UnmapMetadataFunc: func(configPatch *omni.ConfigPatch) *omni.ClusterMachine {
+				id := strings.TrimPrefix(configPatch.Metadata().ID(), UserVolumesConfigPatchPrefix)
+
+				return omni.NewClusterMachine(resources.DefaultNamespace, id)
+			},
+			TransformExtraOutputFunc: func(ctx context.Context, r controller.ReaderWriter, _ *zap.Logger, clusterMachine *omni.ClusterMachine, configPatch *omni.ConfigPatch) error {
+				clusterName, ok := clusterMachine.Metadata().Labels().Get(omni.LabelCluster)
+				if !ok {
+					return xerrors.NewTaggedf[qtransform.DestroyOutputTag]("cluster machine doesn't have the cluster label")
+				}
+
+				machineSetName, ok := clusterMachine.Metadata().Labels().Get(omni.LabelMachineSet)
+				if !ok {
+					return xerrors.NewTaggedf[qtransform.DestroyOutputTag]("cluster machine doesn't have the machine set label")
+				}
+
+				machineSet, err := safe.ReaderGetByID[*omni.MachineSet](ctx, r, machineSetName)
+				if err != nil {
+					if state.IsNotFoundError(err) {
+						return xerrors.NewTaggedf[qtransform.DestroyOutputTag]("machine set doesn't exist")
+					}
+
+					return err
+				}
+
+				volumes := machineSet.TypedSpec().Value.UserVolumes
+				if len(volumes) == 0 {
+					return xerrors.NewTaggedf[qtransform.DestroyOutputTag]("machine set doesn't have user volumes")
+				}
+
+				machineStatus, err := safe.ReaderGetByID[*omni.MachineStatus](ctx, r, clusterMachine.Metadata().ID())
+				if err != nil {
+					if state.IsNotFoundError(err) {
+						return xerrors.NewTagged[qtransform.SkipReconcileTag](err)
+					}
+
+					return err
+				}
+
+				if machineStatus.TypedSpec().Value.Hardware == nil {
+					return xerrors.NewTagged[qtransform.SkipReconcileTag](errors.New("machine hardware status is not known yet"))
+				}
+
+				installDisk := omni.GetMachineStatusSystemDisk(machineStatus)
+
+				genOptions, err := safe.ReaderGetByID[*omni.MachineConfigGenOptions](ctx, r, clusterMachine.Metadata().ID())
+				if err != nil && !state.IsNotFoundError(err) {
+					return err
+				}
+
+				if installDisk == "" && genOptions != nil {
+					installDisk = genOptions.TypedSpec().Value.InstallDisk
+				}
+
+				if err = validateUserVolumeDisks(volumes, machineStatus.TypedSpec().Value.Hardware.Blockdevices, installDisk); err != nil {
+					return fmt.Errorf("user volumes of the machine set %q don't match the disks of the machine %q: %w", machineSetName, clusterMachine.Metadata().ID(), err)
+				}
+
+				patchYAML, err := renderUserVolumesPatch(volumes)
+				if err != nil {
+					return err
+				}
+
+				configPatch.Metadata().Labels().Set(omni.LabelSystemPatch, "")
+				configPatch.Metadata().Labels().Set(omni.LabelCluster, clusterName)
+				configPatch.Metadata().Labels().Set(omni.LabelClusterMachine, clusterMachine.Metadata().ID())
+
+				configPatch.TypedSpec().Value.Data = patchYAML
+
+				return nil
+			},
+		},
+		qtransform.WithExtraMappedInput(
+			mappers.MapMachineSetToLabeledResources[*omni.MachineSet, *omni.ClusterMachine](),
+		),
+		qtransform.WithExtraMappedInput(
+			qtransform.MapperSameID[*omni.MachineStatus, *omni.ClusterMachine](),
+		),
+		qtransform.WithExtraMappedInput(
+			qtransform.MapperSameID[*omni.MachineConfigGenOptions, *omni.ClusterMachine](),
+		),
+		qtransform.WithConcurrency(2),
+		qtransform.WithOutputKind(controller.OutputShared),
+	)
+}
+
+// validateUserVolumeDisks checks that the disks of the user volumes exist on the machine, are not used for the installation and fit the volumes.
+func validateUserVolumeDisks(volumes []*specs.MachineSetSpec_UserVolume, disks []*specs.MachineStatusSpec_HardwareStatus_BlockDevice, installDisk string) error {
+	diskSizes := make(map[string]uint64, len(disks))
+
+	for _, disk := range disks {
+		diskSizes[disk.LinuxName] = disk.Size
+	}
+
+	volumesSize := map[string]uint64{}
+
+	var errs []error
+
+	for _, volume := range volumes {
+		if volume.Disk == installDisk {
+			errs = append(errs, fmt.Errorf("disk %q is used for the installation", volume.Disk))
+
+			continue
+		}
+
+		if _, ok := diskSizes[volume.Disk]; !ok {
+			errs = append(errs, fmt.Errorf("disk %q doesn't exist", volume.Disk))
+
+			continue
+		}
+
+		volumesSize[volume.Disk] += volume.Size
+	}
+
+	for disk, size := range volumesSize {
+		if size > diskSizes[disk] {
+			errs = append(errs, fmt.Errorf("volumes of total size %d don't fit the disk %q of size %d", size, disk, diskSizes[disk]))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func renderUserVolumesPatch(volumes []*specs.MachineSetSpec_UserVolume) (string, error) {
+	var machineDisks []*v1alpha1.MachineDisk
+
+	disks := map[string]*v1alpha1.MachineDisk{}
+
+	for _, volume := range volumes {
+		disk, ok := disks[volume.Disk]
+		if !ok {
+			disk = &v1alpha1.MachineDisk{
+				DeviceName: volume.Disk,
+			}
+
+			disks[volume.Disk] = disk
+
+			machineDisks = append(machineDisks, disk)
+		}
+
+		disk.DiskPartitions = append(disk.DiskPartitions, &v1alpha1.DiskPartition{
+			DiskSize:       v1alpha1.DiskSize(volume.Size),
+			DiskMountPoint: volume.MountPoint,
+		})
+	}
+
+	var sb strings.Builder
+
+	encoder := yaml.NewEncoder(&sb)
+
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(map[string]any{
+		"machine": map[string]any{
+			"disks": machineDisks,
+		},
+	}); err != nil {
+		return "", fmt.Errorf("failed to encode patch: %w", err)
+	}
+
+	return sb.String(), nil
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+)
+
+type UserVolumesConfigPatchSuite struct {
+	OmniSuite
+}
+
+func (suite *UserVolumesConfigPatchSuite) TestReconcile() {
+	suite.startRuntime()
+
+	suite.Require().NoError(suite.runtime.RegisterQController(omnictrl.NewUserVolumesConfigPatchController()))
+
+	machineSet := omni.NewMachineSet(resources.DefaultNamespace, "test-cluster-workers")
+	machineSet.TypedSpec().Value.UserVolumes = []*specs.MachineSetSpec_UserVolume{
+		{Disk: "/dev/sdb", MountPoint: "/var/mnt/data", Size: 100e9},
+		{Disk: "/dev/sdb", MountPoint: "/var/mnt/logs"},
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineSet))
+
+	machineStatus := omni.NewMachineStatus(resources.DefaultNamespace, "machine-1")
+	machineStatus.TypedSpec().Value.Hardware = &specs.MachineStatusSpec_HardwareStatus{
+		Blockdevices: []*specs.MachineStatusSpec_HardwareStatus_BlockDevice{
+			{LinuxName: "/dev/sda", Size: 50e9, SystemDisk: true},
+			{LinuxName: "/dev/sdb", Size: 50e9},
+		},
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, machineStatus))
+
+	clusterMachine := omni.NewClusterMachine(resources.DefaultNamespace, "machine-1")
+	clusterMachine.Metadata().Labels().Set(omni.LabelCluster, "test-cluster")
+	clusterMachine.Metadata().Labels().Set(omni.LabelMachineSet, machineSet.Metadata().ID())
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, clusterMachine))
+
+	patchID := omnictrl.UserVolumesConfigPatchPrefix + clusterMachine.Metadata().ID()
+
+	// the volumes don't fit the disk, so the patch is not created
+	rtestutils.AssertNoResource[*omni.ConfigPatch](suite.ctx, suite.T(), suite.state, patchID)
+
+	_, err := safe.StateUpdateWithConflicts(suite.ctx, suite.state, machineStatus.Metadata(), func(res *omni.MachineStatus) error {
+		res.TypedSpec().Value.Hardware.Blockdevices[1].Size = 500e9
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	rtestutils.AssertResource[*omni.ConfigPatch](suite.ctx, suite.T(), suite.state, patchID, func(r *omni.ConfigPatch, assertion *assert.Assertions) {
+		clusterMachineID, _ := r.Metadata().Labels().Get(omni.LabelClusterMachine)
+
+		assertion.Equal(clusterMachine.Metadata().ID(), clusterMachineID)
+		assertion.Equal(`machine:
+  disks:
+    - device: /dev/sdb
+      partitions:
+        - size: 100 GB
+          mountpoint: /var/mnt/data
+        - mountpoint: /var/mnt/logs
+`, r.TypedSpec().Value.Data)
+	})
+
+	_, err = safe.StateUpdateWithConflicts(suite.ctx, suite.state, machineSet.Metadata(), func(res *omni.MachineSet) error {
+		res.TypedSpec().Value.UserVolumes = nil
+
+		return nil
+	})
+	suite.Require().NoError(err)
+
+	// assert that the config patch is removed
+	rtestutils.AssertNoResource[*omni.ConfigPatch](suite.ctx, suite.T(), suite.state, patchID)
+}
+
+func TestUserVolumesConfigPatchSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(UserVolumesConfigPatchSuite))
+}
@@ -8,8 +8,11 @@ package omni
 import (
 	"context"
 
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/prometheus/client_golang/prometheus"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
 
@@ -42,6 +45,18 @@ func MachineSetValidationOptions(st state.State, etcdBackupStoreFactory store.Fa
 	return machineSetValidationOptions(st, etcdBackupStoreFactory)
 }
 
+func ClusterLockValidationOptions(st state.State) []validated.StateOption {
+	return clusterLockValidationOptions(st)
+}
+
+func QuotaValidationOptions(st state.State) []validated.StateOption {
+	return quotaValidationOptions(st)
+}
+
+func LabelPolicyValidationOptions(params config.LabelPolicyParams) ([]validated.StateOption, error) {
+	return labelPolicyValidationOptions(params)
+}
+
 func MachineSetNodeValidationOptions(st state.State) []validated.StateOption {
 	return machineSetNodeValidationOptions(st)
 }
@@ -70,6 +85,52 @@ func S3ConfigValidationOptions() []validated.StateOption {
 	return s3ConfigValidationOptions()
 }
 
+func EtcdBackupStorageConfigValidationOptions() []validated.StateOption {
+	return etcdBackupStorageConfigValidationOptions()
+}
+
+func SettingsValidationOptions() []validated.StateOption {
+	return settingsValidationOptions()
+}
+
 func SchematicConfigurationValidationOptions() []validated.StateOption {
 	return schematicConfigurationValidationOptions()
 }
+
+func WrapStateWithAuthorship(st state.CoreState) state.CoreState { //nolint:ireturn
+	return wrapStateWithAuthorship(st)
+}
+
+func WrapStateWithDestroyReason(st state.CoreState, params config.DestroyReasonParams, logger *zap.Logger) state.CoreState { //nolint:ireturn
+	return wrapStateWithDestroyReason(st, params, logger)
+}
+
+func WrapStateWithCache(ctx context.Context, st state.CoreState, logger *zap.Logger, kinds ...resource.Kind) state.CoreState { //nolint:ireturn
+	cache := wrapStateWithCache(st, logger, kinds...)
+
+	go cache.Run(ctx)
+
+	return cache
+}
+
+func ACLValidationOptions(st state.State) []validated.StateOption {
+	return aclValidationOptions(st)
+}
+
+func NewControllerMetrics(admission config.ControllerAdmissionParams, priorities map[string]string) prometheus.Collector { //nolint:ireturn
+	controllerPriorities := make(map[string]controllerPriority, len(priorities))
+
+	for name, priority := range priorities {
+		controllerPriorities[name] = controllerPriority(priority)
+	}
+
+	return newControllerMetrics(admission, controllerPriorities)
+}
+
+func WrapControllerWithMetrics(m prometheus.Collector, ctrl controller.Controller) controller.Controller { //nolint:ireturn
+	return m.(*controllerMetrics).wrapController(ctrl) //nolint:forcetypeassert,errcheck
+}
+
+func WrapQControllerWithMetrics(m prometheus.Collector, ctrl controller.QController) controller.QController { //nolint:ireturn
+	return m.(*controllerMetrics).wrapQController(ctrl) //nolint:forcetypeassert,errcheck
+}
@@ -100,7 +100,7 @@ func (s *State) List(ctx context.Context, kind resource.Kind, opts ...state.List
 		return resource.List{}, fmt.Errorf("failed to get cluster UUID by ID: %w", err)
 	}
 
-	st, err := s.StoreFactory.GetStore()
+	st, err := store.GetClusterStore(s.StoreFactory, parsed.ClusterID)
 	if err != nil {
 		return resource.List{}, fmt.Errorf("failed to get store: %w", err)
 	}
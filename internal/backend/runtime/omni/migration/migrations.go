@@ -951,9 +951,7 @@ func machineInstallDiskPatches(ctx context.Context, st state.State, _ *zap.Logge
 		}
 
 		if err = createOrUpdate(ctx, st, omni.NewMachineConfigGenOptions(resources.DefaultNamespace, item.Metadata().ID()), func(res *omni.MachineConfigGenOptions) error {
-			omnictrl.GenInstallConfig(machineStatus, nil, res)
-
-			return nil
+			return omnictrl.GenInstallConfig(machineStatus, nil, nil, res)
 		}, omnictrl.MachineConfigGenOptionsControllerName); err != nil {
 			return err
 		}
@@ -1279,9 +1277,7 @@ func migrateInstallImageConfigIntoGenOptions(ctx context.Context, st state.State
 		}
 
 		if _, err = safe.StateUpdateWithConflicts(ctx, st, genOptions.Metadata(), func(res *omni.MachineConfigGenOptions) error {
-			omnictrl.GenInstallConfig(machineStatus, talosVersion, res)
-
-			return nil
+			return omnictrl.GenInstallConfig(machineStatus, talosVersion, nil, res)
 		}, state.WithUpdateOwner(genOptions.Metadata().Owner())); err != nil {
 			return err
 		}
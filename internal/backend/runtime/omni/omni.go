@@ -35,7 +35,7 @@ import (
 	virtualres "github.com/siderolabs/omni/client/pkg/omni/resources/virtual"
 	pkgruntime "github.com/siderolabs/omni/client/pkg/runtime"
 	"github.com/siderolabs/omni/internal/backend/dns"
-	"github.com/siderolabs/omni/internal/backend/imagefactory"
+	"github.com/siderolabs/omni/internal/backend/logging"
 	"github.com/siderolabs/omni/internal/backend/resourcelogger"
 	"github.com/siderolabs/omni/internal/backend/runtime"
 	"github.com/siderolabs/omni/internal/backend/runtime/cosi"
@@ -43,15 +43,16 @@ import (
 	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup/store"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/image"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/notification"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/validated"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/virtual"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/virtual/pkg/producers"
 	"github.com/siderolabs/omni/internal/backend/runtime/talos"
 	"github.com/siderolabs/omni/internal/backend/workloadproxy"
 	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/certs"
 	"github.com/siderolabs/omni/internal/pkg/config"
 	newgroup "github.com/siderolabs/omni/internal/pkg/errgroup"
-	"github.com/siderolabs/omni/internal/pkg/siderolink"
 )
 
 // Name is the runtime time.
@@ -71,6 +72,12 @@ type Runtime struct {
 	state   state.State
 	virtual *virtual.State
 
+	// dryRunState validates the writes without persisting them
+	dryRunState state.State
+
+	// watchHub serves the identical watches of the API clients from the shared watches
+	watchHub *cosi.WatchHub
+
 	logger *zap.Logger
 }
 
@@ -78,10 +85,15 @@ type Runtime struct {
 //
 //nolint:maintidx
 func New(talosClientFactory *talos.ClientFactory, dnsService *dns.Service, workloadProxyReconciler *workloadproxy.Reconciler,
-	resourceLogger *resourcelogger.Logger, imageFactoryClient *imagefactory.Client, linkCounterDeltaCh <-chan siderolink.LinkCounterDeltas,
-	siderolinkEventsCh <-chan *omni.MachineStatusSnapshot, resourceState state.State, virtualState *virtual.State, metricsRegistry prometheus.Registerer,
-	defaultDiscoveryClient, embeddedDiscoveryClient omnictrl.DiscoveryClient, logger *zap.Logger,
+	resourceState state.State, metricsRegistry prometheus.Registerer, defaultDiscoveryClient omnictrl.DiscoveryClient, logger *zap.Logger,
+	opt ...Option,
 ) (*Runtime, error) {
+	var runtimeOptions Options
+
+	for _, o := range opt {
+		o(&runtimeOptions)
+	}
+
 	var opts []options.Option
 
 	if !config.Config.DisableControllerRuntimeCache {
@@ -153,11 +165,17 @@ func New(talosClientFactory *talos.ClientFactory, dnsService *dns.Service, workl
 		return nil, err
 	}
 
+	tunables := omnictrl.NewTunables(omnictrl.DefaultTunableValues())
+
 	controllers := []controller.Controller{
 		omnictrl.NewCertRefreshTickController(constants.CertificateValidityTime / 10), // issue ticks at 10% of the validity, as we refresh certificates at 50% of the validity
 		omnictrl.NewClusterController(),
+		omnictrl.NewClusterAvailabilityController(time.Minute),
+		omnictrl.NewClusterStatusHistoryController(5 * time.Minute),
 		omnictrl.NewMachineSetController(),
+		omnictrl.NewMachineSetScalingHistoryController(),
 		&omnictrl.ClusterDestroyStatusController{},
+		omnictrl.NewClusterTTLController(time.Minute, time.Hour),
 		&omnictrl.ClusterMachineIdentityController{},
 		&omnictrl.ClusterMachineStatusMetricsController{},
 		omnictrl.NewClusterMachineController(),
@@ -170,12 +188,14 @@ func New(talosClientFactory *talos.ClientFactory, dnsService *dns.Service, workl
 			TalosClientFactory: talosClientFactory,
 			NodeResolver:       dnsService,
 		}),
-		omnictrl.NewKubernetesStatusController(config.Config.APIURL, config.Config.WorkloadProxying.Subdomain),
+		omnictrl.NewKubernetesStatusController(config.Config.APIURL, config.Config.WorkloadProxying.Subdomain, workloadProxyReconciler),
 		&omnictrl.LoadBalancerController{},
 		&omnictrl.MachineSetNodeController{},
 		&omnictrl.MachineSetDestroyStatusController{},
+		&omnictrl.MachineMoveController{},
 		omnictrl.NewMachineCleanupController(),
-		omnictrl.NewMachineStatusLinkController(linkCounterDeltaCh),
+		omnictrl.NewMachineStatusLinkController(runtimeOptions.linkCounterDeltaCh),
+		omnictrl.NewMachineLinkStatusController(runtimeOptions.linkSampleCh),
 		&omnictrl.MachineStatusMetricsController{},
 		&omnictrl.VersionsController{},
 		omnictrl.NewClusterLoadBalancerController(
@@ -187,8 +207,12 @@ func New(talosClientFactory *talos.ClientFactory, dnsService *dns.Service, workl
 			config.Config.KeyPruner.Interval,
 		),
 		&omnictrl.OngoingTaskController{},
+		omnictrl.NewRuntimeConfigurationController(tunables),
+		omnictrl.NewNotificationController(notification.NewSender(10*time.Minute, time.Second)),
 	}
 
+	upgradeQueue := omnictrl.NewUpgradeQueue(config.Config.UpgradeConcurrency, tunables)
+
 	qcontrollers := []controller.QController{
 		destroy.NewController[*siderolinkresources.Link](optional.Some[uint](4)),
 
@@ -197,35 +221,57 @@ func New(talosClientFactory *talos.ClientFactory, dnsService *dns.Service, workl
 		omnictrl.NewClusterConfigVersionController(),
 		omnictrl.NewClusterEndpointController(),
 		omnictrl.NewClusterKubernetesNodesController(),
+		omnictrl.NewClusterNodeVersionsController(),
 		omnictrl.NewClusterMachineConfigController(config.Config.DefaultConfigGenOptions),
-		omnictrl.NewClusterMachineTeardownController(defaultDiscoveryClient, embeddedDiscoveryClient),
+		omnictrl.NewClusterMachineTeardownController(defaultDiscoveryClient, runtimeOptions.embeddedDiscoveryClient, tunables),
 		omnictrl.NewMachineConfigGenOptionsController(),
-		omnictrl.NewMachineStatusController(imageFactoryClient),
-		omnictrl.NewClusterMachineConfigStatusController(),
+		omnictrl.NewMachineStatusController(runtimeOptions.imageFactoryClient, config.Config.MachinePolling.MinInterval, config.Config.MachinePolling.MaxInterval),
+		omnictrl.NewClusterMachineConfigStatusController(config.Config.ConfigApply.Concurrency, config.Config.SchematicDriftRemediation),
 		omnictrl.NewClusterMachineEncryptionKeyController(),
 		omnictrl.NewClusterMachineStatusController(),
 		omnictrl.NewClusterStatusController(config.Config.EmbeddedDiscoveryService.Enabled),
 		omnictrl.NewClusterUUIDController(),
 		omnictrl.NewControlPlaneStatusController(),
 		omnictrl.NewDiscoveryServiceConfigPatchController(config.Config.EmbeddedDiscoveryService.Port),
-		omnictrl.NewKubernetesNodeAuditController(nil, time.Minute),
+		omnictrl.NewDiscoveryKeyRotationController(),
+		omnictrl.NewTalosSecretsRotationController(),
+		omnictrl.NewKubernetesNodeAuditController(nil, tunables),
 		omnictrl.NewEtcdBackupEncryptionController(),
 		omnictrl.NewClusterWorkloadProxyStatusController(workloadProxyReconciler),
 		omnictrl.NewKubeconfigController(constants.CertificateValidityTime),
 		omnictrl.NewKubernetesUpgradeManifestStatusController(),
-		omnictrl.NewKubernetesUpgradeStatusController(),
+		omnictrl.NewKubernetesUpgradeStatusController(upgradeQueue),
 		omnictrl.NewMachineController(),
 		omnictrl.NewMachineExtensionsController(),
-		omnictrl.NewMachineSetStatusController(),
-		omnictrl.NewMachineSetEtcdAuditController(talosClientFactory, time.Minute),
+		omnictrl.NewMachineSetStatusController(tunables),
+		omnictrl.NewMachineSetDefaultExtensionsController(),
+		omnictrl.NewMachineSetEtcdAuditController(talosClientFactory, tunables),
 		omnictrl.NewMaintenanceConfigPatchController(config.Config.EventSinkPort),
 		omnictrl.NewRedactedClusterMachineConfigController(),
-		omnictrl.NewSchematicConfigurationController(imageFactoryClient),
+		omnictrl.NewSchematicConfigurationController(runtimeOptions.imageFactoryClient),
+		omnictrl.NewSchematicDriftStatusController(config.Config.SchematicDriftRemediation),
+		omnictrl.NewMachineBootHistoryController(config.Config.CrashLoopDetection.Boots, config.Config.CrashLoopDetection.Window),
+		omnictrl.NewMachineWipeStatusController(),
 		omnictrl.NewSecretsController(storeFactory),
 		omnictrl.NewTalosConfigController(constants.CertificateValidityTime),
-		omnictrl.NewTalosExtensionsController(imageFactoryClient),
-		omnictrl.NewTalosUpgradeStatusController(),
-		omnictrl.NewMachineStatusSnapshotController(siderolinkEventsCh),
+		omnictrl.NewTalosExtensionsController(runtimeOptions.imageFactoryClient),
+		omnictrl.NewTalosUpgradeStatusController(upgradeQueue),
+		omnictrl.NewMachineStatusSnapshotController(runtimeOptions.siderolinkEventsCh),
+		omnictrl.NewUserVolumesConfigPatchController(),
+		omnictrl.NewReadinessGatesConfigPatchController(),
+		omnictrl.NewAutoscalerConfigPatchController(),
+	}
+
+	if levels := logging.DefaultLevels(); levels != nil {
+		controllers = append(controllers,
+			omnictrl.NewLogLevelController(levels),
+		)
+	}
+
+	if config.Config.EmbeddedDiscoveryService.Enabled && runtimeOptions.embeddedDiscoveryClient != nil {
+		controllers = append(controllers,
+			omnictrl.NewDiscoveryAffiliateController(runtimeOptions.embeddedDiscoveryClient, 30*time.Second, config.Config.EmbeddedDiscoveryService.StaleAffiliateTimeout),
+		)
 	}
 
 	if config.Config.Auth.SAML.Enabled {
@@ -234,8 +280,10 @@ func New(talosClientFactory *talos.ClientFactory, dnsService *dns.Service, workl
 		)
 	}
 
+	controllerMetrics := newControllerMetrics(config.Config.ControllerAdmission, controllerPriorities)
+
 	for _, c := range controllers {
-		if err = controllerRuntime.RegisterController(c); err != nil {
+		if err = controllerRuntime.RegisterController(controllerMetrics.wrapController(c)); err != nil {
 			return nil, err
 		}
 
@@ -245,7 +293,7 @@ func New(talosClientFactory *talos.ClientFactory, dnsService *dns.Service, workl
 	}
 
 	for _, c := range qcontrollers {
-		if err = controllerRuntime.RegisterQController(c); err != nil {
+		if err = controllerRuntime.RegisterQController(controllerMetrics.wrapQController(c)); err != nil {
 			return nil, err
 		}
 
@@ -254,6 +302,8 @@ func New(talosClientFactory *talos.ClientFactory, dnsService *dns.Service, workl
 		}
 	}
 
+	metricsRegistry.MustRegister(controllerMetrics)
+
 	//nolint:lll
 	expvarCollector := collectors.NewExpvarCollector(map[string]*prometheus.Desc{
 		"controller_crashes":         prometheus.NewDesc("omni_runtime_controller_crashes", "Number of controller-runtime controller crashes by controller name.", []string{"controller"}, nil),
@@ -275,6 +325,11 @@ func New(talosClientFactory *talos.ClientFactory, dnsService *dns.Service, workl
 
 	metricsRegistry.MustRegister(expvarCollector)
 
+	labelPolicyOptions, err := labelPolicyValidationOptions(config.Config.LabelPolicy)
+	if err != nil {
+		return nil, err
+	}
+
 	validationOptions := slices.Concat(
 		clusterValidationOptions(resourceState, config.Config.EtcdBackup, config.Config.EmbeddedDiscoveryService),
 		relationLabelsValidationOptions(),
@@ -283,23 +338,51 @@ func New(talosClientFactory *talos.ClientFactory, dnsService *dns.Service, workl
 		roleValidationOptions(),
 		machineSetNodeValidationOptions(resourceState),
 		machineSetValidationOptions(resourceState, storeFactory),
+		clusterLockValidationOptions(resourceState),
+		quotaValidationOptions(resourceState),
 		identityValidationOptions(config.Config.Auth.SAML),
 		exposedServiceValidationOptions(),
+		exposedServiceAccessPolicyValidationOptions(),
 		configPatchValidationOptions(resourceState),
 		etcdManualBackupValidationOptions(),
 		samlLabelRuleValidationOptions(),
 		s3ConfigValidationOptions(),
+		etcdBackupStorageConfigValidationOptions(),
+		logLevelConfigValidationOptions(),
+		runtimeConfigurationValidationOptions(),
+		settingsValidationOptions(),
+		defaultExtensionsValidationOptions(),
+		kernelArgsConfigurationValidationOptions(),
+		notificationConfigValidationOptions(),
+		maintenanceWindowValidationOptions(),
+		labelPolicyOptions,
 	)
 
+	// the authorship and the deletion reasons are recorded in the resources before they are validated
+	destroyReasonState := wrapStateWithDestroyReason(
+		validated.NewState(resourceState, validationOptions...),
+		config.Config.DestroyReason,
+		logger.With(logging.Component("destroy_reason")),
+	)
+
+	runtimeState := state.WrapCore(wrapStateWithAuthorship(destroyReasonState))
+	validatedDryRunState := state.WrapCore(validated.NewState(&dryRunState{CoreState: resourceState}, validationOptions...))
+
+	watchHub := cosi.NewWatchHub(runtimeState, sharedWatchAccess(resourceState), logger.With(logging.Component("watch_hub")))
+
+	metricsRegistry.MustRegister(watchHub)
+
 	return &Runtime{
 		controllerRuntime:       controllerRuntime,
 		talosClientFactory:      talosClientFactory,
 		storeFactory:            storeFactory,
 		dnsService:              dnsService,
 		workloadProxyReconciler: workloadProxyReconciler,
-		resourceLogger:          resourceLogger,
-		state:                   state.WrapCore(validated.NewState(resourceState, validationOptions...)),
-		virtual:                 virtualState,
+		resourceLogger:          runtimeOptions.resourceLogger,
+		state:                   runtimeState,
+		virtual:                 runtimeOptions.virtualState,
+		dryRunState:             validatedDryRunState,
+		watchHub:                watchHub,
 		logger:                  logger,
 	}, nil
 }
@@ -365,19 +448,20 @@ func (r *Runtime) watch(ctx context.Context, events chan<- runtime.WatchResponse
 		}
 	}
 
-	return cosi.Watch(
-		ctx,
-		r.state,
-		cosiresource.NewMetadata(
-			opts.Namespace,
-			opts.Resource,
-			opts.Name,
-			cosiresource.VersionUndefined,
-		),
-		events,
-		opts.TailEvents,
-		queries,
+	md := cosiresource.NewMetadata(
+		opts.Namespace,
+		opts.Resource,
+		opts.Name,
+		cosiresource.VersionUndefined,
 	)
+
+	// the resources in the default namespace are watched by many clients at once, e.g. every open dashboard watches the cluster statuses,
+	// so these watches are shared to keep a single watch of the storage for each of them
+	if opts.Namespace == omniresources.DefaultNamespace {
+		return r.watchHub.Watch(ctx, md, events, opts.TailEvents, queries)
+	}
+
+	return cosi.Watch(ctx, r.state, md, events, opts.TailEvents, queries)
 }
 
 // Get implements runtime.Runtime.
@@ -487,6 +571,11 @@ func (r *Runtime) State() state.State { //nolint:ireturn
 	return r.state
 }
 
+// DryRunState returns the state which runs the access checks and the validations of the writes without persisting them.
+func (r *Runtime) DryRunState() state.State { //nolint:ireturn
+	return r.dryRunState
+}
+
 // GetCOSIRuntime returns COSI  controller runtime.
 func (r *Runtime) GetCOSIRuntime() *cosiruntime.Runtime {
 	return r.controllerRuntime
@@ -538,7 +627,7 @@ func (r *Runtime) OperatorTalosconfig(ctx context.Context, clusterName string) (
 	config := clientconfig.NewConfig(
 		clusterName,
 		endpoints,
-		bundle.Certs.OS.Crt,
+		certs.TalosCAs(s, bundle),
 		clientSecret,
 	)
 
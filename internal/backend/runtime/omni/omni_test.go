@@ -25,6 +25,7 @@ import (
 
 	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/discovery"
 	"github.com/siderolabs/omni/internal/backend/dns"
 	"github.com/siderolabs/omni/internal/backend/runtime"
 	omniruntime "github.com/siderolabs/omni/internal/backend/runtime/omni"
@@ -83,8 +84,8 @@ func (suite *OmniRuntimeSuite) SetupTest() {
 	discoveryServiceClient := &discoveryClientMock{}
 	workloadProxyReconciler := workloadproxy.NewReconciler(logger, zapcore.InfoLevel)
 
-	suite.runtime, err = omniruntime.New(clientFactory, dnsService, workloadProxyReconciler, nil, nil, nil, nil,
-		resourceState, nil, prometheus.NewRegistry(), discoveryServiceClient, nil, logger)
+	suite.runtime, err = omniruntime.New(clientFactory, dnsService, workloadProxyReconciler, resourceState, prometheus.NewRegistry(),
+		discoveryServiceClient, logger)
 
 	suite.Require().NoError(err)
 
@@ -197,3 +198,8 @@ type discoveryClientMock struct{}
 func (d *discoveryClientMock) AffiliateDelete(context.Context, string, string) error {
 	return nil
 }
+
+// ListAffiliates implements the omni.DiscoveryClient interface.
+func (d *discoveryClientMock) ListAffiliates(context.Context, string, ...string) ([]discovery.Affiliate, error) {
+	return nil, nil
+}
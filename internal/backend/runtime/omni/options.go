@@ -0,0 +1,78 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/imagefactory"
+	"github.com/siderolabs/omni/internal/backend/resourcelogger"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/virtual"
+	"github.com/siderolabs/omni/internal/pkg/siderolink"
+)
+
+// Options are the optional dependencies of the Omni runtime.
+type Options struct {
+	resourceLogger          *resourcelogger.Logger
+	imageFactoryClient      *imagefactory.Client
+	linkCounterDeltaCh      <-chan siderolink.LinkCounterDeltas
+	linkSampleCh            <-chan siderolink.LinkSamples
+	siderolinkEventsCh      <-chan *omni.MachineStatusSnapshot
+	virtualState            *virtual.State
+	embeddedDiscoveryClient omnictrl.DiscoveryClient
+}
+
+// Option configures the Omni runtime.
+type Option func(*Options)
+
+// WithResourceLogger enables logging of the resource changes.
+func WithResourceLogger(resourceLogger *resourcelogger.Logger) Option {
+	return func(o *Options) {
+		o.resourceLogger = resourceLogger
+	}
+}
+
+// WithImageFactoryClient sets the image factory client used to build the schematics.
+func WithImageFactoryClient(imageFactoryClient *imagefactory.Client) Option {
+	return func(o *Options) {
+		o.imageFactoryClient = imageFactoryClient
+	}
+}
+
+// WithLinkCounterDeltas sets the channel of the SideroLink traffic counter updates.
+func WithLinkCounterDeltas(ch <-chan siderolink.LinkCounterDeltas) Option {
+	return func(o *Options) {
+		o.linkCounterDeltaCh = ch
+	}
+}
+
+// WithLinkSamples sets the channel of the SideroLink link quality samples.
+func WithLinkSamples(ch <-chan siderolink.LinkSamples) Option {
+	return func(o *Options) {
+		o.linkSampleCh = ch
+	}
+}
+
+// WithMachineStatusSnapshots sets the channel of the machine status snapshots received over SideroLink.
+func WithMachineStatusSnapshots(ch <-chan *omni.MachineStatusSnapshot) Option {
+	return func(o *Options) {
+		o.siderolinkEventsCh = ch
+	}
+}
+
+// WithVirtualState sets the virtual resource state.
+func WithVirtualState(virtualState *virtual.State) Option {
+	return func(o *Options) {
+		o.virtualState = virtualState
+	}
+}
+
+// WithEmbeddedDiscoveryClient sets the client of the embedded discovery service.
+func WithEmbeddedDiscoveryClient(client omnictrl.DiscoveryClient) Option {
+	return func(o *Options) {
+		o.embeddedDiscoveryClient = client
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"slices"
 	"strings"
 	"time"
 
@@ -24,6 +25,7 @@ import (
 	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/dryrun"
 	"github.com/siderolabs/omni/internal/backend/runtime/talos"
 )
 
@@ -140,7 +142,7 @@ func GetEtcdMembers(ctx context.Context, r controller.Reader, clusterName string
 
 	config := omni.NewTalosClientConfig(talosConfig, endpoints...)
 
-	c, err := client.New(ctx, append(unixSocketOpts, client.WithConfig(config))...)
+	c, err := client.New(ctx, slices.Concat(unixSocketOpts, []client.OptionFunc{client.WithConfig(config)}, dryrun.TalosClientOptions())...)
 	if err != nil {
 		return nil, err
 	}
@@ -321,6 +323,7 @@ func getMemberState(ctx context.Context, talosConfig *omni.TalosConfig, clusterM
 	config := omni.NewTalosClientConfig(talosConfig, clusterMachineStatus.TypedSpec().Value.ManagementAddress)
 
 	opts = append(opts, client.WithConfig(config))
+	opts = append(opts, dryrun.TalosClientOptions()...)
 
 	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
 
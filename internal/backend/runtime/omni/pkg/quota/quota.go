@@ -0,0 +1,143 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package quota computes the usage of the quotas limiting the clusters and the machines owned by the users and the teams.
+//
+// A cluster is owned by the identity which created it, the machines allocated to the cluster count towards its owner.
+package quota
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+// Usage is the number of the clusters and the machines owned by the identities sharing a quota.
+type Usage struct {
+	Clusters int
+	Machines int
+}
+
+// Limits are the quotas applied to a single identity along with their usage.
+type Limits struct {
+	quotas []*omni.Quota
+	usage  []Usage
+}
+
+// Get returns the limits of the identity.
+//
+// The identities which are not listed in any quota, including the internal actors with the empty identity, are not limited.
+func Get(ctx context.Context, r controller.Reader, identity string) (*Limits, error) {
+	limits := &Limits{}
+
+	if identity == "" {
+		return limits, nil
+	}
+
+	quotas, err := safe.ReaderListAll[*omni.Quota](ctx, r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list quotas: %w", err)
+	}
+
+	quotas.ForEach(func(quota *omni.Quota) {
+		if slices.Contains(quota.TypedSpec().Value.Identities, identity) {
+			limits.quotas = append(limits.quotas, quota)
+		}
+	})
+
+	if len(limits.quotas) == 0 {
+		return limits, nil
+	}
+
+	clusters, err := safe.ReaderListAll[*omni.Cluster](ctx, r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %w", err)
+	}
+
+	machineSetNodes, err := safe.ReaderListAll[*omni.MachineSetNode](ctx, r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list machine set nodes: %w", err)
+	}
+
+	machinesPerCluster := map[resource.ID]int{}
+
+	machineSetNodes.ForEach(func(machineSetNode *omni.MachineSetNode) {
+		if clusterName, ok := machineSetNode.Metadata().Labels().Get(omni.LabelCluster); ok {
+			machinesPerCluster[clusterName]++
+		}
+	})
+
+	limits.usage = make([]Usage, len(limits.quotas))
+
+	for i, quota := range limits.quotas {
+		clusters.ForEach(func(cluster *omni.Cluster) {
+			if cluster.Metadata().Phase() == resource.PhaseTearingDown {
+				return
+			}
+
+			owner, _ := cluster.Metadata().Annotations().Get(omni.CreatedBy)
+			if !slices.Contains(quota.TypedSpec().Value.Identities, owner) {
+				return
+			}
+
+			limits.usage[i].Clusters++
+			limits.usage[i].Machines += machinesPerCluster[cluster.Metadata().ID()]
+		})
+	}
+
+	return limits, nil
+}
+
+// CheckCluster returns an error if the identity has reached the limit of the clusters.
+func (l *Limits) CheckCluster() error {
+	for i, quota := range l.quotas {
+		maxClusters := int(quota.TypedSpec().Value.MaxClusters)
+
+		if maxClusters > 0 && l.usage[i].Clusters >= maxClusters {
+			return fmt.Errorf("the limit of %d clusters of the quota %q is reached", maxClusters, quota.Metadata().ID())
+		}
+	}
+
+	return nil
+}
+
+// RemainingMachines returns the number of the machines which can still be allocated to the clusters of the identity, -1 if not limited.
+func (l *Limits) RemainingMachines() int {
+	remaining := -1
+
+	for i, quota := range l.quotas {
+		maxMachines := int(quota.TypedSpec().Value.MaxMachines)
+		if maxMachines == 0 {
+			continue
+		}
+
+		quotaRemaining := max(maxMachines-l.usage[i].Machines, 0)
+
+		if remaining == -1 || quotaRemaining < remaining {
+			remaining = quotaRemaining
+		}
+	}
+
+	return remaining
+}
+
+// CheckMachine returns an error if no more machines can be allocated to the clusters of the identity.
+func (l *Limits) CheckMachine() error {
+	for i, quota := range l.quotas {
+		maxMachines := int(quota.TypedSpec().Value.MaxMachines)
+
+		if maxMachines > 0 && l.usage[i].Machines >= maxMachines {
+			return fmt.Errorf("the limit of %d machines of the quota %q is reached", maxMachines, quota.Metadata().ID())
+		}
+	}
+
+	return nil
+}
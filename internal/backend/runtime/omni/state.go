@@ -8,6 +8,7 @@ package omni
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
@@ -20,8 +21,11 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	resourceregistry "github.com/siderolabs/omni/client/pkg/omni/resources/registry"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/siderolink"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/system"
+	"github.com/siderolabs/omni/client/pkg/panichandler"
 	"github.com/siderolabs/omni/internal/backend/logging"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup/store"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/external"
@@ -68,11 +72,35 @@ func NewState(ctx context.Context, params *config.Params, logger *zap.Logger, me
 					Logger:       logger,
 				}
 			default:
-				return primaryStorageCoreState
+				return persistentStateBuilder(ns)
 			}
 		})
 
-		measuredState := wrapStateWithMetrics(namespacedState)
+		// the frequently read, rarely changed resources are served from memory
+		cachedState := wrapStateWithCache(namespacedState, logger.With(logging.Component("state_cache")),
+			resource.NewMetadata(resources.DefaultNamespace, omni.TalosConfigType, "", resource.VersionUndefined),
+			resource.NewMetadata(resources.DefaultNamespace, omni.ClusterConfigVersionType, "", resource.VersionUndefined),
+			resource.NewMetadata(siderolink.Namespace, siderolink.ConnectionParamsType, "", resource.VersionUndefined),
+		)
+
+		metricsRegistry.MustRegister(cachedState)
+
+		var cacheWg sync.WaitGroup
+
+		cacheCtx, cacheCancel := context.WithCancel(ctx)
+
+		defer cacheWg.Wait()
+		defer cacheCancel()
+
+		cacheWg.Add(1)
+
+		panichandler.Go(func() {
+			defer cacheWg.Done()
+
+			cachedState.Run(cacheCtx)
+		}, logger)
+
+		measuredState := wrapStateWithMetrics(cachedState)
 
 		metricsRegistry.MustRegister(measuredState)
 
@@ -116,6 +144,7 @@ func NewState(ctx context.Context, params *config.Params, logger *zap.Logger, me
 		sysVersion.TypedSpec().Value.BackendVersion = version.Tag
 		sysVersion.TypedSpec().Value.InstanceName = config.Config.Name
 		sysVersion.TypedSpec().Value.BackendApiVersion = version.API
+		sysVersion.TypedSpec().Value.BackendMinApiVersion = version.MinAPI
 
 		if err := resourceState.Create(ctx, sysVersion); err != nil {
 			return err
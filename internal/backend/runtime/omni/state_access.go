@@ -22,6 +22,7 @@ import (
 	"github.com/siderolabs/omni/client/pkg/omni/resources/siderolink"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/system"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/virtual"
+	"github.com/siderolabs/omni/internal/backend/runtime/cosi"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/validated"
 	"github.com/siderolabs/omni/internal/pkg/auth"
 	"github.com/siderolabs/omni/internal/pkg/auth/accesspolicy"
@@ -33,48 +34,80 @@ import (
 var (
 	// clusterIDTypeSet is the set of resource types which have the related cluster's ID as their ID.
 	clusterIDTypeSet = xslices.ToSet([]resource.Type{
+		omni.ClusterAvailabilityType,
 		omni.ClusterBootstrapStatusType,
 		omni.ClusterConfigVersionType,
 		omni.ClusterDestroyStatusType,
 		omni.ClusterEndpointType,
 		omni.ClusterWorkloadProxyStatusType,
 		omni.ClusterKubernetesNodesType,
+		omni.ClusterNodeVersionsType,
 		omni.ClusterTaintType,
 		omni.ClusterType,
 		omni.ClusterUUIDType,
 		omni.ClusterSecretsType,
 		omni.ClusterStatusType,
+		omni.ClusterStatusHistoryType,
+		omni.DiscoveryKeyRotationType,
+		omni.DiscoveryKeyRotationStatusType,
 		omni.EtcdAuditResultType,
 		omni.EtcdBackupStatusType,
 		omni.EtcdManualBackupType,
+		omni.MaintenanceWindowType,
+		omni.TalosSecretsRotationType,
+		omni.TalosSecretsRotationStatusType,
 	})
 
 	// clusterLabelTypeSet is the set of resource types which have the related cluster's ID as a label.
 	clusterLabelTypeSet = xslices.ToSet([]resource.Type{
 		omni.ClusterMachineConfigType,
+		omni.ClusterMachineConfigBackupType,
 		omni.ClusterMachineConfigStatusType,
 		omni.ClusterMachineIdentityType,
 		omni.ClusterMachineType,
 		omni.ClusterMachineConfigPatchesType,
+		omni.ConfigPatchBlobType,
 		omni.ClusterMachineConfigStatusType,
 		omni.ClusterMachineTalosVersionType,
 		omni.ClusterMachineTemplateType,
 		omni.ConfigPatchType,
+		omni.DiscoveryAffiliateType,
 		omni.ExposedServiceType,
+		omni.ExposedServiceAccessPolicyType,
 		omni.ImagePullRequestType,
 		omni.ImagePullStatusType,
 		omni.MachineSetType,
 		omni.MachineSetNodeType,
+		omni.MachineSetScalingHistoryType,
 		omni.EtcdBackupType,
 		omni.SchematicConfigurationType,
 		omni.ExtensionsConfigurationType,
+		omni.KernelArgsConfigurationType,
 		omni.MachineExtensionsStatusType,
 		omni.MachineExtensionsType,
+		omni.MachineSetDefaultExtensionsType,
 		omni.ExtensionsConfigurationStatusType,
+		omni.SchematicDriftStatusType,
 	})
 
 	// userManagedResourceTypeSet is the set of resource types that are managed by the user.
 	userManagedResourceTypeSet = xslices.ToSet(common.UserManagedResourceTypes)
+
+	// clusterScopeReadableTypeSet is the set of resource types not related to a cluster which can be read by the identities restricted to a cluster.
+	clusterScopeReadableTypeSet = xslices.ToSet([]resource.Type{
+		meta.NamespaceType,
+		meta.ResourceDefinitionType,
+		omni.FeaturesConfigType,
+		omni.KubernetesVersionType,
+		omni.SettingsType,
+		omni.TalosExtensionsType,
+		omni.TalosVersionType,
+		authres.AuthConfigType,
+		system.SysVersionType,
+		virtual.CurrentUserType,
+		virtual.PermissionsType,
+		virtual.ClusterPermissionsType,
+	})
 )
 
 // aclValidationOptions returns the validation options for the ACLs on the resources.
@@ -109,17 +142,7 @@ func aclValidationOptions(st state.State) []validated.StateOption {
 					o(&opts)
 				}
 
-				if len(opts.LabelQueries) == 0 {
-					return checkForKindAccess(ctx, st, state.Watch, kind, nil)
-				}
-
-				for _, query := range opts.LabelQueries {
-					if err := checkForKindAccess(ctx, st, state.Watch, kind, query.Terms); err != nil {
-						return err
-					}
-				}
-
-				return nil
+				return checkWatchKindAccess(ctx, st, kind, opts.LabelQueries)
 			},
 		),
 		validated.WithGetValidations(
@@ -148,17 +171,7 @@ func aclValidationOptions(st state.State) []validated.StateOption {
 		),
 		validated.WithWatchValidations(
 			func(ctx context.Context, ptr resource.Pointer, _ ...state.WatchOption) error {
-				// todo: watch validation here only works for resources that have same ID as Cluster type,
-				// not for the ones that are related over a label.
-				// we should improve this by checking/filtering the labels for the cluster.
-				clusterID := clusterIDFromPointer(ptr)
-
-				return checkForRole(ctx, st, state.Access{
-					ResourceNamespace: ptr.Namespace(),
-					ResourceType:      ptr.Type(),
-					ResourceID:        ptr.ID(),
-					Verb:              state.Watch,
-				}, clusterID, false)
+				return checkWatchAccess(ctx, st, ptr)
 			},
 		),
 		validated.WithCreateValidations(
@@ -245,6 +258,10 @@ func checkForRole(ctx context.Context, st state.State, access state.Access, clus
 		return nil
 	}
 
+	if err := checkClusterScope(ctx, access, clusterID, requireAll); err != nil {
+		return err
+	}
+
 	if requireAll {
 		clusterID = "any"
 	}
@@ -264,6 +281,67 @@ func checkForRole(ctx context.Context, st state.State, access state.Access, clus
 	return filterAccess(ctx, access)
 }
 
+// checkClusterScope restricts the access of the identities restricted to a cluster to the resources of that cluster.
+func checkClusterScope(ctx context.Context, access state.Access, clusterID resource.ID, requireAll bool) error {
+	scopedCluster, ok := ctx.Value(auth.ClusterContextKey{}).(string)
+	if !ok || scopedCluster == "" {
+		return nil
+	}
+
+	if !isClusterRelatedType(access.ResourceType) {
+		if _, readable := clusterScopeReadableTypeSet[access.ResourceType]; readable && access.Verb.Readonly() {
+			return nil
+		}
+
+		return status.Errorf(codes.PermissionDenied, "the identity is restricted to the cluster %q", scopedCluster)
+	}
+
+	if requireAll || clusterID != scopedCluster {
+		return status.Errorf(codes.PermissionDenied, "the identity is restricted to the cluster %q", scopedCluster)
+	}
+
+	return nil
+}
+
+// sharedWatchAccess checks the access of the watches served from the shared watches, the same way the watches of the state are checked.
+func sharedWatchAccess(st state.State) cosi.WatchAuthorizer {
+	return func(ctx context.Context, md resource.Metadata, queries []resource.LabelQuery) error {
+		if md.ID() == "" {
+			return checkWatchKindAccess(ctx, st, md, queries)
+		}
+
+		return checkWatchAccess(ctx, st, md)
+	}
+}
+
+func checkWatchKindAccess(ctx context.Context, st state.State, kind resource.Kind, queries []resource.LabelQuery) error {
+	if len(queries) == 0 {
+		return checkForKindAccess(ctx, st, state.Watch, kind, nil)
+	}
+
+	for _, query := range queries {
+		if err := checkForKindAccess(ctx, st, state.Watch, kind, query.Terms); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkWatchAccess(ctx context.Context, st state.State, ptr resource.Pointer) error {
+	// todo: watch validation here only works for resources that have same ID as Cluster type,
+	// not for the ones that are related over a label.
+	// we should improve this by checking/filtering the labels for the cluster.
+	clusterID := clusterIDFromPointer(ptr)
+
+	return checkForRole(ctx, st, state.Access{
+		ResourceNamespace: ptr.Namespace(),
+		ResourceType:      ptr.Type(),
+		ResourceID:        ptr.ID(),
+		Verb:              state.Watch,
+	}, clusterID, false)
+}
+
 func checkForKindAccess(ctx context.Context, st state.State, verb state.Verb, kind resource.Kind, labelTerms []resource.LabelTerm) error {
 	clusterID := ""
 	requireAll := false
@@ -358,15 +436,23 @@ func filterAccess(ctx context.Context, access state.Access) error {
 		omni.ClusterMachineTalosVersionType,
 		omni.ClusterMachineType,
 		omni.ClusterMachineConfigPatchesType,
+		omni.ConfigPatchBlobType,
 		omni.ClusterMachineTemplateType,
+		omni.ClusterNodeVersionsType,
 		omni.ClusterStatusType,
+		omni.ClusterAvailabilityType,
+		omni.ClusterStatusHistoryType,
 		omni.ClusterUUIDType,
 		omni.ClusterWorkloadProxyStatusType,
 		omni.ClusterTaintType,
 		omni.ConfigPatchType,
 		omni.ControlPlaneStatusType,
+		omni.DiscoveryAffiliateType,
+		omni.DiscoveryKeyRotationType,
+		omni.DiscoveryKeyRotationStatusType,
 		omni.KubernetesNodeAuditResultType,
 		omni.ExposedServiceType,
+		omni.ExposedServiceAccessPolicyType,
 		omni.EtcdBackupType,
 		omni.EtcdBackupStatusType,
 		omni.EtcdBackupStoreStatusType,
@@ -381,10 +467,16 @@ func filterAccess(ctx context.Context, access state.Access) error {
 		omni.LoadBalancerStatusType,
 		omni.MachineLabelsType,
 		omni.MachineSetType,
+		omni.MachineSetDefaultExtensionsType,
 		omni.MachineSetDestroyStatusType,
 		omni.MachineSetNodeType,
 		omni.MachineSetStatusType,
+		omni.MachineSetScalingHistoryType,
+		omni.MaintenanceWindowType,
+		omni.TalosSecretsRotationType,
+		omni.TalosSecretsRotationStatusType,
 		omni.TalosUpgradeStatusType,
+		omni.TemplateSyncStatusType,
 		omni.RedactedClusterMachineConfigType,
 		siderolink.LinkType,
 		siderolink.ConnectionParamsType,
@@ -392,14 +484,21 @@ func filterAccess(ctx context.Context, access state.Access) error {
 		omni.MachineClassType,
 		omni.MachineExtensionsStatusType,
 		omni.MachineExtensionsType,
+		omni.MachineHardwareInventoryType,
+		omni.MachineMoveRequestType,
+		omni.MachineMoveStatusType,
 		omni.MachineStatusType,
 		omni.MachineStatusSnapshotType,
+		omni.MachineBootHistoryType,
 		omni.MachineStatusLinkType,
+		omni.MachineLinkStatusType,
 		omni.MachineConfigGenOptionsType,
 		omni.SchematicType,
 		omni.SchematicConfigurationType,
+		omni.SchematicDriftStatusType,
 		omni.ExtensionsConfigurationType,
 		omni.ExtensionsConfigurationStatusType,
+		omni.KernelArgsConfigurationType,
 		virtual.LabelsCompletionType,
 		virtual.KubernetesUsageType:
 		_, err = auth.CheckGRPC(ctx, auth.WithRole(verbToRole(access.Verb)))
@@ -419,7 +518,9 @@ func filterAccess(ctx context.Context, access state.Access) error {
 		virtual.PermissionsType:
 		// allow access with just valid signature
 		_, err = auth.CheckGRPC(ctx, auth.WithValidSignature(true))
-	case authres.IdentityType, authres.UserType, authres.SAMLLabelRuleType, authres.AccessPolicyType, omni.EtcdBackupS3ConfType:
+	case authres.IdentityType, authres.UserType, authres.SAMLLabelRuleType, authres.AccessPolicyType, omni.EtcdBackupS3ConfType, omni.LogLevelConfigType,
+		omni.EtcdBackupStorageConfigType, omni.ClusterMachineConfigBackupType, omni.RuntimeConfigurationType, omni.DefaultExtensionsType, omni.QuotaType,
+		omni.NotificationConfigType, authres.BreakGlassTokenType:
 		var checkResult auth.CheckResult
 		// user management access
 		checkResult, err = auth.CheckGRPC(ctx, auth.WithRole(role.Admin))
@@ -433,6 +534,13 @@ func filterAccess(ctx context.Context, access state.Access) error {
 				err = status.Errorf(codes.PermissionDenied, "destroying/updating resource %s is not allowed by the current user", access.ResourceID)
 			}
 		}
+	case omni.SettingsType:
+		// the settings are read to pre-select the default versions, only the admins can change them
+		if access.Verb.Readonly() {
+			_, err = auth.CheckGRPC(ctx, auth.WithRole(role.Reader))
+		} else {
+			_, err = auth.CheckGRPC(ctx, auth.WithRole(role.Admin))
+		}
 	case authres.AuthConfigType:
 		// allow access even without auth
 	default:
@@ -487,17 +595,24 @@ func filterAccessByType(access state.Access) error {
 		omni.ClusterDestroyStatusType,
 		omni.ClusterEndpointType,
 		omni.ClusterKubernetesNodesType,
+		omni.ClusterMachineConfigBackupType,
 		omni.ClusterMachineIdentityType,
 		omni.ClusterMachineStatusType,
 		omni.ClusterMachineTalosVersionType,
 		omni.ClusterMachineType,
 		omni.ClusterMachineConfigPatchesType,
+		omni.ConfigPatchBlobType,
 		omni.ClusterMachineTemplateType,
+		omni.ClusterNodeVersionsType,
 		omni.ClusterStatusType,
+		omni.ClusterAvailabilityType,
+		omni.ClusterStatusHistoryType,
 		omni.ClusterTaintType,
 		omni.ClusterUUIDType,
 		omni.ClusterWorkloadProxyStatusType,
 		omni.ControlPlaneStatusType,
+		omni.DiscoveryAffiliateType,
+		omni.DiscoveryKeyRotationStatusType,
 		omni.KubernetesNodeAuditResultType,
 		omni.ExposedServiceType,
 		omni.EtcdBackupType,
@@ -514,25 +629,36 @@ func filterAccessByType(access state.Access) error {
 		omni.LoadBalancerStatusType,
 		omni.MachineType,
 		omni.MachineConfigGenOptionsType,
+		omni.MachineMoveRequestType,
+		omni.MachineMoveStatusType,
+		omni.MachineSetDefaultExtensionsType,
 		omni.MachineSetDestroyStatusType,
 		omni.MachineSetStatusType,
+		omni.MachineSetScalingHistoryType,
+		omni.MachineHardwareInventoryType,
 		omni.MachineStatusType,
 		omni.MachineStatusLinkType,
+		omni.MachineLinkStatusType,
 		omni.MachineStatusSnapshotType,
+		omni.MachineBootHistoryType,
 		omni.KubernetesVersionType,
 		omni.TalosExtensionsType,
+		omni.TalosSecretsRotationStatusType,
 		omni.TalosVersionType,
 		omni.TalosUpgradeStatusType,
+		omni.TemplateSyncStatusType,
 		omni.InstallationMediaType,
 		omni.OngoingTaskType,
 		omni.RedactedClusterMachineConfigType,
 		omni.SchematicType,
 		omni.SchematicConfigurationType,
+		omni.SchematicDriftStatusType,
 		omni.ExtensionsConfigurationStatusType,
 		omni.MachineExtensionsStatusType,
 		omni.MachineExtensionsType,
 		omni.MachineStatusMetricsType,
 		authres.AuthConfigType,
+		authres.BreakGlassTokenType,
 		siderolink.ConnectionParamsType,
 		system.SysVersionType,
 		meta.NamespaceType,
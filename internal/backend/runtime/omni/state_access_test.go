@@ -0,0 +1,123 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/validated"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+func TestClusterScopedAccess(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	t.Cleanup(cancel)
+
+	innerSt := state.WrapCore(namespaced.NewState(inmem.Build))
+	st := state.WrapCore(validated.NewState(innerSt, omni.ACLValidationOptions(innerSt)...))
+
+	require.NoError(t, innerSt.Create(ctx, omnires.NewCluster(resources.DefaultNamespace, "other")))
+	require.NoError(t, innerSt.Create(ctx, omnires.NewMachine(resources.DefaultNamespace, "machine-1")))
+
+	scopedCtx := context.WithValue(ctx, auth.EnabledAuthContextKey{}, true)
+	scopedCtx = context.WithValue(scopedCtx, auth.IdentityContextKey{}, "ci@serviceaccount.omni.sidero.dev")
+	scopedCtx = context.WithValue(scopedCtx, auth.RoleContextKey{}, role.Operator)
+	scopedCtx = context.WithValue(scopedCtx, auth.ClusterContextKey{}, "allowed")
+
+	// the resources of the cluster are accessible
+	require.NoError(t, st.Create(scopedCtx, omnires.NewCluster(resources.DefaultNamespace, "allowed")))
+
+	machineSet := omnires.NewMachineSet(resources.DefaultNamespace, omnires.WorkersResourceID("allowed"))
+	machineSet.Metadata().Labels().Set(omnires.LabelCluster, "allowed")
+
+	require.NoError(t, st.Create(scopedCtx, machineSet))
+
+	_, err := st.List(scopedCtx, omnires.NewMachineSet(resources.DefaultNamespace, "").Metadata(),
+		state.WithLabelQuery(resource.LabelEqual(omnires.LabelCluster, "allowed")),
+	)
+	require.NoError(t, err)
+
+	_, err = st.List(scopedCtx, omnires.NewTalosVersion(resources.DefaultNamespace, "").Metadata())
+	require.NoError(t, err)
+
+	// the resources of other clusters and the instance-wide resources are not
+	for _, check := range []func() error{
+		func() error {
+			return st.Create(scopedCtx, omnires.NewCluster(resources.DefaultNamespace, "new"))
+		},
+		func() error {
+			_, getErr := st.Get(scopedCtx, omnires.NewCluster(resources.DefaultNamespace, "other").Metadata())
+
+			return getErr
+		},
+		func() error {
+			_, listErr := st.List(scopedCtx, omnires.NewMachineSet(resources.DefaultNamespace, "").Metadata())
+
+			return listErr
+		},
+		func() error {
+			_, getErr := st.Get(scopedCtx, omnires.NewMachine(resources.DefaultNamespace, "machine-1").Metadata())
+
+			return getErr
+		},
+		func() error {
+			return st.Create(scopedCtx, omnires.NewTalosVersion(resources.DefaultNamespace, "1.7.0"))
+		},
+	} {
+		assert.ErrorContains(t, check(), `the identity is restricted to the cluster "allowed"`)
+	}
+}
+
+func TestRuntimeConfigurationAccess(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	t.Cleanup(cancel)
+
+	innerSt := state.WrapCore(namespaced.NewState(inmem.Build))
+	st := state.WrapCore(validated.NewState(innerSt, omni.ACLValidationOptions(innerSt)...))
+
+	ctx = context.WithValue(ctx, auth.EnabledAuthContextKey{}, true)
+	ctx = context.WithValue(ctx, auth.IdentityContextKey{}, "user@example.org")
+
+	operatorCtx := context.WithValue(ctx, auth.RoleContextKey{}, role.Operator)
+	adminCtx := context.WithValue(ctx, auth.RoleContextKey{}, role.Admin)
+
+	for _, res := range []resource.Resource{
+		omnires.NewLogLevelConfig(),
+		omnires.NewRuntimeConfiguration(),
+	} {
+		// only the admins manage the configuration through the API
+		assert.Equal(t, codes.PermissionDenied, status.Code(st.Create(operatorCtx, res)), res.Metadata().Type())
+
+		require.NoError(t, st.Create(adminCtx, res))
+
+		_, err := st.Get(adminCtx, res.Metadata())
+		require.NoError(t, err)
+
+		_, err = st.Get(operatorCtx, res.Metadata())
+		assert.Equal(t, codes.PermissionDenied, status.Code(err), res.Metadata().Type())
+
+		require.NoError(t, st.Destroy(adminCtx, res.Metadata()))
+	}
+}
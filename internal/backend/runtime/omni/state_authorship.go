@@ -0,0 +1,100 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+)
+
+// authorshipState wraps COSI core state and records the identity and the time of the changes of the user managed resources.
+//
+// The annotations are only set for the requests coming through the API, which have the identity in the context.
+type authorshipState struct {
+	state.CoreState
+
+	clock func() time.Time
+}
+
+// Check interfaces.
+var _ state.CoreState = &authorshipState{}
+
+func wrapStateWithAuthorship(st state.CoreState) *authorshipState {
+	return &authorshipState{
+		CoreState: st,
+		clock:     time.Now,
+	}
+}
+
+// Create implements state.CoreState.
+func (st *authorshipState) Create(ctx context.Context, r resource.Resource, opts ...state.CreateOption) error {
+	if identity, ok := authorshipIdentity(ctx, r.Metadata().Type()); ok {
+		r.Metadata().Annotations().Set(omni.CreatedBy, identity)
+		st.setUpdated(r, identity)
+	}
+
+	return st.CoreState.Create(ctx, r, opts...)
+}
+
+// Update implements state.CoreState.
+func (st *authorshipState) Update(ctx context.Context, newResource resource.Resource, opts ...state.UpdateOption) error {
+	identity, ok := authorshipIdentity(ctx, newResource.Metadata().Type())
+	if !ok {
+		return st.CoreState.Update(ctx, newResource, opts...)
+	}
+
+	// the creator can't be changed by the update, so it's always copied from the current version of the resource
+	current, err := st.CoreState.Get(ctx, newResource.Metadata())
+	if err != nil {
+		return err
+	}
+
+	if createdBy, exists := current.Metadata().Annotations().Get(omni.CreatedBy); exists {
+		newResource.Metadata().Annotations().Set(omni.CreatedBy, createdBy)
+	} else {
+		newResource.Metadata().Annotations().Delete(omni.CreatedBy)
+	}
+
+	st.setUpdated(newResource, identity)
+
+	return st.CoreState.Update(ctx, newResource, opts...)
+}
+
+func (st *authorshipState) setUpdated(r resource.Resource, identity string) {
+	r.Metadata().Annotations().Set(omni.UpdatedBy, identity)
+	r.Metadata().Annotations().Set(omni.UpdatedAt, st.clock().UTC().Format(time.RFC3339))
+}
+
+// withoutAuthorship returns the copy of the annotations without the ones set by the authorshipState.
+//
+// The annotations are copied on write, so the original annotations are not modified.
+func withoutAuthorship(annotations resource.Annotations) resource.Annotations {
+	annotations.Delete(omni.CreatedBy)
+	annotations.Delete(omni.UpdatedBy)
+	annotations.Delete(omni.UpdatedAt)
+
+	return annotations
+}
+
+// authorshipIdentity returns the identity of the API request if the changes of the resource type should be recorded.
+func authorshipIdentity(ctx context.Context, typ resource.Type) (string, bool) {
+	if _, ok := userManagedResourceTypeSet[typ]; !ok {
+		return "", false
+	}
+
+	identity, ok := ctx.Value(auth.IdentityContextKey{}).(string)
+	if !ok || identity == "" {
+		return "", false
+	}
+
+	return identity, true
+}
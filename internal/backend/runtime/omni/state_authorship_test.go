@@ -0,0 +1,91 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+)
+
+func TestAuthorshipState(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	st := state.WrapCore(omni.WrapStateWithAuthorship(namespaced.NewState(inmem.Build)))
+
+	aliceCtx := context.WithValue(ctx, auth.IdentityContextKey{}, "alice@example.com")
+	bobCtx := context.WithValue(ctx, auth.IdentityContextKey{}, "bob@example.com")
+
+	patch := omnires.NewConfigPatch(resources.DefaultNamespace, "patch")
+
+	require.NoError(t, st.Create(aliceCtx, patch))
+
+	patch, err := safe.StateGetByID[*omnires.ConfigPatch](ctx, st, "patch")
+	require.NoError(t, err)
+
+	createdBy, _ := patch.Metadata().Annotations().Get(omnires.CreatedBy)
+	updatedBy, _ := patch.Metadata().Annotations().Get(omnires.UpdatedBy)
+	updatedAt, _ := patch.Metadata().Annotations().Get(omnires.UpdatedAt)
+
+	assert.Equal(t, "alice@example.com", createdBy)
+	assert.Equal(t, "alice@example.com", updatedBy)
+	assert.NotEmpty(t, updatedAt)
+
+	// the creator can't be overwritten
+	_, err = safe.StateUpdateWithConflicts(bobCtx, st, patch.Metadata(), func(res *omnires.ConfigPatch) error {
+		res.Metadata().Annotations().Set(omnires.CreatedBy, "bob@example.com")
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	patch, err = safe.StateGetByID[*omnires.ConfigPatch](ctx, st, "patch")
+	require.NoError(t, err)
+
+	createdBy, _ = patch.Metadata().Annotations().Get(omnires.CreatedBy)
+	updatedBy, _ = patch.Metadata().Annotations().Get(omnires.UpdatedBy)
+
+	assert.Equal(t, "alice@example.com", createdBy)
+	assert.Equal(t, "bob@example.com", updatedBy)
+
+	// the internal updates without the identity are not recorded
+	_, err = safe.StateUpdateWithConflicts(ctx, st, patch.Metadata(), func(res *omnires.ConfigPatch) error {
+		res.Metadata().Labels().Set("foo", "bar")
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	patch, err = safe.StateGetByID[*omnires.ConfigPatch](ctx, st, "patch")
+	require.NoError(t, err)
+
+	updatedBy, _ = patch.Metadata().Annotations().Get(omnires.UpdatedBy)
+
+	assert.Equal(t, "bob@example.com", updatedBy)
+
+	// the resources which are not managed by the user are not annotated
+	machineStatus := omnires.NewMachineStatus(resources.DefaultNamespace, "machine")
+
+	require.NoError(t, st.Create(aliceCtx, machineStatus))
+
+	_, ok := machineStatus.Metadata().Annotations().Get(omnires.CreatedBy)
+	assert.False(t, ok)
+}
@@ -20,6 +20,8 @@ import (
 	"github.com/cosi-project/runtime/pkg/state/impl/store/bolt"
 	"go.etcd.io/bbolt"
 	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
 )
 
 func buildBoltPersistentState(ctx context.Context, path string, logger *zap.Logger, f func(context.Context, namespaced.StateBuilder) error) error {
@@ -30,7 +32,12 @@ func buildBoltPersistentState(ctx context.Context, path string, logger *zap.Logg
 
 	defer backingStore.Close() //nolint:errcheck
 
-	return f(ctx, builder)
+	// the BoltDB state of the default namespace handles all persistent namespaces, the storage is not sharded
+	primaryState := builder(resources.DefaultNamespace)
+
+	return f(ctx, func(resource.Namespace) state.CoreState {
+		return primaryState
+	})
 }
 
 func newBoltPersistentState(path string, options *bbolt.Options, compact bool, logger *zap.Logger) (st state.CoreState, backingStore io.Closer, err error) {
@@ -0,0 +1,324 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/pkg/panichandler"
+)
+
+// stateCacheRetryInterval is the delay before restarting the failed watch of the cached resource kind.
+const stateCacheRetryInterval = 5 * time.Second
+
+// stateCache wraps COSI core state and serves the reads of the frequently read, rarely changed resources from memory.
+//
+// The contents of the cache are kept up to date by watching each of the cached resource kinds.
+// The resources written through the cache are not served from it until the watch catches up with the write,
+// so the writer always reads its own writes.
+type stateCache struct {
+	state.CoreState
+
+	logger *zap.Logger
+	kinds  map[cachedKindKey]*cachedKind
+
+	hits   *prometheus.CounterVec
+	misses *prometheus.CounterVec
+}
+
+// Check interfaces.
+var (
+	_ prometheus.Collector = &stateCache{}
+	_ state.CoreState      = &stateCache{}
+)
+
+type cachedKindKey struct {
+	ns  resource.Namespace
+	typ resource.Type
+}
+
+type cachedKind struct {
+	entries map[resource.ID]cacheEntry
+	mu      sync.Mutex
+	synced  bool
+}
+
+// cacheEntry is the cached resource, the resource is nil if the entry is waiting for the watch to catch up with the write.
+type cacheEntry struct {
+	res resource.Resource
+
+	// version is the minimum version of the resource accepted from the watch
+	version uint64
+
+	// destroyed is set if the resource was destroyed, and the watch hasn't delivered the destroy event yet
+	destroyed bool
+}
+
+func wrapStateWithCache(st state.CoreState, logger *zap.Logger, kinds ...resource.Kind) *stateCache {
+	cache := &stateCache{
+		CoreState: st,
+		logger:    logger,
+		kinds:     make(map[cachedKindKey]*cachedKind, len(kinds)),
+
+		hits: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "omni_state_cache_hits_total",
+				Help: "Number of resource reads served from the state cache by resource type.",
+			},
+			[]string{"type"},
+		),
+		misses: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "omni_state_cache_misses_total",
+				Help: "Number of resource reads of the cached resource types passed to the underlying state by resource type.",
+			},
+			[]string{"type"},
+		),
+	}
+
+	for _, kind := range kinds {
+		cache.kinds[cachedKindKey{ns: kind.Namespace(), typ: kind.Type()}] = &cachedKind{
+			entries: map[resource.ID]cacheEntry{},
+		}
+	}
+
+	return cache
+}
+
+// Run watches the cached resource kinds until the context is canceled.
+func (cache *stateCache) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+
+	for key, kind := range cache.kinds {
+		wg.Add(1)
+
+		panichandler.Go(func() {
+			defer wg.Done()
+
+			md := resource.NewMetadata(key.ns, key.typ, "", resource.VersionUndefined)
+
+			kind.run(ctx, cache.CoreState, &md, cache.logger.With(zap.String("type", key.typ)))
+		}, cache.logger)
+	}
+
+	wg.Wait()
+}
+
+// Describe implements prom.Collector interface.
+func (cache *stateCache) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(cache, ch)
+}
+
+// Collect implements prom.Collector interface.
+func (cache *stateCache) Collect(ch chan<- prometheus.Metric) {
+	cache.hits.Collect(ch)
+	cache.misses.Collect(ch)
+}
+
+// Get implements state.CoreState.
+func (cache *stateCache) Get(ctx context.Context, ptr resource.Pointer, opts ...state.GetOption) (resource.Resource, error) {
+	kind, ok := cache.kinds[cachedKindKey{ns: ptr.Namespace(), typ: ptr.Type()}]
+	if !ok || len(opts) > 0 {
+		return cache.CoreState.Get(ctx, ptr, opts...)
+	}
+
+	if res := kind.get(ptr.ID()); res != nil {
+		cache.hits.WithLabelValues(ptr.Type()).Inc()
+
+		return res, nil
+	}
+
+	cache.misses.WithLabelValues(ptr.Type()).Inc()
+
+	return cache.CoreState.Get(ctx, ptr, opts...)
+}
+
+// Create implements state.CoreState.
+func (cache *stateCache) Create(ctx context.Context, res resource.Resource, opts ...state.CreateOption) error {
+	if err := cache.CoreState.Create(ctx, res, opts...); err != nil {
+		return err
+	}
+
+	cache.invalidate(res.Metadata(), res.Metadata().Version().Value(), false)
+
+	return nil
+}
+
+// Update implements state.CoreState.
+func (cache *stateCache) Update(ctx context.Context, newResource resource.Resource, opts ...state.UpdateOption) error {
+	if err := cache.CoreState.Update(ctx, newResource, opts...); err != nil {
+		return err
+	}
+
+	cache.invalidate(newResource.Metadata(), newResource.Metadata().Version().Value(), false)
+
+	return nil
+}
+
+// Destroy implements state.CoreState.
+func (cache *stateCache) Destroy(ctx context.Context, ptr resource.Pointer, opts ...state.DestroyOption) error {
+	if err := cache.CoreState.Destroy(ctx, ptr, opts...); err != nil {
+		return err
+	}
+
+	cache.invalidate(ptr, math.MaxUint64, true)
+
+	return nil
+}
+
+func (cache *stateCache) invalidate(ptr resource.Pointer, version uint64, destroyed bool) {
+	if kind, ok := cache.kinds[cachedKindKey{ns: ptr.Namespace(), typ: ptr.Type()}]; ok {
+		kind.invalidate(ptr.ID(), version, destroyed)
+	}
+}
+
+func (kind *cachedKind) run(ctx context.Context, st state.CoreState, md *resource.Metadata, logger *zap.Logger) {
+	for {
+		err := kind.watch(ctx, st, md)
+
+		kind.reset()
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		logger.Warn("state cache watch failed, the reads are passed to the underlying state", zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(stateCacheRetryInterval):
+		}
+	}
+}
+
+func (kind *cachedKind) watch(ctx context.Context, st state.CoreState, md *resource.Metadata) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ch := make(chan state.Event)
+
+	if err := st.WatchKind(ctx, md, ch, state.WithBootstrapContents(true)); err != nil {
+		return err
+	}
+
+	for {
+		var event state.Event
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event = <-ch:
+		}
+
+		switch event.Type {
+		case state.Created, state.Updated:
+			kind.store(event.Resource)
+		case state.Destroyed:
+			kind.remove(event.Resource.Metadata().ID())
+		case state.Bootstrapped:
+			kind.setSynced()
+		case state.Errored:
+			return event.Error
+		}
+	}
+}
+
+// get returns the copy of the cached resource, or nil if the resource should be read from the underlying state.
+func (kind *cachedKind) get(id resource.ID) resource.Resource {
+	kind.mu.Lock()
+	defer kind.mu.Unlock()
+
+	if !kind.synced {
+		return nil
+	}
+
+	entry, ok := kind.entries[id]
+	if !ok || entry.res == nil {
+		return nil
+	}
+
+	return entry.res.DeepCopy()
+}
+
+func (kind *cachedKind) store(res resource.Resource) {
+	kind.mu.Lock()
+	defer kind.mu.Unlock()
+
+	id := res.Metadata().ID()
+	version := res.Metadata().Version().Value()
+
+	// the event is older than the latest write or precedes the destroy, wait for the watch to catch up
+	if entry, ok := kind.entries[id]; ok && (entry.destroyed || version < entry.version) {
+		return
+	}
+
+	kind.entries[id] = cacheEntry{
+		res:     res,
+		version: version,
+	}
+}
+
+func (kind *cachedKind) remove(id resource.ID) {
+	kind.mu.Lock()
+	defer kind.mu.Unlock()
+
+	entry, ok := kind.entries[id]
+	if ok && entry.destroyed && entry.version != math.MaxUint64 {
+		// the resource was created again after the destroy, wait for the events of the new resource
+		kind.entries[id] = cacheEntry{
+			version: entry.version,
+		}
+
+		return
+	}
+
+	delete(kind.entries, id)
+}
+
+func (kind *cachedKind) invalidate(id resource.ID, version uint64, destroyed bool) {
+	kind.mu.Lock()
+	defer kind.mu.Unlock()
+
+	entry, ok := kind.entries[id]
+
+	switch {
+	case destroyed && !ok && kind.synced:
+		// the watch has already delivered the destroy event
+		return
+	case !destroyed && entry.res != nil && entry.res.Metadata().Version().Value() >= version:
+		// the watch has already delivered the write
+		return
+	}
+
+	kind.entries[id] = cacheEntry{
+		version:   version,
+		destroyed: destroyed || entry.destroyed,
+	}
+}
+
+func (kind *cachedKind) setSynced() {
+	kind.mu.Lock()
+	defer kind.mu.Unlock()
+
+	kind.synced = true
+}
+
+func (kind *cachedKind) reset() {
+	kind.mu.Lock()
+	defer kind.mu.Unlock()
+
+	kind.synced = false
+	kind.entries = map[resource.ID]cacheEntry{}
+}
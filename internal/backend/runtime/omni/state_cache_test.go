@@ -0,0 +1,120 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni"
+)
+
+type getCountingState struct {
+	state.CoreState
+
+	gets atomic.Int64
+}
+
+func (st *getCountingState) Get(ctx context.Context, ptr resource.Pointer, opts ...state.GetOption) (resource.Resource, error) {
+	st.gets.Add(1)
+
+	return st.CoreState.Get(ctx, ptr, opts...)
+}
+
+func TestStateCache(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	innerSt := &getCountingState{CoreState: namespaced.NewState(inmem.Build)}
+	st := state.WrapCore(omni.WrapStateWithCache(ctx, innerSt, zaptest.NewLogger(t),
+		resource.NewMetadata(resources.DefaultNamespace, omnires.ClusterConfigVersionType, "", resource.VersionUndefined),
+	))
+
+	configVersion := omnires.NewClusterConfigVersion(resources.DefaultNamespace, "cluster1")
+	configVersion.TypedSpec().Value.Version = "v1"
+
+	require.NoError(t, st.Create(ctx, configVersion))
+
+	getVersion := func() string {
+		res, err := safe.StateGetByID[*omnires.ClusterConfigVersion](ctx, st, "cluster1")
+		require.NoError(t, err)
+
+		return res.TypedSpec().Value.Version
+	}
+
+	// the writer reads its own writes
+	assert.Equal(t, "v1", getVersion())
+
+	// the reads are served from memory once the watch catches up
+	require.Eventually(t, func() bool {
+		gets := innerSt.gets.Load()
+
+		getVersion()
+
+		return innerSt.gets.Load() == gets
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the cached resource is a copy
+	res, err := safe.StateGetByID[*omnires.ClusterConfigVersion](ctx, st, "cluster1")
+	require.NoError(t, err)
+
+	res.TypedSpec().Value.Version = "modified"
+
+	assert.Equal(t, "v1", getVersion())
+
+	// the updates made bypassing the cache are picked up by the watch
+	_, err = safe.StateUpdateWithConflicts(ctx, state.WrapCore(innerSt), configVersion.Metadata(), func(res *omnires.ClusterConfigVersion) error {
+		res.TypedSpec().Value.Version = "v2"
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return getVersion() == "v2"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the updates made through the cache are visible immediately
+	_, err = safe.StateUpdateWithConflicts(ctx, st, configVersion.Metadata(), func(res *omnires.ClusterConfigVersion) error {
+		res.TypedSpec().Value.Version = "v3"
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "v3", getVersion())
+
+	// the destroyed resources are not served from memory
+	require.NoError(t, st.Destroy(ctx, configVersion.Metadata()))
+
+	_, err = st.Get(ctx, configVersion.Metadata())
+	require.True(t, state.IsNotFoundError(err))
+
+	// the uncached resources are always read from the underlying state
+	require.NoError(t, st.Create(ctx, omnires.NewClusterStatus(resources.DefaultNamespace, "cluster1")))
+
+	gets := innerSt.gets.Load()
+
+	_, err = st.Get(ctx, omnires.NewClusterStatus(resources.DefaultNamespace, "cluster1").Metadata())
+	require.NoError(t, err)
+
+	assert.Equal(t, gets+1, innerSt.gets.Load())
+}
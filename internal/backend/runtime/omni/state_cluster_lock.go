@@ -0,0 +1,242 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/validated"
+)
+
+// clusterOperation is a long-running operation on the cluster, which conflicts with the other changes of the cluster.
+type clusterOperation int
+
+const (
+	clusterOperationNone clusterOperation = iota
+	clusterOperationTalosUpgrade
+	clusterOperationKubernetesUpgrade
+	clusterOperationRestore
+)
+
+// clusterLock describes the operation in progress on the cluster.
+type clusterLock struct {
+	description string
+	operation   clusterOperation
+}
+
+// check returns an error referencing the operation in progress if the action is not allowed while it is running.
+func (lock clusterLock) check(clusterName, action string, allowedDuring ...clusterOperation) error {
+	if lock.operation == clusterOperationNone {
+		return nil
+	}
+
+	for _, allowed := range allowedDuring {
+		if lock.operation == allowed {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%s is not allowed: the cluster %q is locked by the operation in progress: %s", action, clusterName, lock.description)
+}
+
+// getClusterLock returns the operation in progress on the cluster.
+//
+// The operations are detected from the status resources, so that the lock is released as soon as the operation is finished
+// or reverted, and can't be left behind.
+func getClusterLock(ctx context.Context, st state.State, clusterName string) (clusterLock, error) {
+	talosUpgradeStatus, err := safe.StateGetByID[*omni.TalosUpgradeStatus](ctx, st, clusterName)
+	if err != nil && !state.IsNotFoundError(err) {
+		return clusterLock{}, err
+	}
+
+	if talosUpgradeStatus != nil && talosUpgradeStatus.TypedSpec().Value.QueuePosition == 0 {
+		switch spec := talosUpgradeStatus.TypedSpec().Value; spec.Phase { //nolint:exhaustive
+		case specs.TalosUpgradeStatusSpec_Upgrading:
+			return clusterLock{operation: clusterOperationTalosUpgrade, description: "Talos upgrade to " + spec.CurrentUpgradeVersion}, nil
+		case specs.TalosUpgradeStatusSpec_Reverting:
+			return clusterLock{operation: clusterOperationTalosUpgrade, description: "Talos upgrade revert to " + spec.LastUpgradeVersion}, nil
+		}
+	}
+
+	kubernetesUpgradeStatus, err := safe.StateGetByID[*omni.KubernetesUpgradeStatus](ctx, st, clusterName)
+	if err != nil && !state.IsNotFoundError(err) {
+		return clusterLock{}, err
+	}
+
+	if kubernetesUpgradeStatus != nil {
+		switch spec := kubernetesUpgradeStatus.TypedSpec().Value; spec.Phase { //nolint:exhaustive
+		case specs.KubernetesUpgradeStatusSpec_Upgrading:
+			return clusterLock{operation: clusterOperationKubernetesUpgrade, description: "Kubernetes upgrade to " + spec.CurrentUpgradeVersion}, nil
+		case specs.KubernetesUpgradeStatusSpec_Reverting:
+			return clusterLock{operation: clusterOperationKubernetesUpgrade, description: "Kubernetes upgrade revert to " + spec.LastUpgradeVersion}, nil
+		}
+	}
+
+	return getClusterRestoreLock(ctx, st, clusterName)
+}
+
+// getClusterRestoreLock returns the lock if etcd of the cluster is being recovered from the backup.
+//
+// The restore is considered in progress only while the control plane machines are connected, so that the cluster
+// which can't be restored at all can still be deleted.
+func getClusterRestoreLock(ctx context.Context, st state.State, clusterName string) (clusterLock, error) {
+	controlPlanes, err := safe.StateGetByID[*omni.MachineSet](ctx, st, omni.ControlPlanesResourceID(clusterName))
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return clusterLock{}, nil
+		}
+
+		return clusterLock{}, err
+	}
+
+	bootstrapSpec := controlPlanes.TypedSpec().Value.GetBootstrapSpec()
+	if bootstrapSpec == nil {
+		return clusterLock{}, nil
+	}
+
+	bootstrapStatus, err := safe.StateGetByID[*omni.ClusterBootstrapStatus](ctx, st, clusterName)
+	if err != nil && !state.IsNotFoundError(err) {
+		return clusterLock{}, err
+	}
+
+	if bootstrapStatus != nil && bootstrapStatus.TypedSpec().Value.Bootstrapped {
+		return clusterLock{}, nil
+	}
+
+	clusterStatus, err := safe.StateGetByID[*omni.ClusterStatus](ctx, st, clusterName)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return clusterLock{}, nil
+		}
+
+		return clusterLock{}, err
+	}
+
+	if !clusterStatus.TypedSpec().Value.HasConnectedControlPlanes {
+		return clusterLock{}, nil
+	}
+
+	return clusterLock{operation: clusterOperationRestore, description: "etcd restore from the backup " + bootstrapSpec.Snapshot}, nil
+}
+
+// clusterLockValidationOptions returns the validation options which reject the changes conflicting with the operation in progress on the cluster.
+//
+//nolint:gocognit
+func clusterLockValidationOptions(st state.State) []validated.StateOption {
+	checkScaling := func(ctx context.Context, res resource.Resource) error {
+		clusterName, ok := res.Metadata().Labels().Get(omni.LabelCluster)
+		if !ok {
+			return nil
+		}
+
+		if machineSetName, exists := res.Metadata().Labels().Get(omni.LabelMachineSet); exists {
+			machineSet, err := safe.StateGetByID[*omni.MachineSet](ctx, st, machineSetName)
+			if err != nil && !state.IsNotFoundError(err) {
+				return err
+			}
+
+			// the machine set is being removed, the nodes are removed together with it
+			if machineSet == nil || machineSet.Metadata().Phase() == resource.PhaseTearingDown {
+				return nil
+			}
+		}
+
+		lock, err := getClusterLock(ctx, st, clusterName)
+		if err != nil {
+			return err
+		}
+
+		return lock.check(clusterName, "scaling")
+	}
+
+	return []validated.StateOption{
+		validated.WithCreateValidations(validated.NewCreateValidationForType(func(ctx context.Context, res *omni.MachineSetNode, _ ...state.CreateOption) error {
+			return checkScaling(ctx, res)
+		})),
+		validated.WithUpdateValidations(validated.NewUpdateValidationForType(func(ctx context.Context, oldRes *omni.MachineSetNode, newRes *omni.MachineSetNode, _ ...state.UpdateOption) error {
+			if oldRes == nil || oldRes.Metadata().Phase() == newRes.Metadata().Phase() {
+				return nil
+			}
+
+			return checkScaling(ctx, newRes)
+		})),
+		validated.WithDestroyValidations(validated.NewDestroyValidationForType(func(ctx context.Context, _ resource.Pointer, res *omni.MachineSetNode, _ ...state.DestroyOption) error {
+			if res.Metadata().Phase() == resource.PhaseTearingDown {
+				return nil
+			}
+
+			return checkScaling(ctx, res)
+		})),
+		validated.WithUpdateValidations(validated.NewUpdateValidationForType(func(ctx context.Context, oldRes *omni.MachineSet, newRes *omni.MachineSet, _ ...state.UpdateOption) error {
+			if oldRes == nil || newRes.Metadata().Phase() == resource.PhaseTearingDown {
+				return nil
+			}
+
+			if oldRes.TypedSpec().Value.GetMachineClass().EqualVT(newRes.TypedSpec().Value.GetMachineClass()) {
+				return nil
+			}
+
+			return checkScaling(ctx, newRes)
+		})),
+		validated.WithUpdateValidations(validated.NewUpdateValidationForType(func(ctx context.Context, oldRes *omni.Cluster, newRes *omni.Cluster, _ ...state.UpdateOption) error {
+			if oldRes == nil {
+				return nil
+			}
+
+			clusterName := newRes.Metadata().ID()
+
+			talosVersionChanged := oldRes.TypedSpec().Value.TalosVersion != newRes.TypedSpec().Value.TalosVersion
+			kubernetesVersionChanged := oldRes.TypedSpec().Value.KubernetesVersion != newRes.TypedSpec().Value.KubernetesVersion
+			tearingDown := oldRes.Metadata().Phase() != resource.PhaseTearingDown && newRes.Metadata().Phase() == resource.PhaseTearingDown
+
+			if !talosVersionChanged && !kubernetesVersionChanged && !tearingDown {
+				return nil
+			}
+
+			lock, err := getClusterLock(ctx, st, clusterName)
+			if err != nil {
+				return err
+			}
+
+			// the upgrades can be interrupted by the cluster deletion
+			if tearingDown {
+				return lock.check(clusterName, "deleting the cluster", clusterOperationTalosUpgrade, clusterOperationKubernetesUpgrade)
+			}
+
+			// the upgrade can be retargeted to another version
+			if talosVersionChanged {
+				if err = lock.check(clusterName, "changing the Talos version", clusterOperationTalosUpgrade); err != nil {
+					return err
+				}
+			}
+
+			if kubernetesVersionChanged {
+				return lock.check(clusterName, "changing the Kubernetes version", clusterOperationKubernetesUpgrade)
+			}
+
+			return nil
+		})),
+		validated.WithDestroyValidations(validated.NewDestroyValidationForType(func(ctx context.Context, _ resource.Pointer, res *omni.Cluster, _ ...state.DestroyOption) error {
+			if res.Metadata().Phase() == resource.PhaseTearingDown {
+				return nil
+			}
+
+			lock, err := getClusterLock(ctx, st, res.Metadata().ID())
+			if err != nil {
+				return err
+			}
+
+			return lock.check(res.Metadata().ID(), "deleting the cluster", clusterOperationTalosUpgrade, clusterOperationKubernetesUpgrade)
+		})),
+	}
+}
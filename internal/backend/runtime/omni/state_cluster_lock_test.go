@@ -0,0 +1,115 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/validated"
+)
+
+func TestClusterLockValidation(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	t.Cleanup(cancel)
+
+	innerSt := state.WrapCore(namespaced.NewState(inmem.Build))
+	st := state.WrapCore(validated.NewState(innerSt, omni.ClusterLockValidationOptions(innerSt)...))
+
+	cluster := omnires.NewCluster(resources.DefaultNamespace, "test")
+	cluster.TypedSpec().Value.TalosVersion = "1.7.0"
+	cluster.TypedSpec().Value.KubernetesVersion = "1.30.0"
+
+	require.NoError(t, st.Create(ctx, cluster))
+
+	workers := omnires.NewMachineSet(resources.DefaultNamespace, omnires.WorkersResourceID(cluster.Metadata().ID()))
+	workers.Metadata().Labels().Set(omnires.LabelCluster, cluster.Metadata().ID())
+	workers.Metadata().Labels().Set(omnires.LabelWorkerRole, "")
+
+	require.NoError(t, st.Create(ctx, workers))
+	require.NoError(t, st.Create(ctx, omnires.NewMachineSetNode(resources.DefaultNamespace, "node-1", workers)))
+
+	talosUpgradeStatus := omnires.NewTalosUpgradeStatus(resources.DefaultNamespace, cluster.Metadata().ID())
+	talosUpgradeStatus.TypedSpec().Value.Phase = specs.TalosUpgradeStatusSpec_Upgrading
+	talosUpgradeStatus.TypedSpec().Value.CurrentUpgradeVersion = "1.8.0"
+
+	require.NoError(t, innerSt.Create(ctx, talosUpgradeStatus))
+
+	// scaling is rejected while Talos is being upgraded
+	err := st.Create(ctx, omnires.NewMachineSetNode(resources.DefaultNamespace, "node-2", workers))
+	require.Error(t, err)
+	assert.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, `scaling is not allowed: the cluster "test" is locked by the operation in progress: Talos upgrade to 1.8.0`)
+
+	_, err = st.Teardown(ctx, omnires.NewMachineSetNode(resources.DefaultNamespace, "node-1", workers).Metadata())
+	assert.ErrorContains(t, err, "scaling is not allowed")
+
+	_, err = safe.StateUpdateWithConflicts(ctx, st, cluster.Metadata(), func(res *omnires.Cluster) error {
+		res.TypedSpec().Value.KubernetesVersion = "1.31.0"
+
+		return nil
+	})
+	assert.ErrorContains(t, err, "changing the Kubernetes version is not allowed")
+
+	// the Talos upgrade can be retargeted
+	_, err = safe.StateUpdateWithConflicts(ctx, st, cluster.Metadata(), func(res *omnires.Cluster) error {
+		res.TypedSpec().Value.TalosVersion = "1.8.1"
+
+		return nil
+	})
+	assert.NoError(t, err)
+
+	// the lock is released once the upgrade is finished
+	_, err = safe.StateUpdateWithConflicts(ctx, innerSt, talosUpgradeStatus.Metadata(), func(res *omnires.TalosUpgradeStatus) error {
+		res.TypedSpec().Value.Phase = specs.TalosUpgradeStatusSpec_Done
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.NoError(t, st.Create(ctx, omnires.NewMachineSetNode(resources.DefaultNamespace, "node-2", workers)))
+
+	// the cluster can't be deleted while etcd is being restored
+	controlPlanes := omnires.NewMachineSet(resources.DefaultNamespace, omnires.ControlPlanesResourceID(cluster.Metadata().ID()))
+	controlPlanes.Metadata().Labels().Set(omnires.LabelCluster, cluster.Metadata().ID())
+	controlPlanes.Metadata().Labels().Set(omnires.LabelControlPlaneRole, "")
+	controlPlanes.TypedSpec().Value.BootstrapSpec = &specs.MachineSetSpec_BootstrapSpec{
+		ClusterUuid: "uuid",
+		Snapshot:    "snapshot.snapshot",
+	}
+
+	require.NoError(t, st.Create(ctx, controlPlanes))
+
+	clusterStatus := omnires.NewClusterStatus(resources.DefaultNamespace, cluster.Metadata().ID())
+	clusterStatus.TypedSpec().Value.HasConnectedControlPlanes = true
+
+	require.NoError(t, innerSt.Create(ctx, clusterStatus))
+
+	_, err = st.Teardown(ctx, cluster.Metadata())
+	assert.ErrorContains(t, err, "deleting the cluster is not allowed: the cluster \"test\" is locked by the operation in progress: etcd restore from the backup snapshot.snapshot")
+
+	bootstrapStatus := omnires.NewClusterBootstrapStatus(resources.DefaultNamespace, cluster.Metadata().ID())
+	bootstrapStatus.TypedSpec().Value.Bootstrapped = true
+
+	require.NoError(t, innerSt.Create(ctx, bootstrapStatus))
+
+	_, err = st.Teardown(ctx, cluster.Metadata())
+	assert.NoError(t, err)
+}
@@ -0,0 +1,187 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/xslices"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/pkg/constants"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+// destroyReasonMaxLength limits the length of the reason stored in the resource annotation.
+const destroyReasonMaxLength = 1024
+
+type destroyReasonContextKey struct{}
+
+// WithDestroyReason attaches the reason of the resource deletion to the context.
+//
+// The reason set in the context takes precedence over the one sent in the gRPC metadata.
+func WithDestroyReason(ctx context.Context, reason string) context.Context {
+	if reason == "" {
+		return ctx
+	}
+
+	return context.WithValue(ctx, destroyReasonContextKey{}, reason)
+}
+
+func destroyReasonFromContext(ctx context.Context) string {
+	if reason, ok := ctx.Value(destroyReasonContextKey{}).(string); ok {
+		return reason
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	if values := md.Get(constants.DestroyReasonHeader); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+// destroyReasonState wraps COSI core state and records the reasons of the resource deletions made through the API.
+//
+// The reason is stored in the annotation of the resource being torn down and logged, the resources of the configured types
+// can't be deleted without the reason if it's required.
+type destroyReasonState struct {
+	state.CoreState
+
+	logger   *zap.Logger
+	types    map[resource.Type]struct{}
+	required bool
+}
+
+// Check interfaces.
+var _ state.CoreState = &destroyReasonState{}
+
+func wrapStateWithDestroyReason(st state.CoreState, params config.DestroyReasonParams, logger *zap.Logger) *destroyReasonState {
+	return &destroyReasonState{
+		CoreState: st,
+		logger:    logger,
+		types:     xslices.ToSet(params.Types),
+		required:  params.Required,
+	}
+}
+
+// Update implements state.CoreState.
+//
+// The teardown of the resource is the update which sets the tearing down phase.
+func (st *destroyReasonState) Update(ctx context.Context, newResource resource.Resource, opts ...state.UpdateOption) error {
+	if newResource.Metadata().Phase() != resource.PhaseTearingDown || !isAPIRequest(ctx) {
+		return st.CoreState.Update(ctx, newResource, opts...)
+	}
+
+	current, err := st.CoreState.Get(ctx, newResource.Metadata())
+	if err != nil {
+		return err
+	}
+
+	if current.Metadata().Phase() == resource.PhaseTearingDown {
+		return st.CoreState.Update(ctx, newResource, opts...)
+	}
+
+	reason, err := st.checkReason(ctx, newResource.Metadata())
+	if err != nil {
+		return err
+	}
+
+	if reason != "" {
+		newResource.Metadata().Annotations().Set(omni.DestroyReason, reason)
+	}
+
+	if err = st.CoreState.Update(ctx, newResource, opts...); err != nil {
+		return err
+	}
+
+	st.logDeletion(ctx, newResource.Metadata(), reason)
+
+	return nil
+}
+
+// Destroy implements state.CoreState.
+func (st *destroyReasonState) Destroy(ctx context.Context, ptr resource.Pointer, opts ...state.DestroyOption) error {
+	if !isAPIRequest(ctx) {
+		return st.CoreState.Destroy(ctx, ptr, opts...)
+	}
+
+	current, err := st.CoreState.Get(ctx, ptr)
+	if err != nil {
+		return err
+	}
+
+	// the reason was already checked and recorded on teardown
+	if current.Metadata().Phase() == resource.PhaseTearingDown {
+		return st.CoreState.Destroy(ctx, ptr, opts...)
+	}
+
+	reason, err := st.checkReason(ctx, ptr)
+	if err != nil {
+		return err
+	}
+
+	if err = st.CoreState.Destroy(ctx, ptr, opts...); err != nil {
+		return err
+	}
+
+	st.logDeletion(ctx, ptr, reason)
+
+	return nil
+}
+
+func (st *destroyReasonState) checkReason(ctx context.Context, ptr resource.Pointer) (string, error) {
+	reason := destroyReasonFromContext(ctx)
+
+	if len(reason) > destroyReasonMaxLength {
+		return "", status.Errorf(codes.InvalidArgument, "destroy reason is too long: %d > %d", len(reason), destroyReasonMaxLength)
+	}
+
+	if reason != "" || !st.required {
+		return reason, nil
+	}
+
+	if _, ok := st.types[ptr.Type()]; ok {
+		return "", status.Errorf(codes.FailedPrecondition, "a reason is required to delete %s %q", ptr.Type(), ptr.ID())
+	}
+
+	return "", nil
+}
+
+func (st *destroyReasonState) logDeletion(ctx context.Context, ptr resource.Pointer, reason string) {
+	if _, ok := st.types[ptr.Type()]; !ok && reason == "" {
+		return
+	}
+
+	identity, _ := ctx.Value(auth.IdentityContextKey{}).(string) //nolint:errcheck
+
+	st.logger.Info("resource deletion requested",
+		zap.String("identity", identity),
+		zap.String("type", ptr.Type()),
+		zap.String("id", ptr.ID()),
+		zap.String("reason", reason),
+	)
+}
+
+// isAPIRequest returns true if the request comes from the API on behalf of the user.
+func isAPIRequest(ctx context.Context) bool {
+	if actor.ContextIsInternalActor(ctx) {
+		return false
+	}
+
+	identity, ok := ctx.Value(auth.IdentityContextKey{}).(string)
+
+	return ok && identity != ""
+}
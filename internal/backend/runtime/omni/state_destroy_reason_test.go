@@ -0,0 +1,86 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/pkg/constants"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+func TestDestroyReasonState(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	innerSt := state.WrapCore(namespaced.NewState(inmem.Build))
+	st := state.WrapCore(omni.WrapStateWithDestroyReason(innerSt, config.DestroyReasonParams{
+		Types:    []string{omnires.ConfigPatchType},
+		Required: true,
+	}, zaptest.NewLogger(t)))
+
+	userCtx := context.WithValue(ctx, auth.IdentityContextKey{}, "alice@example.com")
+
+	for _, id := range []string{"patch1", "patch2", "patch3"} {
+		require.NoError(t, st.Create(ctx, omnires.NewConfigPatch(resources.DefaultNamespace, id)))
+	}
+
+	// the reason is required for the API requests
+	_, err := st.Teardown(userCtx, omnires.NewConfigPatch(resources.DefaultNamespace, "patch1").Metadata())
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	err = st.Destroy(userCtx, omnires.NewConfigPatch(resources.DefaultNamespace, "patch1").Metadata())
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// the reason is recorded on teardown, and the teardown allows the destroy without the reason
+	_, err = st.Teardown(omni.WithDestroyReason(userCtx, "decommissioned"), omnires.NewConfigPatch(resources.DefaultNamespace, "patch1").Metadata())
+	require.NoError(t, err)
+
+	patch, err := safe.StateGetByID[*omnires.ConfigPatch](ctx, st, "patch1")
+	require.NoError(t, err)
+
+	reason, _ := patch.Metadata().Annotations().Get(omnires.DestroyReason)
+	assert.Equal(t, "decommissioned", reason)
+	assert.Equal(t, resource.PhaseTearingDown, patch.Metadata().Phase())
+
+	require.NoError(t, st.Destroy(userCtx, patch.Metadata()))
+
+	// the reason is read from the gRPC metadata
+	mdCtx := metadata.NewIncomingContext(userCtx, metadata.Pairs(constants.DestroyReasonHeader, "replaced"))
+
+	require.NoError(t, st.Destroy(mdCtx, omnires.NewConfigPatch(resources.DefaultNamespace, "patch2").Metadata()))
+
+	// the internal requests don't require the reason
+	require.NoError(t, st.Destroy(ctx, omnires.NewConfigPatch(resources.DefaultNamespace, "patch3").Metadata()))
+
+	// the other resource types don't require the reason
+	machineClass := omnires.NewMachineClass(resources.DefaultNamespace, "class")
+
+	require.NoError(t, st.Create(ctx, machineClass))
+	require.NoError(t, st.Destroy(userCtx, machineClass.Metadata()))
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+)
+
+// dryRunState reads from the underlying state and discards all writes.
+//
+// Wrapped with the validations, it runs the access checks and the validations of the writes without persisting anything.
+type dryRunState struct {
+	state.CoreState
+}
+
+// Create implements state.CoreState.
+func (st *dryRunState) Create(context.Context, resource.Resource, ...state.CreateOption) error {
+	return nil
+}
+
+// Update implements state.CoreState.
+func (st *dryRunState) Update(context.Context, resource.Resource, ...state.UpdateOption) error {
+	return nil
+}
+
+// Destroy implements state.CoreState.
+func (st *dryRunState) Destroy(context.Context, resource.Pointer, ...state.DestroyOption) error {
+	return nil
+}
@@ -14,6 +14,7 @@ import (
 	"net"
 	"net/url"
 	"os"
+	"path"
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
@@ -41,6 +42,10 @@ import (
 const compressionThresholdBytes = 2048
 
 func buildEtcdPersistentState(ctx context.Context, params *config.Params, logger *zap.Logger, f func(context.Context, namespaced.StateBuilder) error) error {
+	if err := validateEtcdShards(params.Storage.Etcd.Shards); err != nil {
+		return err
+	}
+
 	prefix := fmt.Sprintf("/omni/%s", url.PathEscape(params.AccountID))
 
 	return getEtcdClient(ctx, &params.Storage.Etcd, logger, func(ctx context.Context, etcdClient *clientv3.Client) error {
@@ -52,30 +57,132 @@ func buildEtcdPersistentState(ctx context.Context, params *config.Params, logger
 
 			salt := sha256.Sum256([]byte(params.AccountID))
 
-			etcdState := etcd.NewState(
-				etcdClient,
-				encryption.NewMarshaler(
-					compression.NewMarshaler(
-						store.ProtobufMarshaler{},
-						compression.ZStd(),
-						compressionThresholdBytes,
+			newEtcdState := func(client etcd.Client, keyPrefix string) *etcd.State {
+				return etcd.NewState(
+					client,
+					encryption.NewMarshaler(
+						compression.NewMarshaler(
+							store.ProtobufMarshaler{},
+							compression.ZStd(),
+							compressionThresholdBytes,
+						),
+						cipher,
 					),
-					cipher,
-				),
-				etcd.WithKeyPrefix(prefix),
-				etcd.WithSalt(salt[:]),
-			)
-
-			stateBuilder := func(resource.Namespace) state.CoreState {
-				// etcdState handles all namespaces in a single instance
-				return etcdState
+					etcd.WithKeyPrefix(keyPrefix),
+					etcd.WithSalt(salt[:]),
+				)
 			}
 
-			return f(ctx, stateBuilder)
+			// etcdState handles all namespaces which are not assigned to any shard in a single instance
+			etcdState := newEtcdState(etcdClient, prefix)
+
+			return withEtcdShardClients(ctx, &params.Storage.Etcd, etcdClient, logger, func(ctx context.Context, shardClients map[string]*clientv3.Client) error {
+				shards := make([]etcdShard, 0, len(params.Storage.Etcd.Shards))
+
+				for _, shardParams := range params.Storage.Etcd.Shards {
+					shardPrefix := fmt.Sprintf("/omni-shards/%s/%s", url.PathEscape(params.AccountID), url.PathEscape(shardParams.Name))
+
+					shards = append(shards, etcdShard{
+						state:      newEtcdState(shardClients[shardParams.Name], shardPrefix),
+						namespaces: shardParams.Namespaces,
+					})
+				}
+
+				return f(ctx, shardedStateBuilder(etcdState, shards))
+			})
 		})
 	})
 }
 
+// etcdShard is the etcd state storing the resources of the namespaces matching the patterns.
+type etcdShard struct {
+	state      state.CoreState
+	namespaces []string
+}
+
+// shardedStateBuilder routes each namespace to the state of the first shard matching it,
+// the namespaces which don't match any shard are stored in the main state.
+func shardedStateBuilder(main state.CoreState, shards []etcdShard) namespaced.StateBuilder {
+	return func(ns resource.Namespace) state.CoreState {
+		for _, shard := range shards {
+			for _, pattern := range shard.namespaces {
+				// the patterns are validated on startup
+				if matched, _ := path.Match(pattern, ns); matched {
+					return shard.state
+				}
+			}
+		}
+
+		return main
+	}
+}
+
+func validateEtcdShards(shards []config.EtcdShardParams) error {
+	names := make(map[string]struct{}, len(shards))
+
+	for _, shard := range shards {
+		if shard.Name == "" {
+			return errors.New("etcd shard name is required")
+		}
+
+		if _, exists := names[shard.Name]; exists {
+			return fmt.Errorf("duplicate etcd shard %q", shard.Name)
+		}
+
+		names[shard.Name] = struct{}{}
+
+		if len(shard.Namespaces) == 0 {
+			return fmt.Errorf("etcd shard %q has no namespaces", shard.Name)
+		}
+
+		for _, pattern := range shard.Namespaces {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("invalid namespace pattern %q of etcd shard %q: %w", pattern, shard.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// withEtcdShardClients creates the clients for the shards stored in the external etcd clusters, the rest of the shards use the main client.
+func withEtcdShardClients(ctx context.Context, params *config.EtcdParams, mainClient *clientv3.Client, logger *zap.Logger,
+	f func(context.Context, map[string]*clientv3.Client) error,
+) error {
+	clients := make(map[string]*clientv3.Client, len(params.Shards))
+
+	var connect func(ctx context.Context, shards []config.EtcdShardParams) error
+
+	connect = func(ctx context.Context, shards []config.EtcdShardParams) error {
+		if len(shards) == 0 {
+			return f(ctx, clients)
+		}
+
+		shard := shards[0]
+
+		if len(shard.Endpoints) == 0 {
+			clients[shard.Name] = mainClient
+
+			return connect(ctx, shards[1:])
+		}
+
+		shardParams := &config.EtcdParams{
+			Endpoints: shard.Endpoints,
+			CAPath:    params.CAPath,
+			CertPath:  params.CertPath,
+			KeyPath:   params.KeyPath,
+		}
+
+		return getExternalEtcdClient(ctx, shardParams, logger.With(zap.String("etcd_shard", shard.Name)), func(ctx context.Context, client *clientv3.Client) error {
+			clients[shard.Name] = client
+
+			return connect(ctx, shards[1:])
+		})
+	}
+
+	return connect(ctx, params.Shards)
+}
+
 func makeCipher(name string, etcdParams config.EtcdParams, etcdClient etcd.Client, logger *zap.Logger) (*encryption.Cipher, error) {
 	publicKeys, err := loadPublicKeys(etcdParams)
 	if err != nil {
@@ -224,3 +224,52 @@ func TestEncryptDecrypt(t *testing.T) {
 		}
 	}
 }
+
+func TestEtcdShards(t *testing.T) {
+	etcdDir := filepath.Join(t.TempDir(), "etcd")
+
+	params := &config.Params{
+		Name: "instance-name",
+		Storage: config.StorageParams{
+			Etcd: config.EtcdParams{
+				Embedded:         true,
+				EmbeddedDBPath:   etcdDir,
+				PrivateKeySource: "file://testdata/pgp/old_key.private",
+				Endpoints:        []string{"http://localhost:0"},
+				Shards: []config.EtcdShardParams{
+					{
+						Name:       "clusters",
+						Namespaces: []string{"cluster-*"},
+					},
+				},
+			},
+		},
+	}
+
+	err := omniruntime.BuildEtcdPersistentState(context.TODO(), params, zaptest.NewLogger(t), func(ctx context.Context, stateBuilder namespaced.StateBuilder) error {
+		sharded := omni.NewCluster("cluster-a", "clusterID")
+
+		require.NoError(t, stateBuilder(sharded.Metadata().Namespace()).Create(ctx, sharded))
+
+		// the resources of the sharded namespace are stored under the separate prefix
+		_, err := stateBuilder(resources.DefaultNamespace).Get(ctx, sharded.Metadata())
+		require.True(t, state.IsNotFoundError(err))
+
+		got, err := stateBuilder("cluster-b").Get(ctx, sharded.Metadata())
+		require.NoError(t, err)
+		require.True(t, resource.Equal(sharded, got))
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	params.Storage.Etcd.Shards = append(params.Storage.Etcd.Shards, config.EtcdShardParams{
+		Name:       "invalid",
+		Namespaces: []string{"cluster-["},
+	})
+
+	err = omniruntime.BuildEtcdPersistentState(context.TODO(), params, zaptest.NewLogger(t), func(context.Context, namespaced.StateBuilder) error {
+		return nil
+	})
+	require.ErrorContains(t, err, `invalid namespace pattern "cluster-[" of etcd shard "invalid"`)
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/validated"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+// labelPolicyValidationOptions enforces the label policy on the user labels of the machines, clusters, machine sets and cluster machines.
+//
+// The system labels of the machines are never set by the users, the system labels of the other resources are set by the cluster templates,
+// so they are not checked.
+func labelPolicyValidationOptions(params config.LabelPolicyParams) ([]validated.StateOption, error) {
+	policy, err := newLabelPolicy(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.Concat(
+		labelPolicyValidationOptionsForType[*omni.MachineLabels](policy, false),
+		labelPolicyValidationOptionsForType[*omni.Cluster](policy, true),
+		labelPolicyValidationOptionsForType[*omni.MachineSet](policy, true),
+		labelPolicyValidationOptionsForType[*omni.ClusterMachine](policy, true),
+	), nil
+}
+
+func labelPolicyValidationOptionsForType[T resource.Resource](policy *labelPolicy, skipSystemLabels bool) []validated.StateOption {
+	return []validated.StateOption{
+		validated.WithCreateValidations(validated.NewCreateValidationForType(func(_ context.Context, res T, _ ...state.CreateOption) error {
+			return policy.validate(nil, res.Metadata().Labels(), skipSystemLabels)
+		})),
+		validated.WithUpdateValidations(validated.NewUpdateValidationForType(func(_ context.Context, existingRes, newRes T, _ ...state.UpdateOption) error {
+			return policy.validate(existingRes.Metadata().Labels(), newRes.Metadata().Labels(), skipSystemLabels)
+		})),
+	}
+}
+
+type labelPolicy struct {
+	valuePattern        *regexp.Regexp
+	valuePatternSource  string
+	allowedKeyPrefixes  []string
+	reservedKeyPrefixes []string
+}
+
+func newLabelPolicy(params config.LabelPolicyParams) (*labelPolicy, error) {
+	policy := &labelPolicy{
+		valuePatternSource:  params.ValuePattern,
+		allowedKeyPrefixes:  params.AllowedKeyPrefixes,
+		reservedKeyPrefixes: params.ReservedKeyPrefixes,
+	}
+
+	if params.ValuePattern != "" {
+		var err error
+
+		// the value must match the whole pattern, not a part of it
+		if policy.valuePattern, err = regexp.Compile("^(?:" + params.ValuePattern + ")$"); err != nil {
+			return nil, fmt.Errorf("invalid label value pattern %q: %w", params.ValuePattern, err)
+		}
+	}
+
+	return policy, nil
+}
+
+// validate checks the labels which are added or changed compared to the existing labels.
+func (policy *labelPolicy) validate(existingLabels, labels *resource.Labels, skipSystemLabels bool) error {
+	raw := labels.Raw()
+
+	keys := make([]string, 0, len(raw))
+
+	for key := range raw {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	var multiErr error
+
+	for _, key := range keys {
+		value := raw[key]
+
+		if existingLabels != nil {
+			if existingValue, ok := existingLabels.Get(key); ok && existingValue == value {
+				continue
+			}
+		}
+
+		if strings.HasPrefix(key, omni.SystemLabelPrefix) {
+			if !skipSystemLabels {
+				multiErr = multierror.Append(multiErr, fmt.Errorf("label %q is invalid: prefix %q is reserved for internal use", key, omni.SystemLabelPrefix))
+			}
+
+			continue
+		}
+
+		if err := policy.validateLabel(key, value); err != nil {
+			multiErr = multierror.Append(multiErr, err)
+		}
+	}
+
+	return multiErr
+}
+
+func (policy *labelPolicy) validateLabel(key, value string) error {
+	hasPrefix := func(prefix string) bool { return strings.HasPrefix(key, prefix) }
+
+	if index := slices.IndexFunc(policy.reservedKeyPrefixes, hasPrefix); index != -1 {
+		return fmt.Errorf("label %q is invalid: prefix %q is reserved", key, policy.reservedKeyPrefixes[index])
+	}
+
+	if len(policy.allowedKeyPrefixes) > 0 && !slices.ContainsFunc(policy.allowedKeyPrefixes, hasPrefix) {
+		return fmt.Errorf("label %q is invalid: the key must start with one of the prefixes %q", key, policy.allowedKeyPrefixes)
+	}
+
+	if policy.valuePattern != nil && !policy.valuePattern.MatchString(value) {
+		return fmt.Errorf("label %q is invalid: the value %q doesn't match the pattern %q", key, value, policy.valuePatternSource)
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/validated"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+func TestLabelPolicyValidation(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	t.Cleanup(cancel)
+
+	options, err := omni.LabelPolicyValidationOptions(config.LabelPolicyParams{
+		AllowedKeyPrefixes:  []string{"fleet.example.com/", "team-"},
+		ReservedKeyPrefixes: []string{"fleet.example.com/internal-"},
+		ValuePattern:        "[a-z0-9-]*",
+	})
+	require.NoError(t, err)
+
+	innerSt := state.WrapCore(namespaced.NewState(inmem.Build))
+	st := state.WrapCore(validated.NewState(innerSt, options...))
+
+	assertInvalid := func(err error, msg string) {
+		t.Helper()
+
+		require.Error(t, err)
+		assert.True(t, validated.IsValidationError(err), "expected validation error")
+		assert.ErrorContains(t, err, msg)
+	}
+
+	machineLabels := omnires.NewMachineLabels(resources.DefaultNamespace, "machine-1")
+	machineLabels.Metadata().Labels().Set("fleet.example.com/site", "site-a")
+	machineLabels.Metadata().Labels().Set("team-owner", "infra")
+
+	require.NoError(t, st.Create(ctx, machineLabels))
+
+	// the system labels can't be set on the machines
+	machineLabels.Metadata().Labels().Set(omnires.LabelCluster, "talos-default")
+	assertInvalid(st.Update(ctx, machineLabels), "is reserved for internal use")
+
+	machineLabels.Metadata().Labels().Delete(omnires.LabelCluster)
+	machineLabels.Metadata().Labels().Set("fleet.example.com/internal-id", "1")
+	assertInvalid(st.Update(ctx, machineLabels), `prefix "fleet.example.com/internal-" is reserved`)
+
+	machineLabels.Metadata().Labels().Delete("fleet.example.com/internal-id")
+	machineLabels.Metadata().Labels().Set("rack", "1")
+	assertInvalid(st.Update(ctx, machineLabels), "the key must start with one of the prefixes")
+
+	machineLabels.Metadata().Labels().Delete("rack")
+	machineLabels.Metadata().Labels().Set("team-owner", "Infra Team")
+	assertInvalid(st.Update(ctx, machineLabels), "doesn't match the pattern")
+
+	// the system labels of the clusters are set by the cluster templates
+	cluster := omnires.NewCluster(resources.DefaultNamespace, "talos-default")
+	cluster.Metadata().Labels().Set(omnires.SystemLabelPrefix+"managed", "")
+	cluster.Metadata().Labels().Set("team-owner", "infra")
+
+	require.NoError(t, st.Create(ctx, cluster))
+
+	cluster = omnires.NewCluster(resources.DefaultNamespace, "other")
+	cluster.Metadata().Labels().Set("env", "prod")
+
+	assertInvalid(st.Create(ctx, cluster), "the key must start with one of the prefixes")
+
+	// the labels set before the policy was enforced are kept until they are changed
+	legacy := omnires.NewMachineSet(resources.DefaultNamespace, "legacy-workers")
+	legacy.Metadata().Labels().Set("env", "Prod")
+
+	require.NoError(t, innerSt.Create(ctx, legacy))
+
+	legacy.Metadata().Labels().Set("team-owner", "infra")
+	require.NoError(t, st.Update(ctx, legacy))
+
+	legacy.Metadata().Labels().Set("env", "staging")
+	assertInvalid(st.Update(ctx, legacy), "the key must start with one of the prefixes")
+
+	_, err = omni.LabelPolicyValidationOptions(config.LabelPolicyParams{ValuePattern: "["})
+	require.Error(t, err)
+}
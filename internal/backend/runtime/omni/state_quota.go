@@ -0,0 +1,78 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/pkg/quota"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/validated"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+)
+
+// quotaValidationOptions rejects creating the clusters and adding the machines to the clusters over the quotas.
+//
+// The machines allocated from the machine classes are limited by the MachineSetNodeController.
+func quotaValidationOptions(st state.State) []validated.StateOption {
+	return []validated.StateOption{
+		validated.WithCreateValidations(validated.NewCreateValidationForType(func(ctx context.Context, _ *omni.Cluster, _ ...state.CreateOption) error {
+			// the clusters created by Omni itself are not limited
+			identity, _ := ctx.Value(auth.IdentityContextKey{}).(string)
+
+			limits, err := quota.Get(ctx, st, identity)
+			if err != nil {
+				return err
+			}
+
+			return limits.CheckCluster()
+		})),
+		validated.WithCreateValidations(validated.NewCreateValidationForType(func(ctx context.Context, res *omni.MachineSetNode, _ ...state.CreateOption) error {
+			clusterName, ok := res.Metadata().Labels().Get(omni.LabelCluster)
+			if !ok {
+				return nil
+			}
+
+			cluster, err := safe.StateGetByID[*omni.Cluster](ctx, st, clusterName)
+			if err != nil {
+				if state.IsNotFoundError(err) {
+					return nil
+				}
+
+				return err
+			}
+
+			owner, _ := cluster.Metadata().Annotations().Get(omni.CreatedBy)
+
+			limits, err := quota.Get(ctx, st, owner)
+			if err != nil {
+				return err
+			}
+
+			return limits.CheckMachine()
+		})),
+		validated.WithCreateValidations(validated.NewCreateValidationForType(func(_ context.Context, res *omni.Quota, _ ...state.CreateOption) error {
+			return validateQuota(res)
+		})),
+		validated.WithUpdateValidations(validated.NewUpdateValidationForType(func(_ context.Context, _ *omni.Quota, newRes *omni.Quota, _ ...state.UpdateOption) error {
+			return validateQuota(newRes)
+		})),
+	}
+}
+
+func validateQuota(res *omni.Quota) error {
+	for _, identity := range res.TypedSpec().Value.Identities {
+		if identity == "" {
+			return errors.New("quota identities can't be empty")
+		}
+	}
+
+	return nil
+}
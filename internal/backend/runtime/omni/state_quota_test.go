@@ -0,0 +1,84 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/validated"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+)
+
+func TestQuotaValidation(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	t.Cleanup(cancel)
+
+	innerSt := state.WrapCore(omni.WrapStateWithAuthorship(namespaced.NewState(inmem.Build)))
+	st := state.WrapCore(validated.NewState(innerSt, omni.QuotaValidationOptions(innerSt)...))
+
+	aliceCtx := context.WithValue(ctx, auth.IdentityContextKey{}, "alice@example.com")
+	bobCtx := context.WithValue(ctx, auth.IdentityContextKey{}, "bob@example.com")
+	carolCtx := context.WithValue(ctx, auth.IdentityContextKey{}, "carol@example.com")
+
+	quota := omnires.NewQuota("team-a")
+	quota.TypedSpec().Value.Identities = []string{"alice@example.com", "bob@example.com"}
+	quota.TypedSpec().Value.MaxClusters = 2
+	quota.TypedSpec().Value.MaxMachines = 2
+
+	require.NoError(t, st.Create(ctx, quota))
+
+	require.NoError(t, st.Create(aliceCtx, omnires.NewCluster(resources.DefaultNamespace, "alice-1")))
+	require.NoError(t, st.Create(bobCtx, omnires.NewCluster(resources.DefaultNamespace, "bob-1")))
+
+	// the team shares the quota
+	err := st.Create(aliceCtx, omnires.NewCluster(resources.DefaultNamespace, "alice-2"))
+	require.Error(t, err)
+	assert.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, `the limit of 2 clusters of the quota "team-a" is reached`)
+
+	// the identities outside of the quota and Omni itself are not limited
+	require.NoError(t, st.Create(carolCtx, omnires.NewCluster(resources.DefaultNamespace, "carol-1")))
+	require.NoError(t, st.Create(ctx, omnires.NewCluster(resources.DefaultNamespace, "internal-1")))
+
+	workers := omnires.NewMachineSet(resources.DefaultNamespace, omnires.WorkersResourceID("alice-1"))
+	workers.Metadata().Labels().Set(omnires.LabelCluster, "alice-1")
+	workers.Metadata().Labels().Set(omnires.LabelWorkerRole, "")
+
+	require.NoError(t, st.Create(aliceCtx, workers))
+	require.NoError(t, st.Create(aliceCtx, omnires.NewMachineSetNode(resources.DefaultNamespace, "node-1", workers)))
+	require.NoError(t, st.Create(aliceCtx, omnires.NewMachineSetNode(resources.DefaultNamespace, "node-2", workers)))
+
+	// the machines count towards the owner of the cluster
+	err = st.Create(carolCtx, omnires.NewMachineSetNode(resources.DefaultNamespace, "node-3", workers))
+	require.Error(t, err)
+	assert.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, `the limit of 2 machines of the quota "team-a" is reached`)
+
+	// the destroyed clusters release the quota
+	_, err = st.Teardown(ctx, omnires.NewCluster(resources.DefaultNamespace, "bob-1").Metadata())
+	require.NoError(t, err)
+
+	require.NoError(t, st.Create(aliceCtx, omnires.NewCluster(resources.DefaultNamespace, "alice-2")))
+
+	quota.TypedSpec().Value.Identities = append(quota.TypedSpec().Value.Identities, "")
+
+	err = st.Update(ctx, quota)
+	require.Error(t, err)
+	assert.True(t, validated.IsValidationError(err), "expected validation error")
+}
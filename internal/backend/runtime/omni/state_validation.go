@@ -10,6 +10,8 @@ import (
 	"errors"
 	"fmt"
 	"net/mail"
+	"net/netip"
+	"path/filepath"
 	"strings"
 	"time"
 	"unicode"
@@ -19,18 +21,22 @@ import (
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/hashicorp/go-multierror"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/cosi/labels"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/logging"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup/store"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/notification"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/validated"
 	"github.com/siderolabs/omni/internal/pkg/auth/accesspolicy"
 	"github.com/siderolabs/omni/internal/pkg/auth/actor"
 	"github.com/siderolabs/omni/internal/pkg/auth/role"
 	"github.com/siderolabs/omni/internal/pkg/config"
+	"github.com/siderolabs/omni/internal/pkg/settings"
 )
 
 // clusterValidationOptions returns the validation options for the Talos and Kubernetes versions on the cluster resource.
@@ -88,22 +94,40 @@ func clusterValidationOptions(st state.State, etcdBackupConfig config.EtcdBackup
 		return nil
 	}
 
-	validateBackupInterval := func(res *omni.Cluster) error {
-		if conf := res.TypedSpec().Value.GetBackupConfiguration(); conf != nil {
-			switch conf := conf.GetInterval().AsDuration(); {
-			case conf < etcdBackupConfig.MinInterval:
-				return fmt.Errorf(
-					"backup interval must be greater than %s, actual %s",
-					etcdBackupConfig.MinInterval.String(),
-					conf.String(),
-				)
-			case conf > etcdBackupConfig.MaxInterval:
-				return fmt.Errorf(
-					"backup interval must be less than %s, actual %s",
-					etcdBackupConfig.MaxInterval.String(),
-					conf.String(),
-				)
-			}
+	validateBackupInterval := func(ctx context.Context, res *omni.Cluster) error {
+		conf := res.TypedSpec().Value.GetBackupConfiguration()
+		if conf == nil {
+			return nil
+		}
+
+		settingsSpec, err := settings.Get(ctx, st)
+		if err != nil {
+			return err
+		}
+
+		minInterval, maxInterval := settings.EtcdBackupIntervals(settingsSpec, etcdBackupConfig)
+
+		switch interval := conf.GetInterval().AsDuration(); {
+		case interval < minInterval:
+			return fmt.Errorf(
+				"backup interval must be greater than %s, actual %s",
+				minInterval.String(),
+				interval.String(),
+			)
+		case interval > maxInterval:
+			return fmt.Errorf(
+				"backup interval must be less than %s, actual %s",
+				maxInterval.String(),
+				interval.String(),
+			)
+		}
+
+		return nil
+	}
+
+	validateTTL := func(res *omni.Cluster) error {
+		if ttl := res.TypedSpec().Value.GetTtl(); ttl != nil && ttl.AsDuration() <= 0 {
+			return fmt.Errorf("ttl must be positive, actual %s", ttl.AsDuration().String())
 		}
 
 		return nil
@@ -133,7 +157,11 @@ func clusterValidationOptions(st state.State, etcdBackupConfig config.EtcdBackup
 				multiErr = multierror.Append(multiErr, err)
 			}
 
-			if err := validateBackupInterval(res); err != nil {
+			if err := validateBackupInterval(ctx, res); err != nil {
+				multiErr = multierror.Append(multiErr, err)
+			}
+
+			if err := validateTTL(res); err != nil {
 				multiErr = multierror.Append(multiErr, err)
 			}
 
@@ -162,7 +190,11 @@ func clusterValidationOptions(st state.State, etcdBackupConfig config.EtcdBackup
 				multiErr = multierror.Append(multiErr, errors.New("updating disk encryption settings is not allowed"))
 			}
 
-			if err := validateBackupInterval(newRes); err != nil {
+			if err := validateBackupInterval(ctx, newRes); err != nil {
+				multiErr = multierror.Append(multiErr, err)
+			}
+
+			if err := validateTTL(newRes); err != nil {
 				multiErr = multierror.Append(multiErr, err)
 			}
 
@@ -205,6 +237,9 @@ func relationLabelsValidationOptions() []validated.StateOption {
 			validated.NewCreateValidationForType(func(_ context.Context, res *omni.ExposedService, _ ...state.CreateOption) error {
 				return validateLabelIsSet(res, omni.LabelCluster)
 			}),
+			validated.NewCreateValidationForType(func(_ context.Context, res *omni.KernelArgsConfiguration, _ ...state.CreateOption) error {
+				return validateLabelIsSet(res, omni.LabelCluster)
+			}),
 		),
 		validated.WithUpdateValidations(
 			validated.NewUpdateValidationForType(func(_ context.Context, _ *omni.MachineSetNode, newRes *omni.MachineSetNode, _ ...state.UpdateOption) error {
@@ -216,6 +251,9 @@ func relationLabelsValidationOptions() []validated.StateOption {
 			validated.NewUpdateValidationForType(func(_ context.Context, _ *omni.ExposedService, newRes *omni.ExposedService, _ ...state.UpdateOption) error {
 				return validateLabelIsSet(newRes, omni.LabelCluster)
 			}),
+			validated.NewUpdateValidationForType(func(_ context.Context, _ *omni.KernelArgsConfiguration, newRes *omni.KernelArgsConfiguration, _ ...state.UpdateOption) error {
+				return validateLabelIsSet(newRes, omni.LabelCluster)
+			}),
 		),
 	}
 }
@@ -301,6 +339,27 @@ func machineSetValidationOptions(st state.State, etcdBackupStoreFactory store.Fa
 		}
 
 		spec := res.TypedSpec().Value
+
+		if err := omni.ValidateInstallDiskPolicy(spec.InstallDiskPolicy); err != nil {
+			return err
+		}
+
+		if err := omni.ValidateUserVolumes(spec.UserVolumes); err != nil {
+			return err
+		}
+
+		if err := omni.ValidateReadinessGates(spec.ReadinessGates); err != nil {
+			return err
+		}
+
+		if spec.Autoscaling != nil && isControlPlane {
+			return errors.New("autoscaling is not supported for the control plane machine set")
+		}
+
+		if err := omni.ValidateAutoscaling(spec); err != nil {
+			return err
+		}
+
 		if spec.MachineClass != nil {
 			if spec.MachineClass.Name == "" {
 				return errors.New("machine set class name is not set")
@@ -427,7 +486,7 @@ func validateBootstrapSpec(ctx context.Context, st state.State, etcdBackupStoreF
 		return fmt.Errorf("error getting backup data: %w", err)
 	}
 
-	backupStore, err := etcdBackupStoreFactory.GetStore()
+	backupStore, err := store.GetClusterStore(etcdBackupStoreFactory, cluster)
 	if err != nil {
 		return fmt.Errorf("error getting etcd backup store: %w", err)
 	}
@@ -638,7 +697,7 @@ func identityValidationOptions(samlConfig config.SAMLParams) []validated.StateOp
 
 			changed := newRes.TypedSpec().Value.UserId != res.TypedSpec().Value.UserId ||
 				!newRes.Metadata().Labels().Equal(*res.Metadata().Labels()) ||
-				!newRes.Metadata().Annotations().Equal(*res.Metadata().Annotations())
+				!withoutAuthorship(*newRes.Metadata().Annotations()).Equal(withoutAuthorship(*res.Metadata().Annotations()))
 
 			if changed {
 				return errors.New("updating identity is not allowed in SAML mode")
@@ -672,6 +731,53 @@ func exposedServiceValidationOptions() []validated.StateOption {
 	}
 }
 
+// exposedServiceAccessPolicyValidationOptions returns the validation options for the exposed service access policy resource.
+func exposedServiceAccessPolicyValidationOptions() []validated.StateOption {
+	validate := func(res *omni.ExposedServiceAccessPolicy) error {
+		var multiErr error
+
+		clusterName, _, ok := strings.Cut(res.Metadata().ID(), "/")
+		if !ok {
+			multiErr = multierror.Append(multiErr, errors.New("ID must be the ID of the exposed service: <cluster>/<service>.<namespace>"))
+		}
+
+		if cluster, _ := res.Metadata().Labels().Get(omni.LabelCluster); ok && cluster != clusterName {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("label %q must be set to %q", omni.LabelCluster, clusterName))
+		}
+
+		spec := res.TypedSpec().Value
+
+		for _, pattern := range spec.GetIdentities() {
+			if _, err := filepath.Match(pattern, ""); err != nil {
+				multiErr = multierror.Append(multiErr, fmt.Errorf("invalid identity pattern %q: %w", pattern, err))
+			}
+		}
+
+		if spec.GetMinRole() != "" {
+			if _, err := role.Parse(spec.GetMinRole()); err != nil {
+				multiErr = multierror.Append(multiErr, fmt.Errorf("invalid min role: %w", err))
+			}
+		}
+
+		for _, cidr := range spec.GetAllowedCidrs() {
+			if _, err := netip.ParsePrefix(cidr); err != nil {
+				multiErr = multierror.Append(multiErr, fmt.Errorf("invalid allowed CIDR %q: %w", cidr, err))
+			}
+		}
+
+		return multiErr
+	}
+
+	return []validated.StateOption{
+		validated.WithCreateValidations(validated.NewCreateValidationForType(func(_ context.Context, res *omni.ExposedServiceAccessPolicy, _ ...state.CreateOption) error {
+			return validate(res)
+		})),
+		validated.WithUpdateValidations(validated.NewUpdateValidationForType(func(_ context.Context, _ *omni.ExposedServiceAccessPolicy, newRes *omni.ExposedServiceAccessPolicy, _ ...state.UpdateOption) error {
+			return validate(newRes)
+		})),
+	}
+}
+
 func configPatchValidationOptions(st state.State) []validated.StateOption {
 	return []validated.StateOption{
 		validated.WithCreateValidations(validated.NewCreateValidationForType(func(ctx context.Context, res *omni.ConfigPatch, _ ...state.CreateOption) error {
@@ -807,6 +913,17 @@ func validateS3Configuration(ctx context.Context, s3Conf *omni.EtcdBackupS3Conf)
 	return nil
 }
 
+func etcdBackupStorageConfigValidationOptions() []validated.StateOption {
+	return []validated.StateOption{
+		validated.WithCreateValidations(validated.NewCreateValidationForType(func(_ context.Context, res *omni.EtcdBackupStorageConfig, _ ...state.CreateOption) error {
+			return store.ValidateStorageConfig(res.TypedSpec().Value)
+		})),
+		validated.WithUpdateValidations(validated.NewUpdateValidationForType(func(_ context.Context, _ *omni.EtcdBackupStorageConfig, newRes *omni.EtcdBackupStorageConfig, _ ...state.UpdateOption) error {
+			return store.ValidateStorageConfig(newRes.TypedSpec().Value)
+		})),
+	}
+}
+
 func validateSchematicConfiguration(schematicConfiguration *omni.SchematicConfiguration) error {
 	var targetValid bool
 
@@ -833,3 +950,183 @@ func validateSchematicConfiguration(schematicConfiguration *omni.SchematicConfig
 
 	return nil
 }
+
+func logLevelConfigValidationOptions() []validated.StateOption {
+	validate := func(res *omni.LogLevelConfig) error {
+		if res.Metadata().ID() != omni.LogLevelConfigID {
+			return fmt.Errorf("log level config ID must be %q", omni.LogLevelConfigID)
+		}
+
+		_, err := logging.ParseLevels(res.TypedSpec().Value.GetLevels())
+
+		return err
+	}
+
+	return []validated.StateOption{
+		validated.WithCreateValidations(validated.NewCreateValidationForType(func(_ context.Context, res *omni.LogLevelConfig, _ ...state.CreateOption) error {
+			return validate(res)
+		})),
+		validated.WithUpdateValidations(validated.NewUpdateValidationForType(func(_ context.Context, _ *omni.LogLevelConfig, newRes *omni.LogLevelConfig, _ ...state.UpdateOption) error {
+			return validate(newRes)
+		})),
+	}
+}
+
+func runtimeConfigurationValidationOptions() []validated.StateOption {
+	validate := func(res *omni.RuntimeConfiguration) error {
+		if res.Metadata().ID() != omni.RuntimeConfigurationID {
+			return fmt.Errorf("runtime configuration ID must be %q", omni.RuntimeConfigurationID)
+		}
+
+		spec := res.TypedSpec().Value
+
+		for _, field := range []struct {
+			value *durationpb.Duration
+			name  string
+		}{
+			{name: "machine teardown timeout", value: spec.GetMachineTeardownTimeout()},
+			{name: "etcd member remove timeout", value: spec.GetEtcdMemberRemoveTimeout()},
+			{name: "kubernetes node delete timeout", value: spec.GetKubernetesNodeDeleteTimeout()},
+			{name: "machine set status poll interval", value: spec.GetMachineSetStatusPollInterval()},
+			{name: "upgrade queue poll interval", value: spec.GetUpgradeQueuePollInterval()},
+		} {
+			if field.value != nil && field.value.AsDuration() <= 0 {
+				return fmt.Errorf("%s must be positive", field.name)
+			}
+		}
+
+		return nil
+	}
+
+	return []validated.StateOption{
+		validated.WithCreateValidations(validated.NewCreateValidationForType(func(_ context.Context, res *omni.RuntimeConfiguration, _ ...state.CreateOption) error {
+			return validate(res)
+		})),
+		validated.WithUpdateValidations(validated.NewUpdateValidationForType(func(_ context.Context, _ *omni.RuntimeConfiguration, newRes *omni.RuntimeConfiguration, _ ...state.UpdateOption) error {
+			return validate(newRes)
+		})),
+	}
+}
+
+func settingsValidationOptions() []validated.StateOption {
+	validate := func(res *omni.Settings) error {
+		if res.Metadata().ID() != omni.SettingsID {
+			return fmt.Errorf("settings ID must be %q", omni.SettingsID)
+		}
+
+		spec := res.TypedSpec().Value
+
+		for _, field := range []struct {
+			value string
+			name  string
+		}{
+			{name: "default talos version", value: spec.GetDefaultTalosVersion()},
+			{name: "default kubernetes version", value: spec.GetDefaultKubernetesVersion()},
+		} {
+			if field.value == "" {
+				continue
+			}
+
+			if _, err := semver.ParseTolerant(field.value); err != nil {
+				return fmt.Errorf("invalid %s %q: %w", field.name, field.value, err)
+			}
+		}
+
+		minInterval, maxInterval := spec.GetEtcdBackupMinInterval(), spec.GetEtcdBackupMaxInterval()
+
+		if minInterval != nil && minInterval.AsDuration() <= 0 {
+			return errors.New("etcd backup min interval must be positive")
+		}
+
+		if maxInterval != nil && maxInterval.AsDuration() <= 0 {
+			return errors.New("etcd backup max interval must be positive")
+		}
+
+		if minInterval != nil && maxInterval != nil && minInterval.AsDuration() > maxInterval.AsDuration() {
+			return errors.New("etcd backup min interval must not be greater than the max interval")
+		}
+
+		for roleName, lifetime := range spec.GetPublicKeyMaxLifetimes() {
+			if _, err := role.Parse(roleName); err != nil {
+				return fmt.Errorf("invalid public key max lifetime role: %w", err)
+			}
+
+			if lifetime.AsDuration() <= 0 {
+				return fmt.Errorf("public key max lifetime of the role %q must be positive", roleName)
+			}
+		}
+
+		return nil
+	}
+
+	return []validated.StateOption{
+		validated.WithCreateValidations(validated.NewCreateValidationForType(func(_ context.Context, res *omni.Settings, _ ...state.CreateOption) error {
+			return validate(res)
+		})),
+		validated.WithUpdateValidations(validated.NewUpdateValidationForType(func(_ context.Context, _ *omni.Settings, newRes *omni.Settings, _ ...state.UpdateOption) error {
+			return validate(newRes)
+		})),
+	}
+}
+
+func defaultExtensionsValidationOptions() []validated.StateOption {
+	validate := func(res *omni.DefaultExtensions) error {
+		if res.Metadata().ID() != omni.DefaultExtensionsID {
+			return fmt.Errorf("default extensions ID must be %q", omni.DefaultExtensionsID)
+		}
+
+		for _, extension := range res.TypedSpec().Value.Extensions {
+			if strings.TrimSpace(extension) == "" {
+				return errors.New("extension name can not be empty")
+			}
+		}
+
+		return nil
+	}
+
+	return []validated.StateOption{
+		validated.WithCreateValidations(validated.NewCreateValidationForType(func(_ context.Context, res *omni.DefaultExtensions, _ ...state.CreateOption) error {
+			return validate(res)
+		})),
+		validated.WithUpdateValidations(validated.NewUpdateValidationForType(func(_ context.Context, _ *omni.DefaultExtensions, newRes *omni.DefaultExtensions, _ ...state.UpdateOption) error {
+			return validate(newRes)
+		})),
+	}
+}
+
+func kernelArgsConfigurationValidationOptions() []validated.StateOption {
+	validate := func(res *omni.KernelArgsConfiguration) error {
+		return omni.ValidateKernelArgs(res.TypedSpec().Value.Add, res.TypedSpec().Value.Remove)
+	}
+
+	return []validated.StateOption{
+		validated.WithCreateValidations(validated.NewCreateValidationForType(func(_ context.Context, res *omni.KernelArgsConfiguration, _ ...state.CreateOption) error {
+			return validate(res)
+		})),
+		validated.WithUpdateValidations(validated.NewUpdateValidationForType(func(_ context.Context, _ *omni.KernelArgsConfiguration, newRes *omni.KernelArgsConfiguration, _ ...state.UpdateOption) error {
+			return validate(newRes)
+		})),
+	}
+}
+
+func maintenanceWindowValidationOptions() []validated.StateOption {
+	return []validated.StateOption{
+		validated.WithCreateValidations(validated.NewCreateValidationForType(func(_ context.Context, res *omni.MaintenanceWindow, _ ...state.CreateOption) error {
+			return omni.ValidateMaintenanceWindow(res.TypedSpec().Value)
+		})),
+		validated.WithUpdateValidations(validated.NewUpdateValidationForType(func(_ context.Context, _ *omni.MaintenanceWindow, newRes *omni.MaintenanceWindow, _ ...state.UpdateOption) error {
+			return omni.ValidateMaintenanceWindow(newRes.TypedSpec().Value)
+		})),
+	}
+}
+
+func notificationConfigValidationOptions() []validated.StateOption {
+	return []validated.StateOption{
+		validated.WithCreateValidations(validated.NewCreateValidationForType(func(_ context.Context, res *omni.NotificationConfig, _ ...state.CreateOption) error {
+			return notification.ValidateConfig(res.TypedSpec().Value)
+		})),
+		validated.WithUpdateValidations(validated.NewUpdateValidationForType(func(_ context.Context, _ *omni.NotificationConfig, newRes *omni.NotificationConfig, _ ...state.UpdateOption) error {
+			return notification.ValidateConfig(newRes.TypedSpec().Value)
+		})),
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/siderolabs/omni/client/api/omni/specs"
@@ -351,6 +352,50 @@ func TestMachineSetValidation(t *testing.T) {
 	assert.True(t, validated.IsValidationError(err), "expected validation error")
 	assert.ErrorContains(t, err, "worker machine set must not have ID")
 
+	// invalid install disk policy
+
+	machineSet5 := omnires.NewMachineSet(resources.DefaultNamespace, "test-cluster-workers")
+
+	machineSet5.Metadata().Labels().Set(omnires.LabelCluster, "test-cluster")
+	machineSet5.Metadata().Labels().Set(omnires.LabelWorkerRole, "")
+
+	machineSet5.TypedSpec().Value.InstallDiskPolicy = &specs.MachineSetSpec_InstallDiskPolicy{
+		ModelRegex: "(",
+	}
+
+	err = st.Create(ctx, machineSet5)
+	assert.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, "invalid install disk policy model regex")
+
+	machineSet5.TypedSpec().Value.InstallDiskPolicy.ModelRegex = "^Samsung"
+
+	// invalid user volumes
+
+	machineSet5.TypedSpec().Value.UserVolumes = []*specs.MachineSetSpec_UserVolume{
+		{Disk: "/dev/sdb", MountPoint: "/var/mnt/data", Size: 100e9},
+		{Disk: "/dev/sdb", MountPoint: "/var/mnt/data"},
+	}
+
+	err = st.Create(ctx, machineSet5)
+	assert.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, `user volume mount point "/var/mnt/data" is used more than once`)
+
+	machineSet5.TypedSpec().Value.UserVolumes[1].MountPoint = "/var/mnt/logs"
+
+	// invalid readiness gates
+
+	machineSet5.TypedSpec().Value.ReadinessGates = &specs.MachineSetSpec_ReadinessGates{
+		Extensions: []string{"siderolabs/gvisor", "siderolabs/gvisor"},
+	}
+
+	err = st.Create(ctx, machineSet5)
+	assert.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, `readiness gate extension "siderolabs/gvisor" is listed more than once`)
+
+	machineSet5.TypedSpec().Value.ReadinessGates.Extensions = []string{"siderolabs/gvisor"}
+
+	require.NoError(t, st.Create(ctx, machineSet5))
+
 	// no cluster exists
 
 	machineSet4 := omnires.NewMachineSet(resources.DefaultNamespace, "test-cluster-tearing-down-control-planes")
@@ -886,6 +931,52 @@ func TestS3ConfigValidation(t *testing.T) {
 	require.NoError(t, st.Update(ctx, res))
 }
 
+func TestEtcdBackupStorageConfigValidation(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	t.Cleanup(cancel)
+
+	innerSt := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	st := validated.NewState(innerSt, omni.EtcdBackupStorageConfigValidationOptions()...)
+
+	res := omnires.NewEtcdBackupStorageConfig("cluster1")
+
+	// no storage
+	require.True(t, validated.IsValidationError(st.Create(ctx, res)), "expected validation error")
+
+	// several storages
+	res.TypedSpec().Value = &specs.EtcdBackupStorageConfigSpec{
+		S3: &specs.EtcdBackupS3ConfSpec{
+			Bucket: "backups",
+		},
+		Gcs: &specs.EtcdBackupStorageConfigSpec_GCS{
+			Bucket:          "backups",
+			AccessKeyId:     "access",
+			SecretAccessKey: "secret",
+		},
+	}
+
+	require.True(t, validated.IsValidationError(st.Create(ctx, res)), "expected validation error")
+
+	res.TypedSpec().Value.S3 = nil
+
+	require.NoError(t, st.Create(ctx, res))
+
+	res.TypedSpec().Value = &specs.EtcdBackupStorageConfigSpec{
+		Azure: &specs.EtcdBackupStorageConfigSpec_Azure{
+			ContainerUrl: "https://account.blob.core.windows.net/backups",
+		},
+	}
+
+	require.True(t, validated.IsValidationError(st.Update(ctx, res)), "expected validation error")
+
+	res.TypedSpec().Value.Azure.SasToken = "sv=2021-08-06&sig=secret"
+
+	require.NoError(t, st.Update(ctx, res))
+}
+
 func TestSchematicConfigurationValidation(t *testing.T) {
 	t.Parallel()
 
@@ -917,6 +1008,69 @@ func TestSchematicConfigurationValidation(t *testing.T) {
 	require.NoError(t, st.Update(ctx, res))
 }
 
+func TestSettingsValidation(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	t.Cleanup(cancel)
+
+	etcdBackupConfig := config.EtcdBackupParams{
+		MinInterval: time.Hour,
+		MaxInterval: 24 * time.Hour,
+	}
+
+	innerSt := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	st := validated.NewState(innerSt, append(
+		omni.SettingsValidationOptions(),
+		omni.ClusterValidationOptions(innerSt, etcdBackupConfig, config.EmbeddedDiscoveryServiceParams{})...,
+	)...)
+
+	talosVersion := omnires.NewTalosVersion(resources.DefaultNamespace, "1.7.0")
+	talosVersion.TypedSpec().Value.CompatibleKubernetesVersions = []string{"1.30.0"}
+
+	require.NoError(t, st.Create(ctx, talosVersion))
+
+	cluster := omnires.NewCluster(resources.DefaultNamespace, "test")
+	cluster.TypedSpec().Value.TalosVersion = "1.7.0"
+	cluster.TypedSpec().Value.KubernetesVersion = "1.30.0"
+	cluster.TypedSpec().Value.BackupConfiguration = &specs.EtcdBackupConf{
+		Interval: durationpb.New(10 * time.Minute),
+		Enabled:  true,
+	}
+
+	// the interval is shorter than the configured minimum
+	require.True(t, validated.IsValidationError(st.Create(ctx, cluster)), "expected validation error")
+
+	settings := omnires.NewSettings()
+	settings.TypedSpec().Value.DefaultTalosVersion = "invalid"
+
+	require.True(t, validated.IsValidationError(st.Create(ctx, settings)), "expected validation error")
+
+	settings.TypedSpec().Value.DefaultTalosVersion = "1.7.0"
+	settings.TypedSpec().Value.EtcdBackupMinInterval = durationpb.New(time.Hour)
+	settings.TypedSpec().Value.EtcdBackupMaxInterval = durationpb.New(time.Minute)
+
+	require.True(t, validated.IsValidationError(st.Create(ctx, settings)), "expected validation error")
+
+	settings.TypedSpec().Value.EtcdBackupMinInterval = durationpb.New(5 * time.Minute)
+	settings.TypedSpec().Value.EtcdBackupMaxInterval = nil
+	settings.TypedSpec().Value.PublicKeyMaxLifetimes = map[string]*durationpb.Duration{
+		"Superuser": durationpb.New(time.Hour),
+	}
+
+	require.True(t, validated.IsValidationError(st.Create(ctx, settings)), "expected validation error")
+
+	settings.TypedSpec().Value.PublicKeyMaxLifetimes = map[string]*durationpb.Duration{
+		string(role.Admin): durationpb.New(time.Hour),
+	}
+
+	require.NoError(t, st.Create(ctx, settings))
+
+	// the minimum is overridden by the settings
+	require.NoError(t, st.Create(ctx, cluster))
+}
+
 type mockEtcdBackupStoreFactory struct {
 	store etcdbackup.Store
 }
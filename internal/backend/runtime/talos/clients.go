@@ -29,6 +29,7 @@ import (
 	"github.com/siderolabs/omni/client/pkg/constants"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/dryrun"
 )
 
 // ClientNotReadyError is returned when building the client fails because cluster endpoints list is empty
@@ -285,6 +286,8 @@ func (factory *ClientFactory) build(ctx context.Context, clusterName string) (*C
 		return nil, err
 	}
 
+	options = append(options, dryrun.TalosClientOptions()...)
+
 	c, err := client.New(ctx, options...)
 	if err != nil {
 		return nil, err
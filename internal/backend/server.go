@@ -7,8 +7,11 @@
 package backend
 
 import (
+	"bytes"
 	"compress/gzip"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -30,7 +33,6 @@ import (
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
 	protobufserver "github.com/cosi-project/runtime/pkg/state/protobuf/server"
-	"github.com/crewjam/saml/samlsp"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
 	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
@@ -49,36 +51,45 @@ import (
 	"golang.org/x/net/http2/h2c"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/status"
 
+	"github.com/siderolabs/omni/client/api/omni/management"
 	resapi "github.com/siderolabs/omni/client/api/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/constants"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
 	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	resourceregistry "github.com/siderolabs/omni/client/pkg/omni/resources/registry"
 	"github.com/siderolabs/omni/client/pkg/panichandler"
+	"github.com/siderolabs/omni/internal/backend/apiversion"
+	"github.com/siderolabs/omni/internal/backend/authprovider"
+	"github.com/siderolabs/omni/internal/backend/autoscaler"
 	"github.com/siderolabs/omni/internal/backend/debug"
+	"github.com/siderolabs/omni/internal/backend/diagnostics"
 	"github.com/siderolabs/omni/internal/backend/dns"
 	"github.com/siderolabs/omni/internal/backend/factory"
+	"github.com/siderolabs/omni/internal/backend/gitops"
 	grpcomni "github.com/siderolabs/omni/internal/backend/grpc"
 	"github.com/siderolabs/omni/internal/backend/grpc/router"
 	"github.com/siderolabs/omni/internal/backend/health"
 	"github.com/siderolabs/omni/internal/backend/imagefactory"
 	"github.com/siderolabs/omni/internal/backend/k8sproxy"
+	"github.com/siderolabs/omni/internal/backend/keyusage"
 	"github.com/siderolabs/omni/internal/backend/logging"
 	"github.com/siderolabs/omni/internal/backend/monitoring"
 	"github.com/siderolabs/omni/internal/backend/oidc"
+	"github.com/siderolabs/omni/internal/backend/payloadsampler"
+	"github.com/siderolabs/omni/internal/backend/ratelimit"
 	"github.com/siderolabs/omni/internal/backend/runtime"
 	"github.com/siderolabs/omni/internal/backend/runtime/kubernetes"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni"
 	"github.com/siderolabs/omni/internal/backend/runtime/talos"
-	"github.com/siderolabs/omni/internal/backend/saml"
 	"github.com/siderolabs/omni/internal/backend/workloadproxy"
 	"github.com/siderolabs/omni/internal/frontend"
 	"github.com/siderolabs/omni/internal/memconn"
 	"github.com/siderolabs/omni/internal/pkg/auth"
 	"github.com/siderolabs/omni/internal/pkg/auth/actor"
-	"github.com/siderolabs/omni/internal/pkg/auth/auth0"
 	"github.com/siderolabs/omni/internal/pkg/auth/handler"
 	"github.com/siderolabs/omni/internal/pkg/auth/interceptor"
 	"github.com/siderolabs/omni/internal/pkg/auth/role"
@@ -101,8 +112,10 @@ type Server struct {
 	dnsService              *dns.Service
 	workloadProxyReconciler *workloadproxy.Reconciler
 	imageFactoryClient      *imagefactory.Client
+	keyUsageRecorder        *keyusage.Recorder
 
 	linkCounterDeltaCh chan<- siderolink.LinkCounterDeltas
+	linkSampleCh       chan<- siderolink.LinkSamples
 	siderolinkEventsCh chan<- *omnires.MachineStatusSnapshot
 
 	proxyServer         Proxy
@@ -121,6 +134,7 @@ func NewServer(
 	workloadProxyReconciler *workloadproxy.Reconciler,
 	imageFactoryClient *imagefactory.Client,
 	linkCounterDeltaCh chan<- siderolink.LinkCounterDeltas,
+	linkSampleCh chan<- siderolink.LinkSamples,
 	siderolinkEventsCh chan<- *omnires.MachineStatusSnapshot,
 	omniRuntime *omni.Runtime,
 	talosRuntime *talos.Runtime,
@@ -138,7 +152,9 @@ func NewServer(
 		dnsService:              dnsService,
 		workloadProxyReconciler: workloadProxyReconciler,
 		imageFactoryClient:      imageFactoryClient,
+		keyUsageRecorder:        keyusage.NewRecorder(omniRuntime.State(), logger.With(logging.Component("key_usage"))),
 		linkCounterDeltaCh:      linkCounterDeltaCh,
+		linkSampleCh:            linkSampleCh,
 		siderolinkEventsCh:      siderolinkEventsCh,
 		proxyServer:             proxyServer,
 		bindAddress:             bindAddress,
@@ -172,8 +188,18 @@ func (s *Server) RegisterRuntime(name string, r runtime.Runtime) {
 func (s *Server) Run(ctx context.Context) error {
 	eg, ctx := errgroup.WithContext(ctx)
 
+	if config.Config.ControllerGraphExportPath != "" {
+		if err := diagnostics.ExportGraph(config.Config.ControllerGraphExportPath, s.omniRuntime.GetCOSIRuntime()); err != nil {
+			return fmt.Errorf("failed to export controller graph: %w", err)
+		}
+
+		s.logger.Info("exported controller graph", zap.String("path", config.Config.ControllerGraphExportPath))
+	}
+
 	s.omniRuntime.Run(ctx, eg)
 
+	eg.Go(func() error { return s.keyUsageRecorder.Run(ctx) })
+
 	runtimeState := s.omniRuntime.State()
 	oidcStorage := oidc.NewStorage(runtimeState, s.logger)
 
@@ -197,26 +223,63 @@ func (s *Server) Run(ctx context.Context) error {
 		s.logger,
 	)
 
-	var samlHandler *samlsp.Middleware
+	authProvider, err := authprovider.New(ctx, s.authConfig, config.Config.Auth, runtimeState, s.logger)
+	if err != nil {
+		return fmt.Errorf("failed to create auth provider: %w", err)
+	}
+
+	s.logger.Info("using auth provider", zap.String("provider", authProvider.Name()))
 
-	if s.authConfig.TypedSpec().Value.Saml.Enabled {
-		samlHandler, err = saml.NewHandler(s.omniRuntime.State(), s.authConfig.TypedSpec().Value.Saml, s.logger) //nolint:contextcheck
+	var gitopsSyncer *gitops.Syncer
+
+	if config.Config.GitOps.Enabled {
+		gitopsSyncer, err = gitops.NewSyncer(runtimeState, config.Config.GitOps, s.logger.With(logging.Component("gitops")))
 		if err != nil {
-			return err
+			return fmt.Errorf("failed to create cluster templates syncer: %w", err)
 		}
 	}
 
-	mux, err := makeMux(imageFactoryHandler, oidcProvider, samlHandler, s.omniRuntime, s.logger)
+	var diagnosticsHandler http.Handler
+
+	if config.Config.EnableDiagnostics {
+		diagnosticsHandler = handler.NewAuthConfig(
+			handler.NewSignature(
+				diagnostics.NewHandler(s.omniRuntime.GetCOSIRuntime(), runtimeState, s.logger.With(logging.Component("diagnostics"))),
+				s.authenticatorFunc(),
+				s.logger,
+			),
+			authres.Enabled(s.authConfig),
+			s.logger,
+		)
+	}
+
+	mux, err := makeMux(imageFactoryHandler, oidcProvider, diagnosticsHandler, authProvider, gitopsSyncer, s.omniRuntime, s.logger)
 	if err != nil {
 		return fmt.Errorf("failed to create mux: %w", err)
 	}
 
-	serverOptions, err := s.buildServerOptions() //nolint:contextcheck
+	payloadSampler, err := payloadsampler.New(config.Config.PayloadSampling, sensitiveRequestHook(), isSensitiveResourceType())
+	if err != nil {
+		return fmt.Errorf("failed to create payload sampler: %w", err)
+	}
+
+	rateLimiter, err := ratelimit.New(config.Config.RateLimit)
+	if err != nil {
+		return fmt.Errorf("failed to create rate limiter: %w", err)
+	}
+
+	if reloader := config.DefaultReloader(); reloader != nil {
+		reloader.Register("rateLimit", func(next *config.Params) error {
+			return rateLimiter.Update(next.RateLimit)
+		})
+	}
+
+	serverOptions, err := s.buildServerOptions(authProvider, rateLimiter, payloadSampler)
 	if err != nil {
 		return err
 	}
 
-	serviceServers, err := grpcomni.MakeServiceServers(runtimeState, s.logHandler, oidcProvider, oidcStorage, s.dnsService, s.imageFactoryClient, s.logger)
+	serviceServers, err := grpcomni.MakeServiceServers(runtimeState, s.omniRuntime.DryRunState(), s.logHandler, oidcProvider, oidcStorage, s.dnsService, s.imageFactoryClient, payloadSampler, s.logger)
 	if err != nil {
 		return err
 	}
@@ -258,9 +321,24 @@ func (s *Server) Run(ctx context.Context) error {
 
 	unifiedHandler := unifyHandler(workloadProxyHandler, grpcProxyServer, crtData)
 
+	apiCrtData := crtData
+
+	var customDomainCerts *workloadproxy.CertManager
+
+	if config.Config.WorkloadProxying.CustomDomains.Enabled {
+		if value.IsZero(crtData) {
+			s.logger.Warn("workload proxy custom domains require TLS to be enabled on the API server, the certificates are not issued")
+		} else {
+			customDomainCerts = workloadproxy.NewCertManager(config.Config.WorkloadProxying.CustomDomains, s.workloadProxyReconciler,
+				s.logger.With(logging.Component("workload_proxy_cert_manager")))
+
+			apiCrtData.customDomainCerts = customDomainCerts
+		}
+	}
+
 	fns := []func() error{
 		func() error { return runGRPCServer(ctx, grpcProxyServer, gatewayTransport, s.logger) },
-		func() error { return runAPIServer(ctx, unifiedHandler, s.bindAddress, crtData, s.logger) },
+		func() error { return runAPIServer(ctx, unifiedHandler, s.bindAddress, apiCrtData, s.logger) },
 		func() error { return runGRPCServer(ctx, grpcServer, grpcTransport, s.logger) },
 		func() error { return runMetricsServer(ctx, s.metricsBindAddress, s.logger) },
 		func() error {
@@ -275,6 +353,10 @@ func (s *Server) Run(ctx context.Context) error {
 		fns = append(fns, func() error { return runPprofServer(ctx, s.pprofBindAddress, s.logger) })
 	}
 
+	if bindAddress := config.Config.WorkloadProxying.CustomDomains.HTTPChallengeBindAddress; customDomainCerts != nil && bindAddress != "" {
+		fns = append(fns, func() error { return runACMEChallengeServer(ctx, bindAddress, customDomainCerts, s.logger) })
+	}
+
 	for _, fn := range fns {
 		eg.Go(fn)
 	}
@@ -283,6 +365,16 @@ func (s *Server) Run(ctx context.Context) error {
 		return fmt.Errorf("failed to run local resource server: %w", err)
 	}
 
+	if config.Config.Autoscaler.BindAddress != "" {
+		if err = runAutoscalerServer(ctx, runtimeState, eg, s.logger); err != nil {
+			return fmt.Errorf("failed to run cluster-autoscaler server: %w", err)
+		}
+	}
+
+	if gitopsSyncer != nil {
+		eg.Go(func() error { return gitopsSyncer.Run(ctx) })
+	}
+
 	if config.Config.EmbeddedDiscoveryService.Enabled {
 		eg.Go(func() error {
 			if err = runEmbeddedDiscoveryService(ctx, s.logger); err != nil {
@@ -303,63 +395,61 @@ func (s *Server) Run(ctx context.Context) error {
 // Logging is installed as the first middleware (even before recovery middleware) in the chain
 // so that request in the form it was received and status sent on the wire is logged (error/success).
 // It also tracks the whole duration of the request, including other middleware overhead.
-func (s *Server) buildServerOptions() ([]grpc.ServerOption, error) {
+func (s *Server) buildServerOptions(authProvider authprovider.Provider, rateLimiter *ratelimit.Limiter, payloadSampler *payloadsampler.Sampler) ([]grpc.ServerOption, error) {
+	grpcLogger := s.logger.With(logging.Component("grpc"))
 	recoveryOpt := grpc_recovery.WithRecoveryHandler(recoveryHandler(s.logger))
 	messageProducer := grpcutil.LogLevelOverridingMessageProducer(grpc_zap.DefaultMessageProducer)
 	logLevelOverrideUnaryInterceptor, logLevelOverrideStreamInterceptor := grpcutil.LogLevelInterceptors()
+	apiVersionUnaryInterceptor, apiVersionStreamInterceptor := apiversion.Interceptors()
 
 	grpc_prometheus.EnableHandlingTimeHistogram(grpc_prometheus.WithHistogramBuckets([]float64{0.001, 0.01, 0.1, 1, 10, 30, 60, 120, 300, 600}))
 
 	unaryInterceptors := []grpc.UnaryServerInterceptor{
 		grpc_ctxtags.UnaryServerInterceptor(),
 		logLevelOverrideUnaryInterceptor,
-		grpc_zap.UnaryServerInterceptor(s.logger, grpc_zap.WithMessageProducer(messageProducer)),
+		grpc_zap.UnaryServerInterceptor(grpcLogger, grpc_zap.WithMessageProducer(messageProducer)),
 		grpcutil.SetUserAgent(),
 		grpcutil.SetRealPeerAddress(),
-		grpcutil.InterceptBodyToTags(
-			grpcutil.NewHook(
-				grpcutil.NewRewriter(resourceServerCreate),
-				grpcutil.NewRewriter(resourceServerUpdate),
-				grpcutil.NewRewriter(cosiResourceServerCreate),
-				grpcutil.NewRewriter(cosiResourceServerUpdate),
-			),
-			1024,
-		),
+		grpcutil.InterceptBodyToTags(sensitiveRequestHook(), 1024),
 		grpc_prometheus.UnaryServerInterceptor,
 		grpc_recovery.UnaryServerInterceptor(recoveryOpt),
+		apiVersionUnaryInterceptor,
 	}
 
 	streamInterceptors := []grpc.StreamServerInterceptor{
 		grpc_ctxtags.StreamServerInterceptor(),
 		logLevelOverrideStreamInterceptor,
-		grpc_zap.StreamServerInterceptor(s.logger, grpc_zap.WithMessageProducer(messageProducer)),
+		grpc_zap.StreamServerInterceptor(grpcLogger, grpc_zap.WithMessageProducer(messageProducer)),
 		grpcutil.StreamSetUserAgent(),
 		grpcutil.StreamSetRealPeerAddress(),
 		grpcutil.StreamIntercept(
 			grpcutil.StreamHooks{
-				RecvMsg: grpcutil.StreamInterceptRequestBodyToTags(
-					grpcutil.NewHook(
-						grpcutil.NewRewriter(resourceServerCreate),
-						grpcutil.NewRewriter(resourceServerUpdate),
-						grpcutil.NewRewriter(cosiResourceServerCreate),
-						grpcutil.NewRewriter(cosiResourceServerUpdate),
-					),
-					1024,
-				),
+				RecvMsg: grpcutil.StreamInterceptRequestBodyToTags(sensitiveRequestHook(), 1024),
 			},
 		),
 		grpc_prometheus.StreamServerInterceptor,
 		grpc_recovery.StreamServerInterceptor(recoveryOpt),
+		apiVersionStreamInterceptor,
 	}
 
-	unaryAuthInterceptors, streamAuthInterceptors, err := s.getAuthInterceptors()
-	if err != nil {
-		return nil, err
-	}
+	unaryAuthInterceptors, streamAuthInterceptors := s.getAuthInterceptors(authProvider)
 
 	unaryInterceptors = append(unaryInterceptors, unaryAuthInterceptors...)
 	streamInterceptors = append(streamInterceptors, streamAuthInterceptors...)
 
+	// the calls are limited after the authentication to apply the limits of the caller identity,
+	// the limits can be enabled by the config reload, so the interceptors are always added if the config file is used
+	if rateLimiter.Enabled() || config.DefaultReloader() != nil {
+		unaryInterceptors = append(unaryInterceptors, rateLimiter.Unary())
+		streamInterceptors = append(streamInterceptors, rateLimiter.Stream())
+	}
+
+	// the payloads are sampled after the authentication to record the identity of the caller
+	if payloadSampler.Enabled() {
+		unaryInterceptors = append(unaryInterceptors, payloadSampler.Unary())
+		streamInterceptors = append(streamInterceptors, payloadSampler.Stream())
+	}
+
 	return []grpc.ServerOption{
 		grpc.MaxRecvMsgSize(constants.GRPCMaxMessageSize),
 		grpc.ChainUnaryInterceptor(unaryInterceptors...),
@@ -368,7 +458,7 @@ func (s *Server) buildServerOptions() ([]grpc.ServerOption, error) {
 	}, nil
 }
 
-func (s *Server) getAuthInterceptors() ([]grpc.UnaryServerInterceptor, []grpc.StreamServerInterceptor, error) {
+func (s *Server) getAuthInterceptors(authProvider authprovider.Provider) ([]grpc.UnaryServerInterceptor, []grpc.StreamServerInterceptor) {
 	authEnabled := authres.Enabled(s.authConfig)
 
 	authConfigInterceptor := interceptor.NewAuthConfig(authEnabled, s.logger)
@@ -382,34 +472,21 @@ func (s *Server) getAuthInterceptors() ([]grpc.UnaryServerInterceptor, []grpc.St
 	}
 
 	if !authEnabled {
-		return unaryInterceptors, streamInterceptors, nil
+		return unaryInterceptors, streamInterceptors
 	}
 
-	// auth is enabled, add signature and jwt interceptors
+	// auth is enabled, add signature and the auth provider interceptors
 	signatureInterceptor := interceptor.NewSignature(s.authenticatorFunc(), s.logger)
 
 	unaryInterceptors = append(unaryInterceptors, signatureInterceptor.Unary())
 	streamInterceptors = append(streamInterceptors, signatureInterceptor.Stream())
 
-	switch {
-	case s.authConfig.TypedSpec().Value.Auth0.Enabled:
-		verifier, err := auth0.NewIDTokenVerifier(s.authConfig.TypedSpec().Value.GetAuth0().Domain)
-		if err != nil {
-			return nil, nil, err
-		}
-
-		jwtInterceptor := interceptor.NewJWT(verifier, s.logger)
-
-		unaryInterceptors = append(unaryInterceptors, jwtInterceptor.Unary())
-		streamInterceptors = append(streamInterceptors, jwtInterceptor.Stream())
-	case s.authConfig.TypedSpec().Value.Saml.Enabled:
-		samlInterceptor := interceptor.NewSAML(s.omniRuntime.State(), s.logger)
+	providerUnaryInterceptors, providerStreamInterceptors := authProvider.Interceptors()
 
-		unaryInterceptors = append(unaryInterceptors, samlInterceptor.Unary())
-		streamInterceptors = append(streamInterceptors, samlInterceptor.Stream())
-	}
+	unaryInterceptors = append(unaryInterceptors, providerUnaryInterceptors...)
+	streamInterceptors = append(streamInterceptors, providerStreamInterceptors...)
 
-	return unaryInterceptors, streamInterceptors, nil
+	return unaryInterceptors, streamInterceptors
 }
 
 func (s *Server) authenticatorFunc() auth.AuthenticatorFunc {
@@ -424,7 +501,7 @@ func (s *Server) authenticatorFunc() auth.AuthenticatorFunc {
 		}
 
 		if pubKey.TypedSpec().Value.Expiration.AsTime().Before(time.Now()) {
-			return nil, errors.New("public key expired")
+			return nil, auth.ErrPublicKeyExpired
 		}
 
 		if !pubKey.TypedSpec().Value.Confirmed {
@@ -460,11 +537,16 @@ func (s *Server) authenticatorFunc() auth.AuthenticatorFunc {
 			finalRole = role.Reader
 		}
 
+		s.keyUsageRecorder.Record(pubKey, time.Now())
+
+		cluster, _ := user.Metadata().Labels().Get(authres.LabelServiceAccountCluster)
+
 		return &auth.Authenticator{
 			UserID:   userID,
 			Identity: pubKey.TypedSpec().Value.GetIdentity().GetEmail(),
 			Role:     finalRole,
 			Verifier: verifier,
+			Cluster:  cluster,
 		}, nil
 	}
 }
@@ -495,6 +577,7 @@ func (s *Server) runMachineAPI(ctx context.Context) error {
 		s.logHandler,
 		machineStatusHandler,
 		s.linkCounterDeltaCh,
+		s.linkSampleCh,
 	)
 	if err != nil {
 		return err
@@ -555,7 +638,7 @@ func (s *Server) workloadProxyHandler(next http.Handler) (http.Handler, error) {
 		return nil, fmt.Errorf("failed to parse API URL: %w", err)
 	}
 
-	return workloadproxy.NewHTTPHandler(
+	handler, err := workloadproxy.NewHTTPHandler(
 		next,
 		s.workloadProxyReconciler,
 		pgpSignatureValidator,
@@ -563,6 +646,13 @@ func (s *Server) workloadProxyHandler(next http.Handler) (http.Handler, error) {
 		config.Config.WorkloadProxying.Subdomain,
 		s.logger.With(logging.Component("workload_proxy_handler")),
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	prometheus.MustRegister(handler)
+
+	return handler, nil
 }
 
 func recoveryHandler(logger *zap.Logger) grpc_recovery.RecoveryHandlerFunc {
@@ -575,6 +665,43 @@ func recoveryHandler(logger *zap.Logger) grpc_recovery.RecoveryHandlerFunc {
 	}
 }
 
+// sensitiveRequestHook redacts the specs of the sensitive resources in the create and update requests, and the break-glass factors.
+func sensitiveRequestHook() grpcutil.Hook {
+	return grpcutil.NewHook(
+		grpcutil.NewRewriter(resourceServerCreate),
+		grpcutil.NewRewriter(resourceServerUpdate),
+		grpcutil.NewRewriter(cosiResourceServerCreate),
+		grpcutil.NewRewriter(cosiResourceServerUpdate),
+		grpcutil.NewRewriter(breakGlassTokenCreate),
+	)
+}
+
+func breakGlassTokenCreate(req *management.CreateBreakGlassTokenRequest) (*management.CreateBreakGlassTokenRequest, bool) {
+	req.Secret = ""
+	req.TotpCode = ""
+
+	return req, true
+}
+
+// isSensitiveResourceType returns the function which checks if the resources of the type are sensitive.
+//
+// The types which are not registered are considered to be sensitive.
+func isSensitiveResourceType() func(resource.Type) bool {
+	nonSensitive := map[resource.Type]struct{}{}
+
+	for _, r := range resourceregistry.Resources {
+		if rd := r.ResourceDefinition(); rd.Sensitivity != meta.Sensitive {
+			nonSensitive[rd.Type] = struct{}{}
+		}
+	}
+
+	return func(resourceType resource.Type) bool {
+		_, ok := nonSensitive[resourceType]
+
+		return !ok
+	}
+}
+
 func cosiResourceServerCreate(req *v1alpha1.CreateRequest) (*v1alpha1.CreateRequest, bool) {
 	if isSensitiveResource(req.Resource) {
 		req.Resource.Spec = nil
@@ -650,7 +777,13 @@ func isSensitiveSpec(resource *resapi.Resource) bool {
 	return false
 }
 
-func makeMux(imageHandler, oidcHandler http.Handler, samlHandler *samlsp.Middleware, omniRuntime *omni.Runtime, logger *zap.Logger) (*http.ServeMux, error) {
+func makeMux(
+	imageHandler, oidcHandler, diagnosticsHandler http.Handler,
+	authProvider authprovider.Provider,
+	gitopsSyncer *gitops.Syncer,
+	omniRuntime *omni.Runtime,
+	logger *zap.Logger,
+) (*http.ServeMux, error) {
 	mux := http.NewServeMux()
 
 	muxHandle := func(route string, handler http.Handler, value string) {
@@ -669,9 +802,7 @@ func makeMux(imageHandler, oidcHandler http.Handler, samlHandler *samlsp.Middlew
 		"static",
 	)
 
-	if samlHandler != nil {
-		saml.RegisterHandlers(samlHandler, mux, logger)
-	}
+	authProvider.RegisterHandlers(mux, logger)
 
 	muxHandle("/image/", imageHandler, "image")
 
@@ -703,9 +834,23 @@ func makeMux(imageHandler, oidcHandler http.Handler, samlHandler *samlsp.Middlew
 		),
 	)
 
+	if diagnosticsHandler != nil {
+		muxHandle(diagnostics.Prefix, diagnosticsHandler, "diagnostics")
+	}
+
 	// Health checks
 	muxHandle("/healthz", health.NewHandler(omniRuntime.State(), logger), "health")
 
+	if gitopsSyncer != nil && config.Config.GitOps.WebhookSecretPath != "" {
+		var secret []byte
+
+		if secret, err = os.ReadFile(config.Config.GitOps.WebhookSecretPath); err != nil {
+			return nil, fmt.Errorf("failed to read the GitOps webhook secret: %w", err)
+		}
+
+		muxHandle("/gitops/webhook", gitops.NewWebhookHandler(gitopsSyncer, bytes.TrimSpace(secret), logger.With(logging.Component("gitops_webhook"))), "gitops-webhook")
+	}
+
 	return mux, nil
 }
 
@@ -743,6 +888,19 @@ func runMetricsServer(ctx context.Context, bindAddress string, logger *zap.Logge
 	}, logger)
 }
 
+func runACMEChallengeServer(ctx context.Context, bindAddress string, certManager *workloadproxy.CertManager, logger *zap.Logger) error {
+	srv := &http.Server{
+		Addr:    bindAddress,
+		Handler: certManager.HTTPHandler(),
+	}
+
+	logger = logger.With(zap.String("server", bindAddress), zap.String("server_type", "acme_challenge"))
+
+	return runServer(ctx, &server{
+		server: srv,
+	}, logger)
+}
+
 type oidcStore interface {
 	GetPublicKeyByID(keyID string) (any, error)
 }
@@ -832,12 +990,19 @@ type server struct {
 }
 
 type certData struct {
+	// customDomainCerts serves the certificates of the workload proxy custom domains, if set.
+	customDomainCerts *workloadproxy.CertManager
+
 	certFile string
 	keyFile  string
 }
 
 func (s *server) ListenAndServe() error {
 	if s.certFile != "" || s.keyFile != "" {
+		if s.customDomainCerts != nil {
+			s.server.TLSConfig = s.customDomainCerts.WrapTLSConfig(s.server.TLSConfig)
+		}
+
 		return s.server.ListenAndServeTLS(s.certFile, s.keyFile)
 	}
 
@@ -931,6 +1096,51 @@ func runLocalResourceServer(ctx context.Context, st state.CoreState, serverOptio
 	return nil
 }
 
+// runAutoscalerServer runs the cluster-autoscaler external gRPC cloud provider API over mutual TLS.
+func runAutoscalerServer(ctx context.Context, st state.State, eg *errgroup.Group, logger *zap.Logger) error {
+	params := config.Config.Autoscaler
+
+	cert, err := tls.LoadX509KeyPair(params.CertFile, params.KeyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load the certificate: %w", err)
+	}
+
+	clientCA, err := os.ReadFile(params.ClientCAFile)
+	if err != nil {
+		return fmt.Errorf("failed to read the client CA: %w", err)
+	}
+
+	clientCAs := x509.NewCertPool()
+
+	if !clientCAs.AppendCertsFromPEM(clientCA) {
+		return fmt.Errorf("no certificates found in the client CA file %q", params.ClientCAFile)
+	}
+
+	listener, err := net.Listen("tcp", params.BindAddress)
+	if err != nil {
+		return fmt.Errorf("failed to listen: %w", err)
+	}
+
+	grpcServer := grpc.NewServer(
+		grpc.Creds(credentials.NewTLS(&tls.Config{
+			Certificates: []tls.Certificate{cert},
+			ClientCAs:    clientCAs,
+			ClientAuth:   tls.RequireAndVerifyClientCert,
+			MinVersion:   tls.VersionTLS12,
+		})),
+		grpc.ChainUnaryInterceptor(grpc_recovery.UnaryServerInterceptor(grpc_recovery.WithRecoveryHandler(recoveryHandler(logger)))),
+		grpc.SharedWriteBuffer(true),
+	)
+
+	autoscaler.NewServer(st, logger.With(logging.Component("autoscaler"))).Register(grpcServer)
+
+	logger.Info("starting cluster-autoscaler server", zap.String("address", params.BindAddress))
+
+	grpcutil.RunServer(ctx, grpcServer, listener, eg, logger)
+
+	return nil
+}
+
 // runEmbeddedDiscoveryService runs an embedded discovery service over Siderolink.
 func runEmbeddedDiscoveryService(ctx context.Context, logger *zap.Logger) error {
 	logLevel, err := zapcore.ParseLevel(config.Config.EmbeddedDiscoveryService.LogLevel)
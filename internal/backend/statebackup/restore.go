@@ -0,0 +1,177 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package statebackup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+)
+
+// ConflictResolution defines how the resources which already exist are restored.
+type ConflictResolution int
+
+// Conflict resolutions.
+const (
+	// ConflictSkip keeps the existing resources.
+	ConflictSkip ConflictResolution = iota
+	// ConflictOverwrite replaces the labels, the annotations and the spec of the existing resources.
+	ConflictOverwrite
+	// ConflictFail aborts the restore without any changes if any of the resources exists.
+	ConflictFail
+)
+
+// Action is the outcome of restoring a resource.
+type Action int
+
+// Actions.
+const (
+	ActionCreated Action = iota
+	ActionUpdated
+	ActionSkipped
+	ActionFailed
+)
+
+// ErrConflict is returned by Restore with ConflictFail if any of the resources exists.
+var ErrConflict = errors.New("resources already exist")
+
+// RestoreOptions configure Restore.
+type RestoreOptions struct {
+	ConflictResolution ConflictResolution
+	// DryRun reports the outcome of the restore without changing the state.
+	DryRun bool
+}
+
+// Result is the outcome of restoring a single resource.
+type Result struct {
+	Err    error
+	Type   resource.Type
+	ID     resource.ID
+	Action Action
+}
+
+// Restore restores the resources of the archive in the archive order and calls report with the outcome for each of them.
+//
+// A failure to restore a resource doesn't stop the restore of the others, it is reported with ActionFailed.
+// With ConflictFail the existing resources are reported as failed and ErrConflict is returned before any change is made.
+// The restored resources get the labels, the annotations and the spec from the archive, the rest of the metadata is reset.
+func Restore(ctx context.Context, st state.State, archive *Archive, opts RestoreOptions, report func(Result) error) error {
+	existing := make([]resource.Resource, len(archive.Resources))
+
+	conflicts := 0
+
+	for i, res := range archive.Resources {
+		current, err := st.Get(ctx, res.Metadata())
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("failed to get %s: %w", resource.String(res), err)
+		}
+
+		existing[i] = current
+
+		if opts.ConflictResolution != ConflictFail {
+			continue
+		}
+
+		conflicts++
+
+		if err = report(Result{
+			Type:   res.Metadata().Type(),
+			ID:     res.Metadata().ID(),
+			Action: ActionFailed,
+			Err:    errors.New("resource already exists"),
+		}); err != nil {
+			return err
+		}
+	}
+
+	if conflicts > 0 {
+		return fmt.Errorf("%w: %d of %d resources", ErrConflict, conflicts, len(archive.Resources))
+	}
+
+	for i, res := range archive.Resources {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		result := Result{
+			Type: res.Metadata().Type(),
+			ID:   res.Metadata().ID(),
+		}
+
+		result.Action, result.Err = restoreResource(ctx, st, restoredResource(res), existing[i], opts)
+		if result.Err != nil {
+			result.Action = ActionFailed
+		}
+
+		if err := report(result); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// restoreResource creates or updates the resource.
+//
+// The resources of ControllerResourceTypes are written on behalf of the controllers which own them, the owner in the archive is ignored.
+func restoreResource(ctx context.Context, st state.State, res, existing resource.Resource, opts RestoreOptions) (Action, error) {
+	owner := ControllerResourceTypes[res.Metadata().Type()]
+
+	if existing == nil {
+		if opts.DryRun {
+			return ActionCreated, nil
+		}
+
+		return ActionCreated, st.Create(ctx, res, state.WithCreateOwner(owner))
+	}
+
+	if opts.ConflictResolution == ConflictSkip {
+		return ActionSkipped, nil
+	}
+
+	if existing.Metadata().Phase() == resource.PhaseTearingDown {
+		return ActionFailed, errors.New("the existing resource is being torn down")
+	}
+
+	if opts.DryRun {
+		return ActionUpdated, nil
+	}
+
+	// keep the finalizers of the controllers which handle the existing resource
+	for _, fin := range *existing.Metadata().Finalizers() {
+		res.Metadata().Finalizers().Add(fin)
+	}
+
+	res.Metadata().SetVersion(existing.Metadata().Version())
+
+	return ActionUpdated, st.Update(ctx, res, state.WithUpdateOwner(owner))
+}
+
+// restoredResource returns the copy of the resource with the metadata reset except for the labels and the annotations.
+func restoredResource(res resource.Resource) resource.Resource {
+	md := resource.NewMetadata(res.Metadata().Namespace(), res.Metadata().Type(), res.Metadata().ID(), resource.VersionUndefined)
+
+	for key, value := range res.Metadata().Labels().Raw() {
+		md.Labels().Set(key, value)
+	}
+
+	for key, value := range res.Metadata().Annotations().Raw() {
+		md.Annotations().Set(key, value)
+	}
+
+	restored := res.DeepCopy()
+
+	*restored.Metadata() = md
+
+	return restored
+}
@@ -0,0 +1,354 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package statebackup exports the user-owned resources of the Omni state to a versioned archive and restores them.
+//
+// The archive is a gzip-compressed tar file: the first entry is the manifest.yaml file with the version of the archive format,
+// it is followed by the resources/<type>.yaml files with the resources of each type as YAML documents.
+// The resources are written in the order they are restored, so that the resources are created after the ones they reference.
+//
+// Only the resources created by the users are exported, the resources managed by the controllers are recreated
+// by the controllers of the instance the archive is restored to.
+// The exception are the cluster secrets and the etcd backup encryption data, see ControllerResourceTypes.
+// The archive contains the cluster secrets, the config patches and the backup storage credentials, so it should be stored securely.
+package statebackup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/state"
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/omni/client/pkg/cosi/snapshot"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+// FormatVersion is the version of the archive format written by Backup, Read rejects the archives of the newer versions.
+const FormatVersion = 1
+
+const (
+	manifestFile = "manifest.yaml"
+	resourcesDir = "resources"
+)
+
+// ResourceTypes are the types of the exported resources in the order they are restored.
+//
+// The one-off operation requests (e.g. the manual etcd backups and the secret rotations) are not exported.
+var ResourceTypes = []resource.Type{
+	authres.UserType,
+	authres.IdentityType,
+	authres.PublicKeyType,
+	authres.AccessPolicyType,
+	authres.SAMLLabelRuleType,
+	omni.SettingsType,
+	omni.QuotaType,
+	omni.RuntimeConfigurationType,
+	omni.LogLevelConfigType,
+	omni.NotificationConfigType,
+	omni.DefaultExtensionsType,
+	omni.EtcdBackupS3ConfType,
+	omni.EtcdBackupStorageConfigType,
+	omni.MachineClassType,
+	omni.MachineLabelsType,
+	omni.ClusterUUIDType,
+	omni.EtcdBackupEncryptionType,
+	omni.ClusterSecretsType,
+	omni.ClusterType,
+	omni.MachineSetType,
+	omni.MachineSetNodeType,
+	omni.ConfigPatchType,
+	omni.ExtensionsConfigurationType,
+	omni.KernelArgsConfigurationType,
+	omni.MaintenanceWindowType,
+	omni.ExposedServiceAccessPolicyType,
+}
+
+// ControllerResourceTypes are the exported resource types managed by the controllers, mapped to the controllers which own them.
+//
+// The controllers would generate new values for them on the restored instance: the running machines of the restored clusters
+// trust only the original cluster secrets, and the etcd backups are stored by the cluster UUID and encrypted with the original key.
+// They are restored before the clusters, so that the controllers keep the restored values.
+var ControllerResourceTypes = map[resource.Type]string{
+	omni.ClusterUUIDType:          "ClusterUUIDController",
+	omni.EtcdBackupEncryptionType: "EtcdBackupEncryptionController",
+	omni.ClusterSecretsType:       "SecretsController",
+}
+
+// Manifest describes the archive.
+type Manifest struct {
+	CreatedAt   time.Time             `yaml:"createdAt"`
+	Resources   map[resource.Type]int `yaml:"resources"`
+	OmniVersion string                `yaml:"omniVersion"`
+	Version     int                   `yaml:"version"`
+}
+
+// Archive is the decoded archive.
+type Archive struct {
+	Manifest  Manifest
+	Resources []resource.Resource
+}
+
+// Backup writes the archive of the user-owned resources of the state to w.
+//
+// The resources are read as a consistent snapshot.
+// The public keys are exported only for the service accounts, the sessions of the users are not exported.
+func Backup(ctx context.Context, st state.CoreState, w io.Writer, omniVersion string) (*Manifest, error) {
+	queries := make([]snapshot.Query, 0, len(ResourceTypes))
+
+	for _, typ := range ResourceTypes {
+		queries = append(queries, snapshot.Query{
+			Kind: resource.NewMetadata(resources.DefaultNamespace, typ, "", resource.VersionUndefined),
+		})
+	}
+
+	snap, err := snapshot.Read(ctx, st, queries...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the state: %w", err)
+	}
+
+	serviceAccountUsers := map[string]struct{}{}
+
+	identities := snapshot.List[*authres.Identity](snap, slices.Index(ResourceTypes, authres.IdentityType))
+
+	for iter := identities.Iterator(); iter.Next(); {
+		if _, ok := iter.Value().Metadata().Labels().Get(authres.LabelIdentityTypeServiceAccount); ok {
+			serviceAccountUsers[iter.Value().TypedSpec().Value.UserId] = struct{}{}
+		}
+	}
+
+	manifest := &Manifest{
+		Version:     FormatVersion,
+		CreatedAt:   time.Now().UTC(),
+		OmniVersion: omniVersion,
+		Resources:   make(map[resource.Type]int, len(ResourceTypes)),
+	}
+
+	files := make([][]byte, len(ResourceTypes))
+
+	for i, typ := range ResourceTypes {
+		var buf bytes.Buffer
+
+		enc := yaml.NewEncoder(&buf)
+		enc.SetIndent(2)
+
+		for _, res := range snap.Items(i) {
+			if !exported(res, serviceAccountUsers) {
+				continue
+			}
+
+			raw, err := resource.MarshalYAML(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal %s: %w", resource.String(res), err)
+			}
+
+			if err = enc.Encode(raw); err != nil {
+				return nil, fmt.Errorf("failed to encode %s: %w", resource.String(res), err)
+			}
+
+			manifest.Resources[typ]++
+		}
+
+		// the types without the exported resources are not written to the archive,
+		// and the encoder which didn't encode any document can't be closed
+		if manifest.Resources[typ] == 0 {
+			continue
+		}
+
+		if err = enc.Close(); err != nil {
+			return nil, err
+		}
+
+		files[i] = buf.Bytes()
+	}
+
+	manifestData, err := yaml.Marshal(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	if err = writeFile(tw, manifestFile, manifestData, manifest.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	for i, typ := range ResourceTypes {
+		if manifest.Resources[typ] == 0 {
+			continue
+		}
+
+		if err = writeFile(tw, path.Join(resourcesDir, typ+".yaml"), files[i], manifest.CreatedAt); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tw.Close(); err != nil {
+		return nil, err
+	}
+
+	if err = gz.Close(); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+// exported returns true if the resource is created by the user or is one of ControllerResourceTypes and should be exported.
+func exported(res resource.Resource, serviceAccountUsers map[string]struct{}) bool {
+	if res.Metadata().Owner() != ControllerResourceTypes[res.Metadata().Type()] || res.Metadata().Phase() == resource.PhaseTearingDown {
+		return false
+	}
+
+	if res.Metadata().Type() == authres.PublicKeyType {
+		userID, _ := res.Metadata().Labels().Get(authres.LabelPublicKeyUserID)
+
+		_, ok := serviceAccountUsers[userID]
+
+		return ok
+	}
+
+	return true
+}
+
+func writeFile(tw *tar.Writer, name string, data []byte, modTime time.Time) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Size:     int64(len(data)),
+		Mode:     0o600,
+		ModTime:  modTime,
+	}); err != nil {
+		return fmt.Errorf("failed to write %q: %w", name, err)
+	}
+
+	if _, err := tw.Write(data); err != nil {
+		return fmt.Errorf("failed to write %q: %w", name, err)
+	}
+
+	return nil
+}
+
+// Read decodes the archive.
+func Read(r io.Reader) (*Archive, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the archive: %w", err)
+	}
+
+	defer gz.Close() //nolint:errcheck
+
+	tr := tar.NewReader(gz)
+
+	var archive *Archive
+
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the archive: %w", err)
+		}
+
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %q: %w", header.Name, err)
+		}
+
+		if archive == nil {
+			if header.Name != manifestFile {
+				return nil, fmt.Errorf("the archive doesn't start with %q", manifestFile)
+			}
+
+			if archive, err = readManifest(data); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		typ, ok := strings.CutSuffix(strings.TrimPrefix(header.Name, resourcesDir+"/"), ".yaml")
+		if !ok || path.Dir(header.Name) != resourcesDir {
+			return nil, fmt.Errorf("unexpected file %q in the archive", header.Name)
+		}
+
+		decoded, err := readResources(typ, data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %q: %w", header.Name, err)
+		}
+
+		archive.Resources = append(archive.Resources, decoded...)
+	}
+
+	if archive == nil {
+		return nil, errors.New("the archive is empty")
+	}
+
+	return archive, nil
+}
+
+func readManifest(data []byte) (*Archive, error) {
+	var manifest Manifest
+
+	if err := yaml.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to read the manifest: %w", err)
+	}
+
+	switch {
+	case manifest.Version == 0:
+		return nil, errors.New("the manifest doesn't specify the archive version")
+	case manifest.Version > FormatVersion:
+		return nil, fmt.Errorf("the archive version %d is not supported, the latest supported version is %d", manifest.Version, FormatVersion)
+	}
+
+	return &Archive{Manifest: manifest}, nil
+}
+
+func readResources(typ resource.Type, data []byte) ([]resource.Resource, error) {
+	if !slices.Contains(ResourceTypes, typ) {
+		return nil, fmt.Errorf("resource type %q is not supported", typ)
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+
+	var result []resource.Resource
+
+	for {
+		var res protobuf.YAMLResource
+
+		err := dec.Decode(&res)
+		if errors.Is(err, io.EOF) {
+			return result, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		decoded := res.Resource()
+
+		if decoded.Metadata().Type() != typ || decoded.Metadata().Namespace() != resources.DefaultNamespace {
+			return nil, fmt.Errorf("unexpected resource %s", resource.String(decoded))
+		}
+
+		result = append(result, decoded)
+	}
+}
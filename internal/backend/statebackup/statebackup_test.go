@@ -0,0 +1,366 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package statebackup_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/statebackup"
+)
+
+func newState() state.State {
+	return state.WrapCore(namespaced.NewState(inmem.Build))
+}
+
+func fillState(ctx context.Context, t *testing.T, st state.State) {
+	t.Helper()
+
+	cluster := omni.NewCluster(resources.DefaultNamespace, "talos-default")
+	cluster.TypedSpec().Value.TalosVersion = "1.7.6"
+	cluster.Metadata().Labels().Set("env", "prod")
+	cluster.Metadata().Finalizers().Add("ClusterController")
+
+	require.NoError(t, st.Create(ctx, cluster))
+
+	configPatch := omni.NewConfigPatch(resources.DefaultNamespace, "500-user-patch")
+	configPatch.Metadata().Labels().Set(omni.LabelCluster, "talos-default")
+	configPatch.Metadata().Annotations().Set(omni.ConfigPatchName, "user patch")
+	configPatch.TypedSpec().Value.Data = "machine:\n  network:\n    hostname: test\n"
+
+	require.NoError(t, st.Create(ctx, configPatch))
+
+	// the resources of the controllers are not exported
+	systemPatch := omni.NewConfigPatch(resources.DefaultNamespace, "950-system-patch")
+	systemPatch.Metadata().Labels().Set(omni.LabelSystemPatch, "")
+
+	require.NoError(t, st.Create(ctx, systemPatch, state.WithCreateOwner("SystemPatchController")))
+
+	serviceAccountUser := authres.NewUser(resources.DefaultNamespace, "sa-user")
+	serviceAccountUser.TypedSpec().Value.Role = "Operator"
+
+	require.NoError(t, st.Create(ctx, serviceAccountUser))
+
+	serviceAccount := authres.NewIdentity(resources.DefaultNamespace, "automation@serviceaccount.omni.sidero.dev")
+	serviceAccount.TypedSpec().Value.UserId = "sa-user"
+	serviceAccount.Metadata().Labels().Set(authres.LabelIdentityUserID, "sa-user")
+	serviceAccount.Metadata().Labels().Set(authres.LabelIdentityTypeServiceAccount, "")
+
+	require.NoError(t, st.Create(ctx, serviceAccount))
+
+	serviceAccountKey := authres.NewPublicKey(resources.DefaultNamespace, "sa-key")
+	serviceAccountKey.Metadata().Labels().Set(authres.LabelPublicKeyUserID, "sa-user")
+
+	require.NoError(t, st.Create(ctx, serviceAccountKey))
+
+	user := authres.NewUser(resources.DefaultNamespace, "user")
+
+	require.NoError(t, st.Create(ctx, user))
+
+	// the session keys of the users are not exported
+	sessionKey := authres.NewPublicKey(resources.DefaultNamespace, "session-key")
+	sessionKey.Metadata().Labels().Set(authres.LabelPublicKeyUserID, "user")
+
+	require.NoError(t, st.Create(ctx, sessionKey))
+}
+
+func backup(ctx context.Context, t *testing.T, st state.State) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	manifest, err := statebackup.Backup(ctx, st, &buf, "v0.40.0")
+	require.NoError(t, err)
+
+	assert.Equal(t, map[resource.Type]int{
+		omni.ClusterType:      1,
+		omni.ConfigPatchType:  1,
+		authres.UserType:      2,
+		authres.IdentityType:  1,
+		authres.PublicKeyType: 1,
+	}, manifest.Resources)
+
+	return buf.Bytes()
+}
+
+func TestBackupRestore(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	st := newState()
+
+	fillState(ctx, t, st)
+
+	archive, err := statebackup.Read(bytes.NewReader(backup(ctx, t, st)))
+	require.NoError(t, err)
+
+	assert.Equal(t, statebackup.FormatVersion, archive.Manifest.Version)
+	assert.Equal(t, "v0.40.0", archive.Manifest.OmniVersion)
+
+	restored := newState()
+
+	var results []statebackup.Result
+
+	require.NoError(t, statebackup.Restore(ctx, restored, archive, statebackup.RestoreOptions{}, func(result statebackup.Result) error {
+		results = append(results, result)
+
+		return nil
+	}))
+
+	require.Len(t, results, 6)
+
+	// the resources are restored in the dependency order
+	assert.Equal(t, authres.UserType, results[0].Type)
+	assert.Equal(t, omni.ConfigPatchType, results[5].Type)
+
+	for _, result := range results {
+		assert.Equal(t, statebackup.ActionCreated, result.Action, result.ID)
+		assert.NoError(t, result.Err)
+	}
+
+	cluster, err := safe.StateGetByID[*omni.Cluster](ctx, restored, "talos-default")
+	require.NoError(t, err)
+
+	assert.Equal(t, "1.7.6", cluster.TypedSpec().Value.TalosVersion)
+	assert.Equal(t, map[string]string{"env": "prod"}, cluster.Metadata().Labels().Raw())
+	assert.Empty(t, *cluster.Metadata().Finalizers())
+
+	configPatch, err := safe.StateGetByID[*omni.ConfigPatch](ctx, restored, "500-user-patch")
+	require.NoError(t, err)
+
+	name, _ := configPatch.Metadata().Annotations().Get(omni.ConfigPatchName)
+
+	assert.Equal(t, "user patch", name)
+	assert.Equal(t, "machine:\n  network:\n    hostname: test\n", configPatch.TypedSpec().Value.Data)
+
+	_, err = safe.StateGetByID[*authres.PublicKey](ctx, restored, "sa-key")
+	require.NoError(t, err)
+
+	_, err = safe.StateGetByID[*authres.PublicKey](ctx, restored, "session-key")
+	require.True(t, state.IsNotFoundError(err))
+}
+
+func TestRestoreConflicts(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	st := newState()
+
+	fillState(ctx, t, st)
+
+	archive, err := statebackup.Read(bytes.NewReader(backup(ctx, t, st)))
+	require.NoError(t, err)
+
+	_, err = safe.StateUpdateWithConflicts(ctx, st, omni.NewCluster(resources.DefaultNamespace, "talos-default").Metadata(), func(res *omni.Cluster) error {
+		res.TypedSpec().Value.TalosVersion = "1.8.0"
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	restore := func(opts statebackup.RestoreOptions) (map[resource.ID]statebackup.Action, error) {
+		actions := map[resource.ID]statebackup.Action{}
+
+		err := statebackup.Restore(ctx, st, archive, opts, func(result statebackup.Result) error {
+			actions[result.ID] = result.Action
+
+			return nil
+		})
+
+		return actions, err
+	}
+
+	talosVersion := func() string {
+		cluster, err := safe.StateGetByID[*omni.Cluster](ctx, st, "talos-default")
+		require.NoError(t, err)
+
+		return cluster.TypedSpec().Value.TalosVersion
+	}
+
+	actions, err := restore(statebackup.RestoreOptions{ConflictResolution: statebackup.ConflictFail})
+	require.ErrorIs(t, err, statebackup.ErrConflict)
+	assert.Len(t, actions, 6)
+	assert.Equal(t, statebackup.ActionFailed, actions["talos-default"])
+
+	actions, err = restore(statebackup.RestoreOptions{ConflictResolution: statebackup.ConflictSkip})
+	require.NoError(t, err)
+	assert.Equal(t, statebackup.ActionSkipped, actions["talos-default"])
+	assert.Equal(t, "1.8.0", talosVersion())
+
+	actions, err = restore(statebackup.RestoreOptions{ConflictResolution: statebackup.ConflictOverwrite, DryRun: true})
+	require.NoError(t, err)
+	assert.Equal(t, statebackup.ActionUpdated, actions["talos-default"])
+	assert.Equal(t, "1.8.0", talosVersion())
+
+	actions, err = restore(statebackup.RestoreOptions{ConflictResolution: statebackup.ConflictOverwrite})
+	require.NoError(t, err)
+	assert.Equal(t, statebackup.ActionUpdated, actions["talos-default"])
+	assert.Equal(t, "1.7.6", talosVersion())
+
+	cluster, err := safe.StateGetByID[*omni.Cluster](ctx, st, "talos-default")
+	require.NoError(t, err)
+
+	// the finalizers of the existing resource are kept
+	assert.Equal(t, resource.Finalizers{"ClusterController"}, *cluster.Metadata().Finalizers())
+}
+
+func TestRestoreClusterSecrets(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	st := newState()
+
+	require.NoError(t, st.Create(ctx, omni.NewCluster(resources.DefaultNamespace, "talos-default")))
+
+	secrets := omni.NewClusterSecrets(resources.DefaultNamespace, "talos-default")
+	secrets.TypedSpec().Value.Data = []byte(`{"cluster":{"id":"original"}}`)
+
+	require.NoError(t, st.Create(ctx, secrets, state.WithCreateOwner("SecretsController")))
+
+	clusterUUID := omni.NewClusterUUID("talos-default")
+	clusterUUID.TypedSpec().Value.Uuid = "a2d5a5a5-13f2-4b0c-9b8e-ff5e1a4e7d1c"
+	clusterUUID.Metadata().Labels().Set(omni.LabelClusterUUID, clusterUUID.TypedSpec().Value.Uuid)
+
+	require.NoError(t, st.Create(ctx, clusterUUID, state.WithCreateOwner("ClusterUUIDController")))
+
+	backupEncryption := omni.NewEtcdBackupEncryption(resources.DefaultNamespace, "talos-default")
+	backupEncryption.TypedSpec().Value.EncryptionKey = []byte("0123456789abcdef0123456789abcdef")
+
+	require.NoError(t, st.Create(ctx, backupEncryption, state.WithCreateOwner("EtcdBackupEncryptionController")))
+
+	var buf bytes.Buffer
+
+	manifest, err := statebackup.Backup(ctx, st, &buf, "v0.40.0")
+	require.NoError(t, err)
+
+	assert.Equal(t, map[resource.Type]int{
+		omni.ClusterType:              1,
+		omni.ClusterSecretsType:       1,
+		omni.ClusterUUIDType:          1,
+		omni.EtcdBackupEncryptionType: 1,
+	}, manifest.Resources)
+
+	archive, err := statebackup.Read(&buf)
+	require.NoError(t, err)
+
+	restored := newState()
+
+	var restoredTypes []resource.Type
+
+	require.NoError(t, statebackup.Restore(ctx, restored, archive, statebackup.RestoreOptions{}, func(result statebackup.Result) error {
+		require.NoError(t, result.Err)
+
+		restoredTypes = append(restoredTypes, result.Type)
+
+		return nil
+	}))
+
+	// the secrets are restored before the cluster, so that the controllers don't generate the new ones
+	assert.Equal(t, omni.ClusterType, restoredTypes[len(restoredTypes)-1])
+
+	restoredSecrets, err := safe.StateGetByID[*omni.ClusterSecrets](ctx, restored, "talos-default")
+	require.NoError(t, err)
+
+	assert.Equal(t, "SecretsController", restoredSecrets.Metadata().Owner())
+	assert.Equal(t, secrets.TypedSpec().Value.Data, restoredSecrets.TypedSpec().Value.Data)
+
+	restoredUUID, err := safe.StateGetByID[*omni.ClusterUUID](ctx, restored, "talos-default")
+	require.NoError(t, err)
+
+	assert.Equal(t, "ClusterUUIDController", restoredUUID.Metadata().Owner())
+	assert.Equal(t, clusterUUID.TypedSpec().Value.Uuid, restoredUUID.TypedSpec().Value.Uuid)
+	assert.Equal(t, clusterUUID.Metadata().Labels().Raw(), restoredUUID.Metadata().Labels().Raw())
+
+	restoredEncryption, err := safe.StateGetByID[*omni.EtcdBackupEncryption](ctx, restored, "talos-default")
+	require.NoError(t, err)
+
+	assert.Equal(t, "EtcdBackupEncryptionController", restoredEncryption.Metadata().Owner())
+	assert.Equal(t, backupEncryption.TypedSpec().Value.EncryptionKey, restoredEncryption.TypedSpec().Value.EncryptionKey)
+
+	// the secrets generated by the controller of the new instance are replaced on overwrite
+	restoredSecrets.TypedSpec().Value.Data = []byte(`{"cluster":{"id":"generated"}}`)
+
+	require.NoError(t, restored.Update(ctx, restoredSecrets, state.WithUpdateOwner("SecretsController")))
+
+	require.NoError(t, statebackup.Restore(ctx, restored, archive, statebackup.RestoreOptions{ConflictResolution: statebackup.ConflictOverwrite},
+		func(result statebackup.Result) error {
+			assert.NoError(t, result.Err)
+
+			return nil
+		},
+	))
+
+	restoredSecrets, err = safe.StateGetByID[*omni.ClusterSecrets](ctx, restored, "talos-default")
+	require.NoError(t, err)
+
+	assert.Equal(t, secrets.TypedSpec().Value.Data, restoredSecrets.TypedSpec().Value.Data)
+}
+
+func TestBackupEmptyTypes(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	st := newState()
+
+	// only the resources of the controllers, nothing is exported for the type
+	require.NoError(t, st.Create(ctx, omni.NewConfigPatch(resources.DefaultNamespace, "950-system-patch"), state.WithCreateOwner("SystemPatchController")))
+
+	var buf bytes.Buffer
+
+	manifest, err := statebackup.Backup(ctx, st, &buf, "v0.40.0")
+	require.NoError(t, err)
+
+	assert.Empty(t, manifest.Resources)
+
+	archive, err := statebackup.Read(&buf)
+	require.NoError(t, err)
+
+	assert.Empty(t, archive.Resources)
+}
+
+func TestReadUnsupportedVersion(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+
+	manifest := []byte("version: 2\n")
+
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "manifest.yaml", Size: int64(len(manifest)), Mode: 0o600}))
+
+	_, err := tw.Write(manifest)
+	require.NoError(t, err)
+
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+
+	_, err = statebackup.Read(&buf)
+	require.ErrorContains(t, err, "archive version 2 is not supported")
+}
@@ -9,6 +9,10 @@ import (
 	"context"
 	"encoding/base64"
 	"errors"
+	"fmt"
+	"net/netip"
+	"path/filepath"
+	"slices"
 
 	pgpcrypto "github.com/ProtonMail/gopenpgp/v2/crypto"
 	"github.com/cosi-project/runtime/pkg/resource"
@@ -17,8 +21,10 @@ import (
 	"github.com/siderolabs/go-api-signature/pkg/pgp"
 	"go.uber.org/zap"
 
+	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/internal/pkg/auth"
 	"github.com/siderolabs/omni/internal/pkg/auth/accesspolicy"
 	"github.com/siderolabs/omni/internal/pkg/auth/actor"
@@ -80,54 +86,145 @@ func NewPGPAccessValidator(state state.State, roleProvider RoleProvider, logger
 }
 
 // ValidateAccess validates the access to an exposed service in the given cluster ID,
-// using the PGP public keys in the Omni database.
-func (p *PGPAccessValidator) ValidateAccess(ctx context.Context, publicKeyID, publicKeyIDSignatureBase64 string, clusterID resource.ID) error {
+// using the PGP public keys in the Omni database and the access policy of the service.
+//
+// It returns the identity of the public key owner once the signature is verified.
+func (p *PGPAccessValidator) ValidateAccess(ctx context.Context, publicKeyID, publicKeyIDSignatureBase64 string, clusterID resource.ID,
+	service ServiceRequest,
+) (string, error) {
 	singatureBytes, err := base64.StdEncoding.DecodeString(publicKeyIDSignatureBase64)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	ctx = actor.MarkContextAsInternalActor(ctx)
 
 	publicKey, err := safe.StateGet[*authres.PublicKey](ctx, p.state, authres.NewPublicKey(resources.DefaultNamespace, publicKeyID).Metadata())
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	key, err := pgpcrypto.NewKeyFromArmored(string(publicKey.TypedSpec().Value.GetPublicKey()))
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	pgpKey, err := pgp.NewKey(key)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	if err = pgpKey.Validate(); err != nil {
-		return err
+		return "", err
 	}
 
 	if err = pgpKey.Verify([]byte(publicKeyID), singatureBytes); err != nil {
-		return err
+		return "", err
 	}
 
 	publicKeyRoleStr := publicKey.TypedSpec().Value.GetRole()
 	if publicKeyRoleStr != "" {
 		publicKeyRole, parseErr := role.Parse(publicKeyRoleStr)
 		if parseErr != nil {
-			return parseErr
+			return "", parseErr
 		}
 
 		ctx = context.WithValue(ctx, auth.RoleContextKey{}, publicKeyRole)
 	}
 
-	ctx = context.WithValue(ctx, auth.IdentityContextKey{}, publicKey.TypedSpec().Value.GetIdentity().GetEmail())
+	identity := publicKey.TypedSpec().Value.GetIdentity().GetEmail()
+
+	ctx = context.WithValue(ctx, auth.IdentityContextKey{}, identity)
 
 	accessRole, err := p.roleProvider.RoleForCluster(ctx, clusterID)
 	if err != nil {
-		return err
+		return identity, err
+	}
+
+	if err = accessRole.Check(role.Reader); err != nil {
+		return identity, err
+	}
+
+	policy, err := p.servicePolicy(ctx, clusterID, service.Alias)
+	if err != nil {
+		return identity, err
+	}
+
+	if policy == nil {
+		return identity, nil
+	}
+
+	return identity, checkServicePolicy(policy.TypedSpec().Value, identity, accessRole, publicKey.TypedSpec().Value.GetMfaVerified(), service.ClientIP)
+}
+
+// servicePolicy returns the access policy of the exposed service with the given alias, nil if there is none.
+func (p *PGPAccessValidator) servicePolicy(ctx context.Context, clusterID resource.ID, alias string) (*omni.ExposedServiceAccessPolicy, error) {
+	services, err := safe.StateListAll[*omni.ExposedService](ctx, p.state, state.WithLabelQuery(
+		resource.LabelEqual(omni.LabelCluster, clusterID),
+		resource.LabelEqual(omni.LabelExposedServiceAlias, alias),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	if services.Len() == 0 {
+		return nil, nil //nolint:nilnil
+	}
+
+	policy, err := safe.StateGetByID[*omni.ExposedServiceAccessPolicy](ctx, p.state, services.Get(0).Metadata().ID())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, nil //nolint:nilnil
+		}
+
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+func checkServicePolicy(policy *specs.ExposedServiceAccessPolicySpec, identity string, accessRole role.Role, mfaVerified bool, clientIP string) error {
+	if identities := policy.GetIdentities(); len(identities) > 0 {
+		if !slices.ContainsFunc(identities, func(pattern string) bool {
+			matches, err := filepath.Match(pattern, identity)
+
+			return err == nil && matches
+		}) {
+			return fmt.Errorf("identity %q is not allowed to access the service", identity)
+		}
+	}
+
+	if policy.GetMinRole() != "" {
+		minRole, err := role.Parse(policy.GetMinRole())
+		if err != nil {
+			return err
+		}
+
+		if err = accessRole.Check(minRole); err != nil {
+			return err
+		}
+	}
+
+	if policy.GetRequireMfa() && !mfaVerified {
+		return errors.New("the service requires the multi-factor authentication")
+	}
+
+	if cidrs := policy.GetAllowedCidrs(); len(cidrs) > 0 {
+		addr, err := netip.ParseAddr(clientIP)
+		if err != nil {
+			return fmt.Errorf("failed to parse the client address %q: %w", clientIP, err)
+		}
+
+		addr = addr.Unmap()
+
+		if !slices.ContainsFunc(cidrs, func(cidr string) bool {
+			prefix, parseErr := netip.ParsePrefix(cidr)
+
+			return parseErr == nil && prefix.Contains(addr)
+		}) {
+			return fmt.Errorf("client address %q is not allowed to access the service", clientIP)
+		}
 	}
 
-	return accessRole.Check(role.Reader)
+	return nil
 }
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
 	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
@@ -19,8 +20,10 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 
+	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/internal/backend/workloadproxy"
 	"github.com/siderolabs/omni/internal/pkg/auth/role"
 )
@@ -62,13 +65,15 @@ func TestAccessValidator(t *testing.T) {
 
 	require.NoError(t, st.Create(ctx, publicKey))
 
-	err = accessValidator.ValidateAccess(ctx, publicKey.Metadata().ID(), base64.StdEncoding.EncodeToString([]byte("invalid-test-signature")), "test-cluster")
+	service := workloadproxy.ServiceRequest{Alias: "test-alias", ClientIP: "10.5.0.1"}
+
+	_, err = accessValidator.ValidateAccess(ctx, publicKey.Metadata().ID(), base64.StdEncoding.EncodeToString([]byte("invalid-test-signature")), "test-cluster", service)
 	require.Error(t, err)
 
 	signature, err := key.Sign([]byte(publicKey.Metadata().ID()))
 	require.NoError(t, err)
 
-	err = accessValidator.ValidateAccess(ctx, publicKey.Metadata().ID(), base64.StdEncoding.EncodeToString(signature), "test-cluster")
+	_, err = accessValidator.ValidateAccess(ctx, publicKey.Metadata().ID(), base64.StdEncoding.EncodeToString(signature), "test-cluster", service)
 	require.NoError(t, err)
 
 	require.Len(t, roleProvider.clusterIDs, 1)
@@ -78,6 +83,127 @@ func TestAccessValidator(t *testing.T) {
 
 	roleProvider.role = role.None
 
-	err = accessValidator.ValidateAccess(ctx, publicKey.Metadata().ID(), base64.StdEncoding.EncodeToString(signature), "test-cluster")
+	_, err = accessValidator.ValidateAccess(ctx, publicKey.Metadata().ID(), base64.StdEncoding.EncodeToString(signature), "test-cluster", service)
 	require.Error(t, err)
 }
+
+func TestAccessValidatorServicePolicy(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	t.Cleanup(cancel)
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	roleProvider := &mockRoleProvider{
+		role: role.Reader,
+	}
+
+	accessValidator, err := workloadproxy.NewPGPAccessValidator(st, roleProvider, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	key, err := pgp.GenerateKey("test", "", "test@example.com", 8*time.Hour)
+	require.NoError(t, err)
+
+	publicKey := auth.NewPublicKey(resources.DefaultNamespace, "test-public-key-id")
+
+	armored, err := key.ArmorPublic()
+	require.NoError(t, err)
+
+	publicKey.TypedSpec().Value.PublicKey = []byte(armored)
+	publicKey.TypedSpec().Value.Identity = &specs.Identity{Email: "test@example.com"}
+
+	require.NoError(t, st.Create(ctx, publicKey))
+
+	signature, err := key.Sign([]byte(publicKey.Metadata().ID()))
+	require.NoError(t, err)
+
+	signatureBase64 := base64.StdEncoding.EncodeToString(signature)
+
+	exposedService := omni.NewExposedService(resources.DefaultNamespace, "test-cluster/test-svc.default")
+	exposedService.Metadata().Labels().Set(omni.LabelCluster, "test-cluster")
+	exposedService.Metadata().Labels().Set(omni.LabelExposedServiceAlias, "test-alias")
+
+	require.NoError(t, st.Create(ctx, exposedService))
+
+	service := workloadproxy.ServiceRequest{Alias: "test-alias", ClientIP: "10.5.0.1"}
+
+	// no policy, access is allowed
+	identity, err := accessValidator.ValidateAccess(ctx, publicKey.Metadata().ID(), signatureBase64, "test-cluster", service)
+	require.NoError(t, err)
+	require.Equal(t, "test@example.com", identity)
+
+	policy := omni.NewExposedServiceAccessPolicy(resources.DefaultNamespace, exposedService.Metadata().ID())
+	policy.Metadata().Labels().Set(omni.LabelCluster, "test-cluster")
+	policy.TypedSpec().Value.Identities = []string{"*@example.com"}
+	policy.TypedSpec().Value.AllowedCidrs = []string{"10.5.0.0/24"}
+
+	require.NoError(t, st.Create(ctx, policy))
+
+	_, err = accessValidator.ValidateAccess(ctx, publicKey.Metadata().ID(), signatureBase64, "test-cluster", service)
+	require.NoError(t, err)
+
+	// the policy doesn't apply to the other services
+	_, err = accessValidator.ValidateAccess(ctx, publicKey.Metadata().ID(), signatureBase64, "test-cluster",
+		workloadproxy.ServiceRequest{Alias: "other-alias", ClientIP: "192.168.0.1"})
+	require.NoError(t, err)
+
+	for _, test := range []struct {
+		name   string
+		update func(*specs.ExposedServiceAccessPolicySpec)
+		ip     string
+	}{
+		{
+			name: "identity",
+			update: func(spec *specs.ExposedServiceAccessPolicySpec) {
+				spec.Identities = []string{"admin@example.com"}
+			},
+		},
+		{
+			name: "role",
+			update: func(spec *specs.ExposedServiceAccessPolicySpec) {
+				spec.MinRole = string(role.Operator)
+			},
+		},
+		{
+			name: "mfa",
+			update: func(spec *specs.ExposedServiceAccessPolicySpec) {
+				spec.RequireMfa = true
+			},
+		},
+		{
+			name: "cidr",
+			ip:   "192.168.0.1",
+		},
+		{
+			name: "unknown ip",
+			ip:   "unknown",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			setPolicy := func(update func(*specs.ExposedServiceAccessPolicySpec)) {
+				_, updateErr := safe.StateUpdateWithConflicts(ctx, st, policy.Metadata(), func(res *omni.ExposedServiceAccessPolicy) error {
+					res.TypedSpec().Value = policy.TypedSpec().Value.CloneVT()
+
+					if update != nil {
+						update(res.TypedSpec().Value)
+					}
+
+					return nil
+				})
+				require.NoError(t, updateErr)
+			}
+
+			setPolicy(test.update)
+
+			testService := service
+
+			if test.ip != "" {
+				testService.ClientIP = test.ip
+			}
+
+			_, accessErr := accessValidator.ValidateAccess(ctx, publicKey.Metadata().ID(), signatureBase64, "test-cluster", testService)
+			require.Error(t, accessErr)
+
+			setPolicy(nil)
+		})
+	}
+}
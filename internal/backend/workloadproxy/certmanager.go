@@ -0,0 +1,100 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package workloadproxy
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"go.uber.org/zap"
+	"golang.org/x/crypto/acme"
+	"golang.org/x/crypto/acme/autocert"
+
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+// CertManager issues the certificates for the custom domains of the exposed services using ACME.
+//
+// The TLS-ALPN-01 challenges are answered by the TLS server using the certificates of the manager,
+// the HTTP-01 challenges are answered by the HTTPHandler.
+type CertManager struct {
+	manager  *autocert.Manager
+	resolver CustomDomainResolver
+	logger   *zap.Logger
+}
+
+// NewCertManager creates a new CertManager, the certificates are issued only for the custom domains known to the resolver.
+func NewCertManager(params config.WorkloadProxyingCustomDomainsParams, resolver CustomDomainResolver, logger *zap.Logger) *CertManager {
+	certManager := &CertManager{
+		resolver: resolver,
+		logger:   logger,
+	}
+
+	certManager.manager = &autocert.Manager{
+		Prompt:     autocert.AcceptTOS,
+		Cache:      autocert.DirCache(params.CertCacheDir),
+		HostPolicy: certManager.hostPolicy,
+		Email:      params.ACMEEmail,
+	}
+
+	if params.ACMEDirectoryURL != "" {
+		certManager.manager.Client = &acme.Client{
+			DirectoryURL: params.ACMEDirectoryURL,
+		}
+	}
+
+	return certManager
+}
+
+func (m *CertManager) hostPolicy(_ context.Context, host string) error {
+	if _, ok := m.resolver.AliasForCustomDomain(host); !ok {
+		return fmt.Errorf("host %q is not a custom domain of an exposed service", host)
+	}
+
+	return nil
+}
+
+// GetCertificate returns the certificate for the custom domains.
+//
+// For the other hosts it returns nil, so that the default certificate of the TLS server is used.
+func (m *CertManager) GetCertificate(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	if _, ok := m.resolver.AliasForCustomDomain(strings.ToLower(hello.ServerName)); !ok {
+		return nil, nil //nolint:nilnil
+	}
+
+	cert, err := m.manager.GetCertificate(hello)
+	if err != nil {
+		m.logger.Warn("failed to get the certificate for the custom domain", zap.String("domain", hello.ServerName), zap.Error(err))
+
+		return nil, err
+	}
+
+	return cert, nil
+}
+
+// WrapTLSConfig makes the TLS config serve the certificates of the custom domains and answer the TLS-ALPN-01 challenges.
+func (m *CertManager) WrapTLSConfig(tlsConfig *tls.Config) *tls.Config {
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{}
+	}
+
+	tlsConfig.GetCertificate = m.GetCertificate
+
+	if !slices.Contains(tlsConfig.NextProtos, acme.ALPNProto) {
+		tlsConfig.NextProtos = append(tlsConfig.NextProtos, acme.ALPNProto)
+	}
+
+	return tlsConfig
+}
+
+// HTTPHandler answers the HTTP-01 challenges, the other requests are redirected to HTTPS.
+func (m *CertManager) HTTPHandler() http.Handler {
+	return m.manager.HTTPHandler(nil)
+}
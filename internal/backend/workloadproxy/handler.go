@@ -15,6 +15,8 @@ import (
 	"strings"
 
 	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/felixge/httpsnoop"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 
 	"github.com/siderolabs/omni/internal/pkg/auth"
@@ -26,22 +28,40 @@ type ProxyProvider interface {
 	GetProxy(alias string) (http.Handler, resource.ID, error)
 }
 
-// AccessValidator validates workload proxy requests against the given cluster by the given public key ID and its signed & base64'd form.
+// AccessValidator validates workload proxy requests against the given cluster and service by the given public key ID and its signed & base64'd form.
+//
+// It returns the identity of the public key owner, if known, to be used in the access logs.
 type AccessValidator interface {
-	ValidateAccess(ctx context.Context, publicKeyID, publicKeyIDSignatureBase64 string, clusterID resource.ID) error
+	ValidateAccess(ctx context.Context, publicKeyID, publicKeyIDSignatureBase64 string, clusterID resource.ID, service ServiceRequest) (string, error)
+}
+
+// ServiceRequest describes the request to an exposed service.
+type ServiceRequest struct {
+	// Alias is the alias of the exposed service.
+	Alias string
+
+	// ClientIP is the address of the client, might be empty if unknown.
+	ClientIP string
+}
+
+// CustomDomainResolver resolves the custom domains of the exposed services to their aliases.
+type CustomDomainResolver interface {
+	AliasForCustomDomain(domain string) (string, bool)
 }
 
 // HTTPHandler is an HTTP handler that will proxy matching requests to the workload proxy.
 //
 // It will pass through the requests that don't match.
 type HTTPHandler struct {
-	next                http.Handler
-	logger              *zap.Logger
-	proxyProvider       ProxyProvider
-	accessValidator     AccessValidator
-	mainURL             *url.URL
-	mainDomain          string
-	workloadProxyDomain string
+	next                 http.Handler
+	logger               *zap.Logger
+	proxyProvider        ProxyProvider
+	accessValidator      AccessValidator
+	customDomainResolver CustomDomainResolver
+	metrics              *handlerMetrics
+	mainURL              *url.URL
+	mainDomain           string
+	workloadProxyDomain  string
 }
 
 // NewHTTPHandler creates a new HTTP handler that will proxy requests to the workload proxy.
@@ -65,26 +85,36 @@ func NewHTTPHandler(next http.Handler, proxyProvider ProxyProvider, accessValida
 	mainDomain := getMainDomain(mainURL)
 	workloadProxyDomain := getWorkloadProxyDomain(workloadProxySubdomain, mainDomain)
 
+	// the custom domains are served only if the proxy provider knows about them
+	customDomainResolver, _ := proxyProvider.(CustomDomainResolver) //nolint:errcheck
+
 	return &HTTPHandler{
-		next:                next,
-		proxyProvider:       proxyProvider,
-		accessValidator:     accessValidator,
-		mainURL:             mainURL,
-		mainDomain:          mainDomain,
-		workloadProxyDomain: workloadProxyDomain,
-		logger:              logger,
+		next:                 next,
+		proxyProvider:        proxyProvider,
+		accessValidator:      accessValidator,
+		customDomainResolver: customDomainResolver,
+		metrics:              newHandlerMetrics(),
+		mainURL:              mainURL,
+		mainDomain:           mainDomain,
+		workloadProxyDomain:  workloadProxyDomain,
+		logger:               logger,
 	}, nil
 }
 
 // ServeHTTP implements http.Handler.
 func (h *HTTPHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
-	if !h.isWorkloadProxyRequest(request) {
-		h.next.ServeHTTP(writer, request)
+	alias, isCustomDomain := h.customDomainAlias(request)
 
-		return
+	if !isCustomDomain {
+		if !h.isWorkloadProxyRequest(request) {
+			h.next.ServeHTTP(writer, request)
+
+			return
+		}
+
+		alias = h.parseServiceAliasFromHost(request)
 	}
 
-	alias := h.parseServiceAliasFromHost(request)
 	if alias == "" {
 		http.NotFound(writer, request)
 
@@ -108,7 +138,33 @@ func (h *HTTPHandler) ServeHTTP(writer http.ResponseWriter, request *http.Reques
 		return
 	}
 
-	h.checkCookies(writer, request, proxy, clusterID)
+	service := ServiceRequest{
+		Alias:    alias,
+		ClientIP: clientIP(request),
+	}
+
+	if isCustomDomain && request.URL.Path == CustomDomainAuthPath {
+		h.setCustomDomainCookies(writer, request, clusterID, service)
+
+		return
+	}
+
+	h.checkCookies(writer, request, proxy, clusterID, service, isCustomDomain)
+}
+
+// customDomainAlias returns the alias of the exposed service if the request is for its custom domain.
+func (h *HTTPHandler) customDomainAlias(request *http.Request) (string, bool) {
+	if h.customDomainResolver == nil {
+		return "", false
+	}
+
+	host := strings.ToLower(requestHost(request))
+
+	if host == h.mainDomain {
+		return "", false
+	}
+
+	return h.customDomainResolver.AliasForCustomDomain(host)
 }
 
 // isWorkloadProxyRequest checks if the request is for the workload proxy.
@@ -117,11 +173,7 @@ func (h *HTTPHandler) ServeHTTP(writer http.ResponseWriter, request *http.Reques
 // - Legacy format: p-g3a4ana-demo.omni.siderolabs.io
 // - New format with a dedicated subdomain for all workload services: g3a4ana-demo.proxy-us.omni.siderolabs.io.
 func (h *HTTPHandler) isWorkloadProxyRequest(request *http.Request) bool {
-	host, _, _ := net.SplitHostPort(request.Host) //nolint:errcheck
-
-	if host == "" {
-		host = request.Host
-	}
+	host := requestHost(request)
 
 	if strings.HasSuffix(host, "."+h.workloadProxyDomain) {
 		return true
@@ -135,16 +187,17 @@ func (h *HTTPHandler) isWorkloadProxyRequest(request *http.Request) bool {
 	return false
 }
 
-func (h *HTTPHandler) checkCookies(writer http.ResponseWriter, request *http.Request, proxy http.Handler, clusterID resource.ID) {
+func (h *HTTPHandler) checkCookies(writer http.ResponseWriter, request *http.Request, proxy http.Handler, clusterID resource.ID, service ServiceRequest, isCustomDomain bool) {
 	publicKeyID, publicKeyIDSignatureBase64 := h.getSignatureCookies(request)
 	if publicKeyID == "" || publicKeyIDSignatureBase64 == "" {
-		h.redirectToLogin(writer, request)
+		h.redirectToLogin(writer, request, isCustomDomain)
 
 		return
 	}
 
-	if err := h.accessValidator.ValidateAccess(request.Context(), publicKeyID, publicKeyIDSignatureBase64, clusterID); err != nil {
-		h.logger.Warn("failed to validate access", zap.Error(err))
+	identity, err := h.accessValidator.ValidateAccess(request.Context(), publicKeyID, publicKeyIDSignatureBase64, clusterID, service)
+	if err != nil {
+		h.accessDenied(clusterID, service, publicKeyID, identity, err)
 
 		forbiddenURL := h.mainURL.JoinPath("/forbidden").String()
 
@@ -153,9 +206,49 @@ func (h *HTTPHandler) checkCookies(writer http.ResponseWriter, request *http.Req
 		return
 	}
 
-	proxy.ServeHTTP(writer, request)
+	metrics := httpsnoop.CaptureMetrics(proxy, writer, request)
+
+	h.metrics.observeRequest(clusterID, service.Alias, metrics.Code, metrics.Duration, metrics.Written)
+
+	h.logger.Info("workload proxy request",
+		zap.String("cluster", clusterID),
+		zap.String("alias", service.Alias),
+		zap.String("identity", identity),
+		zap.String("client_ip", service.ClientIP),
+		zap.String("method", request.Method),
+		zap.String("request_url", request.URL.RequestURI()),
+		zap.Int("status", metrics.Code),
+		zap.Duration("duration", metrics.Duration),
+		zap.Int64("response_length", metrics.Written),
+	)
+}
+
+// accessDenied logs and counts the denied request.
+func (h *HTTPHandler) accessDenied(clusterID resource.ID, service ServiceRequest, publicKeyID, identity string, err error) {
+	h.metrics.observeDenied(clusterID, service.Alias)
+
+	h.logger.Warn("failed to validate access",
+		zap.String("cluster", clusterID),
+		zap.String("alias", service.Alias),
+		zap.String("public_key_id", publicKeyID),
+		zap.String("identity", identity),
+		zap.String("client_ip", service.ClientIP),
+		zap.Error(err),
+	)
 }
 
+// Describe implements prometheus.Collector interface.
+func (h *HTTPHandler) Describe(ch chan<- *prometheus.Desc) {
+	h.metrics.Describe(ch)
+}
+
+// Collect implements prometheus.Collector interface.
+func (h *HTTPHandler) Collect(ch chan<- prometheus.Metric) {
+	h.metrics.Collect(ch)
+}
+
+var _ prometheus.Collector = &HTTPHandler{}
+
 // parseServiceAliasFromHost parses the service alias from the request host.
 //
 // The host will have the pattern: p-<alias>-<instance-name>.<main domain>.
@@ -205,7 +298,45 @@ func (h *HTTPHandler) getSignatureCookies(request *http.Request) (publicKeyID st
 	return publicKeyID, publicKeyIDSignatureBase64
 }
 
-func (h *HTTPHandler) redirectToLogin(writer http.ResponseWriter, request *http.Request) {
+// setCustomDomainCookies sets the authentication cookies passed in the query on the custom domain and redirects to the requested path.
+func (h *HTTPHandler) setCustomDomainCookies(writer http.ResponseWriter, request *http.Request, clusterID resource.ID, service ServiceRequest) {
+	query := request.URL.Query()
+
+	publicKeyID := query.Get(PublicKeyIDCookie)
+	publicKeyIDSignatureBase64 := query.Get(PublicKeyIDSignatureBase64Cookie)
+
+	if identity, err := h.accessValidator.ValidateAccess(request.Context(), publicKeyID, publicKeyIDSignatureBase64, clusterID, service); err != nil {
+		h.accessDenied(clusterID, service, publicKeyID, identity, err)
+
+		http.Redirect(writer, request, h.mainURL.JoinPath("/forbidden").String(), http.StatusSeeOther)
+
+		return
+	}
+
+	for name, value := range map[string]string{
+		PublicKeyIDCookie:                publicKeyID,
+		PublicKeyIDSignatureBase64Cookie: publicKeyIDSignatureBase64,
+	} {
+		http.SetCookie(writer, &http.Cookie{
+			Name:     name,
+			Value:    value,
+			Path:     "/",
+			Secure:   true,
+			HttpOnly: true,
+			SameSite: http.SameSiteLaxMode,
+		})
+	}
+
+	// allow only the local paths to avoid open redirects
+	redirect := query.Get(customDomainAuthRedirectQueryParam)
+	if !strings.HasPrefix(redirect, "/") || strings.HasPrefix(redirect, "//") || strings.HasPrefix(redirect, "/\\") {
+		redirect = "/"
+	}
+
+	http.Redirect(writer, request, redirect, http.StatusSeeOther)
+}
+
+func (h *HTTPHandler) redirectToLogin(writer http.ResponseWriter, request *http.Request, isCustomDomain bool) {
 	loginURL, err := url.Parse(config.Config.APIURL)
 	if err != nil {
 		h.logger.Warn("failed to redirect to login", zap.Error(err))
@@ -219,7 +350,12 @@ func (h *HTTPHandler) redirectToLogin(writer http.ResponseWriter, request *http.
 	reqURL.Scheme = "https"
 	reqURL.Host = request.Host
 
-	if reqURL.Port() == "" && loginURL.Port() != "" {
+	if isCustomDomain {
+		// the cookies are passed to the custom domain through the auth path after the login
+		reqURL.Path = CustomDomainAuthPath
+		reqURL.RawPath = ""
+		reqURL.RawQuery = url.Values{customDomainAuthRedirectQueryParam: []string{request.URL.RequestURI()}}.Encode()
+	} else if reqURL.Port() == "" && loginURL.Port() != "" {
 		reqURL.Host = fmt.Sprintf("%s:%s", request.Host, loginURL.Port())
 	}
 
@@ -233,6 +369,28 @@ func (h *HTTPHandler) redirectToLogin(writer http.ResponseWriter, request *http.
 	http.Redirect(writer, request, loginURL.String(), http.StatusSeeOther)
 }
 
+// requestHost returns the host of the request without the port.
+func requestHost(request *http.Request) string {
+	host, _, _ := net.SplitHostPort(request.Host) //nolint:errcheck
+
+	if host == "" {
+		host = request.Host
+	}
+
+	return host
+}
+
+// clientIP returns the address of the client, preferring the one set by the reverse proxy in front of Omni.
+func clientIP(request *http.Request) string {
+	if realIP := request.Header.Get("X-Real-IP"); realIP != "" {
+		return realIP
+	}
+
+	host, _, _ := net.SplitHostPort(request.RemoteAddr) //nolint:errcheck
+
+	return host
+}
+
 // getMainDomain returns the main domain from the given URL.
 //
 // Example: demo.omni.siderolabs.io.
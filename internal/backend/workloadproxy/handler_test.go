@@ -8,14 +8,18 @@ package workloadproxy_test
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 
@@ -41,14 +45,19 @@ type mockAccessValidator struct {
 	publicKeyIDs                []string
 	publicKeyIDSignatureBase64s []string
 	clusterIDs                  []resource.ID
+	services                    []workloadproxy.ServiceRequest
+	err                         error
 }
 
-func (m *mockAccessValidator) ValidateAccess(_ context.Context, publicKeyID, publicKeyIDSignatureBase64 string, clusterID resource.ID) error {
+func (m *mockAccessValidator) ValidateAccess(_ context.Context, publicKeyID, publicKeyIDSignatureBase64 string, clusterID resource.ID,
+	service workloadproxy.ServiceRequest,
+) (string, error) {
 	m.publicKeyIDs = append(m.publicKeyIDs, publicKeyID)
 	m.publicKeyIDSignatureBase64s = append(m.publicKeyIDSignatureBase64s, publicKeyIDSignatureBase64)
 	m.clusterIDs = append(m.clusterIDs, clusterID)
+	m.services = append(m.services, service)
 
-	return nil
+	return "test@example.com", m.err
 }
 
 type mockHandler struct {
@@ -193,6 +202,7 @@ func testSubdomainRequestWithCookies(ctx context.Context, t *testing.T, mainURL
 
 	req.AddCookie(&http.Cookie{Name: workloadproxy.PublicKeyIDCookie, Value: testPublicKeyID})
 	req.AddCookie(&http.Cookie{Name: workloadproxy.PublicKeyIDSignatureBase64Cookie, Value: testPublicKeyIDSignatureBase64})
+	req.Header.Set("X-Real-IP", "10.5.0.1")
 
 	handler.ServeHTTP(rr, req)
 
@@ -203,4 +213,149 @@ func testSubdomainRequestWithCookies(ctx context.Context, t *testing.T, mainURL
 	require.Equal(t, []string{testPublicKeyID}, accessValidator.publicKeyIDs)
 	require.Equal(t, []string{testPublicKeyIDSignatureBase64}, accessValidator.publicKeyIDSignatureBase64s)
 	require.Equal(t, []resource.ID{"test-cluster"}, accessValidator.clusterIDs)
+	require.Equal(t, []workloadproxy.ServiceRequest{{Alias: testServiceAlias, ClientIP: "10.5.0.1"}}, accessValidator.services)
+}
+
+type mockCustomDomainProxyProvider struct {
+	mockProxyProvider
+
+	customDomains map[string]string
+}
+
+func (m *mockCustomDomainProxyProvider) AliasForCustomDomain(domain string) (string, bool) {
+	alias, ok := m.customDomains[domain]
+
+	return alias, ok
+}
+
+func TestHandlerCustomDomain(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	mainURL, err := url.Parse("https://instanceid.example.com")
+	require.NoError(t, err)
+
+	next := &mockHandler{}
+	proxyProvider := &mockCustomDomainProxyProvider{
+		customDomains: map[string]string{
+			"grafana.example.org": "testsvc",
+		},
+	}
+	accessValidator := &mockAccessValidator{}
+
+	handler, err := workloadproxy.NewHTTPHandler(next, proxyProvider, accessValidator, mainURL, "proxy-us", zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	// without cookies, redirected to the login with the auth path of the custom domain as the redirect
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://grafana.example.org/dashboards?orgId=1", nil)
+	require.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+
+	handler.ServeHTTP(rr, req)
+
+	require.Equal(t, http.StatusSeeOther, rr.Code)
+
+	loginURL, err := url.Parse(rr.Header().Get("Location"))
+	require.NoError(t, err)
+
+	require.Equal(t, "https://grafana.example.org"+workloadproxy.CustomDomainAuthPath+"?redirect=%2Fdashboards%3ForgId%3D1", loginURL.Query().Get("redirect"))
+
+	// the auth path sets the cookies passed in the query
+	testPublicKeyID := "test-public-key-id"
+	testPublicKeyIDSignatureBase64 := base64.StdEncoding.EncodeToString([]byte("test-signed-public-key-id"))
+
+	query := url.Values{
+		workloadproxy.PublicKeyIDCookie:                {testPublicKeyID},
+		workloadproxy.PublicKeyIDSignatureBase64Cookie: {testPublicKeyIDSignatureBase64},
+		"redirect": {"/dashboards?orgId=1"},
+	}
+
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, "https://grafana.example.org"+workloadproxy.CustomDomainAuthPath+"?"+query.Encode(), nil)
+	require.NoError(t, err)
+
+	rr = httptest.NewRecorder()
+
+	handler.ServeHTTP(rr, req)
+
+	require.Equal(t, http.StatusSeeOther, rr.Code)
+	require.Equal(t, "/dashboards?orgId=1", rr.Header().Get("Location"))
+	require.Len(t, rr.Result().Cookies(), 2) //nolint:bodyclose
+
+	// the request with the cookies is proxied
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, "https://grafana.example.org/dashboards", nil)
+	require.NoError(t, err)
+
+	for _, cookie := range rr.Result().Cookies() { //nolint:bodyclose
+		req.AddCookie(cookie)
+	}
+
+	rr = httptest.NewRecorder()
+
+	handler.ServeHTTP(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Equal(t, []string{"testsvc", "testsvc", "testsvc"}, proxyProvider.aliases)
+	require.Equal(t, []string{testPublicKeyID, testPublicKeyID}, accessValidator.publicKeyIDs)
+
+	// the other domains are passed through
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, "https://argocd.example.org/", nil)
+	require.NoError(t, err)
+
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	require.Len(t, next.requests, 1)
+}
+
+func TestHandlerMetrics(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	mainURL, err := url.Parse("https://instanceid.example.com")
+	require.NoError(t, err)
+
+	accessValidator := &mockAccessValidator{}
+
+	handler, err := workloadproxy.NewHTTPHandler(&mockHandler{}, &mockProxyProvider{}, accessValidator, mainURL, "proxy-us", zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	serve := func() {
+		req, reqErr := http.NewRequestWithContext(ctx, http.MethodGet, "https://testsvc-instanceid.proxy-us.example.com/example", nil)
+		require.NoError(t, reqErr)
+
+		req.AddCookie(&http.Cookie{Name: workloadproxy.PublicKeyIDCookie, Value: "test-public-key-id"})
+		req.AddCookie(&http.Cookie{Name: workloadproxy.PublicKeyIDSignatureBase64Cookie, Value: "dGVzdA=="})
+
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	serve()
+	serve()
+
+	// two successful requests, each of them gets the response written by the mock proxy
+	responseBytes := 2 * len("alias: testsvc")
+
+	accessValidator.err = errors.New("access denied")
+
+	serve()
+
+	require.NoError(t, testutil.CollectAndCompare(handler, strings.NewReader(`
+# HELP omni_workload_proxy_access_denied_total Number of the requests to the exposed services which were denied.
+# TYPE omni_workload_proxy_access_denied_total counter
+omni_workload_proxy_access_denied_total{alias="testsvc",cluster="test-cluster"} 1
+# HELP omni_workload_proxy_requests_total Number of the requests proxied to the exposed services.
+# TYPE omni_workload_proxy_requests_total counter
+omni_workload_proxy_requests_total{alias="testsvc",cluster="test-cluster",code="200"} 2
+# HELP omni_workload_proxy_response_bytes_total Number of the bytes sent to the clients by the exposed services.
+# TYPE omni_workload_proxy_response_bytes_total counter
+omni_workload_proxy_response_bytes_total{alias="testsvc",cluster="test-cluster"} `+strconv.Itoa(responseBytes)+`
+`),
+		"omni_workload_proxy_access_denied_total",
+		"omni_workload_proxy_requests_total",
+		"omni_workload_proxy_response_bytes_total",
+	))
 }
@@ -0,0 +1,194 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package workloadproxy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"go.uber.org/zap"
+)
+
+const maxHealthCheckTimeout = 5 * time.Second
+
+// HealthCheck is the configuration of the exposed service health check.
+type HealthCheck struct {
+	// Path is the HTTP path probed through the load balancer of the service.
+	Path string
+
+	// Interval is the interval between the probes.
+	Interval time.Duration
+}
+
+// ServiceHealth is the result of the exposed service health check.
+type ServiceHealth struct {
+	// Error is the reason of the failed health check.
+	Error string
+
+	Healthy bool
+}
+
+type healthChecker struct {
+	cancel  context.CancelFunc
+	health  ServiceHealth
+	check   HealthCheck
+	checked bool
+}
+
+// ReconcileHealthChecks reconciles the health checks of the exposed services of a cluster.
+//
+// The results are available through ServiceHealth, and the cluster is sent to the HealthUpdates channel when they change.
+func (registry *Reconciler) ReconcileHealthChecks(cluster resource.ID, aliasToHealthCheck map[string]HealthCheck) error {
+	registry.logger.Log(registry.logLevel, "reconcile health checks", zap.String("cluster", cluster))
+
+	for alias, check := range aliasToHealthCheck {
+		if check.Interval <= 0 {
+			return fmt.Errorf("invalid health check interval %s for %q/%q", check.Interval, cluster, alias)
+		}
+	}
+
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	checkers := registry.healthCheckers[cluster]
+
+	for alias, checker := range checkers {
+		if check, ok := aliasToHealthCheck[alias]; ok && check == checker.check {
+			continue
+		}
+
+		checker.cancel()
+
+		delete(checkers, alias)
+	}
+
+	for alias, check := range aliasToHealthCheck {
+		if _, ok := checkers[alias]; ok {
+			continue
+		}
+
+		if checkers == nil {
+			checkers = map[string]*healthChecker{}
+
+			registry.healthCheckers[cluster] = checkers
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		checker := &healthChecker{
+			cancel: cancel,
+			check:  check,
+		}
+
+		checkers[alias] = checker
+
+		go registry.runHealthChecker(ctx, cluster, alias, checker)
+	}
+
+	if len(checkers) == 0 {
+		delete(registry.healthCheckers, cluster)
+	}
+
+	return nil
+}
+
+// ServiceHealth returns the result of the latest health check of the exposed service, false if it wasn't checked yet.
+func (registry *Reconciler) ServiceHealth(cluster resource.ID, alias string) (ServiceHealth, bool) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	checker := registry.healthCheckers[cluster][alias]
+	if checker == nil || !checker.checked {
+		return ServiceHealth{}, false
+	}
+
+	return checker.health, true
+}
+
+// HealthUpdates returns the channel receiving the clusters with the changed health of the exposed services.
+func (registry *Reconciler) HealthUpdates() <-chan resource.ID {
+	return registry.healthUpdateCh
+}
+
+func (registry *Reconciler) runHealthChecker(ctx context.Context, cluster resource.ID, alias string, checker *healthChecker) {
+	client := &http.Client{
+		Transport: &http.Transport{
+			DialContext: registry.connProvider.DialContext,
+		},
+		// the redirects are considered healthy, and they might point outside of the cluster
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+		Timeout: min(checker.check.Interval, maxHealthCheckTimeout),
+	}
+
+	defer client.CloseIdleConnections()
+
+	target := "http://" + registry.hostPortForAlias(cluster, alias) + checker.check.Path
+
+	ticker := time.NewTicker(checker.check.Interval)
+	defer ticker.Stop()
+
+	for {
+		health := probe(ctx, client, target)
+
+		registry.mu.Lock()
+
+		// the checker was stopped while probing, the result is not relevant anymore
+		if ctx.Err() != nil {
+			registry.mu.Unlock()
+
+			return
+		}
+
+		changed := !checker.checked || checker.health != health
+
+		checker.health = health
+		checker.checked = true
+
+		registry.mu.Unlock()
+
+		if changed {
+			registry.logger.Log(registry.logLevel, "exposed service health changed",
+				zap.String("cluster", cluster), zap.String("alias", alias), zap.Bool("healthy", health.Healthy), zap.String("error", health.Error))
+
+			select {
+			case registry.healthUpdateCh <- cluster:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func probe(ctx context.Context, client *http.Client, target string) ServiceHealth {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return ServiceHealth{Error: err.Error()}
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return ServiceHealth{Error: err.Error()}
+	}
+
+	resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return ServiceHealth{Error: fmt.Sprintf("unexpected status code %d", resp.StatusCode)}
+	}
+
+	return ServiceHealth{Healthy: true}
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package workloadproxy
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// handlerMetrics are the usage metrics of the exposed services, partitioned by the cluster and the service alias.
+type handlerMetrics struct {
+	requests      *prometheus.CounterVec
+	deniedTotal   *prometheus.CounterVec
+	duration      *prometheus.HistogramVec
+	responseBytes *prometheus.CounterVec
+}
+
+func newHandlerMetrics() *handlerMetrics {
+	return &handlerMetrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "omni_workload_proxy_requests_total",
+			Help: "Number of the requests proxied to the exposed services.",
+		}, []string{"cluster", "alias", "code"}),
+		deniedTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "omni_workload_proxy_access_denied_total",
+			Help: "Number of the requests to the exposed services which were denied.",
+		}, []string{"cluster", "alias"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "omni_workload_proxy_request_duration_seconds",
+			Help:    "Latency of the requests proxied to the exposed services.",
+			Buckets: []float64{.01, .05, .1, .25, .5, 1, 2.5, 5, 10},
+		}, []string{"cluster", "alias"}),
+		responseBytes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "omni_workload_proxy_response_bytes_total",
+			Help: "Number of the bytes sent to the clients by the exposed services.",
+		}, []string{"cluster", "alias"}),
+	}
+}
+
+func (m *handlerMetrics) observeRequest(clusterID, alias string, code int, duration time.Duration, written int64) {
+	m.requests.WithLabelValues(clusterID, alias, strconv.Itoa(code)).Inc()
+	m.duration.WithLabelValues(clusterID, alias).Observe(duration.Seconds())
+	m.responseBytes.WithLabelValues(clusterID, alias).Add(float64(written))
+}
+
+func (m *handlerMetrics) observeDenied(clusterID, alias string) {
+	m.deniedTotal.WithLabelValues(clusterID, alias).Inc()
+}
+
+// Describe implements prometheus.Collector interface.
+func (m *handlerMetrics) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(m, ch)
+}
+
+// Collect implements prometheus.Collector interface.
+func (m *handlerMetrics) Collect(ch chan<- prometheus.Metric) {
+	m.requests.Collect(ch)
+	m.deniedTotal.Collect(ch)
+	m.duration.Collect(ch)
+	m.responseBytes.Collect(ch)
+}
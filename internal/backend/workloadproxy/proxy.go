@@ -0,0 +1,152 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package workloadproxy
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	upstreamDialTimeout = time.Second
+	idleConnTimeout     = 90 * time.Second
+)
+
+type dialFunc func(ctx context.Context, network, address string) (net.Conn, error)
+
+// newTransport creates the transport to the exposed services, the connections are closed after the idle timeout.
+//
+// The upgraded connections (WebSockets) are hijacked from the transport, so the idle timeout is applied on the connection level.
+func newTransport(dial dialFunc, idleTimeout time.Duration) *http.Transport {
+	return &http.Transport{
+		DialContext: func(ctx context.Context, network, address string) (net.Conn, error) {
+			conn, err := dial(ctx, network, address)
+			if err != nil {
+				return nil, err
+			}
+
+			if idleTimeout <= 0 {
+				return conn, nil
+			}
+
+			return &idleTimeoutConn{Conn: conn, timeout: idleTimeout}, nil
+		},
+		IdleConnTimeout: idleConnTimeout,
+	}
+}
+
+// newReverseProxy creates the reverse proxy to the target.
+//
+// The responses are flushed immediately to support the streaming responses of the interactive applications.
+func newReverseProxy(target *url.URL, transport http.RoundTripper) *httputil.ReverseProxy {
+	proxy := httputil.NewSingleHostReverseProxy(target)
+	proxy.Transport = transport
+	proxy.FlushInterval = -1
+
+	return proxy
+}
+
+// idleTimeoutConn is a connection which is closed if there is no traffic during the timeout.
+type idleTimeoutConn struct {
+	net.Conn
+
+	timeout time.Duration
+}
+
+func (c *idleTimeoutConn) Read(b []byte) (int, error) {
+	if err := c.Conn.SetDeadline(time.Now().Add(c.timeout)); err != nil {
+		return 0, err
+	}
+
+	return c.Conn.Read(b)
+}
+
+func (c *idleTimeoutConn) Write(b []byte) (int, error) {
+	if err := c.Conn.SetDeadline(time.Now().Add(c.timeout)); err != nil {
+		return 0, err
+	}
+
+	return c.Conn.Write(b)
+}
+
+// stickySessionProxy proxies the requests to the upstream pinned by the StickySessionCookie, bypassing the load balancer.
+//
+// A random upstream is picked and pinned if the cookie is missing or the upstream is gone.
+type stickySessionProxy struct {
+	transport http.RoundTripper
+	logger    *zap.Logger
+	upstreams []string
+}
+
+// ServeHTTP implements http.Handler.
+func (p *stickySessionProxy) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if len(p.upstreams) == 0 {
+		http.Error(writer, "no upstreams available", http.StatusBadGateway)
+
+		return
+	}
+
+	upstream := p.pinnedUpstream(request)
+	if upstream == "" {
+		upstream = p.upstreams[rand.IntN(len(p.upstreams))]
+
+		http.SetCookie(writer, &http.Cookie{
+			Name:     StickySessionCookie,
+			Value:    upstreamHash(upstream),
+			Path:     "/",
+			Secure:   true,
+			HttpOnly: true,
+			SameSite: http.SameSiteLaxMode,
+		})
+	}
+
+	proxy := newReverseProxy(&url.URL{Scheme: "http", Host: upstream}, p.transport)
+	proxy.ErrorHandler = func(writer http.ResponseWriter, _ *http.Request, err error) {
+		p.logger.Warn("failed to proxy the request to the pinned upstream", zap.Error(err))
+
+		// unpin the upstream, so that the next request picks another one
+		http.SetCookie(writer, &http.Cookie{
+			Name:   StickySessionCookie,
+			Path:   "/",
+			MaxAge: -1,
+		})
+
+		writer.WriteHeader(http.StatusBadGateway)
+	}
+
+	proxy.ServeHTTP(writer, request)
+}
+
+func (p *stickySessionProxy) pinnedUpstream(request *http.Request) string {
+	cookie, err := request.Cookie(StickySessionCookie)
+	if err != nil {
+		return ""
+	}
+
+	for _, upstream := range p.upstreams {
+		if upstreamHash(upstream) == cookie.Value {
+			return upstream
+		}
+	}
+
+	return ""
+}
+
+// upstreamHash returns the value of the StickySessionCookie for the upstream, so that the upstream addresses are not exposed to the clients.
+func upstreamHash(upstream string) string {
+	hash := sha256.Sum256([]byte(upstream))
+
+	return hex.EncodeToString(hash[:8])
+}
@@ -7,8 +7,8 @@ package workloadproxy
 
 import (
 	"fmt"
+	"net"
 	"net/http"
-	"net/http/httputil"
 	"net/url"
 	"slices"
 	"sync"
@@ -29,19 +29,45 @@ type lbStatus struct {
 	upstreamAddresses []string
 }
 
+type customDomainTarget struct {
+	cluster resource.ID
+	alias   string
+}
+
 // Reconciler reconciles the load balancers for a cluster.
 type Reconciler struct {
 	clusterToAliasToLBStatus map[resource.ID]map[string]*lbStatus
 	aliasToCluster           map[string]resource.ID
+	customDomains            map[string]customDomainTarget
+	healthCheckers           map[resource.ID]map[string]*healthChecker
+	stickySessions           map[resource.ID]map[string]struct{}
+	healthUpdateCh           chan resource.ID
+
+	// lbTransport is used for the requests balanced by the load balancers, upstreamTransport for the requests pinned to an upstream.
+	lbTransport       *http.Transport
+	upstreamTransport *http.Transport
 
 	connProvider *memconn.Provider
 	logger       *zap.Logger
 	logLevel     zapcore.Level
+	idleTimeout  time.Duration
 	mu           sync.Mutex
 }
 
+// ReconcilerOption is a functional option for Reconciler.
+type ReconcilerOption func(*Reconciler)
+
+// WithIdleTimeout sets the time without traffic after which the connections to the exposed services are closed.
+//
+// The connections are not closed on idle if the timeout is zero.
+func WithIdleTimeout(timeout time.Duration) ReconcilerOption {
+	return func(registry *Reconciler) {
+		registry.idleTimeout = timeout
+	}
+}
+
 // NewReconciler creates a new Reconciler.
-func NewReconciler(logger *zap.Logger, logLevel zapcore.Level) *Reconciler {
+func NewReconciler(logger *zap.Logger, logLevel zapcore.Level, opts ...ReconcilerOption) *Reconciler {
 	// use an in-memory transport for the connections to the load balancer
 	provider := &memconn.Provider{}
 
@@ -51,13 +77,26 @@ func NewReconciler(logger *zap.Logger, logLevel zapcore.Level) *Reconciler {
 		logger = zap.NewNop()
 	}
 
-	return &Reconciler{
+	registry := &Reconciler{
 		clusterToAliasToLBStatus: map[resource.ID]map[string]*lbStatus{},
 		aliasToCluster:           map[string]resource.ID{},
+		customDomains:            map[string]customDomainTarget{},
+		healthCheckers:           map[resource.ID]map[string]*healthChecker{},
+		stickySessions:           map[resource.ID]map[string]struct{}{},
+		healthUpdateCh:           make(chan resource.ID),
 		connProvider:             provider,
 		logger:                   logger,
 		logLevel:                 logLevel,
 	}
+
+	for _, opt := range opts {
+		opt(registry)
+	}
+
+	registry.lbTransport = newTransport(provider.DialContext, registry.idleTimeout)
+	registry.upstreamTransport = newTransport((&net.Dialer{Timeout: upstreamDialTimeout}).DialContext, registry.idleTimeout)
+
+	return registry
 }
 
 // Reconcile reconciles the workload proxy load balancers for a cluster.
@@ -93,6 +132,77 @@ func (registry *Reconciler) Reconcile(cluster resource.ID, aliasToUpstreamAddres
 	return errs
 }
 
+// ReconcileCustomDomains reconciles the custom domains of the exposed services of a cluster.
+//
+// The custom domains already used by another cluster are not taken over.
+func (registry *Reconciler) ReconcileCustomDomains(cluster resource.ID, customDomainToAlias map[string]string) error {
+	registry.logger.Log(registry.logLevel, "reconcile custom domains", zap.String("cluster", cluster))
+
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	for domain, target := range registry.customDomains {
+		if target.cluster != cluster {
+			continue
+		}
+
+		if _, ok := customDomainToAlias[domain]; !ok {
+			delete(registry.customDomains, domain)
+		}
+	}
+
+	var errs error
+
+	for domain, alias := range customDomainToAlias {
+		if target, ok := registry.customDomains[domain]; ok && target.cluster != cluster {
+			errs = multierror.Append(errs, fmt.Errorf("custom domain %q is already used by cluster %q", domain, target.cluster))
+
+			continue
+		}
+
+		registry.customDomains[domain] = customDomainTarget{
+			cluster: cluster,
+			alias:   alias,
+		}
+	}
+
+	return errs
+}
+
+// ReconcileStickySessions reconciles the exposed services of a cluster which pin the clients to the same upstream.
+func (registry *Reconciler) ReconcileStickySessions(cluster resource.ID, aliases []string) error {
+	registry.logger.Log(registry.logLevel, "reconcile sticky sessions", zap.String("cluster", cluster))
+
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	if len(aliases) == 0 {
+		delete(registry.stickySessions, cluster)
+
+		return nil
+	}
+
+	stickyAliases := make(map[string]struct{}, len(aliases))
+
+	for _, alias := range aliases {
+		stickyAliases[alias] = struct{}{}
+	}
+
+	registry.stickySessions[cluster] = stickyAliases
+
+	return nil
+}
+
+// AliasForCustomDomain returns the alias of the exposed service using the given custom domain.
+func (registry *Reconciler) AliasForCustomDomain(domain string) (string, bool) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	target, ok := registry.customDomains[domain]
+
+	return target.alias, ok
+}
+
 // ensureLB ensures that a load balancer exists and started for the given cluster and alias, targeting the given upstream addresses.
 func (registry *Reconciler) ensureLB(lbSts *lbStatus, cluster resource.ID, alias string, upstreamAddresses []string) error {
 	registry.logger.Log(registry.logLevel, "ensure LB", zap.String("cluster", cluster), zap.String("alias", alias), zap.Strings("upstreamAddresses", upstreamAddresses))
@@ -112,7 +222,7 @@ func (registry *Reconciler) ensureLB(lbSts *lbStatus, cluster resource.ID, alias
 				Proxy: tcpproxy.Proxy{
 					ListenFunc: registry.connProvider.Listen,
 				},
-				DialTimeout:    1 * time.Second,
+				DialTimeout:    upstreamDialTimeout,
 				TCPUserTimeout: 5 * time.Second,
 			}
 
@@ -164,6 +274,14 @@ func (registry *Reconciler) GetProxy(alias string) (http.Handler, resource.ID, e
 		return nil, "", nil
 	}
 
+	if _, sticky := registry.stickySessions[clusterID][alias]; sticky {
+		return &stickySessionProxy{
+			upstreams: lbSts.upstreamAddresses,
+			transport: registry.upstreamTransport,
+			logger:    registry.logger,
+		}, clusterID, nil
+	}
+
 	hostPort := registry.hostPortForAlias(clusterID, alias)
 
 	targetURL := &url.URL{
@@ -171,12 +289,7 @@ func (registry *Reconciler) GetProxy(alias string) (http.Handler, resource.ID, e
 		Host:   hostPort,
 	}
 
-	proxy := httputil.NewSingleHostReverseProxy(targetURL)
-	proxy.Transport = &http.Transport{
-		DialContext: registry.connProvider.DialContext,
-	}
-
-	return proxy, clusterID, nil
+	return newReverseProxy(targetURL, registry.lbTransport), clusterID, nil
 }
 
 func (registry *Reconciler) removeLB(cluster resource.ID, alias string) error {
@@ -6,7 +6,12 @@
 package workloadproxy_test
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zapcore"
@@ -59,3 +64,173 @@ func TestReconciler(t *testing.T) {
 	require.Nil(t, proxy)
 	require.Zero(t, id)
 }
+
+func TestReconcilerCustomDomains(t *testing.T) {
+	t.Parallel()
+
+	reconciler := workloadproxy.NewReconciler(zaptest.NewLogger(t), zapcore.InfoLevel)
+
+	require.NoError(t, reconciler.ReconcileCustomDomains("cluster1", map[string]string{
+		"grafana.example.org": "alias1",
+	}))
+
+	alias, ok := reconciler.AliasForCustomDomain("grafana.example.org")
+	require.True(t, ok)
+	require.Equal(t, "alias1", alias)
+
+	// the domain is not taken over by another cluster
+	require.Error(t, reconciler.ReconcileCustomDomains("cluster2", map[string]string{
+		"grafana.example.org": "alias2",
+		"argocd.example.org":  "alias3",
+	}))
+
+	alias, ok = reconciler.AliasForCustomDomain("grafana.example.org")
+	require.True(t, ok)
+	require.Equal(t, "alias1", alias)
+
+	alias, ok = reconciler.AliasForCustomDomain("argocd.example.org")
+	require.True(t, ok)
+	require.Equal(t, "alias3", alias)
+
+	require.NoError(t, reconciler.ReconcileCustomDomains("cluster1", nil))
+
+	_, ok = reconciler.AliasForCustomDomain("grafana.example.org")
+	require.False(t, ok)
+}
+
+func TestReconcilerHealthChecks(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/healthz" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	t.Cleanup(server.Close)
+
+	reconciler := workloadproxy.NewReconciler(zaptest.NewLogger(t), zapcore.InfoLevel)
+
+	require.NoError(t, reconciler.Reconcile("cluster1", map[string][]string{
+		"alias1": {server.Listener.Addr().String()},
+	}))
+
+	_, ok := reconciler.ServiceHealth("cluster1", "alias1")
+	require.False(t, ok)
+
+	awaitHealth := func(expectedHealthy bool) workloadproxy.ServiceHealth {
+		for {
+			select {
+			case <-ctx.Done():
+				require.FailNow(t, "timeout waiting for the health update")
+			case cluster := <-reconciler.HealthUpdates():
+				require.Equal(t, "cluster1", cluster)
+			}
+
+			health, checked := reconciler.ServiceHealth("cluster1", "alias1")
+			if checked && health.Healthy == expectedHealthy {
+				return health
+			}
+		}
+	}
+
+	require.NoError(t, reconciler.ReconcileHealthChecks("cluster1", map[string]workloadproxy.HealthCheck{
+		"alias1": {Path: "/healthz", Interval: 100 * time.Millisecond},
+	}))
+
+	require.Empty(t, awaitHealth(true).Error)
+
+	require.NoError(t, reconciler.ReconcileHealthChecks("cluster1", map[string]workloadproxy.HealthCheck{
+		"alias1": {Path: "/ready", Interval: 100 * time.Millisecond},
+	}))
+
+	require.Equal(t, "unexpected status code 503", awaitHealth(false).Error)
+
+	// invalid health checks are rejected without affecting the running ones
+	require.Error(t, reconciler.ReconcileHealthChecks("cluster1", map[string]workloadproxy.HealthCheck{
+		"alias1": {Path: "/healthz"},
+	}))
+
+	health, ok := reconciler.ServiceHealth("cluster1", "alias1")
+	require.True(t, ok)
+	require.False(t, health.Healthy)
+
+	require.NoError(t, reconciler.ReconcileHealthChecks("cluster1", nil))
+
+	_, ok = reconciler.ServiceHealth("cluster1", "alias1")
+	require.False(t, ok)
+
+	require.NoError(t, reconciler.Reconcile("cluster1", nil))
+}
+
+func TestReconcilerStickySessions(t *testing.T) {
+	t.Parallel()
+
+	upstreams := make([]string, 0, 2)
+
+	for i := range 2 {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.Write([]byte(strconv.Itoa(i))) //nolint:errcheck
+		}))
+
+		t.Cleanup(server.Close)
+
+		upstreams = append(upstreams, server.Listener.Addr().String())
+	}
+
+	reconciler := workloadproxy.NewReconciler(zaptest.NewLogger(t), zapcore.InfoLevel, workloadproxy.WithIdleTimeout(time.Minute))
+
+	require.NoError(t, reconciler.Reconcile("cluster1", map[string][]string{
+		"alias1": upstreams,
+	}))
+
+	require.NoError(t, reconciler.ReconcileStickySessions("cluster1", []string{"alias1"}))
+
+	proxy, _, err := reconciler.GetProxy("alias1")
+	require.NoError(t, err)
+
+	serve := func(cookies ...*http.Cookie) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "https://alias1.example.com/", nil)
+
+		for _, cookie := range cookies {
+			req.AddCookie(cookie)
+		}
+
+		rr := httptest.NewRecorder()
+
+		proxy.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		return rr
+	}
+
+	rr := serve()
+
+	cookies := rr.Result().Cookies() //nolint:bodyclose
+	require.Len(t, cookies, 1)
+	require.Equal(t, workloadproxy.StickySessionCookie, cookies[0].Name)
+
+	pinned := rr.Body.String()
+
+	for range 10 {
+		rr = serve(cookies[0])
+
+		require.Equal(t, pinned, rr.Body.String())
+		require.Empty(t, rr.Result().Cookies()) //nolint:bodyclose
+	}
+
+	// the unknown upstream is replaced
+	rr = serve(&http.Cookie{Name: workloadproxy.StickySessionCookie, Value: "unknown"})
+
+	require.Len(t, rr.Result().Cookies(), 1) //nolint:bodyclose
+
+	require.NoError(t, reconciler.Reconcile("cluster1", nil))
+}
@@ -19,4 +19,17 @@ const (
 	//
 	// tsgen:workloadProxyPublicKeyIdSignatureBase64Cookie
 	PublicKeyIDSignatureBase64Cookie = "publicKeyIdSignatureBase64"
+
+	// CustomDomainAuthPath is the path on the custom domains of the exposed services which sets the authentication cookies.
+	//
+	// The cookies set by Omni Web on its parent domain are not sent to the custom domains, so they are passed in the query instead.
+	//
+	// tsgen:workloadProxyCustomDomainAuthPath
+	CustomDomainAuthPath = "/_omni/workload-proxy-auth"
+
+	// CustomDomainAuthRedirectQueryParam is the query parameter of the CustomDomainAuthPath containing the path to redirect to.
+	customDomainAuthRedirectQueryParam = "redirect"
+
+	// StickySessionCookie is the name of the cookie which pins the client to an upstream of the exposed service with the sticky sessions.
+	StickySessionCookie = "omniWorkloadProxyUpstream"
 )
@@ -7,10 +7,14 @@ package auth0
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/mail"
 	"net/url"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/auth0/go-jwt-middleware/v2/jwks"
@@ -80,6 +84,31 @@ func (v *IDTokenVerifier) Verify(ctx context.Context, token string) (*jwt.Claims
 	}, nil
 }
 
+// MFAVerified checks if the ID token was issued after the multi-factor authentication.
+//
+// Only the authentication methods claim is read, the token must be verified beforehand.
+func MFAVerified(token string) bool {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return false
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return false
+	}
+
+	var claims struct {
+		AMR []string `json:"amr"`
+	}
+
+	if err = json.Unmarshal(payload, &claims); err != nil {
+		return false
+	}
+
+	return slices.Contains(claims.AMR, "mfa")
+}
+
 // IDClaims represents the claims of an ID token.
 type IDClaims struct {
 	validator.RegisteredClaims
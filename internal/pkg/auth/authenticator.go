@@ -7,18 +7,25 @@ package auth
 
 import (
 	"context"
+	"errors"
 
 	"github.com/siderolabs/go-api-signature/pkg/message"
 
 	"github.com/siderolabs/omni/internal/pkg/auth/role"
 )
 
+// ErrPublicKeyExpired is returned by the AuthenticatorFunc when the public key is expired.
+var ErrPublicKeyExpired = errors.New("public key expired")
+
 // Authenticator represents an authenticator.
 type Authenticator struct {
 	Verifier message.SignatureVerifier
 	Identity string
 	UserID   string
 	Role     role.Role
+
+	// Cluster is the cluster the identity is restricted to, empty if the identity is not restricted.
+	Cluster string
 }
 
 // AuthenticatorFunc represents a function that returns an authenticator for the given public key fingerprint.
@@ -0,0 +1,221 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package breakglass implements the verification of the break-glass access token requests.
+//
+// The request must have two factors: the break-glass secret kept by the operators,
+// and the current TOTP code generated by an authenticator app from the seed shared with the server.
+package breakglass
+
+import (
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec // RFC 6238 uses HMAC-SHA1, which is what the authenticator apps support
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	totpPeriod = 30 * time.Second
+	totpDigits = 6
+
+	// totpSkew is the number of the periods before and after the current one the codes are accepted for, to tolerate the clock drift.
+	totpSkew = 1
+
+	// failureBurst is the number of the failed attempts a source address can make in a row before its requests are rejected.
+	failureBurst = 5
+
+	// failureRefill is the interval after which a source address is allowed one more failed attempt.
+	failureRefill = 12 * time.Second
+
+	// sweepInterval is the interval between the removals of the source addresses which are allowed failureBurst attempts again.
+	sweepInterval = time.Minute
+)
+
+var (
+	// ErrDenied is returned when either the secret or the TOTP code doesn't match, it doesn't tell which one.
+	ErrDenied = errors.New("invalid break-glass secret or TOTP code")
+
+	// ErrLockedOut is returned when the requests of a source address are rejected after too many failed attempts.
+	ErrLockedOut = errors.New("too many failed break-glass attempts, try again later")
+)
+
+// failures is the token bucket of the failed attempts of a source address.
+type failures struct {
+	updated time.Time
+	tokens  float64
+}
+
+// Verifier checks the secret and the TOTP code of the break-glass token requests.
+//
+// The files are read on each request, so that the secret and the seed can be rotated without a restart.
+// The failed attempts are limited for each source address, so that a client guessing the factors can't lock out the others.
+type Verifier struct {
+	lastSweep    time.Time
+	failures     map[string]*failures
+	now          func() time.Time
+	secretPath   string
+	totpSeedPath string
+	// lastCounter is the TOTP counter of the latest accepted code, the same code can't be used twice.
+	lastCounter int64
+	mu          sync.Mutex
+}
+
+// NewVerifier creates a new Verifier reading the secret and the base32 encoded TOTP seed from the given files.
+func NewVerifier(secretPath, totpSeedPath string) *Verifier {
+	return &Verifier{
+		failures:     map[string]*failures{},
+		secretPath:   secretPath,
+		totpSeedPath: totpSeedPath,
+		now:          time.Now,
+	}
+}
+
+// Verify checks the secret and the TOTP code of the request made from the source address.
+func (v *Verifier) Verify(source, secret, totpCode string) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	now := v.now()
+
+	if now.Sub(v.lastSweep) >= sweepInterval {
+		v.sweep(now)
+	}
+
+	f, ok := v.failures[source]
+	if !ok {
+		f = &failures{
+			tokens:  failureBurst,
+			updated: now,
+		}
+
+		v.failures[source] = f
+	}
+
+	f.tokens = min(failureBurst, f.tokens+float64(now.Sub(f.updated))/float64(failureRefill))
+	f.updated = now
+
+	if f.tokens < 1 {
+		return ErrLockedOut
+	}
+
+	counter, err := v.verify(secret, totpCode, now)
+	if err != nil {
+		if errors.Is(err, ErrDenied) {
+			f.tokens--
+		}
+
+		return err
+	}
+
+	delete(v.failures, source)
+
+	v.lastCounter = counter
+
+	return nil
+}
+
+// sweep removes the source addresses which are allowed failureBurst attempts again, they are recreated on the next attempt.
+func (v *Verifier) sweep(now time.Time) {
+	v.lastSweep = now
+
+	for source, f := range v.failures {
+		if f.tokens+float64(now.Sub(f.updated))/float64(failureRefill) >= failureBurst {
+			delete(v.failures, source)
+		}
+	}
+}
+
+func (v *Verifier) verify(secret, totpCode string, now time.Time) (int64, error) {
+	expectedSecret, err := os.ReadFile(v.secretPath)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read the break-glass secret: %w", err)
+	}
+
+	seedData, err := os.ReadFile(v.totpSeedPath)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read the break-glass TOTP seed: %w", err)
+	}
+
+	seed, err := ParseSeed(string(seedData))
+	if err != nil {
+		return 0, err
+	}
+
+	// compare the hashes to not leak the length of the secret
+	expectedHash := sha256.Sum256([]byte(strings.TrimSpace(string(expectedSecret))))
+	actualHash := sha256.Sum256([]byte(secret))
+
+	secretMatches := subtle.ConstantTimeCompare(expectedHash[:], actualHash[:]) == 1
+
+	counter, codeMatches := matchTOTP(seed, totpCode, now)
+
+	if !secretMatches || !codeMatches || counter <= v.lastCounter {
+		return 0, ErrDenied
+	}
+
+	return counter, nil
+}
+
+// ParseSeed decodes the base32 encoded TOTP seed, the padding, the spaces and the case are ignored.
+func ParseSeed(encoded string) ([]byte, error) {
+	encoded = strings.ToUpper(strings.TrimRight(strings.ReplaceAll(strings.TrimSpace(encoded), " ", ""), "="))
+
+	seed, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the break-glass TOTP seed: %w", err)
+	}
+
+	if len(seed) == 0 {
+		return nil, errors.New("the break-glass TOTP seed is empty")
+	}
+
+	return seed, nil
+}
+
+// TOTP returns the RFC 6238 time-based one-time password of the seed at the given time.
+func TOTP(seed []byte, t time.Time) string {
+	return hotp(seed, t.Unix()/int64(totpPeriod/time.Second))
+}
+
+func matchTOTP(seed []byte, code string, now time.Time) (int64, bool) {
+	current := now.Unix() / int64(totpPeriod/time.Second)
+
+	for counter := current - totpSkew; counter <= current+totpSkew; counter++ {
+		if subtle.ConstantTimeCompare([]byte(hotp(seed, counter)), []byte(code)) == 1 {
+			return counter, true
+		}
+	}
+
+	return 0, false
+}
+
+// hotp implements the RFC 4226 HMAC-based one-time password.
+func hotp(seed []byte, counter int64) string {
+	var msg [8]byte
+
+	binary.BigEndian.PutUint64(msg[:], uint64(counter))
+
+	mac := hmac.New(sha1.New, seed)
+	mac.Write(msg[:]) //nolint:errcheck
+
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	code := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	modulo := uint32(1)
+	for range totpDigits {
+		modulo *= 10
+	}
+
+	return fmt.Sprintf("%0*d", totpDigits, code%modulo)
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package breakglass_test
+
+import (
+	"encoding/base32"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/internal/pkg/auth/breakglass"
+)
+
+// rfcSeed is the seed of the RFC 6238 SHA1 test vectors.
+var rfcSeed = []byte("12345678901234567890")
+
+func TestTOTP(t *testing.T) {
+	t.Parallel()
+
+	// the RFC 6238 test vectors truncated to 6 digits
+	for unix, expected := range map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1111111111: "050471",
+		1234567890: "005924",
+		2000000000: "279037",
+	} {
+		assert.Equal(t, expected, breakglass.TOTP(rfcSeed, time.Unix(unix, 0)), unix)
+	}
+}
+
+func TestParseSeed(t *testing.T) {
+	t.Parallel()
+
+	encoded := base32.StdEncoding.EncodeToString(rfcSeed)
+
+	seed, err := breakglass.ParseSeed(encoded + "\n")
+	require.NoError(t, err)
+	assert.Equal(t, rfcSeed, seed)
+
+	seed, err = breakglass.ParseSeed("gezd gnbv gy3t qojq gezd gnbv gy3t qojq")
+	require.NoError(t, err)
+	assert.Equal(t, rfcSeed, seed)
+
+	_, err = breakglass.ParseSeed("not base32!")
+	assert.Error(t, err)
+
+	_, err = breakglass.ParseSeed("")
+	assert.Error(t, err)
+}
+
+func TestVerifier(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	secretPath := filepath.Join(dir, "secret")
+	seedPath := filepath.Join(dir, "seed")
+
+	require.NoError(t, os.WriteFile(secretPath, []byte("correct horse battery staple\n"), 0o600))
+	require.NoError(t, os.WriteFile(seedPath, []byte(base32.StdEncoding.EncodeToString(rfcSeed)), 0o600))
+
+	now := time.Unix(1_700_000_000, 0)
+
+	verifier := breakglass.NewVerifier(secretPath, seedPath)
+	verifier.SetClock(func() time.Time { return now })
+
+	const source = "192.0.2.1"
+
+	assert.ErrorIs(t, verifier.Verify(source, "wrong", breakglass.TOTP(rfcSeed, now)), breakglass.ErrDenied)
+	assert.ErrorIs(t, verifier.Verify(source, "correct horse battery staple", "000000"), breakglass.ErrDenied)
+
+	// the code of the previous period is accepted to tolerate the clock drift
+	require.NoError(t, verifier.Verify(source, "correct horse battery staple", breakglass.TOTP(rfcSeed, now.Add(-30*time.Second))))
+
+	// the code can't be reused
+	assert.ErrorIs(t, verifier.Verify(source, "correct horse battery staple", breakglass.TOTP(rfcSeed, now.Add(-30*time.Second))), breakglass.ErrDenied)
+
+	require.NoError(t, verifier.Verify(source, "correct horse battery staple", breakglass.TOTP(rfcSeed, now)))
+}
+
+func TestVerifierFailureLimit(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	secretPath := filepath.Join(dir, "secret")
+	seedPath := filepath.Join(dir, "seed")
+
+	require.NoError(t, os.WriteFile(secretPath, []byte("correct horse battery staple\n"), 0o600))
+	require.NoError(t, os.WriteFile(seedPath, []byte(base32.StdEncoding.EncodeToString(rfcSeed)), 0o600))
+
+	now := time.Unix(1_700_000_000, 0)
+
+	verifier := breakglass.NewVerifier(secretPath, seedPath)
+	verifier.SetClock(func() time.Time { return now })
+
+	const (
+		attacker = "192.0.2.1"
+		operator = "198.51.100.1"
+	)
+
+	for range 5 {
+		assert.ErrorIs(t, verifier.Verify(attacker, "wrong", breakglass.TOTP(rfcSeed, now)), breakglass.ErrDenied)
+	}
+
+	// locked out even with the correct factors
+	assert.ErrorIs(t, verifier.Verify(attacker, "correct horse battery staple", breakglass.TOTP(rfcSeed, now)), breakglass.ErrLockedOut)
+
+	// the other source addresses are not affected
+	require.NoError(t, verifier.Verify(operator, "correct horse battery staple", breakglass.TOTP(rfcSeed, now)))
+
+	// one more attempt is allowed after the refill interval
+	now = now.Add(30 * time.Second)
+
+	assert.ErrorIs(t, verifier.Verify(attacker, "wrong", breakglass.TOTP(rfcSeed, now)), breakglass.ErrDenied)
+	assert.ErrorIs(t, verifier.Verify(attacker, "wrong", breakglass.TOTP(rfcSeed, now)), breakglass.ErrDenied)
+	assert.ErrorIs(t, verifier.Verify(attacker, "wrong", breakglass.TOTP(rfcSeed, now)), breakglass.ErrLockedOut)
+
+	// the source addresses are forgotten once all their attempts are refilled
+	assert.Equal(t, 1, verifier.Sources())
+
+	now = now.Add(2 * time.Minute)
+
+	require.NoError(t, verifier.Verify(attacker, "correct horse battery staple", breakglass.TOTP(rfcSeed, now)))
+
+	assert.ErrorIs(t, verifier.Verify(operator, "wrong", breakglass.TOTP(rfcSeed, now)), breakglass.ErrDenied)
+
+	now = now.Add(2 * time.Minute)
+
+	assert.ErrorIs(t, verifier.Verify(attacker, "wrong", breakglass.TOTP(rfcSeed, now)), breakglass.ErrDenied)
+	assert.Equal(t, 1, verifier.Sources())
+}
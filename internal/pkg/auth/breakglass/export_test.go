@@ -0,0 +1,21 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package breakglass
+
+import "time"
+
+// SetClock overrides the clock of the verifier.
+func (v *Verifier) SetClock(now func() time.Time) {
+	v.now = now
+}
+
+// Sources returns the number of the source addresses with the failed attempts tracked.
+func (v *Verifier) Sources() int {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	return len(v.failures)
+}
@@ -45,6 +45,7 @@ type CheckResult struct {
 	UserID            string
 	Labels            map[string]string
 	Role              role.Role
+	Cluster           string
 	HasValidSignature bool
 	AuthEnabled       bool
 }
@@ -145,6 +146,10 @@ func Check(ctx context.Context, opt ...CheckOption) (CheckResult, error) {
 		result.UserID = userID
 	}
 
+	if cluster, ok := ctx.Value(ClusterContextKey{}).(string); ok {
+		result.Cluster = cluster
+	}
+
 	return result, nil
 }
 
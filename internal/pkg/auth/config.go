@@ -41,6 +41,10 @@ func EnsureAuthConfigResource(ctx context.Context, st state.State, logger *zap.L
 			res.TypedSpec().Value.Webauthn = &specs.AuthConfigSpec_Webauthn{}
 		}
 
+		if res.TypedSpec().Value.Static == nil {
+			res.TypedSpec().Value.Static = &specs.AuthConfigSpec_Static{}
+		}
+
 		res.TypedSpec().Value.Auth0.Enabled = authParams.Auth0.Enabled
 		res.TypedSpec().Value.Auth0.Domain = authParams.Auth0.Domain
 		res.TypedSpec().Value.Auth0.ClientId = authParams.Auth0.ClientID
@@ -49,6 +53,7 @@ func EnsureAuthConfigResource(ctx context.Context, st state.State, logger *zap.L
 		res.TypedSpec().Value.Saml.Url = authParams.SAML.URL
 		res.TypedSpec().Value.Saml.Metadata = authParams.SAML.Metadata
 		res.TypedSpec().Value.Saml.LabelRules = authParams.SAML.LabelRules
+		res.TypedSpec().Value.Static.Enabled = authParams.Static.Enabled
 
 		if res.TypedSpec().Value.Webauthn.Enabled && !authParams.WebAuthn.Enabled {
 			logger.Warn("webauthn is disabled in Config, but enabled in the cluster, refusing to disable it",
@@ -77,6 +82,7 @@ func EnsureAuthConfigResource(ctx context.Context, st state.State, logger *zap.L
 			zap.Any("auth0", authParams.Auth0),
 			zap.Any("webauthn", authParams.WebAuthn),
 			zap.Any("saml", authParams.SAML),
+			zap.Any("static", authParams.Static),
 		)
 
 		return authConfig, nil
@@ -94,6 +100,7 @@ func EnsureAuthConfigResource(ctx context.Context, st state.State, logger *zap.L
 			zap.Any("auth0", authParams.Auth0),
 			zap.Any("webauthn", authParams.WebAuthn),
 			zap.Any("saml", authParams.SAML),
+			zap.Any("static", authParams.Static),
 		)
 
 		return nil
@@ -106,18 +113,30 @@ func EnsureAuthConfigResource(ctx context.Context, st state.State, logger *zap.L
 }
 
 func validateParams(authParams config.AuthParams) error {
-	if !authParams.SAML.Enabled && !authParams.Auth0.Enabled && !authParams.WebAuthn.Enabled {
+	if !authParams.SAML.Enabled && !authParams.Auth0.Enabled && !authParams.WebAuthn.Enabled && !authParams.Static.Enabled {
 		return errors.New("no authentication is enabled")
 	}
 
-	if authParams.SAML.Enabled && authParams.Auth0.Enabled {
-		return errors.New("both auth0 and SAML auth are enabled, only one can be enabled at the same time")
+	enabledProviders := 0
+
+	for _, enabled := range []bool{authParams.Auth0.Enabled, authParams.SAML.Enabled, authParams.Static.Enabled} {
+		if enabled {
+			enabledProviders++
+		}
+	}
+
+	if enabledProviders > 1 {
+		return errors.New("more than one of auth0, SAML and static auth are enabled, only one can be enabled at the same time")
 	}
 
 	if authParams.SAML.Enabled && authParams.SAML.URL == "" && authParams.SAML.Metadata == "" {
 		return errors.New("SAML is enabled but neither URL nor metadata is set")
 	}
 
+	if authParams.Static.Enabled && authParams.Static.UsersFile == "" {
+		return errors.New("static auth is enabled but the users file is not set")
+	}
+
 	if !authParams.Auth0.Enabled {
 		return nil
 	}
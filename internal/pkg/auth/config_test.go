@@ -60,6 +60,7 @@ func TestEnsureAuthConfigResource(t *testing.T) {
 				},
 				Webauthn: &specs.AuthConfigSpec_Webauthn{},
 				Saml:     &specs.AuthConfigSpec_SAML{},
+				Static:   &specs.AuthConfigSpec_Static{},
 			},
 		},
 		{
@@ -73,8 +74,9 @@ func TestEnsureAuthConfigResource(t *testing.T) {
 				Webauthn: &specs.AuthConfigSpec_Webauthn{
 					Enabled: true,
 				},
-				Auth0: &specs.AuthConfigSpec_Auth0{},
-				Saml:  &specs.AuthConfigSpec_SAML{},
+				Auth0:  &specs.AuthConfigSpec_Auth0{},
+				Saml:   &specs.AuthConfigSpec_SAML{},
+				Static: &specs.AuthConfigSpec_Static{},
 			},
 		},
 		{
@@ -95,8 +97,9 @@ func TestEnsureAuthConfigResource(t *testing.T) {
 				Webauthn: &specs.AuthConfigSpec_Webauthn{
 					Enabled: true,
 				},
-				Auth0: &specs.AuthConfigSpec_Auth0{},
-				Saml:  &specs.AuthConfigSpec_SAML{},
+				Auth0:  &specs.AuthConfigSpec_Auth0{},
+				Saml:   &specs.AuthConfigSpec_SAML{},
+				Static: &specs.AuthConfigSpec_Static{},
 			},
 		},
 		{
@@ -148,6 +151,7 @@ func TestEnsureAuthConfigResource(t *testing.T) {
 					Enabled: true,
 					Url:     "http://samltest.sp/idp",
 				},
+				Static: &specs.AuthConfigSpec_Static{},
 			},
 		},
 		{
@@ -161,6 +165,46 @@ func TestEnsureAuthConfigResource(t *testing.T) {
 			updatedConfig:     &config.AuthParams{},
 			expectUpdateError: true,
 		},
+		{
+			name: "enable static",
+			initialConfig: config.AuthParams{
+				Static: config.StaticParams{
+					Enabled:   true,
+					UsersFile: "/etc/omni/users.yaml",
+				},
+			},
+			expected: &specs.AuthConfigSpec{
+				Auth0:    &specs.AuthConfigSpec_Auth0{},
+				Webauthn: &specs.AuthConfigSpec_Webauthn{},
+				Saml:     &specs.AuthConfigSpec_SAML{},
+				Static: &specs.AuthConfigSpec_Static{
+					Enabled: true,
+				},
+			},
+		},
+		{
+			name: "static without users file",
+			initialConfig: config.AuthParams{
+				Static: config.StaticParams{
+					Enabled: true,
+				},
+			},
+			expectInitError: true,
+		},
+		{
+			name: "static and SAML",
+			initialConfig: config.AuthParams{
+				SAML: config.SAMLParams{
+					Enabled: true,
+					URL:     "http://samltest.sp/idp",
+				},
+				Static: config.StaticParams{
+					Enabled:   true,
+					UsersFile: "/etc/omni/users.yaml",
+				},
+			},
+			expectInitError: true,
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
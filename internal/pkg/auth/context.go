@@ -22,3 +22,8 @@ type RoleContextKey struct{}
 
 // IdentityContextKey is the context key for the user identity. Value has the type string.
 type IdentityContextKey struct{}
+
+// ClusterContextKey is the context key for the cluster the identity is restricted to. Value has the type string.
+//
+// It is set only for the identities which are restricted to a single cluster.
+type ClusterContextKey struct{}
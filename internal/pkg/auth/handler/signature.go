@@ -100,11 +100,16 @@ func (s *Signature) intercept(request *http.Request) (*http.Request, error) {
 	grpc_ctxtags.Extract(ctx).
 		Set("authenticator.user_id", authenticator.UserID).
 		Set("authenticator.identity", authenticator.Identity).
-		Set("authenticator.role", string(authenticator.Role))
+		Set("authenticator.role", string(authenticator.Role)).
+		Set("authenticator.cluster", authenticator.Cluster)
 
 	ctx = context.WithValue(ctx, auth.IdentityContextKey{}, authenticator.Identity)
 	ctx = context.WithValue(ctx, auth.UserIDContextKey{}, authenticator.UserID)
 	ctx = context.WithValue(ctx, auth.RoleContextKey{}, authenticator.Role)
 
+	if authenticator.Cluster != "" {
+		ctx = context.WithValue(ctx, auth.ClusterContextKey{}, authenticator.Cluster)
+	}
+
 	return request.WithContext(ctx), nil
 }
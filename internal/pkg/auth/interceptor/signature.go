@@ -8,6 +8,7 @@ package interceptor
 import (
 	"context"
 	"errors"
+	"strings"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
@@ -15,12 +16,17 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
+	"github.com/siderolabs/omni/client/pkg/constants"
 	"github.com/siderolabs/omni/internal/pkg/auth"
 )
 
-var errGRPCInvalidSignature = status.Error(codes.Unauthenticated, "invalid signature")
+var (
+	errGRPCInvalidSignature = status.Error(codes.Unauthenticated, "invalid signature")
+	errGRPCExpiredPublicKey = status.Error(codes.Unauthenticated, constants.ExpiredPublicKeyMessage)
+)
 
 // Signature represents a signature interceptor.
 type Signature struct {
@@ -95,6 +101,12 @@ func (i *Signature) intercept(ctx context.Context) (context.Context, error) {
 			return nil, status.Error(codes.Canceled, "context canceled while doing authentication")
 		}
 
+		if errors.Is(err, auth.ErrPublicKeyExpired) {
+			i.logger.Info("public key expired", zap.String("fingerprint", signature.KeyFingerprint))
+
+			return nil, errGRPCExpiredPublicKey
+		}
+
 		i.logger.Warn("failed to get authenticator", zap.Error(err))
 
 		return nil, errGRPCInvalidSignature
@@ -110,11 +122,90 @@ func (i *Signature) intercept(ctx context.Context) (context.Context, error) {
 	grpc_ctxtags.Extract(ctx).
 		Set("authenticator.user_id", authenticator.UserID).
 		Set("authenticator.identity", authenticator.Identity).
-		Set("authenticator.role", string(authenticator.Role))
+		Set("authenticator.role", string(authenticator.Role)).
+		Set("authenticator.cluster", authenticator.Cluster)
 
 	ctx = context.WithValue(ctx, auth.UserIDContextKey{}, authenticator.UserID)
 	ctx = context.WithValue(ctx, auth.IdentityContextKey{}, authenticator.Identity)
 	ctx = context.WithValue(ctx, auth.RoleContextKey{}, authenticator.Role)
 
+	if authenticator.Cluster != "" {
+		ctx = context.WithValue(ctx, auth.ClusterContextKey{}, authenticator.Cluster)
+
+		if err = checkClusterScope(ctx, authenticator.Cluster); err != nil {
+			return nil, err
+		}
+	}
+
 	return ctx, nil
 }
+
+const (
+	// resourceServicePrefix is the prefix of the resource API methods.
+	resourceServicePrefix = "/omni.resources.ResourceService/"
+
+	// managementServicePrefix is the prefix of the management API methods.
+	managementServicePrefix = "/management.ManagementService/"
+)
+
+var (
+	// clusterRoutedManagementMethods are the management API methods which target the cluster set in the request headers.
+	clusterRoutedManagementMethods = map[string]struct{}{
+		managementServicePrefix + "Kubeconfig":                 {},
+		managementServicePrefix + "Talosconfig":                {},
+		managementServicePrefix + "KubernetesUpgradePreChecks": {},
+		managementServicePrefix + "KubernetesSyncManifests":    {},
+	}
+
+	// clusterCheckedManagementMethods are the management API methods which are available to the identities restricted to a cluster,
+	// as they either do not target any resource or check the cluster of the resource they target themselves.
+	clusterCheckedManagementMethods = map[string]struct{}{
+		managementServicePrefix + "Omniconfig":     {},
+		managementServicePrefix + "ValidateConfig": {},
+		managementServicePrefix + "MachineLogs":    {},
+	}
+)
+
+// checkClusterScope denies the requests of an identity restricted to a cluster which do not target that cluster.
+//
+// The resource API requests are passed through, the access to the resources is checked by the state access filter.
+// The management API methods which target the resources set in the request body are denied,
+// except for the ones which check the cluster of the targeted resource themselves.
+func checkClusterScope(ctx context.Context, cluster string) error {
+	method, _ := grpc.Method(ctx)
+
+	switch {
+	case strings.HasPrefix(method, resourceServicePrefix):
+		return nil
+	case strings.HasPrefix(method, managementServicePrefix):
+		if _, ok := clusterCheckedManagementMethods[method]; ok {
+			return nil
+		}
+
+		if _, ok := clusterRoutedManagementMethods[method]; !ok {
+			return status.Errorf(codes.PermissionDenied, "the identity is restricted to the cluster %q", cluster)
+		}
+	}
+
+	if requestCluster(ctx) == cluster {
+		return nil
+	}
+
+	return status.Errorf(codes.PermissionDenied, "the identity is restricted to the cluster %q", cluster)
+}
+
+// requestCluster returns the cluster the request is targeted to, the cluster header takes precedence over the context header.
+func requestCluster(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	for _, key := range []string{message.ClusterHeaderKey, message.ContextHeaderKey} {
+		if values := md.Get(key); len(values) > 0 && values[0] != "" {
+			return values[0]
+		}
+	}
+
+	return ""
+}
@@ -25,7 +25,10 @@ import (
 	"google.golang.org/grpc/interop/grpc_testing"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/constants"
 	"github.com/siderolabs/omni/internal/pkg/auth"
 	"github.com/siderolabs/omni/internal/pkg/auth/interceptor"
 	"github.com/siderolabs/omni/internal/pkg/auth/role"
@@ -41,12 +44,15 @@ func (s testServer) UnaryCall(_ context.Context, _ *grpc_testing.SimpleRequest)
 	return &grpc_testing.SimpleResponse{}, nil
 }
 
+const expiredFingerprint = "expired"
+
 type SignatureTestSuite struct {
 	testServiceClient grpc_testing.TestServiceClient
 
 	clientConn *grpc.ClientConn
 
-	key *pgp.Key
+	key       *pgp.Key
+	scopedKey *pgp.Key
 
 	test.GRPCSuite
 }
@@ -57,7 +63,24 @@ func (suite *SignatureTestSuite) SetupSuite() {
 	suite.key, err = pgp.GenerateKey("", "", "test@example.org", time.Minute)
 	suite.Require().NoError(err)
 
-	authenticatorFunc := func(context.Context, string) (*auth.Authenticator, error) {
+	suite.scopedKey, err = pgp.GenerateKey("", "", "scoped@serviceaccount.omni.sidero.dev", time.Minute)
+	suite.Require().NoError(err)
+
+	authenticatorFunc := func(_ context.Context, fingerprint string) (*auth.Authenticator, error) {
+		if fingerprint == expiredFingerprint {
+			return nil, auth.ErrPublicKeyExpired
+		}
+
+		if fingerprint == suite.scopedKey.Fingerprint() {
+			return &auth.Authenticator{
+				Verifier: suite.scopedKey,
+				Identity: "scoped@serviceaccount.omni.sidero.dev",
+				UserID:   "scoped-user-id",
+				Role:     role.Operator,
+				Cluster:  "talos-default",
+			}, nil
+		}
+
 		return &auth.Authenticator{
 			Verifier: suite.key,
 			Identity: "user@example.com",
@@ -87,6 +110,8 @@ func (suite *SignatureTestSuite) SetupSuite() {
 		t: suite.T(),
 	})
 
+	management.RegisterManagementServiceServer(suite.Server, management.UnimplementedManagementServiceServer{})
+
 	suite.StartServer()
 
 	dialOptions := []grpc.DialOption{
@@ -170,6 +195,134 @@ func (suite *SignatureTestSuite) TestValidSignature() {
 	assert.NoError(suite.T(), err)
 }
 
+func (suite *SignatureTestSuite) TestExpiredPublicKey() {
+	epochTimestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	payloadJSON, err := json.Marshal(message.GRPCPayload{
+		Headers: map[string][]string{
+			message.TimestampHeaderKey: {epochTimestamp},
+		},
+		Method: "/grpc.testing.TestService/UnaryCall",
+	})
+	suite.Require().NoError(err)
+
+	signature, err := suite.key.Sign(payloadJSON)
+	suite.Require().NoError(err)
+
+	ctx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs(
+		message.SignatureHeaderKey, fmt.Sprintf(
+			"%s test@example.org %s %s",
+			message.SignatureVersionV1,
+			expiredFingerprint,
+			base64.StdEncoding.EncodeToString(signature),
+		),
+		message.TimestampHeaderKey, epochTimestamp,
+		message.PayloadHeaderKey, string(payloadJSON),
+	))
+
+	_, err = suite.testServiceClient.UnaryCall(ctx, &grpc_testing.SimpleRequest{})
+
+	suite.Assert().Equal(codes.Unauthenticated, status.Code(err), "error code should be codes.Unauthenticated")
+	suite.Assert().Equal(constants.ExpiredPublicKeyMessage, status.Convert(err).Message())
+}
+
+func (suite *SignatureTestSuite) TestClusterScope() {
+	for _, tt := range []struct {
+		name         string
+		method       string
+		cluster      string
+		expectedCode codes.Code
+	}{
+		{
+			name:         "no cluster",
+			method:       "/grpc.testing.TestService/UnaryCall",
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "other cluster",
+			method:       "/grpc.testing.TestService/UnaryCall",
+			cluster:      "other",
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "same cluster",
+			method:       "/grpc.testing.TestService/UnaryCall",
+			cluster:      "talos-default",
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "cluster routed management method",
+			method:       management.ManagementService_Kubeconfig_FullMethodName,
+			cluster:      "talos-default",
+			expectedCode: codes.Unimplemented,
+		},
+		{
+			name:         "cluster routed management method other cluster",
+			method:       management.ManagementService_Kubeconfig_FullMethodName,
+			cluster:      "other",
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "cluster checked management method",
+			method:       management.ManagementService_ValidateConfig_FullMethodName,
+			expectedCode: codes.Unimplemented,
+		},
+		{
+			name:         "body targeted management method",
+			method:       management.ManagementService_GetClusterAvailability_FullMethodName,
+			cluster:      "talos-default",
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "instance management method",
+			method:       management.ManagementService_CreateServiceAccount_FullMethodName,
+			cluster:      "talos-default",
+			expectedCode: codes.PermissionDenied,
+		},
+	} {
+		suite.Run(tt.name, func() {
+			epochTimestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+			headers := map[string][]string{
+				message.TimestampHeaderKey: {epochTimestamp},
+			}
+
+			if tt.cluster != "" {
+				headers[message.ClusterHeaderKey] = []string{tt.cluster}
+			}
+
+			payloadJSON, err := json.Marshal(message.GRPCPayload{
+				Headers: headers,
+				Method:  tt.method,
+			})
+			suite.Require().NoError(err)
+
+			signature, err := suite.scopedKey.Sign(payloadJSON)
+			suite.Require().NoError(err)
+
+			md := metadata.Pairs(
+				message.SignatureHeaderKey, fmt.Sprintf(
+					"%s scoped@serviceaccount.omni.sidero.dev %s %s",
+					message.SignatureVersionV1,
+					suite.scopedKey.Fingerprint(),
+					base64.StdEncoding.EncodeToString(signature),
+				),
+				message.TimestampHeaderKey, epochTimestamp,
+				message.PayloadHeaderKey, string(payloadJSON),
+			)
+
+			if tt.cluster != "" {
+				md.Set(message.ClusterHeaderKey, tt.cluster)
+			}
+
+			// the request and the response messages are not relevant for the interceptor, any message will do
+			err = suite.clientConn.Invoke(metadata.NewOutgoingContext(context.Background(), md), tt.method, &emptypb.Empty{}, &emptypb.Empty{})
+
+			suite.Assert().Equal(tt.expectedCode, status.Code(err), "unexpected error: %v", err)
+		})
+	}
+}
+
 func TestSignatureTestSuite(t *testing.T) {
 	t.Parallel()
 
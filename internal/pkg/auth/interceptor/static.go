@@ -0,0 +1,99 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package interceptor
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/siderolabs/go-api-signature/pkg/message"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/static"
+)
+
+const basicAuthPrefix = "Basic "
+
+var errGRPCInvalidCredentials = status.Error(codes.Unauthenticated, "invalid credentials")
+
+// Static is a GRPC interceptor that verifies the basic auth credentials against the static users file.
+type Static struct {
+	users  *static.Users
+	logger *zap.Logger
+}
+
+// NewStatic returns a new static users interceptor.
+func NewStatic(users *static.Users, logger *zap.Logger) *Static {
+	return &Static{
+		users:  users,
+		logger: logger,
+	}
+}
+
+// Unary returns a new unary static users interceptor.
+func (i *Static) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := i.intercept(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// Stream returns a new stream static users interceptor.
+func (i *Static) Stream() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := i.intercept(ss.Context())
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &grpc_middleware.WrappedServerStream{
+			ServerStream:   ss,
+			WrappedContext: ctx,
+		})
+	}
+}
+
+func (i *Static) intercept(ctx context.Context) (context.Context, error) {
+	msg, ok := ctx.Value(auth.GRPCMessageContextKey{}).(*message.GRPC)
+	if !ok {
+		return nil, status.Error(codes.Internal, "missing or invalid message in context")
+	}
+
+	values := msg.Metadata.Get(message.AuthorizationHeaderKey)
+	if len(values) == 0 || !strings.HasPrefix(values[0], basicAuthPrefix) { // no basic auth credentials, pass it through
+		return ctx, nil
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(values[0], basicAuthPrefix))
+	if err != nil {
+		return nil, errGRPCInvalidCredentials
+	}
+
+	email, password, ok := strings.Cut(string(decoded), ":")
+	if !ok {
+		return nil, errGRPCInvalidCredentials
+	}
+
+	if err = i.users.Verify(email, password); err != nil {
+		i.logger.Info("invalid static user credentials", zap.String("email", email), zap.Error(err))
+
+		return nil, errGRPCInvalidCredentials
+	}
+
+	ctx = context.WithValue(ctx, auth.VerifiedEmailContextKey{}, strings.ToLower(email))
+
+	return ctx, nil
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package static implements the authentication against the static users file.
+//
+// It is meant for air-gapped environments which don't have an identity provider.
+package static
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
+
+// ErrInvalidCredentials is returned when the email or the password doesn't match.
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+// User is a single user entry in the static users file.
+type User struct {
+	Email        string    `yaml:"email"`
+	PasswordHash string    `yaml:"passwordHash"`
+	Role         role.Role `yaml:"role"`
+}
+
+// File is the static users file format.
+//
+// Example:
+//
+//	users:
+//	  - email: admin@example.org
+//	    passwordHash: $2a$10$...
+//	    role: Admin
+type File struct {
+	Users []User `yaml:"users"`
+}
+
+// Users holds the users loaded from the static users file.
+type Users struct {
+	users map[string]User
+}
+
+// Load reads and validates the static users file.
+func Load(path string) (*Users, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read static users file: %w", err)
+	}
+
+	return Parse(data)
+}
+
+// Parse parses and validates the static users file contents.
+func Parse(data []byte) (*Users, error) {
+	var file File
+
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse static users file: %w", err)
+	}
+
+	users := make(map[string]User, len(file.Users))
+
+	for i, user := range file.Users {
+		user.Email = strings.ToLower(strings.TrimSpace(user.Email))
+
+		if user.Email == "" {
+			return nil, fmt.Errorf("user #%d has no email", i)
+		}
+
+		if _, ok := users[user.Email]; ok {
+			return nil, fmt.Errorf("duplicate user %q", user.Email)
+		}
+
+		if _, err := bcrypt.Cost([]byte(user.PasswordHash)); err != nil {
+			return nil, fmt.Errorf("user %q has invalid bcrypt password hash: %w", user.Email, err)
+		}
+
+		if user.Role == "" {
+			user.Role = role.None
+		}
+
+		if _, err := role.Parse(string(user.Role)); err != nil {
+			return nil, fmt.Errorf("user %q has invalid role: %w", user.Email, err)
+		}
+
+		users[user.Email] = user
+	}
+
+	return &Users{
+		users: users,
+	}, nil
+}
+
+// Verify checks the given email and password against the users file.
+func (u *Users) Verify(email, password string) error {
+	user, ok := u.users[strings.ToLower(email)]
+	if !ok {
+		// still run a comparison to not leak the existence of the user via timing
+		bcrypt.CompareHashAndPassword([]byte(dummyHash), []byte(password)) //nolint:errcheck
+
+		return ErrInvalidCredentials
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+		return ErrInvalidCredentials
+	}
+
+	return nil
+}
+
+// List returns all users from the users file.
+func (u *Users) List() []User {
+	result := make([]User, 0, len(u.users))
+
+	for _, user := range u.users {
+		result = append(result, user)
+	}
+
+	return result
+}
+
+// dummyHash is a bcrypt hash of a random string, used to equalize the response time for the unknown users.
+const dummyHash = "$2a$10$mKXpm3y8YJgHKETPAOKlbO8uIxmyo5tncFxmz694bjg7kH5Piy3LO"
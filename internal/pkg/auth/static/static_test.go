@@ -0,0 +1,115 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package static_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+	"github.com/siderolabs/omni/internal/pkg/auth/static"
+)
+
+// passwordHash is a bcrypt hash of "password".
+const passwordHash = "$2a$04$wM8GQG/OTfyLaZq8zPzpMepGEf6oayXykww71BMkvudwqbddjPiz."
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name        string
+		data        string
+		expectError bool
+	}{
+		{
+			name: "valid",
+			data: `users:
+  - email: Admin@example.org
+    passwordHash: ` + passwordHash + `
+    role: Admin
+  - email: reader@example.org
+    passwordHash: ` + passwordHash + `
+`,
+		},
+		{
+			name: "no email",
+			data: `users:
+  - passwordHash: ` + passwordHash + `
+`,
+			expectError: true,
+		},
+		{
+			name: "duplicate email",
+			data: `users:
+  - email: admin@example.org
+    passwordHash: ` + passwordHash + `
+  - email: ADMIN@example.org
+    passwordHash: ` + passwordHash + `
+`,
+			expectError: true,
+		},
+		{
+			name: "plain text password",
+			data: `users:
+  - email: admin@example.org
+    passwordHash: password
+`,
+			expectError: true,
+		},
+		{
+			name: "invalid role",
+			data: `users:
+  - email: admin@example.org
+    passwordHash: ` + passwordHash + `
+    role: Superuser
+`,
+			expectError: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := static.Parse([]byte(tt.data))
+			if tt.expectError {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	users, err := static.Parse([]byte(`users:
+  - email: Admin@example.org
+    passwordHash: ` + passwordHash + `
+    role: Admin
+  - email: reader@example.org
+    passwordHash: ` + passwordHash + `
+`))
+	require.NoError(t, err)
+
+	require.NoError(t, users.Verify("admin@example.org", "password"))
+	require.NoError(t, users.Verify("ADMIN@example.org", "password"))
+	require.ErrorIs(t, users.Verify("admin@example.org", "wrong"), static.ErrInvalidCredentials)
+	require.ErrorIs(t, users.Verify("unknown@example.org", "password"), static.ErrInvalidCredentials)
+
+	roles := map[string]role.Role{}
+
+	for _, user := range users.List() {
+		roles[user.Email] = user.Role
+	}
+
+	require.Equal(t, map[string]role.Role{
+		"admin@example.org":  role.Admin,
+		"reader@example.org": role.None,
+	}, roles)
+}
@@ -12,6 +12,7 @@ import (
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	talosx509 "github.com/siderolabs/crypto/x509"
@@ -72,5 +73,17 @@ func TalosAPIClientCertificateFromSecrets(secrets *omni.ClusterSecrets, certific
 		return nil, nil, fmt.Errorf("error generating Talos API certificate: %w", err)
 	}
 
-	return clientCert, secretBundle.Certs.OS.Crt, nil
+	return clientCert, TalosCAs(secrets, secretBundle), nil
+}
+
+// TalosCAs returns the PEM-encoded Talos CAs which should be trusted by the Talos API clients.
+//
+// While the Talos secrets are rotated, the machines might use the certificates issued by both the old and the new CA.
+func TalosCAs(secrets *omni.ClusterSecrets, secretBundle *talossecrets.Bundle) []byte {
+	extraCA := secrets.TypedSpec().Value.GetTalosSecretsRotation().GetExtraCaCrt()
+	if len(extraCA) == 0 {
+		return secretBundle.Certs.OS.Crt
+	}
+
+	return slices.Concat(secretBundle.Certs.OS.Crt, extraCA)
 }
@@ -5,7 +5,17 @@
 
 package config
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/siderolabs/gen/maps"
+
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+)
 
 // AuthParams configures authentication.
 //
@@ -14,6 +24,13 @@ type AuthParams struct {
 	Auth0    Auth0Params    `yaml:"auth0"`
 	WebAuthn WebAuthnParams `yaml:"webauthn"`
 	SAML     SAMLParams     `yaml:"saml"`
+	Static   StaticParams   `yaml:"static"`
+
+	// PublicKeyMaxLifetimes limits the lifetime of the user public keys per role.
+	// Keys registered with a longer lifetime get their expiration shortened to the limit.
+	PublicKeyMaxLifetimes RoleDurations `yaml:"publicKeyMaxLifetimes"`
+
+	HardwareKeys HardwareKeysParams `yaml:"hardwareKeys"`
 
 	Suspended bool `yaml:"suspended"`
 }
@@ -40,6 +57,23 @@ type SAMLParams struct {
 	Enabled    bool           `yaml:"enabled"`
 }
 
+// StaticParams holds configuration parameters for the static users file auth.
+type StaticParams struct {
+	UsersFile string `yaml:"usersFile"`
+	Enabled   bool   `yaml:"enabled"`
+}
+
+// HardwareKeysParams holds configuration parameters for the user keys stored on hardware tokens.
+//
+// Such keys are long-lived, so their lifetime is not limited on registration,
+// instead each session bound to the key lasts for SessionLifetime and then requires a new confirmation.
+// Only the keys the client registers with the hardware key header are treated this way.
+// The server has no way to verify that the key is actually stored on a hardware token.
+type HardwareKeysParams struct {
+	SessionLifetime time.Duration `yaml:"sessionLifetime"`
+	Enabled         bool          `yaml:"enabled"`
+}
+
 // SAMLLabelRules defines mapping of SAML assertion attributes to Omni identity labels.
 type SAMLLabelRules map[string]string
 
@@ -62,3 +96,53 @@ func (s *SAMLLabelRules) Set(value string) error {
 func (SAMLLabelRules) Type() string {
 	return "JSON encoded key/value map"
 }
+
+// RoleDurations maps user roles to durations.
+type RoleDurations map[string]time.Duration
+
+// String implements pflag.Value.
+func (r RoleDurations) String() string {
+	keys := maps.Keys(r)
+	slices.Sort(keys)
+
+	parts := make([]string, 0, len(keys))
+
+	for _, key := range keys {
+		parts = append(parts, key+"="+r[key].String())
+	}
+
+	return strings.Join(parts, ",")
+}
+
+// Set implements pflag.Value.
+func (r *RoleDurations) Set(value string) error {
+	if *r == nil {
+		*r = RoleDurations{}
+	}
+
+	for _, part := range strings.Split(value, ",") {
+		roleStr, durationStr, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return fmt.Errorf("invalid role duration %q, expected format <role>=<duration>", part)
+		}
+
+		parsedRole, err := role.Parse(roleStr)
+		if err != nil {
+			return err
+		}
+
+		duration, err := time.ParseDuration(durationStr)
+		if err != nil {
+			return fmt.Errorf("invalid duration for role %q: %w", roleStr, err)
+		}
+
+		(*r)[string(parsedRole)] = duration
+	}
+
+	return nil
+}
+
+// Type implements pflag.Value.
+func (RoleDurations) Type() string {
+	return "comma separated <role>=<duration> list"
+}
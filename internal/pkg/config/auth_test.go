@@ -0,0 +1,32 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+func TestRoleDurations(t *testing.T) {
+	t.Parallel()
+
+	var durations config.RoleDurations
+
+	require.NoError(t, durations.Set("Admin=8h, Reader=168h"))
+	require.Equal(t, config.RoleDurations{
+		"Admin":  8 * time.Hour,
+		"Reader": 168 * time.Hour,
+	}, durations)
+	require.Equal(t, "Admin=8h0m0s,Reader=168h0m0s", durations.String())
+
+	require.Error(t, durations.Set("Superuser=1h"))
+	require.Error(t, durations.Set("Admin=forever"))
+	require.Error(t, durations.Set("Admin"))
+}
@@ -18,6 +18,8 @@ import (
 
 	consts "github.com/siderolabs/omni/client/pkg/constants"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/common"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/siderolink"
 )
 
 const (
@@ -92,6 +94,193 @@ type Params struct {
 	EmbeddedDiscoveryService EmbeddedDiscoveryServiceParams `yaml:"embeddedDiscoveryService"`
 
 	EnableBreakGlassConfigs bool `yaml:"enableBreakGlassConfigs"`
+
+	BreakGlass BreakGlassParams `yaml:"breakGlass"`
+
+	GitOps GitOpsParams `yaml:"gitOps"`
+
+	PayloadSampling PayloadSamplingParams `yaml:"payloadSampling"`
+
+	RateLimit RateLimitParams `yaml:"rateLimit"`
+
+	EnableDiagnostics bool `yaml:"enableDiagnostics"`
+
+	ControllerGraphExportPath string `yaml:"controllerGraphExportPath"`
+
+	DryRun bool `yaml:"dryRun"`
+
+	ConfigApply ConfigApplyParams `yaml:"configApply"`
+
+	UpgradeConcurrency UpgradeConcurrencyParams `yaml:"upgradeConcurrency"`
+
+	SchematicDriftRemediation bool `yaml:"schematicDriftRemediation"`
+
+	CrashLoopDetection CrashLoopDetectionParams `yaml:"crashLoopDetection"`
+
+	MachinePolling MachinePollingParams `yaml:"machinePolling"`
+
+	DestroyReason DestroyReasonParams `yaml:"destroyReason"`
+
+	ControllerAdmission ControllerAdmissionParams `yaml:"controllerAdmission"`
+
+	Autoscaler AutoscalerParams `yaml:"autoscaler"`
+
+	LabelPolicy LabelPolicyParams `yaml:"labelPolicy"`
+}
+
+// PayloadSamplingParams defines the configs of the gRPC payload sampling used to debug the client integrations.
+type PayloadSamplingParams struct {
+	// Methods is the list of the full gRPC method names to sample, the sampling is disabled if empty.
+	Methods []string `yaml:"methods"`
+	// Rate is the fraction of the calls which are sampled, from 0 to 1.
+	Rate float64 `yaml:"rate"`
+	// BufferSize is the number of the latest samples kept in memory.
+	BufferSize int `yaml:"bufferSize"`
+	// MessageSizeLimit is the maximum size of each recorded message, the longer messages are truncated.
+	MessageSizeLimit int `yaml:"messageSizeLimit"`
+	// MaxStreamMessages is the maximum number of the recorded messages of a streaming call in each direction.
+	MaxStreamMessages int `yaml:"maxStreamMessages"`
+}
+
+// RateLimitParams defines the rate limits of the gRPC calls made by each identity.
+type RateLimitParams struct {
+	// Rate is the number of the calls per second allowed for each user, the rate limiting is disabled if zero.
+	Rate float64 `yaml:"rate"`
+	// Burst is the number of the calls a user can make at once before being limited to the Rate.
+	Burst int `yaml:"burst"`
+	// ServiceAccountRate is the number of the calls per second allowed for each service account, the Rate is used if zero.
+	ServiceAccountRate float64 `yaml:"serviceAccountRate"`
+	// ServiceAccountBurst is the number of the calls a service account can make at once, the Burst is used if zero.
+	ServiceAccountBurst int `yaml:"serviceAccountBurst"`
+}
+
+// ConfigApplyParams defines the limits of the machine config applies.
+type ConfigApplyParams struct {
+	// Concurrency is the maximum number of the machine configs applied at the same time across all clusters.
+	Concurrency int `yaml:"concurrency"`
+}
+
+// UpgradeConcurrencyParams defines the limits of the Talos and Kubernetes upgrades running at the same time.
+type UpgradeConcurrencyParams struct {
+	// Limit is the maximum number of the clusters upgraded at the same time across all clusters, unlimited if zero.
+	Limit int `yaml:"limit"`
+	// GroupLabel is the cluster label which splits the clusters into groups, the clusters with the same label value are in the same group.
+	GroupLabel string `yaml:"groupLabel"`
+	// GroupLimit is the maximum number of the clusters upgraded at the same time in each group, unlimited if zero.
+	GroupLimit int `yaml:"groupLimit"`
+}
+
+// DestroyReasonParams defines the policy of the reasons given for the destructive API calls.
+//
+// The reason is recorded in the resource annotation and in the server log whenever it's given, whether it's required or not.
+type DestroyReasonParams struct {
+	// Types is the list of the resource types the reason is required for on deletion.
+	Types []string `yaml:"types"`
+	// Required rejects the deletion of the resources of the Types without a reason.
+	Required bool `yaml:"required"`
+}
+
+// ControllerAdmissionParams defines when the controller runtime is overloaded and the low priority reconciles are delayed.
+//
+// The status and health controllers are never delayed, the cleanup and statistics controllers are delayed first.
+type ControllerAdmissionParams struct {
+	// MaxInFlight is the number of the reconciles running at the same time which marks the runtime as overloaded, disabled if zero.
+	//
+	// The low priority reconciles are delayed when it's reached, the normal priority ones are delayed when it's reached twice.
+	MaxInFlight int `yaml:"maxInFlight"`
+	// ShedDelay is the time the delayed reconcile is retried after.
+	ShedDelay time.Duration `yaml:"shedDelay"`
+}
+
+// AutoscalerParams defines the configs of the Kubernetes cluster-autoscaler external gRPC cloud provider API.
+//
+// The API is served over mutual TLS, the common name of the client certificate is the ID of the cluster the autoscaler manages.
+type AutoscalerParams struct {
+	// BindAddress is the address to serve the API on, the API is disabled if empty.
+	BindAddress  string `yaml:"bindAddress"`
+	CertFile     string `yaml:"certFile"`
+	KeyFile      string `yaml:"keyFile"`
+	ClientCAFile string `yaml:"clientCAFile"`
+}
+
+// LabelPolicyParams defines the rules the user labels of the machines, clusters, machine sets and cluster machines must follow.
+//
+// The rules are enforced on the labels set through the API, the labels which are already set are checked only when they are changed.
+type LabelPolicyParams struct {
+	// AllowedKeyPrefixes is the list of the prefixes one of which the label keys must start with, any key is allowed if empty.
+	AllowedKeyPrefixes []string `yaml:"allowedKeyPrefixes"`
+	// ReservedKeyPrefixes is the list of the prefixes the label keys can't start with in addition to the prefix of the Omni system labels.
+	ReservedKeyPrefixes []string `yaml:"reservedKeyPrefixes"`
+	// ValuePattern is the regular expression the label values must fully match, any value is allowed if empty.
+	ValuePattern string `yaml:"valuePattern"`
+}
+
+// CrashLoopDetectionParams defines when a machine is considered to be rebooting in a loop.
+type CrashLoopDetectionParams struct {
+	// Boots is the number of the boots within the Window which marks the machine as crash looping, the detection is disabled if zero.
+	Boots int `yaml:"boots"`
+	// Window is the period of time the boots are counted in.
+	Window time.Duration `yaml:"window"`
+}
+
+// BreakGlassParams defines the issuing of the short-lived break-glass access tokens to the operators locked out of the identity provider.
+//
+// The token is issued only if the request has both the secret and the current TOTP code, the tokens are disabled if either path is empty.
+type BreakGlassParams struct {
+	// SecretPath is the path to the file containing the break-glass secret.
+	SecretPath string `yaml:"secretPath"`
+	// TOTPSeedPath is the path to the file containing the base32 encoded TOTP seed shared with the authenticator apps of the operators.
+	TOTPSeedPath string `yaml:"totpSeedPath"`
+	// MaxTTL is the maximum lifetime of the token.
+	MaxTTL time.Duration `yaml:"maxTTL"`
+	// TrustedProxies are the addresses or the CIDRs of the reverse proxies the X-Forwarded-For and X-Real-IP headers are accepted from.
+	//
+	// The failed attempts are limited for each client address, the headers sent by the other clients are ignored, as they can be spoofed.
+	TrustedProxies []string `yaml:"trustedProxies"`
+}
+
+// Enabled returns true if the break-glass tokens can be issued.
+func (p BreakGlassParams) Enabled() bool {
+	return p.SecretPath != "" && p.TOTPSeedPath != ""
+}
+
+// MachinePollingParams defines the bounds of the interval between the periodic polls of the machine status from the Talos API
+// and between the retries of the failed polls.
+//
+// The machines in transition are polled at the MinInterval, the interval doubles after each poll which found no changes up to the MaxInterval.
+// The resources which can be watched are polled on changes regardless of the interval.
+type MachinePollingParams struct {
+	MinInterval time.Duration `yaml:"minInterval"`
+	MaxInterval time.Duration `yaml:"maxInterval"`
+}
+
+// GitOpsParams defines the configs of the cluster templates sync from a Git repository.
+type GitOpsParams struct {
+	// Repository is the URL of the Git repository, SSH and HTTPS URLs are supported.
+	Repository string `yaml:"repository"`
+	Branch     string `yaml:"branch"`
+	// Path is the directory in the repository to look up the cluster templates in.
+	Path string `yaml:"path"`
+	// WorkDir is the local directory the repository is checked out to.
+	WorkDir string `yaml:"workDir"`
+
+	// SSHKeyPath is the path to the private SSH key used for the SSH repository URLs.
+	SSHKeyPath string `yaml:"sshKeyPath"`
+	// SSHKnownHostsPath is the path to the known hosts file, if not set, the host keys are accepted on the first use.
+	SSHKnownHostsPath string `yaml:"sshKnownHostsPath"`
+	// TokenPath is the path to the file containing the access token used for the HTTPS repository URLs.
+	TokenPath     string `yaml:"tokenPath"`
+	TokenUsername string `yaml:"tokenUsername"`
+	// WebhookSecretPath is the path to the file containing the secret of the push event webhook, the webhook is disabled if not set.
+	WebhookSecretPath string `yaml:"webhookSecretPath"`
+
+	// GitPath is the path to the git binary.
+	GitPath string `yaml:"gitPath"`
+
+	PollInterval time.Duration `yaml:"pollInterval"`
+	Enabled      bool          `yaml:"enabled"`
+	// Prune enables destroying the clusters whose templates were removed from the repository.
+	Prune bool `yaml:"prune"`
 }
 
 // EmbeddedDiscoveryServiceParams defines embedded discovery service configs.
@@ -102,6 +291,10 @@ type EmbeddedDiscoveryServiceParams struct {
 	SnapshotsEnabled bool          `yaml:"snapshotsEnabled"`
 	Port             int           `yaml:"port"`
 	SnapshotInterval time.Duration `yaml:"snapshotInterval"`
+
+	// StaleAffiliateTimeout is the time after which the affiliates which don't belong to any cluster machine
+	// are deleted from the embedded discovery service. Zero disables the deletion.
+	StaleAffiliateTimeout time.Duration `yaml:"staleAffiliateTimeout"`
 }
 
 // EtcdBackupParams defines etcd backup configs.
@@ -133,8 +326,27 @@ func (ebp EtcdBackupParams) GetStorageType() (EtcdBackupStorage, error) {
 
 // WorkloadProxyingParams defines workload proxying configs.
 type WorkloadProxyingParams struct {
-	Subdomain string `yaml:"subdomain"`
-	Enabled   bool   `yaml:"enabled"`
+	Subdomain     string                              `yaml:"subdomain"`
+	CustomDomains WorkloadProxyingCustomDomainsParams `yaml:"customDomains"`
+
+	// IdleTimeout closes the connections to the exposed services, including the upgraded ones, after the given time without traffic.
+	IdleTimeout time.Duration `yaml:"idleTimeout"`
+	Enabled     bool          `yaml:"enabled"`
+}
+
+// WorkloadProxyingCustomDomainsParams defines the configs of the user-specified hostnames of the exposed services.
+type WorkloadProxyingCustomDomainsParams struct {
+	// ACMEDirectoryURL is the ACME directory used to issue the certificates, Let's Encrypt is used if not set.
+	ACMEDirectoryURL string `yaml:"acmeDirectoryURL"`
+	ACMEEmail        string `yaml:"acmeEmail"`
+	CertCacheDir     string `yaml:"certCacheDir"`
+
+	// HTTPChallengeBindAddress is the address to answer the ACME HTTP-01 challenges on.
+	//
+	// If not set, only the TLS-ALPN-01 challenges are answered by the API server.
+	HTTPChallengeBindAddress string `yaml:"httpChallengeBindAddress"`
+
+	Enabled bool `yaml:"enabled"`
 }
 
 // LoadBalancerParams defines load balancer configs.
@@ -178,6 +390,24 @@ type EtcdParams struct { ///nolint:govet
 
 	PrivateKeySource string   `yaml:"privateKeySource"`
 	PublicKeyFiles   []string `yaml:"publicKeysFiles"`
+
+	// Shards route the resource namespaces to the separate key prefixes, optionally in the separate etcd clusters.
+	Shards []EtcdShardParams `yaml:"shards"`
+}
+
+// EtcdShardParams defines a shard of the etcd storage.
+//
+// The resources of the namespaces matching the shard are stored under the key prefix of the shard,
+// assigning an existing namespace to the shard doesn't migrate its resources.
+type EtcdShardParams struct {
+	Name string `yaml:"name"`
+
+	// Namespaces are the patterns of the namespaces stored in the shard in the path.Match syntax.
+	Namespaces []string `yaml:"namespaces"`
+
+	// Endpoints of the external etcd the shard is stored in, the main etcd is used if empty.
+	// The shard uses the same client TLS settings as the main etcd.
+	Endpoints []string `yaml:"endpoints"`
 }
 
 // KeyPrunerParams defines key pruner configs.
@@ -197,6 +427,11 @@ type MachineLogConfigParams struct {
 	StorageFlushPeriod time.Duration `yaml:"flushPeriod"`
 	StorageFlushJitter float64       `yaml:"flushJitter"`
 	StorageEnabled     bool          `yaml:"enabled"`
+
+	// StorageMaxAge is the time after which the stored logs of the machine which stopped sending logs are removed.
+	StorageMaxAge time.Duration `yaml:"maxAge"`
+	// StorageMaxSize is the maximum size of the stored logs of a single machine in bytes.
+	StorageMaxSize int64 `yaml:"maxSize"`
 }
 
 var (
@@ -225,6 +460,11 @@ var (
 			HealthCheckInterval: 20 * time.Second,
 			HealthCheckTimeout:  15 * time.Second,
 		},
+		Auth: AuthParams{
+			HardwareKeys: HardwareKeysParams{
+				SessionLifetime: 8 * time.Hour,
+			},
+		},
 		KeyPruner: KeyPrunerParams{
 			Interval: 10 * time.Minute,
 		},
@@ -263,8 +503,12 @@ var (
 		},
 
 		WorkloadProxying: WorkloadProxyingParams{
-			Enabled:   true,
-			Subdomain: "proxy-us",
+			Enabled:     true,
+			Subdomain:   "proxy-us",
+			IdleTimeout: time.Hour,
+			CustomDomains: WorkloadProxyingCustomDomainsParams{
+				CertCacheDir: "_out/workload-proxy-certs",
+			},
 		},
 
 		LocalResourceServerPort: 8081,
@@ -285,6 +529,52 @@ var (
 			SnapshotPath:     "_out/secondary-storage/discovery-service-state.binpb",
 			SnapshotInterval: 10 * time.Minute,
 			LogLevel:         zapcore.WarnLevel.String(),
+
+			StaleAffiliateTimeout: 10 * time.Minute,
+		},
+
+		GitOps: GitOpsParams{
+			Branch:        "main",
+			WorkDir:       "_out/gitops",
+			TokenUsername: "x-access-token",
+			GitPath:       "git",
+			PollInterval:  time.Minute,
+		},
+
+		PayloadSampling: PayloadSamplingParams{
+			Rate:              1,
+			BufferSize:        100,
+			MessageSizeLimit:  64 * 1024,
+			MaxStreamMessages: 10,
+		},
+		RateLimit: RateLimitParams{
+			Burst: 100,
+		},
+		ConfigApply: ConfigApplyParams{
+			Concurrency: 4,
+		},
+		CrashLoopDetection: CrashLoopDetectionParams{
+			Boots:  5,
+			Window: 15 * time.Minute,
+		},
+		BreakGlass: BreakGlassParams{
+			MaxTTL: 4 * time.Hour,
+		},
+		MachinePolling: MachinePollingParams{
+			MinInterval: 15 * time.Second,
+			MaxInterval: 15 * time.Minute,
+		},
+		ControllerAdmission: ControllerAdmissionParams{
+			MaxInFlight: 50,
+			ShedDelay:   5 * time.Second,
+		},
+		DestroyReason: DestroyReasonParams{
+			Types: []string{
+				omni.ClusterType,
+				omni.ConfigPatchType,
+				omni.MachineSetNodeType,
+				siderolink.LinkType,
+			},
 		},
 	}
 )
@@ -0,0 +1,272 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package config
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+)
+
+// reloadPollInterval is the interval of checking the config file for the changes.
+const reloadPollInterval = 10 * time.Second
+
+// ReloadHandler applies the changed setting from the reloaded config.
+//
+// The handler must not modify the params, they are only used to read the new setting.
+type ReloadHandler func(next *Params) error
+
+// ReloadStatus is the result of the latest config reload.
+type ReloadStatus struct {
+	Time time.Time
+	Err  error
+
+	// Applied are the settings which were changed and applied without a restart.
+	Applied []string
+	// RestartRequired are the settings which were changed, but are applied only on the restart.
+	RestartRequired []string
+}
+
+// Reloader loads the config file on top of the config built from the flags,
+// and applies the changes of the file to the settings which can be changed at runtime.
+type Reloader struct {
+	effective *Params
+	handlers  map[string]ReloadHandler
+	logger    *zap.Logger
+	status    ReloadStatus
+	path      string
+	base      []byte
+	modTime   time.Time
+	mu        sync.Mutex
+}
+
+// NewReloader loads the config file into the params and creates a new Reloader.
+//
+// The settings from the file take precedence over the flags.
+func NewReloader(path string, params *Params, logger *zap.Logger) (*Reloader, error) {
+	base, err := yaml.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the config: %w", err)
+	}
+
+	r := &Reloader{
+		handlers: map[string]ReloadHandler{},
+		logger:   logger,
+		path:     path,
+		base:     base,
+	}
+
+	r.modTime, err = r.fileModTime()
+	if err != nil {
+		return nil, err
+	}
+
+	loaded, err := r.load(params)
+	if err != nil {
+		return nil, err
+	}
+
+	*params = *loaded
+
+	r.effective, err = r.load(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Path returns the path of the config file.
+func (r *Reloader) Path() string {
+	return r.path
+}
+
+// Register sets the handler which applies the setting with the YAML key without a restart.
+func (r *Reloader) Register(key string, handler ReloadHandler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.handlers[key] = handler
+}
+
+// Status returns the result of the latest reload, the zero status is returned if the config wasn't reloaded yet.
+func (r *Reloader) Status() ReloadStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.status
+}
+
+// Reload reads the config file and applies the changed settings which have the handlers registered.
+//
+// The changes of the other settings are reported as requiring a restart.
+func (r *Reloader) Reload() (ReloadStatus, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	status := ReloadStatus{
+		Time: time.Now(),
+	}
+
+	next, err := r.load(r.effective)
+	if err != nil {
+		status.Err = err
+		r.status = status
+
+		return status, err
+	}
+
+	var errs []error
+
+	effectiveValue := reflect.ValueOf(r.effective).Elem()
+	nextValue := reflect.ValueOf(next).Elem()
+
+	for i := range effectiveValue.NumField() {
+		key := yamlKey(effectiveValue.Type().Field(i))
+		if key == "" || reflect.DeepEqual(effectiveValue.Field(i).Interface(), nextValue.Field(i).Interface()) {
+			continue
+		}
+
+		handler, ok := r.handlers[key]
+		if !ok {
+			status.RestartRequired = append(status.RestartRequired, key)
+
+			continue
+		}
+
+		if err = handler(next); err != nil {
+			errs = append(errs, fmt.Errorf("failed to apply %q: %w", key, err))
+
+			continue
+		}
+
+		effectiveValue.Field(i).Set(nextValue.Field(i))
+
+		status.Applied = append(status.Applied, key)
+	}
+
+	status.Err = errors.Join(errs...)
+	r.status = status
+
+	return status, status.Err
+}
+
+// Run reloads the config on SIGHUP and when the config file is changed.
+func (r *Reloader) Run(ctx context.Context) {
+	signals := make(chan os.Signal, 1)
+
+	signal.Notify(signals, syscall.SIGHUP)
+	defer signal.Stop(signals)
+
+	ticker := time.NewTicker(reloadPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-signals:
+			r.logger.Info("SIGHUP received, reloading the config", zap.String("path", r.path))
+		case <-ticker.C:
+			modTime, err := r.fileModTime()
+			if err != nil {
+				r.logger.Error("failed to check the config file", zap.Error(err))
+
+				continue
+			}
+
+			if modTime.Equal(r.modTime) {
+				continue
+			}
+
+			r.modTime = modTime
+
+			r.logger.Info("config file changed, reloading the config", zap.String("path", r.path))
+		}
+
+		status, err := r.Reload()
+		if err != nil {
+			r.logger.Error("failed to reload the config", zap.Error(err))
+		} else {
+			r.logger.Info("config reloaded", zap.Strings("applied", status.Applied))
+		}
+
+		if len(status.RestartRequired) > 0 {
+			r.logger.Warn("changed settings are applied only on restart", zap.Strings("settings", status.RestartRequired))
+		}
+	}
+}
+
+// load builds the params from the flags and the config file.
+//
+// The settings which can't be set in the file are copied from the current params.
+func (r *Reloader) load(current *Params) (*Params, error) {
+	var params Params
+
+	if err := yaml.Unmarshal(r.base, &params); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the config: %w", err)
+	}
+
+	data, err := os.ReadFile(r.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the config file: %w", err)
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	if err = decoder.Decode(&params); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to decode the config file %q: %w", r.path, err)
+	}
+
+	params.DefaultConfigGenOptions = current.DefaultConfigGenOptions
+
+	return &params, nil
+}
+
+func (r *Reloader) fileModTime() (time.Time, error) {
+	info, err := os.Stat(r.path)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to stat the config file: %w", err)
+	}
+
+	return info.ModTime(), nil
+}
+
+func yamlKey(field reflect.StructField) string {
+	key, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+	if key == "-" {
+		return ""
+	}
+
+	return key
+}
+
+// defaultReloader is set when the config is loaded from a file.
+var defaultReloader atomic.Pointer[Reloader]
+
+// SetDefaultReloader sets the reloader of the config file.
+func SetDefaultReloader(r *Reloader) {
+	defaultReloader.Store(r)
+}
+
+// DefaultReloader returns the reloader of the config file, nil if the config is not loaded from a file.
+func DefaultReloader() *Reloader {
+	return defaultReloader.Load()
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+func TestReloader(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+
+	require.NoError(t, os.WriteFile(path, []byte("rateLimit:\n  rate: 10\n  burst: 20\n"), 0o600))
+
+	params := &config.Params{
+		Name: "flags",
+		KeyPruner: config.KeyPrunerParams{
+			Interval: time.Minute,
+		},
+	}
+
+	reloader, err := config.NewReloader(path, params, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	// the file is applied on top of the flags
+	assert.Equal(t, "flags", params.Name)
+	assert.Equal(t, time.Minute, params.KeyPruner.Interval)
+	assert.Equal(t, config.RateLimitParams{Rate: 10, Burst: 20}, params.RateLimit)
+
+	var applied config.RateLimitParams
+
+	reloader.Register("rateLimit", func(next *config.Params) error {
+		applied = next.RateLimit
+
+		return nil
+	})
+
+	require.NoError(t, os.WriteFile(path, []byte("name: file\nrateLimit:\n  rate: 5\n  burst: 20\n"), 0o600))
+
+	status, err := reloader.Reload()
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"rateLimit"}, status.Applied)
+	assert.Equal(t, []string{"name"}, status.RestartRequired)
+	assert.Equal(t, config.RateLimitParams{Rate: 5, Burst: 20}, applied)
+
+	// the params used by the running server are not changed
+	assert.Equal(t, "flags", params.Name)
+	assert.Equal(t, config.RateLimitParams{Rate: 10, Burst: 20}, params.RateLimit)
+
+	// the applied settings are not reported again
+	status, err = reloader.Reload()
+	require.NoError(t, err)
+
+	assert.Empty(t, status.Applied)
+	assert.Equal(t, []string{"name"}, status.RestartRequired)
+
+	// the unknown settings are rejected
+	require.NoError(t, os.WriteFile(path, []byte("rateLimits: {}\n"), 0o600))
+
+	_, err = reloader.Reload()
+	require.Error(t, err)
+	assert.Equal(t, err, reloader.Status().Err)
+}
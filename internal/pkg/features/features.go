@@ -16,6 +16,7 @@ import (
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/constants"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/internal/pkg/config"
@@ -65,3 +66,38 @@ func UpdateResources(ctx context.Context, st state.State, logger *zap.Logger) er
 
 	return nil
 }
+
+// Enabled returns the list of the optional features enabled in the config.
+func Enabled() []string {
+	var enabled []string
+
+	if config.Config.WorkloadProxying.Enabled {
+		enabled = append(enabled, constants.FeatureWorkloadProxying)
+	}
+
+	if config.Config.EmbeddedDiscoveryService.Enabled {
+		enabled = append(enabled, constants.FeatureEmbeddedDiscoveryService)
+	}
+
+	if config.Config.EtcdBackup.S3Enabled {
+		enabled = append(enabled, constants.FeatureEtcdBackupS3)
+	}
+
+	if config.Config.EtcdBackup.LocalPath != "" {
+		enabled = append(enabled, constants.FeatureEtcdBackupLocal)
+	}
+
+	if config.Config.EnableBreakGlassConfigs {
+		enabled = append(enabled, constants.FeatureBreakGlassConfigs)
+	}
+
+	if config.Config.EnableTalosPreReleaseVersions {
+		enabled = append(enabled, constants.FeatureTalosPreReleaseVersions)
+	}
+
+	if config.Config.GitOps.Enabled {
+		enabled = append(enabled, constants.FeatureGitOps)
+	}
+
+	return enabled
+}
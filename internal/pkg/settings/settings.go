@@ -0,0 +1,59 @@
+// Copyright (c) 2024 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package settings resolves the instance-wide settings which can be overridden at runtime by the omni.Settings resource.
+package settings
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth/role"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+// Get returns the settings overrides, the empty spec is returned if the settings were not overridden.
+func Get(ctx context.Context, st state.State) (*specs.SettingsSpec, error) {
+	settings, err := safe.StateGetByID[*omni.Settings](ctx, st, omni.SettingsID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return &specs.SettingsSpec{}, nil
+		}
+
+		return nil, fmt.Errorf("failed to get settings: %w", err)
+	}
+
+	return settings.TypedSpec().Value, nil
+}
+
+// EtcdBackupIntervals returns the range of the etcd backup intervals the clusters can be configured with.
+func EtcdBackupIntervals(spec *specs.SettingsSpec, defaults config.EtcdBackupParams) (minInterval, maxInterval time.Duration) {
+	minInterval, maxInterval = defaults.MinInterval, defaults.MaxInterval
+
+	if spec.GetEtcdBackupMinInterval() != nil {
+		minInterval = spec.GetEtcdBackupMinInterval().AsDuration()
+	}
+
+	if spec.GetEtcdBackupMaxInterval() != nil {
+		maxInterval = spec.GetEtcdBackupMaxInterval().AsDuration()
+	}
+
+	return minInterval, maxInterval
+}
+
+// PublicKeyMaxLifetime returns the maximum lifetime of the public keys of the role, zero if it's not limited.
+func PublicKeyMaxLifetime(spec *specs.SettingsSpec, keyRole role.Role) time.Duration {
+	if lifetime, ok := spec.GetPublicKeyMaxLifetimes()[string(keyRole)]; ok {
+		return lifetime.AsDuration()
+	}
+
+	return config.Config.Auth.PublicKeyMaxLifetimes[string(keyRole)]
+}
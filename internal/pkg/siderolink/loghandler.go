@@ -8,9 +8,11 @@ package siderolink
 import (
 	"bufio"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/netip"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/siderolabs/gen/optional"
@@ -30,10 +32,11 @@ func NewLogHandler(machineMap *MachineMap, omniState state.State, storageConfig
 	}
 
 	handler := LogHandler{
-		Map:       machineMap,
-		OmniState: omniState,
-		Cache:     cache,
-		logger:    logger,
+		Map:           machineMap,
+		OmniState:     omniState,
+		Cache:         cache,
+		logger:        logger,
+		storageConfig: storageConfig,
 	}
 
 	return &handler, nil
@@ -41,12 +44,16 @@ func NewLogHandler(machineMap *MachineMap, omniState state.State, storageConfig
 
 // LogHandler stores a map of machines to their circular log buffers.
 type LogHandler struct {
-	OmniState state.State
-	Map       *MachineMap
-	logger    *zap.Logger
-	Cache     *MachineCache
+	OmniState     state.State
+	Map           *MachineMap
+	logger        *zap.Logger
+	Cache         *MachineCache
+	storageConfig *config.MachineLogConfigParams
 }
 
+// logRetentionInterval is the interval of applying the retention policy to the stored logs.
+const logRetentionInterval = 10 * time.Minute
+
 // Start starts the LogHandler.
 func (h *LogHandler) Start(ctx context.Context) error {
 	h.logger.Info("starting log handler")
@@ -62,8 +69,21 @@ func (h *LogHandler) Start(ctx context.Context) error {
 		return err
 	}
 
+	var retentionCh <-chan time.Time
+
+	if h.storageConfig.StorageEnabled && (h.storageConfig.StorageMaxAge > 0 || h.storageConfig.StorageMaxSize > 0) {
+		ticker := time.NewTicker(logRetentionInterval)
+		defer ticker.Stop()
+
+		retentionCh = ticker.C
+	}
+
 	for {
 		select {
+		case now := <-retentionCh:
+			if err := h.Cache.Cleanup(now); err != nil {
+				h.logger.Error("failed to apply the machine logs retention policy", zap.Error(err))
+			}
 		case <-ctx.Done():
 			if err := h.Cache.Close(); err != nil {
 				h.logger.Error("failed to close machine logs cache", zap.Error(err))
@@ -186,8 +206,20 @@ func (h *LogHandler) GetReader(machineID MachineID, follow bool, tailLines optio
 
 // LineReader is a reader which reads lines surrounded by \n from the underlying reader.
 type LineReader struct {
+	since  time.Time
+	until  time.Time
 	buf    *bufio.Reader
 	reader io.ReadCloser
+	done   bool
+}
+
+// SetTimeRange limits the lines returned by the reader to the ones written by the machine in the time range.
+//
+// The zero since or until leaves the range open on that side.
+// The lines which don't have the time, e.g. the non-JSON ones, are always returned.
+func (r *LineReader) SetTimeRange(since, until time.Time) {
+	r.since = since
+	r.until = until
 }
 
 // Close closes the LineReader underlying reader.
@@ -197,6 +229,44 @@ func (r *LineReader) Close() error {
 
 // ReadLine reads a line from the underlying reader.
 func (r *LineReader) ReadLine() ([]byte, error) {
+	if r.since.IsZero() && r.until.IsZero() {
+		return r.readLine()
+	}
+
+	if r.done {
+		return nil, io.EOF
+	}
+
+	for {
+		line, err := r.readLine()
+		if err != nil {
+			return nil, err
+		}
+
+		var entry struct {
+			TalosTime time.Time `json:"talos-time"`
+		}
+
+		if err = json.Unmarshal(line, &entry); err != nil || entry.TalosTime.IsZero() {
+			return line, nil //nolint:nilerr
+		}
+
+		if !r.since.IsZero() && entry.TalosTime.Before(r.since) {
+			continue
+		}
+
+		// the lines are ordered, so the rest of them are out of the range as well
+		if !r.until.IsZero() && entry.TalosTime.After(r.until) {
+			r.done = true
+
+			return nil, io.EOF
+		}
+
+		return line, nil
+	}
+}
+
+func (r *LineReader) readLine() ([]byte, error) {
 	if r.buf == nil {
 		r.buf = bufio.NewReader(r.reader)
 	}
@@ -167,6 +167,82 @@ func TestLogHandler_HandleMessage(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, `{"hello": "world5"}`, string(line))
 	})
+
+	t.Run("time range", func(t *testing.T) {
+		cache := siderolink.NewMachineMap(&siderolink.MapStorage{
+			IPToMachine: map[string]siderolink.MachineID{
+				"1.2.3.4": "machine1",
+			},
+		})
+
+		st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+		handler, err := siderolink.NewLogHandler(cache, st, &storageConfig, zaptest.NewLogger(t))
+		require.NoError(t, err)
+
+		handler.HandleMessage(netip.MustParseAddr("1.2.3.4"), []byte(`{"msg": "1", "talos-time": "2024-01-01T10:00:00Z"}`))
+		handler.HandleMessage(netip.MustParseAddr("1.2.3.4"), []byte(`{"msg": "2", "talos-time": "2024-01-01T11:00:00Z"}`))
+		handler.HandleMessage(netip.MustParseAddr("1.2.3.4"), []byte(`not json`))
+		handler.HandleMessage(netip.MustParseAddr("1.2.3.4"), []byte(`{"msg": "3", "talos-time": "2024-01-01T12:00:00Z"}`))
+		handler.HandleMessage(netip.MustParseAddr("1.2.3.4"), []byte(`{"msg": "4", "talos-time": "2024-01-01T13:00:00Z"}`))
+
+		reader, err := handler.GetReader("machine1", false, optional.None[int32]())
+		require.NoError(t, err)
+
+		reader.SetTimeRange(
+			time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC),
+			time.Date(2024, 1, 1, 12, 30, 0, 0, time.UTC),
+		)
+
+		line, err := reader.ReadLine()
+		require.NoError(t, err)
+		require.Equal(t, `{"msg": "2", "talos-time": "2024-01-01T11:00:00Z"}`, string(line))
+		line, err = reader.ReadLine()
+		require.NoError(t, err)
+		require.Equal(t, `not json`, string(line))
+		line, err = reader.ReadLine()
+		require.NoError(t, err)
+		require.Equal(t, `{"msg": "3", "talos-time": "2024-01-01T12:00:00Z"}`, string(line))
+		_, err = reader.ReadLine()
+		require.ErrorIs(t, err, io.EOF)
+	})
+}
+
+func TestMachineCacheCleanup(t *testing.T) {
+	tempDir := t.TempDir()
+	now := time.Now()
+
+	writeLogFile := func(name string, size int, age time.Duration) {
+		path := filepath.Join(tempDir, name)
+
+		require.NoError(t, os.WriteFile(path, make([]byte, size), 0o644))
+		require.NoError(t, os.Chtimes(path, now.Add(-age), now.Add(-age)))
+	}
+
+	writeLogFile("machine-1.log.0", 100, time.Minute)
+	writeLogFile("machine-1.log.1", 100, 2*time.Minute)
+	writeLogFile("machine-1.log.2", 100, 3*time.Minute)
+	writeLogFile("machine-2.log.0", 100, 2*time.Hour)
+	writeLogFile("machine-3.log.0", 200, time.Minute)
+
+	cache, err := siderolink.NewMachineCache(&config.MachineLogConfigParams{
+		StorageEnabled: true,
+		StoragePath:    tempDir,
+		StorageMaxAge:  time.Hour,
+		StorageMaxSize: 150,
+	}, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	require.NoError(t, cache.Cleanup(now))
+
+	// the oldest chunks over the size limit are removed, the most recent one is always kept
+	assert.FileExists(t, filepath.Join(tempDir, "machine-1.log.0"))
+	assert.NoFileExists(t, filepath.Join(tempDir, "machine-1.log.1"))
+	assert.NoFileExists(t, filepath.Join(tempDir, "machine-1.log.2"))
+	assert.FileExists(t, filepath.Join(tempDir, "machine-3.log.0"))
+
+	// the machine didn't send logs for longer than the max age
+	assert.NoFileExists(t, filepath.Join(tempDir, "machine-2.log.0"))
 }
 
 // TestLogHandlerStorage tests that log handler can store logs on the filesystem when log storage is enabled.
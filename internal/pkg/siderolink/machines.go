@@ -15,13 +15,17 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/hashicorp/go-multierror"
 	"github.com/siderolabs/gen/containers"
+	"github.com/siderolabs/gen/xslices"
 	"github.com/siderolabs/go-circular"
 	"github.com/siderolabs/go-circular/zstd"
 	"go.uber.org/zap"
@@ -154,19 +158,131 @@ func (m *MachineCache) Remove(id MachineID) error {
 		return fmt.Errorf("failed to list log files for machine %q: %w", id, err)
 	}
 
+	if err = removeFiles(matches); err != nil {
+		return fmt.Errorf("failed to remove log files for machine %q: %w", id, err)
+	}
+
+	return nil
+}
+
+// Cleanup applies the retention policy to the logs in the storage.
+//
+// The logs of the machines which didn't send any logs for the max age are removed completely,
+// the oldest log chunks of the machines which exceed the max size are removed.
+func (m *MachineCache) Cleanup(now time.Time) error {
+	if !m.logStorageConfig.StorageEnabled || (m.logStorageConfig.StorageMaxAge <= 0 && m.logStorageConfig.StorageMaxSize <= 0) {
+		return nil
+	}
+
+	m.mx.Lock()
+	defer m.mx.Unlock()
+	m.init()
+
+	machineFiles, err := m.listLogFiles()
+	if err != nil {
+		return err
+	}
+
 	var errs error
 
-	for _, match := range matches {
-		if err = os.Remove(match); err != nil && !errors.Is(err, os.ErrNotExist) {
-			errs = multierror.Append(errs, err)
+	for id, files := range machineFiles {
+		// newest first
+		slices.SortFunc(files, func(a, b logFile) int { return b.modTime.Compare(a.modTime) })
+
+		var expired []string
+
+		if m.logStorageConfig.StorageMaxAge > 0 && now.Sub(files[0].modTime) > m.logStorageConfig.StorageMaxAge {
+			expired = xslices.Map(files, func(f logFile) string { return f.path })
+		} else if m.logStorageConfig.StorageMaxSize > 0 {
+			var size int64
+
+			// the most recent file is always kept
+			for i, f := range files {
+				size += f.size
+
+				if i > 0 && size > m.logStorageConfig.StorageMaxSize {
+					expired = append(expired, f.path)
+				}
+			}
+		}
+
+		if len(expired) == 0 {
+			continue
+		}
+
+		m.logger.Info("removing expired machine logs", zap.String("machine_id", string(id)), zap.Int("files", len(expired)))
+
+		// the buffer is flushed and loaded again from the remaining files on the next access
+		if buf, ok := m.machineBuffers.Get(id); ok {
+			if err = buf.Close(); err != nil {
+				m.logger.Error("failed to close buffer", zap.String("machine_id", string(id)), zap.Error(err))
+			}
+
+			m.machineBuffers.Remove(id)
+		}
+
+		if err = removeFiles(expired); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("failed to remove log files for machine %q: %w", id, err))
 		}
 	}
 
-	if errs != nil {
-		return fmt.Errorf("failed to remove log files for machine %q: %w", id, errs)
+	return errs
+}
+
+type logFile struct {
+	modTime time.Time
+	path    string
+	size    int64
+}
+
+// listLogFiles returns the log files in the storage grouped by the machine ID.
+func (m *MachineCache) listLogFiles() (map[MachineID][]logFile, error) {
+	entries, err := os.ReadDir(m.logStorageConfig.StoragePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to list log files: %w", err)
 	}
 
-	return nil
+	machineFiles := map[MachineID][]logFile{}
+
+	for _, entry := range entries {
+		id, _, ok := strings.Cut(entry.Name(), ".log")
+		if !ok || entry.IsDir() {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+
+			return nil, fmt.Errorf("failed to get log file info: %w", err)
+		}
+
+		machineFiles[MachineID(id)] = append(machineFiles[MachineID(id)], logFile{
+			path:    filepath.Join(m.logStorageConfig.StoragePath, entry.Name()),
+			modTime: info.ModTime(),
+			size:    info.Size(),
+		})
+	}
+
+	return machineFiles, nil
+}
+
+func removeFiles(paths []string) error {
+	var errs error
+
+	for _, path := range paths {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	return errs
 }
 
 // Close closes all the circular buffers, triggering a flush to the storage for each of them if they have persistence enabled.
@@ -67,6 +67,19 @@ type LinkCounterDelta struct {
 	BytesReceived int64
 }
 
+// LinkSamples represents a map of the WireGuard peer statistics sampled for the links.
+type LinkSamples = map[resource.ID]LinkSample
+
+// LinkSample represents the WireGuard peer statistics of the link at the sampling time.
+type LinkSample struct {
+	SampledAt         time.Time
+	LastHandshake     time.Time
+	Endpoint          string
+	BytesReceived     int64
+	BytesSent         int64
+	KeepaliveInterval time.Duration
+}
+
 // maxPendingClientMessages sets the maximum number of messages for queue "from peers" after which it will block.
 const maxPendingClientMessages = 100
 
@@ -80,6 +93,7 @@ func NewManager(
 	handler *LogHandler,
 	machineStatusHandler *machinestatus.Handler,
 	deltaCh chan<- LinkCounterDeltas,
+	sampleCh chan<- LinkSamples,
 ) (*Manager, error) {
 	manager := &Manager{
 		logger:               logger,
@@ -108,6 +122,7 @@ func NewManager(
 			},
 		}),
 		deltaCh:       deltaCh,
+		sampleCh:      sampleCh,
 		allowedPeers:  wggrpc.NewAllowedPeers(),
 		peerTraffic:   wgbind.NewPeerTraffic(maxPendingClientMessages),
 		virtualPrefix: wireguard.VirtualNetworkPrefix(),
@@ -144,6 +159,7 @@ type Manager struct {
 	metricBytesSent     prometheus.Counter
 	metricLastHandshake prometheus.Histogram
 	deltaCh             chan<- LinkCounterDeltas
+	sampleCh            chan<- LinkSamples
 	serverAddr          netip.Prefix
 	allowedPeers        *wggrpc.AllowedPeers
 	peerTraffic         *wgbind.PeerTraffic
@@ -505,6 +521,8 @@ func (manager *Manager) pollWireguardPeers(ctx context.Context) error {
 			}
 
 			counterDeltas := make(LinkCounterDeltas, links.Len())
+			samples := make(LinkSamples, links.Len())
+			sampledAt := time.Now()
 
 			for iter := links.Iterator(); iter.Next(); {
 				link := iter.Value()
@@ -539,6 +557,20 @@ func (manager *Manager) pollWireguardPeers(ctx context.Context) error {
 					transmitBytes: peer.TransmitBytes,
 				}
 
+				sample := LinkSample{
+					SampledAt:         sampledAt,
+					LastHandshake:     peer.LastHandshakeTime,
+					BytesReceived:     peer.ReceiveBytes,
+					BytesSent:         peer.TransmitBytes,
+					KeepaliveInterval: peer.PersistentKeepaliveInterval,
+				}
+
+				if peer.Endpoint != nil {
+					sample.Endpoint = peer.Endpoint.String()
+				}
+
+				samples[link.Metadata().ID()] = sample
+
 				sinceLastHandshake := time.Since(peer.LastHandshakeTime)
 
 				manager.metricLastHandshake.Observe(sinceLastHandshake.Seconds())
@@ -568,6 +600,10 @@ func (manager *Manager) pollWireguardPeers(ctx context.Context) error {
 			if manager.deltaCh != nil {
 				channel.SendWithContext(ctx, manager.deltaCh, counterDeltas)
 			}
+
+			if manager.sampleCh != nil {
+				channel.SendWithContext(ctx, manager.sampleCh, samples)
+			}
 		}
 	}
 }
@@ -37,6 +37,15 @@ type handler struct {
 }
 
 func getSecretsBundle(ctx context.Context, st state.State, peerAddress string) (*secrets.Bundle, error) {
+	clusterSecrets, err := getClusterSecrets(ctx, st, peerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return omni.ToSecretsBundle(clusterSecrets)
+}
+
+func getClusterSecrets(ctx context.Context, st state.State, peerAddress string) (*omni.ClusterSecrets, error) {
 	ctx = actor.MarkContextAsInternalActor(ctx)
 
 	machines, err := safe.StateListAll[*omni.Machine](
@@ -71,7 +80,7 @@ func getSecretsBundle(ctx context.Context, st state.State, peerAddress string) (
 		return nil, status.Errorf(codes.PermissionDenied, "failed to get cluster secrets: %s", err)
 	}
 
-	return omni.ToSecretsBundle(clusterSecrets)
+	return clusterSecrets, nil
 }
 
 // Certificate implements the securityapi.SecurityServer interface.
@@ -89,14 +98,19 @@ func (h *handler) Certificate(ctx context.Context, in *securityapi.CertificateRe
 		return nil, status.Error(codes.PermissionDenied, "peer address is not TCP")
 	}
 
-	secretsBundle, err := getSecretsBundle(ctx, h.state, tcpAddr.IP.String())
+	clusterSecrets, err := getClusterSecrets(ctx, h.state, tcpAddr.IP.String())
 	if err != nil {
 		return nil, err
 	}
 
-	// validate the token
+	secretsBundle, err := omni.ToSecretsBundle(clusterSecrets)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to decode cluster secrets: %s", err)
+	}
+
+	// validate the token, the previous token is accepted until the Talos secrets rotation rolls out the new one
 	md, _ := metadata.FromIncomingContext(ctx)
-	if token := md.Get("token"); len(token) != 1 || token[0] != secretsBundle.TrustdInfo.Token {
+	if token := md.Get("token"); len(token) != 1 || !validTrustdToken(token[0], secretsBundle, clusterSecrets) {
 		return nil, status.Error(codes.PermissionDenied, "invalid token")
 	}
 
@@ -146,3 +160,13 @@ func (h *handler) Certificate(ctx context.Context, in *securityapi.CertificateRe
 
 	return resp, nil
 }
+
+func validTrustdToken(token string, secretsBundle *secrets.Bundle, clusterSecrets *omni.ClusterSecrets) bool {
+	if token == secretsBundle.TrustdInfo.Token {
+		return true
+	}
+
+	previousToken := clusterSecrets.TypedSpec().Value.GetTalosSecretsRotation().GetPreviousTrustdToken()
+
+	return previousToken != "" && token == previousToken
+}
@@ -7,5 +7,13 @@ package version
 
 // API represents the current API version of the backend, and is checked by the clients for compatibility.
 //
-// When the backend API changes in a breaking way, this needs to be bumped.
+// When the backend API changes in a breaking way, this needs to be bumped,
+// and the compatibility shims for the clients using the previous API version should be registered.
 const API uint32 = 1
+
+// MinAPI is the oldest client API version the backend still serves.
+//
+// The clients using the API versions in the [MinAPI, API] range are served with the help of the compatibility shims.
+// MinAPI can be raised only when the older API version was supported by at least two minor releases of the backend,
+// so that the clients have the time to upgrade.
+const MinAPI uint32 = 1